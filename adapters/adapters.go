@@ -16,16 +16,26 @@
 package adapters
 
 import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/docker/go-units"
+	"github.com/go-openapi/runtime/middleware"
 
 	"github.com/haproxytech/models/v2"
 
+	"github.com/haproxytech/dataplaneapi/auth"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/policy"
+
 	"github.com/sirupsen/logrus"
 )
 
@@ -66,6 +76,13 @@ func (srw *statusResponseWriter) Length() int {
 	return srw.length
 }
 
+// Flush sends buffered data to the client, needed by streaming responses
+func (srw *statusResponseWriter) Flush() {
+	if f, ok := srw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func newStatusResponseWriter(rw http.ResponseWriter) *statusResponseWriter {
 	nsrw := &statusResponseWriter{
 		ResponseWriter: rw,
@@ -192,3 +209,626 @@ func logAfter(logger *logrus.Logger, res *statusResponseWriter, start time.Time)
 	e = e.WithField("took", latency)
 	e.Info("completed handling request")
 }
+
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+	// streaming is set once the handler flushed the response, the buffer is
+	// then written out and following writes go straight to rw
+	streaming  bool
+	rw         http.ResponseWriter
+	getVersion func() (int64, error)
+}
+
+func (brw *bufferedResponseWriter) Header() http.Header {
+	return brw.header
+}
+
+func (brw *bufferedResponseWriter) WriteHeader(s int) {
+	if brw.status == 0 {
+		brw.status = s
+	}
+}
+
+func (brw *bufferedResponseWriter) Write(b []byte) (int, error) {
+	if brw.status == 0 {
+		brw.status = http.StatusOK
+	}
+	if brw.streaming {
+		return brw.rw.Write(b)
+	}
+	return brw.body.Write(b)
+}
+
+// Flush switches to streaming the response, a streamed response can not be
+// answered with 304 Not Modified
+func (brw *bufferedResponseWriter) Flush() {
+	if !brw.streaming {
+		brw.streaming = true
+		if brw.status == 0 {
+			brw.status = http.StatusOK
+		}
+		setVersionHeader(brw.header, brw.getVersion)
+		brw.rw.WriteHeader(brw.status)
+		// nolint:errcheck
+		brw.rw.Write(brw.body.Bytes())
+		brw.body.Reset()
+	}
+	if f, ok := brw.rw.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+type versionResponseWriter struct {
+	http.ResponseWriter
+	getVersion  func() (int64, error)
+	wroteHeader bool
+}
+
+func (vrw *versionResponseWriter) WriteHeader(s int) {
+	if !vrw.wroteHeader {
+		vrw.wroteHeader = true
+		setVersionHeader(vrw.Header(), vrw.getVersion)
+	}
+	vrw.ResponseWriter.WriteHeader(s)
+}
+
+func (vrw *versionResponseWriter) Write(b []byte) (int, error) {
+	if !vrw.wroteHeader {
+		vrw.WriteHeader(http.StatusOK)
+	}
+	return vrw.ResponseWriter.Write(b)
+}
+
+// Flush sends buffered data to the client, needed by streaming responses
+func (vrw *versionResponseWriter) Flush() {
+	if !vrw.wroteHeader {
+		vrw.WriteHeader(http.StatusOK)
+	}
+	if f, ok := vrw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func setVersionHeader(header http.Header, getVersion func() (int64, error)) {
+	if header.Get("Configuration-Version") != "" {
+		return
+	}
+	if v, err := getVersion(); err == nil {
+		header.Set("Configuration-Version", strconv.FormatInt(v, 10))
+	}
+}
+
+// ConfigVersionMiddleware sets the Configuration-Version header on every response,
+// and answers conditional GETs with 304 Not Modified when the If-None-Match header
+// matches the configuration version returned by the handler. Responses in a
+// transaction are never considered unmodified, as the transaction content can
+// change without its version changing.
+func ConfigVersionMiddleware(getVersion func() (int64, error)) Adapter {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Query().Get("transaction_id") != "" {
+				h.ServeHTTP(&versionResponseWriter{ResponseWriter: w, getVersion: getVersion}, r)
+				return
+			}
+
+			res := &bufferedResponseWriter{header: w.Header(), rw: w, getVersion: getVersion}
+			h.ServeHTTP(res, r)
+			if res.streaming {
+				return
+			}
+			if res.status == 0 {
+				res.status = http.StatusOK
+			}
+			// only configuration handlers set the version themselves
+			if version := res.header.Get("Configuration-Version"); version != "" && res.status == http.StatusOK {
+				etag := `"` + version + `"`
+				res.header.Set("ETag", etag)
+				if etagMatches(r.Header.Get("If-None-Match"), etag) {
+					res.header.Del("Content-Type")
+					res.header.Del("Content-Length")
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+			}
+			setVersionHeader(res.header, getVersion)
+			w.WriteHeader(res.status)
+			// nolint:errcheck
+			w.Write(res.body.Bytes())
+		})
+	}
+}
+
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, t := range strings.Split(ifNoneMatch, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == "*" || t == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// RequestMetricsMiddleware passes the operation, status and duration of every
+// request to record, if set. It runs after routing.
+func RequestMetricsMiddleware(record func(method, operation string, status int, d time.Duration)) Adapter {
+	return func(h http.Handler) http.Handler {
+		if record == nil {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			res := newStatusResponseWriter(w)
+			h.ServeHTTP(res, r)
+			operation := ""
+			if route := middleware.MatchedRouteFrom(r); route != nil {
+				operation = route.Operation.ID
+			}
+			record(r.Method, operation, res.Status(), time.Since(start))
+		})
+	}
+}
+
+// GitCommitMiddleware commits the files changed by successful mutating
+// requests once per transaction: when a transaction is committed, or after a
+// request made without a transaction. It runs after routing, before
+// authentication.
+func GitCommitMiddleware(commit func(transactionID, user string) error) Adapter {
+	return func(h http.Handler) http.Handler {
+		if commit == nil {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := middleware.MatchedRouteFrom(r)
+			if route == nil || r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				h.ServeHTTP(w, r)
+				return
+			}
+			res := newStatusResponseWriter(w)
+			h.ServeHTTP(res, r)
+			if res.Status() < http.StatusOK || res.Status() >= http.StatusMultipleChoices {
+				return
+			}
+			transactionID := ""
+			if route.Operation.ID == "commitTransaction" {
+				for _, p := range route.Params {
+					if p.Name == "id" {
+						transactionID = p.Value
+					}
+				}
+			} else if r.URL.Query().Get("transaction_id") != "" {
+				// committed with the transaction
+				return
+			}
+			if err := commit(transactionID, auth.User(r)); err != nil {
+				logrus.Warning("Git mode: " + err.Error())
+			}
+		})
+	}
+}
+
+// FeaturesMiddleware answers requests to endpoints of disabled feature groups
+// with 403 Forbidden. It runs after routing, disabled returns the disabled group
+// of the path pattern of the matched route, empty when the endpoint is enabled.
+func FeaturesMiddleware(disabled func(path string) string) Adapter {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := middleware.MatchedRouteFrom(r)
+			if route == nil {
+				h.ServeHTTP(w, r)
+				return
+			}
+			group := disabled(route.PathPattern)
+			if group == "" {
+				h.ServeHTTP(w, r)
+				return
+			}
+			writeError(w, http.StatusForbidden, fmt.Sprintf("%s endpoints are disabled", group))
+		})
+	}
+}
+
+// PolicyMiddleware submits mutating requests to the admission policies, denied
+// requests are answered with 403 Forbidden and requests whose evaluation failed
+// with 500. It runs after routing, before authentication.
+func PolicyMiddleware(engine *policy.Engine) Adapter {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := middleware.MatchedRouteFrom(r)
+			if engine.Empty() || route == nil || r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				h.ServeHTTP(w, r)
+				return
+			}
+			req := policy.Request{
+				Method:    r.Method,
+				Path:      route.PathPattern,
+				URL:       r.URL.RequestURI(),
+				Operation: route.Operation.ID,
+				Params:    make(map[string]string),
+				Query:     make(map[string]string),
+			}
+			for _, p := range route.Params {
+				req.Params[p.Name] = p.Value
+			}
+			for k, v := range r.URL.Query() {
+				req.Query[k] = v[0]
+			}
+			req.User = auth.User(r)
+			req.Roles = auth.Roles(r)
+			if r.Body != nil {
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					writeError(w, http.StatusBadRequest, err.Error())
+					return
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+				// a body that is not JSON is left to the handler to reject
+				// nolint:errcheck
+				json.Unmarshal(body, &req.Body)
+			}
+
+			allowed, reason, err := engine.Admit(req)
+			if err != nil {
+				logrus.Error(err.Error())
+				writeError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			if !allowed {
+				writeError(w, http.StatusForbidden, reason)
+				return
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// sectionEndpoints are the configuration endpoints of sections, by section type
+var sectionEndpoints = map[string]string{
+	"backends":     "backend",
+	"frontends":    "frontend",
+	"listens":      "listen",
+	"resolvers":    "resolvers",
+	"peer_section": "peers",
+}
+
+// parentParams are the parameters naming the section of a child object, with
+// the type of the section, in the order they are read
+var parentParams = []struct {
+	param       string
+	sectionType string
+}{
+	{"backend", "backend"},
+	{"frontend", "frontend"},
+	{"listen", "listen"},
+	{"resolver", "resolvers"},
+	{"peer_section", "peers"},
+}
+
+// wholeConfigurationEndpoints replace the whole configuration, or stage
+// changes of any section in a transaction
+var wholeConfigurationEndpoints = map[string]bool{
+	"/services/haproxy/configuration/raw":         true,
+	"/services/haproxy/configuration/declarative": true,
+	"/services/haproxy/configuration/import":      true,
+	"/services/haproxy/configuration/migrate":     true,
+	"/services/haproxy/snapshot/restore":          true,
+}
+
+// ProtectionMiddleware checks mutating requests to the configuration endpoints
+// against the protection of the section they change. check returns an error
+// when the user is not allowed to change the section, an empty name stands for
+// the whole configuration. It runs after routing, before authentication.
+func ProtectionMiddleware(check func(sectionType, name, user string, force bool) error) Adapter {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := middleware.MatchedRouteFrom(r)
+			if route == nil || r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				h.ServeHTTP(w, r)
+				return
+			}
+			sectionType, name, ok, err := changedSection(route, r)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if !ok {
+				h.ServeHTTP(w, r)
+				return
+			}
+			force, _ := strconv.ParseBool(r.URL.Query().Get("force"))
+			if err := check(sectionType, name, auth.User(r), force); err != nil {
+				e := misc.HandleError(err)
+				writeError(w, int(*e.Code), *e.Message)
+				return
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// changedSection returns the section changed by a request to a configuration
+// endpoint, with an empty name when the whole configuration is replaced
+func changedSection(route *middleware.MatchedRoute, r *http.Request) (string, string, bool, error) {
+	if wholeConfigurationEndpoints[route.PathPattern] {
+		return "", "", true, nil
+	}
+	return endpointSection("/services/haproxy/configuration/", route, r)
+}
+
+// endpointSection returns the section named by the parameters of a request
+// to an endpoint under prefix. Only the path parameters and the parameters the
+// operation declares are read, a request naming different sections is an
+// error.
+func endpointSection(prefix string, route *middleware.MatchedRoute, r *http.Request) (string, string, bool, error) {
+	if !strings.HasPrefix(route.PathPattern, prefix) {
+		return "", "", false, nil
+	}
+	path := make(map[string]string)
+	for _, p := range route.Params {
+		path[p.Name] = p.Value
+	}
+	query := r.URL.Query()
+	params := make(map[string]string)
+	for _, p := range route.Parameters {
+		switch p.In {
+		case "path":
+			params[p.Name] = path[p.Name]
+		case "query":
+			params[p.Name] = query.Get(p.Name)
+		}
+	}
+
+	var sectionType, name string
+	found := func(t, n string) error {
+		if sectionType != "" && (t != sectionType || n != name) {
+			return fmt.Errorf("the request names both %s %s and %s %s", sectionType, name, t, n)
+		}
+		sectionType, name = t, n
+		return nil
+	}
+	endpoint := strings.Split(strings.TrimPrefix(route.PathPattern, prefix), "/")
+	if t, ok := sectionEndpoints[endpoint[0]]; ok && params["name"] != "" {
+		// nolint:errcheck
+		found(t, params["name"])
+	}
+	if params["parent_type"] != "" && params["parent_name"] != "" {
+		if err := found(params["parent_type"], params["parent_name"]); err != nil {
+			return "", "", false, err
+		}
+	}
+	for _, p := range parentParams {
+		if params[p.param] == "" {
+			continue
+		}
+		if err := found(p.sectionType, params[p.param]); err != nil {
+			return "", "", false, err
+		}
+	}
+	return sectionType, name, sectionType != "", nil
+}
+
+// RBACMiddleware checks the requests against the roles of their user,
+// authorize returning an error when the user with the roles of the external
+// authentication is not allowed to send the request, sectionType and name
+// being the section it changes. It runs after routing, before authentication.
+func RBACMiddleware(authorize func(method, path, sectionType, name, user string, roles []string) error) Adapter {
+	return func(h http.Handler) http.Handler {
+		if authorize == nil {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := middleware.MatchedRouteFrom(r)
+			// the endpoints without security, such as the git webhook,
+			// authenticate their requests themselves
+			if route == nil || !route.HasAuth() {
+				h.ServeHTTP(w, r)
+				return
+			}
+			var sectionType, name string
+			if r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodOptions {
+				var ok bool
+				var err error
+				sectionType, name, ok, err = changedSection(route, r)
+				if err == nil && !ok {
+					sectionType, name, _, err = endpointSection("/services/haproxy/runtime/", route, r)
+				}
+				if err != nil {
+					writeError(w, http.StatusBadRequest, err.Error())
+					return
+				}
+				if sectionType == "" && r.Method == http.MethodPost && r.Body != nil {
+					// the sections created are named in the body
+					sectionType = createdSectionType(route.PathPattern)
+					if sectionType != "" {
+						body, err := ioutil.ReadAll(r.Body)
+						if err != nil {
+							writeError(w, http.StatusBadRequest, err.Error())
+							return
+						}
+						r.Body = ioutil.NopCloser(bytes.NewReader(body))
+						var section struct {
+							Name string `json:"name"`
+						}
+						// a body that is not JSON is left to the handler to reject
+						// nolint:errcheck
+						json.Unmarshal(body, &section)
+						name = section.Name
+					}
+				}
+			}
+			if err := authorize(r.Method, route.PathPattern, sectionType, name, auth.User(r), auth.Roles(r)); err != nil {
+				e := misc.HandleError(err)
+				writeError(w, int(*e.Code), *e.Message)
+				return
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// createdSectionType returns the type of the sections created with a POST to
+// the path pattern, empty for the other endpoints
+func createdSectionType(path string) string {
+	const prefix = "/services/haproxy/configuration/"
+	if !strings.HasPrefix(path, prefix) {
+		return ""
+	}
+	return sectionEndpoints[strings.TrimPrefix(path, prefix)]
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	code := int64(status)
+	e := &models.Error{
+		Code:    &code,
+		Message: &msg,
+	}
+	errMsg, _ := e.MarshalJSON()
+	w.Header().Set(http.CanonicalHeaderKey("Content-Type"), "application/json")
+	w.WriteHeader(status)
+	// nolint:errcheck
+	w.Write(errMsg)
+}
+
+// WritableConfigMiddleware keeps the configuration directory writable for the
+// duration of mutating requests, open making it writable and close read-only
+// again. It runs after routing, before authentication.
+func WritableConfigMiddleware(open func() error, close func()) Adapter {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				h.ServeHTTP(w, r)
+				return
+			}
+			if err := open(); err != nil {
+				writeError(w, http.StatusServiceUnavailable, err.Error())
+				return
+			}
+			defer close()
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// BearerAuthMiddleware authenticates the requests with a bearer token with
+// authenticate, the principal it returns being set to the request for the
+// API authentication. Other requests are left to the Basic Authentication.
+func BearerAuthMiddleware(authenticate func(token string) (*auth.Principal, error)) Adapter {
+	return func(h http.Handler) http.Handler {
+		if authenticate == nil {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if len(header) < 7 || !strings.EqualFold(header[:7], "bearer ") {
+				h.ServeHTTP(w, r)
+				return
+			}
+			p, err := authenticate(strings.TrimSpace(header[7:]))
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				writeError(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+			h.ServeHTTP(w, auth.WithPrincipal(r, p))
+		})
+	}
+}
+
+// ExternalAuthMiddleware authenticates the Basic Authentication of the users
+// which are not users of the API with authenticate, the principal it returns
+// being set to the request, for the roles of the external authentication to
+// apply. The users of the API are left to the Basic Authentication.
+func ExternalAuthMiddleware(isUser func(user string) bool, authenticate func(user, password string) (*auth.Principal, error)) Adapter {
+	return func(h http.Handler) http.Handler {
+		if authenticate == nil {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, password, ok := r.BasicAuth()
+			if !ok || auth.FromRequest(r) != nil || isUser(user) {
+				h.ServeHTTP(w, r)
+				return
+			}
+			p, err := authenticate(user, password)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Basic realm="API"`)
+				writeError(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+			h.ServeHTTP(w, auth.WithPrincipal(r, p))
+		})
+	}
+}
+
+// ClientCertificateMiddleware authenticates the requests with a verified
+// client certificate as the user identify maps it to, the requests with a
+// certificate which is not mapped being authenticated as the other ones
+func ClientCertificateMiddleware(identify func(cert *x509.Certificate) (string, bool)) Adapter {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+				h.ServeHTTP(w, r)
+				return
+			}
+			user, ok := identify(r.TLS.VerifiedChains[0][0])
+			if !ok {
+				h.ServeHTTP(w, r)
+				return
+			}
+			h.ServeHTTP(w, auth.WithPrincipal(r, &auth.Principal{User: user}))
+		})
+	}
+}
+
+// MountMiddleware serves handler on path, before routing and the global
+// middleware, for handlers such as WebSockets which take over the connection
+func MountMiddleware(path string, handler http.Handler) Adapter {
+	return func(h http.Handler) http.Handler {
+		if handler == nil {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != path {
+				h.ServeHTTP(w, r)
+				return
+			}
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// MetricsMiddleware serves the metrics on path, outside of the API base path,
+// authenticating the requests with authenticate when it is set. It runs before
+// routing.
+func MetricsMiddleware(path string, metrics http.Handler, authenticate func(user, pass string) (interface{}, error)) Adapter {
+	return func(h http.Handler) http.Handler {
+		if metrics == nil {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != path {
+				h.ServeHTTP(w, r)
+				return
+			}
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				writeError(w, http.StatusMethodNotAllowed, "metrics are read with GET")
+				return
+			}
+			if authenticate != nil {
+				user, pass, ok := r.BasicAuth()
+				if !ok {
+					w.Header().Set("WWW-Authenticate", `Basic realm="metrics"`)
+					writeError(w, http.StatusUnauthorized, "unauthenticated for invalid credentials")
+					return
+				}
+				if _, err := authenticate(user, pass); err != nil {
+					w.Header().Set("WWW-Authenticate", `Basic realm="metrics"`)
+					writeError(w, http.StatusUnauthorized, err.Error())
+					return
+				}
+			}
+			metrics.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package adapters
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/spec"
+)
+
+func TestEndpointSection(t *testing.T) {
+	tests := []struct {
+		name        string
+		pattern     string
+		path        middleware.RouteParams
+		declared    []spec.Parameter
+		query       string
+		sectionType string
+		section     string
+		ok          bool
+		err         bool
+	}{
+		{
+			name:        "section endpoint",
+			pattern:     "/services/haproxy/configuration/backends/{name}",
+			path:        middleware.RouteParams{{Name: "name", Value: "app"}},
+			declared:    []spec.Parameter{*spec.PathParam("name")},
+			sectionType: "backend",
+			section:     "app",
+			ok:          true,
+		},
+		{
+			name:        "parent param",
+			pattern:     "/services/haproxy/configuration/servers/{name}",
+			path:        middleware.RouteParams{{Name: "name", Value: "s1"}},
+			declared:    []spec.Parameter{*spec.PathParam("name"), *spec.QueryParam("backend")},
+			query:       "backend=app",
+			sectionType: "backend",
+			section:     "app",
+			ok:          true,
+		},
+		{
+			name:        "parent type and name",
+			pattern:     "/services/haproxy/configuration/acls",
+			declared:    []spec.Parameter{*spec.QueryParam("parent_type"), *spec.QueryParam("parent_name")},
+			query:       "parent_type=frontend&parent_name=web",
+			sectionType: "frontend",
+			section:     "web",
+			ok:          true,
+		},
+		{
+			name:     "undeclared parent type ignored",
+			pattern:  "/services/haproxy/configuration/servers/{name}",
+			path:     middleware.RouteParams{{Name: "name", Value: "s1"}},
+			declared: []spec.Parameter{*spec.PathParam("name"), *spec.QueryParam("backend")},
+			query:    "parent_type=backend&parent_name=allowed&backend=victim",
+			// only backend is declared, the section is the one the handler changes
+			sectionType: "backend",
+			section:     "victim",
+			ok:          true,
+		},
+		{
+			name:        "undeclared parent param ignored",
+			pattern:     "/services/haproxy/configuration/backends/{name}",
+			path:        middleware.RouteParams{{Name: "name", Value: "app"}},
+			declared:    []spec.Parameter{*spec.PathParam("name")},
+			query:       "frontend=web",
+			sectionType: "backend",
+			section:     "app",
+			ok:          true,
+		},
+		{
+			name:     "different sections",
+			pattern:  "/services/haproxy/configuration/acls",
+			declared: []spec.Parameter{*spec.QueryParam("parent_type"), *spec.QueryParam("parent_name"), *spec.QueryParam("backend")},
+			query:    "parent_type=backend&parent_name=allowed&backend=victim",
+			err:      true,
+		},
+		{
+			name:        "same section twice",
+			pattern:     "/services/haproxy/configuration/acls",
+			declared:    []spec.Parameter{*spec.QueryParam("parent_type"), *spec.QueryParam("parent_name"), *spec.QueryParam("backend")},
+			query:       "parent_type=backend&parent_name=app&backend=app",
+			sectionType: "backend",
+			section:     "app",
+			ok:          true,
+		},
+		{
+			name:     "no section",
+			pattern:  "/services/haproxy/configuration/global",
+			declared: []spec.Parameter{*spec.QueryParam("version")},
+			query:    "version=1&backend=app",
+		},
+		{
+			name:    "other prefix",
+			pattern: "/services/haproxy/reloads",
+			query:   "backend=app",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := &middleware.MatchedRoute{Params: tt.path}
+			route.PathPattern = tt.pattern
+			route.Parameters = make(map[string]spec.Parameter)
+			for _, p := range tt.declared {
+				route.Parameters[p.In+"#"+p.Name] = p
+			}
+			r := httptest.NewRequest("PUT", "http://localhost/v2"+tt.pattern+"?"+tt.query, nil)
+			sectionType, section, ok, err := endpointSection("/services/haproxy/configuration/", route, r)
+			if (err != nil) != tt.err {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if sectionType != tt.sectionType || section != tt.section || ok != tt.ok {
+				t.Fatalf("got %s %s %v, expected %s %s %v", sectionType, section, ok, tt.sectionType, tt.section, tt.ok)
+			}
+		})
+	}
+}
+
+func TestChangedSectionWholeConfiguration(t *testing.T) {
+	for _, pattern := range []string{
+		"/services/haproxy/configuration/raw",
+		"/services/haproxy/configuration/declarative",
+		"/services/haproxy/configuration/import",
+		"/services/haproxy/configuration/migrate",
+		"/services/haproxy/snapshot/restore",
+	} {
+		route := &middleware.MatchedRoute{}
+		route.PathPattern = pattern
+		r := httptest.NewRequest("POST", "http://localhost/v2"+pattern+"?backend=app", nil)
+		sectionType, section, ok, err := changedSection(route, r)
+		if err != nil || !ok || sectionType != "" || section != "" {
+			t.Errorf("%s: got %s %s %v %v, expected the whole configuration", pattern, sectionType, section, ok, err)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package adapters
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/haproxytech/dataplaneapi/auth"
+)
+
+// auditRedacted are the fields whose values are not written to the audit log
+var auditRedacted = map[string]bool{
+	"password":        true,
+	"secure_password": true,
+	"token":           true,
+	"secret":          true,
+	"bootstrap_key":   true,
+	"private_key":     true,
+}
+
+// AuditChange is a field changed by a request, with its value before and after
+type AuditChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+type captureResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (crw *captureResponseWriter) Header() http.Header {
+	return crw.header
+}
+
+func (crw *captureResponseWriter) WriteHeader(s int) {
+	if crw.status == 0 {
+		crw.status = s
+	}
+}
+
+func (crw *captureResponseWriter) Write(b []byte) (int, error) {
+	if crw.status == 0 {
+		crw.status = http.StatusOK
+	}
+	return crw.body.Write(b)
+}
+
+// AuditMiddleware writes an entry to logger for every mutating request: the
+// user, the endpoint, the transaction, the fields the request body changes and
+// the resulting configuration version. The object replaced or deleted is read
+// first with a GET of the same URL. It runs before routing.
+func AuditMiddleware(logger *logrus.Logger) Adapter {
+	return func(h http.Handler) http.Handler {
+		if logger == nil {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				h.ServeHTTP(w, r)
+				return
+			}
+			var body []byte
+			if r.Body != nil {
+				var err error
+				body, err = ioutil.ReadAll(r.Body)
+				if err != nil {
+					writeError(w, http.StatusBadRequest, err.Error())
+					return
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+			var before interface{}
+			if r.Method == http.MethodPut || r.Method == http.MethodDelete {
+				before = currentObject(h, r)
+			}
+
+			start := time.Now()
+			res := newStatusResponseWriter(w)
+			h.ServeHTTP(res, r)
+
+			e := logger.WithFields(logrus.Fields{
+				"user":     auth.User(r),
+				"method":   r.Method,
+				"endpoint": r.URL.Path,
+				"status":   res.Status(),
+				"took":     time.Since(start).String(),
+			})
+			if id := r.URL.Query().Get("transaction_id"); id != "" {
+				e = e.WithField("transaction_id", id)
+			}
+			if version := res.Header().Get("Configuration-Version"); version != "" {
+				e = e.WithField("version", version)
+			}
+			if reload := res.Header().Get("Reload-ID"); reload != "" {
+				e = e.WithField("reload_id", reload)
+			}
+			var after interface{}
+			if len(body) > 0 {
+				if err := json.Unmarshal(body, &after); err != nil {
+					// raw configurations and files are identified by their checksum
+					sum := sha256.Sum256(body)
+					e = e.WithField("body_sha256", hex.EncodeToString(sum[:]))
+					after = nil
+				}
+			}
+			if changes := auditDiff("", redact(before), redact(after)); len(changes) > 0 {
+				e = e.WithField("changes", changes)
+			}
+			e.Info("audit")
+		})
+	}
+}
+
+// currentObject returns the object at the URL of r, nil when it cannot be read as JSON
+func currentObject(h http.Handler, r *http.Request) interface{} {
+	get, err := http.NewRequest(http.MethodGet, r.URL.String(), nil)
+	if err != nil {
+		return nil
+	}
+	get = get.WithContext(r.Context())
+	get.Header.Set("Authorization", r.Header.Get("Authorization"))
+	get.Header.Set("Accept", "application/json")
+	res := &captureResponseWriter{header: make(http.Header)}
+	h.ServeHTTP(res, get)
+	if res.status != http.StatusOK {
+		return nil
+	}
+	var object interface{}
+	if err := json.Unmarshal(res.body.Bytes(), &object); err != nil {
+		return nil
+	}
+	// objects are returned in the data field along with the configuration version
+	if m, ok := object.(map[string]interface{}); ok {
+		if data, ok := m["data"]; ok {
+			return data
+		}
+	}
+	return object
+}
+
+// redact replaces the values of the secret fields of v
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			if auditRedacted[k] {
+				m[k] = "<redacted>"
+				continue
+			}
+			m[k] = redact(value)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, 0, len(v))
+		for _, value := range v {
+			l = append(l, redact(value))
+		}
+		return l
+	}
+	return v
+}
+
+// auditDiff returns the fields which differ between before and after, by
+// their dotted path under prefix
+func auditDiff(prefix string, before, after interface{}) []AuditChange {
+	b, bIsMap := before.(map[string]interface{})
+	a, aIsMap := after.(map[string]interface{})
+	if !bIsMap || !aIsMap {
+		if jsonEqual(before, after) {
+			return nil
+		}
+		field := prefix
+		if field == "" {
+			field = "."
+		}
+		return []AuditChange{{Field: field, Before: before, After: after}}
+	}
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range b {
+		keys = append(keys, k)
+	}
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	changes := make([]AuditChange, 0)
+	for _, k := range keys {
+		changes = append(changes, auditDiff(strings.TrimPrefix(prefix+"."+k, "."), b[k], a[k])...)
+	}
+	return changes
+}
+
+func jsonEqual(a, b interface{}) bool {
+	x, errX := json.Marshal(a)
+	y, errY := json.Marshal(b)
+	return errX == nil && errY == nil && bytes.Equal(x, y)
+}
@@ -0,0 +1,185 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+)
+
+// modes of the client certificate authentication
+const (
+	ClientAuthRequest = "request"
+	ClientAuthRequire = "require"
+)
+
+// types of the fields of a client certificate an identity matches
+const (
+	IdentityCN    = "cn"
+	IdentityDNS   = "dns"
+	IdentityEmail = "email"
+	IdentityURI   = "uri"
+	IdentityIP    = "ip"
+)
+
+// ClientIdentity maps the client certificates with a subject common name or a
+// subject alternative name to a user of the API
+type ClientIdentity struct {
+	Type  string
+	Value string
+	User  string
+}
+
+// ParseClientIdentity parses an identity as type:value=user, such as
+// cn:ops-bot=admin, the user being the value when it is omitted
+func ParseClientIdentity(s string) (ClientIdentity, error) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return ClientIdentity{}, fmt.Errorf("invalid client identity %s, expected type:value=user", s)
+	}
+	id := ClientIdentity{Type: strings.ToLower(parts[0]), Value: parts[1]}
+	if i := strings.LastIndex(parts[1], "="); i >= 0 {
+		id.Value, id.User = parts[1][:i], parts[1][i+1:]
+	} else {
+		id.User = id.Value
+	}
+	switch id.Type {
+	case IdentityCN, IdentityDNS, IdentityEmail, IdentityURI, IdentityIP:
+	default:
+		return ClientIdentity{}, fmt.Errorf("invalid client identity %s, expected a type among cn, dns, email, uri and ip", s)
+	}
+	if id.Value == "" || id.User == "" {
+		return ClientIdentity{}, fmt.Errorf("invalid client identity %s, expected type:value=user", s)
+	}
+	return id, nil
+}
+
+// Matches reports whether cert has the field of the identity
+func (id ClientIdentity) Matches(cert *x509.Certificate) bool {
+	switch id.Type {
+	case IdentityCN:
+		return cert.Subject.CommonName == id.Value
+	case IdentityDNS:
+		for _, name := range cert.DNSNames {
+			if strings.EqualFold(name, id.Value) {
+				return true
+			}
+		}
+	case IdentityEmail:
+		for _, email := range cert.EmailAddresses {
+			if strings.EqualFold(email, id.Value) {
+				return true
+			}
+		}
+	case IdentityURI:
+		for _, uri := range cert.URIs {
+			if uri.String() == id.Value {
+				return true
+			}
+		}
+	case IdentityIP:
+		for _, ip := range cert.IPAddresses {
+			if ip.String() == id.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ClientCertificates verifies the client certificates of the TLS listener
+// with a CA file and maps them to users, Load replacing its settings for the
+// next handshakes
+type ClientCertificates struct {
+	mu         sync.RWMutex
+	pool       *x509.CertPool
+	clientAuth tls.ClientAuthType
+	identities []ClientIdentity
+}
+
+// Load reads caFile and the identities, the client certificates being
+// required with mode require or when mode is empty and caFile is set, and
+// only verified when given with mode request. The settings are kept when an
+// error is returned.
+func (c *ClientCertificates) Load(caFile, mode string, identities []string) error {
+	ids := make([]ClientIdentity, 0, len(identities))
+	for _, s := range identities {
+		id, err := ParseClientIdentity(s)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	clientAuth := tls.NoClientCert
+	var pool *x509.CertPool
+	if caFile != "" {
+		data, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return err
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("cannot parse CA certificate")
+		}
+		switch mode {
+		case "", ClientAuthRequire:
+			clientAuth = tls.RequireAndVerifyClientCert
+		case ClientAuthRequest:
+			clientAuth = tls.VerifyClientCertIfGiven
+		default:
+			return fmt.Errorf("invalid client certificate authentication %s, expected request or require", mode)
+		}
+	} else if mode != "" {
+		return fmt.Errorf("client certificate authentication %s needs the CA certificate to verify them", mode)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pool = pool
+	c.clientAuth = clientAuth
+	c.identities = ids
+	return nil
+}
+
+// ConfigForClient returns the GetConfigForClient function of base, setting
+// the current CA certificates and authentication mode to a copy of it
+func (c *ClientCertificates) ConfigForClient(base *tls.Config) func(*tls.ClientHelloInfo) (*tls.Config, error) {
+	return func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		config := base.Clone()
+		config.GetConfigForClient = nil
+		config.ClientCAs = c.pool
+		config.ClientAuth = c.clientAuth
+		return config, nil
+	}
+}
+
+// Identify returns the user of the first identity cert matches
+func (c *ClientCertificates) Identify(cert *x509.Certificate) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, id := range c.identities {
+		if id.Matches(cert) {
+			return id.User, true
+		}
+	}
+	return "", false
+}
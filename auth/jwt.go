@@ -0,0 +1,336 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	// hash functions of the signing algorithms
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksTimeout is the timeout of the requests to the JWKS URL
+	jwksTimeout = 10 * time.Second
+	// jwksMinInterval is the minimum time between two reads of the keys to
+	// find an unknown key ID
+	jwksMinInterval = time.Minute
+	// clockSkew is the tolerance on the expiration and not before times
+	clockSkew = time.Minute
+)
+
+// JWT authenticates requests with JSON Web Tokens signed with RSA or ECDSA
+// keys published as a JSON Web Key Set
+type JWT struct {
+	// Issuer and Audience are checked when set
+	Issuer   string
+	Audience string
+	JWKSURL  string
+	// UserClaim is the claim naming the user, defaults to sub
+	UserClaim string
+	// RolesClaim is the claim listing the roles of the user, a list or a
+	// string of roles separated by spaces, defaults to roles
+	RolesClaim string
+	// Refresh is the time after which the keys are read again, defaults to an
+	// hour
+	Refresh time.Duration
+
+	mu      sync.Mutex
+	keys    map[string]crypto.PublicKey
+	fetched time.Time
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Authenticate verifies the signature and the claims of token, returning the
+// user and roles it grants
+func (j *JWT) Authenticate(token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed token header: %w", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature: %w", err)
+	}
+	key, err := j.key(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	claims := make(map[string]interface{})
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+	now := time.Now()
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, errors.New("token without expiration time")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return nil, errors.New("token expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return nil, errors.New("token not valid yet")
+	}
+	if j.Issuer != "" && claims["iss"] != j.Issuer {
+		return nil, fmt.Errorf("token issued by %v, expected %s", claims["iss"], j.Issuer)
+	}
+	if j.Audience != "" && !containsClaim(claims["aud"], j.Audience) {
+		return nil, fmt.Errorf("token not issued for %s", j.Audience)
+	}
+
+	userClaim := j.UserClaim
+	if userClaim == "" {
+		userClaim = "sub"
+	}
+	user, _ := claims[userClaim].(string)
+	if user == "" {
+		return nil, fmt.Errorf("token without %s claim", userClaim)
+	}
+	rolesClaim := j.RolesClaim
+	if rolesClaim == "" {
+		rolesClaim = "roles"
+	}
+	return &Principal{User: user, Roles: claimStrings(claims[rolesClaim]), Expires: time.Unix(int64(exp), 0)}, nil
+}
+
+// key returns the key with the ID kid, the only key when kid is not set,
+// reading the keys again when they are stale or the key is unknown
+func (j *JWT) key(kid string) (crypto.PublicKey, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	refresh := j.Refresh
+	if refresh == 0 {
+		refresh = time.Hour
+	}
+	since := time.Since(j.fetched)
+	_, known := j.keys[kid]
+	if j.keys == nil || since > refresh || (!known && kid != "" && since > jwksMinInterval) {
+		keys, err := fetchKeys(j.JWKSURL)
+		switch {
+		case err == nil:
+			j.keys = keys
+			j.fetched = time.Now()
+		case j.keys == nil:
+			return nil, fmt.Errorf("cannot read the token keys: %w", err)
+		}
+	}
+	if kid == "" {
+		if len(j.keys) != 1 {
+			return nil, errors.New("token without key ID")
+		}
+		for _, k := range j.keys {
+			return k, nil
+		}
+	}
+	k, ok := j.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown token key %s", kid)
+	}
+	return k, nil
+}
+
+// fetchKeys reads the RSA and EC signing keys of a JSON Web Key Set
+func fetchKeys(url string) (map[string]crypto.PublicKey, error) {
+	client := &http.Client{Timeout: jwksTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s answered %s", url, resp.Status)
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("invalid key set: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey)
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", k.Kid, err)
+		}
+		if key != nil {
+			keys[k.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+// publicKey returns the RSA or EC key, nil for other types
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, nil
+}
+
+// verifySignature checks the signature of a token signed with RSA PKCS #1
+// v1.5, RSA-PSS or ECDSA, the only algorithms of keys which can be published
+func verifySignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	if len(alg) != 5 {
+		return fmt.Errorf("unsupported token algorithm %s", alg)
+	}
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported token algorithm %s", alg)
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	var err error
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		switch alg[:2] {
+		case "RS":
+			err = rsa.VerifyPKCS1v15(k, hash, digest, sig)
+		case "PS":
+			err = rsa.VerifyPSS(k, hash, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		default:
+			return fmt.Errorf("token algorithm %s does not match an RSA key", alg)
+		}
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if alg[:2] != "ES" || len(sig) != 2*size {
+			return fmt.Errorf("token algorithm %s does not match an EC key", alg)
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			err = errors.New("verification error")
+		}
+	default:
+		return fmt.Errorf("unsupported token algorithm %s", alg)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid token signature: %w", err)
+	}
+	return nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// containsClaim reports whether a string or list claim contains value
+func containsClaim(claim interface{}, value string) bool {
+	for _, v := range claimStrings(claim) {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// claimStrings returns the strings of a list claim, or of a string claim
+// separated by spaces like the OAuth scopes
+func claimStrings(claim interface{}) []string {
+	switch c := claim.(type) {
+	case string:
+		return strings.Fields(c)
+	case []interface{}:
+		values := make([]string, 0, len(c))
+		for _, v := range c {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package auth
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// ldapTimeout is the default timeout of the exchanges with the LDAP server
+const ldapTimeout = 10 * time.Second
+
+// LDAP authenticates the users of the API with a bind to an LDAP directory,
+// with the password of the Basic Authentication. The user is either bound with
+// the DN of UserDN, or searched by UserAttribute under BaseDN with the
+// service account BindDN. The values of GroupAttribute of the user are its
+// roles.
+type LDAP struct {
+	// URL of the server, ldap://host:port or ldaps://host:port
+	URL string
+	// StartTLS upgrades the ldap:// connections to TLS
+	StartTLS bool
+	// TLSConfig is the configuration of the ldaps:// and StartTLS connections
+	TLSConfig *tls.Config
+	// BindDN and BindPassword are the credentials of the service account
+	// searching the users, anonymous when BindDN is not set
+	BindDN       string
+	BindPassword string
+	// BaseDN is where the users are searched
+	BaseDN string
+	// UserAttribute is the attribute holding the user names, defaults to uid
+	UserAttribute string
+	// UserDN is the DN of the users with %s for the user name, the users
+	// being bound without a search when set
+	UserDN string
+	// GroupAttribute lists the groups of a user, defaults to memberOf
+	GroupAttribute string
+	// Timeout of the exchanges with the server, defaults to 10 seconds
+	Timeout time.Duration
+}
+
+// AuthenticatePassword binds to the directory as user with password
+func (l *LDAP) AuthenticatePassword(user, password string) (*Principal, error) {
+	// a bind without password is an unauthenticated bind, which servers accept
+	if user == "" || password == "" {
+		return nil, errors.New("user name and password are required")
+	}
+	c, err := l.dial()
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to the LDAP server: %w", err)
+	}
+	defer c.Close()
+
+	groupAttribute := l.GroupAttribute
+	if groupAttribute == "" {
+		groupAttribute = "memberOf"
+	}
+	var entry *ldap.Entry
+	if l.UserDN != "" {
+		dn := fmt.Sprintf(l.UserDN, escapeDN(user))
+		if err := bind(c, dn, password); err != nil {
+			return nil, err
+		}
+		entry, err = searchOne(c, dn, ldap.ScopeBaseObject, "(objectClass=*)", groupAttribute)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if l.BindDN != "" {
+			if err := bind(c, l.BindDN, l.BindPassword); err != nil {
+				return nil, fmt.Errorf("cannot bind the LDAP service account: %w", err)
+			}
+		}
+		userAttribute := l.UserAttribute
+		if userAttribute == "" {
+			userAttribute = "uid"
+		}
+		filter := fmt.Sprintf("(%s=%s)", ldap.EscapeFilter(userAttribute), ldap.EscapeFilter(user))
+		entry, err = searchOne(c, l.BaseDN, ldap.ScopeWholeSubtree, filter, groupAttribute)
+		if err != nil {
+			return nil, err
+		}
+		if err := bind(c, entry.DN, password); err != nil {
+			return nil, err
+		}
+	}
+	return &Principal{User: user, Roles: entry.GetEqualFoldAttributeValues(groupAttribute)}, nil
+}
+
+// AuthenticateToken returns ErrUnsupportedCredentials, the directory only
+// authenticates passwords
+func (l *LDAP) AuthenticateToken(token string) (*Principal, error) {
+	return nil, ErrUnsupportedCredentials
+}
+
+func (l *LDAP) dial() (*ldap.Conn, error) {
+	u, err := url.Parse(l.URL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "ldap" && u.Scheme != "ldaps" {
+		return nil, fmt.Errorf("unsupported scheme %s", u.Scheme)
+	}
+	timeout := l.Timeout
+	if timeout == 0 {
+		timeout = ldapTimeout
+	}
+	tlsConfig := &tls.Config{}
+	if l.TLSConfig != nil {
+		tlsConfig = l.TLSConfig.Clone()
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = u.Hostname()
+	}
+	c, err := ldap.DialURL(l.URL, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}), ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, err
+	}
+	c.SetTimeout(timeout)
+	if u.Scheme == "ldap" && l.StartTLS {
+		if err := c.StartTLS(tlsConfig); err != nil {
+			c.Close()
+			return nil, fmt.Errorf("StartTLS refused: %w", err)
+		}
+	}
+	return c, nil
+}
+
+// bind binds the connection as dn, the invalid credentials being reported
+// without the details of the server
+func bind(c *ldap.Conn, dn, password string) error {
+	err := c.Bind(dn, password)
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		return errors.New("invalid credentials")
+	}
+	return err
+}
+
+// searchOne returns the only entry matching filter under base, with the
+// values of attribute
+func searchOne(c *ldap.Conn, base string, scope int, filter, attribute string) (*ldap.Entry, error) {
+	// two entries at most, more are ambiguous
+	request := ldap.NewSearchRequest(base, scope, ldap.NeverDerefAliases, 2, 0, false, filter, []string{attribute}, nil)
+	result, err := c.Search(request)
+	// the size limit is exceeded when more than one user matches
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) || err == nil && len(result.Entries) > 1 {
+		return nil, errors.New("more than one LDAP entry matches the user")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Entries) == 0 {
+		return nil, errors.New("unknown user")
+	}
+	return result.Entries[0], nil
+}
+
+// escapeDN escapes the special characters of an attribute value of a DN
+func escapeDN(value string) string {
+	var b strings.Builder
+	for i, c := range value {
+		switch {
+		case strings.ContainsRune(`,+"\<>;=`, c),
+			i == 0 && (c == ' ' || c == '#'),
+			i == len(value)-1 && c == ' ':
+			b.WriteByte('\\')
+			b.WriteRune(c)
+		case c == 0:
+			b.WriteString(`\00`)
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
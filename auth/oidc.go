@@ -0,0 +1,113 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// introspectionTimeout is the default timeout of the introspection requests
+const introspectionTimeout = 10 * time.Second
+
+// OIDC authenticates the bearer tokens of an OpenID Connect or OAuth 2.0
+// provider with its token introspection endpoint (RFC 7662), which also
+// accepts the opaque tokens a JWKS cannot verify
+type OIDC struct {
+	IntrospectionURL string
+	// ClientID and ClientSecret authenticate the API to the introspection
+	// endpoint with the Basic Authentication
+	ClientID     string
+	ClientSecret string
+	// Audience is checked when set
+	Audience string
+	// UserClaim is the claim naming the user, defaults to sub
+	UserClaim string
+	// RolesClaim is the claim listing the roles of the user, defaults to roles
+	RolesClaim string
+	// Timeout of the introspection requests, defaults to 10 seconds
+	Timeout time.Duration
+}
+
+// AuthenticatePassword returns ErrUnsupportedCredentials, the introspection
+// only authenticates tokens
+func (o *OIDC) AuthenticatePassword(user, password string) (*Principal, error) {
+	return nil, ErrUnsupportedCredentials
+}
+
+// AuthenticateToken introspects token, returning the user and roles of an
+// active token
+func (o *OIDC) AuthenticateToken(token string) (*Principal, error) {
+	form := url.Values{"token": {token}, "token_type_hint": {"access_token"}}
+	req, err := http.NewRequest(http.MethodPost, o.IntrospectionURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if o.ClientID != "" {
+		req.SetBasicAuth(url.QueryEscape(o.ClientID), url.QueryEscape(o.ClientSecret))
+	}
+	timeout := o.Timeout
+	if timeout == 0 {
+		timeout = introspectionTimeout
+	}
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot introspect the token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot introspect the token: %s answered %s", o.IntrospectionURL, resp.Status)
+	}
+	claims := make(map[string]interface{})
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return nil, fmt.Errorf("malformed introspection response: %w", err)
+	}
+
+	if active, _ := claims["active"].(bool); !active {
+		return nil, errors.New("token not active")
+	}
+	var expires time.Time
+	if exp, ok := claims["exp"].(float64); ok {
+		expires = time.Unix(int64(exp), 0)
+		if time.Now().After(expires.Add(clockSkew)) {
+			return nil, errors.New("token expired")
+		}
+	}
+	if o.Audience != "" && !containsClaim(claims["aud"], o.Audience) {
+		return nil, fmt.Errorf("token not issued for %s", o.Audience)
+	}
+	userClaim := o.UserClaim
+	if userClaim == "" {
+		userClaim = "sub"
+	}
+	user, _ := claims[userClaim].(string)
+	if user == "" {
+		return nil, fmt.Errorf("token without %s claim", userClaim)
+	}
+	rolesClaim := o.RolesClaim
+	if rolesClaim == "" {
+		rolesClaim = "roles"
+	}
+	return &Principal{User: user, Roles: claimStrings(claims[rolesClaim]), Expires: expires}, nil
+}
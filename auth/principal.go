@@ -0,0 +1,63 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Principal is the user a request is authenticated as
+type Principal struct {
+	User  string
+	Roles []string
+	// Expires is when the credentials of the principal expire, zero when
+	// they do not or it is not known
+	Expires time.Time
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of r authenticated as p
+func WithPrincipal(r *http.Request, p *Principal) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), principalKey{}, p))
+}
+
+// FromRequest returns the principal r was authenticated as with a token or a
+// client certificate, nil for the requests using the Basic Authentication
+func FromRequest(r *http.Request) *Principal {
+	p, _ := r.Context().Value(principalKey{}).(*Principal)
+	return p
+}
+
+// User returns the user of r, the one of its token or client certificate or the
+// one given with the Basic Authentication
+func User(r *http.Request) string {
+	if p := FromRequest(r); p != nil {
+		return p.User
+	}
+	user, _, _ := r.BasicAuth()
+	return user
+}
+
+// Roles returns the roles of the user of r, only set for tokens
+func Roles(r *http.Request) []string {
+	if p := FromRequest(r); p != nil {
+		return p.Roles
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrUnsupportedCredentials is returned by the providers for the credentials
+// they do not authenticate, such as passwords for a token introspection
+var ErrUnsupportedCredentials = errors.New("credentials not supported by the authentication provider")
+
+// Provider authenticates the users of the API with an external identity
+// service, instead of the users of the userlist
+type Provider interface {
+	// AuthenticatePassword authenticates user with the password of the Basic
+	// Authentication
+	AuthenticatePassword(user, password string) (*Principal, error)
+	// AuthenticateToken authenticates a bearer token
+	AuthenticateToken(token string) (*Principal, error)
+}
+
+// Cached returns p keeping the principals of the credentials it
+// authenticated for ttl, so that a request does not query the identity
+// service each time. Failed authentications are not kept.
+func Cached(p Provider, ttl time.Duration) Provider {
+	if ttl <= 0 {
+		return p
+	}
+	return &cachedProvider{provider: p, ttl: ttl, principals: make(map[string]cachedPrincipal)}
+}
+
+type cachedProvider struct {
+	provider   Provider
+	ttl        time.Duration
+	mu         sync.Mutex
+	principals map[string]cachedPrincipal
+}
+
+type cachedPrincipal struct {
+	principal *Principal
+	expires   time.Time
+}
+
+func (c *cachedProvider) AuthenticatePassword(user, password string) (*Principal, error) {
+	return c.authenticate(credentialsKey("password", user, password), func() (*Principal, error) {
+		return c.provider.AuthenticatePassword(user, password)
+	})
+}
+
+func (c *cachedProvider) AuthenticateToken(token string) (*Principal, error) {
+	return c.authenticate(credentialsKey("token", token), func() (*Principal, error) {
+		return c.provider.AuthenticateToken(token)
+	})
+}
+
+func (c *cachedProvider) authenticate(key string, authenticate func() (*Principal, error)) (*Principal, error) {
+	now := time.Now()
+	c.mu.Lock()
+	cached, ok := c.principals[key]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.principal, nil
+	}
+	p, err := authenticate()
+	if err != nil {
+		return nil, err
+	}
+	expires := now.Add(c.ttl)
+	// credentials expiring earlier, such as tokens, are not kept longer
+	if !p.Expires.IsZero() && p.Expires.Before(expires) {
+		expires = p.Expires
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, v := range c.principals {
+		if now.After(v.expires) {
+			delete(c.principals, k)
+		}
+	}
+	c.principals[key] = cachedPrincipal{principal: p, expires: expires}
+	return p, nil
+}
+
+// credentialsKey returns the key of the cached credentials, which are not kept
+// in memory in clear
+func credentialsKey(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
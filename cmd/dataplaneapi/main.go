@@ -28,6 +28,7 @@ import (
 	"github.com/haproxytech/dataplaneapi"
 	"github.com/haproxytech/dataplaneapi/configuration"
 	"github.com/haproxytech/dataplaneapi/operations"
+	"github.com/haproxytech/dataplaneapi/system"
 )
 
 //GitRepo ...
@@ -57,6 +58,10 @@ var cliOptions struct {
 	Version bool `short:"v" long:"version" description:"Version and build information"`
 }
 
+var checkOptions struct {
+	CheckConfig bool `long:"check-config" description:"Validate the dataplane configuration file and print the effective configuration without starting the server"`
+}
+
 func main() {
 	cfg := configuration.Get()
 	for {
@@ -76,6 +81,9 @@ func startServer(cfg *configuration.Configuration) (reload configuration.AtomicB
 
 	dataplaneapi.BuildTime = BuildTime
 	dataplaneapi.Version = fmt.Sprintf("%s %s%s", GitTag, GitCommit, GitDirty)
+	dataplaneapi.GitCommit = GitCommit + GitDirty
+	dataplaneapi.GitTag = GitTag
+	dataplaneapi.GitRepo = GitRepo
 
 	api := operations.NewDataPlaneAPI(swaggerSpec)
 	server := dataplaneapi.NewServer(api)
@@ -98,7 +106,12 @@ func startServer(cfg *configuration.Configuration) (reload configuration.AtomicB
 	if err != nil {
 		log.Fatalln(err)
 	}
+	_, err = parser.AddGroup("Check configuration", "Validate the configuration and exit", &checkOptions)
+	if err != nil {
+		log.Fatalln(err)
+	}
 
+	configuration.SetLegacyEnv(&cfg.HAProxy, &cfg.Logging, &cfg.APIOptions)
 	if _, err = parser.Parse(); err != nil {
 		if fe, ok := err.(*flags.Error); ok {
 			if fe.Type == flags.ErrHelp {
@@ -116,7 +129,13 @@ func startServer(cfg *configuration.Configuration) (reload configuration.AtomicB
 		return
 	}
 
-	err = cfg.Load(dataplaneapi.SwaggerJSON, server.Host, server.Port)
+	recordOptionSources(cfg, []*flags.Group{parser.Group})
+
+	if checkOptions.CheckConfig {
+		os.Exit(checkConfig(cfg))
+	}
+
+	err = cfg.Load(dataplaneapi.SwaggerJSON, server)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -135,7 +154,7 @@ func startServer(cfg *configuration.Configuration) (reload configuration.AtomicB
 				server.TLSPort = server.Port
 			}
 		} else if cfg.Cluster.ActiveBootstrapKey.Load() != "" {
-			cfg.Notify.BootstrapKeyChanged.NotifyWithRetry()
+			cfg.Notify.BootstrapKeyChanged.Notify()
 		}
 	}
 	err = cfg.Save()
@@ -148,7 +167,9 @@ func startServer(cfg *configuration.Configuration) (reload configuration.AtomicB
 			log.Info("HAProxy Data Plane API reloading")
 			reload.Store(true)
 			cfg.UnSubscribeAll()
-			err := server.Shutdown()
+			// in-flight requests are served before restarting, the listeners
+			// are kept open for the restarted server
+			err := server.Restart()
 			if err != nil {
 				log.Fatalln(err)
 			}
@@ -166,9 +187,79 @@ func startServer(cfg *configuration.Configuration) (reload configuration.AtomicB
 		}
 	}()
 
+	go func() {
+		for range cfg.Notify.Hangup.Subscribe("main") {
+			var err error
+			cfg.ReadServerOptions(func() { err = server.ReloadClientCertificates() })
+			if err != nil {
+				log.Warningf("Keeping the current client certificates settings, cannot reload them: %s", err.Error())
+			}
+		}
+	}()
+
+	// bind the listeners, on privileged ports for instance, before dropping the privileges
+	if err := server.Listen(); err != nil {
+		log.Fatalln(err)
+	}
+	if err := system.DropPrivileges(cfg.HAProxy.Chroot, cfg.HAProxy.RunAsUser, cfg.HAProxy.RunAsGroup); err != nil {
+		log.Fatalln(err)
+	}
+
 	server.ConfigureAPI()
 	if err := server.Serve(); err != nil {
 		log.Fatalln(err)
 	}
 	return reload
 }
+
+// checkConfig validates the dataplane configuration file, printing its problems
+// and defaults on stderr and the effective configuration on stdout, and returns
+// the exit status
+func checkConfig(cfg *configuration.Configuration) int {
+	check, err := configuration.CheckFile(cfg.HAProxy.DataplaneConfig)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	for _, e := range check.Errors {
+		fmt.Fprintf(os.Stderr, "error: %s\n", e)
+	}
+	for _, w := range check.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+	}
+	for _, d := range check.Defaults {
+		fmt.Fprintf(os.Stderr, "default: %s\n", d)
+	}
+	if !check.Valid() {
+		fmt.Fprintf(os.Stderr, "%s: configuration is invalid\n", check.File)
+		return 1
+	}
+	effective, err := check.Effective(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Print(effective)
+	fmt.Fprintf(os.Stderr, "%s: configuration is valid\n", check.File)
+	return 0
+}
+
+// recordOptionSources records the command line options set with a flag or an
+// environment variable
+func recordOptionSources(cfg *configuration.Configuration, groups []*flags.Group) {
+	for _, g := range groups {
+		for _, o := range g.Options() {
+			name := o.LongName
+			if name == "" {
+				name = string(o.ShortName)
+			}
+			switch {
+			case o.IsSet() && !o.IsSetDefault():
+				cfg.SetOptionSource(name, configuration.SourceFlag)
+			case o.EnvDefaultKey != "" && os.Getenv(o.EnvDefaultKey) != "":
+				cfg.SetOptionSource(name, configuration.SourceEnv)
+			}
+		}
+		recordOptionSources(cfg, g.Groups())
+	}
+}
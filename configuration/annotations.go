@@ -0,0 +1,135 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	api_errors "github.com/go-openapi/errors"
+)
+
+// Annotation is the ownership metadata of a configuration section
+type Annotation struct {
+	Type      string `yaml:"type"`
+	Name      string `yaml:"name"`
+	Owner     string `yaml:"owner,omitempty"`
+	Protected bool   `yaml:"protected,omitempty"`
+}
+
+// Annotations marks configuration sections as owned by a team or a controller,
+// protected sections can only be changed by forcing it. When admins are set,
+// only they can protect sections and change protected ones.
+type Annotations struct {
+	mu     sync.Mutex
+	Admins []string     `yaml:"admins,omitempty"`
+	Items  []Annotation `yaml:"items,omitempty"`
+}
+
+// MarshalYAML marshals a copy of the annotations taken under their lock
+func (a *Annotations) MarshalYAML() (interface{}, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return struct {
+		Admins []string     `yaml:"admins,omitempty"`
+		Items  []Annotation `yaml:"items,omitempty"`
+	}{append([]string{}, a.Admins...), append([]Annotation{}, a.Items...)}, nil
+}
+
+// IsZero reports whether there are neither admins nor annotations, the
+// configuration file omitting them
+func (a *Annotations) IsZero() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.Admins) == 0 && len(a.Items) == 0
+}
+
+// Get returns a copy of the annotations
+func (a *Annotations) Get() []Annotation {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]Annotation{}, a.Items...)
+}
+
+// Find returns the annotation of a section
+func (a *Annotations) Find(sectionType, name string) (Annotation, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, an := range a.Items {
+		if an.Type == sectionType && an.Name == name {
+			return an, true
+		}
+	}
+	return Annotation{}, false
+}
+
+// Admin reports whether a user can protect sections and change protected ones
+func (a *Annotations) Admin(user string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.Admins) == 0 {
+		return true
+	}
+	for _, admin := range a.Admins {
+		if admin == user {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckChange returns an error when a user is not allowed to change a section.
+// An empty name stands for the whole configuration, which includes every
+// protected section.
+func (a *Annotations) CheckChange(sectionType, name, user string, force bool) error {
+	var protected *Annotation
+	for _, an := range a.Get() {
+		if an.Protected && (name == "" || an.Type == sectionType && an.Name == name) {
+			an := an
+			protected = &an
+			break
+		}
+	}
+	if protected == nil {
+		return nil
+	}
+	section := fmt.Sprintf("%s %s", protected.Type, protected.Name)
+	if protected.Owner != "" {
+		section = fmt.Sprintf("%s owned by %s", section, protected.Owner)
+	}
+	if !force {
+		return api_errors.New(http.StatusConflict, "%s is protected, set force=true to change it", section)
+	}
+	if !a.Admin(user) {
+		return api_errors.New(http.StatusForbidden, "%s is protected, only admins can change it", section)
+	}
+	return nil
+}
+
+// UpdateAnnotations replaces the annotations with the result of fn, called with a copy of
+// the current ones, and saves the configuration
+func (c *Configuration) UpdateAnnotations(fn func([]Annotation) ([]Annotation, error)) error {
+	c.Annotations.mu.Lock()
+	items, err := fn(append([]Annotation{}, c.Annotations.Items...))
+	if err != nil {
+		c.Annotations.mu.Unlock()
+		return err
+	}
+	c.Annotations.Items = items
+	c.Annotations.mu.Unlock()
+	return c.Save()
+}
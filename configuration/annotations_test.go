@@ -0,0 +1,67 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"net/http"
+	"testing"
+
+	api_errors "github.com/go-openapi/errors"
+)
+
+func TestAnnotationsCheckChange(t *testing.T) {
+	items := []Annotation{
+		{Type: "backend", Name: "payments", Owner: "team-pay", Protected: true},
+		{Type: "backend", Name: "web", Owner: "team-web"},
+	}
+	tests := []struct {
+		name        string
+		admins      []string
+		items       []Annotation
+		sectionType string
+		section     string
+		user        string
+		force       bool
+		code        int
+	}{
+		{"unprotected section", nil, items, "backend", "web", "bob", false, 0},
+		{"unannotated section", nil, items, "frontend", "payments", "bob", false, 0},
+		{"protected section", nil, items, "backend", "payments", "bob", false, http.StatusConflict},
+		{"protected section forced", nil, items, "backend", "payments", "bob", true, 0},
+		{"protected section forced by non admin", []string{"alice"}, items, "backend", "payments", "bob", true, http.StatusForbidden},
+		{"protected section forced by admin", []string{"alice"}, items, "backend", "payments", "alice", true, 0},
+		{"whole configuration", nil, items, "", "", "bob", false, http.StatusConflict},
+		{"whole configuration forced by non admin", []string{"alice"}, items, "", "", "bob", true, http.StatusForbidden},
+		{"whole configuration forced by admin", []string{"alice"}, items, "", "", "alice", true, 0},
+		{"whole configuration without protection", nil, items[1:], "", "", "bob", false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Annotations{Admins: tt.admins, Items: tt.items}
+			err := a.CheckChange(tt.sectionType, tt.section, tt.user, tt.force)
+			if tt.code == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error %v", err)
+				}
+				return
+			}
+			e, ok := err.(api_errors.Error)
+			if !ok || int(e.Code()) != tt.code {
+				t.Fatalf("expected error with code %d, got %v", tt.code, err)
+			}
+		})
+	}
+}
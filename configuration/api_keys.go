@@ -0,0 +1,172 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/haproxytech/dataplaneapi/auth"
+)
+
+// APIKeyPrefix starts the API keys, telling them from the other bearer tokens
+const APIKeyPrefix = "dpk_"
+
+// APIKey authenticates the requests with a bearer token as the user which
+// created it, only the hash of the key being stored
+type APIKey struct {
+	ID    string   `yaml:"id"`
+	Name  string   `yaml:"name,omitempty"`
+	User  string   `yaml:"user"`
+	Roles []string `yaml:"roles,omitempty"`
+	// External is set when the user is not a user of the API but one of the
+	// external authentication, whose existence is not checked
+	External bool `yaml:"external,omitempty"`
+	// Hash is the SHA-256 of the key, hex encoded
+	Hash    string     `yaml:"hash"`
+	Created time.Time  `yaml:"created"`
+	Expires *time.Time `yaml:"expires,omitempty"`
+}
+
+// Expired reports whether the key expired at t
+func (k APIKey) Expired(t time.Time) bool {
+	return k.Expires != nil && !t.Before(*k.Expires)
+}
+
+// APIKeys are the API keys of the users, valid without reloading HAProxy
+type APIKeys struct {
+	mu    sync.Mutex
+	Items []APIKey `yaml:"items,omitempty"`
+}
+
+// MarshalYAML marshals a copy of the API keys taken under their lock
+func (a *APIKeys) MarshalYAML() (interface{}, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return struct {
+		Items []APIKey `yaml:"items,omitempty"`
+	}{append([]APIKey{}, a.Items...)}, nil
+}
+
+// IsZero reports whether there are no API keys, the configuration file
+// omitting them
+func (a *APIKeys) IsZero() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.Items) == 0
+}
+
+// Get returns a copy of the API keys
+func (a *APIKeys) Get() []APIKey {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]APIKey{}, a.Items...)
+}
+
+// Find returns the API key with id
+func (a *APIKeys) Find(id string) (APIKey, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, k := range a.Items {
+		if k.ID == id {
+			return k, true
+		}
+	}
+	return APIKey{}, false
+}
+
+// IsAPIKey reports whether a bearer token is an API key
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// Authenticate returns the principal of the API key token, the user which
+// created it with its roles, the keys of the users removed from the userlist
+// being refused
+func (a *APIKeys) Authenticate(token string) (*auth.Principal, error) {
+	parts := strings.SplitN(strings.TrimPrefix(token, APIKeyPrefix), "_", 2)
+	if !IsAPIKey(token) || len(parts) != 2 {
+		return nil, errors.New("unknown API key")
+	}
+	k, ok := a.Find(parts[0])
+	if !ok {
+		return nil, errors.New("unknown API key")
+	}
+	sum := sha256.Sum256([]byte(token))
+	if subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(k.Hash)) != 1 {
+		return nil, errors.New("unknown API key")
+	}
+	if k.Expired(time.Now()) {
+		return nil, errors.New("API key expired")
+	}
+	// the keys are not revoked with their user, which may be removed from
+	// the userlist without the API
+	if !k.External && !IsUser(k.User) {
+		return nil, errors.New("the user of the API key was removed")
+	}
+	p := &auth.Principal{User: k.User, Roles: k.Roles}
+	if k.Expires != nil {
+		p.Expires = *k.Expires
+	}
+	return p, nil
+}
+
+// NewAPIKey returns a new API key of user with its secret key, as
+// dpk_<id>_<secret>
+func NewAPIKey(name, user string, roles []string, expires *time.Time) (APIKey, string, error) {
+	id := make([]byte, 6)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return APIKey{}, "", err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return APIKey{}, "", err
+	}
+	k := APIKey{
+		ID:       hex.EncodeToString(id),
+		Name:     name,
+		User:     user,
+		Roles:    roles,
+		External: !IsUser(user),
+		Created:  time.Now().UTC(),
+		Expires:  expires,
+	}
+	key := APIKeyPrefix + k.ID + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	sum := sha256.Sum256([]byte(key))
+	k.Hash = hex.EncodeToString(sum[:])
+	return k, key, nil
+}
+
+// UpdateAPIKeys replaces the API keys with the result of fn, called with a
+// copy of the current ones, and saves the configuration
+func (c *Configuration) UpdateAPIKeys(fn func([]APIKey) ([]APIKey, error)) error {
+	c.APIKeys.mu.Lock()
+	items, err := fn(append([]APIKey{}, c.APIKeys.Items...))
+	if err != nil {
+		c.APIKeys.mu.Unlock()
+		return err
+	}
+	c.APIKeys.Items = items
+	c.APIKeys.mu.Unlock()
+	return c.Save()
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAPIKeyFormat(t *testing.T) {
+	k, key, err := NewAPIKey("ci", "ext", []string{"sre"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(`^dpk_[0-9a-f]{12}_[A-Za-z0-9_-]{43}$`).MatchString(key) {
+		t.Fatalf("unexpected key format %s", key)
+	}
+	if !strings.HasPrefix(key, APIKeyPrefix+k.ID+"_") {
+		t.Fatalf("key %s does not start with its id %s", key, k.ID)
+	}
+	if strings.Contains(k.Hash, key) || len(k.Hash) != 64 {
+		t.Fatalf("unexpected hash %s", k.Hash)
+	}
+}
+
+func TestAPIKeysAuthenticate(t *testing.T) {
+	expires := time.Now().Add(time.Hour).UTC()
+	past := time.Now().Add(-time.Hour).UTC()
+	valid, validKey, _ := NewAPIKey("valid", "ext", []string{"sre"}, &expires)
+	expired, expiredKey, _ := NewAPIKey("expired", "ext", nil, &past)
+	keys := &APIKeys{Items: []APIKey{valid, expired}}
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"valid", validKey, true},
+		{"expired", expiredKey, false},
+		{"wrong secret", APIKeyPrefix + valid.ID + "_" + strings.Repeat("A", 43), false},
+		{"unknown id", APIKeyPrefix + "000000000000_" + strings.TrimPrefix(validKey, APIKeyPrefix+valid.ID+"_"), false},
+		{"without secret", APIKeyPrefix + valid.ID, false},
+		{"without prefix", strings.TrimPrefix(validKey, APIKeyPrefix), false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := keys.Authenticate(tt.token)
+			if (err == nil) != tt.valid {
+				t.Fatalf("valid %v, got error %v", tt.valid, err)
+			}
+			if err != nil {
+				return
+			}
+			if p.User != "ext" || len(p.Roles) != 1 || p.Roles[0] != "sre" {
+				t.Fatalf("unexpected principal %+v", p)
+			}
+			if !p.Expires.Equal(expires) {
+				t.Fatalf("expected the principal to expire at %s, got %s", expires, p.Expires)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/haproxytech/dataplaneapi/auth"
+)
+
+// authProviderCacheTTL is the default time in seconds the credentials
+// authenticated by the provider are kept
+const authProviderCacheTTL = 60
+
+// AuthProvider authenticates the users of the API with an external identity
+// service, the users of the userlist being authenticated first
+type AuthProvider struct {
+	// Type is ldap or oidc
+	Type string `yaml:"type"`
+	// CacheTTL is the time in seconds the authenticated credentials are kept,
+	// defaults to 60, a negative time disabling the cache
+	CacheTTL int           `yaml:"cache_ttl,omitempty"`
+	LDAP     *LDAPProvider `yaml:"ldap,omitempty"`
+	OIDC     *OIDCProvider `yaml:"oidc,omitempty"`
+}
+
+// LDAPProvider authenticates the Basic Authentication of the users with a
+// bind to an LDAP directory, their groups being their roles
+type LDAPProvider struct {
+	// URL is ldap://host:port or ldaps://host:port
+	URL                string `yaml:"url"`
+	StartTLS           bool   `yaml:"start_tls,omitempty"`
+	CAFile             string `yaml:"ca_file,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
+	// BindDN and BindPassword are the service account searching the users
+	// under BaseDN, the search being anonymous without BindDN
+	BindDN       string `yaml:"bind_dn,omitempty"`
+	BindPassword string `yaml:"bind_password,omitempty"`
+	BaseDN       string `yaml:"base_dn,omitempty"`
+	// UserAttribute holds the user names, defaults to uid
+	UserAttribute string `yaml:"user_attribute,omitempty"`
+	// UserDN is the DN of the users with %s for the user name, such as
+	// uid=%s,ou=people,dc=example,dc=com, the users being bound without a
+	// search when set
+	UserDN string `yaml:"user_dn,omitempty"`
+	// GroupAttribute lists the groups of the users, defaults to memberOf
+	GroupAttribute string `yaml:"group_attribute,omitempty"`
+	// Timeout in seconds, defaults to 10
+	Timeout int `yaml:"timeout,omitempty"`
+}
+
+// OIDCProvider authenticates the bearer tokens with the token introspection
+// endpoint of an OpenID Connect provider
+type OIDCProvider struct {
+	IntrospectionURL string `yaml:"introspection_url"`
+	ClientID         string `yaml:"client_id,omitempty"`
+	ClientSecret     string `yaml:"client_secret,omitempty"`
+	Audience         string `yaml:"audience,omitempty"`
+	// UserClaim names the user, defaults to sub
+	UserClaim string `yaml:"user_claim,omitempty"`
+	// RolesClaim lists the roles of the user, defaults to roles
+	RolesClaim string `yaml:"roles_claim,omitempty"`
+	// Timeout in seconds, defaults to 10
+	Timeout int `yaml:"timeout,omitempty"`
+}
+
+// Provider returns the provider of the type of a
+func (a *AuthProvider) Provider() (auth.Provider, error) {
+	var p auth.Provider
+	switch a.Type {
+	case "ldap":
+		l := a.LDAP
+		tlsConfig := &tls.Config{InsecureSkipVerify: l.InsecureSkipVerify} // nolint:gosec
+		if l.CAFile != "" {
+			ca, err := ioutil.ReadFile(l.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("auth_provider.ldap.ca_file: %w", err)
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("auth_provider.ldap.ca_file: no certificate in %s", l.CAFile)
+			}
+		}
+		p = &auth.LDAP{
+			URL:            l.URL,
+			StartTLS:       l.StartTLS,
+			TLSConfig:      tlsConfig,
+			BindDN:         l.BindDN,
+			BindPassword:   l.BindPassword,
+			BaseDN:         l.BaseDN,
+			UserAttribute:  l.UserAttribute,
+			UserDN:         l.UserDN,
+			GroupAttribute: l.GroupAttribute,
+			Timeout:        time.Duration(l.Timeout) * time.Second,
+		}
+	case "oidc":
+		o := a.OIDC
+		p = &auth.OIDC{
+			IntrospectionURL: o.IntrospectionURL,
+			ClientID:         o.ClientID,
+			ClientSecret:     o.ClientSecret,
+			Audience:         o.Audience,
+			UserClaim:        o.UserClaim,
+			RolesClaim:       o.RolesClaim,
+			Timeout:          time.Duration(o.Timeout) * time.Second,
+		}
+	default:
+		return nil, fmt.Errorf("auth_provider.type: unknown type %s", a.Type)
+	}
+	ttl := a.CacheTTL
+	if ttl == 0 {
+		ttl = authProviderCacheTTL
+	}
+	return auth.Cached(p, time.Duration(ttl)*time.Second), nil
+}
+
+func (a *AuthProvider) check() []string {
+	errs := make([]string, 0)
+	switch a.Type {
+	case "ldap":
+		if a.LDAP == nil {
+			return append(errs, "auth_provider.ldap: required with type ldap")
+		}
+		l := a.LDAP
+		if u, err := url.Parse(l.URL); err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Host == "" {
+			errs = append(errs, fmt.Sprintf("auth_provider.ldap.url: invalid url %s, expected ldap://host:port or ldaps://host:port", l.URL))
+		} else if u.Scheme == "ldaps" && l.StartTLS {
+			errs = append(errs, "auth_provider.ldap.start_tls: not used with ldaps")
+		}
+		switch {
+		case l.UserDN != "" && strings.Count(l.UserDN, "%s") != 1:
+			errs = append(errs, fmt.Sprintf("auth_provider.ldap.user_dn: %s must contain %%s once, replaced by the user name", l.UserDN))
+		case l.UserDN == "" && l.BaseDN == "":
+			errs = append(errs, "auth_provider.ldap: either user_dn or base_dn is required")
+		}
+		if l.Timeout < 0 {
+			errs = append(errs, "auth_provider.ldap.timeout: negative time")
+		}
+	case "oidc":
+		if a.OIDC == nil {
+			return append(errs, "auth_provider.oidc: required with type oidc")
+		}
+		if u, err := url.Parse(a.OIDC.IntrospectionURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Sprintf("auth_provider.oidc.introspection_url: invalid url %s", a.OIDC.IntrospectionURL))
+		}
+		if a.OIDC.Timeout < 0 {
+			errs = append(errs, "auth_provider.oidc.timeout: negative time")
+		}
+	default:
+		errs = append(errs, fmt.Sprintf("auth_provider.type: invalid type %s, expected ldap or oidc", a.Type))
+	}
+	return errs
+}
+
+// defaults returns the defaults applied to a
+func (a *AuthProvider) defaults() []string {
+	defaults := make([]string, 0)
+	if a.CacheTTL == 0 {
+		defaults = append(defaults, fmt.Sprintf("auth_provider.cache_ttl: %d", authProviderCacheTTL))
+	}
+	if l := a.LDAP; a.Type == "ldap" && l != nil {
+		if l.UserDN == "" && l.UserAttribute == "" {
+			defaults = append(defaults, "auth_provider.ldap.user_attribute: uid")
+		}
+		if l.GroupAttribute == "" {
+			defaults = append(defaults, "auth_provider.ldap.group_attribute: memberOf")
+		}
+		if l.Timeout == 0 {
+			defaults = append(defaults, "auth_provider.ldap.timeout: 10")
+		}
+	}
+	if o := a.OIDC; a.Type == "oidc" && o != nil {
+		if o.UserClaim == "" {
+			defaults = append(defaults, "auth_provider.oidc.user_claim: sub")
+		}
+		if o.RolesClaim == "" {
+			defaults = append(defaults, "auth_provider.oidc.roles_claim: roles")
+		}
+		if o.Timeout == 0 {
+			defaults = append(defaults, "auth_provider.oidc.timeout: 10")
+		}
+	}
+	return defaults
+}
@@ -0,0 +1,151 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// KeyRotationPending is a key the node did not join the cluster with yet
+	KeyRotationPending = "pending"
+	// KeyRotationJoined is a key the node joined the cluster with
+	KeyRotationJoined = "joined"
+	// KeyRotationFailed is a key the node failed to join the cluster with
+	KeyRotationFailed = "failed"
+	// KeyRotationRemoved is the removal of the key, leaving cluster mode
+	KeyRotationRemoved = "removed"
+
+	maxBootstrapKeyRotations = 100
+)
+
+// BootstrapKeyRotation is a change of the bootstrap key, the key itself is
+// not recorded
+type BootstrapKeyRotation struct {
+	Timestamp   int64  `yaml:"timestamp"`
+	Fingerprint string `yaml:"fingerprint,omitempty"`
+	Cluster     string `yaml:"cluster,omitempty"`
+	// Source is api for keys set with the API, startup for keys found in the
+	// configuration file
+	Source  string `yaml:"source"`
+	Status  string `yaml:"status"`
+	Message string `yaml:"message,omitempty"`
+}
+
+type BootstrapKeyHistory struct {
+	mu        sync.Mutex
+	Rotations []BootstrapKeyRotation `yaml:"rotations"`
+}
+
+// MarshalYAML marshals a copy of the history taken under its lock
+func (h *BootstrapKeyHistory) MarshalYAML() (interface{}, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return struct {
+		Rotations []BootstrapKeyRotation `yaml:"rotations"`
+	}{append([]BootstrapKeyRotation{}, h.Rotations...)}, nil
+}
+
+// IsZero reports whether the history is empty, the configuration file
+// omitting it
+func (h *BootstrapKeyHistory) IsZero() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.Rotations) == 0
+}
+
+// RecordBootstrapKeyRotation adds the change of the bootstrap key to key to the
+// history, an empty key being a removal, and saves the configuration
+func (c *Configuration) RecordBootstrapKeyRotation(key, source string) {
+	r := BootstrapKeyRotation{Timestamp: time.Now().Unix(), Source: source, Status: KeyRotationPending}
+	if key == "" {
+		r.Status = KeyRotationRemoved
+	} else {
+		r.Fingerprint = bootstrapKeyFingerprint(key)
+		if data, err := decodeBootstrapKey(key); err == nil {
+			r.Cluster = fmt.Sprintf("%s://%s:%s", data["schema"], data["address"], data["port"])
+		} else {
+			r.Status = KeyRotationFailed
+			r.Message = "invalid bootstrap key"
+		}
+	}
+	c.BootstrapKeyHistory.mu.Lock()
+	c.BootstrapKeyHistory.Rotations = append(c.BootstrapKeyHistory.Rotations, r)
+	if n := len(c.BootstrapKeyHistory.Rotations); n > maxBootstrapKeyRotations {
+		c.BootstrapKeyHistory.Rotations = append([]BootstrapKeyRotation{}, c.BootstrapKeyHistory.Rotations[n-maxBootstrapKeyRotations:]...)
+	}
+	c.BootstrapKeyHistory.mu.Unlock()
+	if err := c.Save(); err != nil {
+		log.Warning(err)
+	}
+}
+
+// SetBootstrapKeyRotationStatus sets the outcome of the last rotation to key
+// and saves the configuration
+func (c *Configuration) SetBootstrapKeyRotationStatus(key, status, message string) {
+	fingerprint := bootstrapKeyFingerprint(key)
+	c.BootstrapKeyHistory.mu.Lock()
+	updated := false
+	for i := len(c.BootstrapKeyHistory.Rotations) - 1; i >= 0; i-- {
+		r := &c.BootstrapKeyHistory.Rotations[i]
+		if r.Fingerprint != fingerprint {
+			continue
+		}
+		updated = r.Status != status || r.Message != message
+		r.Status = status
+		r.Message = message
+		break
+	}
+	c.BootstrapKeyHistory.mu.Unlock()
+	if !updated {
+		return
+	}
+	if err := c.Save(); err != nil {
+		log.Warning(err)
+	}
+}
+
+// GetBootstrapKeyRotations returns a copy of the bootstrap key history
+func (c *Configuration) GetBootstrapKeyRotations() []BootstrapKeyRotation {
+	c.BootstrapKeyHistory.mu.Lock()
+	defer c.BootstrapKeyHistory.mu.Unlock()
+	return append([]BootstrapKeyRotation{}, c.BootstrapKeyHistory.Rotations...)
+}
+
+// lastBootstrapKeyFingerprint returns the fingerprint of the last recorded key,
+// empty when there is none or it was removed
+func (c *Configuration) lastBootstrapKeyFingerprint() string {
+	c.BootstrapKeyHistory.mu.Lock()
+	defer c.BootstrapKeyHistory.mu.Unlock()
+	if n := len(c.BootstrapKeyHistory.Rotations); n > 0 {
+		return c.BootstrapKeyHistory.Rotations[n-1].Fingerprint
+	}
+	return ""
+}
+
+func bootstrapKeyFingerprint(key string) string {
+	if key == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
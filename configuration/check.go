@@ -0,0 +1,353 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+)
+
+// ConfigCheck is the outcome of the validation of a dataplane configuration file
+type ConfigCheck struct {
+	File string
+	// Errors prevent the API from starting
+	Errors []string
+	// Warnings are settings ignored by the API, unknown keys included
+	Warnings []string
+	// Defaults are the settings left unset the API applies defaults to
+	Defaults []string
+
+	loaded *Configuration
+	data   []byte
+}
+
+// Valid reports whether the file has no error
+func (r *ConfigCheck) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// Err returns the errors of the file as one error, nil when it is valid
+func (r *ConfigCheck) Err() error {
+	if r.Valid() {
+		return nil
+	}
+	return fmt.Errorf("invalid dataplane configuration file %s:\n  %s", r.File, strings.Join(r.Errors, "\n  "))
+}
+
+// CheckFile validates the dataplane configuration file, the error is set when
+// the file exists and cannot be read
+func CheckFile(file string) (*ConfigCheck, error) {
+	r := &ConfigCheck{File: file, loaded: New()}
+	if file == "" {
+		r.Defaults = append(r.Defaults, "no dataplane configuration file, settings changed with the API are not saved")
+		r.applyDefaults()
+		return r, nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		r.Defaults = append(r.Defaults, "file does not exist, it is created on startup")
+		r.applyDefaults()
+		return r, nil
+	}
+	data, err = toYAML(fileFormat(file), data)
+	if err != nil {
+		r.Errors = append(r.Errors, err.Error())
+		return r, nil
+	}
+	r.data = data
+	if err := yaml.Unmarshal(data, r.loaded); err != nil {
+		r.Errors = append(r.Errors, yamlErrors(err)...)
+		return r, nil
+	}
+	// the strict decoding only differs by rejecting unknown and duplicate keys
+	if err := yaml.UnmarshalStrict(data, &Configuration{}); err != nil {
+		for _, e := range yamlErrors(err) {
+			r.Warnings = append(r.Warnings, e+", ignoring it")
+		}
+	}
+	r.validate()
+	r.applyDefaults()
+	return r, nil
+}
+
+// Effective returns the configuration the API runs with, the command line
+// options of c with the ones of the file they do not override, followed by the
+// content of the checked file with its defaults
+func (r *ConfigCheck) Effective(c *Configuration) (string, error) {
+	overridden := c.overriddenOptions()
+	isOverridden := func(name string) bool { return overridden[name] }
+	c.optionsMu.RLock()
+	haproxy, logging, api := c.HAProxy, c.Logging, c.APIOptions
+	c.optionsMu.RUnlock()
+	groups := []struct {
+		declared yaml.MapSlice
+		options  interface{}
+	}{
+		{r.loaded.Options.HAProxy, &haproxy},
+		{r.loaded.Options.Logging, &logging},
+		{r.loaded.Options.API, &api},
+	}
+	for _, g := range groups {
+		if _, _, err := applyOptions(g.declared, g.options, isOverridden); err != nil {
+			return "", err
+		}
+	}
+	options := yaml.MapSlice{
+		{Key: "haproxy", Value: commandLineOptions(haproxy)},
+		{Key: "logging", Value: commandLineOptions(logging)},
+		{Key: "api", Value: commandLineOptions(api)},
+	}
+	out, err := yaml.Marshal(options)
+	if err != nil {
+		return "", err
+	}
+	// the options of the file are part of the ones above
+	declared := r.loaded.Options
+	r.loaded.Options = CommandLineOptions{Server: declared.Server}
+	file, err := yaml.Marshal(r.loaded)
+	r.loaded.Options = declared
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("# command line options\n%s# %s\n%s", out, r.File, file), nil
+}
+
+func (r *ConfigCheck) validate() {
+	c := r.loaded
+	switch c.Mode.Load() {
+	case "", "single", "cluster":
+	default:
+		r.Errors = append(r.Errors, fmt.Sprintf("mode: unknown mode %s, expected single or cluster", c.Mode.Load()))
+	}
+	if key := c.BootstrapKey.Load(); key != "" {
+		if _, err := decodeBootstrapKey(key); err != nil {
+			r.Errors = append(r.Errors, "bootstrap_key: invalid bootstrap key")
+		}
+	}
+	for group := range c.Features {
+		if _, ok := featureGroups[group]; !ok {
+			r.Warnings = append(r.Warnings, fmt.Sprintf("features: unknown feature %s, ignoring it", group))
+		}
+	}
+	for i, h := range c.Hooks {
+		switch {
+		case h.Command == "":
+			r.Warnings = append(r.Warnings, fmt.Sprintf("hooks[%d]: hook %s has no command, ignoring it", i, h.Name))
+		case h.Type != "validator" && h.Type != "mutator" && h.Type != "notifier":
+			r.Warnings = append(r.Warnings, fmt.Sprintf("hooks[%d]: hook %s has unknown type %s, ignoring it", i, h.Name, h.Type))
+		}
+	}
+	for i, p := range c.Probes {
+		switch {
+		case p.Address == "" && p.Frontend == "":
+			r.Warnings = append(r.Warnings, fmt.Sprintf("probes[%d]: probe %s has no address nor frontend, ignoring it", i, p.Name))
+		case p.Type != "" && p.Type != "http" && p.Type != "tcp":
+			r.Warnings = append(r.Warnings, fmt.Sprintf("probes[%d]: probe %s has unknown type %s, ignoring it", i, p.Name, p.Type))
+		}
+	}
+	groups := []struct {
+		name     string
+		declared yaml.MapSlice
+		options  interface{}
+	}{
+		{"haproxy", c.Options.HAProxy, &HAProxyConfiguration{}},
+		{"logging", c.Options.Logging, &LoggingOptions{}},
+		{"api", c.Options.API, &APIConfiguration{}},
+	}
+	for _, g := range groups {
+		_, ignored, err := applyOptions(g.declared, g.options, nil)
+		if err != nil {
+			r.Errors = append(r.Errors, fmt.Sprintf("%s.%s", g.name, err.Error()))
+		}
+		for _, name := range ignored {
+			r.Warnings = append(r.Warnings, fmt.Sprintf("%s.%s: unknown option, ignoring it", g.name, name))
+		}
+	}
+	users := make(map[string]bool)
+	for i, u := range c.Users {
+		if u.Name == "" || u.Password == "" {
+			r.Errors = append(r.Errors, fmt.Sprintf("users[%d]: user without name or password", i))
+			continue
+		}
+		if users[u.Name] {
+			r.Errors = append(r.Errors, fmt.Sprintf("users[%d]: user %s set more than once", i, u.Name))
+		}
+		users[u.Name] = true
+	}
+	if c.Storage != nil {
+		quotas := []*StorageQuota{c.Storage.Maps, c.Storage.General}
+		for i, area := range []string{"maps", "general"} {
+			if q := quotas[i]; q != nil && (q.MaxFiles < 0 || q.MaxFileSize < 0 || q.MaxSize < 0) {
+				r.Errors = append(r.Errors, fmt.Sprintf("storage.%s: negative limit", area))
+			}
+		}
+	}
+	if c.StatsD != nil && c.StatsD.Address == "" {
+		r.Errors = append(r.Errors, "statsd: no address")
+	}
+	if c.Metrics != nil && c.Metrics.Path != "" && !strings.HasPrefix(c.Metrics.Path, "/") {
+		r.Errors = append(r.Errors, fmt.Sprintf("metrics.path: %s is not an absolute path", c.Metrics.Path))
+	}
+	if c.ChangePlanner != nil {
+		for i, w := range c.ChangePlanner.ReloadWindows {
+			if _, err := haproxy.ParseReloadWindow(w); err != nil {
+				r.Errors = append(r.Errors, fmt.Sprintf("change_planner.reload_windows[%d]: %s", i, err.Error()))
+			}
+		}
+	}
+	if c.APIAuth != nil {
+		if u, err := url.Parse(c.APIAuth.JWKSURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			r.Errors = append(r.Errors, fmt.Sprintf("api_auth.jwks_url: invalid url %s", c.APIAuth.JWKSURL))
+		}
+		if c.APIAuth.JWKSRefresh < 0 {
+			r.Errors = append(r.Errors, "api_auth.jwks_refresh: negative time")
+		}
+	}
+	if c.AuthProvider != nil {
+		r.Errors = append(r.Errors, c.AuthProvider.check()...)
+	}
+	if c.RBAC != nil {
+		r.Errors = append(r.Errors, c.RBAC.check()...)
+	}
+	if c.Fleet != nil {
+		names := make(map[string]bool)
+		for i, n := range c.Fleet.Nodes {
+			if n.Name == "" || n.URL == "" {
+				r.Errors = append(r.Errors, fmt.Sprintf("fleet.nodes[%d]: node without name or url", i))
+				continue
+			}
+			if names[n.Name] {
+				r.Errors = append(r.Errors, fmt.Sprintf("fleet.nodes[%d]: node %s set more than once", i, n.Name))
+			}
+			names[n.Name] = true
+			if u, err := url.Parse(n.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				r.Errors = append(r.Errors, fmt.Sprintf("fleet.nodes[%d]: invalid url %s", i, n.URL))
+			}
+		}
+		if len(c.Fleet.Nodes) == 0 {
+			r.Errors = append(r.Errors, "fleet: no node")
+		}
+	}
+}
+
+// applyDefaults sets the defaults applied on loading, and reports the ones
+// applied when the configuration is used
+func (r *ConfigCheck) applyDefaults() {
+	c := r.loaded
+	if c.Mode.Load() == "" {
+		c.Mode.Store("single")
+		r.Defaults = append(r.Defaults, "mode: single")
+	}
+	if c.Name.Load() == "" {
+		r.Defaults = append(r.Defaults, "name: a random name is generated on startup")
+	}
+	for i, h := range c.Hooks {
+		if h.Timeout == 0 {
+			r.Defaults = append(r.Defaults, fmt.Sprintf("hooks[%d].timeout: 10", i))
+		}
+	}
+	for i, p := range c.Probes {
+		if p.Type == "" {
+			r.Defaults = append(r.Defaults, fmt.Sprintf("probes[%d].type: http", i))
+		}
+		if p.Timeout == 0 {
+			r.Defaults = append(r.Defaults, fmt.Sprintf("probes[%d].timeout: 5", i))
+		}
+	}
+	if c.StatsD != nil && c.StatsD.Prefix == "" {
+		r.Defaults = append(r.Defaults, "statsd.prefix: dataplaneapi.")
+	}
+	if c.Metrics != nil && c.Metrics.Enabled && c.Metrics.Path == "" {
+		r.Defaults = append(r.Defaults, "metrics.path: /metrics")
+	}
+	if c.APIAuth != nil {
+		if c.APIAuth.UserClaim == "" {
+			r.Defaults = append(r.Defaults, "api_auth.user_claim: sub")
+		}
+		if c.APIAuth.RolesClaim == "" {
+			r.Defaults = append(r.Defaults, "api_auth.roles_claim: roles")
+		}
+		if c.APIAuth.JWKSRefresh == 0 {
+			r.Defaults = append(r.Defaults, "api_auth.jwks_refresh: 3600")
+		}
+	}
+	if c.AuthProvider != nil {
+		r.Defaults = append(r.Defaults, c.AuthProvider.defaults()...)
+	}
+	if c.RBAC != nil && c.RBAC.DefaultRole == "" {
+		r.Defaults = append(r.Defaults, "rbac.default_role: read-only")
+	}
+	if c.SNMP != nil {
+		if c.SNMP.AgentXAddress == "" {
+			r.Defaults = append(r.Defaults, "snmp.agentx_address: /var/agentx/master")
+		}
+		if c.SNMP.BaseOID == "" {
+			r.Defaults = append(r.Defaults, "snmp.base_oid: 1.3.6.1.4.1.23263.4.3")
+		}
+	}
+	if c.Git != nil {
+		if c.Git.Path == "" {
+			r.Defaults = append(r.Defaults, "git.path: the directory of the HAProxy configuration file")
+		}
+		if c.Git.Branch == "" {
+			r.Defaults = append(r.Defaults, "git.branch: master")
+		}
+	}
+	if c.Fleet != nil && c.Fleet.Timeout == 0 {
+		r.Defaults = append(r.Defaults, "fleet.timeout: 60")
+	}
+}
+
+// yamlErrors splits a decoding error in one message per problem
+func yamlErrors(err error) []string {
+	if e, ok := err.(*yaml.TypeError); ok {
+		return e.Errors
+	}
+	return []string{strings.TrimPrefix(err.Error(), "yaml: ")}
+}
+
+// commandLineOptions returns the options of a group by their long name
+func commandLineOptions(group interface{}) yaml.MapSlice {
+	v := reflect.ValueOf(group)
+	t := v.Type()
+	options := make(yaml.MapSlice, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("long")
+		if name == "" {
+			name = t.Field(i).Tag.Get("short")
+		}
+		if name == "" {
+			continue
+		}
+		options = append(options, yaml.MapItem{Key: name, Value: v.Field(i).Interface()})
+	}
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].Key.(string) < options[j].Key.(string)
+	})
+	return options
+}
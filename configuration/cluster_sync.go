@@ -33,10 +33,10 @@ import (
 	"strings"
 	"time"
 
-	"github.com/google/renameio"
 	client_native "github.com/haproxytech/client-native/v2"
 	"github.com/haproxytech/config-parser/v2/types"
 	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/system"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -103,7 +103,7 @@ func (c *ClusterSync) monitorCertificateRefresh() {
 			log.Warning(err)
 			continue
 		}
-		err = renameio.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s-csr.crt", c.cfg.Name.Load())), []byte(csr), 0644)
+		err = system.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s-csr.crt", c.cfg.Name.Load())), []byte(csr), 0644)
 		if err != nil {
 			log.Warning(err)
 			continue
@@ -158,12 +158,12 @@ func (c *ClusterSync) issueRefreshRequest(url, port, basePath string, nodesPath
 		return err
 	}
 	log.Infof("Cluster re joined, status: %s", responseData.Status)
-	err = renameio.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s.crt", c.cfg.Name.Load())), []byte(csr), 0644)
+	err = system.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s.crt", c.cfg.Name.Load())), []byte(csr), 0644)
 	if err != nil {
 		log.Warning(err)
 		return err
 	}
-	err = renameio.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s.key", c.cfg.Name.Load())), []byte(key), 0644)
+	err = system.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s.key", c.cfg.Name.Load())), []byte(key), 0600)
 	if err != nil {
 		log.Warning(err)
 		return err
@@ -187,7 +187,7 @@ func (c *ClusterSync) monitorBootstrapKey() {
 			c.cfg.Cluster.ActiveBootstrapKey.Store("")
 			err := c.cfg.Save()
 			if err != nil {
-				log.Panic(err)
+				log.Warning(err)
 			}
 			continue
 		}
@@ -201,6 +201,8 @@ func (c *ClusterSync) monitorBootstrapKey() {
 		data, err := decodeBootstrapKey(key)
 		if err != nil {
 			log.Warning(err)
+			c.cfg.SetBootstrapKeyRotationStatus(key, KeyRotationFailed, "invalid bootstrap key")
+			continue
 		}
 		url := fmt.Sprintf("%s://%s", data["schema"], data["address"])
 		c.cfg.Cluster.URL.Store(url)
@@ -212,32 +214,41 @@ func (c *ClusterSync) monitorBootstrapKey() {
 		c.cfg.Mode.Store("cluster")
 		err = c.cfg.Save()
 		if err != nil {
-			log.Panic(err)
+			log.Warning(err)
+			c.cfg.SetBootstrapKeyRotationStatus(key, KeyRotationFailed, err.Error())
+			continue
 		}
-		csr, key, err := generateCSR()
+		csr, privateKey, err := generateCSR()
 		if err != nil {
 			log.Warning(err)
+			c.cfg.SetBootstrapKeyRotationStatus(key, KeyRotationFailed, err.Error())
 			continue
 		}
-		err = renameio.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s.key", c.cfg.Name.Load())), []byte(key), 0644)
+		err = system.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s.key", c.cfg.Name.Load())), []byte(privateKey), 0600)
 		if err != nil {
 			log.Warning(err)
+			c.cfg.SetBootstrapKeyRotationStatus(key, KeyRotationFailed, err.Error())
 			continue
 		}
-		err = renameio.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s-csr.crt", c.cfg.Name.Load())), []byte(csr), 0644)
+		err = system.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s-csr.crt", c.cfg.Name.Load())), []byte(csr), 0644)
 		if err != nil {
 			log.Warning(err)
+			c.cfg.SetBootstrapKeyRotationStatus(key, KeyRotationFailed, err.Error())
 			continue
 		}
 		err = c.cfg.Save()
 		if err != nil {
-			log.Panic(err)
+			log.Warning(err)
+			c.cfg.SetBootstrapKeyRotationStatus(key, KeyRotationFailed, err.Error())
+			continue
 		}
-		err = c.issueJoinRequest(url, data["port"], data["api-base-path"], data["path"], csr, key)
+		err = c.issueJoinRequest(url, data["port"], data["api-base-path"], data["path"], csr, privateKey)
 		if err != nil {
 			log.Warning(err)
+			c.cfg.SetBootstrapKeyRotationStatus(key, KeyRotationFailed, err.Error())
 			continue
 		}
+		c.cfg.SetBootstrapKeyRotationStatus(key, KeyRotationJoined, "")
 		c.certFetch <- struct{}{}
 	}
 }
@@ -246,9 +257,13 @@ func (c *ClusterSync) issueJoinRequest(url, port, basePath string, nodesPath str
 	url = fmt.Sprintf("%s:%s%s/%s", url, port, basePath, nodesPath)
 	serverCfg := c.cfg.Server
 	apiCfg := c.cfg.APIOptions
-	users := GetUsersStore().GetUsers()
+	store, err := GetUsersStore()
+	if err != nil {
+		return err
+	}
+	users := store.GetUsers()
 	if len(users) == 0 {
-		return fmt.Errorf("no users configured in %v userlist in conf", c.cfg.HAProxy.Userlist)
+		return fmt.Errorf("no users configured in %v userlist in conf", c.cfg.HAProxyOptions().Userlist)
 	}
 	var user *types.User
 	for index, u := range users {
@@ -331,7 +346,7 @@ func (c *ClusterSync) issueJoinRequest(url, port, basePath string, nodesPath str
 	}
 	if c.cfg.HAProxy.NodeIDFile != "" {
 		//write id to file
-		errFID := ioutil.WriteFile(c.cfg.HAProxy.NodeIDFile, []byte(responseData.ID), 0644)
+		errFID := system.WriteFile(c.cfg.HAProxy.NodeIDFile, []byte(responseData.ID), 0644)
 		if errFID != nil {
 			return errFID
 		}
@@ -375,7 +390,12 @@ func (c *ClusterSync) issueJoinRequest(url, port, basePath string, nodesPath str
 			_ = c.cli.Configuration.DeleteTransaction(t.ID)
 			return fmt.Errorf("peer [%s] not found in HAProxy config", dataplaneID)
 		}
+		if err = system.ConfigWindow.Open(); err != nil {
+			_ = c.cli.Configuration.DeleteTransaction(t.ID)
+			return err
+		}
 		_, err = c.cli.Configuration.CommitTransaction(t.ID)
+		system.ConfigWindow.Close()
 		if err != nil {
 			return err
 		}
@@ -410,7 +430,7 @@ func (c *ClusterSync) checkCertificate(node Node) (fetched bool, err error) {
 		c.cfg.Status.Store("unconfigured")
 		return false, nil
 	}
-	err = renameio.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s.crt", c.cfg.Name.Load())), []byte(node.Certificate), 0644)
+	err = system.WriteFile(path.Join(c.cfg.GetClusterCertDir(), fmt.Sprintf("dataplane-%s.crt", c.cfg.Name.Load())), []byte(node.Certificate), 0644)
 	if err != nil {
 		c.cfg.Status.Store("unconfigured")
 		return false, err
@@ -18,7 +18,6 @@ package configuration
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -27,7 +26,7 @@ import (
 	"math/rand"
 	"time"
 
-	"github.com/google/renameio"
+	"github.com/haproxytech/dataplaneapi/system"
 	"github.com/haproxytech/models/v2"
 	log "github.com/sirupsen/logrus"
 
@@ -35,40 +34,68 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-var cfg *Configuration
+var (
+	cfg     *Configuration
+	cfgOnce sync.Once
+)
 
 type HAProxyConfiguration struct {
-	ConfigFile           string `short:"c" long:"config-file" description:"Path to the haproxy configuration file" default:"/etc/haproxy/haproxy.cfg"`
-	Userlist             string `short:"u" long:"userlist" description:"Userlist in HAProxy configuration to use for API Basic Authentication" default:"controller"`
-	HAProxy              string `short:"b" long:"haproxy-bin" description:"Path to the haproxy binary file" default:"haproxy"`
-	ReloadDelay          int    `short:"d" long:"reload-delay" description:"Minimum delay between two reloads (in s)" default:"5"`
-	ReloadCmd            string `short:"r" long:"reload-cmd" description:"Reload command"`
-	RestartCmd           string `short:"s" long:"restart-cmd" description:"Restart command"`
-	ReloadRetention      int    `long:"reload-retention" description:"Reload retention in days, every older reload id will be deleted" default:"1"`
-	TransactionDir       string `short:"t" long:"transaction-dir" description:"Path to the transaction directory" default:"/tmp/haproxy"`
-	BackupsNumber        int    `short:"n" long:"backups-number" description:"Number of backup configuration files you want to keep, stored in the config dir with version number suffix" default:"0"`
-	MasterRuntime        string `short:"m" long:"master-runtime" description:"Path to the master Runtime API socket"`
-	ShowSystemInfo       bool   `short:"i" long:"show-system-info" description:"Show system info on info endpoint"`
-	DataplaneConfig      string `short:"f" description:"Path to the dataplane configuration file" default:"" yaml:"-"`
-	UserListFile         string `long:"userlist-file" description:"Path to the dataplaneapi userlist file. By default userlist is read from HAProxy conf. When specified userlist would be read from this file"`
-	NodeIDFile           string `long:"fid" description:"Path to file that will dataplaneapi use to write its id (not a pid) that was given to him after joining a cluster"`
-	MapsDir              string `short:"p" long:"maps-dir" description:"Path to maps directory. If set, it reads from specified dir, otherwise it reads from config file"`
-	UpdateMapFiles       bool   `long:"update-map-files" description:"Flag used for syncing map files with runtime maps values"`
-	UpdateMapFilesPeriod int64  `long:"update-map-files-period" description:"Elapsed time in seconds between two maps syncing operations" default:"10"`
-	ClusterTLSCertDir    string `long:"cluster-tls-dir" description:"Path where cluster tls certificates will be stored. Defaults to same directory as dataplane configuration file"`
-	MasterWorkerMode     bool   `long:"master-worker-mode" description:"Flag to enable helpers when running within HAProxy"`
+	ConfigFile              string `short:"c" long:"config-file" description:"Path to the haproxy configuration file" default:"/etc/haproxy/haproxy.cfg" env:"DATAPLANEAPI_CONFIG_FILE"`
+	Userlist                string `short:"u" long:"userlist" description:"Userlist in HAProxy configuration to use for API Basic Authentication" default:"controller" env:"DATAPLANEAPI_USERLIST"`
+	HAProxy                 string `short:"b" long:"haproxy-bin" description:"Path to the haproxy binary file" default:"haproxy" env:"DATAPLANEAPI_HAPROXY_BIN"`
+	ReloadDelay             int    `short:"d" long:"reload-delay" description:"Minimum delay between two reloads (in s)" default:"5" env:"DATAPLANEAPI_RELOAD_DELAY"`
+	ReloadCmd               string `short:"r" long:"reload-cmd" description:"Reload command" env:"DATAPLANEAPI_RELOAD_CMD"`
+	RestartCmd              string `short:"s" long:"restart-cmd" description:"Restart command" env:"DATAPLANEAPI_RESTART_CMD"`
+	ReloadRetention         int    `long:"reload-retention" description:"Reload retention in days, every older reload id will be deleted" default:"1" env:"DATAPLANEAPI_RELOAD_RETENTION"`
+	ReloadRetries           int    `long:"reload-retries" description:"Number of automatic retries of a failed reload" default:"0" env:"DATAPLANEAPI_RELOAD_RETRIES"`
+	ReloadRetryBackoff      int    `long:"reload-retry-backoff" description:"Delay before the first retry of a failed reload (in s), doubled on every next retry" default:"1" env:"DATAPLANEAPI_RELOAD_RETRY_BACKOFF"`
+	ReloadRollback          bool   `long:"reload-rollback" description:"Roll back to the last known good configuration when a reload fails or HAProxy stops running after it" env:"DATAPLANEAPI_RELOAD_ROLLBACK"`
+	ReloadRollbackWebhook   string `long:"reload-rollback-webhook" description:"URL notified with a POST request containing the failed reload when the configuration is rolled back" env:"DATAPLANEAPI_RELOAD_ROLLBACK_WEBHOOK"`
+	TransactionDir          string `short:"t" long:"transaction-dir" description:"Path to the transaction directory" default:"/tmp/haproxy" env:"DATAPLANEAPI_TRANSACTION_DIR"`
+	TransactionTTL          int    `long:"transaction-ttl" description:"Time (in s) after which the transactions in progress without changes expire and are deleted, 0 to keep them" default:"0" env:"DATAPLANEAPI_TRANSACTION_TTL"`
+	MaxOpenTransactions     int    `long:"max-open-transactions" description:"Maximum number of transactions in progress, starting another one fails with a conflict, 0 for no limit" default:"0" env:"DATAPLANEAPI_MAX_OPEN_TRANSACTIONS"`
+	BackupsNumber           int    `short:"n" long:"backups-number" description:"Number of backup configuration files you want to keep, stored in the config dir with version number suffix" default:"0" env:"DATAPLANEAPI_BACKUPS_NUMBER"`
+	MasterRuntime           string `short:"m" long:"master-runtime" description:"Path to the master Runtime API socket" env:"DATAPLANEAPI_MASTER_RUNTIME"`
+	OldWorkersTimeout       int    `long:"old-workers-timeout" description:"Time (in s) after which workers of previous reloads still draining connections are stopped, like hard-stop-after does, 0 to disable" default:"0" env:"DATAPLANEAPI_OLD_WORKERS_TIMEOUT"`
+	ShowSystemInfo          bool   `short:"i" long:"show-system-info" description:"Show system info on info endpoint" env:"DATAPLANEAPI_SHOW_SYSTEM_INFO"`
+	DataplaneConfig         string `short:"f" description:"Path to the dataplane configuration file" default:"" yaml:"-" env:"DATAPLANEAPI_DATAPLANE_CONFIG"`
+	UserListFile            string `long:"userlist-file" description:"Path to the dataplaneapi userlist file. By default userlist is read from HAProxy conf. When specified userlist would be read from this file, users of the dataplane configuration file take precedence over both" env:"DATAPLANEAPI_USERLIST_FILE"`
+	NodeIDFile              string `long:"fid" description:"Path to file that will dataplaneapi use to write its id (not a pid) that was given to him after joining a cluster" env:"DATAPLANEAPI_FID"`
+	MapsDir                 string `short:"p" long:"maps-dir" description:"Path to maps directory. If set, it reads from specified dir, otherwise it reads from config file" env:"DATAPLANEAPI_MAPS_DIR"`
+	GeneralStorageDir       string `long:"general-storage-dir" description:"Path to the directory storing the general files referenced by the configuration. Defaults to the general directory next to the haproxy configuration file" env:"DATAPLANEAPI_GENERAL_STORAGE_DIR"`
+	SNIConflictPolicy       string `long:"sni-conflict-policy" description:"Policy for certificates stored in the general storage claiming a hostname of another stored certificate, warn stores them with a warning, reject rejects them" default:"warn" choice:"warn" choice:"reject" env:"DATAPLANEAPI_SNI_CONFLICT_POLICY"`
+	UpdateMapFiles          bool   `long:"update-map-files" description:"Flag used for syncing map files with runtime maps values" env:"DATAPLANEAPI_UPDATE_MAP_FILES"`
+	UpdateMapFilesPeriod    int64  `long:"update-map-files-period" description:"Elapsed time in seconds between two maps syncing operations" default:"10" env:"DATAPLANEAPI_UPDATE_MAP_FILES_PERIOD"`
+	GeoIPMapURL             string `long:"geoip-map-url" description:"URL of the GeoIP map (network to country code), downloaded periodically when set" env:"DATAPLANEAPI_GEOIP_MAP_URL"`
+	GeoIPMapFile            string `long:"geoip-map-file" description:"Path of the GeoIP map file. Defaults to geoip.map in the maps directory" env:"DATAPLANEAPI_GEOIP_MAP_FILE"`
+	GeoIPRefreshInterval    int    `long:"geoip-refresh-interval" description:"Elapsed time in seconds between two GeoIP map downloads" default:"86400" env:"DATAPLANEAPI_GEOIP_REFRESH_INTERVAL"`
+	DataplaneStatsFile      string `long:"dataplane-stats-file" description:"Path to the file storing daily counters of transactions and reloads, kept in memory only when not set" env:"DATAPLANEAPI_DATAPLANE_STATS_FILE"`
+	DataplaneStatsRetention int    `long:"dataplane-stats-retention" description:"Number of days of counters of transactions and reloads to keep" default:"90" env:"DATAPLANEAPI_DATAPLANE_STATS_RETENTION"`
+	ClusterTLSCertDir       string `long:"cluster-tls-dir" description:"Path where cluster tls certificates will be stored. Defaults to same directory as dataplane configuration file" env:"DATAPLANEAPI_CLUSTER_TLS_DIR"`
+	MasterWorkerMode        bool   `long:"master-worker-mode" description:"Flag to enable helpers when running within HAProxy" env:"DATAPLANEAPI_MASTER_WORKER_MODE"`
+	PreflightStrict         bool   `long:"preflight-strict" description:"Refuse to start when a hard preflight check fails, such as a missing haproxy binary or an unwritable transaction directory" env:"DATAPLANEAPI_PREFLIGHT_STRICT"`
+	RunAsUser               string `long:"run-as-user" description:"User the API runs as once its listeners are bound, the API being started as root" env:"DATAPLANEAPI_RUN_AS_USER"`
+	RunAsGroup              string `long:"run-as-group" description:"Group the API runs as once its listeners are bound. Defaults to the primary group of the run-as-user" env:"DATAPLANEAPI_RUN_AS_GROUP"`
+	Chroot                  string `long:"chroot" description:"Directory the API is chrooted to once its listeners are bound, the paths of the other options being inside it" env:"DATAPLANEAPI_CHROOT"`
+	ConfigUnlockCmd         string `long:"config-unlock-cmd" description:"Command making the directory of the haproxy configuration file writable before the API changes it, such as remounting it read-write. Run as the run-as-user, it must work unprivileged" env:"DATAPLANEAPI_CONFIG_UNLOCK_CMD"`
+	ConfigLockCmd           string `long:"config-lock-cmd" description:"Command making the directory of the haproxy configuration file read-only again once the API changed it. Run as the run-as-user, it must work unprivileged" env:"DATAPLANEAPI_CONFIG_LOCK_CMD"`
+	ConfinedFiles           bool   `long:"confined-files" description:"Create the temporary files next to the files they replace, never in the system temporary directory, for SELinux or AppArmor confinement" env:"DATAPLANEAPI_CONFINED_FILES"`
+	FileMode                string `long:"file-mode" description:"Mode of the files the API creates, in octal, the files holding credentials staying readable by their owner only" env:"DATAPLANEAPI_FILE_MODE"`
+	DirMode                 string `long:"dir-mode" description:"Mode of the directories the API creates, in octal" env:"DATAPLANEAPI_DIR_MODE"`
+	FileLabel               string `long:"file-label" description:"SELinux context of the files and directories the API creates, such as system_u:object_r:haproxy_var_lib_t:s0" env:"DATAPLANEAPI_FILE_LABEL"`
 }
 
 type APIConfiguration struct {
-	APIAddress string `long:"api-address" description:"Advertised API address"`
-	APIPort    int64  `long:"api-port" description:"Advertised API port"`
+	APIAddress string `long:"api-address" description:"Advertised API address" env:"DATAPLANEAPI_API_ADDRESS"`
+	APIPort    int64  `long:"api-port" description:"Advertised API port" env:"DATAPLANEAPI_API_PORT"`
 }
 
 type LoggingOptions struct {
-	LogTo     string `long:"log-to" description:"Log target, can be stdout or file" default:"stdout" choice:"stdout" choice:"file"`
-	LogFile   string `long:"log-file" description:"Location of the log file" default:"/var/log/dataplaneapi/dataplaneapi.log"`
-	LogLevel  string `long:"log-level" description:"Logging level" default:"warning" choice:"trace" choice:"debug" choice:"info" choice:"warning" choice:"error"`
-	LogFormat string `long:"log-format" description:"Logging format" default:"text" choice:"text" choice:"JSON"`
+	LogTo     string `long:"log-to" description:"Log target, can be stdout or file" default:"stdout" choice:"stdout" choice:"file" env:"DATAPLANEAPI_LOG_TO"`
+	LogFile   string `long:"log-file" description:"Location of the log file" default:"/var/log/dataplaneapi/dataplaneapi.log" env:"DATAPLANEAPI_LOG_FILE"`
+	LogLevel  string `long:"log-level" description:"Logging level" default:"warning" choice:"trace" choice:"debug" choice:"info" choice:"warning" choice:"error" env:"DATAPLANEAPI_LOG_LEVEL"`
+	LogFormat string `long:"log-format" description:"Logging format" default:"text" choice:"text" choice:"JSON" env:"DATAPLANEAPI_LOG_FORMAT"`
+	AuditLog  string `long:"audit-log" description:"Path to the audit log file recording every mutating API call in JSON, stdout to write it to the standard output, disabled when not set" env:"DATAPLANEAPI_AUDIT_LOG"`
 }
 
 type ClusterConfiguration struct {
@@ -104,6 +131,9 @@ type ServerConfiguration struct {
 	Host        string `yaml:"host"`
 	Port        int    `yaml:"port"`
 	APIBasePath string `yaml:"api_base_path"`
+	// options are the options of the listeners, the TLS options of the
+	// client certificates being reloaded by ReloadFile
+	options interface{}
 }
 
 type NotifyConfiguration struct {
@@ -111,49 +141,366 @@ type NotifyConfiguration struct {
 	CertificateRefresh  *ChanNotify `yaml:"-"`
 	Reload              *ChanNotify `yaml:"-"`
 	Shutdown            *ChanNotify `yaml:"-"`
+	// ConfigChanged is notified once options of the dataplane configuration
+	// file are applied without restarting the API, its subscribers reading them
+	ConfigChanged *ChanNotify `yaml:"-"`
+	// Hangup is notified on SIGHUP once the dataplane configuration file is
+	// reloaded, its subscribers reading their files again, such as the CA
+	// certificate of the client certificates
+	Hangup *ChanNotify `yaml:"-"`
 }
 type ServiceDiscovery struct {
 	mu      sync.Mutex
 	Consuls []*models.Consul `yaml:"consuls"`
 }
 
+// Hook is an external command run when a transaction is committed. Validators
+// and mutators get the configuration of the transaction on standard input before
+// it is committed, a validator rejects it by exiting with a non-zero status and a
+// mutator writes the configuration to commit on standard output, which must not
+// be empty. Notifiers get a JSON description of the commit once it is done. The
+// hooks run on the commits of the transactions and batches only, not on the
+// changes sent with a version nor the declarative configuration.
+type Hook struct {
+	Name    string   `yaml:"name"`
+	Type    string   `yaml:"type"`
+	Command string   `yaml:"command"`
+	Args    []string `yaml:"args,omitempty"`
+	// Timeout in seconds, defaults to 10
+	Timeout int `yaml:"timeout,omitempty"`
+}
+
+// APIUser is a user of the API Basic Authentication set in the dataplane
+// configuration file, the password being encrypted with crypt(3) unless the
+// user is insecure
+type APIUser struct {
+	Name     string `yaml:"name"`
+	Password string `yaml:"password"`
+	Insecure bool   `yaml:"insecure,omitempty"`
+}
+
+// Probe is a request sent through HAProxy after a reload to verify it still
+// serves traffic. A failing probe fails the reload, which rolls the configuration
+// back when rollback is enabled, an optional one only reports it as degraded.
+type Probe struct {
+	Name string `yaml:"name"`
+	// Type is http or tcp, defaults to http
+	Type string `yaml:"type,omitempty"`
+	// Address to probe as host:port, or Frontend to probe each of its binds
+	Address  string `yaml:"address,omitempty"`
+	Frontend string `yaml:"frontend,omitempty"`
+	Method   string `yaml:"method,omitempty"`
+	Path     string `yaml:"path,omitempty"`
+	Host     string `yaml:"host,omitempty"`
+	// ExpectStatus lists the accepted status codes, any status below 500 when empty
+	ExpectStatus []int `yaml:"expect_status,omitempty"`
+	// Timeout in seconds, defaults to 5
+	Timeout  int  `yaml:"timeout,omitempty"`
+	Optional bool `yaml:"optional,omitempty"`
+}
+
+// StatsD sends API, transaction and reload metrics to a statsd server over UDP,
+// tagged in the DogStatsD format when enabled
+type StatsD struct {
+	Address string `yaml:"address"`
+	// Prefix of the metric names, defaults to dataplaneapi.
+	Prefix    string   `yaml:"prefix,omitempty"`
+	DogStatsD bool     `yaml:"dogstatsd,omitempty"`
+	Tags      []string `yaml:"tags,omitempty"`
+}
+
+// Metrics exposes the API internals to Prometheus: transactions, reloads,
+// API requests and the liveness of HAProxy
+type Metrics struct {
+	Enabled bool `yaml:"enabled"`
+	// Path the metrics are served on, defaults to /metrics
+	Path string `yaml:"path,omitempty"`
+	// Auth requires the API Basic Authentication to read the metrics
+	Auth bool `yaml:"auth,omitempty"`
+}
+
+// ChangePlanner applies the changes of the committed transactions which the
+// runtime API supports right away, the ones requiring a reload waiting for
+// the next reload window
+type ChangePlanner struct {
+	RuntimeApply bool `yaml:"runtime_apply"`
+	// ReloadWindows are the daily time ranges, HH:MM-HH:MM in local time, the
+	// reloads of the committed transactions wait for, any time when empty
+	ReloadWindows []string `yaml:"reload_windows,omitempty"`
+}
+
+// APIAuth authenticates the API requests with JWT bearer tokens, as an
+// alternative to the Basic Authentication of the users
+type APIAuth struct {
+	// Issuer and Audience are checked when set
+	Issuer   string `yaml:"issuer,omitempty"`
+	Audience string `yaml:"audience,omitempty"`
+	// JWKSURL publishes the keys the tokens are signed with
+	JWKSURL string `yaml:"jwks_url"`
+	// UserClaim names the user, defaults to sub
+	UserClaim string `yaml:"user_claim,omitempty"`
+	// RolesClaim lists the roles of the user, defaults to roles
+	RolesClaim string `yaml:"roles_claim,omitempty"`
+	// JWKSRefresh is the time in seconds after which the keys are read again,
+	// defaults to 3600
+	JWKSRefresh int `yaml:"jwks_refresh,omitempty"`
+}
+
+// SNMP exposes HAProxy stats read-only to an SNMP master agent over AgentX
+type SNMP struct {
+	// AgentXAddress is the unix socket path, or tcp:host:port, of the master
+	// agent, defaults to /var/agentx/master
+	AgentXAddress string `yaml:"agentx_address,omitempty"`
+	// BaseOID is the registered subtree, defaults to 1.3.6.1.4.1.23263.4.3
+	BaseOID string `yaml:"base_oid,omitempty"`
+}
+
+// Git commits the files changed by each committed transaction, and by each
+// change made without a transaction, to a git repository
+type Git struct {
+	// Path of the repository, defaults to the directory of the configuration file
+	Path string `yaml:"path,omitempty"`
+	// Paths of other files or directories to commit, such as certificates,
+	// the configuration file, the SPOE directory and the maps directory when
+	// set are always committed
+	Paths       []string `yaml:"paths,omitempty"`
+	AuthorName  string   `yaml:"author_name,omitempty"`
+	AuthorEmail string   `yaml:"author_email,omitempty"`
+	// Remote is the URL commits are pushed to when Push is enabled, SSH
+	// remotes are reached with the SSHKey deploy key, checking their host key
+	// strictly against KnownHosts
+	Remote string `yaml:"remote,omitempty"`
+	// Branch pushed to, defaults to master
+	Branch string `yaml:"branch,omitempty"`
+	Push   bool   `yaml:"push,omitempty"`
+	SSHKey string `yaml:"ssh_key,omitempty"`
+	// KnownHosts file, defaults to known_hosts in the directory of the
+	// dataplane configuration file
+	KnownHosts string `yaml:"known_hosts,omitempty"`
+	// WebhookSecret signs the push webhooks of the remote, which pull it
+	WebhookSecret string `yaml:"webhook_secret,omitempty"`
+	// SigningKey signs every commit when set, it is a key ID for the openpgp
+	// and x509 formats and a key file for the ssh one
+	SigningKey string `yaml:"signing_key,omitempty"`
+	// SigningFormat is openpgp, ssh or x509, defaults to openpgp
+	SigningFormat string `yaml:"signing_format,omitempty"`
+	// SigningProgram defaults to the program of the signing format
+	SigningProgram string `yaml:"signing_program,omitempty"`
+}
+
+// Storage sets quotas on the storage areas of the API, the map files and the
+// general files such as error pages or lua scripts
+type Storage struct {
+	Maps    *StorageQuota `yaml:"maps,omitempty"`
+	General *StorageQuota `yaml:"general,omitempty"`
+}
+
+// StorageQuota limits the files of a storage area, a zero limit is no limit
+type StorageQuota struct {
+	MaxFiles int `yaml:"max_files,omitempty"`
+	// MaxFileSize and MaxSize, the size of all files, are in bytes
+	MaxFileSize int64 `yaml:"max_file_size,omitempty"`
+	MaxSize     int64 `yaml:"max_size,omitempty"`
+}
+
+// Fleet pushes the configuration to peer Data Plane APIs, keeping identical
+// load balancers in sync without cluster mode
+type Fleet struct {
+	Nodes []FleetNode `yaml:"nodes"`
+	// AutoPush pushes the configuration after every successful reload
+	AutoPush bool `yaml:"auto_push,omitempty"`
+	// Atomic rolls back all nodes when one of them fails
+	Atomic bool `yaml:"atomic,omitempty"`
+	// TwoPhase prepares the configuration on all nodes before committing it
+	// on any of them, aborting the push when a node fails to prepare it
+	TwoPhase bool `yaml:"two_phase,omitempty"`
+	// Timeout of a push to a node in seconds, reload included, defaults to 60
+	Timeout int `yaml:"timeout,omitempty"`
+}
+
+// FleetNode is a peer Data Plane API
+type FleetNode struct {
+	Name string `yaml:"name"`
+	// URL of the API, base path included, such as https://lb2:5555/v2
+	URL      string `yaml:"url"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// CAFile verifies the certificate of the node, the system roots are used
+	// when not set
+	CAFile             string `yaml:"ca_file,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
+	// Variables replace the "${NAME}" environment variable references of the
+	// configuration pushed to the node, the other ones are left to HAProxy
+	Variables map[string]string `yaml:"variables,omitempty"`
+	// Overrides replace lines of the configuration pushed to the node
+	Overrides []FleetOverride `yaml:"overrides,omitempty"`
+}
+
+// FleetOverride replaces the lines of a section starting with Match, for
+// node specific values such as bind addresses or server source addresses
+type FleetOverride struct {
+	// Section is the header of the section, such as global or frontend http
+	Section string `yaml:"section"`
+	Match   string `yaml:"match"`
+	// Replace is the replacing line, the lines are removed when empty
+	Replace string `yaml:"replace,omitempty"`
+}
+
+// TLSProfile is a custom named set of TLS options
+type TLSProfile struct {
+	Name                string `yaml:"name"`
+	SslMinVer           string `yaml:"ssl_min_ver,omitempty"`
+	SslMaxVer           string `yaml:"ssl_max_ver,omitempty"`
+	Ciphers             string `yaml:"ciphers,omitempty"`
+	Ciphersuites        string `yaml:"ciphersuites,omitempty"`
+	Curves              string `yaml:"curves,omitempty"`
+	PreferClientCiphers bool   `yaml:"prefer_client_ciphers,omitempty"`
+}
+
+// TLSProfileAssignment is a bind or server a TLS profile was applied to
+type TLSProfileAssignment struct {
+	Profile string `yaml:"profile"`
+	Type    string `yaml:"type"`
+	Parent  string `yaml:"parent"`
+	Name    string `yaml:"name"`
+}
+
+type TLSProfiles struct {
+	mu          sync.Mutex
+	Custom      []TLSProfile           `yaml:"custom"`
+	Assignments []TLSProfileAssignment `yaml:"assignments"`
+}
+
+// MarshalYAML marshals a copy of the profiles taken under their lock
+func (p *TLSProfiles) MarshalYAML() (interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return struct {
+		Custom      []TLSProfile           `yaml:"custom"`
+		Assignments []TLSProfileAssignment `yaml:"assignments"`
+	}{append([]TLSProfile{}, p.Custom...), append([]TLSProfileAssignment{}, p.Assignments...)}, nil
+}
+
+// LogFormat is a custom named log-format string
+type LogFormat struct {
+	Name        string `yaml:"name"`
+	Format      string `yaml:"format"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// LogFormatAssignment is a frontend or the defaults section a log format was applied to
+type LogFormatAssignment struct {
+	Format string `yaml:"format"`
+	Type   string `yaml:"type"`
+	Name   string `yaml:"name,omitempty"`
+}
+
+type LogFormats struct {
+	mu          sync.Mutex
+	Custom      []LogFormat           `yaml:"custom"`
+	Assignments []LogFormatAssignment `yaml:"assignments"`
+}
+
+// MarshalYAML marshals a copy of the log formats taken under their lock
+func (f *LogFormats) MarshalYAML() (interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return struct {
+		Custom      []LogFormat           `yaml:"custom"`
+		Assignments []LogFormatAssignment `yaml:"assignments"`
+	}{append([]LogFormat{}, f.Custom...), append([]LogFormatAssignment{}, f.Assignments...)}, nil
+}
+
 type Configuration struct {
 	HAProxy          HAProxyConfiguration `yaml:"-"`
 	Logging          LoggingOptions       `yaml:"-"`
 	APIOptions       APIConfiguration     `yaml:"-"`
+	Options          CommandLineOptions   `yaml:",inline"`
 	Cluster          ClusterConfiguration `yaml:"cluster"`
 	Server           ServerConfiguration  `yaml:"-"`
 	Notify           NotifyConfiguration  `yaml:"-"`
 	ServiceDiscovery ServiceDiscovery     `yaml:"service_discovery"`
-	Name             AtomicString         `yaml:"name"`
-	BootstrapKey     AtomicString         `yaml:"bootstrap_key"`
-	Mode             AtomicString         `yaml:"mode" default:"single"`
-	Status           AtomicString         `yaml:"status"`
-	Cmdline          AtomicString         `yaml:"-"`
+	TLSProfiles      *TLSProfiles         `yaml:"tls_profiles"`
+	LogFormats       *LogFormats          `yaml:"log_formats"`
+	// BootstrapKeyHistory records the changes of BootstrapKey
+	BootstrapKeyHistory *BootstrapKeyHistory `yaml:"bootstrap_key_history,omitempty"`
+	Features            Features             `yaml:"features,omitempty"`
+	Hooks               []Hook               `yaml:"hooks,omitempty"`
+	Policies            []string             `yaml:"policies,omitempty"`
+	Probes              []Probe              `yaml:"probes,omitempty"`
+	StatsD              *StatsD              `yaml:"statsd,omitempty"`
+	Metrics             *Metrics             `yaml:"metrics,omitempty"`
+	APIAuth             *APIAuth             `yaml:"api_auth,omitempty"`
+	AuthProvider        *AuthProvider        `yaml:"auth_provider,omitempty"`
+	ChangePlanner       *ChangePlanner       `yaml:"change_planner,omitempty"`
+	RBAC                *RBAC                `yaml:"rbac,omitempty"`
+	SNMP                *SNMP                `yaml:"snmp,omitempty"`
+	Git                 *Git                 `yaml:"git,omitempty"`
+	Fleet               *Fleet               `yaml:"fleet,omitempty"`
+	Storage             *Storage             `yaml:"storage,omitempty"`
+	// ChannelOrigins are the origins of the pages allowed to open the
+	// transaction channel from a browser besides the API host, as
+	// scheme://host[:port]
+	ChannelOrigins []string `yaml:"transaction_channel_origins,omitempty"`
+	// Users replace the userlist of the HAProxy configuration when set
+	Users        []APIUser    `yaml:"users,omitempty"`
+	Annotations  *Annotations `yaml:"annotations,omitempty"`
+	APIKeys      *APIKeys     `yaml:"api_keys,omitempty"`
+	Name         AtomicString `yaml:"name"`
+	BootstrapKey AtomicString `yaml:"bootstrap_key"`
+	Mode         AtomicString `yaml:"mode" default:"single"`
+	Status       AtomicString `yaml:"status"`
+	Cmdline      AtomicString `yaml:"-"`
+
+	// saveMu serializes the writes of the configuration file
+	saveMu *sync.Mutex
+	// optionsMu guards the options and the users ReloadFile changes while
+	// the API runs. The locks and sources are pointers as marshaling the
+	// configuration copies its fields, the sections with a lock of their own
+	// being marshaled under it.
+	optionsMu *sync.RWMutex
+	sources   *settingSources
 }
 
-//Get returns pointer to configuration
+//Get returns pointer to the configuration of the process, handling its signals
 func Get() *Configuration {
-	if cfg == nil {
-		cfg = &Configuration{}
+	cfgOnce.Do(func() {
+		cfg = New()
 		cfg.initSignalHandler()
-		cfg.Notify.BootstrapKeyChanged = NewChanNotify()
-		cfg.Notify.CertificateRefresh = NewChanNotify()
-		cfg.Notify.Reload = NewChanNotify()
-		cfg.Notify.Shutdown = NewChanNotify()
-
-		var sb strings.Builder
-		for _, v := range os.Args {
-			if !strings.HasPrefix(v, "-") && !strings.Contains(v, `\ `) && strings.ContainsAny(v, " ") {
-				fmt.Fprintf(&sb, "\"%s\" ", v)
-			} else {
-				fmt.Fprintf(&sb, "%s ", v)
-			}
-		}
+	})
+	return cfg
+}
 
-		cfg.Cmdline.Store(sb.String())
+//New returns an empty configuration with its notifications set up
+func New() *Configuration {
+	c := &Configuration{
+		TLSProfiles:         &TLSProfiles{},
+		LogFormats:          &LogFormats{},
+		BootstrapKeyHistory: &BootstrapKeyHistory{},
+		Annotations:         &Annotations{},
+		APIKeys:             &APIKeys{},
+		saveMu:              &sync.Mutex{},
+		optionsMu:           &sync.RWMutex{},
+		sources:             &settingSources{},
 	}
-	return cfg
+	c.Notify.BootstrapKeyChanged = NewChanNotify()
+	c.Notify.CertificateRefresh = NewChanNotify()
+	c.Notify.Reload = NewChanNotify()
+	c.Notify.Shutdown = NewChanNotify()
+	c.Notify.ConfigChanged = NewChanNotify()
+	c.Notify.Hangup = NewChanNotify()
+
+	var sb strings.Builder
+	for _, v := range os.Args {
+		if !strings.HasPrefix(v, "-") && !strings.Contains(v, `\ `) && strings.ContainsAny(v, " ") {
+			fmt.Fprintf(&sb, "\"%s\" ", v)
+		} else {
+			fmt.Fprintf(&sb, "%s ", v)
+		}
+	}
+
+	c.Cmdline.Store(sb.String())
+	return c
 }
 
 func (c *Configuration) BotstrapKeyChanged(bootstrapKey string) {
@@ -170,37 +517,89 @@ func (c *Configuration) UnSubscribeAll() {
 	c.Notify.CertificateRefresh.UnSubscribeAll()
 	c.Notify.Reload.UnSubscribeAll()
 	c.Notify.Shutdown.UnSubscribeAll()
+	c.Notify.ConfigChanged.UnSubscribeAll()
+	c.Notify.Hangup.UnSubscribeAll()
 }
 
-func (c *Configuration) Load(swaggerJSON json.RawMessage, host string, port int) error {
+//Load loads the dataplane configuration file, server being the options of
+//the listeners the options of the server group of the file are set to
+func (c *Configuration) Load(swaggerJSON json.RawMessage, server interface{}) error {
 	var m map[string]interface{}
 	err := json.Unmarshal(swaggerJSON, &m)
 	if err != nil {
 		return err
 	}
-	cfg.Server.APIBasePath = m["basePath"].(string)
+	basePath, ok := m["basePath"].(string)
+	if !ok {
+		return fmt.Errorf("no base path in the specification")
+	}
+	c.Server.APIBasePath = basePath
+
+	check, err := CheckFile(c.HAProxy.DataplaneConfig)
+	if err != nil {
+		return fmt.Errorf("cannot read %s: %w", c.HAProxy.DataplaneConfig, err)
+	}
+	if err := check.Err(); err != nil {
+		return err
+	}
+	for _, w := range check.Warnings {
+		log.Warningf("Dataplane configuration file %s: %s", check.File, w)
+	}
+	cfgLoaded := check.loaded
+	c.Options = cfgLoaded.Options
+	warnings, err := c.applyFileOptions(server)
+	if err != nil {
+		return fmt.Errorf("invalid dataplane configuration file %s: %w", check.File, err)
+	}
+	for _, w := range warnings {
+		log.Warningf("Dataplane configuration file %s: %s", check.File, w)
+	}
+	host, _ := optionValue(server, "host").(string)
 	if host == "localhost" {
 		host = "127.0.0.1"
 	}
-	cfg.Server.Host = host
-	cfg.Server.Port = port
-
-	cfgLoaded := &Configuration{}
-	if c.HAProxy.DataplaneConfig != "" {
-		yamlFile, err := ioutil.ReadFile(c.HAProxy.DataplaneConfig)
-		if err == nil {
-			err = yaml.Unmarshal(yamlFile, cfgLoaded)
-			if err != nil {
-				log.Fatalf("Unmarshal: %v", err)
-			}
-		}
-	}
+	c.Server.Host = host
+	c.Server.Port, _ = optionValue(server, "port").(int)
+	c.Server.options = server
+
 	c.Cluster = cfgLoaded.Cluster
 	c.BootstrapKey.Store(cfgLoaded.BootstrapKey.Load())
 	c.Name.Store(cfgLoaded.Name.Load())
 	c.Mode.Store(cfgLoaded.Mode.Load())
 	c.Status.Store(cfgLoaded.Status.Load())
 	c.ServiceDiscovery.Consuls = cfgLoaded.ServiceDiscovery.Consuls
+	c.TLSProfiles.Custom = cfgLoaded.TLSProfiles.Custom
+	c.TLSProfiles.Assignments = cfgLoaded.TLSProfiles.Assignments
+	c.LogFormats.Custom = cfgLoaded.LogFormats.Custom
+	c.LogFormats.Assignments = cfgLoaded.LogFormats.Assignments
+	c.Features = cfgLoaded.Features
+	c.Hooks = cfgLoaded.Hooks
+	c.Policies = cfgLoaded.Policies
+	c.Probes = cfgLoaded.Probes
+	c.StatsD = cfgLoaded.StatsD
+	c.Metrics = cfgLoaded.Metrics
+	c.APIAuth = cfgLoaded.APIAuth
+	c.AuthProvider = cfgLoaded.AuthProvider
+	c.ChangePlanner = cfgLoaded.ChangePlanner
+	c.RBAC = cfgLoaded.RBAC
+	c.SNMP = cfgLoaded.SNMP
+	c.Git = cfgLoaded.Git
+	c.Fleet = cfgLoaded.Fleet
+	c.Users = cfgLoaded.Users
+	c.Storage = cfgLoaded.Storage
+	c.ChannelOrigins = cfgLoaded.ChannelOrigins
+	c.Annotations.Admins = cfgLoaded.Annotations.Admins
+	c.Annotations.Items = cfgLoaded.Annotations.Items
+	c.APIKeys.Items = cfgLoaded.APIKeys.Items
+	c.BootstrapKeyHistory.Rotations = cfgLoaded.BootstrapKeyHistory.Rotations
+
+	// a key changed in the configuration file is recorded as a rotation
+	if key := c.BootstrapKey.Load(); bootstrapKeyFingerprint(key) != c.lastBootstrapKeyFingerprint() {
+		c.RecordBootstrapKeyRotation(key, "startup")
+		if key != "" && key == c.Cluster.ActiveBootstrapKey.Load() {
+			c.SetBootstrapKeyRotationStatus(key, KeyRotationJoined, "")
+		}
+	}
 
 	if c.Mode.Load() == "" {
 		c.Mode.Store("single")
@@ -211,20 +610,28 @@ func (c *Configuration) Load(swaggerJSON json.RawMessage, host string, port int)
 		c.Name.Store(petname.Generate(2, "_"))
 	}
 
-	return nil
+	return c.loadSources(check.data)
 }
 
 func (c *Configuration) Save() error {
 	if c.HAProxy.DataplaneConfig == "" {
 		return nil
 	}
+	c.saveMu.Lock()
+	defer c.saveMu.Unlock()
 
-	data, err := yaml.Marshal(&c)
+	c.optionsMu.RLock()
+	data, err := yaml.Marshal(c)
+	c.optionsMu.RUnlock()
 	if err != nil {
-		log.Fatalf("error: %v", err)
+		return err
+	}
+	data, err = fromYAML(fileFormat(c.HAProxy.DataplaneConfig), data)
+	if err != nil {
+		return err
 	}
 
-	err = renameio.WriteFile(c.HAProxy.DataplaneConfig, data, 0644)
+	err = system.WriteFile(c.HAProxy.DataplaneConfig, data, 0600)
 	if err != nil {
 		return err
 	}
@@ -244,9 +651,60 @@ func (c *Configuration) GetClusterCertDir() string {
 	return dir
 }
 
+func (c *Configuration) GetGeneralStorageDir() string {
+	if c.HAProxy.GeneralStorageDir != "" {
+		return c.HAProxy.GeneralStorageDir
+	}
+	return filepath.Join(filepath.Dir(c.HAProxy.ConfigFile), "general")
+}
+
 func (c *Configuration) SaveConsuls(consuls []*models.Consul) error {
 	c.ServiceDiscovery.mu.Lock()
 	c.ServiceDiscovery.Consuls = consuls
 	c.ServiceDiscovery.mu.Unlock()
 	return c.Save()
 }
+
+// GetTLSProfiles returns copies of the custom TLS profiles and of the assignments of all profiles
+func (c *Configuration) GetTLSProfiles() ([]TLSProfile, []TLSProfileAssignment) {
+	c.TLSProfiles.mu.Lock()
+	defer c.TLSProfiles.mu.Unlock()
+	return append([]TLSProfile{}, c.TLSProfiles.Custom...), append([]TLSProfileAssignment{}, c.TLSProfiles.Assignments...)
+}
+
+// UpdateTLSProfiles replaces the custom TLS profiles and the assignments with the result
+// of fn, called with copies of the current ones, and saves the configuration
+func (c *Configuration) UpdateTLSProfiles(fn func([]TLSProfile, []TLSProfileAssignment) ([]TLSProfile, []TLSProfileAssignment, error)) error {
+	c.TLSProfiles.mu.Lock()
+	custom, assignments, err := fn(append([]TLSProfile{}, c.TLSProfiles.Custom...), append([]TLSProfileAssignment{}, c.TLSProfiles.Assignments...))
+	if err != nil {
+		c.TLSProfiles.mu.Unlock()
+		return err
+	}
+	c.TLSProfiles.Custom = custom
+	c.TLSProfiles.Assignments = assignments
+	c.TLSProfiles.mu.Unlock()
+	return c.Save()
+}
+
+// GetLogFormats returns copies of the custom log formats and of the assignments of all formats
+func (c *Configuration) GetLogFormats() ([]LogFormat, []LogFormatAssignment) {
+	c.LogFormats.mu.Lock()
+	defer c.LogFormats.mu.Unlock()
+	return append([]LogFormat{}, c.LogFormats.Custom...), append([]LogFormatAssignment{}, c.LogFormats.Assignments...)
+}
+
+// UpdateLogFormats replaces the custom log formats and the assignments with the result
+// of fn, called with copies of the current ones, and saves the configuration
+func (c *Configuration) UpdateLogFormats(fn func([]LogFormat, []LogFormatAssignment) ([]LogFormat, []LogFormatAssignment, error)) error {
+	c.LogFormats.mu.Lock()
+	custom, assignments, err := fn(append([]LogFormat{}, c.LogFormats.Custom...), append([]LogFormatAssignment{}, c.LogFormats.Assignments...))
+	if err != nil {
+		c.LogFormats.mu.Unlock()
+		return err
+	}
+	c.LogFormats.Custom = custom
+	c.LogFormats.Assignments = assignments
+	c.LogFormats.mu.Unlock()
+	return c.Save()
+}
@@ -0,0 +1,128 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestSaveConcurrent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dataplaneapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c := New()
+	c.HAProxy.DataplaneConfig = filepath.Join(dir, "dataplaneapi.yaml")
+
+	updates := []func(i int) error{
+		func(i int) error {
+			return c.UpdateAnnotations(func(items []Annotation) ([]Annotation, error) {
+				return append(items, Annotation{Type: "backend", Name: fmt.Sprintf("b%d", i), Protected: true}), nil
+			})
+		},
+		func(i int) error {
+			return c.UpdateAPIKeys(func(items []APIKey) ([]APIKey, error) {
+				return append(items, APIKey{ID: fmt.Sprintf("k%d", i), User: "admin"}), nil
+			})
+		},
+		func(i int) error {
+			return c.UpdateTLSProfiles(func(p []TLSProfile, a []TLSProfileAssignment) ([]TLSProfile, []TLSProfileAssignment, error) {
+				return append(p, TLSProfile{Name: fmt.Sprintf("p%d", i)}), a, nil
+			})
+		},
+		func(i int) error {
+			return c.UpdateLogFormats(func(f []LogFormat, a []LogFormatAssignment) ([]LogFormat, []LogFormatAssignment, error) {
+				return append(f, LogFormat{Name: fmt.Sprintf("f%d", i), Format: "%ci"}), a, nil
+			})
+		},
+		func(i int) error {
+			c.RecordBootstrapKeyRotation("", "api")
+			return nil
+		},
+		func(i int) error {
+			c.Annotations.Admin("admin")
+			return c.Save()
+		},
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, len(updates)*10)
+	for _, update := range updates {
+		wg.Add(1)
+		go func(update func(i int) error) {
+			defer wg.Done()
+			for i := 0; i < 10; i++ {
+				if err := update(i); err != nil {
+					errs <- err
+				}
+			}
+		}(update)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(c.HAProxy.DataplaneConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := New()
+	if err := yaml.Unmarshal(data, saved); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(saved.Annotations.Items); n != 10 {
+		t.Errorf("expected 10 annotations, got %d", n)
+	}
+	if n := len(saved.APIKeys.Items); n != 10 {
+		t.Errorf("expected 10 API keys, got %d", n)
+	}
+	if n := len(saved.TLSProfiles.Custom); n != 10 {
+		t.Errorf("expected 10 TLS profiles, got %d", n)
+	}
+	if n := len(saved.LogFormats.Custom); n != 10 {
+		t.Errorf("expected 10 log formats, got %d", n)
+	}
+	if n := len(saved.BootstrapKeyHistory.Rotations); n != 10 {
+		t.Errorf("expected 10 bootstrap key rotations, got %d", n)
+	}
+}
+
+func TestSaveOmitsEmptySections(t *testing.T) {
+	data, err := yaml.Marshal(New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"annotations:", "api_keys:", "bootstrap_key_history:"} {
+		if strings.Contains(string(data), key) {
+			t.Errorf("%s saved without values", key)
+		}
+	}
+	for _, key := range []string{"tls_profiles:", "log_formats:"} {
+		if !strings.Contains(string(data), key) {
+			t.Errorf("%s not saved", key)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"os"
+	"reflect"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// EnvPrefix is the prefix of the environment variables of the options
+	EnvPrefix = "DATAPLANEAPI_"
+	// legacyEnvPrefix is the previous prefix, still read when the variable
+	// with EnvPrefix is not set
+	legacyEnvPrefix = "DPAPI_"
+)
+
+// SetLegacyEnv sets the environment variables of the options of groups,
+// pointers to groups of command line options, which are only set with their
+// legacy DPAPI_ name so that the options parser reads them
+func SetLegacyEnv(groups ...interface{}) {
+	for _, g := range groups {
+		t := reflect.TypeOf(g).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			name := t.Field(i).Tag.Get("env")
+			if !strings.HasPrefix(name, EnvPrefix) {
+				continue
+			}
+			legacy := legacyEnvPrefix + strings.TrimPrefix(name, EnvPrefix)
+			value, ok := os.LookupEnv(legacy)
+			if !ok {
+				continue
+			}
+			if _, ok := os.LookupEnv(name); ok {
+				continue
+			}
+			log.Warningf("%s is deprecated, use %s instead", legacy, name)
+			os.Setenv(name, value)
+		}
+	}
+}
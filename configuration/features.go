@@ -0,0 +1,69 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"sort"
+	"strings"
+)
+
+// featureGroups are the endpoint groups that can be disabled, with the path
+// prefixes of their endpoints in the specification
+var featureGroups = map[string][]string{
+	"runtime":             {"/services/haproxy/runtime"},
+	"raw_configuration":   {"/services/haproxy/configuration/raw"},
+	"consul":              {"/service_discovery/consul"},
+	"transaction_channel": {"/services/haproxy/transactions/live"},
+	"snapshot":            {"/services/haproxy/snapshot"},
+}
+
+// Features enables or disables endpoint groups at startup, groups not listed
+// are enabled
+type Features map[string]bool
+
+// Enabled reports whether the endpoints of a group are enabled
+func (f Features) Enabled(group string) bool {
+	enabled, ok := f[group]
+	return !ok || enabled
+}
+
+// Disabled returns the names of the disabled groups
+func (f Features) Disabled() []string {
+	disabled := make([]string, 0)
+	for group := range featureGroups {
+		if !f.Enabled(group) {
+			disabled = append(disabled, group)
+		}
+	}
+	sort.Strings(disabled)
+	return disabled
+}
+
+// DisabledGroup returns the disabled group the endpoint with the path pattern
+// belongs to, empty when the endpoint is enabled
+func (f Features) DisabledGroup(path string) string {
+	for group, prefixes := range featureGroups {
+		if f.Enabled(group) {
+			continue
+		}
+		for _, p := range prefixes {
+			if path == p || strings.HasPrefix(path, p+"/") {
+				return group
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// formats of the dataplane configuration file, detected by its extension,
+// files without a known extension are YAML
+const (
+	formatYAML = "yaml"
+	formatJSON = "json"
+	formatTOML = "toml"
+)
+
+func fileFormat(file string) string {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		return formatJSON
+	case ".toml":
+		return formatTOML
+	default:
+		return formatYAML
+	}
+}
+
+// toYAML converts the content of a dataplane configuration file in format to
+// YAML, the settings are decoded from YAML only
+func toYAML(format string, data []byte) ([]byte, error) {
+	var v interface{}
+	switch format {
+	case formatJSON:
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.UseNumber()
+		if err := d.Decode(&v); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %s", err.Error())
+		}
+		v = jsonNumbers(v)
+	case formatTOML:
+		m := make(map[string]interface{})
+		if _, err := toml.Decode(string(data), &m); err != nil {
+			return nil, fmt.Errorf("invalid TOML: %s", err.Error())
+		}
+		v = m
+	default:
+		return data, nil
+	}
+	return yaml.Marshal(v)
+}
+
+// fromYAML converts the YAML content of a dataplane configuration file to
+// format
+func fromYAML(format string, data []byte) ([]byte, error) {
+	if format == formatYAML {
+		return data, nil
+	}
+	var v interface{}
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	v = stringKeys(v)
+	if format == formatJSON {
+		out, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(out, '\n'), nil
+	}
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// jsonNumbers converts the numbers of decoded JSON to integers when they are
+func jsonNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, item := range t {
+			t[k] = jsonNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range t {
+			t[i] = jsonNumbers(item)
+		}
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	}
+	return v
+}
+
+// stringKeys converts the maps of decoded YAML to maps with string keys, TOML
+// having no null value, null values are removed
+func stringKeys(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, item := range t {
+			if item != nil {
+				m[fmt.Sprint(k)] = stringKeys(item)
+			}
+		}
+		return m
+	case []interface{}:
+		for i, item := range t {
+			t[i] = stringKeys(item)
+		}
+	}
+	return v
+}
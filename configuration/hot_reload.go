@@ -0,0 +1,164 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"reflect"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// hotReloadOptions are the options ReloadFile applies without restarting the API
+var hotReloadOptions = map[string]bool{
+	"log-level":     true,
+	"reload-delay":  true,
+	"userlist":      true,
+	"userlist-file": true,
+	// server options
+	"tls-ca":              true,
+	"tls-client-auth":     true,
+	"tls-client-identity": true,
+}
+
+// ReloadFile reads the dataplane configuration file again and applies the
+// changes of the log level, the reload delay, the client certificates
+// options and the users, the subscribers of Notify.ConfigChanged and
+// Notify.Hangup applying them to the running API. It returns the names of the
+// changed options, users standing for the users of the file. The other
+// changes need a restart and are logged, the options removed from the file
+// keep their value until then.
+func (c *Configuration) ReloadFile() ([]string, error) {
+	if c.HAProxy.DataplaneConfig == "" {
+		return nil, fmt.Errorf("no dataplane configuration file")
+	}
+	check, err := CheckFile(c.HAProxy.DataplaneConfig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", c.HAProxy.DataplaneConfig, err)
+	}
+	if err := check.Err(); err != nil {
+		return nil, err
+	}
+	loaded := check.loaded
+	overridden := c.overriddenOptions()
+
+	c.saveMu.Lock()
+	c.optionsMu.Lock()
+	haproxyOptions := c.HAProxy
+	loggingOptions := c.Logging
+	apiOptions := c.APIOptions
+	type optionGroup struct {
+		name     string
+		declared yaml.MapSlice
+		current  interface{}
+		options  interface{}
+	}
+	groups := []optionGroup{
+		{"haproxy", loaded.Options.HAProxy, &c.HAProxy, &haproxyOptions},
+		{"logging", loaded.Options.Logging, &c.Logging, &loggingOptions},
+		{"api", loaded.Options.API, &c.APIOptions, &apiOptions},
+	}
+	if c.Server.options != nil {
+		// only the options declared in the file are compared
+		serverOptions := reflect.New(reflect.TypeOf(c.Server.options).Elem()).Interface()
+		groups = append(groups, optionGroup{"server", loaded.Options.Server, c.Server.options, serverOptions})
+	}
+	changed := make([]string, 0)
+	restart := make([]string, 0)
+	for _, g := range groups {
+		set, _, err := applyOptions(g.declared, g.options, func(name string) bool { return overridden[name] })
+		if err != nil {
+			c.optionsMu.Unlock()
+			c.saveMu.Unlock()
+			return nil, fmt.Errorf("invalid dataplane configuration file %s: %s.%w", check.File, g.name, err)
+		}
+		for _, name := range set {
+			current, _ := optionField(reflect.ValueOf(g.current).Elem(), name)
+			value, _ := optionField(reflect.ValueOf(g.options).Elem(), name)
+			if reflect.DeepEqual(current.Interface(), value.Interface()) {
+				continue
+			}
+			if !hotReloadOptions[name] {
+				restart = append(restart, g.name+"."+name)
+				continue
+			}
+			current.Set(value)
+			changed = append(changed, name)
+		}
+	}
+	if !reflect.DeepEqual(c.Users, loaded.Users) {
+		c.Users = loaded.Users
+		changed = append(changed, "users")
+	}
+	c.Options = loaded.Options
+	c.optionsMu.Unlock()
+	c.saveMu.Unlock()
+
+	for _, name := range changed {
+		if name != "users" {
+			c.SetOptionSource(name, SourceFile)
+		}
+	}
+	for _, name := range restart {
+		log.Warningf("Dataplane configuration file %s: %s changed, restart the API to apply it", check.File, name)
+	}
+	if err := c.loadSources(check.data); err != nil {
+		return nil, err
+	}
+	if len(changed) > 0 {
+		c.Notify.ConfigChanged.Notify()
+	}
+	return changed, nil
+}
+
+// HAProxyOptions returns a copy of the HAProxy options, read while ReloadFile
+// does not change them
+func (c *Configuration) HAProxyOptions() HAProxyConfiguration {
+	c.optionsMu.RLock()
+	defer c.optionsMu.RUnlock()
+	return c.HAProxy
+}
+
+// LoggingOptions returns a copy of the logging options, read while ReloadFile
+// does not change them
+func (c *Configuration) LoggingOptions() LoggingOptions {
+	c.optionsMu.RLock()
+	defer c.optionsMu.RUnlock()
+	return c.Logging
+}
+
+// ReadServerOptions calls fn while ReloadFile does not change the server
+// options, fn reading them
+func (c *Configuration) ReadServerOptions(fn func()) {
+	c.optionsMu.RLock()
+	defer c.optionsMu.RUnlock()
+	fn()
+}
+
+// apiUsers returns the users of the dataplane configuration file
+func (c *Configuration) apiUsers() []APIUser {
+	c.optionsMu.RLock()
+	defer c.optionsMu.RUnlock()
+	return c.Users
+}
+
+// setAPIUsers replaces the users of the dataplane configuration file
+func (c *Configuration) setAPIUsers(users []APIUser) {
+	c.optionsMu.Lock()
+	c.Users = users
+	c.optionsMu.Unlock()
+}
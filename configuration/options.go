@@ -0,0 +1,197 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// CommandLineOptions are the command line options set in the dataplane
+// configuration file by group and long name, such as reload-delay in the
+// haproxy group. They override the environment variables, the flags override
+// them.
+type CommandLineOptions struct {
+	HAProxy yaml.MapSlice `yaml:"haproxy,omitempty"`
+	Logging yaml.MapSlice `yaml:"logging,omitempty"`
+	API     yaml.MapSlice `yaml:"api,omitempty"`
+	// Server are the options of the listeners, such as host, port or tls-key
+	Server yaml.MapSlice `yaml:"server,omitempty"`
+}
+
+// optionGroups are the keys of the groups of CommandLineOptions
+var optionGroups = []string{"haproxy", "logging", "api", "server"}
+
+// flagUnmarshaler is implemented by the values of options which are not
+// plain YAML values, such as byte sizes
+type flagUnmarshaler interface {
+	UnmarshalFlag(value string) error
+}
+
+// applyFileOptions sets the options of the file to c and to server, the
+// options of the listeners, except the ones set with a flag, records their
+// source and returns the unknown server options
+func (c *Configuration) applyFileOptions(server interface{}) ([]string, error) {
+	c.sources.mu.Lock()
+	for name, source := range c.sources.options {
+		// left by a previous start of the server
+		if source == SourceFile {
+			delete(c.sources.options, name)
+		}
+	}
+	c.sources.mu.Unlock()
+	overridden := c.overriddenOptions()
+
+	groups := []struct {
+		name     string
+		declared yaml.MapSlice
+		options  interface{}
+	}{
+		{"haproxy", c.Options.HAProxy, &c.HAProxy},
+		{"logging", c.Options.Logging, &c.Logging},
+		{"api", c.Options.API, &c.APIOptions},
+		{"server", c.Options.Server, server},
+	}
+	warnings := make([]string, 0)
+	for _, g := range groups {
+		set, ignored, err := applyOptions(g.declared, g.options, func(name string) bool { return overridden[name] })
+		if err != nil {
+			return nil, fmt.Errorf("%s.%w", g.name, err)
+		}
+		for _, name := range set {
+			c.SetOptionSource(name, SourceFile)
+		}
+		// the other groups are validated with the file
+		if g.name != "server" {
+			continue
+		}
+		for _, name := range ignored {
+			warnings = append(warnings, fmt.Sprintf("%s.%s: unknown option, ignoring it", g.name, name))
+		}
+	}
+	return warnings, nil
+}
+
+// overriddenOptions returns the names of the options set with a flag, the
+// only source overriding the file
+func (c *Configuration) overriddenOptions() map[string]bool {
+	c.sources.mu.Lock()
+	defer c.sources.mu.Unlock()
+	overridden := make(map[string]bool)
+	for name, source := range c.sources.options {
+		if source == SourceFlag {
+			overridden[name] = true
+		}
+	}
+	return overridden
+}
+
+// applyOptions sets the options declared in the file to options, a pointer
+// to a group of command line options, except the overridden ones. It returns
+// the names of the options set and the ones which are not options of the group.
+func applyOptions(declared yaml.MapSlice, options interface{}, overridden func(name string) bool) ([]string, []string, error) {
+	set := make([]string, 0, len(declared))
+	ignored := make([]string, 0)
+	v := reflect.ValueOf(options).Elem()
+	for _, item := range declared {
+		name := fmt.Sprint(item.Key)
+		field, ok := optionField(v, name)
+		if !ok {
+			ignored = append(ignored, name)
+			continue
+		}
+		if overridden != nil && overridden(name) {
+			continue
+		}
+		if err := setOption(field, item.Value); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if choices := optionChoices(v, name); len(choices) > 0 && !containsChoice(choices, fmt.Sprint(field.Interface())) {
+			return nil, nil, fmt.Errorf("%s: invalid value %v, expected one of %s", name, item.Value, strings.Join(choices, ", "))
+		}
+		set = append(set, name)
+	}
+	return set, ignored, nil
+}
+
+// optionField returns the field of the option with the long name name of the
+// group v, the dataplane configuration file not being an option of the file
+func optionField(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Tag.Get("long") == name && f.Tag.Get("yaml") != "-" {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func optionChoices(v reflect.Value, name string) []string {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Tag.Get("long") != name {
+			continue
+		}
+		// a field has one choice tag per choice, which Tag.Get does not return
+		choices := make([]string, 0)
+		for _, part := range strings.Split(string(f.Tag), `choice:"`)[1:] {
+			choices = append(choices, part[:strings.Index(part, `"`)])
+		}
+		return choices
+	}
+	return nil
+}
+
+func containsChoice(choices []string, value string) bool {
+	for _, c := range choices {
+		if c == value {
+			return true
+		}
+	}
+	return false
+}
+
+// setOption sets value, decoded from the file, to the field of an option
+func setOption(field reflect.Value, value interface{}) error {
+	if u, ok := field.Addr().Interface().(flagUnmarshaler); ok {
+		return u.UnmarshalFlag(fmt.Sprint(value))
+	}
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	v := reflect.New(field.Type())
+	if err := yaml.UnmarshalStrict(data, v.Interface()); err != nil {
+		return fmt.Errorf("invalid value %v", value)
+	}
+	field.Set(v.Elem())
+	return nil
+}
+
+// optionValue returns the value of the option with the long name name of
+// options, a pointer to a group of command line options
+func optionValue(options interface{}, name string) interface{} {
+	field, ok := optionField(reflect.ValueOf(options).Elem(), name)
+	if !ok {
+		return nil
+	}
+	return field.Interface()
+}
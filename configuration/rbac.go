@@ -0,0 +1,195 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	api_errors "github.com/go-openapi/errors"
+)
+
+// roles of the users of the API
+const (
+	// RoleReadOnly only reads
+	RoleReadOnly = "read-only"
+	// RoleOperator reads and changes the runtime state of HAProxy
+	RoleOperator = "operator"
+	// RoleAdmin reads and changes everything
+	RoleAdmin = "admin"
+)
+
+// scopeSectionTypes are the types of sections the scopes restrict changes to
+var scopeSectionTypes = map[string]bool{
+	"backend":   true,
+	"frontend":  true,
+	"listen":    true,
+	"resolvers": true,
+	"peers":     true,
+}
+
+// selfServicePaths are the path patterns of the endpoints acting on the
+// account of the user sending the request, allowed to every user
+var selfServicePaths = map[string]bool{
+	"/users/self/password": true,
+}
+
+// RBAC assigns roles to the users of the API, the users without assignment
+// having DefaultRole. Without RBAC all users are admins.
+type RBAC struct {
+	// DefaultRole defaults to read-only
+	DefaultRole string           `yaml:"default_role,omitempty"`
+	Assignments []RoleAssignment `yaml:"assignments"`
+}
+
+// RoleAssignment gives a role to a user, or to the users with a role of the
+// external authentication, optionally restricting their changes to sections
+type RoleAssignment struct {
+	// User is a user of the userlist or of the external authentication, the
+	// tokens of the external authentication being refused for the users of
+	// the userlist
+	User string `yaml:"user,omitempty"`
+	// ExternalRole is a role of the external authentication, such as a role
+	// of the roles claim of the tokens
+	ExternalRole string `yaml:"external_role,omitempty"`
+	Role         string `yaml:"role"`
+	// Scopes restrict the changes to the sections whose name has a prefix, as
+	// type:prefix such as backend:app_, all sections being allowed when empty
+	Scopes []string `yaml:"scopes,omitempty"`
+}
+
+// Authorize returns an error when the user with the roles of the external
+// authentication is not allowed to send a request with method to the path
+// pattern, sectionType and name being the section it changes, empty when it
+// changes none or the whole configuration. Any assignment of the user may
+// allow the request, the self-service endpoints being allowed to every user.
+func (r *RBAC) Authorize(method, path, sectionType, name, user string, roles []string) error {
+	if selfServicePaths[path] {
+		return nil
+	}
+	read := method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+	for _, a := range r.assignments(user, roles) {
+		if a.allows(read, path, sectionType, name) {
+			return nil
+		}
+	}
+	if sectionType != "" && name != "" {
+		return api_errors.New(http.StatusForbidden, "user %s is not allowed to change %s %s", user, sectionType, name)
+	}
+	return api_errors.New(http.StatusForbidden, "user %s is not allowed to %s %s", user, method, path)
+}
+
+// Admin reports whether the user with the roles of the external
+// authentication is an admin of every section, all users being admins
+// without RBAC
+func (r *RBAC) Admin(user string, roles []string) bool {
+	if r == nil {
+		return true
+	}
+	for _, a := range r.assignments(user, roles) {
+		if a.Role == RoleAdmin && len(a.Scopes) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// assignments returns the assignments of the user with the roles of the
+// external authentication, the default role when there are none
+func (r *RBAC) assignments(user string, roles []string) []RoleAssignment {
+	assignments := make([]RoleAssignment, 0)
+	for _, a := range r.Assignments {
+		if a.User != "" && a.User == user || a.ExternalRole != "" && containsString(roles, a.ExternalRole) {
+			assignments = append(assignments, a)
+		}
+	}
+	if len(assignments) == 0 {
+		role := r.DefaultRole
+		if role == "" {
+			role = RoleReadOnly
+		}
+		assignments = append(assignments, RoleAssignment{Role: role})
+	}
+	return assignments
+}
+
+func (a RoleAssignment) allows(read bool, path, sectionType, name string) bool {
+	switch a.Role {
+	case RoleAdmin:
+	case RoleOperator:
+		if !read && !strings.HasPrefix(path, "/services/haproxy/runtime/") {
+			return false
+		}
+	default:
+		return read
+	}
+	// the changes of a transaction are checked when they are staged, the
+	// handlers restrict the scoped users to their own transactions, and the
+	// batch handler authorizes every operation with the section it changes
+	if read || len(a.Scopes) == 0 || strings.HasPrefix(path, "/services/haproxy/transactions") {
+		return true
+	}
+	if path == "/services/haproxy/configuration/batch" && sectionType == "" {
+		return true
+	}
+	if sectionType == "" || name == "" {
+		return false
+	}
+	for _, s := range a.Scopes {
+		parts := strings.SplitN(s, ":", 2)
+		if len(parts) == 2 && parts[0] == sectionType && strings.HasPrefix(name, parts[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns the errors of the roles, assignments and scopes
+func (r *RBAC) check() []string {
+	errs := make([]string, 0)
+	if r.DefaultRole != "" && !validRole(r.DefaultRole) {
+		errs = append(errs, fmt.Sprintf("rbac.default_role: invalid role %s, expected read-only, operator or admin", r.DefaultRole))
+	}
+	for i, a := range r.Assignments {
+		if (a.User == "") == (a.ExternalRole == "") {
+			errs = append(errs, fmt.Sprintf("rbac.assignments[%d]: expected either a user or an external_role", i))
+		}
+		if !validRole(a.Role) {
+			errs = append(errs, fmt.Sprintf("rbac.assignments[%d].role: invalid role %s, expected read-only, operator or admin", i, a.Role))
+		}
+		for j, s := range a.Scopes {
+			parts := strings.SplitN(s, ":", 2)
+			if len(parts) != 2 || !scopeSectionTypes[parts[0]] {
+				errs = append(errs, fmt.Sprintf("rbac.assignments[%d].scopes[%d]: invalid scope %s, expected type:prefix, type being backend, frontend, listen, resolvers or peers", i, j, s))
+			}
+		}
+	}
+	return errs
+}
+
+func validRole(role string) bool {
+	return role == RoleReadOnly || role == RoleOperator || role == RoleAdmin
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"testing"
+)
+
+func TestRBACAuthorize(t *testing.T) {
+	rbac := &RBAC{
+		Assignments: []RoleAssignment{
+			{User: "admin", Role: RoleAdmin},
+			{User: "ops", Role: RoleOperator},
+			{User: "app", Role: RoleAdmin, Scopes: []string{"backend:app_"}},
+			{ExternalRole: "sre", Role: RoleOperator},
+		},
+	}
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		sectionType string
+		section     string
+		user        string
+		roles       []string
+		allowed     bool
+	}{
+		{"admin changes anything", "PUT", "/services/haproxy/configuration/global", "", "", "admin", nil, true},
+		{"default role reads", "GET", "/services/haproxy/configuration/backends", "", "", "guest", nil, true},
+		{"default role does not change", "POST", "/services/haproxy/configuration/backends", "backend", "b", "guest", nil, false},
+		{"self service", "PUT", "/users/self/password", "", "", "guest", nil, true},
+		{"operator changes the runtime", "PUT", "/services/haproxy/runtime/servers/{name}", "backend", "b", "ops", nil, true},
+		{"operator does not change the configuration", "PUT", "/services/haproxy/configuration/backends/{name}", "backend", "b", "ops", nil, false},
+		{"external role", "PUT", "/services/haproxy/runtime/servers/{name}", "backend", "b", "someone", []string{"sre"}, true},
+		{"scope prefix", "PUT", "/services/haproxy/configuration/backends/{name}", "backend", "app_web", "app", nil, true},
+		{"scope other prefix", "PUT", "/services/haproxy/configuration/backends/{name}", "backend", "db", "app", nil, false},
+		{"scope other type", "PUT", "/services/haproxy/configuration/frontends/{name}", "frontend", "app_web", "app", nil, false},
+		{"scope without section", "PUT", "/services/haproxy/configuration/global", "", "", "app", nil, false},
+		{"scope whole configuration", "POST", "/services/haproxy/configuration/raw", "", "", "app", nil, false},
+		{"scope transactions", "PUT", "/services/haproxy/transactions/{id}", "", "", "app", nil, true},
+		{"scope batch", "POST", "/services/haproxy/configuration/batch", "", "", "app", nil, true},
+		{"scope batch operation", "POST", "/services/haproxy/configuration/batch", "backend", "app_web", "app", nil, true},
+		{"scope batch other operation", "DELETE", "/services/haproxy/configuration/batch", "backend", "db", "app", nil, false},
+		{"operator batch", "POST", "/services/haproxy/configuration/batch", "", "", "ops", nil, false},
+		{"scope reads", "GET", "/services/haproxy/configuration/global", "", "", "app", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rbac.Authorize(tt.method, tt.path, tt.sectionType, tt.section, tt.user, tt.roles)
+			if (err == nil) != tt.allowed {
+				t.Fatalf("allowed %v, got error %v", tt.allowed, err)
+			}
+		})
+	}
+}
+
+func TestRBACAdmin(t *testing.T) {
+	rbac := &RBAC{
+		Assignments: []RoleAssignment{
+			{User: "admin", Role: RoleAdmin},
+			{User: "app", Role: RoleAdmin, Scopes: []string{"backend:app_"}},
+			{ExternalRole: "root", Role: RoleAdmin},
+		},
+	}
+	tests := []struct {
+		user  string
+		roles []string
+		admin bool
+	}{
+		{"admin", nil, true},
+		{"app", nil, false},
+		{"guest", nil, false},
+		{"someone", []string{"root"}, true},
+	}
+	for _, tt := range tests {
+		if rbac.Admin(tt.user, tt.roles) != tt.admin {
+			t.Errorf("%s: expected admin %v", tt.user, tt.admin)
+		}
+	}
+	var none *RBAC
+	if !none.Admin("guest", nil) {
+		t.Error("all users are admins without RBAC")
+	}
+}
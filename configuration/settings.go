@@ -0,0 +1,208 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// SourceDefault is a setting left unset
+	SourceDefault = "default"
+	// SourceFlag is a command line option set with a flag
+	SourceFlag = "flag"
+	// SourceEnv is a command line option set with an environment variable
+	SourceEnv = "env"
+	// SourceFile is a setting of the dataplane configuration file
+	SourceFile = "file"
+	// SourceAPI is a setting changed with the API since the API started
+	SourceAPI = "api"
+)
+
+// secretSetting matches the names of the settings redacted in dumps
+var secretSetting = regexp.MustCompile(`(^|\.)([a-z_]*(password|secret|token)[a-z_]*|bootstrap_key|active_bootstrap_key)(\[\d+\])?$`)
+
+// Setting is an effective setting of the API and where its value comes from
+type Setting struct {
+	Name     string
+	Value    string
+	Source   string
+	Redacted bool
+}
+
+type settingSources struct {
+	mu sync.Mutex
+	// options are the sources of the command line options set by the user
+	options map[string]string
+	// file and loaded are the flattened settings of the dataplane
+	// configuration file, as written and once loaded with its defaults
+	file   map[string]string
+	loaded map[string]string
+}
+
+// SetOptionSource records that the command line option with the long name
+// name was set from source
+func (c *Configuration) SetOptionSource(name, source string) {
+	c.sources.mu.Lock()
+	defer c.sources.mu.Unlock()
+	if c.sources.options == nil {
+		c.sources.options = make(map[string]string)
+	}
+	c.sources.options[name] = source
+}
+
+// Settings returns the effective settings of the API, haproxyOptions being
+// the HAProxy options with the overrides of the environment applied
+func (c *Configuration) Settings(haproxyOptions HAProxyConfiguration) ([]Setting, error) {
+	settings := make([]Setting, 0)
+	c.optionsMu.RLock()
+	logging, api := c.Logging, c.APIOptions
+	c.optionsMu.RUnlock()
+	groups := []struct {
+		prefix  string
+		options interface{}
+	}{
+		{"haproxy", haproxyOptions},
+		{"logging", logging},
+		{"api", api},
+	}
+	c.sources.mu.Lock()
+	for _, g := range groups {
+		for _, o := range commandLineOptions(g.options) {
+			name := o.Key.(string)
+			source, ok := c.sources.options[name]
+			if !ok {
+				source = SourceDefault
+			}
+			settings = append(settings, Setting{Name: g.prefix + "." + name, Value: fmt.Sprint(o.Value), Source: source})
+		}
+	}
+	for name, value := range map[string]interface{}{"host": c.Server.Host, "port": c.Server.Port} {
+		source, ok := c.sources.options[name]
+		if !ok {
+			source = SourceDefault
+		}
+		settings = append(settings, Setting{Name: "server." + name, Value: fmt.Sprint(value), Source: source})
+	}
+	c.sources.mu.Unlock()
+
+	current, err := c.flatten()
+	if err != nil {
+		return nil, err
+	}
+	c.sources.mu.Lock()
+	for name, value := range current {
+		s := Setting{Name: name, Value: value, Source: SourceAPI}
+		if loaded, ok := c.sources.loaded[name]; ok && loaded == value {
+			s.Source = SourceDefault
+			if _, ok := c.sources.file[name]; ok {
+				s.Source = SourceFile
+			}
+		}
+		settings = append(settings, s)
+	}
+	c.sources.mu.Unlock()
+
+	for i := range settings {
+		if secretSetting.MatchString(settings[i].Name) && settings[i].Value != "" {
+			settings[i].Value = "******"
+			settings[i].Redacted = true
+		}
+	}
+	sort.SliceStable(settings, func(i, j int) bool {
+		return settings[i].Name < settings[j].Name
+	})
+	return settings, nil
+}
+
+// loadSources records the settings of the dataplane configuration file data
+// and the ones of c once loaded
+func (c *Configuration) loadSources(data []byte) error {
+	file := make(map[string]string)
+	if len(data) > 0 {
+		var raw interface{}
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		flattenSetting("", raw, file)
+	}
+	loaded, err := c.flatten()
+	if err != nil {
+		return err
+	}
+	c.sources.mu.Lock()
+	defer c.sources.mu.Unlock()
+	c.sources.file = file
+	c.sources.loaded = loaded
+	return nil
+}
+
+// flatten returns the settings of c saved in the dataplane configuration file
+// by their dotted names
+func (c *Configuration) flatten() (map[string]string, error) {
+	c.optionsMu.RLock()
+	data, err := yaml.Marshal(c)
+	c.optionsMu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	settings := make(map[string]string)
+	flattenSetting("", raw, settings)
+	// the rotation history is the state of the cluster mode, not a setting,
+	// the command line options are listed with their source
+	for name := range settings {
+		if strings.HasPrefix(name, "bootstrap_key_history.") {
+			delete(settings, name)
+		}
+		for _, group := range optionGroups {
+			if strings.HasPrefix(name, group+".") {
+				delete(settings, name)
+			}
+		}
+	}
+	return settings, nil
+}
+
+func flattenSetting(name string, value interface{}, settings map[string]string) {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		for k, item := range v {
+			key := fmt.Sprint(k)
+			if name != "" {
+				key = name + "." + key
+			}
+			flattenSetting(key, item, settings)
+		}
+	case []interface{}:
+		for i, item := range v {
+			flattenSetting(fmt.Sprintf("%s[%d]", name, i), item, settings)
+		}
+	case nil:
+		settings[name] = ""
+	default:
+		settings[name] = fmt.Sprint(v)
+	}
+}
@@ -16,73 +16,79 @@
 package configuration
 
 import (
+	"github.com/haproxytech/dataplaneapi/system"
 	log "github.com/sirupsen/logrus"
 
 	"os"
-	"os/signal"
 	"sync"
-	"syscall"
-	"time"
 )
 
+// ChanNotify broadcasts change notifications to named subscribers.
+// Notifying never blocks: a subscriber which did not consume the previous
+// notification yet receives a single one for both. A subscriber which missed
+// the last notification, not being subscribed yet, receives it on subscribing.
 type ChanNotify struct {
-	mu         sync.RWMutex
+	mu         sync.Mutex
 	subsribers map[string]chan struct{}
+	// sequence counts the notifications, delivered holds the last one
+	// delivered to each subscriber
+	sequence  uint64
+	delivered map[string]uint64
 }
 
 func NewChanNotify() *ChanNotify {
 	cn := &ChanNotify{}
 	cn.subsribers = make(map[string]chan struct{})
+	cn.delivered = make(map[string]uint64)
 	return cn
 }
 
+// Subscribe returns the channel of the subscriber name, the channel of a
+// previous subscriber of the same name is closed
 func (cn *ChanNotify) Subscribe(name string) chan struct{} {
 	cn.mu.Lock()
 	defer cn.mu.Unlock()
 
+	if c, ok := cn.subsribers[name]; ok {
+		close(c)
+	}
 	c := make(chan struct{}, 1)
 	cn.subsribers[name] = c
+	if cn.delivered[name] < cn.sequence {
+		c <- struct{}{}
+		cn.delivered[name] = cn.sequence
+	}
 	return c
 }
 
+// UnSubscribeAll closes the channels of all subscribers
 func (cn *ChanNotify) UnSubscribeAll() {
 	cn.mu.Lock()
 	defer cn.mu.Unlock()
+	for _, c := range cn.subsribers {
+		close(c)
+	}
 	cn.subsribers = make(map[string]chan struct{})
 }
 
 func (cn *ChanNotify) Notify() {
-	cn.notify(0)
-}
-
-func (cn *ChanNotify) NotifyWithRetry() {
-	cn.notify(3)
-}
-
-func (cn *ChanNotify) notify(numTry int) {
-	if numTry < 0 {
-		return
-	}
-	cn.mu.RLock()
-	defer cn.mu.RUnlock()
-
-	if len(cn.subsribers) == 0 {
-		go func() {
-			time.Sleep(2 * time.Second)
-			numTry--
-			cn.notify(numTry)
-		}()
-		return
-	}
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
 
-	for _, c := range cn.subsribers {
-		c <- struct{}{}
+	cn.sequence++
+	for name, c := range cn.subsribers {
+		select {
+		case c <- struct{}{}:
+		default:
+			// the pending notification covers this one
+		}
+		cn.delivered[name] = cn.sequence
 	}
 }
 
 func (c *Configuration) initSignalHandler() {
 	osSignals := make(chan os.Signal, 1)
-	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
+	system.Notify(osSignals, system.ShutdownSignals...)
 
 	go func() {
 		sig := <-osSignals
@@ -91,10 +97,25 @@ func (c *Configuration) initSignalHandler() {
 	}()
 
 	osSignals2 := make(chan os.Signal, 1)
-	signal.Notify(osSignals2, syscall.SIGUSR1)
+	system.Notify(osSignals2, system.ReloadSignal)
 
 	go func() {
 		<-osSignals2
 		c.Notify.Reload.Notify()
 	}()
+
+	osSignals3 := make(chan os.Signal, 1)
+	system.Notify(osSignals3, system.HangupSignal)
+
+	go func() {
+		for range osSignals3 {
+			changed, err := c.ReloadFile()
+			if err != nil {
+				log.Warningf("Keeping the current configuration, cannot reload the dataplane configuration file: %s", err.Error())
+			} else {
+				log.Infof("Dataplane configuration file reloaded, changed: %v", changed)
+			}
+			c.Notify.Hangup.Notify()
+		}
+	}()
 }
@@ -20,6 +20,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/haproxytech/dataplaneapi/misc"
 
@@ -31,24 +32,65 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-var usersStore *Users
+var (
+	usersStore   *Users
+	usersStoreMu sync.Mutex
+)
 
 type Users struct {
 	mu    sync.Mutex
+	cfg   *Configuration
 	users []types.User
+	// file the users are read from and its modification time when read,
+	// the users are read again once it changes
+	file    string
+	modTime time.Time
 }
 
-func GetUsersStore() *Users {
+// GetUsersStore returns the users of the configuration of the process, they
+// are read again on the next call when reading them fails
+func GetUsersStore() (*Users, error) {
+	usersStoreMu.Lock()
+	defer usersStoreMu.Unlock()
 	if usersStore == nil {
-		usersStore = &Users{}
-		if err := usersStore.Init(); err != nil {
-			log.Fatalf("Error initiating users: %s", err.Error())
+		u, err := NewUsers(Get())
+		if err != nil {
+			return nil, fmt.Errorf("error initiating users: %w", err)
 		}
+		usersStore = u
+	}
+	return usersStore, nil
+}
+
+// NewUsers returns the users of the userlist of cfg
+func NewUsers(cfg *Configuration) (*Users, error) {
+	u := &Users{cfg: cfg}
+	if err := u.Init(); err != nil {
+		return nil, err
 	}
-	return usersStore
+	return u, nil
 }
 
+// GetUsers returns the users, reading them again first when the file they
+// come from changed so that credentials are rotated without a restart
 func (u *Users) GetUsers() []types.User {
+	u.mu.Lock()
+	file, modTime := u.file, u.modTime
+	u.mu.Unlock()
+	if file != "" {
+		if fi, err := os.Stat(file); err == nil && !fi.ModTime().Equal(modTime) {
+			if err := u.Init(); err != nil {
+				log.Warningf("keeping the previous users, cannot read them again from %s: %s", file, err.Error())
+				u.mu.Lock()
+				u.modTime = fi.ModTime()
+				u.mu.Unlock()
+			} else {
+				log.Infof("users read again from %s", file)
+			}
+		}
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
 	return u.users
 }
 
@@ -64,10 +106,45 @@ func (u *Users) setUser(data common.ParserData, file string) error {
 	if len(users) == 0 {
 		return fmt.Errorf("no users configured in %s", file)
 	}
+	var modTime time.Time
+	if fi, err := os.Stat(file); err == nil {
+		modTime = fi.ModTime()
+	}
+	u.mu.Lock()
 	u.users = users
+	u.file = file
+	u.modTime = modTime
+	u.mu.Unlock()
 	return nil
 }
 
+// dataplaneUsers returns the users of the dataplane configuration file, read
+// again from the file once the API started
+func (u *Users) dataplaneUsers() ([]types.User, error) {
+	cfg := u.cfg
+	apiUsers := cfg.apiUsers()
+	u.mu.Lock()
+	loaded := u.file != ""
+	u.mu.Unlock()
+	if loaded && cfg.HAProxy.DataplaneConfig != "" {
+		check, err := CheckFile(cfg.HAProxy.DataplaneConfig)
+		if err != nil {
+			return nil, err
+		}
+		if err := check.Err(); err != nil {
+			return nil, err
+		}
+		apiUsers = check.loaded.Users
+		// saving the configuration must not restore the previous users
+		cfg.setAPIUsers(apiUsers)
+	}
+	users := make([]types.User, 0, len(apiUsers))
+	for _, au := range apiUsers {
+		users = append(users, types.User{Name: au.Name, Password: au.Password, IsInsecure: au.Insecure})
+	}
+	return users, nil
+}
+
 func (u *Users) saveUsers(userlist, file string, user common.ParserData) error {
 	p := &parser.Parser{}
 	if err := p.LoadData(file); err != nil {
@@ -102,48 +179,55 @@ func (u *Users) createUserFile(file string) error {
 }
 
 func (u *Users) Init() error {
-	cfg := Get()
+	haproxyOptions := u.cfg.HAProxyOptions()
+	users, err := u.dataplaneUsers()
+	if err != nil {
+		return err
+	}
+	if len(users) > 0 {
+		return u.setUser(users, haproxyOptions.DataplaneConfig)
+	}
 	p := &parser.Parser{}
-	if cfg.HAProxy.UserListFile != "" {
+	if haproxyOptions.UserListFile != "" {
 		//if userlist file doesn't exists
-		if _, err := os.Stat(cfg.HAProxy.UserListFile); os.IsNotExist(err) {
+		if _, err := os.Stat(haproxyOptions.UserListFile); os.IsNotExist(err) {
 			//get user from HAProxy config file
-			if err := p.LoadData(cfg.HAProxy.ConfigFile); err != nil {
-				return fmt.Errorf("cannot read %s, err: %s", cfg.HAProxy.ConfigFile, err.Error())
+			if err := p.LoadData(haproxyOptions.ConfigFile); err != nil {
+				return fmt.Errorf("cannot read %s, err: %s", haproxyOptions.ConfigFile, err.Error())
 			}
-			data, err := p.Get(parser.UserList, cfg.HAProxy.Userlist, "user")
+			data, err := p.Get(parser.UserList, haproxyOptions.Userlist, "user")
 			if err != nil {
-				return fmt.Errorf("error reading userlist %v userlist in conf: %s", cfg.HAProxy.ConfigFile, err.Error())
+				return fmt.Errorf("error reading userlist %v userlist in conf: %s", haproxyOptions.ConfigFile, err.Error())
 			}
-			err = u.createUserFile(cfg.HAProxy.UserListFile)
+			err = u.createUserFile(haproxyOptions.UserListFile)
 			if err != nil {
 				return err
 			}
-			err = u.saveUsers(cfg.HAProxy.Userlist, cfg.HAProxy.UserListFile, data)
+			err = u.saveUsers(haproxyOptions.Userlist, haproxyOptions.UserListFile, data)
 			if err != nil {
 				return err
 			}
-			return u.setUser(data, cfg.HAProxy.UserListFile)
+			return u.setUser(data, haproxyOptions.UserListFile)
 		}
 		//if userlist file exists
-		if err := p.LoadData(cfg.HAProxy.UserListFile); err != nil {
-			return fmt.Errorf("cannot read %s, err: %s", cfg.HAProxy.UserListFile, err.Error())
+		if err := p.LoadData(haproxyOptions.UserListFile); err != nil {
+			return fmt.Errorf("cannot read %s, err: %s", haproxyOptions.UserListFile, err.Error())
 		}
-		data, err := p.Get(parser.UserList, cfg.HAProxy.Userlist, "user")
+		data, err := p.Get(parser.UserList, haproxyOptions.Userlist, "user")
 		if err != nil {
-			return fmt.Errorf("no users configured in %v, error: %s", cfg.HAProxy.UserListFile, err.Error())
+			return fmt.Errorf("no users configured in %v, error: %s", haproxyOptions.UserListFile, err.Error())
 		}
-		return u.setUser(data, cfg.HAProxy.UserListFile)
+		return u.setUser(data, haproxyOptions.UserListFile)
 	}
 	//get user from HAProxy config
-	if err := p.LoadData(cfg.HAProxy.ConfigFile); err != nil {
-		return fmt.Errorf("cannot read %s, err: %s", cfg.HAProxy.ConfigFile, err.Error())
+	if err := p.LoadData(haproxyOptions.ConfigFile); err != nil {
+		return fmt.Errorf("cannot read %s, err: %s", haproxyOptions.ConfigFile, err.Error())
 	}
-	user, err := p.Get(parser.UserList, cfg.HAProxy.Userlist, "user")
+	user, err := p.Get(parser.UserList, haproxyOptions.Userlist, "user")
 	if err != nil {
-		return fmt.Errorf("no users configured in %v, error: %s", cfg.HAProxy.ConfigFile, err.Error())
+		return fmt.Errorf("no users configured in %v, error: %s", haproxyOptions.ConfigFile, err.Error())
 	}
-	return u.setUser(user, cfg.HAProxy.ConfigFile)
+	return u.setUser(user, haproxyOptions.ConfigFile)
 }
 
 //findUser searches user by its name. If found, returns user, otherwise returns an error.
@@ -156,8 +240,23 @@ func findUser(userName string, users []types.User) (*types.User, error) {
 	return nil, api_errors.New(401, "no configured users")
 }
 
+// IsUser returns true if user is a user of the API, authenticated with its
+// password
+func IsUser(user string) bool {
+	store, err := GetUsersStore()
+	if err != nil {
+		return false
+	}
+	_, err = findUser(user, store.GetUsers())
+	return err == nil
+}
+
 func AuthenticateUser(user string, pass string) (interface{}, error) {
-	users := GetUsersStore().GetUsers()
+	store, err := GetUsersStore()
+	if err != nil {
+		return nil, err
+	}
+	users := store.GetUsers()
 	if len(users) == 0 {
 		return nil, api_errors.New(401, "no configured users")
 	}
@@ -208,3 +307,85 @@ func checkPassword(pass, storedPass string) bool {
 
 	return false
 }
+
+// HashPassword returns the SHA-512 crypt(3) hash of password with a random salt
+func HashPassword(password string) (string, error) {
+	return crypt.SHA512.New().Generate([]byte(password), nil)
+}
+
+// InHAProxyConfiguration reports whether the users are read from the userlist
+// of the HAProxy configuration, which is changed with a transaction
+func (u *Users) InHAProxyConfiguration() bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.file == u.cfg.HAProxy.ConfigFile
+}
+
+// SetPassword replaces the password of the user name with hash in the
+// dataplane configuration file or in the userlist file the users are read
+// from, and reads the users again
+func (u *Users) SetPassword(name, hash string) error {
+	cfg := u.cfg
+	haproxyOptions := cfg.HAProxyOptions()
+	u.mu.Lock()
+	file := u.file
+	u.mu.Unlock()
+	switch file {
+	case haproxyOptions.DataplaneConfig:
+		users := append([]APIUser{}, cfg.apiUsers()...)
+		found := false
+		for i := range users {
+			if users[i].Name == name {
+				users[i].Password = hash
+				users[i].Insecure = false
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("user %s not found in %s", name, file)
+		}
+		cfg.setAPIUsers(users)
+		if err := cfg.Save(); err != nil {
+			return err
+		}
+	case haproxyOptions.UserListFile:
+		p := &parser.Parser{}
+		if err := p.LoadData(file); err != nil {
+			return fmt.Errorf("cannot read %s, err: %s", file, err.Error())
+		}
+		if err := SetUserlistPassword(p, haproxyOptions.Userlist, name, hash); err != nil {
+			return err
+		}
+		if err := p.Save(file); err != nil {
+			return fmt.Errorf("cannot save %s, err: %s", file, err.Error())
+		}
+	default:
+		return fmt.Errorf("users are read from the HAProxy configuration %s", file)
+	}
+	return u.Init()
+}
+
+// SetUserlistPassword replaces the password of the user name of userlist in
+// the configuration of p with hash
+func SetUserlistPassword(p *parser.Parser, userlist, name, hash string) error {
+	data, err := p.Get(parser.UserList, userlist, "user")
+	if err != nil {
+		return fmt.Errorf("no users configured in userlist %s, error: %s", userlist, err.Error())
+	}
+	users, ok := data.([]types.User)
+	if !ok {
+		return fmt.Errorf("error reading users of userlist %s", userlist)
+	}
+	found := false
+	for i := range users {
+		if users[i].Name == name {
+			users[i].Password = hash
+			users[i].IsInsecure = false
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("user %s not found in userlist %s", name, userlist)
+	}
+	return p.Set(parser.UserList, userlist, "user", users)
+}
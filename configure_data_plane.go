@@ -24,22 +24,23 @@ import (
 	"hash/fnv"
 	"io/ioutil"
 	"math/rand"
+	"net"
 	"net/http"
 	"os"
-	"os/signal"
 	"path/filepath"
 	"runtime/debug"
 	"strconv"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi2"
 	"github.com/getkin/kin-openapi/openapi2conv"
 	"github.com/haproxytech/dataplaneapi/adapters"
+	"github.com/haproxytech/dataplaneapi/auth"
 	service_discovery "github.com/haproxytech/dataplaneapi/discovery"
 	"github.com/haproxytech/dataplaneapi/operations/specification"
 	"github.com/haproxytech/dataplaneapi/operations/specification_openapiv3"
+	"github.com/haproxytech/dataplaneapi/policy"
 	"github.com/haproxytech/models/v2"
 
 	log "github.com/sirupsen/logrus"
@@ -54,11 +55,19 @@ import (
 	"github.com/haproxytech/client-native/v2/configuration"
 	runtime_api "github.com/haproxytech/client-native/v2/runtime"
 	dataplaneapi_config "github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/fleet"
+	"github.com/haproxytech/dataplaneapi/gitmode"
 	"github.com/haproxytech/dataplaneapi/handlers"
 	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/hooks"
+	"github.com/haproxytech/dataplaneapi/metrics"
+	"github.com/haproxytech/dataplaneapi/probes"
+	"github.com/haproxytech/dataplaneapi/snmp"
+	"github.com/haproxytech/dataplaneapi/system"
 
 	errors "github.com/go-openapi/errors"
 	runtime "github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/security"
 	swag "github.com/go-openapi/swag"
 	"github.com/rs/cors"
 
@@ -74,8 +83,16 @@ import (
 
 var Version string
 var BuildTime string
+var GitCommit string
+var GitTag string
+var GitRepo string
 var mWorker bool = false
 var logFile *os.File
+var auditLogFile *os.File
+
+// clientCertificates verifies and identifies the client certificates of the
+// TLS listener
+var clientCertificates = &auth.ClientCertificates{}
 
 func configureFlags(api *operations.DataPlaneAPI) {
 	cfg := dataplaneapi_config.Get()
@@ -114,17 +131,78 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 		masterRuntime := os.Getenv("HAPROXY_MASTER_CLI")
 		if misc.IsUnixSocketAddr(masterRuntime) {
 			haproxyOptions.MasterRuntime = strings.Replace(masterRuntime, "unix@", "", 1)
+			cfg.SetOptionSource("master-runtime", dataplaneapi_config.SourceEnv)
 		}
 	}
 	cfgFiles := os.Getenv("HAPROXY_CFGFILES")
 	if cfgFiles != "" {
-		cfg := strings.Split(cfgFiles, ";")
-		haproxyOptions.ConfigFile = cfg[0]
+		files := strings.Split(cfgFiles, ";")
+		haproxyOptions.ConfigFile = files[0]
+		cfg.SetOptionSource("config-file", dataplaneapi_config.SourceEnv)
 	}
 	// end overriding options with env variables
 
 	configureLogging(cfg.Logging)
 
+	// the configuration directory can be kept read-only outside of the changes of the API
+	system.ConfigWindow.SetCommands(haproxyOptions.ConfigUnlockCmd, haproxyOptions.ConfigLockCmd)
+	if (haproxyOptions.ConfigUnlockCmd == "") != (haproxyOptions.ConfigLockCmd == "") {
+		log.Warning("Only one of --config-unlock-cmd and --config-lock-cmd is set, the configuration directory is not locked")
+	}
+	// the files are created with the configured modes and labels, confined to their directories
+	fileMode, err := parseMode(haproxyOptions.FileMode)
+	if err != nil {
+		log.Fatalf("Invalid --file-mode: %v", err)
+	}
+	dirMode, err := parseMode(haproxyOptions.DirMode)
+	if err != nil {
+		log.Fatalf("Invalid --dir-mode: %v", err)
+	}
+	system.Files.Set(haproxyOptions.ConfinedFiles, fileMode, dirMode, haproxyOptions.FileLabel)
+	managedFiles := []haproxy.ManagedFile{
+		{Path: haproxyOptions.ConfigFile, Type: "configuration", Write: true, Locked: system.ConfigWindow.Enabled()},
+		{Path: filepath.Dir(haproxyOptions.ConfigFile), Type: "configuration_dir", Dir: true, Write: true, Locked: system.ConfigWindow.Enabled()},
+		{Path: haproxyOptions.TransactionDir, Type: "transaction_dir", Dir: true, Write: true},
+	}
+	if haproxyOptions.DataplaneConfig != "" {
+		managedFiles = append(managedFiles, haproxy.ManagedFile{Path: haproxyOptions.DataplaneConfig, Type: "dataplane", Write: true, Secret: true, Optional: true})
+	}
+	if haproxyOptions.UserListFile != "" {
+		managedFiles = append(managedFiles, haproxy.ManagedFile{Path: haproxyOptions.UserListFile, Type: "userlist", Write: true, Secret: true})
+	}
+	if haproxyOptions.MapsDir != "" {
+		managedFiles = append(managedFiles, haproxy.ManagedFile{Path: haproxyOptions.MapsDir, Type: "maps_dir", Dir: true, Write: true})
+	}
+	managedFiles = append(managedFiles, haproxy.ManagedFile{Path: cfg.GetGeneralStorageDir(), Type: "general_dir", Dir: true, Write: true, Optional: true, Locked: system.ConfigWindow.Enabled()})
+
+	// check the environment before setting up the clients, which fail on the
+	// same problems with less helpful errors
+	preflight := haproxy.Preflight(haproxy.PreflightParams{
+		Bin:            haproxyOptions.HAProxy,
+		ConfigFile:     haproxyOptions.ConfigFile,
+		TransactionDir: haproxyOptions.TransactionDir,
+		MasterRuntime:  haproxyOptions.MasterRuntime,
+		Users: func() error {
+			_, err := dataplaneapi_config.GetUsersStore()
+			return err
+		},
+		Files:    managedFiles,
+		Confined: haproxyOptions.ConfinedFiles,
+	})
+	for _, c := range preflight.Checks {
+		switch c.Status {
+		case haproxy.PreflightFailed:
+			log.Errorf("Preflight check %s failed: %s", c.Name, c.Message)
+		case haproxy.PreflightWarning:
+			log.Warningf("Preflight check %s: %s", c.Name, c.Message)
+		default:
+			log.Debugf("Preflight check %s passed: %s", c.Name, c.Message)
+		}
+	}
+	if !preflight.Passed() && haproxyOptions.PreflightStrict {
+		log.Fatalf("Refusing to start, %d preflight checks failed", len(preflight.Failures()))
+	}
+
 	defer func() {
 		if err := recover(); err != nil {
 			log.Fatalf("Error starting Data Plane API: %s\n Stacktrace from panic: \n%s", err, string(debug.Stack()))
@@ -145,15 +223,20 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	api.TxtProducer = runtime.TextProducer()
+
 	api.ServerShutdown = serverShutdown
 
 	client := configureNativeClient(haproxyOptions, mWorker)
 
-	users := dataplaneapi_config.GetUsersStore()
+	users, err := dataplaneapi_config.GetUsersStore()
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// Handle reload signals
 	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGUSR1, syscall.SIGUSR2)
+	system.Notify(sigs, system.ReloadSignal, system.RereadSignal)
 	go handleSignals(sigs, client, haproxyOptions, users)
 
 	// Sync map physical file with runtime map entries
@@ -161,14 +244,232 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 		go syncMaps(client)
 	}
 
-	// Initialize reload agent
+	// Stop workers of previous reloads still running past the timeout
+	if haproxyOptions.OldWorkersTimeout > 0 {
+		if haproxyOptions.MasterRuntime == "" {
+			log.Warning("Old workers timeout requires the master runtime socket, old workers will not be stopped")
+		} else {
+			go haproxy.CleanupOldWorkers(haproxyOptions.MasterRuntime, haproxyOptions.OldWorkersTimeout)
+		}
+	}
+
+	// daily counters of transactions and reloads
+	dataplaneStats, err := metrics.NewStore(haproxyOptions.DataplaneStatsFile, haproxyOptions.DataplaneStatsRetention)
+	if err != nil {
+		log.Fatalf("Cannot read dataplane stats: %v", err)
+	}
+
+	// Expose HAProxy stats over SNMP through the master agent
+	if cfg.SNMP != nil {
+		baseOID := cfg.SNMP.BaseOID
+		if baseOID == "" {
+			baseOID = snmp.DefaultBaseOID
+		}
+		base, err := snmp.ParseOID(baseOID)
+		if err != nil {
+			log.Fatalf("Cannot set up SNMP subagent: %v", err)
+		}
+		mib := snmp.NewMIB(base, func() models.NativeStats {
+			if client.Runtime == nil {
+				return nil
+			}
+			return client.Runtime.GetStats()
+		})
+		go snmp.NewAgent(cfg.SNMP.AgentXAddress, base, mib.VarBinds).Run()
+	}
+
+	// fleet mode pushes the configuration to the peer APIs of the fleet section
+	var fleetNodes *fleet.Fleet
+	if cfg.Fleet != nil {
+		fleetNodes, err = fleet.New(cfg.Fleet)
+		if err != nil {
+			log.Fatalf("Cannot set up fleet mode: %v", err)
+		}
+		fleetNodes.Config = func() (int64, string, error) {
+			v, data, err := client.Configuration.GetRawConfiguration("", 0)
+			if err != nil {
+				return 0, "", err
+			}
+			return v, data, nil
+		}
+	}
+
+	// Initialize reload agent, reloading the haproxy service of the system
+	// when no command is set
+	reloadCmd, restartCmd := haproxyOptions.ReloadCmd, haproxyOptions.RestartCmd
+	if reloadCmd == "" {
+		reloadCmd = system.DefaultReloadCmd()
+		log.Infof("No reload command set, using %q", reloadCmd)
+	}
+	if restartCmd == "" {
+		restartCmd = system.DefaultRestartCmd()
+		log.Infof("No restart command set, using %q", restartCmd)
+	}
 	ra := &haproxy.ReloadAgent{}
-	if err := ra.Init(haproxyOptions.ReloadDelay, haproxyOptions.ReloadCmd, haproxyOptions.RestartCmd, haproxyOptions.ConfigFile, haproxyOptions.ReloadRetention); err != nil {
+	raParams := haproxy.ReloadAgentParams{
+		Delay:           haproxyOptions.ReloadDelay,
+		ReloadCmd:       reloadCmd,
+		RestartCmd:      restartCmd,
+		ConfigFile:      haproxyOptions.ConfigFile,
+		Retention:       haproxyOptions.ReloadRetention,
+		Retries:         haproxyOptions.ReloadRetries,
+		RetryBackoff:    haproxyOptions.ReloadRetryBackoff,
+		Rollback:        haproxyOptions.ReloadRollback,
+		RollbackWebhook: haproxyOptions.ReloadRollbackWebhook,
+	}
+	if cfg.ChangePlanner != nil {
+		for _, w := range cfg.ChangePlanner.ReloadWindows {
+			window, err := haproxy.ParseReloadWindow(w)
+			if err != nil {
+				log.Fatalf("Error in change planner: %s", err.Error())
+			}
+			raParams.Windows = append(raParams.Windows, window)
+		}
+	}
+	// the configuration file must be the one the API wrote to be reloaded
+	raParams.Integrity = func() error {
+		p, err := client.Configuration.GetParser("")
+		if err != nil {
+			return err
+		}
+		return haproxy.VerifyConfiguration(haproxyOptions.ConfigFile, p)
+	}
+	if haproxyOptions.ReloadRollback {
+		raParams.ProcessCheck = func() error {
+			return checkHAProxyProcess(client)
+		}
+		raParams.OnRollback = func() error {
+			return rereadConfiguration(client.Configuration, haproxyOptions.ConfigFile)
+		}
+	}
+	recorder := metrics.Recorders{dataplaneStats}
+	var statsD *metrics.StatsD
+	if cfg.StatsD != nil {
+		statsD, err = metrics.NewStatsD(cfg.StatsD.Address, cfg.StatsD.Prefix, cfg.StatsD.DogStatsD, cfg.StatsD.Tags)
+		if err != nil {
+			log.Fatalf("Cannot set up statsd metrics: %v", err)
+		}
+		recorder = append(recorder, statsD)
+	}
+	var prometheus *metrics.Prometheus
+	if cfg.Metrics != nil && cfg.Metrics.Enabled {
+		prometheus = metrics.NewPrometheus()
+		prometheus.Transactions = func() (map[string]int64, error) {
+			return transactionsByStatus(client)
+		}
+		prometheus.HAProxyUp = func() (bool, bool) {
+			if client.Runtime == nil {
+				return false, false
+			}
+			return checkHAProxyProcess(client) == nil, true
+		}
+		recorder = append(recorder, prometheus)
+	}
+	raParams.OnReload = recorder.Reload
+	if fleetNodes != nil && fleetNodes.AutoPush {
+		raParams.OnReload = func(succeeded bool, d time.Duration) {
+			recorder.Reload(succeeded, d)
+			if succeeded {
+				go func() {
+					if _, err := fleetNodes.Push(fleetNodes.Atomic, fleetNodes.TwoPhase); err != nil {
+						log.Warningf("Fleet push failed: %s", err.Error())
+					}
+				}()
+			}
+		}
+	}
+	raParams.Version = func() (int64, error) {
+		return client.Configuration.GetVersion("")
+	}
+	if smokeTest := probes.NewRunner(cfg.Probes, func(frontend string) ([]probes.Endpoint, error) {
+		return frontendEndpoints(client, frontend)
+	}); !smokeTest.Empty() {
+		raParams.SmokeTest = smokeTest.Run
+	}
+	if err := ra.Init(raParams); err != nil {
 		log.Fatalf("Cannot initialize reload agent: %v", err)
 	}
 
+	// apply the options changed in the dataplane configuration file on SIGHUP
+	go handleConfigChanges(cfg, ra, users)
+
 	// Applies when the Authorization header is set with the Basic scheme
 	api.BasicAuthAuth = dataplaneapi_config.AuthenticateUser
+	// requests with a bearer token, an API key or a JWT, are authenticated by
+	// the bearer middleware, which sets their principal
+	var authenticateJWT func(token string) (*auth.Principal, error)
+	if cfg.APIAuth != nil {
+		jwt := &auth.JWT{
+			Issuer:     cfg.APIAuth.Issuer,
+			Audience:   cfg.APIAuth.Audience,
+			JWKSURL:    cfg.APIAuth.JWKSURL,
+			UserClaim:  cfg.APIAuth.UserClaim,
+			RolesClaim: cfg.APIAuth.RolesClaim,
+			Refresh:    time.Duration(cfg.APIAuth.JWKSRefresh) * time.Second,
+		}
+		authenticateJWT = jwt.Authenticate
+	}
+	// the users which are not users of the API are authenticated by the
+	// external provider, with their password or a token it issued
+	var provider auth.Provider
+	if cfg.AuthProvider != nil {
+		p, err := cfg.AuthProvider.Provider()
+		if err != nil {
+			log.Fatalf("Cannot set up the authentication provider: %v", err)
+		}
+		provider = p
+	}
+	// the users of the tokens are refused when they are users of the API,
+	// whose role assignments, keys and password would otherwise apply to them
+	external := func(p *auth.Principal, err error) (*auth.Principal, error) {
+		if err == nil && dataplaneapi_config.IsUser(p.User) {
+			return nil, fmt.Errorf("user %s of the token is a user of the API", p.User)
+		}
+		return p, err
+	}
+	authenticateToken := func(token string) (*auth.Principal, error) {
+		if dataplaneapi_config.IsAPIKey(token) {
+			return cfg.APIKeys.Authenticate(token)
+		}
+		if authenticateJWT != nil {
+			p, err := authenticateJWT(token)
+			if err == nil || provider == nil {
+				return external(p, err)
+			}
+		}
+		if provider == nil {
+			return nil, fmt.Errorf("bearer tokens are not configured")
+		}
+		p, err := provider.AuthenticateToken(token)
+		if err == auth.ErrUnsupportedCredentials {
+			return nil, fmt.Errorf("bearer tokens are not configured")
+		}
+		return external(p, err)
+	}
+	var authenticatePassword func(user, pass string) (*auth.Principal, error)
+	authenticateUser := dataplaneapi_config.AuthenticateUser
+	if provider != nil {
+		authenticatePassword = provider.AuthenticatePassword
+		authenticateUser = func(user, pass string) (interface{}, error) {
+			if dataplaneapi_config.IsUser(user) {
+				return dataplaneapi_config.AuthenticateUser(user, pass)
+			}
+			p, err := provider.AuthenticatePassword(user, pass)
+			if err != nil {
+				return nil, err
+			}
+			return p.User, nil
+		}
+	}
+	api.BasicAuthenticator = func(authenticate security.UserPassAuthentication) runtime.Authenticator {
+		basic := security.BasicAuth(authenticate)
+		return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
+			if p := auth.FromRequest(r); p != nil {
+				return true, p.User, nil
+			}
+			return basic.Authenticate(r)
+		})
+	}
 	// setup discovery handlers
 	api.DiscoveryGetAPIEndpointsHandler = discovery.GetAPIEndpointsHandlerFunc(func(params discovery.GetAPIEndpointsParams, principal interface{}) middleware.Responder {
 		uriSlice := strings.SplitN(params.HTTPRequest.RequestURI[1:], "/", 2)
@@ -232,12 +533,87 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 		return discovery.NewGetStatsEndpointsOK().WithPayload(ends)
 	})
 
-	// setup transaction handlers
-	api.TransactionsStartTransactionHandler = &handlers.StartTransactionHandlerImpl{Client: client}
-	api.TransactionsDeleteTransactionHandler = &handlers.DeleteTransactionHandlerImpl{Client: client}
-	api.TransactionsGetTransactionHandler = &handlers.GetTransactionHandlerImpl{Client: client}
-	api.TransactionsGetTransactionsHandler = &handlers.GetTransactionsHandlerImpl{Client: client}
-	api.TransactionsCommitTransactionHandler = &handlers.CommitTransactionHandlerImpl{Client: client, ReloadAgent: ra}
+	// setup transaction handlers, notes and labels are kept next to the transactions
+	transactionMetadata, err := haproxy.NewTransactionMetadataStore(filepath.Join(haproxyOptions.TransactionDir, "metadata"), haproxyOptions.ReloadRetention)
+	if err != nil {
+		log.Fatalf("Cannot set up transaction metadata: %v", err)
+	}
+	// storage files changed in transactions are staged next to them until
+	// committed, SPOE files are stored next to the configuration
+	spoeDir := filepath.Join(filepath.Dir(haproxyOptions.ConfigFile), "spoe")
+	storageAreas := map[string]string{
+		"maps":    haproxyOptions.MapsDir,
+		"general": cfg.GetGeneralStorageDir(),
+		"spoe":    spoeDir,
+	}
+	stagedAreas := map[string]string{"dataplane": ""}
+	for area, dir := range storageAreas {
+		stagedAreas[area] = dir
+	}
+	if haproxyOptions.DataplaneConfig != "" {
+		stagedAreas["dataplane"] = filepath.Dir(haproxyOptions.DataplaneConfig)
+	}
+	transactionStorage, err := haproxy.NewTransactionStorage(filepath.Join(haproxyOptions.TransactionDir, "storage"), stagedAreas)
+	if err != nil {
+		log.Fatalf("Cannot set up transaction storage: %v", err)
+	}
+	// abandoned transactions expire after the transaction TTL
+	reaper := &haproxy.TransactionReaper{
+		TTL:          time.Duration(haproxyOptions.TransactionTTL) * time.Second,
+		Dir:          client.Configuration.TransactionDir,
+		ConfigFile:   client.Configuration.ConfigurationFile,
+		Transactions: client.Configuration.GetTransactions,
+		Delete: func(id string) error {
+			if err := client.Configuration.DeleteTransaction(id); err != nil {
+				return err
+			}
+			return transactionStorage.Discard(id)
+		},
+		Metadata: transactionMetadata,
+	}
+	go reaper.Start()
+	api.TransactionsStartTransactionHandler = &handlers.StartTransactionHandlerImpl{Client: client, Metadata: transactionMetadata, MaxOpen: haproxyOptions.MaxOpenTransactions}
+	api.TransactionsDeleteTransactionHandler = &handlers.DeleteTransactionHandlerImpl{Client: client, Metadata: transactionMetadata, Storage: transactionStorage, RBAC: cfg.RBAC}
+	api.TransactionsGetTransactionHandler = &handlers.GetTransactionHandlerImpl{Client: client, Metadata: transactionMetadata}
+	api.TransactionsGetTransactionsHandler = &handlers.GetTransactionsHandlerImpl{Client: client, Metadata: transactionMetadata}
+	commitTransaction := &handlers.CommitTransactionHandlerImpl{
+		Client:      client,
+		ReloadAgent: ra,
+		Hooks:       hooks.NewRunner(cfg.Hooks),
+		Metrics:     recorder,
+		Metadata:    transactionMetadata,
+		Dir:         haproxyOptions.TransactionDir,
+		Validate: func(file string) (string, error) {
+			return haproxy.CheckConfigurationOutput(haproxyOptions.HAProxy, file)
+		},
+		Storage: transactionStorage,
+		RBAC:    cfg.RBAC,
+	}
+	if cfg.ChangePlanner != nil {
+		if cfg.ChangePlanner.RuntimeApply {
+			commitTransaction.Runtime = func() haproxy.RuntimeExecutor {
+				if client.Runtime == nil {
+					return nil
+				}
+				return client.Runtime
+			}
+		}
+		commitTransaction.InWindow = len(cfg.ChangePlanner.ReloadWindows) > 0
+	}
+	api.TransactionsCommitTransactionHandler = commitTransaction
+	api.TransactionsReplaceTransactionMetadataHandler = &handlers.ReplaceTransactionMetadataHandlerImpl{Client: client, Metadata: transactionMetadata, RBAC: cfg.RBAC}
+	api.TransactionsGetTransactionImpactHandler = &handlers.GetTransactionImpactHandlerImpl{Client: client}
+	api.TransactionsGetTransactionStorageHandler = &handlers.GetTransactionStorageHandlerImpl{Client: client, Storage: transactionStorage}
+
+	// setup snapshot handlers, snapshots are restored into transactions
+	api.SnapshotGetSnapshotHandler = &handlers.GetSnapshotHandlerImpl{Client: client, Areas: storageAreas, DataplaneConfig: haproxyOptions.DataplaneConfig}
+	api.SnapshotRestoreSnapshotHandler = &handlers.RestoreSnapshotHandlerImpl{
+		Client:          client,
+		Metadata:        transactionMetadata,
+		Storage:         transactionStorage,
+		Areas:           storageAreas,
+		DataplaneConfig: haproxyOptions.DataplaneConfig,
+	}
 
 	// setup sites handlers
 	api.SitesCreateSiteHandler = &handlers.CreateSiteHandlerImpl{Client: client, ReloadAgent: ra}
@@ -246,19 +622,145 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 	api.SitesGetSitesHandler = &handlers.GetSitesHandlerImpl{Client: client}
 	api.SitesReplaceSiteHandler = &handlers.ReplaceSiteHandlerImpl{Client: client, ReloadAgent: ra}
 
+	// setup rate limit handlers
+	api.RateLimitGetRateLimitsHandler = &handlers.GetRateLimitsHandlerImpl{Client: client}
+	api.RateLimitGetRateLimitHandler = &handlers.GetRateLimitHandlerImpl{Client: client}
+	api.RateLimitCreateRateLimitHandler = &handlers.CreateRateLimitHandlerImpl{Client: client, ReloadAgent: ra}
+	api.RateLimitReplaceRateLimitHandler = &handlers.ReplaceRateLimitHandlerImpl{Client: client, ReloadAgent: ra}
+	api.RateLimitDeleteRateLimitHandler = &handlers.DeleteRateLimitHandlerImpl{Client: client, ReloadAgent: ra}
+	api.RateLimitGetRateLimitOffendersHandler = &handlers.GetRateLimitOffendersHandlerImpl{Client: client}
+
+	// setup SPOE agent handlers
+	api.SpoeAgentGetSpoeAgentsHandler = &handlers.GetSpoeAgentsHandlerImpl{Client: client, SpoeDir: spoeDir}
+	api.SpoeAgentGetSpoeAgentHandler = &handlers.GetSpoeAgentHandlerImpl{Client: client, SpoeDir: spoeDir}
+	api.SpoeAgentCreateSpoeAgentHandler = &handlers.CreateSpoeAgentHandlerImpl{Client: client, ReloadAgent: ra, SpoeDir: spoeDir}
+	api.SpoeAgentReplaceSpoeAgentHandler = &handlers.ReplaceSpoeAgentHandlerImpl{Client: client, ReloadAgent: ra, SpoeDir: spoeDir}
+	api.SpoeAgentDeleteSpoeAgentHandler = &handlers.DeleteSpoeAgentHandlerImpl{Client: client, ReloadAgent: ra, SpoeDir: spoeDir}
+	api.SpoeAgentEnableSpoeAgentHandler = &handlers.EnableSpoeAgentHandlerImpl{Client: client, ReloadAgent: ra, SpoeDir: spoeDir}
+	api.SpoeAgentDisableSpoeAgentHandler = &handlers.DisableSpoeAgentHandlerImpl{Client: client, ReloadAgent: ra}
+
+	// setup host routing handlers, map files are stored next to the configuration when no maps dir is set
+	mapsDir := haproxyOptions.MapsDir
+	if mapsDir == "" {
+		mapsDir = filepath.Dir(haproxyOptions.ConfigFile)
+	}
+	// setup git mode handlers, the repository commits the changes of every transaction
+	var gitRepository *gitmode.Repository
+	if cfg.Git != nil {
+		gitRepository, err = newGitRepository(cfg.Git, haproxyOptions, spoeDir)
+		if err != nil {
+			log.Fatalf("Cannot set up git mode: %v", err)
+		}
+		// files merged from the remote are applied like a rolled back configuration
+		gitRepository.OnChange = func() error {
+			if err := rereadConfiguration(client.Configuration, haproxyOptions.ConfigFile); err != nil {
+				return err
+			}
+			return ra.ForceReload()
+		}
+		gitRepository.Validate = func(read func(path string) ([]byte, error)) error {
+			data, err := read(haproxyOptions.ConfigFile)
+			if err != nil {
+				return err
+			}
+			file := filepath.Join(haproxyOptions.TransactionDir, ".git_pull.cfg")
+			if err := system.WriteFile(file, data, 0600); err != nil {
+				return err
+			}
+			defer os.Remove(file)
+			return haproxy.CheckConfiguration(haproxyOptions.HAProxy, file)
+		}
+	}
+	api.GitGetGitKnownHostsHandler = &handlers.GetGitKnownHostsHandlerImpl{Repository: gitRepository}
+	api.GitReplaceGitKnownHostsHandler = &handlers.ReplaceGitKnownHostsHandlerImpl{Repository: gitRepository}
+	api.GitTestGitRemoteHandler = &handlers.TestGitRemoteHandlerImpl{Repository: gitRepository}
+	api.GitGetGitConflictsHandler = &handlers.GetGitConflictsHandlerImpl{Repository: gitRepository}
+	api.GitResolveGitConflictsHandler = &handlers.ResolveGitConflictsHandlerImpl{Repository: gitRepository}
+	var webhookSecret string
+	if cfg.Git != nil {
+		webhookSecret = cfg.Git.WebhookSecret
+	}
+	api.GitGitWebhookHandler = &handlers.GitWebhookHandlerImpl{Repository: gitRepository, Secret: webhookSecret}
+
+	// setup fleet handlers
+	api.FleetGetFleetHandler = &handlers.GetFleetHandlerImpl{Fleet: fleetNodes}
+	api.FleetPushFleetHandler = &handlers.PushFleetHandlerImpl{Fleet: fleetNodes}
+	api.FleetGetFleetNodeConfigurationHandler = &handlers.GetFleetNodeConfigurationHandlerImpl{Fleet: fleetNodes}
+
+	// any API takes part in the two-phase pushes of a fleet it is a node of
+	participant, err := fleet.NewParticipant(filepath.Join(haproxyOptions.TransactionDir, "prepared"), func(file string) error {
+		return haproxy.CheckConfiguration(haproxyOptions.HAProxy, file)
+	})
+	if err != nil {
+		log.Fatalf("Cannot set up fleet participant: %v", err)
+	}
+	api.FleetPrepareFleetConfigurationHandler = &handlers.PrepareFleetConfigurationHandlerImpl{Client: client, Participant: participant}
+	api.FleetCommitFleetConfigurationHandler = &handlers.CommitFleetConfigurationHandlerImpl{Client: client, ReloadAgent: ra, Participant: participant}
+	api.FleetAbortFleetConfigurationHandler = &handlers.AbortFleetConfigurationHandlerImpl{Participant: participant}
+
+	api.HostRoutingGetHostRoutesHandler = &handlers.GetHostRoutesHandlerImpl{Client: client, MapsDir: mapsDir}
+	api.HostRoutingGetHostRouteHandler = &handlers.GetHostRouteHandlerImpl{Client: client, MapsDir: mapsDir}
+	api.HostRoutingCreateHostRouteHandler = &handlers.CreateHostRouteHandlerImpl{Client: client, ReloadAgent: ra, MapsDir: mapsDir}
+	api.HostRoutingDeleteHostRouteHandler = &handlers.DeleteHostRouteHandlerImpl{Client: client, ReloadAgent: ra, MapsDir: mapsDir}
+
+	// setup GeoIP handlers, the map is refreshed periodically when its URL is set
+	geoIPMapFile := haproxyOptions.GeoIPMapFile
+	if geoIPMapFile == "" {
+		geoIPMapFile = filepath.Join(mapsDir, "geoip.map")
+	}
+	geoIP := &haproxy.GeoIPUpdater{
+		URL:      haproxyOptions.GeoIPMapURL,
+		MapFile:  geoIPMapFile,
+		Interval: time.Duration(haproxyOptions.GeoIPRefreshInterval) * time.Second,
+		Runtime: func() haproxy.RuntimeExecutor {
+			if client.Runtime == nil {
+				return nil
+			}
+			return client.Runtime
+		},
+	}
+	if haproxyOptions.MasterRuntime != "" {
+		// commands after the first one of a batch have to be sent to the worker too
+		geoIP.WorkerPrefix = "@1 "
+	}
+	go geoIP.Start()
+	api.GeoIPGetGeoIPHandler = &handlers.GetGeoIPHandlerImpl{Updater: geoIP}
+	api.GeoIPRefreshGeoIPHandler = &handlers.RefreshGeoIPHandlerImpl{Updater: geoIP}
+	api.GeoIPGetGeoIPPoliciesHandler = &handlers.GetGeoIPPoliciesHandlerImpl{Client: client, MapFile: geoIPMapFile}
+	api.GeoIPGetGeoIPPolicyHandler = &handlers.GetGeoIPPolicyHandlerImpl{Client: client, MapFile: geoIPMapFile}
+	api.GeoIPReplaceGeoIPPolicyHandler = &handlers.ReplaceGeoIPPolicyHandlerImpl{Client: client, ReloadAgent: ra, MapFile: geoIPMapFile}
+	api.GeoIPDeleteGeoIPPolicyHandler = &handlers.DeleteGeoIPPolicyHandlerImpl{Client: client, ReloadAgent: ra, MapFile: geoIPMapFile}
+
+	// setup maintenance handlers, the ACL files switching it are stored with the maps
+	api.MaintenanceGetMaintenancesHandler = &handlers.GetMaintenancesHandlerImpl{Client: client, MapsDir: mapsDir}
+	api.MaintenanceGetMaintenanceHandler = &handlers.GetMaintenanceHandlerImpl{Client: client, MapsDir: mapsDir}
+	api.MaintenanceReplaceMaintenanceHandler = &handlers.ReplaceMaintenanceHandlerImpl{Client: client, ReloadAgent: ra, MapsDir: mapsDir}
+	api.MaintenanceDeleteMaintenanceHandler = &handlers.DeleteMaintenanceHandlerImpl{Client: client, ReloadAgent: ra, MapsDir: mapsDir}
+
 	// setup backend handlers
 	api.BackendCreateBackendHandler = &handlers.CreateBackendHandlerImpl{Client: client, ReloadAgent: ra}
 	api.BackendDeleteBackendHandler = &handlers.DeleteBackendHandlerImpl{Client: client, ReloadAgent: ra}
 	api.BackendGetBackendHandler = &handlers.GetBackendHandlerImpl{Client: client}
+	api.BackendGetBackendFullHandler = &handlers.GetBackendFullHandlerImpl{Client: client}
 	api.BackendGetBackendsHandler = &handlers.GetBackendsHandlerImpl{Client: client}
 	api.BackendReplaceBackendHandler = &handlers.ReplaceBackendHandlerImpl{Client: client, ReloadAgent: ra}
+	api.BackendGetDynamicCookieKeyHandler = &handlers.GetDynamicCookieKeyHandlerImpl{Client: client}
+	api.BackendReplaceDynamicCookieKeyHandler = &handlers.ReplaceDynamicCookieKeyHandlerImpl{Client: client, ReloadAgent: ra}
+	api.BackendDeleteDynamicCookieKeyHandler = &handlers.DeleteDynamicCookieKeyHandlerImpl{Client: client, ReloadAgent: ra}
+
+	// detect HAProxy version to validate sample expressions and log formats against its keywords
+	sampleValidator := haproxy.NewSampleValidator(haproxyOptions.HAProxy)
 
 	// setup frontend handlers
-	api.FrontendCreateFrontendHandler = &handlers.CreateFrontendHandlerImpl{Client: client, ReloadAgent: ra}
+	api.FrontendCreateFrontendHandler = &handlers.CreateFrontendHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
 	api.FrontendDeleteFrontendHandler = &handlers.DeleteFrontendHandlerImpl{Client: client, ReloadAgent: ra}
 	api.FrontendGetFrontendHandler = &handlers.GetFrontendHandlerImpl{Client: client}
+	api.FrontendGetFrontendFullHandler = &handlers.GetFrontendFullHandlerImpl{Client: client}
 	api.FrontendGetFrontendsHandler = &handlers.GetFrontendsHandlerImpl{Client: client}
-	api.FrontendReplaceFrontendHandler = &handlers.ReplaceFrontendHandlerImpl{Client: client, ReloadAgent: ra}
+	api.FrontendReplaceFrontendHandler = &handlers.ReplaceFrontendHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
+	api.FrontendGetHTTPSRedirectHandler = &handlers.GetHTTPSRedirectHandlerImpl{Client: client}
+	api.FrontendReplaceHTTPSRedirectHandler = &handlers.ReplaceHTTPSRedirectHandlerImpl{Client: client, ReloadAgent: ra}
+	api.FrontendDeleteHTTPSRedirectHandler = &handlers.DeleteHTTPSRedirectHandlerImpl{Client: client, ReloadAgent: ra}
 
 	// setup server handlers
 	api.ServerCreateServerHandler = &handlers.CreateServerHandlerImpl{Client: client, ReloadAgent: ra}
@@ -266,6 +768,8 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 	api.ServerGetServerHandler = &handlers.GetServerHandlerImpl{Client: client}
 	api.ServerGetServersHandler = &handlers.GetServersHandlerImpl{Client: client}
 	api.ServerReplaceServerHandler = &handlers.ReplaceServerHandlerImpl{Client: client, ReloadAgent: ra}
+	api.ServerGetServerNetworkHandler = &handlers.GetServerNetworkHandlerImpl{Client: client}
+	api.ServerReplaceServerNetworkHandler = &handlers.ReplaceServerNetworkHandlerImpl{Client: client, ReloadAgent: ra}
 
 	// setup bind handlers
 	api.BindCreateBindHandler = &handlers.CreateBindHandlerImpl{Client: client, ReloadAgent: ra}
@@ -273,27 +777,55 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 	api.BindGetBindHandler = &handlers.GetBindHandlerImpl{Client: client}
 	api.BindGetBindsHandler = &handlers.GetBindsHandlerImpl{Client: client}
 	api.BindReplaceBindHandler = &handlers.ReplaceBindHandlerImpl{Client: client, ReloadAgent: ra}
+	api.BindGetBindNetworkHandler = &handlers.GetBindNetworkHandlerImpl{Client: client}
+	api.BindReplaceBindNetworkHandler = &handlers.ReplaceBindNetworkHandlerImpl{Client: client, ReloadAgent: ra}
+	api.BindGetBindSSLHandler = &handlers.GetBindSSLHandlerImpl{Client: client}
+	api.BindReplaceBindSSLHandler = &handlers.ReplaceBindSSLHandlerImpl{Client: client, ReloadAgent: ra}
+
+	// setup TLS profile handlers
+	api.TLSProfileGetTLSProfilesHandler = &handlers.GetTLSProfilesHandlerImpl{Config: cfg}
+	api.TLSProfileCreateTLSProfileHandler = &handlers.CreateTLSProfileHandlerImpl{Config: cfg}
+	api.TLSProfileGetTLSProfileHandler = &handlers.GetTLSProfileHandlerImpl{Config: cfg}
+	api.TLSProfileReplaceTLSProfileHandler = &handlers.ReplaceTLSProfileHandlerImpl{Config: cfg}
+	api.TLSProfileDeleteTLSProfileHandler = &handlers.DeleteTLSProfileHandlerImpl{Config: cfg}
+	api.TLSProfileApplyTLSProfileHandler = &handlers.ApplyTLSProfileHandlerImpl{Client: client, ReloadAgent: ra, Config: cfg}
+	api.TLSProfileGetTLSProfileDeviationsHandler = &handlers.GetTLSProfileDeviationsHandlerImpl{Client: client, Config: cfg}
+
+	// setup log format handlers
+	api.LogFormatGetLogFormatsHandler = &handlers.GetLogFormatsHandlerImpl{Config: cfg, Validator: sampleValidator}
+	api.LogFormatCreateLogFormatHandler = &handlers.CreateLogFormatHandlerImpl{Config: cfg, Validator: sampleValidator}
+	api.LogFormatGetLogFormatHandler = &handlers.GetLogFormatHandlerImpl{Config: cfg, Validator: sampleValidator}
+	api.LogFormatReplaceLogFormatHandler = &handlers.ReplaceLogFormatHandlerImpl{Config: cfg, Validator: sampleValidator}
+	api.LogFormatDeleteLogFormatHandler = &handlers.DeleteLogFormatHandlerImpl{Config: cfg}
+	api.LogFormatApplyLogFormatHandler = &handlers.ApplyLogFormatHandlerImpl{Client: client, ReloadAgent: ra, Config: cfg, Validator: sampleValidator}
+	api.LogFormatValidateLogFormatHandler = &handlers.ValidateLogFormatHandlerImpl{Validator: sampleValidator}
+
+	// setup annotation handlers
+	api.AnnotationsGetAnnotationsHandler = &handlers.GetAnnotationsHandlerImpl{Config: cfg}
+	api.AnnotationsGetAnnotationHandler = &handlers.GetAnnotationHandlerImpl{Config: cfg}
+	api.AnnotationsReplaceAnnotationHandler = &handlers.ReplaceAnnotationHandlerImpl{Config: cfg}
+	api.AnnotationsDeleteAnnotationHandler = &handlers.DeleteAnnotationHandlerImpl{Config: cfg}
 
 	// setup http request rule handlers
-	api.HTTPRequestRuleCreateHTTPRequestRuleHandler = &handlers.CreateHTTPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra}
+	api.HTTPRequestRuleCreateHTTPRequestRuleHandler = &handlers.CreateHTTPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
 	api.HTTPRequestRuleDeleteHTTPRequestRuleHandler = &handlers.DeleteHTTPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra}
 	api.HTTPRequestRuleGetHTTPRequestRuleHandler = &handlers.GetHTTPRequestRuleHandlerImpl{Client: client}
 	api.HTTPRequestRuleGetHTTPRequestRulesHandler = &handlers.GetHTTPRequestRulesHandlerImpl{Client: client}
-	api.HTTPRequestRuleReplaceHTTPRequestRuleHandler = &handlers.ReplaceHTTPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra}
+	api.HTTPRequestRuleReplaceHTTPRequestRuleHandler = &handlers.ReplaceHTTPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
 
 	// setup http response rule handlers
-	api.HTTPResponseRuleCreateHTTPResponseRuleHandler = &handlers.CreateHTTPResponseRuleHandlerImpl{Client: client, ReloadAgent: ra}
+	api.HTTPResponseRuleCreateHTTPResponseRuleHandler = &handlers.CreateHTTPResponseRuleHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
 	api.HTTPResponseRuleDeleteHTTPResponseRuleHandler = &handlers.DeleteHTTPResponseRuleHandlerImpl{Client: client, ReloadAgent: ra}
 	api.HTTPResponseRuleGetHTTPResponseRuleHandler = &handlers.GetHTTPResponseRuleHandlerImpl{Client: client}
 	api.HTTPResponseRuleGetHTTPResponseRulesHandler = &handlers.GetHTTPResponseRulesHandlerImpl{Client: client}
-	api.HTTPResponseRuleReplaceHTTPResponseRuleHandler = &handlers.ReplaceHTTPResponseRuleHandlerImpl{Client: client, ReloadAgent: ra}
+	api.HTTPResponseRuleReplaceHTTPResponseRuleHandler = &handlers.ReplaceHTTPResponseRuleHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
 
 	// setup tcp content rule handlers
-	api.TCPRequestRuleCreateTCPRequestRuleHandler = &handlers.CreateTCPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra}
+	api.TCPRequestRuleCreateTCPRequestRuleHandler = &handlers.CreateTCPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
 	api.TCPRequestRuleDeleteTCPRequestRuleHandler = &handlers.DeleteTCPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra}
 	api.TCPRequestRuleGetTCPRequestRuleHandler = &handlers.GetTCPRequestRuleHandlerImpl{Client: client}
 	api.TCPRequestRuleGetTCPRequestRulesHandler = &handlers.GetTCPRequestRulesHandlerImpl{Client: client}
-	api.TCPRequestRuleReplaceTCPRequestRuleHandler = &handlers.ReplaceTCPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra}
+	api.TCPRequestRuleReplaceTCPRequestRuleHandler = &handlers.ReplaceTCPRequestRuleHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
 
 	// setup tcp connection rule handlers
 	api.TCPResponseRuleCreateTCPResponseRuleHandler = &handlers.CreateTCPResponseRuleHandlerImpl{Client: client, ReloadAgent: ra}
@@ -323,6 +855,22 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 	api.FilterGetFiltersHandler = &handlers.GetFiltersHandlerImpl{Client: client}
 	api.FilterReplaceFilterHandler = &handlers.ReplaceFilterHandlerImpl{Client: client, ReloadAgent: ra}
 
+	// setup compression handlers
+	api.CompressionGetCompressionHandler = &handlers.GetCompressionHandlerImpl{Client: client}
+	api.CompressionReplaceCompressionHandler = &handlers.ReplaceCompressionHandlerImpl{Client: client, ReloadAgent: ra}
+
+	// setup security options handlers
+	api.SecurityOptionsGetSecurityOptionsHandler = &handlers.GetSecurityOptionsHandlerImpl{Client: client}
+	api.SecurityOptionsReplaceSecurityOptionsHandler = &handlers.ReplaceSecurityOptionsHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
+	api.SecurityOptionsApplySecurityBaselineHandler = &handlers.ApplySecurityBaselineHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
+
+	// setup bandwidth limit handlers
+	api.BandwidthLimitGetBandwidthLimitsHandler = &handlers.GetBandwidthLimitsHandlerImpl{Client: client}
+	api.BandwidthLimitGetBandwidthLimitHandler = &handlers.GetBandwidthLimitHandlerImpl{Client: client}
+	api.BandwidthLimitCreateBandwidthLimitHandler = &handlers.CreateBandwidthLimitHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
+	api.BandwidthLimitReplaceBandwidthLimitHandler = &handlers.ReplaceBandwidthLimitHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
+	api.BandwidthLimitDeleteBandwidthLimitHandler = &handlers.DeleteBandwidthLimitHandlerImpl{Client: client, ReloadAgent: ra}
+
 	// setup stick rule handlers
 	api.StickRuleCreateStickRuleHandler = &handlers.CreateStickRuleHandlerImpl{Client: client, ReloadAgent: ra}
 	api.StickRuleDeleteStickRuleHandler = &handlers.DeleteStickRuleHandlerImpl{Client: client, ReloadAgent: ra}
@@ -343,6 +891,7 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 	api.ACLGetACLHandler = &handlers.GetACLHandlerImpl{Client: client}
 	api.ACLGetAclsHandler = &handlers.GetAclsHandlerImpl{Client: client}
 	api.ACLReplaceACLHandler = &handlers.ReplaceACLHandlerImpl{Client: client, ReloadAgent: ra}
+	api.ACLValidateACLHandler = &handlers.ValidateACLHandlerImpl{Validator: sampleValidator, ConfigFile: haproxyOptions.ConfigFile}
 
 	// setup resolvers handlers
 	api.ResolverCreateResolverHandler = &handlers.CreateResolverHandlerImpl{Client: client, ReloadAgent: ra}
@@ -373,30 +922,142 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 
 	// setup stats handler
 	api.StatsGetStatsHandler = &handlers.GetStatsHandlerImpl{Client: client}
+	api.StatsGetDataplaneStatsHandler = &handlers.GetDataplaneStatsHandlerImpl{Metrics: dataplaneStats}
 
 	// setup info handler
 	api.InformationGetHaproxyProcessInfoHandler = &handlers.GetHaproxyProcessInfoHandlerImpl{Client: client}
+	api.InformationGetHaproxyProcessesHandler = &handlers.GetHaproxyProcessesHandlerImpl{MasterSocket: haproxyOptions.MasterRuntime}
+	api.InformationStopOldWorkersHandler = &handlers.StopOldWorkersHandlerImpl{MasterSocket: haproxyOptions.MasterRuntime}
+	api.InformationGetPreflightHandler = &handlers.GetPreflightHandlerImpl{Result: preflight}
+	api.InformationGetPrivilegesHandler = &handlers.GetPrivilegesHandlerImpl{Files: managedFiles}
+
+	// setup health handler
+	api.HealthGetHAProxyHealthHandler = &handlers.GetHAProxyHealthHandlerImpl{
+		Client:       client,
+		ReloadAgent:  ra,
+		MasterSocket: haproxyOptions.MasterRuntime,
+		Check:        &haproxy.ConfigurationCheck{Bin: haproxyOptions.HAProxy, File: haproxyOptions.ConfigFile},
+	}
+
+	// setup trace handlers
+	api.TracesGetTracesHandler = &handlers.GetTracesHandlerImpl{Client: client}
+	api.TracesReplaceTraceHandler = &handlers.ReplaceTraceHandlerImpl{Client: client}
+	api.TracesGetRingEventsHandler = &handlers.GetRingEventsHandlerImpl{Client: client, MasterSocket: haproxyOptions.MasterRuntime}
+	api.TracesGetRuntimeLogsHandler = &handlers.GetRuntimeLogsHandlerImpl{Client: client, MasterSocket: haproxyOptions.MasterRuntime}
+
+	// setup certificates handlers
+	api.CertificatesGetSSLCertificatesHandler = &handlers.GetSSLCertificatesHandlerImpl{Client: client}
 
 	// setup raw configuration handlers
 	api.ConfigurationGetHAProxyConfigurationHandler = &handlers.GetRawConfigurationHandlerImpl{Client: client}
 	api.ConfigurationPostHAProxyConfigurationHandler = &handlers.PostRawConfigurationHandlerImpl{Client: client, ReloadAgent: ra}
+	api.ConfigurationGetConfigurationIntegrityHandler = &handlers.GetConfigurationIntegrityHandlerImpl{Client: client, HAProxyOptions: haproxyOptions}
+	api.ConfigurationGetConfigurationCompatibilityHandler = &handlers.GetConfigurationCompatibilityHandlerImpl{Client: client, Validator: sampleValidator}
+	api.ConfigurationMigrateConfigurationHandler = &handlers.MigrateConfigurationHandlerImpl{Client: client}
+	api.ConfigurationImportConfigurationHandler = &handlers.ImportConfigurationHandlerImpl{Client: client}
+	api.ConfigurationApplyConfigurationHandler = &handlers.ApplyConfigurationHandlerImpl{Client: client, ReloadAgent: ra}
+	api.ConfigurationPlanConfigurationHandler = &handlers.PlanConfigurationHandlerImpl{Client: client}
+	applyBatch := &handlers.ApplyBatchHandlerImpl{Client: client, Commit: commitTransaction, CheckChange: cfg.Annotations.CheckChange}
+	if cfg.RBAC != nil {
+		applyBatch.Authorize = cfg.RBAC.Authorize
+	}
+	api.ConfigurationApplyBatchHandler = applyBatch
 
 	// setup global configuration handlers
 	api.GlobalGetGlobalHandler = &handlers.GetGlobalHandlerImpl{Client: client}
 	api.GlobalReplaceGlobalHandler = &handlers.ReplaceGlobalHandlerImpl{Client: client, ReloadAgent: ra}
+	api.GlobalGetThreadingHandler = &handlers.GetThreadingHandlerImpl{Client: client}
+	api.GlobalReplaceThreadingHandler = &handlers.ReplaceThreadingHandlerImpl{Client: client, ReloadAgent: ra, SystemInfo: haproxyOptions.ShowSystemInfo}
+	api.GlobalGetDHParamHandler = &handlers.GetDHParamHandlerImpl{Client: client}
+	api.GlobalReplaceDHParamHandler = &handlers.ReplaceDHParamHandlerImpl{Client: client, ReloadAgent: ra}
+	api.GlobalGetCertificateUpdateHandler = &handlers.GetCertificateUpdateHandlerImpl{Client: client}
+	api.GlobalReplaceCertificateUpdateHandler = &handlers.ReplaceCertificateUpdateHandlerImpl{Client: client, ReloadAgent: ra}
+	api.GlobalGetDeviceDetectionHandler = &handlers.GetDeviceDetectionHandlerImpl{Client: client}
+	api.GlobalReplaceDeviceDetectionHandler = &handlers.ReplaceDeviceDetectionHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
 
 	// setup defaults configuration handlers
 	api.DefaultsGetDefaultsHandler = &handlers.GetDefaultsHandlerImpl{Client: client}
-	api.DefaultsReplaceDefaultsHandler = &handlers.ReplaceDefaultsHandlerImpl{Client: client, ReloadAgent: ra}
+	api.DefaultsReplaceDefaultsHandler = &handlers.ReplaceDefaultsHandlerImpl{Client: client, ReloadAgent: ra, Validator: sampleValidator}
+
+	// setup stats page handlers
+	api.StatsPageGetStatsPageHandler = &handlers.GetStatsPageHandlerImpl{Client: client}
+	api.StatsPageReplaceStatsPageHandler = &handlers.ReplaceStatsPageHandlerImpl{Client: client, ReloadAgent: ra}
+	api.StatsPageGetStatsSocketsHandler = &handlers.GetStatsSocketsHandlerImpl{Client: client}
+	api.StatsPageReplaceStatsSocketsHandler = &handlers.ReplaceStatsSocketsHandlerImpl{Client: client, ReloadAgent: ra}
+
+	// setup listen handlers
+	api.ListenCreateListenHandler = &handlers.CreateListenHandlerImpl{Client: client, ReloadAgent: ra}
+	api.ListenDeleteListenHandler = &handlers.DeleteListenHandlerImpl{Client: client, ReloadAgent: ra}
+	api.ListenGetListenHandler = &handlers.GetListenHandlerImpl{Client: client}
+	api.ListenGetListensHandler = &handlers.GetListensHandlerImpl{Client: client}
+	api.ListenReplaceListenHandler = &handlers.ReplaceListenHandlerImpl{Client: client, ReloadAgent: ra}
+	api.ListenCreateListenServerHandler = &handlers.CreateListenServerHandlerImpl{Client: client, ReloadAgent: ra}
+	api.ListenDeleteListenServerHandler = &handlers.DeleteListenServerHandlerImpl{Client: client, ReloadAgent: ra}
+	api.ListenGetListenServerHandler = &handlers.GetListenServerHandlerImpl{Client: client}
+	api.ListenGetListenServersHandler = &handlers.GetListenServersHandlerImpl{Client: client}
+	api.ListenReplaceListenServerHandler = &handlers.ReplaceListenServerHandlerImpl{Client: client, ReloadAgent: ra}
 
 	// setup reload handlers
-	api.ReloadsGetReloadHandler = &handlers.GetReloadHandlerImpl{ReloadAgent: ra}
-	api.ReloadsGetReloadsHandler = &handlers.GetReloadsHandlerImpl{ReloadAgent: ra}
+	api.ReloadsGetReloadHandler = &handlers.GetReloadHandlerImpl{ReloadAgent: ra, Metadata: transactionMetadata}
+	api.ReloadsGetReloadsHandler = &handlers.GetReloadsHandlerImpl{ReloadAgent: ra, Metadata: transactionMetadata}
+	api.ReloadsGetReloadFreezeHandler = &handlers.GetReloadFreezeHandlerImpl{ReloadAgent: ra}
+	api.ReloadsFreezeReloadsHandler = &handlers.FreezeReloadsHandlerImpl{ReloadAgent: ra}
+	api.ReloadsUnfreezeReloadsHandler = &handlers.UnfreezeReloadsHandlerImpl{ReloadAgent: ra}
+	api.ReloadsRetryReloadHandler = &handlers.RetryReloadHandlerImpl{ReloadAgent: ra}
 
 	// setup runtime server handlers
 	api.ServerGetRuntimeServerHandler = &handlers.GetRuntimeServerHandlerImpl{Client: client}
 	api.ServerGetRuntimeServersHandler = &handlers.GetRuntimeServersHandlerImpl{Client: client}
 	api.ServerReplaceRuntimeServerHandler = &handlers.ReplaceRuntimeServerHandlerImpl{Client: client}
+	// the set weight commands are pipelined like the GeoIP map updates
+	api.ServerReplaceRuntimeWeightsHandler = &handlers.ReplaceRuntimeWeightsHandlerImpl{Client: client, WorkerPrefix: geoIP.WorkerPrefix}
+
+	// setup runtime frontend and backend handlers
+	api.FrontendGetRuntimeFrontendsHandler = &handlers.GetRuntimeFrontendsHandlerImpl{Client: client}
+	api.FrontendGetRuntimeFrontendHandler = &handlers.GetRuntimeFrontendHandlerImpl{Client: client}
+	api.FrontendReplaceRuntimeFrontendHandler = &handlers.ReplaceRuntimeFrontendHandlerImpl{Client: client}
+	api.BackendGetRuntimeBackendsHandler = &handlers.GetRuntimeBackendsHandlerImpl{Client: client}
+	api.BackendGetRuntimeBackendHandler = &handlers.GetRuntimeBackendHandlerImpl{Client: client}
+	api.BackendReplaceRuntimeBackendHandler = &handlers.ReplaceRuntimeBackendHandlerImpl{Client: client}
+
+	// setup server warm-up handlers
+	warmups := &haproxy.WarmupScheduler{
+		Runtime: func() haproxy.WarmupRuntime {
+			if client.Runtime == nil {
+				return nil
+			}
+			return client.Runtime
+		},
+	}
+	api.ServerGetServerWarmupHandler = &handlers.GetServerWarmupHandlerImpl{Warmups: warmups}
+	api.ServerReplaceServerWarmupHandler = &handlers.ReplaceServerWarmupHandlerImpl{Client: client, Warmups: warmups}
+	api.ServerDeleteServerWarmupHandler = &handlers.DeleteServerWarmupHandlerImpl{Warmups: warmups}
+
+	// setup frontend circuit breaker handlers
+	breakers := &haproxy.CircuitBreakers{
+		Runtime: func() haproxy.BreakerRuntime {
+			if client.Runtime == nil {
+				return nil
+			}
+			return client.Runtime
+		},
+	}
+	api.FrontendGetFrontendCircuitBreakerHandler = &handlers.GetFrontendCircuitBreakerHandlerImpl{Breakers: breakers}
+	api.FrontendReplaceFrontendCircuitBreakerHandler = &handlers.ReplaceFrontendCircuitBreakerHandlerImpl{Breakers: breakers}
+	api.FrontendDeleteFrontendCircuitBreakerHandler = &handlers.DeleteFrontendCircuitBreakerHandlerImpl{Breakers: breakers}
+
+	// setup host drain handlers
+	drainer := &haproxy.HostDrainer{
+		Runtime: func() haproxy.DrainRuntime {
+			if client.Runtime == nil {
+				return nil
+			}
+			return client.Runtime
+		},
+	}
+	api.DrainGetDrainsHandler = &handlers.GetDrainsHandlerImpl{Drainer: drainer}
+	api.DrainGetDrainHandler = &handlers.GetDrainHandlerImpl{Drainer: drainer}
+	api.DrainCreateDrainHandler = &handlers.CreateDrainHandlerImpl{Drainer: drainer}
 
 	// setup stick table handlers
 	api.StickTableGetStickTablesHandler = &handlers.GetStickTablesHandlerImpl{Client: client}
@@ -404,7 +1065,12 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 	api.StickTableGetStickTableEntriesHandler = &handlers.GetStickTableEntriesHandlerImpl{Client: client}
 
 	// setup map handlers
-	api.MapsCreateRuntimeMapHandler = &handlers.MapsCreateRuntimeMapHandlerImpl{Client: client}
+	var mapsQuota, generalQuota *dataplaneapi_config.StorageQuota
+	if cfg.Storage != nil {
+		mapsQuota = cfg.Storage.Maps
+		generalQuota = cfg.Storage.General
+	}
+	api.MapsCreateRuntimeMapHandler = &handlers.MapsCreateRuntimeMapHandlerImpl{Client: client, Quota: mapsQuota, TransactionStorage: transactionStorage}
 	api.MapsGetAllRuntimeMapFilesHandler = &handlers.GetMapsHandlerImpl{Client: client}
 	api.MapsGetOneRuntimeMapHandler = &handlers.GetMapHandlerImpl{Client: client}
 	api.MapsClearRuntimeMapHandler = &handlers.ClearMapHandlerImpl{Client: client}
@@ -414,13 +1080,24 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 	api.MapsReplaceRuntimeMapEntryHandler = &handlers.ReplaceRuntimeMapEntryHandlerImpl{Client: client}
 	api.MapsDeleteRuntimeMapEntryHandler = &handlers.DeleteRuntimeMapEntryHandlerImpl{Client: client}
 
-	// setup info handler
-	api.InformationGetInfoHandler = &handlers.GetInfoHandlerImpl{SystemInfo: haproxyOptions.ShowSystemInfo, BuildTime: BuildTime, Version: Version}
+	// setup storage handlers
+	generalDir := cfg.GetGeneralStorageDir()
+	api.StorageGetStorageCleanupHandler = &handlers.GetStorageCleanupHandlerImpl{Client: client, Config: cfg, MapsDir: haproxyOptions.MapsDir, GeneralDir: generalDir}
+	api.StorageGetAllStorageGeneralFilesHandler = &handlers.GetAllStorageGeneralFilesHandlerImpl{Client: client, Dir: generalDir}
+	api.StorageCreateStorageGeneralFileHandler = &handlers.CreateStorageGeneralFileHandlerImpl{Client: client, Dir: generalDir, Quota: generalQuota, SNIConflictPolicy: haproxyOptions.SNIConflictPolicy, TransactionStorage: transactionStorage}
+	api.StorageGetOneStorageGeneralFileHandler = &handlers.GetOneStorageGeneralFileHandlerImpl{Dir: generalDir}
+	api.StorageReplaceStorageGeneralFileHandler = &handlers.ReplaceStorageGeneralFileHandlerImpl{Client: client, ReloadAgent: ra, Dir: generalDir, Quota: generalQuota, SNIConflictPolicy: haproxyOptions.SNIConflictPolicy, TransactionStorage: transactionStorage}
+	api.StorageDeleteStorageGeneralFileHandler = &handlers.DeleteStorageGeneralFileHandlerImpl{Client: client, Dir: generalDir, TransactionStorage: transactionStorage}
+	dhParamGenerator := &haproxy.DHParamGenerator{}
+	api.StorageGetAllStorageDHParamsHandler = &handlers.GetAllStorageDHParamsHandlerImpl{Client: client, Dir: generalDir, Generator: dhParamGenerator}
+	api.StorageCreateStorageDHParamHandler = &handlers.CreateStorageDHParamHandlerImpl{Client: client, Dir: generalDir, Quota: generalQuota}
+	api.StorageGenerateStorageDHParamHandler = &handlers.GenerateStorageDHParamHandlerImpl{Dir: generalDir, Generator: dhParamGenerator}
 
 	// setup cluster handlers
 	api.DiscoveryGetClusterHandler = &handlers.GetClusterHandlerImpl{Config: cfg}
 	api.ClusterPostClusterHandler = &handlers.CreateClusterHandlerImpl{Client: client, Config: cfg, ReloadAgent: ra}
 	api.ClusterInitiateCertificateRefreshHandler = &handlers.ClusterInitiateCertificateRefreshHandlerImpl{Config: cfg}
+	api.ClusterGetBootstrapKeyRotationsHandler = &handlers.GetBootstrapKeyRotationsHandlerImpl{Config: cfg}
 
 	clusterSync := dataplaneapi_config.ClusterSync{ReloadAgent: ra}
 	go clusterSync.Monitor(cfg, client)
@@ -434,6 +1111,7 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 		}
 		return specification.NewGetSpecificationOK().WithPayload(&m)
 	})
+	api.SpecificationGetSpecificationSchemaHandler = &handlers.GetSpecificationSchemaHandlerImpl{Spec: SwaggerJSON, Validator: sampleValidator}
 
 	//set up service discovery handlers
 	discovery := service_discovery.NewServiceDiscoveries(client.Configuration)
@@ -443,11 +1121,39 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 	api.ServiceDiscoveryGetConsulsHandler = &handlers.GetConsulsHandlerImpl{Discovery: discovery}
 	api.ServiceDiscoveryReplaceConsulHandler = &handlers.ReplaceConsulHandlerImpl{Discovery: discovery, PersistCallback: cfg.SaveConsuls}
 
-	//create stored consul instances
-	for _, data := range cfg.ServiceDiscovery.Consuls {
-		err := discovery.AddNode("consul", *data.ID, data)
-		if err != nil {
-			log.Warning("Error creating consul instance: " + err.Error())
+	// setup users handlers
+	api.UsersReplaceSelfPasswordHandler = &handlers.ReplaceSelfPasswordHandlerImpl{Client: client, Config: cfg, ReloadAgent: ra, Users: users}
+
+	// setup API key handlers
+	api.UsersGetAPIKeysHandler = &handlers.GetAPIKeysHandlerImpl{Config: cfg}
+	api.UsersGetAPIKeyHandler = &handlers.GetAPIKeyHandlerImpl{Config: cfg}
+	api.UsersCreateAPIKeyHandler = &handlers.CreateAPIKeyHandlerImpl{Config: cfg}
+	api.UsersReplaceAPIKeyHandler = &handlers.ReplaceAPIKeyHandlerImpl{Config: cfg}
+	api.UsersDeleteAPIKeyHandler = &handlers.DeleteAPIKeyHandlerImpl{Config: cfg}
+
+	// setup info handlers
+	api.InformationGetDataplaneConfigurationHandler = &handlers.GetDataplaneConfigurationHandlerImpl{Config: cfg, HAProxyOptions: haproxyOptions}
+	api.InformationGetInfoHandler = &handlers.GetInfoHandlerImpl{
+		Client:       client,
+		Config:       cfg,
+		Discovery:    discovery,
+		Validator:    sampleValidator,
+		MasterSocket: haproxyOptions.MasterRuntime,
+		SystemInfo:   haproxyOptions.ShowSystemInfo,
+		BuildTime:    BuildTime,
+		Version:      Version,
+		GitCommit:    GitCommit,
+		GitTag:       GitTag,
+		GitRepo:      GitRepo,
+	}
+
+	//create stored consul instances, unless the consul feature is disabled
+	if cfg.Features.Enabled("consul") {
+		for _, data := range cfg.ServiceDiscovery.Consuls {
+			err := discovery.AddNode("consul", *data.ID, data)
+			if err != nil {
+				log.Warning("Error creating consul instance: " + err.Error())
+			}
 		}
 	}
 
@@ -463,7 +1169,6 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 		// if host is empty(dynamic hosts), server prop is empty,
 		// so we need to set it explicitly
 		if v2.Host == "" {
-			cfg := dataplaneapi_config.Get()
 			v2.Host = cfg.Server.Host
 		}
 
@@ -475,7 +1180,87 @@ func configureAPI(api *operations.DataPlaneAPI) http.Handler {
 		return specification_openapiv3.NewGetOpenapiv3SpecificationOK().WithPayload(v3)
 	})
 
-	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
+	configVersion := adapters.ConfigVersionMiddleware(func() (int64, error) {
+		return client.Configuration.GetVersion("")
+	})
+	features := adapters.FeaturesMiddleware(cfg.Features.DisabledGroup)
+	// the API does not start without the admission policies it is configured with
+	engine, err := policy.NewEngine(cfg.Policies)
+	if err != nil {
+		log.Fatalf("Error loading admission policies: %s", err.Error())
+	}
+	policies := adapters.PolicyMiddleware(engine)
+	protection := adapters.ProtectionMiddleware(cfg.Annotations.CheckChange)
+	var authorize func(method, path, sectionType, name, user string, roles []string) error
+	if cfg.RBAC != nil {
+		authorize = cfg.RBAC.Authorize
+	}
+	rbac := adapters.RBACMiddleware(authorize)
+	var recordRequest func(method, operation string, status int, d time.Duration)
+	switch {
+	case statsD != nil && prometheus != nil:
+		recordRequest = func(method, operation string, status int, d time.Duration) {
+			statsD.Request(method, operation, status, d)
+			prometheus.Request(method, operation, status, d)
+		}
+	case statsD != nil:
+		recordRequest = statsD.Request
+	case prometheus != nil:
+		recordRequest = prometheus.Request
+	}
+	requestMetrics := adapters.RequestMetricsMiddleware(recordRequest)
+	var commitChanges func(transactionID, user string) error
+	if gitRepository != nil {
+		commitChanges = func(transactionID, user string) error {
+			m, _ := transactionMetadata.Get(transactionID)
+			return gitRepository.Commit(transactionID, user, m.Note, m.Labels)
+		}
+	}
+	gitCommit := adapters.GitCommitMiddleware(commitChanges)
+	writableConfig := adapters.WritableConfigMiddleware(system.ConfigWindow.Open, system.ConfigWindow.Close)
+	// the metrics are served outside of the API base path, for Prometheus to scrape
+	var metricsHandler http.Handler
+	metricsPath := "/metrics"
+	var metricsAuth func(user, pass string) (interface{}, error)
+	if prometheus != nil {
+		metricsHandler = prometheus
+		if cfg.Metrics.Path != "" {
+			metricsPath = cfg.Metrics.Path
+		}
+		if cfg.Metrics.Auth {
+			metricsAuth = authenticateUser
+		}
+	}
+	serveMetrics := adapters.MetricsMiddleware(metricsPath, metricsHandler, metricsAuth)
+	audit := adapters.AuditMiddleware(configureAuditLog(cfg.Logging))
+	bearerAuth := adapters.BearerAuthMiddleware(authenticateToken)
+	externalAuth := adapters.ExternalAuthMiddleware(dataplaneapi_config.IsUser, authenticatePassword)
+	apiHandler := serveMetrics(bearerAuth(externalAuth(audit(configVersion(api.Serve(func(handler http.Handler) http.Handler {
+		return requestMetrics(writableConfig(gitCommit(features(rbac(policies(protection(setupMiddlewares(handler))))))))
+	}))))))
+	// the transaction channel takes over the connection, which the global
+	// middleware does not allow, and stages the changes through the API
+	var channel http.Handler
+	channelPath := "/services/haproxy/transactions/live"
+	if cfg.Features.DisabledGroup(channelPath) == "" {
+		channel = &handlers.TransactionChannel{
+			Client:            client,
+			API:               apiHandler,
+			BasePath:          cfg.Server.APIBasePath,
+			Dir:               haproxyOptions.TransactionDir,
+			Authenticate:      authenticateUser,
+			AuthenticateToken: authenticateToken,
+			AllowedOrigins:    cfg.ChannelOrigins,
+			Validate: func(file string) error {
+				return haproxy.CheckConfiguration(haproxyOptions.HAProxy, file)
+			},
+		}
+	}
+	mountChannel := adapters.MountMiddleware(cfg.Server.APIBasePath+channelPath, channel)
+	// the requests are authenticated with their client certificate before the
+	// transaction channel, which stages the changes as its user
+	clientCert := adapters.ClientCertificateMiddleware(clientCertificates.Identify)
+	return clientCert(mountChannel(setupGlobalMiddleware(apiHandler)))
 }
 
 // The TLS configuration before HTTPS server starts.
@@ -510,7 +1295,7 @@ func setupGlobalMiddleware(handler http.Handler) http.Handler {
 			http.MethodDelete,
 		},
 		AllowedHeaders:   []string{"*"},
-		ExposedHeaders:   []string{"Reload-ID", "Configuration-Version"},
+		ExposedHeaders:   []string{"Reload-ID", "Configuration-Version", "ETag"},
 		AllowCredentials: true,
 		MaxAge:           86400,
 	}).Handler
@@ -546,7 +1331,36 @@ func configureLogging(loggingOptions dataplaneapi_config.LoggingOptions) {
 		log.SetOutput(logFile)
 	}
 
-	switch loggingOptions.LogLevel {
+	setLogLevel(loggingOptions.LogLevel)
+}
+
+// configureAuditLog returns the logger of the audit log, a sink separate from
+// the application logs, nil when it is disabled
+func configureAuditLog(loggingOptions dataplaneapi_config.LoggingOptions) *log.Logger {
+	if loggingOptions.AuditLog == "" {
+		return nil
+	}
+	logger := log.New()
+	logger.SetFormatter(&log.JSONFormatter{})
+	logger.SetLevel(log.InfoLevel)
+	if loggingOptions.AuditLog == "stdout" {
+		logger.SetOutput(os.Stdout)
+		return logger
+	}
+	if err := system.MkdirAll(filepath.Dir(loggingOptions.AuditLog), 0750); err != nil {
+		log.Fatalf("Cannot create the directory of the audit log: %v", err)
+	}
+	f, err := os.OpenFile(loggingOptions.AuditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Fatalf("Cannot open the audit log: %v", err)
+	}
+	auditLogFile = f
+	logger.SetOutput(f)
+	return logger
+}
+
+func setLogLevel(level string) {
+	switch level {
 	case "debug":
 		log.SetLevel(log.DebugLevel)
 	case "info":
@@ -563,6 +1377,9 @@ func serverShutdown() {
 	if logFile != nil {
 		logFile.Close()
 	}
+	if auditLogFile != nil {
+		auditLogFile.Close()
+	}
 	if cfg.HAProxy.UpdateMapFiles {
 		MapQuitChan <- MapQuitNotice{}
 	}
@@ -587,6 +1404,19 @@ func configureNativeClient(haproxyOptions dataplaneapi_config.HAProxyConfigurati
 	return client
 }
 
+// rereadConfiguration loads the configuration file changed outside of the
+// configuration client into it. The file is committed as a raw configuration
+// is, the client replacing its parser under its own lock, as the requests
+// being handled keep using the client.
+func rereadConfiguration(confClient *configuration.Client, file string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	config := string(data)
+	return confClient.PostRawConfiguration(&config, 0, true)
+}
+
 func configureConfigurationClient(haproxyOptions dataplaneapi_config.HAProxyConfiguration, mWorker bool) (*configuration.Client, error) {
 	confClient := &configuration.Client{}
 	confParams := configuration.ClientParams{
@@ -692,10 +1522,10 @@ func handleSignals(sigs chan os.Signal, client *client_native.HAProxyClient, hap
 	for {
 		select {
 		case sig := <-sigs:
-			if sig == syscall.SIGUSR1 {
+			if sig == system.ReloadSignal {
 				client.Runtime = configureRuntimeClient(client.Configuration, haproxyOptions)
 				log.Info("Reloaded Data Plane API")
-			} else if sig == syscall.SIGUSR2 {
+			} else if sig == system.RereadSignal {
 				confClient, err := configureConfigurationClient(haproxyOptions, mWorker)
 				if err != nil {
 					log.Fatalf(err.Error())
@@ -710,6 +1540,126 @@ func handleSignals(sigs chan os.Signal, client *client_native.HAProxyClient, hap
 	}
 }
 
+// handleConfigChanges applies the options of the dataplane configuration file
+// which do not need a restart once it is reloaded
+func handleConfigChanges(cfg *dataplaneapi_config.Configuration, ra *haproxy.ReloadAgent, users *dataplaneapi_config.Users) {
+	for range cfg.Notify.ConfigChanged.Subscribe("configChanges") {
+		setLogLevel(cfg.LoggingOptions().LogLevel)
+		ra.SetDelay(cfg.HAProxyOptions().ReloadDelay)
+		if err := users.Init(); err != nil {
+			log.Warningf("Keeping the previous users, cannot read them again: %s", err.Error())
+		}
+	}
+}
+
+// checkHAProxyProcess verifies HAProxy processes answer on the runtime API,
+// it is skipped when the runtime API is not configured
+func checkHAProxyProcess(client *client_native.HAProxyClient) error {
+	if client.Runtime == nil {
+		return nil
+	}
+	infos, err := client.Runtime.GetInfo()
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if info.Error != "" {
+			return fmt.Errorf("%s: %s", info.RuntimeAPI, info.Error)
+		}
+	}
+	return nil
+}
+
+// parseMode parses a file mode in octal, 0 when it is not set
+func parseMode(mode string) (os.FileMode, error) {
+	if mode == "" {
+		return 0, nil
+	}
+	m, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || m > 0777 {
+		return 0, fmt.Errorf("%s is not a mode in octal, such as 0640", mode)
+	}
+	return os.FileMode(m), nil
+}
+
+// transactionsByStatus counts the open transactions by status
+func transactionsByStatus(client *client_native.HAProxyClient) (map[string]int64, error) {
+	transactions, err := client.Configuration.GetTransactions("")
+	if err != nil {
+		return nil, err
+	}
+	counts := map[string]int64{"in_progress": 0, "failed": 0}
+	for _, t := range *transactions {
+		counts[t.Status]++
+	}
+	return counts, nil
+}
+
+// frontendEndpoints returns the addresses the binds of a frontend listen on, as
+// reached from the local host. Binds without a port, such as unix sockets, are skipped.
+func frontendEndpoints(client *client_native.HAProxyClient, frontend string) ([]probes.Endpoint, error) {
+	_, binds, err := client.Configuration.GetBinds(frontend, "")
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([]probes.Endpoint, 0, len(binds))
+	for _, b := range binds {
+		if b.Port == nil {
+			continue
+		}
+		address := b.Address
+		switch address {
+		case "", "*", "0.0.0.0":
+			address = "127.0.0.1"
+		case "::":
+			address = "::1"
+		}
+		endpoints = append(endpoints, probes.Endpoint{
+			Address: net.JoinHostPort(address, strconv.FormatInt(*b.Port, 10)),
+			TLS:     b.Ssl,
+		})
+	}
+	return endpoints, nil
+}
+
+// newGitRepository returns the repository committing the configuration file,
+// the SPOE and maps directories and the other paths of the git mode settings,
+// signing commits and pushing them to the remote of the settings
+func newGitRepository(settings *dataplaneapi_config.Git, haproxyOptions dataplaneapi_config.HAProxyConfiguration, spoeDir string) (*gitmode.Repository, error) {
+	dir := settings.Path
+	if dir == "" {
+		dir = filepath.Dir(haproxyOptions.ConfigFile)
+	}
+	paths := []string{haproxyOptions.ConfigFile, spoeDir, haproxyOptions.MapsDir}
+	paths = append(paths, settings.Paths...)
+	repo, err := gitmode.NewRepository(dir, paths, settings.AuthorName, settings.AuthorEmail)
+	if err != nil {
+		return nil, err
+	}
+	knownHosts := settings.KnownHosts
+	if knownHosts == "" {
+		knownHosts = filepath.Join(filepath.Dir(haproxyOptions.DataplaneConfig), "known_hosts")
+	}
+	if settings.SigningKey != "" {
+		err = repo.SetSigning(gitmode.Signing{
+			Format:  settings.SigningFormat,
+			Key:     settings.SigningKey,
+			Program: settings.SigningProgram,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	repo.SetRemote(gitmode.Remote{
+		URL:        settings.Remote,
+		Branch:     settings.Branch,
+		Push:       settings.Push,
+		SSHKey:     settings.SSHKey,
+		KnownHosts: knownHosts,
+	})
+	return repo, nil
+}
+
 type MapQuitNotice struct{}
 
 var MapQuitChan = make(chan MapQuitNotice)
@@ -17,6 +17,7 @@ package discovery
 
 import (
 	"errors"
+	"sort"
 	"sync"
 
 	"github.com/haproxytech/client-native/v2/configuration"
@@ -42,6 +43,7 @@ type ServiceDiscoveries interface {
 	GetNodes(serviceName string) (ServiceDiscoveryParams, error)
 	RemoveNode(serviceName string, id string) error
 	RemoveService(serviceName string) error
+	Services() []string
 	UpdateNode(serviceName string, id string, params ServiceDiscoveryParams) error
 }
 
@@ -133,3 +135,14 @@ func (s *serviceDiscoveryImpl) UpdateNode(serviceName string, id string, params
 	}
 	return sd.UpdateNode(id, params)
 }
+
+func (s *serviceDiscoveryImpl) Services() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.services))
+	for name := range s.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
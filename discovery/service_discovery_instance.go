@@ -17,6 +17,7 @@ package discovery
 
 import (
 	"github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/dataplaneapi/system"
 )
 
 //ServiceInstance specifies the needed information required from the service to provide for the ServiceDiscoveryInstance.
@@ -189,6 +190,11 @@ func (s *ServiceDiscoveryInstance) deleteTransaction() {
 }
 
 func (s *ServiceDiscoveryInstance) commitTransaction() error {
+	if err := system.ConfigWindow.Open(); err != nil {
+		s.deleteTransaction()
+		return err
+	}
+	defer system.ConfigWindow.Close()
 	_, err := s.client.CommitTransaction(s.transactionID)
 	s.transactionID = ""
 	return err
@@ -0,0 +1,474 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package fleet
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/haproxytech/dataplaneapi/configuration"
+)
+
+const (
+	// StatusApplied is a node running the pushed configuration
+	StatusApplied = "applied"
+	// StatusUnchanged is a node already running the pushed configuration
+	StatusUnchanged = "unchanged"
+	// StatusFailed is a node the configuration could not be pushed to, or
+	// which could not be rolled back
+	StatusFailed = "failed"
+	// StatusRolledBack is a node restored to its previous configuration
+	StatusRolledBack = "rolled_back"
+	// StatusAborted is a node which discarded the configuration prepared by
+	// a two-phase push
+	StatusAborted = "aborted"
+
+	defaultTimeout = 60 * time.Second
+)
+
+// Report is the outcome of a push
+type Report struct {
+	Version  int64
+	Started  time.Time
+	Finished time.Time
+	Atomic   bool
+	TwoPhase bool
+	Nodes    []NodeResult
+}
+
+// NodeResult is the outcome of a push to a node
+type NodeResult struct {
+	Name            string
+	URL             string
+	Status          string
+	Message         string
+	PreviousVersion int64
+	Version         int64
+}
+
+// Node is a peer Data Plane API
+type Node struct {
+	Name      string
+	URL       string
+	Variables map[string]string
+	Overrides []configuration.FleetOverride
+	username  string
+	password  string
+	client    *http.Client
+}
+
+// Fleet pushes the configuration to its nodes, one push at a time
+type Fleet struct {
+	Nodes    []*Node
+	AutoPush bool
+	Atomic   bool
+	TwoPhase bool
+	// Config returns the version and the content of the configuration pushed
+	Config func() (int64, string, error)
+
+	pushMu sync.Mutex
+	mu     sync.Mutex
+	last   *Report
+}
+
+// New returns the fleet of the nodes of settings
+func New(settings *configuration.Fleet) (*Fleet, error) {
+	timeout := defaultTimeout
+	if settings.Timeout > 0 {
+		timeout = time.Duration(settings.Timeout) * time.Second
+	}
+	f := &Fleet{AutoPush: settings.AutoPush, Atomic: settings.Atomic, TwoPhase: settings.TwoPhase, Nodes: make([]*Node, 0, len(settings.Nodes))}
+	names := make(map[string]bool)
+	for _, n := range settings.Nodes {
+		if n.Name == "" || n.URL == "" {
+			return nil, fmt.Errorf("fleet node without name or url")
+		}
+		if names[n.Name] {
+			return nil, fmt.Errorf("fleet node %s set more than once", n.Name)
+		}
+		names[n.Name] = true
+		u, err := url.Parse(n.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid url of fleet node %s: %s", n.Name, n.URL)
+		}
+		// nolint:gosec
+		tlsConfig := &tls.Config{InsecureSkipVerify: n.InsecureSkipVerify}
+		if n.CAFile != "" {
+			ca, err := ioutil.ReadFile(n.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("no certificate in %s", n.CAFile)
+			}
+		}
+		overrides, err := newOverrides(n)
+		if err != nil {
+			return nil, err
+		}
+		f.Nodes = append(f.Nodes, &Node{
+			Name:      n.Name,
+			URL:       strings.TrimSuffix(n.URL, "/"),
+			username:  n.Username,
+			password:  n.Password,
+			Variables: n.Variables,
+			Overrides: overrides,
+			client: &http.Client{
+				Timeout:   timeout,
+				Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig},
+			},
+		})
+	}
+	if len(f.Nodes) == 0 {
+		return nil, fmt.Errorf("no fleet node")
+	}
+	return f, nil
+}
+
+// LastPush returns the report of the last push, nil when there was none
+func (f *Fleet) LastPush() *Report {
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.last
+}
+
+// Push pushes the configuration to all nodes concurrently and reloads them,
+// with the variables and overrides of each node applied.
+// A node failing once it wrote the configuration, when its reload is refused
+// or fails, is rolled back to its previous configuration, all the updated nodes are rolled back as well when the push is atomic.
+// A two-phase push first prepares the configuration on all nodes, and commits
+// it only when all of them validated it, it is always atomic.
+func (f *Fleet) Push(atomic, twoPhase bool) (*Report, error) {
+	f.pushMu.Lock()
+	defer f.pushMu.Unlock()
+
+	version, data, err := f.Config()
+	if err != nil {
+		return nil, err
+	}
+	atomic = atomic || twoPhase
+	r := &Report{Version: version, Started: time.Now(), Atomic: atomic, TwoPhase: twoPhase, Nodes: make([]NodeResult, len(f.Nodes))}
+	previous := make([]string, len(f.Nodes))
+	if twoPhase {
+		f.pushTwoPhase(r, previous, data)
+	} else {
+		f.each(func(i int, n *Node) {
+			r.Nodes[i], previous[i] = n.push(data)
+		})
+	}
+
+	if atomic && failed(r) {
+		f.each(func(i int, n *Node) {
+			if r.Nodes[i].Status == StatusApplied {
+				n.rollback(&r.Nodes[i], previous[i], "another node failed")
+			}
+		})
+	}
+	r.Finished = time.Now()
+	for _, res := range r.Nodes {
+		if res.Status != StatusApplied && res.Status != StatusUnchanged {
+			log.Warningf("Fleet push of version %d to %s: %s: %s", version, res.Name, res.Status, res.Message)
+		}
+	}
+
+	f.mu.Lock()
+	f.last = r
+	f.mu.Unlock()
+	return r, nil
+}
+
+// pushTwoPhase prepares the configuration on all nodes, then commits it when
+// all of them accepted it, and aborts it otherwise
+func (f *Fleet) pushTwoPhase(r *Report, previous []string, data string) {
+	prepared := make([]string, len(f.Nodes))
+	f.each(func(i int, n *Node) {
+		r.Nodes[i], previous[i], prepared[i] = n.prepare(data)
+	})
+	if failed(r) {
+		f.each(func(i int, n *Node) {
+			if prepared[i] == "" {
+				return
+			}
+			res := &r.Nodes[i]
+			if err := n.abort(prepared[i]); err != nil {
+				res.Status = StatusFailed
+				res.Message = fmt.Sprintf("another node failed, abort failed: %s", err.Error())
+				return
+			}
+			res.Status = StatusAborted
+			res.Message = "another node failed"
+		})
+		return
+	}
+	f.each(func(i int, n *Node) {
+		if prepared[i] != "" {
+			n.commit(&r.Nodes[i], prepared[i], previous[i])
+		}
+	})
+}
+
+// each runs fn for all nodes concurrently
+func (f *Fleet) each(fn func(i int, n *Node)) {
+	var wg sync.WaitGroup
+	for i, n := range f.Nodes {
+		wg.Add(1)
+		go func(i int, n *Node) {
+			defer wg.Done()
+			fn(i, n)
+		}(i, n)
+	}
+	wg.Wait()
+}
+
+func failed(r *Report) bool {
+	for _, res := range r.Nodes {
+		if res.Status == StatusFailed || res.Status == StatusRolledBack {
+			return true
+		}
+	}
+	return false
+}
+
+// push applies the configuration to the node, returning the outcome and the
+// previous configuration of the node
+func (n *Node) push(data string) (NodeResult, string) {
+	res := NodeResult{Name: n.Name, URL: n.URL}
+	data, err := n.Render(data)
+	if err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+		return res, ""
+	}
+	version, current, err := n.getConfiguration()
+	if err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+		return res, ""
+	}
+	res.PreviousVersion = version
+	res.Version = version
+	if current == data {
+		res.Status = StatusUnchanged
+		return res, current
+	}
+	// the version check fails the push when the node changed in the meantime
+	if err := n.postConfiguration(data, version, false); err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+		if n.written(err, version) {
+			n.rollback(&res, current, err.Error())
+		}
+		return res, current
+	}
+	res.Status = StatusApplied
+	if v, _, err := n.getConfiguration(); err == nil {
+		res.Version = v
+	}
+	return res, current
+}
+
+// rollback restores the previous configuration of the node
+func (n *Node) rollback(res *NodeResult, previous, reason string) {
+	if err := n.postConfiguration(previous, 0, true); err != nil {
+		res.Status = StatusFailed
+		res.Message = fmt.Sprintf("%s, rollback failed: %s", reason, err.Error())
+		return
+	}
+	res.Status = StatusRolledBack
+	res.Message = reason
+	if v, _, err := n.getConfiguration(); err == nil {
+		res.Version = v
+	}
+}
+
+// prepare stages the configuration on the node, returning the outcome, the
+// previous configuration of the node and the ID of the prepared configuration,
+// empty when the node is unchanged or failed
+func (n *Node) prepare(data string) (NodeResult, string, string) {
+	res := NodeResult{Name: n.Name, URL: n.URL}
+	data, err := n.Render(data)
+	if err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+		return res, "", ""
+	}
+	version, current, err := n.getConfiguration()
+	if err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+		return res, "", ""
+	}
+	res.PreviousVersion = version
+	res.Version = version
+	if current == data {
+		res.Status = StatusUnchanged
+		return res, current, ""
+	}
+	req, err := http.NewRequest(http.MethodPost, n.URL+"/services/haproxy/fleet/prepared?version="+strconv.FormatInt(version, 10), strings.NewReader(data))
+	if err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+		return res, current, ""
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	body, err := n.do(req)
+	if err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+		return res, current, ""
+	}
+	var prepared struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &prepared); err != nil || prepared.ID == "" {
+		res.Status = StatusFailed
+		res.Message = "invalid prepared configuration returned"
+		return res, current, ""
+	}
+	return res, current, prepared.ID
+}
+
+// commit applies the configuration prepared on the node and reloads it, the
+// node is rolled back when it fails once the configuration is written
+func (n *Node) commit(res *NodeResult, id, previous string) {
+	req, err := http.NewRequest(http.MethodPut, n.URL+"/services/haproxy/fleet/prepared/"+url.PathEscape(id), nil)
+	if err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+		return
+	}
+	if _, err := n.do(req); err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+		if n.written(err, res.PreviousVersion) {
+			n.rollback(res, previous, err.Error())
+		}
+		return
+	}
+	res.Status = StatusApplied
+	if v, _, err := n.getConfiguration(); err == nil {
+		res.Version = v
+	}
+}
+
+// abort discards the configuration prepared on the node
+func (n *Node) abort(id string) error {
+	req, err := http.NewRequest(http.MethodDelete, n.URL+"/services/haproxy/fleet/prepared/"+url.PathEscape(id), nil)
+	if err != nil {
+		return err
+	}
+	_, err = n.do(req)
+	return err
+}
+
+func (n *Node) getConfiguration() (int64, string, error) {
+	req, err := http.NewRequest(http.MethodGet, n.URL+"/services/haproxy/configuration/raw", nil)
+	if err != nil {
+		return 0, "", err
+	}
+	body, err := n.do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	var raw struct {
+		Version int64  `json:"_version"`
+		Data    string `json:"data"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return 0, "", fmt.Errorf("invalid configuration returned: %s", err.Error())
+	}
+	return raw.Version, raw.Data, nil
+}
+
+func (n *Node) postConfiguration(data string, version int64, skipVersion bool) error {
+	q := url.Values{}
+	q.Set("force_reload", "true")
+	if skipVersion {
+		q.Set("skip_version", "true")
+	} else {
+		q.Set("version", strconv.FormatInt(version, 10))
+	}
+	req, err := http.NewRequest(http.MethodPost, n.URL+"/services/haproxy/configuration/raw?"+q.Encode(), strings.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	_, err = n.do(req)
+	return err
+}
+
+// written reports whether the node wrote the configuration pushed over version
+// before failing with err, refusing or failing to reload it for instance. The
+// version of the node changed then, a version mismatch meaning another client
+// changed it instead. The node is assumed written when its version cannot be
+// read.
+func (n *Node) written(err error, version int64) bool {
+	if e, ok := err.(*statusError); ok && e.code == http.StatusConflict {
+		return false
+	}
+	v, _, err := n.getConfiguration()
+	return err != nil || v != version
+}
+
+type statusError struct {
+	code int
+	msg  string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%d: %s", e.code, e.msg)
+}
+
+func (n *Node) do(req *http.Request) ([]byte, error) {
+	if n.username != "" {
+		req.SetBasicAuth(n.username, n.password)
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var e struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &e) != nil || e.Message == "" {
+			e.Message = strings.TrimSpace(string(bytes.TrimSpace(body)))
+		}
+		return nil, &statusError{code: resp.StatusCode, msg: e.Message}
+	}
+	return body, nil
+}
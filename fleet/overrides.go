@@ -0,0 +1,115 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package fleet
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/haproxytech/dataplaneapi/configuration"
+)
+
+// variableRef is an environment variable reference of the configuration,
+// HAProxy expands them in double quoted arguments, the quotes are kept
+var variableRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// sections are the keywords starting a section of the configuration
+var sections = map[string]bool{
+	"global": true, "defaults": true, "frontend": true, "backend": true,
+	"listen": true, "peers": true, "resolvers": true, "userlist": true,
+	"mailers": true, "program": true, "http-errors": true, "ring": true,
+	"cache": true, "fcgi-app": true,
+}
+
+// Render returns the configuration pushed to the node, with its variables
+// and overrides applied
+func (n *Node) Render(data string) (string, error) {
+	if len(n.Variables) > 0 {
+		data = variableRef.ReplaceAllStringFunc(data, func(ref string) string {
+			name := variableRef.FindStringSubmatch(ref)[1]
+			if v, ok := n.Variables[name]; ok {
+				return v
+			}
+			return ref
+		})
+	}
+	if len(n.Overrides) == 0 {
+		return data, nil
+	}
+
+	matched := make([]bool, len(n.Overrides))
+	lines := strings.Split(data, "\n")
+	out := make([]string, 0, len(lines))
+	section := ""
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		fields := strings.Fields(trimmed)
+		if len(fields) > 0 && sections[fields[0]] && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			section = strings.Join(fields, " ")
+			out = append(out, line)
+			continue
+		}
+		replaced := false
+		for i, o := range n.Overrides {
+			if o.Section != section || !strings.HasPrefix(trimmed, o.Match) {
+				continue
+			}
+			matched[i] = true
+			replaced = true
+			if o.Replace != "" {
+				indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+				out = append(out, indent+o.Replace)
+			}
+			break
+		}
+		if !replaced {
+			out = append(out, line)
+		}
+	}
+	for i, o := range n.Overrides {
+		if !matched[i] {
+			return "", fmt.Errorf("override of node %s matches no line: %s: %s", n.Name, o.Section, o.Match)
+		}
+	}
+	return strings.Join(out, "\n"), nil
+}
+
+// Node returns the node named name, nil when there is none
+func (f *Fleet) Node(name string) *Node {
+	for _, n := range f.Nodes {
+		if n.Name == name {
+			return n
+		}
+	}
+	return nil
+}
+
+func newOverrides(n configuration.FleetNode) ([]configuration.FleetOverride, error) {
+	overrides := make([]configuration.FleetOverride, 0, len(n.Overrides))
+	for _, o := range n.Overrides {
+		o.Section = strings.Join(strings.Fields(o.Section), " ")
+		o.Match = strings.TrimSpace(o.Match)
+		if o.Section == "" || o.Match == "" {
+			return nil, fmt.Errorf("override of fleet node %s without section or match", n.Name)
+		}
+		if !sections[strings.Fields(o.Section)[0]] {
+			return nil, fmt.Errorf("override of fleet node %s in unknown section %s", n.Name, o.Section)
+		}
+		overrides = append(overrides, o)
+	}
+	return overrides, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package fleet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/haproxytech/dataplaneapi/system"
+	log "github.com/sirupsen/logrus"
+)
+
+// preparedTTL is the time a prepared configuration waits for its commit
+const preparedTTL = 10 * time.Minute
+
+// Prepared is a validated configuration waiting to be committed
+type Prepared struct {
+	ID      string
+	Version int64
+	Expires time.Time
+	file    string
+}
+
+// Participant stages the configurations prepared by the coordinator of a
+// two-phase push until they are committed or aborted
+type Participant struct {
+	dir string
+	// validate checks a prepared configuration file
+	validate func(file string) error
+	mu       sync.Mutex
+	prepared map[string]*Prepared
+}
+
+// NewParticipant returns a participant staging prepared configurations in
+// dir, validated with validate
+func NewParticipant(dir string, validate func(file string) error) (*Participant, error) {
+	if err := system.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	// configurations prepared before a restart can no longer be committed
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if err := os.Remove(filepath.Join(dir, f.Name())); err != nil {
+			log.Warning(err)
+		}
+	}
+	return &Participant{dir: dir, validate: validate, prepared: make(map[string]*Prepared)}, nil
+}
+
+// Prepare validates and stages the configuration replacing version
+func (p *Participant) Prepare(version int64, data string) (*Prepared, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.expire()
+
+	id := uuid.New().String()
+	file := filepath.Join(p.dir, id+".cfg")
+	if err := system.WriteFile(file, []byte(data), 0600); err != nil {
+		return nil, err
+	}
+	if err := p.validate(file); err != nil {
+		os.Remove(file)
+		return nil, err
+	}
+	prepared := &Prepared{ID: id, Version: version, Expires: time.Now().Add(preparedTTL), file: file}
+	p.prepared[id] = prepared
+	return prepared, nil
+}
+
+// Take removes a prepared configuration to commit it, returning it with its
+// content, nil when there is no such configuration
+func (p *Participant) Take(id string) (*Prepared, string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.expire()
+
+	prepared, ok := p.prepared[id]
+	if !ok {
+		return nil, "", nil
+	}
+	delete(p.prepared, id)
+	defer os.Remove(prepared.file)
+	data, err := ioutil.ReadFile(prepared.file)
+	if err != nil {
+		return nil, "", err
+	}
+	return prepared, string(data), nil
+}
+
+// Abort discards a prepared configuration, reporting whether it existed
+func (p *Participant) Abort(id string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.expire()
+
+	prepared, ok := p.prepared[id]
+	if !ok {
+		return false
+	}
+	delete(p.prepared, id)
+	if err := os.Remove(prepared.file); err != nil {
+		log.Warning(err)
+	}
+	return true
+}
+
+func (p *Participant) expire() {
+	now := time.Now()
+	for id, prepared := range p.prepared {
+		if now.After(prepared.Expires) {
+			log.Warningf("Prepared configuration %s expired without being committed", id)
+			delete(p.prepared, id)
+			os.Remove(prepared.file)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitmode
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// StrategyOurs keeps the local files, discarding the changes of the remote
+	StrategyOurs = "ours"
+	// StrategyTheirs merges the remote, taking its side of conflicting hunks
+	StrategyTheirs = "theirs"
+	// StrategyManual uses the uploaded content of the conflicting files
+	StrategyManual = "manual"
+
+	// remoteRef is where the remote branch is fetched to
+	remoteRef = "refs/dataplaneapi/remote"
+)
+
+// Conflict is a divergence of the local and remote branches, pushing is
+// suspended until it is resolved
+type Conflict struct {
+	LocalCommit  string
+	RemoteCommit string
+	BaseCommit   string
+	Detected     time.Time
+	// Files changed on both branches since they diverged
+	Files []ConflictFile
+	// RemoteOnly are the files changed on the remote branch only
+	RemoteOnly []string
+}
+
+// ConflictFile is a file changed on both branches, with the changes of each
+// one as unified diffs from the common commit
+type ConflictFile struct {
+	Path   string
+	Local  string
+	Remote string
+}
+
+// ResolutionError is returned when a conflict can not be resolved as requested
+type ResolutionError struct {
+	msg string
+}
+
+func (e *ResolutionError) Error() string {
+	return e.msg
+}
+
+// Conflict returns the pending conflict, nil when there is none
+func (r *Repository) Conflict() *Conflict {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.conflict
+}
+
+// Resolve merges the remote branch with a strategy and pushes the result.
+// Contents maps paths of conflicting files to their resolved content, for the
+// manual strategy. The files on disk changed by the merge are reported
+// through the OnChange callback.
+func (r *Repository) Resolve(strategy string, contents map[string]string) error {
+	if r == nil {
+		return fmt.Errorf("git mode is not enabled")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.conflict == nil {
+		return &ResolutionError{"there is no git conflict to resolve"}
+	}
+	msg := fmt.Sprintf("Merge remote branch %s, resolved with %s strategy", r.remote.Branch, strategy)
+	changed := false
+	switch strategy {
+	case StrategyOurs:
+		if _, err := r.git("merge", "--quiet", "--no-ff", "--strategy", "ours", "--message", msg, remoteRef); err != nil {
+			return err
+		}
+	case StrategyTheirs:
+		if _, err := r.git("merge", "--quiet", "--no-ff", "--strategy-option", "theirs", "--message", msg, remoteRef); err != nil {
+			// nolint:errcheck
+			r.git("merge", "--abort")
+			return err
+		}
+		changed = true
+	case StrategyManual:
+		for _, f := range r.conflict.Files {
+			if _, ok := contents[f.Path]; !ok {
+				return &ResolutionError{fmt.Sprintf("missing content of conflicting file %s", f.Path)}
+			}
+		}
+		for path := range contents {
+			if !r.conflicting(path) {
+				return &ResolutionError{fmt.Sprintf("%s is not a conflicting file", path)}
+			}
+		}
+		// the remote changes to other files are merged as they are
+		if _, err := r.git("merge", "--quiet", "--no-ff", "--no-commit", "--strategy-option", "ours", remoteRef); err != nil {
+			// nolint:errcheck
+			r.git("merge", "--abort")
+			return err
+		}
+		paths := make([]string, 0, len(contents))
+		for path, content := range contents {
+			if err := ioutil.WriteFile(filepath.Join(r.dir, path), []byte(content), 0644); err != nil {
+				// nolint:errcheck
+				r.git("merge", "--abort")
+				return err
+			}
+			paths = append(paths, path)
+		}
+		if _, err := r.git(append([]string{"add", "--"}, paths...)...); err != nil {
+			// nolint:errcheck
+			r.git("merge", "--abort")
+			return err
+		}
+		if _, err := r.git("commit", "--quiet", "--message", msg); err != nil {
+			// nolint:errcheck
+			r.git("merge", "--abort")
+			return err
+		}
+		changed = true
+	default:
+		return &ResolutionError{fmt.Sprintf("unknown resolution strategy %s", strategy)}
+	}
+	log.Infof("Git mode: conflict with remote branch %s resolved with %s strategy", r.remote.Branch, strategy)
+	r.conflict = nil
+	if changed && r.OnChange != nil {
+		if err := r.OnChange(); err != nil {
+			return err
+		}
+	}
+	return r.push()
+}
+
+func (r *Repository) conflicting(path string) bool {
+	for _, f := range r.conflict.Files {
+		if f.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// detectConflict fetches the remote branch after a push failed, recording a
+// conflict when it diverged from the local one. It returns false when the
+// push failed for another reason.
+func (r *Repository) detectConflict() (bool, error) {
+	if err := r.fetch(); err != nil {
+		return false, err
+	}
+	if r.ancestor(remoteRef, "HEAD") {
+		// the local branch contains the remote one, pushing failed otherwise
+		return false, nil
+	}
+	if err := r.recordConflict(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// recordConflict records the conflict between the local branch and the
+// fetched remote one
+func (r *Repository) recordConflict() error {
+	base, err := r.git("merge-base", "HEAD", remoteRef)
+	if err != nil {
+		return err
+	}
+	local, err := r.git("rev-parse", "HEAD")
+	if err != nil {
+		return err
+	}
+	remote, err := r.git("rev-parse", remoteRef)
+	if err != nil {
+		return err
+	}
+	c := &Conflict{
+		LocalCommit:  strings.TrimSpace(local),
+		RemoteCommit: strings.TrimSpace(remote),
+		BaseCommit:   strings.TrimSpace(base),
+		Detected:     time.Now(),
+		Files:        make([]ConflictFile, 0),
+		RemoteOnly:   make([]string, 0),
+	}
+	localFiles, err := r.changedFiles(c.BaseCommit, c.LocalCommit)
+	if err != nil {
+		return err
+	}
+	remoteFiles, err := r.changedFiles(c.BaseCommit, c.RemoteCommit)
+	if err != nil {
+		return err
+	}
+	for _, path := range remoteFiles {
+		if !contains(localFiles, path) {
+			c.RemoteOnly = append(c.RemoteOnly, path)
+			continue
+		}
+		f := ConflictFile{Path: path}
+		if f.Local, err = r.git("diff", "--relative", c.BaseCommit, c.LocalCommit, "--", path); err != nil {
+			return err
+		}
+		if f.Remote, err = r.git("diff", "--relative", c.BaseCommit, c.RemoteCommit, "--", path); err != nil {
+			return err
+		}
+		c.Files = append(c.Files, f)
+	}
+	r.conflict = c
+	log.Warningf("Git mode: remote branch %s diverged, pushing is suspended until the conflict is resolved", r.remote.Branch)
+	return nil
+}
+
+func (r *Repository) fetch() error {
+	_, err := r.git("fetch", "--quiet", "--", r.remote.URL, "+refs/heads/"+r.remote.Branch+":"+remoteRef)
+	return err
+}
+
+// ancestor reports whether commit is an ancestor of, or is, of
+func (r *Repository) ancestor(commit, of string) bool {
+	_, err := r.git("merge-base", "--is-ancestor", commit, of)
+	return err == nil
+}
+
+func (r *Repository) changedFiles(from, to string) ([]string, error) {
+	out, err := r.git("diff", "--name-only", "--relative", "-z", from, to)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0)
+	for _, f := range strings.Split(out, "\x00") {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitmode
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// commandTimeout bounds git commands, the ones reaching the remote included
+const commandTimeout = 30 * time.Second
+
+// Repository commits the files changed by each committed transaction to a
+// git repository, one commit per transaction
+type Repository struct {
+	mu          sync.Mutex
+	dir         string
+	paths       []string
+	authorName  string
+	authorEmail string
+	remote      *Remote
+	signing     *Signing
+	conflict    *Conflict
+	// OnChange is called when resolving a conflict or pulling changed the
+	// files on disk
+	OnChange func() error
+	// Validate checks the files of the revision pulled before they replace the
+	// ones on disk, read returning the content of a file in that revision
+	Validate func(read func(path string) ([]byte, error)) error
+}
+
+// NewRepository returns a repository in dir tracking the files under paths,
+// the repository is initialized when dir is not in one yet. Paths outside of
+// dir are ignored.
+func NewRepository(dir string, paths []string, authorName, authorEmail string) (*Repository, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	r := &Repository{dir: dir, paths: make([]string, 0, len(paths)), authorName: authorName, authorEmail: authorEmail}
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return nil, err
+		}
+		rel, err := filepath.Rel(dir, abs)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			log.Warningf("Git mode: %s is outside of %s, ignoring it", p, dir)
+			continue
+		}
+		r.paths = append(r.paths, rel)
+	}
+	if len(r.paths) == 0 {
+		return nil, fmt.Errorf("no file to track in %s", dir)
+	}
+	if _, err := r.git("rev-parse", "--is-inside-work-tree"); err != nil {
+		if _, err := r.git("init"); err != nil {
+			return nil, err
+		}
+		log.Infof("Git mode: initialized repository in %s", dir)
+	}
+	return r, nil
+}
+
+// Commit commits the tracked files changed since the last commit. The
+// transaction ID, the API user and the transaction labels are set as trailers
+// of the commit message, an empty transaction ID standing for a change made
+// without a transaction. The transaction note is the body of the message.
+// Nothing is committed when no tracked file changed.
+func (r *Repository) Commit(transactionID, user, note string, labels map[string]string) error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	paths := r.existingPaths()
+	if len(paths) == 0 {
+		return nil
+	}
+	if _, err := r.git(append([]string{"add", "--all", "--"}, paths...)...); err != nil {
+		return err
+	}
+	changed, err := r.git(append([]string{"diff", "--cached", "--name-only", "--relative", "-z", "--"}, paths...)...)
+	if err != nil {
+		return err
+	}
+	files := make([]string, 0)
+	for _, f := range strings.Split(changed, "\x00") {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	var msg strings.Builder
+	if transactionID != "" {
+		fmt.Fprintf(&msg, "Commit transaction %s\n\n", transactionID)
+	} else {
+		msg.WriteString("Change configuration\n\n")
+	}
+	if note = strings.TrimSpace(note); note != "" {
+		fmt.Fprintf(&msg, "%s\n\n", note)
+	}
+	for _, f := range files {
+		fmt.Fprintf(&msg, "  %s\n", f)
+	}
+	msg.WriteString("\n")
+	if transactionID != "" {
+		fmt.Fprintf(&msg, "Transaction-ID: %s\n", transactionID)
+	}
+	if user != "" {
+		fmt.Fprintf(&msg, "API-User: %s\n", user)
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&msg, "Label: %s=%s\n", k, labels[k])
+	}
+	// only the changed files are committed, leaving anything else staged in
+	// the repository as it is
+	if _, err = r.git(append([]string{"commit", "--quiet", "--message", msg.String(), "--"}, files...)...); err != nil {
+		return err
+	}
+	if r.remote != nil && r.remote.Push {
+		// a failed push is caught up by the next one
+		return r.push()
+	}
+	return nil
+}
+
+// existingPaths returns the tracked paths present on disk, git refusing to
+// add a path matching no file. Deleted files of a tracked directory are
+// still committed as the directory exists.
+func (r *Repository) existingPaths() []string {
+	paths := make([]string, 0, len(r.paths))
+	for _, p := range r.paths {
+		if _, err := os.Stat(filepath.Join(r.dir, p)); err == nil {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+func (r *Repository) git(args ...string) (string, error) {
+	global := []string{"-C", r.dir}
+	if r.authorName != "" {
+		global = append(global, "-c", "user.name="+r.authorName)
+	}
+	if r.authorEmail != "" {
+		global = append(global, "-c", "user.email="+r.authorEmail)
+	}
+	if r.signing != nil {
+		global = append(global, r.signing.options()...)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	// nolint:gosec
+	cmd := exec.CommandContext(ctx, "git", append(global, args...)...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if r.remote != nil {
+		cmd.Env = append(cmd.Env, "GIT_SSH_COMMAND="+r.remote.sshCommand())
+	}
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %s: %s", args[0], err.Error(), strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitmode
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrDiverged is returned when pulling a remote branch that diverged
+	// from the local one, the conflict is recorded to be resolved
+	ErrDiverged = errors.New("remote branch diverged from the local one, resolve the git conflict to apply it")
+	// ErrInvalidSignature is returned for webhook requests not signed with
+	// the webhook secret
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// Pull fetches the remote branch and fast-forwards the local one to it. The
+// files of the remote branch are checked with the Validate callback before
+// the ones on disk are changed, then applied through the OnChange callback.
+// It reports whether anything was pulled.
+func (r *Repository) Pull() (bool, error) {
+	if r == nil || r.remote == nil || r.remote.URL == "" {
+		return false, fmt.Errorf("no git remote is configured")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.conflict != nil {
+		return false, ErrDiverged
+	}
+	if err := r.fetch(); err != nil {
+		return false, err
+	}
+	if r.ancestor(remoteRef, "HEAD") {
+		return false, nil
+	}
+	if !r.ancestor("HEAD", remoteRef) {
+		if err := r.recordConflict(); err != nil {
+			return false, err
+		}
+		return false, ErrDiverged
+	}
+	// the remote files are validated from the fetched revision, the
+	// configuration on disk being read by commits and reloads meanwhile
+	if r.Validate != nil {
+		if err := r.Validate(r.reader(remoteRef)); err != nil {
+			return false, fmt.Errorf("configuration of remote branch %s is invalid: %s", r.remote.Branch, err.Error())
+		}
+	}
+	if _, err := r.git("merge", "--quiet", "--ff-only", remoteRef); err != nil {
+		return false, err
+	}
+	log.Infof("Git mode: pulled remote branch %s", r.remote.Branch)
+	if r.OnChange != nil {
+		if err := r.OnChange(); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// reader returns a function reading the files of the repository at the
+// revision ref
+func (r *Repository) reader(ref string) func(path string) ([]byte, error) {
+	return func(path string) ([]byte, error) {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+		rel, err := filepath.Rel(r.dir, abs)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("%s is outside of %s", path, r.dir)
+		}
+		out, err := r.git("show", ref+":"+filepath.ToSlash(rel))
+		if err != nil {
+			return nil, err
+		}
+		return []byte(out), nil
+	}
+}
+
+// Webhook is a push event sent by GitHub or GitLab
+type Webhook struct {
+	// Ping is set for the event GitHub sends when the webhook is created
+	Ping bool
+	// Ref is the pushed reference, such as refs/heads/master
+	Ref string
+}
+
+// ParseWebhook checks the signature of a webhook request and returns its
+// event. GitHub requests are signed with an HMAC of the body in the
+// X-Hub-Signature-256 header, GitLab ones carry the secret in the
+// X-Gitlab-Token header.
+func ParseWebhook(secret string, header http.Header, body []byte) (*Webhook, error) {
+	if secret == "" {
+		return nil, ErrInvalidSignature
+	}
+	switch {
+	case header.Get("X-Hub-Signature-256") != "":
+		signature, err := hex.DecodeString(strings.TrimPrefix(header.Get("X-Hub-Signature-256"), "sha256="))
+		if err != nil {
+			return nil, ErrInvalidSignature
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return nil, ErrInvalidSignature
+		}
+	case header.Get("X-Gitlab-Token") != "":
+		if subtle.ConstantTimeCompare([]byte(header.Get("X-Gitlab-Token")), []byte(secret)) != 1 {
+			return nil, ErrInvalidSignature
+		}
+	default:
+		return nil, ErrInvalidSignature
+	}
+	if header.Get("X-GitHub-Event") == "ping" {
+		return &Webhook{Ping: true}, nil
+	}
+	var event struct {
+		Ref string `json:"ref"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %s", err.Error())
+	}
+	return &Webhook{Ref: event.Ref}, nil
+}
+
+// Pushes reports whether a webhook event is a push to the remote branch
+func (r *Repository) Pushes(w *Webhook) bool {
+	return r != nil && r.remote != nil && !w.Ping && w.Ref == "refs/heads/"+r.remote.Branch
+}
@@ -0,0 +1,166 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitmode
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/haproxytech/dataplaneapi/system"
+)
+
+const defaultBranch = "master"
+
+// Remote is the repository commits are pushed to. SSH remotes are reached
+// with the SSHKey deploy key, their host key being checked strictly against
+// the KnownHosts file.
+type Remote struct {
+	URL        string
+	Branch     string
+	Push       bool
+	SSHKey     string
+	KnownHosts string
+}
+
+// SetRemote sets the remote of the repository, it must be called before the
+// repository is used
+func (r *Repository) SetRemote(remote Remote) {
+	if remote.Branch == "" {
+		remote.Branch = defaultBranch
+	}
+	r.remote = &remote
+}
+
+// TestRemote connects to the remote without pushing, returning the error of
+// git when it can not be reached
+func (r *Repository) TestRemote() error {
+	if r == nil || r.remote == nil || r.remote.URL == "" {
+		return fmt.Errorf("no git remote is configured")
+	}
+	_, err := r.git("ls-remote", "--heads", "--", r.remote.URL)
+	return err
+}
+
+func (r *Repository) push() error {
+	if r.remote.URL == "" {
+		return fmt.Errorf("push is enabled but no git remote is configured")
+	}
+	if r.conflict != nil {
+		// suspended until the conflict is resolved
+		return nil
+	}
+	_, err := r.git("push", "--quiet", "--", r.remote.URL, "HEAD:refs/heads/"+r.remote.Branch)
+	if err == nil {
+		return nil
+	}
+	diverged, fetchErr := r.detectConflict()
+	if fetchErr != nil {
+		return fmt.Errorf("%s, fetching the remote branch failed too: %s", err.Error(), fetchErr.Error())
+	}
+	if diverged {
+		return fmt.Errorf("remote branch %s diverged, pushing is suspended until the conflict is resolved", r.remote.Branch)
+	}
+	return err
+}
+
+// sshCommand returns the command git reaches SSH remotes with, never
+// prompting and refusing hosts whose key is not known
+func (rm *Remote) sshCommand() string {
+	args := []string{"ssh", "-o", "BatchMode=yes", "-o", "StrictHostKeyChecking=yes"}
+	if rm.KnownHosts != "" {
+		args = append(args, "-o", "UserKnownHostsFile="+shellQuote(rm.KnownHosts))
+	}
+	if rm.SSHKey != "" {
+		args = append(args, "-o", "IdentitiesOnly=yes", "-i", shellQuote(rm.SSHKey))
+	}
+	return strings.Join(args, " ")
+}
+
+// KnownHosts returns the lines of the known_hosts file of the remote
+func (r *Repository) KnownHosts() ([]string, error) {
+	if r == nil || r.remote == nil || r.remote.KnownHosts == "" {
+		return nil, fmt.Errorf("no known_hosts file is configured")
+	}
+	data, err := ioutil.ReadFile(r.remote.KnownHosts)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]string, 0)
+	for _, l := range strings.Split(string(data), "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines, nil
+}
+
+// ReplaceKnownHosts replaces the known_hosts file of the remote, after
+// checking each line is a host key or a comment
+func (r *Repository) ReplaceKnownHosts(lines []string) error {
+	if r == nil || r.remote == nil || r.remote.KnownHosts == "" {
+		return fmt.Errorf("no known_hosts file is configured")
+	}
+	var data strings.Builder
+	for _, l := range lines {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		if err := validateKnownHost(l); err != nil {
+			return err
+		}
+		data.WriteString(l + "\n")
+	}
+	return system.WriteFile(r.remote.KnownHosts, []byte(data.String()), 0600)
+}
+
+// validateKnownHost checks a line of a known_hosts file, made of an optional
+// marker, the host patterns, the key type, the base64 encoded key and an
+// optional comment
+func validateKnownHost(line string) error {
+	if strings.HasPrefix(line, "#") {
+		return nil
+	}
+	fields := strings.Fields(line)
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "@") {
+		if fields[0] != "@cert-authority" && fields[0] != "@revoked" {
+			return fmt.Errorf("unknown marker %s in known host '%s'", fields[0], line)
+		}
+		fields = fields[1:]
+	}
+	if len(fields) < 3 {
+		return fmt.Errorf("known host '%s' must be made of host patterns, key type and key", line)
+	}
+	key, err := base64.StdEncoding.DecodeString(fields[2])
+	if err != nil {
+		return fmt.Errorf("invalid key in known host '%s': %s", line, err.Error())
+	}
+	// the key starts with its type, as a length prefixed string
+	if len(key) < 4+len(fields[1]) || string(key[4:4+len(fields[1])]) != fields[1] {
+		return fmt.Errorf("key of known host '%s' is not of type %s", line, fields[1])
+	}
+	return nil
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
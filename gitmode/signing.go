@@ -0,0 +1,79 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitmode
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// SigningOpenPGP signs commits with a GPG key
+	SigningOpenPGP = "openpgp"
+	// SigningSSH signs commits with an SSH key, it requires git 2.34
+	SigningSSH = "ssh"
+	// SigningX509 signs commits with an X.509 certificate through gpgsm
+	SigningX509 = "x509"
+)
+
+// Signing is the key every commit, merges included, is signed with
+type Signing struct {
+	// Format is openpgp, ssh or x509, defaults to openpgp
+	Format string
+	// Key is the key ID for openpgp and x509, and the path of the private
+	// key, or of its public part when held by an agent, for ssh
+	Key string
+	// Program signing the commits, defaults to the one of the format
+	Program string
+}
+
+// SetSigning makes the repository sign its commits, it must be called
+// before the repository is used
+func (r *Repository) SetSigning(signing Signing) error {
+	if signing.Key == "" {
+		return fmt.Errorf("no signing key is set")
+	}
+	if signing.Format == "" {
+		signing.Format = SigningOpenPGP
+	}
+	switch signing.Format {
+	case SigningOpenPGP, SigningX509:
+	case SigningSSH:
+		if !strings.HasPrefix(signing.Key, "key::") {
+			if _, err := os.Stat(signing.Key); err != nil {
+				return fmt.Errorf("ssh signing key: %s", err.Error())
+			}
+		}
+	default:
+		return fmt.Errorf("unknown signing format %s, expected %s, %s or %s", signing.Format, SigningOpenPGP, SigningSSH, SigningX509)
+	}
+	r.signing = &signing
+	return nil
+}
+
+// options returns the git configuration signing commits
+func (s *Signing) options() []string {
+	options := []string{
+		"-c", "commit.gpgsign=true",
+		"-c", "gpg.format=" + s.Format,
+		"-c", "user.signingkey=" + s.Key,
+	}
+	if s.Program != "" {
+		options = append(options, "-c", fmt.Sprintf("gpg.%s.program=%s", s.Format, s.Program))
+	}
+	return options
+}
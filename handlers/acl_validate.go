@@ -0,0 +1,92 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"path/filepath"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/operations/acl"
+)
+
+//ValidateACLHandlerImpl implementation of the ValidateACLHandler interface
+type ValidateACLHandlerImpl struct {
+	Validator *haproxy.SampleValidator
+	// ConfigFile is the HAProxy configuration file, relative pattern files are
+	// looked up in its directory
+	ConfigFile string
+}
+
+//Handle executing the request and returning a response
+func (h *ValidateACLHandlerImpl) Handle(params acl.ValidateACLParams, principal interface{}) middleware.Responder {
+	valid := false
+	report := &acl.ValidateACLOKBody{
+		Valid:      &valid,
+		Converters: []string{},
+		Patterns:   []string{},
+		Files:      []string{},
+		Errors:     []string{},
+		Warnings:   []string{},
+	}
+
+	a, err := haproxy.ParseACLExpression(*params.Data.Expression)
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+		return acl.NewValidateACLOK().WithPayload(report)
+	}
+	report.Criterion = a.Criterion
+	report.Fetch = a.Fetch
+	report.Converters = a.Converters
+	report.Match = a.Match
+	report.CaseInsensitive = a.CaseInsensitive
+	report.Patterns = a.Patterns
+	report.Files = a.Files
+
+	baseDir := filepath.Dir(h.ConfigFile)
+	report.Errors, report.Warnings = h.Validator.ValidateACL(a, baseDir)
+	valid = len(report.Errors) == 0
+
+	if valid && params.Data.Sample != nil {
+		s := params.Data.Sample
+		sample := &haproxy.ACLSample{
+			Method:  s.Method,
+			Path:    s.Path,
+			Query:   s.Query,
+			Version: s.Version,
+			Headers: make([]haproxy.ACLSampleHeader, 0, len(s.Headers)),
+			Src:     s.Src,
+			SrcPort: s.SrcPort,
+			Dst:     s.Dst,
+			DstPort: s.DstPort,
+			SSL:     s.Ssl,
+		}
+		for _, hdr := range s.Headers {
+			if hdr != nil && hdr.Name != nil {
+				sample.Headers = append(sample.Headers, haproxy.ACLSampleHeader{Name: *hdr.Name, Value: hdr.Value})
+			}
+		}
+		result, detail, err := haproxy.EvaluateACL(a, sample, baseDir)
+		if err != nil {
+			report.Evaluation = err.Error()
+		} else {
+			report.Result = &result
+			report.Evaluation = detail
+		}
+	}
+	return acl.NewValidateACLOK().WithPayload(report)
+}
@@ -0,0 +1,164 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	api_errors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+
+	"github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/annotations"
+)
+
+//GetAnnotationsHandlerImpl implementation of the GetAnnotationsHandler interface
+type GetAnnotationsHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//GetAnnotationHandlerImpl implementation of the GetAnnotationHandler interface
+type GetAnnotationHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//ReplaceAnnotationHandlerImpl implementation of the ReplaceAnnotationHandler interface
+type ReplaceAnnotationHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//DeleteAnnotationHandlerImpl implementation of the DeleteAnnotationHandler interface
+type DeleteAnnotationHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//Handle executing the request and returning a response
+func (h *GetAnnotationsHandlerImpl) Handle(params annotations.GetAnnotationsParams, principal interface{}) middleware.Responder {
+	items := h.Config.Annotations.Get()
+	data := make([]*annotations.GetAnnotationsOKBodyItems0, 0, len(items))
+	for _, a := range items {
+		data = append(data, &annotations.GetAnnotationsOKBodyItems0{
+			Type:      a.Type,
+			Name:      a.Name,
+			Owner:     a.Owner,
+			Protected: a.Protected,
+		})
+	}
+	return annotations.NewGetAnnotationsOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *GetAnnotationHandlerImpl) Handle(params annotations.GetAnnotationParams, principal interface{}) middleware.Responder {
+	a, ok := h.Config.Annotations.Find(params.Type, params.Name)
+	if !ok {
+		e := misc.HandleError(annotationNotFound(params.Type, params.Name))
+		return annotations.NewGetAnnotationDefault(int(*e.Code)).WithPayload(e)
+	}
+	return annotations.NewGetAnnotationOK().WithPayload(&annotations.GetAnnotationOKBody{
+		Type:      a.Type,
+		Name:      a.Name,
+		Owner:     a.Owner,
+		Protected: a.Protected,
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceAnnotationHandlerImpl) Handle(params annotations.ReplaceAnnotationParams, principal interface{}) middleware.Responder {
+	user, _ := principal.(string)
+	annotation := configuration.Annotation{
+		Type:      params.Type,
+		Name:      params.Name,
+		Owner:     params.Data.Owner,
+		Protected: params.Data.Protected,
+	}
+	err := h.Config.UpdateAnnotations(func(items []configuration.Annotation) ([]configuration.Annotation, error) {
+		i := findAnnotation(items, params.Type, params.Name)
+		if i >= 0 {
+			if err := checkAnnotationChange(h.Config.Annotations, items[i], user, *params.Force); err != nil {
+				return nil, err
+			}
+		}
+		if annotation.Protected && !h.Config.Annotations.Admin(user) {
+			return nil, api_errors.New(http.StatusForbidden, "only admins can protect %s %s", params.Type, params.Name)
+		}
+		if i >= 0 {
+			items[i] = annotation
+			return items, nil
+		}
+		return append(items, annotation), nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return annotations.NewReplaceAnnotationDefault(int(*e.Code)).WithPayload(e)
+	}
+	return annotations.NewReplaceAnnotationOK().WithPayload(&annotations.ReplaceAnnotationOKBody{
+		Type:      annotation.Type,
+		Name:      annotation.Name,
+		Owner:     annotation.Owner,
+		Protected: annotation.Protected,
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteAnnotationHandlerImpl) Handle(params annotations.DeleteAnnotationParams, principal interface{}) middleware.Responder {
+	user, _ := principal.(string)
+	err := h.Config.UpdateAnnotations(func(items []configuration.Annotation) ([]configuration.Annotation, error) {
+		i := findAnnotation(items, params.Type, params.Name)
+		if i < 0 {
+			return nil, annotationNotFound(params.Type, params.Name)
+		}
+		if err := checkAnnotationChange(h.Config.Annotations, items[i], user, *params.Force); err != nil {
+			return nil, err
+		}
+		return append(items[:i], items[i+1:]...), nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return annotations.NewDeleteAnnotationDefault(int(*e.Code)).WithPayload(e)
+	}
+	return annotations.NewDeleteAnnotationNoContent()
+}
+
+func findAnnotation(items []configuration.Annotation, sectionType, name string) int {
+	for i, a := range items {
+		if a.Type == sectionType && a.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkAnnotationChange returns an error when the user is not allowed to
+// change the existing annotation of a protected section
+func checkAnnotationChange(a *configuration.Annotations, existing configuration.Annotation, user string, force bool) error {
+	if !existing.Protected {
+		return nil
+	}
+	if !force {
+		return api_errors.New(http.StatusConflict, "%s %s is protected, set force=true to change its annotation", existing.Type, existing.Name)
+	}
+	if !a.Admin(user) {
+		return api_errors.New(http.StatusForbidden, "%s %s is protected, only admins can change its annotation", existing.Type, existing.Name)
+	}
+	return nil
+}
+
+func annotationNotFound(sectionType, name string) error {
+	return native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("no annotation for %s %s", sectionType, name))
+}
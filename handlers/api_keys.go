@@ -0,0 +1,250 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	api_errors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+
+	"github.com/haproxytech/dataplaneapi/auth"
+	"github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/users"
+)
+
+//GetAPIKeysHandlerImpl implementation of the GetAPIKeysHandler interface
+type GetAPIKeysHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//GetAPIKeyHandlerImpl implementation of the GetAPIKeyHandler interface
+type GetAPIKeyHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//CreateAPIKeyHandlerImpl implementation of the CreateAPIKeyHandler interface
+type CreateAPIKeyHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//ReplaceAPIKeyHandlerImpl implementation of the ReplaceAPIKeyHandler interface
+type ReplaceAPIKeyHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//DeleteAPIKeyHandlerImpl implementation of the DeleteAPIKeyHandler interface
+type DeleteAPIKeyHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//Handle executing the request and returning a response
+func (h *GetAPIKeysHandlerImpl) Handle(params users.GetAPIKeysParams, principal interface{}) middleware.Responder {
+	now := time.Now()
+	items := h.Config.APIKeys.Get()
+	data := make([]*users.GetAPIKeysOKBodyItems0, 0, len(items))
+	for _, k := range items {
+		if !apiKeyVisible(h.Config, k, principal, params.HTTPRequest) {
+			continue
+		}
+		data = append(data, &users.GetAPIKeysOKBodyItems0{
+			ID:        k.ID,
+			Name:      k.Name,
+			User:      k.User,
+			Roles:     k.Roles,
+			CreatedAt: strfmt.DateTime(k.Created),
+			ExpiresAt: apiKeyExpires(k),
+			Expired:   k.Expired(now),
+		})
+	}
+	return users.NewGetAPIKeysOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *GetAPIKeyHandlerImpl) Handle(params users.GetAPIKeyParams, principal interface{}) middleware.Responder {
+	k, ok := h.Config.APIKeys.Find(params.ID)
+	if !ok || !apiKeyVisible(h.Config, k, principal, params.HTTPRequest) {
+		e := misc.HandleError(apiKeyNotFound(params.ID))
+		return users.NewGetAPIKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+	return users.NewGetAPIKeyOK().WithPayload(&users.GetAPIKeyOKBody{
+		ID:        k.ID,
+		Name:      k.Name,
+		User:      k.User,
+		Roles:     k.Roles,
+		CreatedAt: strfmt.DateTime(k.Created),
+		ExpiresAt: apiKeyExpires(k),
+		Expired:   k.Expired(time.Now()),
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *CreateAPIKeyHandlerImpl) Handle(params users.CreateAPIKeyParams, principal interface{}) middleware.Responder {
+	user, _ := principal.(string)
+	expires, err := apiKeyExpiration(params.Data.ExpiresAt, params.HTTPRequest)
+	if err != nil {
+		e := misc.HandleError(err)
+		return users.NewCreateAPIKeyBadRequest().WithPayload(e)
+	}
+	k, key, err := configuration.NewAPIKey(params.Data.Name, user, auth.Roles(params.HTTPRequest), expires)
+	if err != nil {
+		e := misc.HandleError(err)
+		return users.NewCreateAPIKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+	err = h.Config.UpdateAPIKeys(func(items []configuration.APIKey) ([]configuration.APIKey, error) {
+		return append(items, k), nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return users.NewCreateAPIKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+	return users.NewCreateAPIKeyCreated().WithPayload(&users.CreateAPIKeyCreatedBody{
+		ID:        k.ID,
+		Name:      k.Name,
+		User:      k.User,
+		Roles:     k.Roles,
+		CreatedAt: strfmt.DateTime(k.Created),
+		ExpiresAt: apiKeyExpires(k),
+		Key:       key,
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceAPIKeyHandlerImpl) Handle(params users.ReplaceAPIKeyParams, principal interface{}) middleware.Responder {
+	expires, err := apiKeyExpiration(params.Data.ExpiresAt, params.HTTPRequest)
+	if err != nil {
+		e := misc.HandleError(err)
+		return users.NewReplaceAPIKeyBadRequest().WithPayload(e)
+	}
+	var k configuration.APIKey
+	err = h.Config.UpdateAPIKeys(func(items []configuration.APIKey) ([]configuration.APIKey, error) {
+		i := findAPIKey(items, params.ID)
+		if i < 0 {
+			return nil, apiKeyNotFound(params.ID)
+		}
+		if err := checkAPIKeyUser(h.Config, items[i], principal, params.HTTPRequest, *params.Force); err != nil {
+			return nil, err
+		}
+		items[i].Name = params.Data.Name
+		items[i].Expires = expires
+		k = items[i]
+		return items, nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return users.NewReplaceAPIKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+	return users.NewReplaceAPIKeyOK().WithPayload(&users.ReplaceAPIKeyOKBody{
+		ID:        k.ID,
+		Name:      k.Name,
+		User:      k.User,
+		Roles:     k.Roles,
+		CreatedAt: strfmt.DateTime(k.Created),
+		ExpiresAt: apiKeyExpires(k),
+		Expired:   k.Expired(time.Now()),
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteAPIKeyHandlerImpl) Handle(params users.DeleteAPIKeyParams, principal interface{}) middleware.Responder {
+	err := h.Config.UpdateAPIKeys(func(items []configuration.APIKey) ([]configuration.APIKey, error) {
+		i := findAPIKey(items, params.ID)
+		if i < 0 {
+			return nil, apiKeyNotFound(params.ID)
+		}
+		if err := checkAPIKeyUser(h.Config, items[i], principal, params.HTTPRequest, *params.Force); err != nil {
+			return nil, err
+		}
+		return append(items[:i], items[i+1:]...), nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return users.NewDeleteAPIKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+	return users.NewDeleteAPIKeyNoContent()
+}
+
+// checkAPIKeyUser returns an error when the user of principal is not allowed
+// to change the key k, the keys of the other users being reserved to the
+// admins forcing it
+func checkAPIKeyUser(c *configuration.Configuration, k configuration.APIKey, principal interface{}, r *http.Request, force bool) error {
+	user, _ := principal.(string)
+	if k.User == user {
+		return nil
+	}
+	if !force {
+		return api_errors.New(http.StatusForbidden, "API key %s belongs to user %s, set force to change it", k.ID, k.User)
+	}
+	if !c.RBAC.Admin(user, auth.Roles(r)) {
+		return api_errors.New(http.StatusForbidden, "user %s is not allowed to change the API keys of other users", user)
+	}
+	return nil
+}
+
+// apiKeyVisible reports whether the user of principal may read the key k, the
+// keys of the other users being listed to the admins only
+func apiKeyVisible(c *configuration.Configuration, k configuration.APIKey, principal interface{}, r *http.Request) bool {
+	user, _ := principal.(string)
+	return k.User == user || c.RBAC.Admin(user, auth.Roles(r))
+}
+
+func findAPIKey(items []configuration.APIKey, id string) int {
+	for i, k := range items {
+		if k.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// apiKeyExpiration returns the expiration date of a key set with the request r,
+// which cannot be in the past. A key does not outlive the credentials of r, an
+// API key or a token, its expiration date being capped at theirs.
+func apiKeyExpiration(expiresAt *strfmt.DateTime, r *http.Request) (*time.Time, error) {
+	var expires *time.Time
+	if expiresAt != nil {
+		t := time.Time(*expiresAt).UTC()
+		if !t.After(time.Now()) {
+			return nil, api_errors.New(http.StatusBadRequest, "expiration date %s is in the past", t.Format(time.RFC3339))
+		}
+		expires = &t
+	}
+	if r == nil {
+		return expires, nil
+	}
+	if p := auth.FromRequest(r); p != nil && !p.Expires.IsZero() && (expires == nil || expires.After(p.Expires)) {
+		t := p.Expires.UTC()
+		expires = &t
+	}
+	return expires, nil
+}
+
+func apiKeyExpires(k configuration.APIKey) *strfmt.DateTime {
+	if k.Expires == nil {
+		return nil
+	}
+	t := strfmt.DateTime(*k.Expires)
+	return &t
+}
+
+func apiKeyNotFound(id string) error {
+	return native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("no API key %s", id))
+}
@@ -0,0 +1,94 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/haproxytech/dataplaneapi/auth"
+	"github.com/haproxytech/dataplaneapi/configuration"
+)
+
+func TestAPIKeyExpiration(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	hour, day := now.Add(time.Hour), now.Add(24*time.Hour)
+	date := func(t time.Time) *strfmt.DateTime {
+		d := strfmt.DateTime(t)
+		return &d
+	}
+	tests := []struct {
+		name        string
+		expiresAt   *strfmt.DateTime
+		credentials time.Time
+		expires     *time.Time
+		invalid     bool
+	}{
+		{"never", nil, time.Time{}, nil, false},
+		{"date", date(day), time.Time{}, &day, false},
+		{"past", date(now.Add(-time.Hour)), time.Time{}, nil, true},
+		{"never capped", nil, hour, &hour, false},
+		{"date capped", date(day), hour, &hour, false},
+		{"date before the credentials", date(hour), day, &hour, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/v2/services/haproxy/api_keys", nil)
+			r = auth.WithPrincipal(r, &auth.Principal{User: "ci", Expires: tt.credentials})
+			expires, err := apiKeyExpiration(tt.expiresAt, r)
+			if (err != nil) != tt.invalid {
+				t.Fatalf("invalid %v, got error %v", tt.invalid, err)
+			}
+			switch {
+			case tt.expires == nil && expires != nil:
+				t.Fatalf("expected no expiration date, got %s", expires)
+			case tt.expires != nil && (expires == nil || !expires.Equal(*tt.expires)):
+				t.Fatalf("expected %s, got %v", tt.expires, expires)
+			}
+		})
+	}
+}
+
+func TestAPIKeyVisible(t *testing.T) {
+	c := configuration.New()
+	c.RBAC = &configuration.RBAC{
+		Assignments: []configuration.RoleAssignment{
+			{User: "admin", Role: configuration.RoleAdmin},
+			{User: "app", Role: configuration.RoleAdmin, Scopes: []string{"backend:app_"}},
+		},
+	}
+	k := configuration.APIKey{ID: "k", User: "ci"}
+	tests := []struct {
+		user    string
+		visible bool
+	}{
+		{"ci", true},
+		{"admin", true},
+		{"app", false},
+		{"other", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.user, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/v2/services/haproxy/api_keys", nil)
+			if visible := apiKeyVisible(c, k, tt.user, r); visible != tt.visible {
+				t.Fatalf("expected visible %v, got %v", tt.visible, visible)
+			}
+		})
+	}
+}
@@ -41,6 +41,11 @@ type GetBackendHandlerImpl struct {
 	Client *client_native.HAProxyClient
 }
 
+//GetBackendFullHandlerImpl implementation of the GetBackendFullHandler interface
+type GetBackendFullHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
 //GetBackendsHandlerImpl implementation of the GetBackendsHandler interface using client-native client
 type GetBackendsHandlerImpl struct {
 	Client *client_native.HAProxyClient
@@ -206,3 +211,61 @@ func (h *ReplaceBackendHandlerImpl) Handle(params backend.ReplaceBackendParams,
 	}
 	return backend.NewReplaceBackendAccepted().WithPayload(params.Data)
 }
+
+//Handle executing the request and returning a response
+func (h *GetBackendFullHandlerImpl) Handle(params backend.GetBackendFullParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, bck, err := h.Client.Configuration.GetBackend(params.Name, t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewGetBackendFullDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data, err := getBackendNested(h.Client, params.Name, t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewGetBackendFullDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data.Backend = bck
+	return backend.NewGetBackendFullOK().WithPayload(&backend.GetBackendFullOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+// getBackendNested returns the resources nested in a backend
+func getBackendNested(client *client_native.HAProxyClient, name string, t string) (*backend.GetBackendFullOKBodyData, error) {
+	var err error
+	data := &backend.GetBackendFullOKBodyData{}
+	if _, data.Servers, err = client.Configuration.GetServers(name, t); err != nil {
+		return nil, err
+	}
+	if _, data.Acls, err = client.Configuration.GetACLs("backend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.HTTPRequestRules, err = client.Configuration.GetHTTPRequestRules("backend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.HTTPResponseRules, err = client.Configuration.GetHTTPResponseRules("backend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.TCPRequestRules, err = client.Configuration.GetTCPRequestRules("backend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.TCPResponseRules, err = client.Configuration.GetTCPResponseRules(name, t); err != nil {
+		return nil, err
+	}
+	if _, data.ServerSwitchingRules, err = client.Configuration.GetServerSwitchingRules(name, t); err != nil {
+		return nil, err
+	}
+	if _, data.StickRules, err = client.Configuration.GetStickRules(name, t); err != nil {
+		return nil, err
+	}
+	if _, data.Filters, err = client.Configuration.GetFilters("backend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.LogTargets, err = client.Configuration.GetLogTargets("backend", name, t); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,583 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/bandwidth_limit"
+)
+
+// bandwidthLimitVersion is the first HAProxy version with bandwidth limitation filters
+const bandwidthLimitVersion = "2.7"
+
+var (
+	bandwidthSizeRegexp = regexp.MustCompile(`^\d+[kmgKMG]?$`)
+	bandwidthTimeRegexp = regexp.MustCompile(`^\d+(us|ms|s|m|h|d)?$`)
+)
+
+// bandwidthLimitRuleKeywords are the configuration keywords of the set-bandwidth-limit rule types
+var bandwidthLimitRuleKeywords = map[string]string{
+	"http-request":  "http-request",
+	"http-response": "http-response",
+	"tcp-request":   "tcp-request content",
+	"tcp-response":  "tcp-response content",
+}
+
+//GetBandwidthLimitsHandlerImpl implementation of the GetBandwidthLimitsHandler interface
+type GetBandwidthLimitsHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//GetBandwidthLimitHandlerImpl implementation of the GetBandwidthLimitHandler interface
+type GetBandwidthLimitHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//CreateBandwidthLimitHandlerImpl implementation of the CreateBandwidthLimitHandler interface
+type CreateBandwidthLimitHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
+}
+
+//ReplaceBandwidthLimitHandlerImpl implementation of the ReplaceBandwidthLimitHandler interface
+type ReplaceBandwidthLimitHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
+}
+
+//DeleteBandwidthLimitHandlerImpl implementation of the DeleteBandwidthLimitHandler interface
+type DeleteBandwidthLimitHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetBandwidthLimitsHandlerImpl) Handle(params bandwidth_limit.GetBandwidthLimitsParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewGetBandwidthLimitsDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewGetBandwidthLimitsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	limits, err := getBandwidthLimits(p, params.ParentType, params.ParentName)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewGetBandwidthLimitsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := make([]*bandwidth_limit.GetBandwidthLimitsOKBodyDataItems0, 0, len(limits))
+	for _, l := range limits {
+		item := &bandwidth_limit.GetBandwidthLimitsOKBodyDataItems0{}
+		if err := convertBody(l, item); err != nil {
+			e := misc.HandleError(err)
+			return bandwidth_limit.NewGetBandwidthLimitsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+		}
+		data = append(data, item)
+	}
+	return bandwidth_limit.NewGetBandwidthLimitsOK().WithPayload(&bandwidth_limit.GetBandwidthLimitsOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *GetBandwidthLimitHandlerImpl) Handle(params bandwidth_limit.GetBandwidthLimitParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewGetBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewGetBandwidthLimitDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := &bandwidth_limit.GetBandwidthLimitOKBodyData{}
+	l, err := findBandwidthLimit(p, params.ParentType, params.ParentName, params.Name)
+	if err == nil {
+		err = convertBody(l, data)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewGetBandwidthLimitDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return bandwidth_limit.NewGetBandwidthLimitOK().WithPayload(&bandwidth_limit.GetBandwidthLimitOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateBandwidthLimitHandlerImpl) Handle(params bandwidth_limit.CreateBandwidthLimitParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return bandwidth_limit.NewCreateBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := params.Data
+	err := validateBandwidthLimit(h.Validator, &data)
+	if err == nil {
+		err = changeParser(h.Client, t, v, func(p *parser.Parser) error {
+			if err := checkSectionExists(p, bandwidthLimitSection(params.ParentType), params.ParentName); err != nil {
+				return err
+			}
+			if _, err := findBandwidthLimit(p, params.ParentType, params.ParentName, *data.Name); err == nil {
+				return configuration.NewConfError(configuration.ErrObjectAlreadyExists, fmt.Sprintf("bandwidth limit %s already exists in %s %s", *data.Name, params.ParentType, params.ParentName))
+			} else if !isNotFound(err) {
+				return err
+			}
+			return setBandwidthLimit(p, params.ParentType, params.ParentName, *data.Name, &data)
+		})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewCreateBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return bandwidth_limit.NewCreateBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+			}
+			created := bandwidth_limit.CreateBandwidthLimitCreatedBody{}
+			if err := convertBody(&data, &created); err != nil {
+				e := misc.HandleError(err)
+				return bandwidth_limit.NewCreateBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+			}
+			return bandwidth_limit.NewCreateBandwidthLimitCreated().WithPayload(&created)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := bandwidth_limit.CreateBandwidthLimitAcceptedBody{}
+		if err := convertBody(&data, &accepted); err != nil {
+			e := misc.HandleError(err)
+			return bandwidth_limit.NewCreateBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+		}
+		return bandwidth_limit.NewCreateBandwidthLimitAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := bandwidth_limit.CreateBandwidthLimitAcceptedBody{}
+	if err := convertBody(&data, &accepted); err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewCreateBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	return bandwidth_limit.NewCreateBandwidthLimitAccepted().WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceBandwidthLimitHandlerImpl) Handle(params bandwidth_limit.ReplaceBandwidthLimitParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return bandwidth_limit.NewReplaceBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := &bandwidth_limit.CreateBandwidthLimitBody{}
+	err := convertBody(&params.Data, data)
+	if err == nil {
+		data.Name = misc.StringP(params.Name)
+		err = validateBandwidthLimit(h.Validator, data)
+	}
+	if err == nil {
+		err = changeParser(h.Client, t, v, func(p *parser.Parser) error {
+			if _, err := findBandwidthLimit(p, params.ParentType, params.ParentName, params.Name); err != nil {
+				return err
+			}
+			return setBandwidthLimit(p, params.ParentType, params.ParentName, params.Name, data)
+		})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewReplaceBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return bandwidth_limit.NewReplaceBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := bandwidth_limit.ReplaceBandwidthLimitOKBody{}
+			if err := convertBody(data, &ok); err != nil {
+				e := misc.HandleError(err)
+				return bandwidth_limit.NewReplaceBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+			}
+			return bandwidth_limit.NewReplaceBandwidthLimitOK().WithPayload(&ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := bandwidth_limit.ReplaceBandwidthLimitAcceptedBody{}
+		if err := convertBody(data, &accepted); err != nil {
+			e := misc.HandleError(err)
+			return bandwidth_limit.NewReplaceBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+		}
+		return bandwidth_limit.NewReplaceBandwidthLimitAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := bandwidth_limit.ReplaceBandwidthLimitAcceptedBody{}
+	if err := convertBody(data, &accepted); err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewReplaceBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	return bandwidth_limit.NewReplaceBandwidthLimitAccepted().WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteBandwidthLimitHandlerImpl) Handle(params bandwidth_limit.DeleteBandwidthLimitParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return bandwidth_limit.NewDeleteBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		if _, err := findBandwidthLimit(p, params.ParentType, params.ParentName, params.Name); err != nil {
+			return err
+		}
+		return setBandwidthLimit(p, params.ParentType, params.ParentName, params.Name, nil)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return bandwidth_limit.NewDeleteBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return bandwidth_limit.NewDeleteBandwidthLimitDefault(int(*e.Code)).WithPayload(e)
+			}
+			return bandwidth_limit.NewDeleteBandwidthLimitNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return bandwidth_limit.NewDeleteBandwidthLimitAccepted().WithReloadID(rID)
+	}
+	return bandwidth_limit.NewDeleteBandwidthLimitAccepted()
+}
+
+// validateBandwidthLimit checks a bandwidth limit is supported by HAProxy, uses
+// either per stream or shared limits and that its values and expressions are valid
+func validateBandwidthLimit(v *haproxy.SampleValidator, l *bandwidth_limit.CreateBandwidthLimitBody) error {
+	invalid := func(format string, a ...interface{}) error {
+		return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf(format, a...))
+	}
+	if v != nil && !v.Supports(bandwidthLimitVersion) {
+		return invalid("bandwidth limitation filters require HAProxy %s, running %s", bandwidthLimitVersion, v.Version)
+	}
+
+	shared := l.Limit != "" || l.Key != "" || l.Table != ""
+	if shared {
+		if l.DefaultLimit != "" || l.DefaultPeriod != "" {
+			return invalid("default_limit and default_period can not be combined with limit, key and table")
+		}
+		if l.Limit == "" || l.Key == "" {
+			return invalid("shared bandwidth limits require limit and key")
+		}
+	} else if l.DefaultLimit == "" || l.DefaultPeriod == "" {
+		return invalid("bandwidth limits require default_limit and default_period, or limit and key")
+	}
+	for _, s := range [][2]string{{"limit", l.Limit}, {"default_limit", l.DefaultLimit}, {"min_size", l.MinSize}} {
+		if s[1] != "" && !bandwidthSizeRegexp.MatchString(s[1]) {
+			return invalid("%s: invalid size '%s'", s[0], s[1])
+		}
+	}
+	if l.DefaultPeriod != "" && !bandwidthTimeRegexp.MatchString(l.DefaultPeriod) {
+		return invalid("default_period: invalid time '%s'", l.DefaultPeriod)
+	}
+	if strings.ContainsAny(l.Key, " \t") {
+		return invalid("key: expression '%s' can not contain spaces", l.Key)
+	}
+	if l.Key != "" && v != nil {
+		if err := v.ValidateExpression(l.Key); err != nil {
+			return invalid("key: %s", err.Error())
+		}
+	}
+
+	for i, r := range l.Rules {
+		if r == nil {
+			continue
+		}
+		if _, ok := bandwidthLimitRuleKeywords[*r.Type]; !ok {
+			return invalid("rules[%d]: unknown rule type '%s'", i, *r.Type)
+		}
+		if shared && r.Period != "" {
+			return invalid("rules[%d]: period can not be set on a shared bandwidth limit", i)
+		}
+		if strings.ContainsAny(r.Limit+r.Period, " \t") {
+			return invalid("rules[%d]: limit and period can not contain spaces", i)
+		}
+		if r.Limit != "" && !bandwidthSizeRegexp.MatchString(r.Limit) && v != nil {
+			if err := v.ValidateExpression(r.Limit); err != nil {
+				return invalid("rules[%d].limit: %s", i, err.Error())
+			}
+		}
+		if r.Period != "" && !bandwidthTimeRegexp.MatchString(r.Period) && v != nil {
+			if err := v.ValidateExpression(r.Period); err != nil {
+				return invalid("rules[%d].period: %s", i, err.Error())
+			}
+		}
+		if (r.Cond == "") != (r.CondTest == "") {
+			return invalid("rules[%d]: cond and cond_test must be set together", i)
+		}
+	}
+	return nil
+}
+
+func bandwidthLimitSection(parentType string) parser.Section {
+	if parentType == "backend" {
+		return parser.Backends
+	}
+	return parser.Frontends
+}
+
+func bandwidthLimitNotFound(parentType, parentName, name string) error {
+	return configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("bandwidth limit %s does not exist in %s %s", name, parentType, parentName))
+}
+
+// getBandwidthLimits returns the bandwidth limitation filters of a frontend or
+// a backend with their rules. The filters and the set-bandwidth-limit rules are
+// not handled by the configuration parser.
+func getBandwidthLimits(p *parser.Parser, parentType, parentName string) ([]*bandwidth_limit.CreateBandwidthLimitBody, error) {
+	section := bandwidthLimitSection(parentType)
+	if err := checkSectionExists(p, section, parentName); err != nil {
+		return nil, err
+	}
+	lines, err := getSectionUnprocessed(p, section, parentName)
+	if err != nil {
+		return nil, err
+	}
+	limits := make([]*bandwidth_limit.CreateBandwidthLimitBody, 0)
+	for _, l := range lines {
+		if f := strings.Fields(l.Value); len(f) >= 3 && f[0] == "filter" && (f[1] == "bwlim-in" || f[1] == "bwlim-out") {
+			limits = append(limits, parseBandwidthLimitFilter(f))
+		}
+	}
+	for _, l := range lines {
+		ruleType, name, rule := parseBandwidthLimitRule(strings.Fields(l.Value))
+		if rule == nil {
+			continue
+		}
+		rule.Type = misc.StringP(ruleType)
+		for _, limit := range limits {
+			if *limit.Name == name {
+				limit.Rules = append(limit.Rules, rule)
+			}
+		}
+	}
+	return limits, nil
+}
+
+func findBandwidthLimit(p *parser.Parser, parentType, parentName, name string) (*bandwidth_limit.CreateBandwidthLimitBody, error) {
+	limits, err := getBandwidthLimits(p, parentType, parentName)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range limits {
+		if *l.Name == name {
+			return l, nil
+		}
+	}
+	return nil, bandwidthLimitNotFound(parentType, parentName, name)
+}
+
+// setBandwidthLimit replaces the filter and rules of the bandwidth limit name,
+// removing them when l is nil. New lines are added after the other lines of the parent.
+func setBandwidthLimit(p *parser.Parser, parentType, parentName, name string, l *bandwidth_limit.CreateBandwidthLimitBody) error {
+	section := bandwidthLimitSection(parentType)
+	lines, err := getSectionUnprocessed(p, section, parentName)
+	if err != nil {
+		return err
+	}
+	unprocessed := make([]types.UnProcessed, 0, len(lines))
+	for _, line := range lines {
+		f := strings.Fields(line.Value)
+		if len(f) >= 3 && f[0] == "filter" && (f[1] == "bwlim-in" || f[1] == "bwlim-out") && f[2] == name {
+			continue
+		}
+		if _, ruleName, rule := parseBandwidthLimitRule(f); rule != nil && ruleName == name {
+			continue
+		}
+		unprocessed = append(unprocessed, line)
+	}
+	if l != nil {
+		for _, line := range bandwidthLimitLines(l) {
+			unprocessed = append(unprocessed, types.UnProcessed{Value: line})
+		}
+	}
+	if len(unprocessed) == 0 {
+		return p.Set(section, parentName, "", nil)
+	}
+	return p.Set(section, parentName, "", unprocessed)
+}
+
+func parseBandwidthLimitFilter(f []string) *bandwidth_limit.CreateBandwidthLimitBody {
+	l := &bandwidth_limit.CreateBandwidthLimitBody{
+		Name:      misc.StringP(f[2]),
+		Direction: misc.StringP(strings.TrimPrefix(f[1], "bwlim-")),
+		Rules:     make([]*bandwidth_limit.CreateBandwidthLimitBodyRulesItems0, 0),
+	}
+	for i := 3; i+1 < len(f); i += 2 {
+		switch f[i] {
+		case "default-limit":
+			l.DefaultLimit = f[i+1]
+		case "default-period":
+			l.DefaultPeriod = f[i+1]
+		case "limit":
+			l.Limit = f[i+1]
+		case "key":
+			l.Key = f[i+1]
+		case "table":
+			l.Table = f[i+1]
+		case "min-size":
+			l.MinSize = f[i+1]
+		}
+	}
+	return l
+}
+
+// parseBandwidthLimitRule returns the type, filter name and options of a
+// set-bandwidth-limit rule line, with a nil rule for other lines
+func parseBandwidthLimitRule(f []string) (string, string, *bandwidth_limit.CreateBandwidthLimitBodyRulesItems0) {
+	if len(f) < 3 {
+		return "", "", nil
+	}
+	ruleType := f[0]
+	i := 1
+	if ruleType == "tcp-request" || ruleType == "tcp-response" {
+		if f[1] != "content" {
+			return "", "", nil
+		}
+		i = 2
+	} else if ruleType != "http-request" && ruleType != "http-response" {
+		return "", "", nil
+	}
+	if i+1 >= len(f) || f[i] != "set-bandwidth-limit" {
+		return "", "", nil
+	}
+	name := f[i+1]
+	rule := &bandwidth_limit.CreateBandwidthLimitBodyRulesItems0{}
+	for i += 2; i < len(f); i++ {
+		switch f[i] {
+		case "limit", "period":
+			if i+1 < len(f) {
+				if f[i] == "limit" {
+					rule.Limit = f[i+1]
+				} else {
+					rule.Period = f[i+1]
+				}
+				i++
+			}
+		case "if", "unless":
+			rule.Cond = f[i]
+			rule.CondTest = strings.Join(f[i+1:], " ")
+			i = len(f)
+		}
+	}
+	return ruleType, name, rule
+}
+
+// bandwidthLimitLines returns the filter and rule lines of a bandwidth limit
+func bandwidthLimitLines(l *bandwidth_limit.CreateBandwidthLimitBody) []string {
+	filter := []string{"filter", "bwlim-" + *l.Direction, *l.Name}
+	for _, o := range [][2]string{
+		{"default-limit", l.DefaultLimit},
+		{"default-period", l.DefaultPeriod},
+		{"limit", l.Limit},
+		{"key", l.Key},
+		{"table", l.Table},
+		{"min-size", l.MinSize},
+	} {
+		if o[1] != "" {
+			filter = append(filter, o[0], o[1])
+		}
+	}
+	lines := []string{strings.Join(filter, " ")}
+	for _, r := range l.Rules {
+		if r == nil {
+			continue
+		}
+		rule := []string{bandwidthLimitRuleKeywords[*r.Type], "set-bandwidth-limit", *l.Name}
+		if r.Limit != "" {
+			rule = append(rule, "limit", r.Limit)
+		}
+		if r.Period != "" {
+			rule = append(rule, "period", r.Period)
+		}
+		if r.Cond != "" {
+			rule = append(rule, r.Cond, r.CondTest)
+		}
+		lines = append(lines, strings.Join(rule, " "))
+	}
+	return lines
+}
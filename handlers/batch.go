@@ -0,0 +1,468 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/haproxytech/dataplaneapi/auth"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/configuration"
+	"github.com/haproxytech/dataplaneapi/operations/transactions"
+)
+
+//ApplyBatchHandlerImpl implementation of the ApplyBatchHandler interface
+type ApplyBatchHandlerImpl struct {
+	Client *client_native.HAProxyClient
+	// Commit commits the transaction of the batch as the transactions of the
+	// API are, with their hooks, conflict checks and metadata
+	Commit *CommitTransactionHandlerImpl
+	// Authorize, if set, and CheckChange check every operation as the
+	// endpoints of the section it changes are, against the roles of the user
+	// and the protection of the section
+	Authorize   func(method, path, sectionType, name, user string, roles []string) error
+	CheckChange func(sectionType, name, user string, force bool) error
+}
+
+// batchMethods are the methods of the endpoints of the batch actions
+var batchMethods = map[string]string{
+	"create":  http.MethodPost,
+	"replace": http.MethodPut,
+	"delete":  http.MethodDelete,
+}
+
+// batchModel is the object of a batch operation
+type batchModel interface {
+	UnmarshalBinary(b []byte) error
+	Validate(formats strfmt.Registry) error
+}
+
+// batchOperation is a validated operation of a batch, applied in a transaction
+type batchOperation func(c *native_configuration.Client, t string) error
+
+// batchSection is a section changed by an operation of a batch
+type batchSection struct {
+	sectionType string
+	name        string
+}
+
+// batchResource describes how the operations on a type of objects are applied,
+// parent being the section holding the objects: none, backend, frontend, or
+// either of them with proxy
+type batchResource struct {
+	parent  string
+	named   bool
+	model   func() batchModel
+	create  func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error
+	replace func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error
+	delete  func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error
+}
+
+var batchResources = map[string]batchResource{
+	"backend": {
+		named: true,
+		model: func() batchModel { return &models.Backend{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateBackend(data.(*models.Backend), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditBackend(name, data.(*models.Backend), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteBackend(name, t, 0)
+		},
+	},
+	"frontend": {
+		named: true,
+		model: func() batchModel { return &models.Frontend{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateFrontend(data.(*models.Frontend), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditFrontend(name, data.(*models.Frontend), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteFrontend(name, t, 0)
+		},
+	},
+	"server": {
+		parent: "backend",
+		named:  true,
+		model:  func() batchModel { return &models.Server{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateServer(parentName, data.(*models.Server), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditServer(name, parentName, data.(*models.Server), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteServer(name, parentName, t, 0)
+		},
+	},
+	"bind": {
+		parent: "frontend",
+		named:  true,
+		model:  func() batchModel { return &models.Bind{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateBind(parentName, data.(*models.Bind), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditBind(name, parentName, data.(*models.Bind), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteBind(name, parentName, t, 0)
+		},
+	},
+	"acl": {
+		parent: "proxy",
+		model:  func() batchModel { return &models.ACL{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateACL(parentType, parentName, data.(*models.ACL), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditACL(index, parentType, parentName, data.(*models.ACL), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteACL(index, parentType, parentName, t, 0)
+		},
+	},
+	"http_request_rule": {
+		parent: "proxy",
+		model:  func() batchModel { return &models.HTTPRequestRule{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateHTTPRequestRule(parentType, parentName, data.(*models.HTTPRequestRule), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditHTTPRequestRule(index, parentType, parentName, data.(*models.HTTPRequestRule), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteHTTPRequestRule(index, parentType, parentName, t, 0)
+		},
+	},
+	"http_response_rule": {
+		parent: "proxy",
+		model:  func() batchModel { return &models.HTTPResponseRule{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateHTTPResponseRule(parentType, parentName, data.(*models.HTTPResponseRule), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditHTTPResponseRule(index, parentType, parentName, data.(*models.HTTPResponseRule), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteHTTPResponseRule(index, parentType, parentName, t, 0)
+		},
+	},
+	"tcp_request_rule": {
+		parent: "proxy",
+		model:  func() batchModel { return &models.TCPRequestRule{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateTCPRequestRule(parentType, parentName, data.(*models.TCPRequestRule), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditTCPRequestRule(index, parentType, parentName, data.(*models.TCPRequestRule), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteTCPRequestRule(index, parentType, parentName, t, 0)
+		},
+	},
+	"tcp_response_rule": {
+		parent: "backend",
+		model:  func() batchModel { return &models.TCPResponseRule{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateTCPResponseRule(parentName, data.(*models.TCPResponseRule), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditTCPResponseRule(index, parentName, data.(*models.TCPResponseRule), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteTCPResponseRule(index, parentName, t, 0)
+		},
+	},
+	"backend_switching_rule": {
+		parent: "frontend",
+		model:  func() batchModel { return &models.BackendSwitchingRule{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateBackendSwitchingRule(parentName, data.(*models.BackendSwitchingRule), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditBackendSwitchingRule(index, parentName, data.(*models.BackendSwitchingRule), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteBackendSwitchingRule(index, parentName, t, 0)
+		},
+	},
+	"server_switching_rule": {
+		parent: "backend",
+		model:  func() batchModel { return &models.ServerSwitchingRule{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateServerSwitchingRule(parentName, data.(*models.ServerSwitchingRule), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditServerSwitchingRule(index, parentName, data.(*models.ServerSwitchingRule), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteServerSwitchingRule(index, parentName, t, 0)
+		},
+	},
+	"stick_rule": {
+		parent: "backend",
+		model:  func() batchModel { return &models.StickRule{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateStickRule(parentName, data.(*models.StickRule), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditStickRule(index, parentName, data.(*models.StickRule), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteStickRule(index, parentName, t, 0)
+		},
+	},
+	"filter": {
+		parent: "proxy",
+		model:  func() batchModel { return &models.Filter{} },
+		create: func(c *native_configuration.Client, parentType, parentName string, data batchModel, t string) error {
+			return c.CreateFilter(parentType, parentName, data.(*models.Filter), t, 0)
+		},
+		replace: func(c *native_configuration.Client, parentType, parentName, name string, index int64, data batchModel, t string) error {
+			return c.EditFilter(index, parentType, parentName, data.(*models.Filter), t, 0)
+		},
+		delete: func(c *native_configuration.Client, parentType, parentName, name string, index int64, t string) error {
+			return c.DeleteFilter(index, parentType, parentName, t, 0)
+		},
+	},
+}
+
+//Handle executing the request and returning a response
+func (h *ApplyBatchHandlerImpl) Handle(params configuration.ApplyBatchParams, principal interface{}) middleware.Responder {
+	v := int64(0)
+	if params.Version != nil {
+		v = *params.Version
+	} else {
+		current, err := h.Client.Configuration.GetVersion("")
+		if err != nil {
+			e := misc.HandleError(err)
+			return configuration.NewApplyBatchDefault(int(*e.Code)).WithPayload(e)
+		}
+		v = current
+	}
+
+	// every operation is validated before the transaction is started
+	user, _ := principal.(string)
+	force := false
+	if params.HTTPRequest != nil {
+		force, _ = strconv.ParseBool(params.HTTPRequest.URL.Query().Get("force"))
+	}
+	ops := make([]batchOperation, 0, len(params.Data.Operations))
+	for i, o := range params.Data.Operations {
+		op, sections, err := prepareBatchOperation(o)
+		if err != nil {
+			return batchError(i, err)
+		}
+		for _, s := range sections {
+			if err := h.check(params.HTTPRequest, batchMethods[*o.Action], s, user, force); err != nil {
+				return batchError(i, err)
+			}
+		}
+		ops = append(ops, op)
+	}
+
+	tr, err := h.Client.Configuration.StartTransaction(v)
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewApplyBatchDefault(int(*e.Code)).WithPayload(e)
+	}
+	for i, op := range ops {
+		if err := op(h.Client.Configuration, tr.ID); err != nil {
+			// nolint:errcheck
+			h.Client.Configuration.DeleteTransaction(tr.ID)
+			return batchError(i, err)
+		}
+	}
+	if owner, _ := principal.(string); owner != "" {
+		if err := h.Commit.Metadata.SetOwner(tr.ID, owner); err != nil {
+			h.discard(tr.ID)
+			e := misc.HandleError(err)
+			return configuration.NewApplyBatchDefault(int(*e.Code)).WithPayload(e)
+		}
+	}
+
+	f := false
+	applied := int64(len(ops))
+	switch r := h.Commit.Handle(transactions.CommitTransactionParams{HTTPRequest: params.HTTPRequest, ID: tr.ID, ForceReload: params.ForceReload, DryRun: &f, IgnoreConflicts: &f}, principal).(type) {
+	case *transactions.CommitTransactionOK:
+		return configuration.NewApplyBatchOK().WithPayload(&configuration.ApplyBatchOKBody{TransactionID: tr.ID, Applied: applied})
+	case *transactions.CommitTransactionAccepted:
+		return configuration.NewApplyBatchAccepted().WithReloadID(r.ReloadID).WithPayload(&configuration.ApplyBatchAcceptedBody{TransactionID: tr.ID, Applied: applied})
+	case *transactions.CommitTransactionConflict:
+		h.discard(tr.ID)
+		return configuration.NewApplyBatchConflict().WithPayload(&models.Error{Code: r.Payload.Code, Message: r.Payload.Message})
+	case *transactions.CommitTransactionBadRequest:
+		h.discard(tr.ID)
+		return configuration.NewApplyBatchBadRequest().WithPayload(r.Payload)
+	case *transactions.CommitTransactionNotFound:
+		h.discard(tr.ID)
+		return configuration.NewApplyBatchNotFound().WithPayload(r.Payload)
+	case *transactions.CommitTransactionDefault:
+		h.discard(tr.ID)
+		return configuration.NewApplyBatchDefault(int(*r.Payload.Code)).WithPayload(r.Payload)
+	default:
+		h.discard(tr.ID)
+		e := misc.HandleError(fmt.Errorf("unexpected commit response %T", r))
+		return configuration.NewApplyBatchDefault(int(*e.Code)).WithPayload(e)
+	}
+}
+
+// check returns an error when the user is not allowed to change the section s
+// with a request of method, or when the section is protected
+func (h *ApplyBatchHandlerImpl) check(r *http.Request, method string, s batchSection, user string, force bool) error {
+	if h.Authorize != nil && r != nil {
+		if err := h.Authorize(method, "/services/haproxy/configuration/batch", s.sectionType, s.name, user, auth.Roles(r)); err != nil {
+			return err
+		}
+	}
+	if h.CheckChange != nil {
+		return h.CheckChange(s.sectionType, s.name, user, force)
+	}
+	return nil
+}
+
+// discard deletes the transaction of a batch left in progress by a commit
+// refused before it started, such as by a conflict or a validator hook
+func (h *ApplyBatchHandlerImpl) discard(id string) {
+	if t, err := h.Client.Configuration.GetTransaction(id); err != nil || t.Status != "in_progress" {
+		return
+	}
+	// nolint:errcheck
+	h.Client.Configuration.DeleteTransaction(id)
+	if err := h.Commit.Metadata.Delete(id); err != nil {
+		log.Warningf("Cannot delete metadata of transaction %s: %s", id, err.Error())
+	}
+}
+
+// batchError returns the error of the operation at index i of a batch
+func batchError(i int, err error) middleware.Responder {
+	e := misc.HandleError(err)
+	msg := fmt.Sprintf("operation %d: %s", i, *e.Message)
+	e.Message = &msg
+	return configuration.NewApplyBatchDefault(int(*e.Code)).WithPayload(e)
+}
+
+// prepareBatchOperation validates an operation of a batch and returns the
+// function applying it, with the sections it changes
+func prepareBatchOperation(o *configuration.ApplyBatchBodyOperationsItems0) (batchOperation, []batchSection, error) {
+	invalid := func(format string, a ...interface{}) error {
+		return native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf(format, a...))
+	}
+	r, ok := batchResources[*o.Type]
+	if !ok {
+		return nil, nil, invalid("unknown type %s", *o.Type)
+	}
+
+	parentType := r.parent
+	switch r.parent {
+	case "":
+		if o.ParentType != "" || o.ParentName != "" {
+			return nil, nil, invalid("parent_type and parent_name are not used for %s", *o.Type)
+		}
+	case "proxy":
+		if o.ParentType == "" || o.ParentName == "" {
+			return nil, nil, invalid("parent_type and parent_name are required for %s", *o.Type)
+		}
+		parentType = o.ParentType
+	default:
+		if o.ParentName == "" {
+			return nil, nil, invalid("parent_name is required for %s", *o.Type)
+		}
+		if o.ParentType != "" && o.ParentType != r.parent {
+			return nil, nil, invalid("the parent of %s must be a %s", *o.Type, r.parent)
+		}
+	}
+	parentName := o.ParentName
+	sections := make([]batchSection, 0, 2)
+	if r.parent != "" {
+		sections = append(sections, batchSection{parentType, parentName})
+	}
+
+	action := *o.Action
+	name := o.Name
+	index := int64(0)
+	if action != "create" {
+		if r.named && name == "" {
+			return nil, nil, invalid("name is required to %s %s", action, *o.Type)
+		}
+		if !r.named {
+			if o.Index == nil {
+				return nil, nil, invalid("index is required to %s %s", action, *o.Type)
+			}
+			index = *o.Index
+		}
+		if r.parent == "" {
+			sections = append(sections, batchSection{*o.Type, name})
+		}
+	}
+
+	if action == "delete" {
+		if o.Data != nil {
+			return nil, nil, invalid("data is not used to delete %s", *o.Type)
+		}
+		return func(c *native_configuration.Client, t string) error {
+			return r.delete(c, parentType, parentName, name, index, t)
+		}, sections, nil
+	}
+	if o.Data == nil {
+		return nil, nil, invalid("data is required to %s %s", action, *o.Type)
+	}
+	data := r.model()
+	b, err := json.Marshal(o.Data)
+	if err == nil {
+		err = data.UnmarshalBinary(b)
+	}
+	if err != nil {
+		return nil, nil, invalid("invalid %s: %s", *o.Type, err.Error())
+	}
+	if err := data.Validate(strfmt.Default); err != nil {
+		return nil, nil, invalid("invalid %s: %s", *o.Type, err.Error())
+	}
+	if r.parent == "" {
+		// the section created, or renamed by its replacement
+		var section struct {
+			Name string `json:"name"`
+		}
+		// nolint:errcheck
+		json.Unmarshal(b, &section)
+		if action == "create" || section.Name != name {
+			sections = append(sections, batchSection{*o.Type, section.Name})
+		}
+	}
+	if action == "create" {
+		return func(c *native_configuration.Client, t string) error {
+			return r.create(c, parentType, parentName, data, t)
+		}, sections, nil
+	}
+	return func(c *native_configuration.Client, t string) error {
+		return r.replace(c, parentType, parentName, name, index, data, t)
+	}, sections, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/haproxytech/dataplaneapi/operations/configuration"
+)
+
+func TestPrepareBatchOperationSections(t *testing.T) {
+	str := func(s string) *string { return &s }
+	tests := []struct {
+		name     string
+		op       configuration.ApplyBatchBodyOperationsItems0
+		sections []batchSection
+	}{
+		{
+			"create backend",
+			configuration.ApplyBatchBodyOperationsItems0{Action: str("create"), Type: str("backend"), Data: map[string]interface{}{"name": "app"}},
+			[]batchSection{{"backend", "app"}},
+		},
+		{
+			"rename backend",
+			configuration.ApplyBatchBodyOperationsItems0{Action: str("replace"), Type: str("backend"), Name: "app", Data: map[string]interface{}{"name": "db"}},
+			[]batchSection{{"backend", "app"}, {"backend", "db"}},
+		},
+		{
+			"replace backend",
+			configuration.ApplyBatchBodyOperationsItems0{Action: str("replace"), Type: str("backend"), Name: "app", Data: map[string]interface{}{"name": "app"}},
+			[]batchSection{{"backend", "app"}},
+		},
+		{
+			"delete frontend",
+			configuration.ApplyBatchBodyOperationsItems0{Action: str("delete"), Type: str("frontend"), Name: "web"},
+			[]batchSection{{"frontend", "web"}},
+		},
+		{
+			"create server",
+			configuration.ApplyBatchBodyOperationsItems0{Action: str("create"), Type: str("server"), ParentName: "app", Data: map[string]interface{}{"name": "s1", "address": "10.0.0.1"}},
+			[]batchSection{{"backend", "app"}},
+		},
+		{
+			"delete acl of a frontend",
+			configuration.ApplyBatchBodyOperationsItems0{Action: str("delete"), Type: str("acl"), ParentType: "frontend", ParentName: "web", Index: new(int64)},
+			[]batchSection{{"frontend", "web"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := tt.op
+			_, sections, err := prepareBatchOperation(&op)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(sections, tt.sections) {
+				t.Fatalf("expected %v, got %v", tt.sections, sections)
+			}
+		})
+	}
+}
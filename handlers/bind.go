@@ -185,7 +185,23 @@ func (h *ReplaceBindHandlerImpl) Handle(params bind.ReplaceBindParams, principal
 		return bind.NewReplaceBindDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.EditBind(params.Name, params.Frontend, params.Data, t, v)
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewReplaceBindDefault(int(*e.Code)).WithPayload(e)
+	}
+	kept, err := getBindParams(p, params.Frontend, params.Name, bindKeptOptions)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewReplaceBindDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err = changeTransaction(h.Client, t, v, func(t string) error {
+		if err := h.Client.Configuration.EditBind(params.Name, params.Frontend, params.Data, t, 0); err != nil {
+			return err
+		}
+		return keepBindParams(h.Client, params.Frontend, params.Data.Name, kept, t)
+	})
 	if err != nil {
 		e := misc.HandleError(err)
 		return bind.NewReplaceBindDefault(int(*e.Code)).WithPayload(e)
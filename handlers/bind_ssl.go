@@ -0,0 +1,205 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/params"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/bind"
+)
+
+// bindSSLOptions are the TLS options of a bind not in the bind model
+var bindSSLOptions = []string{"ssl-min-ver", "ssl-max-ver", "ciphers", "ciphersuites", "curves", "npn", "crl-file", "strict-sni", "prefer-client-ciphers"}
+
+// sslVersions are the SSL and TLS versions, oldest first
+var sslVersions = []string{"SSLv3", "TLSv1.0", "TLSv1.1", "TLSv1.2", "TLSv1.3"}
+
+//GetBindSSLHandlerImpl implementation of the GetBindSSLHandler interface using client-native client
+type GetBindSSLHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceBindSSLHandlerImpl implementation of the ReplaceBindSSLHandler interface using client-native client
+type ReplaceBindSSLHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetBindSSLHandlerImpl) Handle(params bind.GetBindSSLParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewGetBindSSLDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewGetBindSSLDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	opts, err := getBindParams(p, params.Frontend, params.Name, bindSSLOptions)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewGetBindSSLDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := bind.GetBindSSLOKBodyData(bindSSL(opts))
+	return bind.NewGetBindSSLOK().WithPayload(&bind.GetBindSSLOKBody{Version: v, Data: &data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceBindSSLHandlerImpl) Handle(params bind.ReplaceBindSSLParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return bind.NewReplaceBindSSLDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.Data.SslMinVer != "" && params.Data.SslMaxVer != "" && sslVersionIndex(params.Data.SslMinVer) > sslVersionIndex(params.Data.SslMaxVer) {
+		msg := fmt.Sprintf("ssl_min_ver %s is higher than ssl_max_ver %s", params.Data.SslMinVer, params.Data.SslMaxVer)
+		c := misc.ErrHTTPBadRequest
+		return bind.NewReplaceBindSSLBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	opts := bindSSLParams(params.Data)
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		if len(opts) > 0 {
+			ssl, err := getBindParams(p, params.Frontend, params.Name, []string{"ssl"})
+			if err != nil {
+				return err
+			}
+			if len(ssl) == 0 {
+				return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("ssl is not enabled on bind %s", params.Name))
+			}
+		}
+		return setBindParams(p, params.Frontend, params.Name, bindSSLOptions, opts)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewReplaceBindSSLDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return bind.NewReplaceBindSSLDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := bind.ReplaceBindSSLOKBody(params.Data)
+			return bind.NewReplaceBindSSLOK().WithPayload(&ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := bind.ReplaceBindSSLAcceptedBody(params.Data)
+		return bind.NewReplaceBindSSLAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := bind.ReplaceBindSSLAcceptedBody(params.Data)
+	return bind.NewReplaceBindSSLAccepted().WithPayload(&accepted)
+}
+
+func sslVersionIndex(version string) int {
+	for i, v := range sslVersions {
+		if v == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// bindSSL returns the TLS options of a bind from its parsed options
+func bindSSL(opts []params.BindOption) bind.ReplaceBindSSLBody {
+	data := bind.ReplaceBindSSLBody{}
+	for _, o := range opts {
+		switch v := o.(type) {
+		case *params.BindOptionWord:
+			switch v.Name {
+			case "strict-sni":
+				data.StrictSni = true
+			case "prefer-client-ciphers":
+				data.PreferClientCiphers = true
+			}
+		case *params.BindOptionValue:
+			switch v.Name {
+			case "ssl-min-ver":
+				data.SslMinVer = v.Value
+			case "ssl-max-ver":
+				data.SslMaxVer = v.Value
+			case "ciphers":
+				data.Ciphers = v.Value
+			case "ciphersuites":
+				data.Ciphersuites = v.Value
+			case "curves":
+				data.Curves = v.Value
+			case "npn":
+				data.Npn = v.Value
+			case "crl-file":
+				data.CrlFile = v.Value
+			}
+		}
+	}
+	return data
+}
+
+// bindSSLParams returns the bind options setting the TLS options of data
+func bindSSLParams(data bind.ReplaceBindSSLBody) []params.BindOption {
+	opts := make([]params.BindOption, 0, len(bindSSLOptions))
+	values := [][2]string{
+		{"ssl-min-ver", data.SslMinVer},
+		{"ssl-max-ver", data.SslMaxVer},
+		{"ciphers", data.Ciphers},
+		{"ciphersuites", data.Ciphersuites},
+		{"curves", data.Curves},
+		{"npn", data.Npn},
+		{"crl-file", data.CrlFile},
+	}
+	for _, v := range values {
+		if v[1] != "" {
+			opts = append(opts, &params.BindOptionValue{Name: v[0], Value: v[1]})
+		}
+	}
+	if data.StrictSni {
+		opts = append(opts, &params.BindOptionWord{Name: "strict-sni"})
+	}
+	if data.PreferClientCiphers {
+		opts = append(opts, &params.BindOptionWord{Name: "prefer-client-ciphers"})
+	}
+	return opts
+}
@@ -0,0 +1,274 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/global"
+)
+
+// The httpclient and OCSP update directives are not known to the configuration
+// parser and are kept as unprocessed global lines.
+
+// certificateUpdateKeywords are the global directives of the certificate update settings
+var certificateUpdateKeywords = []string{
+	"httpclient.resolvers.id",
+	"httpclient.resolvers.prefer",
+	"httpclient.resolvers.disabled",
+	"httpclient.ssl.verify",
+	"httpclient.ssl.ca-file",
+	"httpclient.retries",
+	"httpclient.timeout.connect",
+	"tune.ssl.ocsp-update.mindelay",
+	"tune.ssl.ocsp-update.maxdelay",
+}
+
+//GetCertificateUpdateHandlerImpl implementation of the GetCertificateUpdateHandler interface
+type GetCertificateUpdateHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceCertificateUpdateHandlerImpl implementation of the ReplaceCertificateUpdateHandler interface
+type ReplaceCertificateUpdateHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetCertificateUpdateHandlerImpl) Handle(params global.GetCertificateUpdateParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetCertificateUpdateDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetCertificateUpdateDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data, err := parseCertificateUpdate(p)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetCertificateUpdateDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return global.NewGetCertificateUpdateOK().WithPayload(&global.GetCertificateUpdateOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceCertificateUpdateHandlerImpl) Handle(params global.ReplaceCertificateUpdateParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return global.NewReplaceCertificateUpdateDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := &global.GetCertificateUpdateOKBodyData{}
+	if err := convertBody(&params.Data, data); err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceCertificateUpdateDefault(int(*e.Code)).WithPayload(e)
+	}
+	data.Warnings = certificateUpdateWarnings(data)
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		if err := validateCertificateUpdate(p, data); err != nil {
+			return err
+		}
+		return serializeCertificateUpdate(p, data)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceCertificateUpdateDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return global.NewReplaceCertificateUpdateDefault(int(*e.Code)).WithPayload(e)
+			}
+			okBody := &global.ReplaceCertificateUpdateOKBody{}
+			// nolint:errcheck
+			convertBody(data, okBody)
+			return global.NewReplaceCertificateUpdateOK().WithPayload(okBody)
+		}
+		rID := h.ReloadAgent.Reload()
+		acceptedBody := &global.ReplaceCertificateUpdateAcceptedBody{}
+		// nolint:errcheck
+		convertBody(data, acceptedBody)
+		return global.NewReplaceCertificateUpdateAccepted().WithReloadID(rID).WithPayload(acceptedBody)
+	}
+	acceptedBody := &global.ReplaceCertificateUpdateAcceptedBody{}
+	// nolint:errcheck
+	convertBody(data, acceptedBody)
+	return global.NewReplaceCertificateUpdateAccepted().WithPayload(acceptedBody)
+}
+
+func parseCertificateUpdate(p *parser.Parser) (*global.GetCertificateUpdateOKBodyData, error) {
+	data := &global.GetCertificateUpdateOKBodyData{}
+	lines, err := getGlobalUnprocessed(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		f := strings.Fields(l.Value)
+		if len(f) != 2 {
+			continue
+		}
+		switch f[0] {
+		case "httpclient.resolvers.id":
+			data.HttpclientResolversID = f[1]
+		case "httpclient.resolvers.prefer":
+			data.HttpclientResolversPrefer = f[1]
+		case "httpclient.resolvers.disabled":
+			data.HttpclientResolversDisabled = f[1] == "on"
+		case "httpclient.ssl.verify":
+			data.HttpclientSslVerify = f[1]
+		case "httpclient.ssl.ca-file":
+			data.HttpclientSslCaFile = f[1]
+		case "httpclient.retries":
+			data.HttpclientRetries = parseGlobalInt(f[1])
+		case "httpclient.timeout.connect":
+			data.HttpclientTimeoutConnect = misc.ParseTimeout(f[1])
+		case "tune.ssl.ocsp-update.mindelay":
+			data.OcspUpdateMindelay = parseGlobalInt(f[1])
+		case "tune.ssl.ocsp-update.maxdelay":
+			data.OcspUpdateMaxdelay = parseGlobalInt(f[1])
+		}
+	}
+	data.Warnings = certificateUpdateWarnings(data)
+	return data, nil
+}
+
+// parseGlobalInt parses a number of a global directive, nil when value is
+// not a number
+func parseGlobalInt(value string) *int64 {
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+func serializeCertificateUpdate(p *parser.Parser, data *global.GetCertificateUpdateOKBodyData) error {
+	lines, err := getGlobalUnprocessed(p)
+	if err != nil {
+		return err
+	}
+	unprocessed := make([]types.UnProcessed, 0, len(lines)+len(certificateUpdateKeywords))
+	for _, l := range lines {
+		if f := strings.Fields(l.Value); len(f) > 0 && containsOption(f[0], certificateUpdateKeywords) {
+			continue
+		}
+		unprocessed = append(unprocessed, l)
+	}
+	add := func(format string, args ...interface{}) {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: fmt.Sprintf(format, args...)})
+	}
+	if data.HttpclientResolversID != "" {
+		add("httpclient.resolvers.id %s", data.HttpclientResolversID)
+	}
+	if data.HttpclientResolversPrefer != "" {
+		add("httpclient.resolvers.prefer %s", data.HttpclientResolversPrefer)
+	}
+	if data.HttpclientResolversDisabled {
+		add("httpclient.resolvers.disabled on")
+	}
+	if data.HttpclientSslVerify != "" {
+		add("httpclient.ssl.verify %s", data.HttpclientSslVerify)
+	}
+	if data.HttpclientSslCaFile != "" {
+		add("httpclient.ssl.ca-file %s", data.HttpclientSslCaFile)
+	}
+	if data.HttpclientRetries != nil {
+		add("httpclient.retries %d", *data.HttpclientRetries)
+	}
+	if data.HttpclientTimeoutConnect != nil {
+		add("httpclient.timeout.connect %dms", *data.HttpclientTimeoutConnect)
+	}
+	if data.OcspUpdateMindelay != nil {
+		add("tune.ssl.ocsp-update.mindelay %d", *data.OcspUpdateMindelay)
+	}
+	if data.OcspUpdateMaxdelay != nil {
+		add("tune.ssl.ocsp-update.maxdelay %d", *data.OcspUpdateMaxdelay)
+	}
+	if len(unprocessed) == 0 {
+		return p.Set(parser.Global, parser.GlobalSectionName, "", nil)
+	}
+	return p.Set(parser.Global, parser.GlobalSectionName, "", unprocessed)
+}
+
+// validateCertificateUpdate checks the settings against the configuration p
+// they are written to, the resolvers section of the HTTP client must exist
+func validateCertificateUpdate(p *parser.Parser, data *global.GetCertificateUpdateOKBodyData) error {
+	if data.HttpclientResolversID != "" {
+		if data.HttpclientResolversDisabled {
+			return configuration.NewConfError(configuration.ErrValidationError, "httpclient_resolvers_id cannot be set with httpclient_resolvers_disabled")
+		}
+		if err := checkSectionExists(p, parser.Resolvers, data.HttpclientResolversID); err != nil {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("httpclient_resolvers_id: resolvers %s does not exist", data.HttpclientResolversID))
+		}
+	}
+	if data.OcspUpdateMindelay != nil && data.OcspUpdateMaxdelay != nil && *data.OcspUpdateMindelay > *data.OcspUpdateMaxdelay {
+		return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("ocsp_update_mindelay %d exceeds ocsp_update_maxdelay %d", *data.OcspUpdateMindelay, *data.OcspUpdateMaxdelay))
+	}
+	return nil
+}
+
+// certificateUpdateWarnings returns the warnings about the settings of data
+func certificateUpdateWarnings(data *global.GetCertificateUpdateOKBodyData) []string {
+	warnings := make([]string, 0)
+	if data.HttpclientSslVerify == "none" {
+		warnings = append(warnings, "httpclient_ssl_verify is none, the certificates of the OCSP responders are not verified")
+	}
+	if data.HttpclientSslCaFile != "" && data.HttpclientSslVerify == "none" {
+		warnings = append(warnings, "httpclient_ssl_ca_file is not used with httpclient_ssl_verify none")
+	}
+	if data.HttpclientResolversPrefer != "" && data.HttpclientResolversDisabled {
+		warnings = append(warnings, "httpclient_resolvers_prefer is not used with httpclient_resolvers_disabled")
+	}
+	return warnings
+}
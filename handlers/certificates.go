@@ -0,0 +1,101 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/certificates"
+)
+
+//GetSSLCertificatesHandlerImpl implementation of the GetSSLCertificatesHandler interface using client-native client
+type GetSSLCertificatesHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//Handle executing the request and returning a response
+func (h *GetSSLCertificatesHandlerImpl) Handle(params certificates.GetSSLCertificatesParams, principal interface{}) middleware.Responder {
+	if h.Client.Runtime == nil {
+		e := misc.HandleError(configuration.NewConfError(configuration.ErrGeneralError, "runtime API not configured"))
+		return certificates.NewGetSSLCertificatesDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	var files []string
+	if params.File != nil {
+		files = []string{*params.File}
+	} else {
+		var err error
+		files, err = haproxy.ShowSSLCerts(h.Client.Runtime)
+		if err != nil {
+			e := misc.HandleError(err)
+			return certificates.NewGetSSLCertificatesDefault(int(*e.Code)).WithPayload(e)
+		}
+	}
+
+	now := time.Now()
+	data := make([]*certificates.GetSSLCertificatesOKBodyItems0, 0, len(files))
+	for _, f := range files {
+		c, err := haproxy.ShowSSLCert(h.Client.Runtime, f)
+		if err == haproxy.ErrSSLCertNotFound {
+			if params.File != nil {
+				e := misc.HandleError(configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("certificate %s not loaded", f)))
+				return certificates.NewGetSSLCertificatesNotFound().WithPayload(e)
+			}
+			// removed since listed
+			continue
+		}
+		if err != nil {
+			e := misc.HandleError(err)
+			return certificates.NewGetSSLCertificatesDefault(int(*e.Code)).WithPayload(e)
+		}
+		data = append(data, sslCertificate(c, now))
+	}
+	return certificates.NewGetSSLCertificatesOK().WithPayload(data)
+}
+
+func sslCertificate(c *haproxy.SSLCertificate, now time.Time) *certificates.GetSSLCertificatesOKBodyItems0 {
+	cert := &certificates.GetSSLCertificatesOKBodyItems0{
+		File:            c.File,
+		Status:          c.Status,
+		Serial:          c.Serial,
+		Subject:         c.Subject,
+		Issuer:          c.Issuer,
+		Sans:            c.SANs,
+		KeyType:         c.KeyType,
+		KeyBits:         int64(c.KeyBits),
+		Sha1Fingerprint: c.SHA1Fingerprint,
+		Chain:           make([]*certificates.GetSSLCertificatesOKBodyItems0ChainItems0, 0, len(c.Chain)),
+		ChainError:      c.ChainError(now),
+	}
+	cert.ChainValid = cert.ChainError == ""
+	if !c.NotBefore.IsZero() {
+		cert.NotBefore = c.NotBefore.Unix()
+	}
+	if !c.NotAfter.IsZero() {
+		cert.NotAfter = c.NotAfter.Unix()
+	}
+	for _, cc := range c.Chain {
+		cert.Chain = append(cert.Chain, &certificates.GetSSLCertificatesOKBodyItems0ChainItems0{Subject: cc.Subject, Issuer: cc.Issuer})
+	}
+	return cert
+}
@@ -20,12 +20,12 @@ import (
 	"strconv"
 
 	"github.com/go-openapi/runtime/middleware"
-	"github.com/google/renameio"
 	client_native "github.com/haproxytech/client-native/v2"
 	"github.com/haproxytech/dataplaneapi/configuration"
 	"github.com/haproxytech/dataplaneapi/haproxy"
 	"github.com/haproxytech/dataplaneapi/operations/cluster"
 	"github.com/haproxytech/dataplaneapi/operations/discovery"
+	"github.com/haproxytech/dataplaneapi/system"
 	"github.com/haproxytech/models/v2"
 )
 
@@ -46,6 +46,11 @@ type ClusterInitiateCertificateRefreshHandlerImpl struct {
 	Config *configuration.Configuration
 }
 
+//GetBootstrapKeyRotationsHandlerImpl implementation of the GetBootstrapKeyRotationsHandler interface
+type GetBootstrapKeyRotationsHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
 //Handle executing the request and returning a response
 func (h *ClusterInitiateCertificateRefreshHandlerImpl) Handle(params cluster.InitiateCertificateRefreshParams, principal interface{}) middleware.Responder {
 	if h.Config.Mode.Load() != "cluster" {
@@ -73,6 +78,7 @@ func (h *CreateClusterHandlerImpl) Handle(params cluster.PostClusterParams, prin
 		h.Config.Mode.Store("cluster")
 		h.Config.BootstrapKey.Store(params.Data.BootstrapKey)
 		h.Config.Cluster.Clear()
+		h.Config.RecordBootstrapKeyRotation(params.Data.BootstrapKey, "api")
 		h.Config.Notify.BootstrapKeyChanged.Notify()
 	}
 	if params.Data.Mode == "single" && h.Config.Mode.Load() != params.Data.Mode {
@@ -124,7 +130,7 @@ func (h *CreateClusterHandlerImpl) Handle(params cluster.PostClusterParams, prin
 			}
 			// now reset peer-id
 			if h.Config.HAProxy.NodeIDFile != "" {
-				err = renameio.WriteFile(h.Config.HAProxy.NodeIDFile, []byte("localhost"), 0644)
+				err = system.WriteFile(h.Config.HAProxy.NodeIDFile, []byte("localhost"), 0644)
 				if err != nil {
 					return h.err500(err, transaction)
 				}
@@ -165,7 +171,10 @@ func (h *CreateClusterHandlerImpl) Handle(params cluster.PostClusterParams, prin
 			}
 		}
 
-		h.Config.BootstrapKey.Store("")
+		if h.Config.BootstrapKey.Load() != "" {
+			h.Config.BootstrapKey.Store("")
+			h.Config.RecordBootstrapKeyRotation("", "api")
+		}
 		h.Config.Mode.Store(params.Data.Mode)
 		h.Config.Status.Store("active")
 		h.Config.Cluster.Clear()
@@ -212,3 +221,20 @@ func (h *GetClusterHandlerImpl) Handle(params discovery.GetClusterParams, princi
 	}
 	return discovery.NewGetClusterOK().WithPayload(settings)
 }
+
+//Handle executing the request and returning a response
+func (h *GetBootstrapKeyRotationsHandlerImpl) Handle(params cluster.GetBootstrapKeyRotationsParams, principal interface{}) middleware.Responder {
+	rotations := h.Config.GetBootstrapKeyRotations()
+	items := make([]*cluster.GetBootstrapKeyRotationsOKBodyItems0, 0, len(rotations))
+	for _, r := range rotations {
+		items = append(items, &cluster.GetBootstrapKeyRotationsOKBodyItems0{
+			Timestamp:   r.Timestamp,
+			Fingerprint: r.Fingerprint,
+			Cluster:     r.Cluster,
+			Source:      r.Source,
+			Status:      r.Status,
+			Message:     r.Message,
+		})
+	}
+	return cluster.NewGetBootstrapKeyRotationsOK().WithPayload(items)
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/configuration"
+)
+
+//GetConfigurationCompatibilityHandlerImpl implementation of the GetConfigurationCompatibilityHandler interface
+type GetConfigurationCompatibilityHandlerImpl struct {
+	Client *client_native.HAProxyClient
+	// Validator holds the version of the running HAProxy
+	Validator *haproxy.SampleValidator
+}
+
+//Handle executing the request and returning a response
+func (h *GetConfigurationCompatibilityHandlerImpl) Handle(params configuration.GetConfigurationCompatibilityParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewGetConfigurationCompatibilityDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewGetConfigurationCompatibilityDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	issues, err := haproxy.CheckCompatibility(p.String(), params.Version)
+	if err != nil {
+		c := misc.ErrHTTPBadRequest
+		msg := err.Error()
+		return configuration.NewGetConfigurationCompatibilityBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	body := &configuration.GetConfigurationCompatibilityOKBody{
+		Version:        v,
+		TargetVersion:  params.Version,
+		RunningVersion: h.Validator.Version,
+		Compatible:     len(issues) == 0,
+		Issues:         make([]*configuration.GetConfigurationCompatibilityOKBodyIssuesItems0, 0, len(issues)),
+	}
+	for _, i := range issues {
+		body.Issues = append(body.Issues, &configuration.GetConfigurationCompatibilityOKBodyIssuesItems0{
+			Line:    int64(i.Line),
+			Section: i.Section,
+			Keyword: i.Keyword,
+			Since:   i.Since,
+			Removed: i.Removed,
+			Message: i.Message,
+		})
+	}
+	return configuration.NewGetConfigurationCompatibilityOK().WithPayload(body).WithConfigurationVersion(v)
+}
@@ -0,0 +1,199 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/compression"
+)
+
+//GetCompressionHandlerImpl implementation of the GetCompressionHandler interface using client-native client
+type GetCompressionHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceCompressionHandlerImpl implementation of the ReplaceCompressionHandler interface using client-native client
+type ReplaceCompressionHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetCompressionHandlerImpl) Handle(params compression.GetCompressionParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return compression.NewGetCompressionDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return compression.NewGetCompressionDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	var c compression.ReplaceCompressionBody
+	section, name, err := proxySection(p, params.ParentType, params.ParentName)
+	if err == nil {
+		c, err = getCompression(p, section, name)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return compression.NewGetCompressionDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := compression.GetCompressionOKBodyData(c)
+	return compression.NewGetCompressionOK().WithPayload(&compression.GetCompressionOKBody{Version: v, Data: &data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceCompressionHandlerImpl) Handle(params compression.ReplaceCompressionParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return compression.NewReplaceCompressionDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := validateCompression(params.ParentType, params.Data)
+	if err == nil {
+		err = changeParser(h.Client, t, v, func(p *parser.Parser) error {
+			section, name, err := proxySection(p, params.ParentType, params.ParentName)
+			if err != nil {
+				return err
+			}
+			return setCompression(p, section, name, params.Data)
+		})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return compression.NewReplaceCompressionDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return compression.NewReplaceCompressionDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := compression.ReplaceCompressionOKBody(params.Data)
+			return compression.NewReplaceCompressionOK().WithPayload(&ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := compression.ReplaceCompressionAcceptedBody(params.Data)
+		return compression.NewReplaceCompressionAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := compression.ReplaceCompressionAcceptedBody(params.Data)
+	return compression.NewReplaceCompressionAccepted().WithPayload(&accepted)
+}
+
+// validateCompression checks compression settings: algorithms are not repeated,
+// types and offload need algorithms and offload is not used in defaults where
+// HAProxy ignores it
+func validateCompression(parentType string, data compression.ReplaceCompressionBody) error {
+	for i, a := range data.Algorithms {
+		for _, b := range data.Algorithms[:i] {
+			if a == b {
+				return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("algorithm %s is listed more than once", a))
+			}
+		}
+	}
+	if len(data.Algorithms) == 0 && (len(data.Types) > 0 || data.Offload) {
+		return configuration.NewConfError(configuration.ErrValidationError, "types and offload require at least one algorithm")
+	}
+	if data.Offload && parentType == "defaults" {
+		return configuration.NewConfError(configuration.ErrValidationError, "offload can not be set in defaults")
+	}
+	return nil
+}
+
+// getCompression returns the compression settings of a section. The compression
+// keywords are not handled by the configuration parser.
+func getCompression(p *parser.Parser, section parser.Section, name string) (compression.ReplaceCompressionBody, error) {
+	data := compression.ReplaceCompressionBody{Algorithms: []string{}, Types: []string{}}
+	lines, err := getSectionUnprocessed(p, section, name)
+	if err != nil {
+		return data, err
+	}
+	for _, l := range lines {
+		f := strings.Fields(l.Value)
+		if len(f) < 2 || f[0] != "compression" {
+			continue
+		}
+		switch f[1] {
+		case "algo":
+			data.Algorithms = append(data.Algorithms, f[2:]...)
+		case "type":
+			data.Types = append(data.Types, f[2:]...)
+		case "offload":
+			data.Offload = true
+		}
+	}
+	return data, nil
+}
+
+// setCompression replaces the compression settings of a section
+func setCompression(p *parser.Parser, section parser.Section, name string, data compression.ReplaceCompressionBody) error {
+	lines, err := getSectionUnprocessed(p, section, name)
+	if err != nil {
+		return err
+	}
+	unprocessed := make([]types.UnProcessed, 0, len(lines)+3)
+	for _, l := range lines {
+		if f := strings.Fields(l.Value); len(f) > 0 && f[0] == "compression" {
+			continue
+		}
+		unprocessed = append(unprocessed, l)
+	}
+	if len(data.Algorithms) > 0 {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: "compression algo " + strings.Join(data.Algorithms, " ")})
+	}
+	if len(data.Types) > 0 {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: "compression type " + strings.Join(data.Types, " ")})
+	}
+	if data.Offload {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: "compression offload"})
+	}
+	if len(unprocessed) == 0 {
+		return p.Set(section, name, "", nil)
+	}
+	return p.Set(section, name, "", unprocessed)
+}
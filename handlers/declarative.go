@@ -0,0 +1,653 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/configuration"
+)
+
+//ApplyConfigurationHandlerImpl implementation of the ApplyConfigurationHandler interface
+type ApplyConfigurationHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//PlanConfigurationHandlerImpl implementation of the PlanConfigurationHandler interface
+type PlanConfigurationHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+// declarativeApply applies the difference between the desired configuration
+// and the configuration in a transaction
+type declarativeApply struct {
+	client  *client_native.HAProxyClient
+	t       string
+	changes []*configuration.ApplyConfigurationOKBodyChangesItems0
+}
+
+//Handle executing the request and returning a response
+func (h *ApplyConfigurationHandlerImpl) Handle(params configuration.ApplyConfigurationParams, principal interface{}) middleware.Responder {
+	v := int64(0)
+	if params.Version != nil {
+		v = *params.Version
+	} else {
+		current, err := h.Client.Configuration.GetVersion("")
+		if err != nil {
+			e := misc.HandleError(err)
+			return configuration.NewApplyConfigurationDefault(int(*e.Code)).WithPayload(e)
+		}
+		v = current
+	}
+
+	if err := validateDeclarative(&params.Data); err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewApplyConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	tr, err := h.Client.Configuration.StartTransaction(v)
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewApplyConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	d := &declarativeApply{
+		client:  h.Client,
+		t:       tr.ID,
+		changes: make([]*configuration.ApplyConfigurationOKBodyChangesItems0, 0),
+	}
+	changed, err := d.apply(&params.Data)
+	if err != nil || !changed {
+		// nolint:errcheck
+		h.Client.Configuration.DeleteTransaction(tr.ID)
+		if err != nil {
+			e := misc.HandleError(err)
+			return configuration.NewApplyConfigurationDefault(int(*e.Code)).WithPayload(e)
+		}
+		return configuration.NewApplyConfigurationOK().WithPayload(&configuration.ApplyConfigurationOKBody{
+			Changes: make([]*configuration.ApplyConfigurationOKBodyChangesItems0, 0),
+		})
+	}
+
+	if _, err := h.Client.Configuration.CommitTransaction(tr.ID); err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewApplyConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if *params.ForceReload {
+		err := h.ReloadAgent.ForceReload()
+		if err != nil {
+			e := misc.HandleError(err)
+			return configuration.NewApplyConfigurationDefault(int(*e.Code)).WithPayload(e)
+		}
+		return configuration.NewApplyConfigurationOK().WithPayload(&configuration.ApplyConfigurationOKBody{TransactionID: tr.ID, Changes: d.changes})
+	}
+	rID := h.ReloadAgent.Reload()
+	acceptedBody := &configuration.ApplyConfigurationAcceptedBody{
+		TransactionID: tr.ID,
+		Changes:       make([]*configuration.ApplyConfigurationAcceptedBodyChangesItems0, 0, len(d.changes)),
+	}
+	for _, c := range d.changes {
+		item := configuration.ApplyConfigurationAcceptedBodyChangesItems0(*c)
+		acceptedBody.Changes = append(acceptedBody.Changes, &item)
+	}
+	return configuration.NewApplyConfigurationAccepted().WithReloadID(rID).WithPayload(acceptedBody)
+}
+
+//Handle executing the request and returning a response
+func (h *PlanConfigurationHandlerImpl) Handle(params configuration.PlanConfigurationParams, principal interface{}) middleware.Responder {
+	v := int64(0)
+	if params.Version != nil {
+		v = *params.Version
+	} else {
+		current, err := h.Client.Configuration.GetVersion("")
+		if err != nil {
+			e := misc.HandleError(err)
+			return configuration.NewPlanConfigurationDefault(int(*e.Code)).WithPayload(e)
+		}
+		v = current
+	}
+
+	// the plan body has the same fields as the apply body
+	data := configuration.ApplyConfigurationBody{}
+	b, err := params.Data.MarshalBinary()
+	if err == nil {
+		err = data.UnmarshalBinary(b)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewPlanConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	if err := validateDeclarative(&data); err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewPlanConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	// changes are computed in a transaction which is always discarded
+	tr, err := h.Client.Configuration.StartTransaction(v)
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewPlanConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	d := &declarativeApply{
+		client:  h.Client,
+		t:       tr.ID,
+		changes: make([]*configuration.ApplyConfigurationOKBodyChangesItems0, 0),
+	}
+	changed, err := d.apply(&data)
+	// nolint:errcheck
+	h.Client.Configuration.DeleteTransaction(tr.ID)
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewPlanConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	body := &configuration.PlanConfigurationOKBody{
+		Version: v,
+		Changes: make([]*configuration.PlanConfigurationOKBodyChangesItems0, 0, len(d.changes)),
+	}
+	if changed {
+		for _, c := range d.changes {
+			item := configuration.PlanConfigurationOKBodyChangesItems0(*c)
+			body.Changes = append(body.Changes, &item)
+		}
+	}
+	return configuration.NewPlanConfigurationOK().WithPayload(body)
+}
+
+func validateDeclarative(data *configuration.ApplyConfigurationBody) error {
+	names := make(map[string]bool)
+	for _, b := range data.Backends {
+		if b.Backend.Name == "" {
+			return native_configuration.NewConfError(native_configuration.ErrValidationError, "backend name not set")
+		}
+		if names[b.Backend.Name] {
+			return native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("backend %s set more than once", b.Backend.Name))
+		}
+		names[b.Backend.Name] = true
+	}
+	names = make(map[string]bool)
+	for _, f := range data.Frontends {
+		if f.Frontend.Name == "" {
+			return native_configuration.NewConfError(native_configuration.ErrValidationError, "frontend name not set")
+		}
+		if names[f.Frontend.Name] {
+			return native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("frontend %s set more than once", f.Frontend.Name))
+		}
+		names[f.Frontend.Name] = true
+	}
+	return nil
+}
+
+// apply changes the transaction to match the desired configuration, and returns
+// true if the resulting configuration differs from the current one
+func (d *declarativeApply) apply(data *configuration.ApplyConfigurationBody) (bool, error) {
+	if data.Global != nil {
+		_, current, err := d.client.Configuration.GetGlobalConfiguration(d.t)
+		if err != nil {
+			return false, err
+		}
+		if !equalJSON(current, data.Global) {
+			if err := d.client.Configuration.PushGlobalConfiguration(data.Global, d.t, 0); err != nil {
+				return false, err
+			}
+			d.change("global", "", "", "", "replace")
+		}
+	}
+	if data.Defaults != nil {
+		_, current, err := d.client.Configuration.GetDefaultsConfiguration(d.t)
+		if err != nil {
+			return false, err
+		}
+		if !equalJSON(current, data.Defaults) {
+			if err := d.client.Configuration.PushDefaultsConfiguration(data.Defaults, d.t, 0); err != nil {
+				return false, err
+			}
+			d.change("defaults", "", "", "", "replace")
+		}
+	}
+
+	// backends are created before the frontends that may use them, and deleted after
+	if data.Backends != nil {
+		for _, b := range data.Backends {
+			if err := d.applyBackend(b); err != nil {
+				return false, err
+			}
+		}
+	}
+	if data.Frontends != nil {
+		for _, f := range data.Frontends {
+			if err := d.applyFrontend(f); err != nil {
+				return false, err
+			}
+		}
+		_, current, err := d.client.Configuration.GetFrontends(d.t)
+		if err != nil {
+			return false, err
+		}
+		for _, c := range current {
+			if !containsFrontend(data.Frontends, c.Name) {
+				if err := d.client.Configuration.DeleteFrontend(c.Name, d.t, 0); err != nil {
+					return false, err
+				}
+				d.change("frontend", "", "", c.Name, "delete")
+			}
+		}
+	}
+	if data.Backends != nil {
+		_, current, err := d.client.Configuration.GetBackends(d.t)
+		if err != nil {
+			return false, err
+		}
+		for _, c := range current {
+			if !containsBackend(data.Backends, c.Name) {
+				if err := d.client.Configuration.DeleteBackend(c.Name, d.t, 0); err != nil {
+					return false, err
+				}
+				d.change("backend", "", "", c.Name, "delete")
+			}
+		}
+	}
+
+	if len(d.changes) == 0 {
+		return false, nil
+	}
+	// objects can differ only by defaults filled in by the configuration client,
+	// so check the resulting configuration really changed
+	current, err := d.client.Configuration.GetParser("")
+	if err != nil {
+		return false, err
+	}
+	staged, err := d.client.Configuration.GetParser(d.t)
+	if err != nil {
+		return false, err
+	}
+	return len(haproxy.DiffConfigurations(current.String(), staged.String())) > 0, nil
+}
+
+func (d *declarativeApply) change(objectType, parentType, parentName, name, action string) {
+	d.changes = append(d.changes, &configuration.ApplyConfigurationOKBodyChangesItems0{
+		ID:         changeID(objectType, parentType, parentName, name),
+		Type:       objectType,
+		ParentName: parentName,
+		Name:       name,
+		Action:     action,
+	})
+}
+
+func (d *declarativeApply) applyBackend(b *configuration.ApplyConfigurationBodyBackendsItems0) error {
+	name := b.Backend.Name
+	current := &backendNested{}
+	_, bck, err := d.client.Configuration.GetBackend(name, d.t)
+	if err != nil {
+		if err := d.client.Configuration.CreateBackend(b.Backend, d.t, 0); err != nil {
+			return err
+		}
+		d.change("backend", "", "", name, "create")
+	} else {
+		if !equalJSON(bck, b.Backend) {
+			if err := d.client.Configuration.EditBackend(name, b.Backend, d.t, 0); err != nil {
+				return err
+			}
+			d.change("backend", "", "", name, "replace")
+		}
+		data, err := getBackendNested(d.client, name, d.t)
+		if err != nil {
+			return err
+		}
+		current = (*backendNested)(data)
+	}
+	desired := backendNested(*b)
+
+	// servers are matched by name
+	for _, s := range desired.Servers {
+		found := false
+		for _, c := range current.Servers {
+			if c.Name != s.Name {
+				continue
+			}
+			found = true
+			if !equalJSON(c, s) {
+				if err := d.client.Configuration.EditServer(s.Name, name, s, d.t, 0); err != nil {
+					return err
+				}
+				d.change("server", "backend", name, s.Name, "replace")
+			}
+		}
+		if !found {
+			if err := d.client.Configuration.CreateServer(name, s, d.t, 0); err != nil {
+				return err
+			}
+			d.change("server", "backend", name, s.Name, "create")
+		}
+	}
+	for _, c := range current.Servers {
+		found := false
+		for _, s := range desired.Servers {
+			found = found || s.Name == c.Name
+		}
+		if !found {
+			if err := d.client.Configuration.DeleteServer(c.Name, name, d.t, 0); err != nil {
+				return err
+			}
+			d.change("server", "backend", name, c.Name, "delete")
+		}
+	}
+
+	for i, a := range desired.Acls {
+		a.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("acls", "backend", name, current.Acls, desired.Acls, len(current.Acls), len(desired.Acls),
+		func(i int64) error { return d.client.Configuration.DeleteACL(i, "backend", name, d.t, 0) },
+		func(i int) error { return d.client.Configuration.CreateACL("backend", name, desired.Acls[i], d.t, 0) })
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.HTTPRequestRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("http_request_rules", "backend", name, current.HTTPRequestRules, desired.HTTPRequestRules, len(current.HTTPRequestRules), len(desired.HTTPRequestRules),
+		func(i int64) error { return d.client.Configuration.DeleteHTTPRequestRule(i, "backend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateHTTPRequestRule("backend", name, desired.HTTPRequestRules[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.HTTPResponseRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("http_response_rules", "backend", name, current.HTTPResponseRules, desired.HTTPResponseRules, len(current.HTTPResponseRules), len(desired.HTTPResponseRules),
+		func(i int64) error { return d.client.Configuration.DeleteHTTPResponseRule(i, "backend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateHTTPResponseRule("backend", name, desired.HTTPResponseRules[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.TCPRequestRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("tcp_request_rules", "backend", name, current.TCPRequestRules, desired.TCPRequestRules, len(current.TCPRequestRules), len(desired.TCPRequestRules),
+		func(i int64) error { return d.client.Configuration.DeleteTCPRequestRule(i, "backend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateTCPRequestRule("backend", name, desired.TCPRequestRules[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.TCPResponseRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("tcp_response_rules", "backend", name, current.TCPResponseRules, desired.TCPResponseRules, len(current.TCPResponseRules), len(desired.TCPResponseRules),
+		func(i int64) error { return d.client.Configuration.DeleteTCPResponseRule(i, name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateTCPResponseRule(name, desired.TCPResponseRules[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.ServerSwitchingRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("server_switching_rules", "backend", name, current.ServerSwitchingRules, desired.ServerSwitchingRules, len(current.ServerSwitchingRules), len(desired.ServerSwitchingRules),
+		func(i int64) error { return d.client.Configuration.DeleteServerSwitchingRule(i, name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateServerSwitchingRule(name, desired.ServerSwitchingRules[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.StickRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("stick_rules", "backend", name, current.StickRules, desired.StickRules, len(current.StickRules), len(desired.StickRules),
+		func(i int64) error { return d.client.Configuration.DeleteStickRule(i, name, d.t, 0) },
+		func(i int) error { return d.client.Configuration.CreateStickRule(name, desired.StickRules[i], d.t, 0) })
+	if err != nil {
+		return err
+	}
+	for i, f := range desired.Filters {
+		f.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("filters", "backend", name, current.Filters, desired.Filters, len(current.Filters), len(desired.Filters),
+		func(i int64) error { return d.client.Configuration.DeleteFilter(i, "backend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateFilter("backend", name, desired.Filters[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, l := range desired.LogTargets {
+		l.Index = misc.Int64P(i)
+	}
+	return d.replaceList("log_targets", "backend", name, current.LogTargets, desired.LogTargets, len(current.LogTargets), len(desired.LogTargets),
+		func(i int64) error { return d.client.Configuration.DeleteLogTarget(i, "backend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateLogTarget("backend", name, desired.LogTargets[i], d.t, 0)
+		})
+}
+
+func (d *declarativeApply) applyFrontend(f *configuration.ApplyConfigurationBodyFrontendsItems0) error {
+	name := f.Frontend.Name
+	current := &frontendNested{}
+	_, fe, err := d.client.Configuration.GetFrontend(name, d.t)
+	if err != nil {
+		if err := d.client.Configuration.CreateFrontend(f.Frontend, d.t, 0); err != nil {
+			return err
+		}
+		d.change("frontend", "", "", name, "create")
+	} else {
+		if !equalJSON(fe, f.Frontend) {
+			if err := d.client.Configuration.EditFrontend(name, f.Frontend, d.t, 0); err != nil {
+				return err
+			}
+			d.change("frontend", "", "", name, "replace")
+		}
+		data, err := getFrontendNested(d.client, name, d.t)
+		if err != nil {
+			return err
+		}
+		current = (*frontendNested)(data)
+	}
+	desired := frontendNested(*f)
+
+	// binds are matched by name
+	for _, b := range desired.Binds {
+		found := false
+		for _, c := range current.Binds {
+			if c.Name != b.Name {
+				continue
+			}
+			found = true
+			if !equalJSON(c, b) {
+				if err := d.client.Configuration.EditBind(b.Name, name, b, d.t, 0); err != nil {
+					return err
+				}
+				d.change("bind", "frontend", name, b.Name, "replace")
+			}
+		}
+		if !found {
+			if err := d.client.Configuration.CreateBind(name, b, d.t, 0); err != nil {
+				return err
+			}
+			d.change("bind", "frontend", name, b.Name, "create")
+		}
+	}
+	for _, c := range current.Binds {
+		found := false
+		for _, b := range desired.Binds {
+			found = found || b.Name == c.Name
+		}
+		if !found {
+			if err := d.client.Configuration.DeleteBind(c.Name, name, d.t, 0); err != nil {
+				return err
+			}
+			d.change("bind", "frontend", name, c.Name, "delete")
+		}
+	}
+
+	for i, a := range desired.Acls {
+		a.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("acls", "frontend", name, current.Acls, desired.Acls, len(current.Acls), len(desired.Acls),
+		func(i int64) error { return d.client.Configuration.DeleteACL(i, "frontend", name, d.t, 0) },
+		func(i int) error { return d.client.Configuration.CreateACL("frontend", name, desired.Acls[i], d.t, 0) })
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.HTTPRequestRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("http_request_rules", "frontend", name, current.HTTPRequestRules, desired.HTTPRequestRules, len(current.HTTPRequestRules), len(desired.HTTPRequestRules),
+		func(i int64) error { return d.client.Configuration.DeleteHTTPRequestRule(i, "frontend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateHTTPRequestRule("frontend", name, desired.HTTPRequestRules[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.HTTPResponseRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("http_response_rules", "frontend", name, current.HTTPResponseRules, desired.HTTPResponseRules, len(current.HTTPResponseRules), len(desired.HTTPResponseRules),
+		func(i int64) error { return d.client.Configuration.DeleteHTTPResponseRule(i, "frontend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateHTTPResponseRule("frontend", name, desired.HTTPResponseRules[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.TCPRequestRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("tcp_request_rules", "frontend", name, current.TCPRequestRules, desired.TCPRequestRules, len(current.TCPRequestRules), len(desired.TCPRequestRules),
+		func(i int64) error { return d.client.Configuration.DeleteTCPRequestRule(i, "frontend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateTCPRequestRule("frontend", name, desired.TCPRequestRules[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, r := range desired.BackendSwitchingRules {
+		r.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("backend_switching_rules", "frontend", name, current.BackendSwitchingRules, desired.BackendSwitchingRules, len(current.BackendSwitchingRules), len(desired.BackendSwitchingRules),
+		func(i int64) error { return d.client.Configuration.DeleteBackendSwitchingRule(i, name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateBackendSwitchingRule(name, desired.BackendSwitchingRules[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, fl := range desired.Filters {
+		fl.Index = misc.Int64P(i)
+	}
+	err = d.replaceList("filters", "frontend", name, current.Filters, desired.Filters, len(current.Filters), len(desired.Filters),
+		func(i int64) error { return d.client.Configuration.DeleteFilter(i, "frontend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateFilter("frontend", name, desired.Filters[i], d.t, 0)
+		})
+	if err != nil {
+		return err
+	}
+	for i, l := range desired.LogTargets {
+		l.Index = misc.Int64P(i)
+	}
+	return d.replaceList("log_targets", "frontend", name, current.LogTargets, desired.LogTargets, len(current.LogTargets), len(desired.LogTargets),
+		func(i int64) error { return d.client.Configuration.DeleteLogTarget(i, "frontend", name, d.t, 0) },
+		func(i int) error {
+			return d.client.Configuration.CreateLogTarget("frontend", name, desired.LogTargets[i], d.t, 0)
+		})
+}
+
+// changeID returns a stable identifier of a changed object, made of the parent
+// and object types and names, for example backend/app/server/app1 or backend/app/acls
+func changeID(objectType, parentType, parentName, name string) string {
+	parts := make([]string, 0, 4)
+	if parentType != "" {
+		parts = append(parts, parentType, parentName)
+	}
+	parts = append(parts, objectType)
+	if name != "" {
+		parts = append(parts, name)
+	}
+	return strings.Join(parts, "/")
+}
+
+// replaceList replaces a list of indexed objects when it differs from the desired one
+func (d *declarativeApply) replaceList(objectType, parentType, parentName string, current, desired interface{}, currentLen, desiredLen int, del func(int64) error, create func(int) error) error {
+	if currentLen == desiredLen && (desiredLen == 0 || equalJSON(current, desired)) {
+		return nil
+	}
+	for i := currentLen - 1; i >= 0; i-- {
+		if err := del(int64(i)); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < desiredLen; i++ {
+		if err := create(i); err != nil {
+			return err
+		}
+	}
+	d.change(objectType, parentType, parentName, "", "replace")
+	return nil
+}
+
+// backendNested and frontendNested share the nested resource fields of the full
+// backend and frontend objects, so the desired and current objects can be compared
+type backendNested configuration.ApplyConfigurationBodyBackendsItems0
+
+type frontendNested configuration.ApplyConfigurationBodyFrontendsItems0
+
+func containsBackend(backends []*configuration.ApplyConfigurationBodyBackendsItems0, name string) bool {
+	for _, b := range backends {
+		if b.Backend.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFrontend(frontends []*configuration.ApplyConfigurationBodyFrontendsItems0, name string) bool {
+	for _, f := range frontends {
+		if f.Frontend.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func equalJSON(a, b interface{}) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aJSON, bJSON)
+}
@@ -34,6 +34,7 @@ type GetDefaultsHandlerImpl struct {
 type ReplaceDefaultsHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
 }
 
 //Handle executing the request and returning a response
@@ -72,7 +73,13 @@ func (h *ReplaceDefaultsHandlerImpl) Handle(params defaults.ReplaceDefaultsParam
 		return defaults.NewReplaceDefaultsDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.PushDefaultsConfiguration(params.Data, t, v)
+	err := validateLogFormat(h.Validator, params.Data.LogFormat, "", "defaults")
+	if err != nil {
+		e := misc.HandleError(err)
+		return defaults.NewReplaceDefaultsDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err = h.Client.Configuration.PushDefaultsConfiguration(params.Data, t, v)
 
 	if err != nil {
 		e := misc.HandleError(err)
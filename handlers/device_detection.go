@@ -0,0 +1,294 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/global"
+)
+
+// The device detection directives are not known to the configuration parser and
+// are kept as unprocessed global lines.
+
+// deviceDetectionKeywords are the global directives of the device detection modules
+var deviceDetectionKeywords = []string{
+	"51degrees-data-file",
+	"51degrees-property-name-list",
+	"51degrees-property-separator",
+	"51degrees-cache-size",
+	"deviceatlas-json-file",
+	"deviceatlas-log-level",
+	"deviceatlas-separator",
+	"deviceatlas-properties-cookie",
+	"wurfl-data-file",
+	"wurfl-information-list",
+	"wurfl-information-list-separator",
+	"wurfl-patch-file",
+	"wurfl-cache-size",
+}
+
+//GetDeviceDetectionHandlerImpl implementation of the GetDeviceDetectionHandler interface
+type GetDeviceDetectionHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceDeviceDetectionHandlerImpl implementation of the ReplaceDeviceDetectionHandler interface
+type ReplaceDeviceDetectionHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
+}
+
+//Handle executing the request and returning a response
+func (h *GetDeviceDetectionHandlerImpl) Handle(params global.GetDeviceDetectionParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetDeviceDetectionDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetDeviceDetectionDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data, err := parseDeviceDetection(p)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetDeviceDetectionDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return global.NewGetDeviceDetectionOK().WithPayload(&global.GetDeviceDetectionOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceDeviceDetectionHandlerImpl) Handle(params global.ReplaceDeviceDetectionParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return global.NewReplaceDeviceDetectionDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := &global.GetDeviceDetectionOKBodyData{}
+	if err := convertBody(&params.Data, data); err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceDeviceDetectionDefault(int(*e.Code)).WithPayload(e)
+	}
+	if err := validateDeviceDetection(h.Validator, data); err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceDeviceDetectionDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		return serializeDeviceDetection(p, data)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceDeviceDetectionDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return global.NewReplaceDeviceDetectionDefault(int(*e.Code)).WithPayload(e)
+			}
+			okBody := &global.ReplaceDeviceDetectionOKBody{}
+			// nolint:errcheck
+			convertBody(data, okBody)
+			return global.NewReplaceDeviceDetectionOK().WithPayload(okBody)
+		}
+		rID := h.ReloadAgent.Reload()
+		acceptedBody := &global.ReplaceDeviceDetectionAcceptedBody{}
+		// nolint:errcheck
+		convertBody(data, acceptedBody)
+		return global.NewReplaceDeviceDetectionAccepted().WithReloadID(rID).WithPayload(acceptedBody)
+	}
+	acceptedBody := &global.ReplaceDeviceDetectionAcceptedBody{}
+	// nolint:errcheck
+	convertBody(data, acceptedBody)
+	return global.NewReplaceDeviceDetectionAccepted().WithPayload(acceptedBody)
+}
+
+func parseDeviceDetection(p *parser.Parser) (*global.GetDeviceDetectionOKBodyData, error) {
+	data := &global.GetDeviceDetectionOKBodyData{}
+	lines, err := getGlobalUnprocessed(p)
+	if err != nil {
+		return nil, err
+	}
+	fiftyoneDegrees := func() *global.GetDeviceDetectionOKBodyDataFiftyoneDegrees {
+		if data.FiftyoneDegrees == nil {
+			data.FiftyoneDegrees = &global.GetDeviceDetectionOKBodyDataFiftyoneDegrees{DataFile: misc.StringP("")}
+		}
+		return data.FiftyoneDegrees
+	}
+	deviceatlas := func() *global.GetDeviceDetectionOKBodyDataDeviceatlas {
+		if data.Deviceatlas == nil {
+			data.Deviceatlas = &global.GetDeviceDetectionOKBodyDataDeviceatlas{JSONFile: misc.StringP("")}
+		}
+		return data.Deviceatlas
+	}
+	wurfl := func() *global.GetDeviceDetectionOKBodyDataWurfl {
+		if data.Wurfl == nil {
+			data.Wurfl = &global.GetDeviceDetectionOKBodyDataWurfl{DataFile: misc.StringP("")}
+		}
+		return data.Wurfl
+	}
+	for _, l := range lines {
+		f := strings.Fields(l.Value)
+		if len(f) < 2 {
+			continue
+		}
+		switch f[0] {
+		case "51degrees-data-file":
+			fiftyoneDegrees().DataFile = misc.StringP(f[1])
+		case "51degrees-property-name-list":
+			fiftyoneDegrees().PropertyNameList = append(fiftyoneDegrees().PropertyNameList, f[1:]...)
+		case "51degrees-property-separator":
+			fiftyoneDegrees().PropertySeparator = f[1]
+		case "51degrees-cache-size":
+			fiftyoneDegrees().CacheSize = parseGlobalInt(f[1])
+		case "deviceatlas-json-file":
+			deviceatlas().JSONFile = misc.StringP(f[1])
+		case "deviceatlas-log-level":
+			deviceatlas().LogLevel = parseGlobalInt(f[1])
+		case "deviceatlas-separator":
+			deviceatlas().Separator = f[1]
+		case "deviceatlas-properties-cookie":
+			deviceatlas().PropertiesCookie = f[1]
+		case "wurfl-data-file":
+			wurfl().DataFile = misc.StringP(f[1])
+		case "wurfl-information-list":
+			wurfl().InformationList = append(wurfl().InformationList, f[1:]...)
+		case "wurfl-information-list-separator":
+			wurfl().InformationListSeparator = f[1]
+		case "wurfl-patch-file":
+			wurfl().PatchFiles = append(wurfl().PatchFiles, f[1:]...)
+		case "wurfl-cache-size":
+			wurfl().CacheSize = parseGlobalInt(f[1])
+		}
+	}
+	return data, nil
+}
+
+func serializeDeviceDetection(p *parser.Parser, data *global.GetDeviceDetectionOKBodyData) error {
+	lines, err := getGlobalUnprocessed(p)
+	if err != nil {
+		return err
+	}
+	unprocessed := make([]types.UnProcessed, 0, len(lines)+len(deviceDetectionKeywords))
+	for _, l := range lines {
+		if f := strings.Fields(l.Value); len(f) > 0 && containsOption(f[0], deviceDetectionKeywords) {
+			continue
+		}
+		unprocessed = append(unprocessed, l)
+	}
+	add := func(keyword string, values ...string) {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: keyword + " " + strings.Join(values, " ")})
+	}
+	if d := data.FiftyoneDegrees; d != nil {
+		add("51degrees-data-file", *d.DataFile)
+		if len(d.PropertyNameList) > 0 {
+			add("51degrees-property-name-list", d.PropertyNameList...)
+		}
+		if d.PropertySeparator != "" {
+			add("51degrees-property-separator", d.PropertySeparator)
+		}
+		if d.CacheSize != nil {
+			add("51degrees-cache-size", strconv.FormatInt(*d.CacheSize, 10))
+		}
+	}
+	if d := data.Deviceatlas; d != nil {
+		add("deviceatlas-json-file", *d.JSONFile)
+		if d.LogLevel != nil {
+			add("deviceatlas-log-level", strconv.FormatInt(*d.LogLevel, 10))
+		}
+		if d.Separator != "" {
+			add("deviceatlas-separator", d.Separator)
+		}
+		if d.PropertiesCookie != "" {
+			add("deviceatlas-properties-cookie", d.PropertiesCookie)
+		}
+	}
+	if d := data.Wurfl; d != nil {
+		add("wurfl-data-file", *d.DataFile)
+		if len(d.InformationList) > 0 {
+			add("wurfl-information-list", d.InformationList...)
+		}
+		if d.InformationListSeparator != "" {
+			add("wurfl-information-list-separator", d.InformationListSeparator)
+		}
+		for _, f := range d.PatchFiles {
+			add("wurfl-patch-file", f)
+		}
+		if d.CacheSize != nil {
+			add("wurfl-cache-size", strconv.FormatInt(*d.CacheSize, 10))
+		}
+	}
+	if len(unprocessed) == 0 {
+		return p.Set(parser.Global, parser.GlobalSectionName, "", nil)
+	}
+	return p.Set(parser.Global, parser.GlobalSectionName, "", unprocessed)
+}
+
+// validateDeviceDetection checks that the running HAProxy is built with the
+// modules set in data
+func validateDeviceDetection(v *haproxy.SampleValidator, data *global.GetDeviceDetectionOKBodyData) error {
+	modules := []struct {
+		name    string
+		feature string
+		set     bool
+	}{
+		{"fiftyone_degrees", "51DEGREES", data.FiftyoneDegrees != nil},
+		{"deviceatlas", "DEVICEATLAS", data.Deviceatlas != nil},
+		{"wurfl", "WURFL", data.Wurfl != nil},
+	}
+	for _, m := range modules {
+		if m.set && v != nil && !v.HasFeature(m.feature) {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("%s: HAProxy is not built with %s", m.name, m.feature))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,367 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	parser_errors "github.com/haproxytech/config-parser/v2/errors"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/global"
+	"github.com/haproxytech/dataplaneapi/operations/storage"
+)
+
+// DH parameter files are stored in the general storage. ssl-dh-param-file is not
+// known to the configuration parser and is kept as an unprocessed global line.
+
+//GetAllStorageDHParamsHandlerImpl implementation of the GetAllStorageDHParamsHandler interface
+type GetAllStorageDHParamsHandlerImpl struct {
+	Client    *client_native.HAProxyClient
+	Dir       string
+	Generator *haproxy.DHParamGenerator
+}
+
+//CreateStorageDHParamHandlerImpl implementation of the CreateStorageDHParamHandler interface
+type CreateStorageDHParamHandlerImpl struct {
+	Client *client_native.HAProxyClient
+	Dir    string
+	Quota  *configuration.StorageQuota
+}
+
+//GenerateStorageDHParamHandlerImpl implementation of the GenerateStorageDHParamHandler interface
+type GenerateStorageDHParamHandlerImpl struct {
+	Dir       string
+	Generator *haproxy.DHParamGenerator
+}
+
+//GetDHParamHandlerImpl implementation of the GetDHParamHandler interface
+type GetDHParamHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceDHParamHandlerImpl implementation of the ReplaceDHParamHandler interface
+type ReplaceDHParamHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetAllStorageDHParamsHandlerImpl) Handle(params storage.GetAllStorageDHParamsParams, principal interface{}) middleware.Responder {
+	config, err := currentConfiguration(h.Client)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewGetAllStorageDHParamsDefault(int(*e.Code)).WithPayload(e)
+	}
+	files, err := haproxy.StorageFiles(h.Dir)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewGetAllStorageDHParamsDefault(int(*e.Code)).WithPayload(e)
+	}
+	generating := h.Generator.Generating()
+	data := make([]*storage.GetAllStorageDHParamsOKBodyItems0, 0)
+	for _, f := range files {
+		if _, ok := generating[f.Path]; ok {
+			continue
+		}
+		bits, err := haproxy.DHParamsFile(f.Path)
+		if err != nil {
+			// not a DH parameter file
+			continue
+		}
+		data = append(data, dhParamFile(f, bits, config))
+	}
+	for path, bits := range generating {
+		if filepath.Dir(path) != filepath.Clean(h.Dir) {
+			continue
+		}
+		data = append(data, &storage.GetAllStorageDHParamsOKBodyItems0{
+			StorageName: filepath.Base(path),
+			File:        path,
+			Bits:        int64(bits),
+			Status:      storage.GetAllStorageDHParamsOKBodyItems0StatusGenerating,
+		})
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].StorageName < data[j].StorageName
+	})
+	return storage.NewGetAllStorageDHParamsOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateStorageDHParamHandlerImpl) Handle(params storage.CreateStorageDHParamParams, principal interface{}) middleware.Responder {
+	defer params.FileUpload.Close()
+	upload := params.FileUpload.(*runtime.File)
+	name := upload.Header.Filename
+	if err := haproxy.ValidStorageName(name); err != nil {
+		c := misc.ErrHTTPBadRequest
+		msg := err.Error()
+		return storage.NewCreateStorageDHParamBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	if _, err := haproxy.StorageFileInfo(h.Dir, name); err == nil {
+		e := misc.HandleError(native_configuration.NewConfError(native_configuration.ErrObjectAlreadyExists, fmt.Sprintf("file %s already exists", name)))
+		return storage.NewCreateStorageDHParamConflict().WithPayload(e)
+	}
+	content, err := ioutil.ReadAll(upload.Data)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewCreateStorageDHParamDefault(int(*e.Code)).WithPayload(e)
+	}
+	bits, err := haproxy.ParseDHParams(content)
+	if err != nil {
+		c := misc.ErrHTTPBadRequest
+		msg := err.Error()
+		return storage.NewCreateStorageDHParamBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	f, err := storeGeneralFile(h.Dir, name, bytes.NewReader(content), int64(len(content)), h.Quota)
+	if err != nil {
+		e := storageError(err)
+		return storage.NewCreateStorageDHParamDefault(int(*e.Code)).WithPayload(e)
+	}
+	config, err := currentConfiguration(h.Client)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewCreateStorageDHParamDefault(int(*e.Code)).WithPayload(e)
+	}
+	created := &storage.CreateStorageDHParamCreatedBody{}
+	if err := convertBody(dhParamFile(f, bits, config), created); err != nil {
+		e := misc.HandleError(err)
+		return storage.NewCreateStorageDHParamDefault(int(*e.Code)).WithPayload(e)
+	}
+	return storage.NewCreateStorageDHParamCreated().WithPayload(created)
+}
+
+//Handle executing the request and returning a response
+func (h *GenerateStorageDHParamHandlerImpl) Handle(params storage.GenerateStorageDHParamParams, principal interface{}) middleware.Responder {
+	name := *params.Data.Name
+	bits := params.Data.Bits
+	if bits == 0 {
+		bits = haproxy.MinDHParamBits
+	}
+	if err := haproxy.ValidStorageName(name); err != nil {
+		c := misc.ErrHTTPBadRequest
+		msg := err.Error()
+		return storage.NewGenerateStorageDHParamBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	if _, err := haproxy.StorageFileInfo(h.Dir, name); err == nil {
+		e := misc.HandleError(native_configuration.NewConfError(native_configuration.ErrObjectAlreadyExists, fmt.Sprintf("file %s already exists", name)))
+		return storage.NewGenerateStorageDHParamConflict().WithPayload(e)
+	}
+	if err := h.Generator.Generate(h.Dir, name, int(bits)); err != nil {
+		e := misc.HandleError(native_configuration.NewConfError(native_configuration.ErrObjectAlreadyExists, err.Error()))
+		return storage.NewGenerateStorageDHParamConflict().WithPayload(e)
+	}
+	return storage.NewGenerateStorageDHParamAccepted().WithPayload(&storage.GenerateStorageDHParamAcceptedBody{
+		StorageName: name,
+		File:        filepath.Join(h.Dir, name),
+		Bits:        bits,
+		Status:      storage.GenerateStorageDHParamAcceptedBodyStatusGenerating,
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *GetDHParamHandlerImpl) Handle(params global.GetDHParamParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetDHParamDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetDHParamDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data, err := parseDHParam(p)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetDHParamDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return global.NewGetDHParamOK().WithPayload(&global.GetDHParamOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceDHParamHandlerImpl) Handle(params global.ReplaceDHParamParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return global.NewReplaceDHParamDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := &global.GetDHParamOKBodyData{}
+	if err := convertBody(&params.Data, data); err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceDHParamDefault(int(*e.Code)).WithPayload(e)
+	}
+	if data.SslDhParamFile != "" {
+		bits, err := haproxy.DHParamsFile(data.SslDhParamFile)
+		if err != nil {
+			e := misc.HandleError(native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("ssl_dh_param_file %s: %s", data.SslDhParamFile, err.Error())))
+			return global.NewReplaceDHParamDefault(int(*e.Code)).WithPayload(e)
+		}
+		data.Bits = int64(bits)
+	}
+	data.Warnings = dhParamWarnings(data)
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		return serializeDHParam(p, data)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceDHParamDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return global.NewReplaceDHParamDefault(int(*e.Code)).WithPayload(e)
+			}
+			okBody := &global.ReplaceDHParamOKBody{}
+			// nolint:errcheck
+			convertBody(data, okBody)
+			return global.NewReplaceDHParamOK().WithPayload(okBody)
+		}
+		rID := h.ReloadAgent.Reload()
+		acceptedBody := &global.ReplaceDHParamAcceptedBody{}
+		// nolint:errcheck
+		convertBody(data, acceptedBody)
+		return global.NewReplaceDHParamAccepted().WithReloadID(rID).WithPayload(acceptedBody)
+	}
+	acceptedBody := &global.ReplaceDHParamAcceptedBody{}
+	// nolint:errcheck
+	convertBody(data, acceptedBody)
+	return global.NewReplaceDHParamAccepted().WithPayload(acceptedBody)
+}
+
+func dhParamFile(f haproxy.StorageFile, bits int, config string) *storage.GetAllStorageDHParamsOKBodyItems0 {
+	return &storage.GetAllStorageDHParamsOKBodyItems0{
+		StorageName: f.Name,
+		File:        f.Path,
+		Bits:        int64(bits),
+		Modified:    f.Modified,
+		Referenced:  haproxy.IsReferenced(config, f.Name),
+		Weak:        bits < haproxy.MinDHParamBits,
+		Status:      storage.GetAllStorageDHParamsOKBodyItems0StatusReady,
+		Warning:     haproxy.DHParamsWarning(bits),
+	}
+}
+
+func parseDHParam(p *parser.Parser) (*global.GetDHParamOKBodyData, error) {
+	data := &global.GetDHParamOKBodyData{}
+	dhParam, err := p.Get(parser.Global, parser.GlobalSectionName, "tune.ssl.default-dh-param")
+	if err == nil {
+		v := dhParam.(*types.Int64C).Value
+		data.TuneSslDefaultDhParam = &v
+	} else if err != parser_errors.ErrFetch {
+		return nil, err
+	}
+	lines, err := getGlobalUnprocessed(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		if f := strings.Fields(l.Value); len(f) == 2 && f[0] == "ssl-dh-param-file" {
+			data.SslDhParamFile = f[1]
+		}
+	}
+	if data.SslDhParamFile != "" {
+		// the file is read on the host of the API, it can be unreadable
+		// when HAProxy runs elsewhere
+		if bits, err := haproxy.DHParamsFile(data.SslDhParamFile); err == nil {
+			data.Bits = int64(bits)
+		}
+	}
+	data.Warnings = dhParamWarnings(data)
+	return data, nil
+}
+
+func serializeDHParam(p *parser.Parser, data *global.GetDHParamOKBodyData) error {
+	var dhParam *types.Int64C
+	if data.TuneSslDefaultDhParam != nil {
+		dhParam = &types.Int64C{Value: *data.TuneSslDefaultDhParam}
+	}
+	if err := p.Set(parser.Global, parser.GlobalSectionName, "tune.ssl.default-dh-param", dhParam); err != nil {
+		return err
+	}
+
+	lines, err := getGlobalUnprocessed(p)
+	if err != nil {
+		return err
+	}
+	unprocessed := make([]types.UnProcessed, 0, len(lines)+1)
+	for _, l := range lines {
+		if f := strings.Fields(l.Value); len(f) > 0 && f[0] == "ssl-dh-param-file" {
+			continue
+		}
+		unprocessed = append(unprocessed, l)
+	}
+	if data.SslDhParamFile != "" {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: "ssl-dh-param-file " + data.SslDhParamFile})
+	}
+	if len(unprocessed) == 0 {
+		return p.Set(parser.Global, parser.GlobalSectionName, "", nil)
+	}
+	return p.Set(parser.Global, parser.GlobalSectionName, "", unprocessed)
+}
+
+// dhParamWarnings returns the warnings about weak DH parameters of data, the
+// size of the generated parameters only matters without ssl-dh-param-file
+func dhParamWarnings(data *global.GetDHParamOKBodyData) []string {
+	warnings := make([]string, 0)
+	if data.SslDhParamFile != "" {
+		if data.Bits == 0 {
+			warnings = append(warnings, fmt.Sprintf("DH parameters of %s cannot be read", data.SslDhParamFile))
+		} else if w := haproxy.DHParamsWarning(int(data.Bits)); w != "" {
+			warnings = append(warnings, w)
+		}
+	} else if data.TuneSslDefaultDhParam != nil && *data.TuneSslDefaultDhParam < haproxy.MinDHParamBits {
+		warnings = append(warnings, fmt.Sprintf("tune.ssl.default-dh-param of %d bits is weak, use at least %d bits", *data.TuneSslDefaultDhParam, haproxy.MinDHParamBits))
+	}
+	return warnings
+}
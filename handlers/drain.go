@@ -0,0 +1,128 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/drain"
+)
+
+// drainDefaultTimeout is the time given to the sessions of a drained host to end
+const drainDefaultTimeout = 300
+
+//GetDrainsHandlerImpl implementation of the GetDrainsHandler interface
+type GetDrainsHandlerImpl struct {
+	Drainer *haproxy.HostDrainer
+}
+
+//GetDrainHandlerImpl implementation of the GetDrainHandler interface
+type GetDrainHandlerImpl struct {
+	Drainer *haproxy.HostDrainer
+}
+
+//CreateDrainHandlerImpl implementation of the CreateDrainHandler interface
+type CreateDrainHandlerImpl struct {
+	Drainer *haproxy.HostDrainer
+}
+
+//Handle executing the request and returning a response
+func (h *GetDrainsHandlerImpl) Handle(params drain.GetDrainsParams, principal interface{}) middleware.Responder {
+	drains := h.Drainer.List()
+	data := make([]*drain.GetDrainsOKBodyItems0, 0, len(drains))
+	for _, d := range drains {
+		item := &drain.GetDrainsOKBodyItems0{}
+		if err := convertBody(hostDrain(d), item); err != nil {
+			e := misc.HandleError(err)
+			return drain.NewGetDrainsDefault(int(*e.Code)).WithPayload(e)
+		}
+		data = append(data, item)
+	}
+	return drain.NewGetDrainsOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *GetDrainHandlerImpl) Handle(params drain.GetDrainParams, principal interface{}) middleware.Responder {
+	d := h.Drainer.Get(params.ID)
+	if d.ID == "" {
+		msg := fmt.Sprintf("drain %s does not exist", params.ID)
+		c := misc.ErrHTTPNotFound
+		return drain.NewGetDrainNotFound().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	data := &drain.GetDrainOKBody{}
+	if err := convertBody(hostDrain(d), data); err != nil {
+		e := misc.HandleError(err)
+		return drain.NewGetDrainDefault(int(*e.Code)).WithPayload(e)
+	}
+	return drain.NewGetDrainOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateDrainHandlerImpl) Handle(params drain.CreateDrainParams, principal interface{}) middleware.Responder {
+	timeout := int64(drainDefaultTimeout)
+	if params.Data.Timeout != nil {
+		timeout = *params.Data.Timeout
+	}
+	d, err := h.Drainer.Start(*params.Data.Address, time.Duration(timeout)*time.Second, params.Data.Maintenance)
+	if err != nil {
+		if err == haproxy.ErrDrainNoServers {
+			err = native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("no server with address %s", *params.Data.Address))
+		}
+		e := misc.HandleError(err)
+		return drain.NewCreateDrainDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := &drain.CreateDrainAcceptedBody{}
+	if err := convertBody(hostDrain(d), data); err != nil {
+		e := misc.HandleError(err)
+		return drain.NewCreateDrainDefault(int(*e.Code)).WithPayload(e)
+	}
+	return drain.NewCreateDrainAccepted().WithPayload(data)
+}
+
+func hostDrain(d haproxy.HostDrain) *drain.CreateDrainBody {
+	timeout := int64(d.Timeout / time.Second)
+	data := &drain.CreateDrainBody{
+		ID:          d.ID,
+		Address:     misc.StringP(d.Address),
+		Timeout:     &timeout,
+		Maintenance: d.Maintenance,
+		Status:      d.Status,
+		Error:       d.Error,
+		Sessions:    d.Sessions,
+		Started:     strfmt.DateTime(d.Started),
+		Servers:     make([]*drain.CreateDrainBodyServersItems0, 0, len(d.Servers)),
+	}
+	if !d.Finished.IsZero() {
+		finished := strfmt.DateTime(d.Finished)
+		data.Finished = &finished
+	}
+	for _, s := range d.Servers {
+		data.Servers = append(data.Servers, &drain.CreateDrainBodyServersItems0{
+			Backend:  s.Backend,
+			Name:     s.Name,
+			Sessions: s.Sessions,
+		})
+	}
+	return data
+}
@@ -0,0 +1,226 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/backend"
+)
+
+//GetDynamicCookieKeyHandlerImpl implementation of the GetDynamicCookieKeyHandler interface using client-native client
+type GetDynamicCookieKeyHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceDynamicCookieKeyHandlerImpl implementation of the ReplaceDynamicCookieKeyHandler interface using client-native client
+type ReplaceDynamicCookieKeyHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//DeleteDynamicCookieKeyHandlerImpl implementation of the DeleteDynamicCookieKeyHandler interface using client-native client
+type DeleteDynamicCookieKeyHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetDynamicCookieKeyHandlerImpl) Handle(params backend.GetDynamicCookieKeyParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewGetDynamicCookieKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewGetDynamicCookieKeyDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	key := ""
+	if err = checkSectionExists(p, parser.Backends, params.Backend); err == nil {
+		key, err = getDynamicCookieKey(p, params.Backend)
+	}
+	if err == nil && key == "" {
+		err = configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("backend %s has no dynamic cookie key", params.Backend))
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewGetDynamicCookieKeyDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := &backend.GetDynamicCookieKeyOKBodyData{Backend: params.Backend, Key: misc.StringP(key)}
+	return backend.NewGetDynamicCookieKeyOK().WithPayload(&backend.GetDynamicCookieKeyOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceDynamicCookieKeyHandlerImpl) Handle(params backend.ReplaceDynamicCookieKeyParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return backend.NewReplaceDynamicCookieKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		if err := checkSectionExists(p, parser.Backends, params.Backend); err != nil {
+			return err
+		}
+		cookie, err := p.Get(parser.Backends, params.Backend, "cookie")
+		if err != nil || !cookie.(*types.Cookie).Dynamic {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("cookie of backend %s is not dynamic", params.Backend))
+		}
+		return setDynamicCookieKey(p, params.Backend, *params.Data.Key)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewReplaceDynamicCookieKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := params.Data
+	data.Backend = params.Backend
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return backend.NewReplaceDynamicCookieKeyDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := backend.ReplaceDynamicCookieKeyOKBody(data)
+			return backend.NewReplaceDynamicCookieKeyOK().WithPayload(&ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := backend.ReplaceDynamicCookieKeyAcceptedBody(data)
+		return backend.NewReplaceDynamicCookieKeyAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := backend.ReplaceDynamicCookieKeyAcceptedBody(data)
+	return backend.NewReplaceDynamicCookieKeyAccepted().WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteDynamicCookieKeyHandlerImpl) Handle(params backend.DeleteDynamicCookieKeyParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return backend.NewDeleteDynamicCookieKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		if err := checkSectionExists(p, parser.Backends, params.Backend); err != nil {
+			return err
+		}
+		key, err := getDynamicCookieKey(p, params.Backend)
+		if err != nil {
+			return err
+		}
+		if key == "" {
+			return configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("backend %s has no dynamic cookie key", params.Backend))
+		}
+		return setDynamicCookieKey(p, params.Backend, "")
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewDeleteDynamicCookieKeyDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return backend.NewDeleteDynamicCookieKeyDefault(int(*e.Code)).WithPayload(e)
+			}
+			return backend.NewDeleteDynamicCookieKeyNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return backend.NewDeleteDynamicCookieKeyAccepted().WithReloadID(rID)
+	}
+	return backend.NewDeleteDynamicCookieKeyAccepted()
+}
+
+// getDynamicCookieKey returns the dynamic-cookie-key of a backend, empty if not set.
+// The keyword is not handled by the configuration parser.
+func getDynamicCookieKey(p *parser.Parser, name string) (string, error) {
+	lines, err := getSectionUnprocessed(p, parser.Backends, name)
+	if err != nil {
+		return "", err
+	}
+	for _, l := range lines {
+		if f := strings.Fields(l.Value); len(f) == 2 && f[0] == "dynamic-cookie-key" {
+			return f[1], nil
+		}
+	}
+	return "", nil
+}
+
+// setDynamicCookieKey replaces the dynamic-cookie-key of a backend, removing it when key is empty
+func setDynamicCookieKey(p *parser.Parser, name, key string) error {
+	lines, err := getSectionUnprocessed(p, parser.Backends, name)
+	if err != nil {
+		return err
+	}
+	unprocessed := make([]types.UnProcessed, 0, len(lines)+1)
+	for _, l := range lines {
+		if f := strings.Fields(l.Value); len(f) > 0 && f[0] == "dynamic-cookie-key" {
+			continue
+		}
+		unprocessed = append(unprocessed, l)
+	}
+	if key != "" {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: "dynamic-cookie-key " + key})
+	}
+	if len(unprocessed) == 0 {
+		return p.Set(parser.Backends, name, "", nil)
+	}
+	return p.Set(parser.Backends, name, "", unprocessed)
+}
@@ -0,0 +1,236 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	api_errors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+
+	"github.com/haproxytech/dataplaneapi/fleet"
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	operations "github.com/haproxytech/dataplaneapi/operations/fleet"
+)
+
+//GetFleetHandlerImpl implementation of the GetFleetHandler interface
+type GetFleetHandlerImpl struct {
+	Fleet *fleet.Fleet
+}
+
+//PushFleetHandlerImpl implementation of the PushFleetHandler interface
+type PushFleetHandlerImpl struct {
+	Fleet *fleet.Fleet
+}
+
+//GetFleetNodeConfigurationHandlerImpl implementation of the GetFleetNodeConfigurationHandler interface
+type GetFleetNodeConfigurationHandlerImpl struct {
+	Fleet *fleet.Fleet
+}
+
+//PrepareFleetConfigurationHandlerImpl implementation of the PrepareFleetConfigurationHandler interface
+type PrepareFleetConfigurationHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	Participant *fleet.Participant
+}
+
+//CommitFleetConfigurationHandlerImpl implementation of the CommitFleetConfigurationHandler interface
+type CommitFleetConfigurationHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	Participant *fleet.Participant
+}
+
+//AbortFleetConfigurationHandlerImpl implementation of the AbortFleetConfigurationHandler interface
+type AbortFleetConfigurationHandlerImpl struct {
+	Participant *fleet.Participant
+}
+
+//Handle executing the request and returning a response
+func (h *GetFleetHandlerImpl) Handle(params operations.GetFleetParams, principal interface{}) middleware.Responder {
+	if h.Fleet == nil {
+		e := misc.HandleError(errFleetDisabled())
+		return operations.NewGetFleetBadRequest().WithPayload(e)
+	}
+	body := &operations.GetFleetOKBody{
+		AutoPush: h.Fleet.AutoPush,
+		Atomic:   h.Fleet.Atomic,
+		TwoPhase: h.Fleet.TwoPhase,
+		Nodes:    make([]*operations.GetFleetOKBodyNodesItems0, 0, len(h.Fleet.Nodes)),
+	}
+	for _, n := range h.Fleet.Nodes {
+		item := &operations.GetFleetOKBodyNodesItems0{
+			Name:      n.Name,
+			URL:       n.URL,
+			Variables: n.Variables,
+			Overrides: make([]*operations.GetFleetOKBodyNodesItems0OverridesItems0, 0, len(n.Overrides)),
+		}
+		for _, o := range n.Overrides {
+			item.Overrides = append(item.Overrides, &operations.GetFleetOKBodyNodesItems0OverridesItems0{Section: o.Section, Match: o.Match, Replace: o.Replace})
+		}
+		body.Nodes = append(body.Nodes, item)
+	}
+	if r := h.Fleet.LastPush(); r != nil {
+		body.LastPush = &operations.GetFleetOKBodyLastPush{
+			Version:  r.Version,
+			Started:  r.Started.Unix(),
+			Finished: r.Finished.Unix(),
+			Atomic:   r.Atomic,
+			TwoPhase: r.TwoPhase,
+			Nodes:    make([]*operations.GetFleetOKBodyLastPushNodesItems0, 0, len(r.Nodes)),
+		}
+		for _, n := range r.Nodes {
+			item := operations.GetFleetOKBodyLastPushNodesItems0(fleetNodeResult(n))
+			body.LastPush.Nodes = append(body.LastPush.Nodes, &item)
+		}
+	}
+	return operations.NewGetFleetOK().WithPayload(body)
+}
+
+//Handle executing the request and returning a response
+func (h *PushFleetHandlerImpl) Handle(params operations.PushFleetParams, principal interface{}) middleware.Responder {
+	if h.Fleet == nil {
+		e := misc.HandleError(errFleetDisabled())
+		return operations.NewPushFleetBadRequest().WithPayload(e)
+	}
+	atomic := h.Fleet.Atomic
+	if params.Atomic != nil {
+		atomic = *params.Atomic
+	}
+	twoPhase := h.Fleet.TwoPhase
+	if params.TwoPhase != nil {
+		twoPhase = *params.TwoPhase
+	}
+	r, err := h.Fleet.Push(atomic, twoPhase)
+	if err != nil {
+		e := misc.HandleError(err)
+		return operations.NewPushFleetDefault(int(*e.Code)).WithPayload(e)
+	}
+	body := &operations.PushFleetOKBody{
+		Version:  r.Version,
+		Started:  r.Started.Unix(),
+		Finished: r.Finished.Unix(),
+		Atomic:   r.Atomic,
+		TwoPhase: r.TwoPhase,
+		Nodes:    make([]*operations.PushFleetOKBodyNodesItems0, 0, len(r.Nodes)),
+	}
+	for _, n := range r.Nodes {
+		item := fleetNodeResult(n)
+		body.Nodes = append(body.Nodes, &item)
+	}
+	return operations.NewPushFleetOK().WithPayload(body)
+}
+
+//Handle executing the request and returning a response
+func (h *GetFleetNodeConfigurationHandlerImpl) Handle(params operations.GetFleetNodeConfigurationParams, principal interface{}) middleware.Responder {
+	if h.Fleet == nil {
+		e := misc.HandleError(errFleetDisabled())
+		return operations.NewGetFleetNodeConfigurationBadRequest().WithPayload(e)
+	}
+	n := h.Fleet.Node(params.Name)
+	if n == nil {
+		e := misc.HandleError(native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("fleet node %s does not exist", params.Name)))
+		return operations.NewGetFleetNodeConfigurationNotFound().WithPayload(e)
+	}
+	v, data, err := h.Fleet.Config()
+	if err != nil {
+		e := misc.HandleError(err)
+		return operations.NewGetFleetNodeConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	rendered, err := n.Render(data)
+	if err != nil {
+		e := misc.HandleError(api_errors.New(http.StatusBadRequest, "%s", err.Error()))
+		return operations.NewGetFleetNodeConfigurationBadRequest().WithPayload(e)
+	}
+	return operations.NewGetFleetNodeConfigurationOK().WithPayload(&operations.GetFleetNodeConfigurationOKBody{Version: v, Data: &rendered})
+}
+
+//Handle executing the request and returning a response
+func (h *PrepareFleetConfigurationHandlerImpl) Handle(params operations.PrepareFleetConfigurationParams, principal interface{}) middleware.Responder {
+	v, err := h.Client.Configuration.GetVersion("")
+	if err != nil {
+		e := misc.HandleError(err)
+		return operations.NewPrepareFleetConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	if v != params.Version {
+		e := misc.HandleError(native_configuration.NewConfError(native_configuration.ErrVersionMismatch, fmt.Sprintf("version mismatch, expected %d, got %d", v, params.Version)))
+		return operations.NewPrepareFleetConfigurationConflict().WithPayload(e)
+	}
+	p, err := h.Participant.Prepare(params.Version, params.Data)
+	if err != nil {
+		e := misc.HandleError(api_errors.New(http.StatusBadRequest, "%s", err.Error()))
+		return operations.NewPrepareFleetConfigurationBadRequest().WithPayload(e)
+	}
+	return operations.NewPrepareFleetConfigurationCreated().WithPayload(&operations.PrepareFleetConfigurationCreatedBody{
+		ID:      p.ID,
+		Version: p.Version,
+		Expires: p.Expires.Unix(),
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *CommitFleetConfigurationHandlerImpl) Handle(params operations.CommitFleetConfigurationParams, principal interface{}) middleware.Responder {
+	p, data, err := h.Participant.Take(params.ID)
+	if err != nil {
+		e := misc.HandleError(err)
+		return operations.NewCommitFleetConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	if p == nil {
+		e := misc.HandleError(errPreparedNotFound(params.ID))
+		return operations.NewCommitFleetConfigurationNotFound().WithPayload(e)
+	}
+	if err := h.Client.Configuration.PostRawConfiguration(&data, p.Version, false); err != nil {
+		e := misc.HandleError(err)
+		return operations.NewCommitFleetConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	if err := h.ReloadAgent.ForceReload(); err != nil {
+		e := misc.HandleError(err)
+		return operations.NewCommitFleetConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	return operations.NewCommitFleetConfigurationOK()
+}
+
+//Handle executing the request and returning a response
+func (h *AbortFleetConfigurationHandlerImpl) Handle(params operations.AbortFleetConfigurationParams, principal interface{}) middleware.Responder {
+	if !h.Participant.Abort(params.ID) {
+		e := misc.HandleError(errPreparedNotFound(params.ID))
+		return operations.NewAbortFleetConfigurationNotFound().WithPayload(e)
+	}
+	return operations.NewAbortFleetConfigurationNoContent()
+}
+
+func fleetNodeResult(n fleet.NodeResult) operations.PushFleetOKBodyNodesItems0 {
+	return operations.PushFleetOKBodyNodesItems0{
+		Name:            n.Name,
+		URL:             n.URL,
+		Status:          n.Status,
+		Message:         n.Message,
+		PreviousVersion: n.PreviousVersion,
+		Version:         n.Version,
+	}
+}
+
+func errPreparedNotFound(id string) error {
+	return native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("prepared configuration %s does not exist", id))
+}
+
+func errFleetDisabled() error {
+	return api_errors.New(http.StatusBadRequest, "fleet mode is not enabled")
+}
@@ -28,6 +28,7 @@ import (
 type CreateFrontendHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
 }
 
 //DeleteFrontendHandlerImpl implementation of the DeleteFrontendHandler interface using client-native client
@@ -41,6 +42,11 @@ type GetFrontendHandlerImpl struct {
 	Client *client_native.HAProxyClient
 }
 
+//GetFrontendFullHandlerImpl implementation of the GetFrontendFullHandler interface
+type GetFrontendFullHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
 //GetFrontendsHandlerImpl implementation of the GetFrontendsHandler interface using client-native client
 type GetFrontendsHandlerImpl struct {
 	Client *client_native.HAProxyClient
@@ -50,6 +56,7 @@ type GetFrontendsHandlerImpl struct {
 type ReplaceFrontendHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
 }
 
 //Handle executing the request and returning a response
@@ -73,7 +80,13 @@ func (h *CreateFrontendHandlerImpl) Handle(params frontend.CreateFrontendParams,
 		return frontend.NewCreateFrontendDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.CreateFrontend(params.Data, t, v)
+	err := validateLogFormat(h.Validator, params.Data.LogFormat, "", "frontend "+params.Data.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewCreateFrontendDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err = h.Client.Configuration.CreateFrontend(params.Data, t, v)
 	if err != nil {
 		e := misc.HandleError(err)
 		return frontend.NewCreateFrontendDefault(int(*e.Code)).WithPayload(e)
@@ -185,6 +198,12 @@ func (h *ReplaceFrontendHandlerImpl) Handle(params frontend.ReplaceFrontendParam
 		return frontend.NewReplaceFrontendDefault(int(*e.Code)).WithPayload(e)
 	}
 
+	err := validateLogFormat(h.Validator, params.Data.LogFormat, "", "frontend "+params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewReplaceFrontendDefault(int(*e.Code)).WithPayload(e)
+	}
+
 	_, ondisk, err := h.Client.Configuration.GetFrontend(params.Name, t)
 	if err != nil {
 		e := misc.HandleError(err)
@@ -214,3 +233,55 @@ func (h *ReplaceFrontendHandlerImpl) Handle(params frontend.ReplaceFrontendParam
 	}
 	return frontend.NewReplaceFrontendAccepted().WithPayload(params.Data)
 }
+
+//Handle executing the request and returning a response
+func (h *GetFrontendFullHandlerImpl) Handle(params frontend.GetFrontendFullParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, f, err := h.Client.Configuration.GetFrontend(params.Name, t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewGetFrontendFullDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data, err := getFrontendNested(h.Client, params.Name, t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewGetFrontendFullDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data.Frontend = f
+	return frontend.NewGetFrontendFullOK().WithPayload(&frontend.GetFrontendFullOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+// getFrontendNested returns the resources nested in a frontend
+func getFrontendNested(client *client_native.HAProxyClient, name string, t string) (*frontend.GetFrontendFullOKBodyData, error) {
+	var err error
+	data := &frontend.GetFrontendFullOKBodyData{}
+	if _, data.Binds, err = client.Configuration.GetBinds(name, t); err != nil {
+		return nil, err
+	}
+	if _, data.Acls, err = client.Configuration.GetACLs("frontend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.HTTPRequestRules, err = client.Configuration.GetHTTPRequestRules("frontend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.HTTPResponseRules, err = client.Configuration.GetHTTPResponseRules("frontend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.TCPRequestRules, err = client.Configuration.GetTCPRequestRules("frontend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.BackendSwitchingRules, err = client.Configuration.GetBackendSwitchingRules(name, t); err != nil {
+		return nil, err
+	}
+	if _, data.Filters, err = client.Configuration.GetFilters("frontend", name, t); err != nil {
+		return nil, err
+	}
+	if _, data.LogTargets, err = client.Configuration.GetLogTargets("frontend", name, t); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/frontend"
+)
+
+// breakerDefaultDuration is the default time in seconds after which the limits
+// of a frontend are restored
+const breakerDefaultDuration = 300
+
+//GetFrontendCircuitBreakerHandlerImpl implementation of the GetFrontendCircuitBreakerHandler interface
+type GetFrontendCircuitBreakerHandlerImpl struct {
+	Breakers *haproxy.CircuitBreakers
+}
+
+//ReplaceFrontendCircuitBreakerHandlerImpl implementation of the ReplaceFrontendCircuitBreakerHandler interface
+type ReplaceFrontendCircuitBreakerHandlerImpl struct {
+	Breakers *haproxy.CircuitBreakers
+}
+
+//DeleteFrontendCircuitBreakerHandlerImpl implementation of the DeleteFrontendCircuitBreakerHandler interface
+type DeleteFrontendCircuitBreakerHandlerImpl struct {
+	Breakers *haproxy.CircuitBreakers
+}
+
+//Handle executing the request and returning a response
+func (h *GetFrontendCircuitBreakerHandlerImpl) Handle(params frontend.GetFrontendCircuitBreakerParams, principal interface{}) middleware.Responder {
+	b := h.Breakers.Get(params.Name)
+	if b.Status == "" {
+		return frontend.NewGetFrontendCircuitBreakerNotFound().WithPayload(breakerNotFound(params.Name))
+	}
+	data := frontend.GetFrontendCircuitBreakerOKBody(frontendBreaker(b))
+	return frontend.NewGetFrontendCircuitBreakerOK().WithPayload(&data)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceFrontendCircuitBreakerHandlerImpl) Handle(params frontend.ReplaceFrontendCircuitBreakerParams, principal interface{}) middleware.Responder {
+	if params.Data.Maxconn == nil && params.Data.SessionRateLimit == nil && !params.Data.RejectConnections {
+		msg := "no limit set, expected maxconn, session_rate_limit or reject_connections"
+		c := misc.ErrHTTPBadRequest
+		return frontend.NewReplaceFrontendCircuitBreakerBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	duration := int64(breakerDefaultDuration)
+	if params.Data.Duration != nil {
+		duration = *params.Data.Duration
+	}
+
+	b, err := h.Breakers.Trip(haproxy.FrontendBreaker{
+		Frontend:          params.Name,
+		MaxConn:           params.Data.Maxconn,
+		SessionRateLimit:  params.Data.SessionRateLimit,
+		RejectConnections: params.Data.RejectConnections,
+		Duration:          time.Duration(duration) * time.Second,
+	})
+	if err == haproxy.ErrBreakerNoFrontend {
+		code := int64(404)
+		msg := fmt.Sprintf("Runtime frontend %s not found", params.Name)
+		return frontend.NewReplaceFrontendCircuitBreakerNotFound().WithPayload(&models.Error{Code: &code, Message: &msg})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewReplaceFrontendCircuitBreakerDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := frontend.ReplaceFrontendCircuitBreakerOKBody(frontendBreaker(b))
+	return frontend.NewReplaceFrontendCircuitBreakerOK().WithPayload(&data)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteFrontendCircuitBreakerHandlerImpl) Handle(params frontend.DeleteFrontendCircuitBreakerParams, principal interface{}) middleware.Responder {
+	active, err := h.Breakers.Reset(params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewDeleteFrontendCircuitBreakerDefault(int(*e.Code)).WithPayload(e)
+	}
+	if !active {
+		return frontend.NewDeleteFrontendCircuitBreakerNotFound().WithPayload(breakerNotFound(params.Name))
+	}
+	return frontend.NewDeleteFrontendCircuitBreakerNoContent()
+}
+
+func breakerNotFound(name string) *models.Error {
+	code := int64(404)
+	msg := fmt.Sprintf("No circuit breaker of frontend %s", name)
+	return &models.Error{Code: &code, Message: &msg}
+}
+
+func frontendBreaker(b haproxy.FrontendBreaker) frontend.ReplaceFrontendCircuitBreakerBody {
+	duration := int64(b.Duration / time.Second)
+	data := frontend.ReplaceFrontendCircuitBreakerBody{
+		Frontend:                 b.Frontend,
+		Maxconn:                  b.MaxConn,
+		SessionRateLimit:         b.SessionRateLimit,
+		RejectConnections:        b.RejectConnections,
+		Duration:                 &duration,
+		Status:                   b.Status,
+		Error:                    b.Error,
+		PreviousMaxconn:          b.PreviousMaxConn,
+		PreviousSessionRateLimit: b.PreviousSessionRateLimit,
+		Started:                  strfmt.DateTime(b.Started),
+		Expires:                  strfmt.DateTime(b.Expires()),
+	}
+	if !b.Finished.IsZero() {
+		finished := strfmt.DateTime(b.Finished)
+		data.Finished = &finished
+	}
+	return data
+}
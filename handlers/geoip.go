@@ -0,0 +1,342 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/geo_ip"
+)
+
+const geoIPDefaultDenyStatus = 403
+
+//GetGeoIPHandlerImpl implementation of the GetGeoIPHandler interface
+type GetGeoIPHandlerImpl struct {
+	Updater *haproxy.GeoIPUpdater
+}
+
+//RefreshGeoIPHandlerImpl implementation of the RefreshGeoIPHandler interface
+type RefreshGeoIPHandlerImpl struct {
+	Updater *haproxy.GeoIPUpdater
+}
+
+//GetGeoIPPoliciesHandlerImpl implementation of the GetGeoIPPoliciesHandler interface using client-native client
+type GetGeoIPPoliciesHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	MapFile string
+}
+
+//GetGeoIPPolicyHandlerImpl implementation of the GetGeoIPPolicyHandler interface using client-native client
+type GetGeoIPPolicyHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	MapFile string
+}
+
+//ReplaceGeoIPPolicyHandlerImpl implementation of the ReplaceGeoIPPolicyHandler interface using client-native client
+type ReplaceGeoIPPolicyHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	MapFile     string
+}
+
+//DeleteGeoIPPolicyHandlerImpl implementation of the DeleteGeoIPPolicyHandler interface using client-native client
+type DeleteGeoIPPolicyHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	MapFile     string
+}
+
+//Handle executing the request and returning a response
+func (h *GetGeoIPHandlerImpl) Handle(params geo_ip.GetGeoIPParams, principal interface{}) middleware.Responder {
+	status := geo_ip.GetGeoIPOKBody(geoIPStatus(h.Updater.Status()))
+	return geo_ip.NewGetGeoIPOK().WithPayload(&status)
+}
+
+//Handle executing the request and returning a response
+func (h *RefreshGeoIPHandlerImpl) Handle(params geo_ip.RefreshGeoIPParams, principal interface{}) middleware.Responder {
+	if h.Updater.URL == "" {
+		msg := "GeoIP map URL not configured, set it with the geoip-map-url option"
+		c := misc.ErrHTTPBadRequest
+		return geo_ip.NewRefreshGeoIPBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	if err := h.Updater.Update(); err != nil {
+		msg := err.Error()
+		c := misc.ErrHTTPBadRequest
+		return geo_ip.NewRefreshGeoIPBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	status := geo_ip.RefreshGeoIPOKBody(geoIPStatus(h.Updater.Status()))
+	return geo_ip.NewRefreshGeoIPOK().WithPayload(&status)
+}
+
+//Handle executing the request and returning a response
+func (h *GetGeoIPPoliciesHandlerImpl) Handle(params geo_ip.GetGeoIPPoliciesParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return geo_ip.NewGetGeoIPPoliciesDefault(int(*e.Code)).WithPayload(e)
+	}
+	_, frontends, err := h.Client.Configuration.GetFrontends(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return geo_ip.NewGetGeoIPPoliciesDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := make([]*geo_ip.GetGeoIPPoliciesOKBodyDataItems0, 0)
+	for _, f := range frontends {
+		p, _, err := getGeoIPPolicy(h.Client, h.MapFile, f.Name, t)
+		if err != nil {
+			e := misc.HandleError(err)
+			return geo_ip.NewGetGeoIPPoliciesDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+		}
+		if p == nil {
+			continue
+		}
+		item := geo_ip.GetGeoIPPoliciesOKBodyDataItems0(*p)
+		data = append(data, &item)
+	}
+	return geo_ip.NewGetGeoIPPoliciesOK().WithPayload(&geo_ip.GetGeoIPPoliciesOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *GetGeoIPPolicyHandlerImpl) Handle(params geo_ip.GetGeoIPPolicyParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return geo_ip.NewGetGeoIPPolicyDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, _, err := getGeoIPPolicy(h.Client, h.MapFile, params.Frontend, t)
+	if err == nil && p == nil {
+		err = geoIPPolicyNotFound(params.Frontend)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return geo_ip.NewGetGeoIPPolicyDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := geo_ip.GetGeoIPPolicyOKBodyData(*p)
+	return geo_ip.NewGetGeoIPPolicyOK().WithPayload(&geo_ip.GetGeoIPPolicyOKBody{Version: v, Data: &data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceGeoIPPolicyHandlerImpl) Handle(params geo_ip.ReplaceGeoIPPolicyParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return geo_ip.NewReplaceGeoIPPolicyDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	p := params.Data
+	p.Frontend = params.Frontend
+	if p.DenyStatus == 0 {
+		p.DenyStatus = geoIPDefaultDenyStatus
+	}
+	for i, c := range p.Countries {
+		p.Countries[i] = strings.ToUpper(c)
+	}
+	if len(p.Countries) == 0 {
+		msg := "at least one country must be set"
+		c := misc.ErrHTTPBadRequest
+		return geo_ip.NewReplaceGeoIPPolicyBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		_, index, err := getGeoIPPolicy(h.Client, h.MapFile, params.Frontend, t)
+		if err != nil {
+			return err
+		}
+		if index >= 0 {
+			if err := h.Client.Configuration.DeleteHTTPRequestRule(index, "frontend", params.Frontend, t, 0); err != nil {
+				return err
+			}
+		}
+		return h.Client.Configuration.CreateHTTPRequestRule("frontend", params.Frontend, geoIPRule(h.MapFile, *p.Mode, p.Countries, p.DenyStatus), t, 0)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return geo_ip.NewReplaceGeoIPPolicyDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return geo_ip.NewReplaceGeoIPPolicyDefault(int(*e.Code)).WithPayload(e)
+			}
+			replaced := geo_ip.ReplaceGeoIPPolicyOKBody(p)
+			return geo_ip.NewReplaceGeoIPPolicyOK().WithPayload(&replaced)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := geo_ip.ReplaceGeoIPPolicyAcceptedBody(p)
+		return geo_ip.NewReplaceGeoIPPolicyAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := geo_ip.ReplaceGeoIPPolicyAcceptedBody(p)
+	return geo_ip.NewReplaceGeoIPPolicyAccepted().WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteGeoIPPolicyHandlerImpl) Handle(params geo_ip.DeleteGeoIPPolicyParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return geo_ip.NewDeleteGeoIPPolicyDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		_, index, err := getGeoIPPolicy(h.Client, h.MapFile, params.Frontend, t)
+		if err != nil {
+			return err
+		}
+		if index < 0 {
+			return geoIPPolicyNotFound(params.Frontend)
+		}
+		return h.Client.Configuration.DeleteHTTPRequestRule(index, "frontend", params.Frontend, t, 0)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return geo_ip.NewDeleteGeoIPPolicyDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return geo_ip.NewDeleteGeoIPPolicyDefault(int(*e.Code)).WithPayload(e)
+			}
+			return geo_ip.NewDeleteGeoIPPolicyNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return geo_ip.NewDeleteGeoIPPolicyAccepted().WithReloadID(rID)
+	}
+	return geo_ip.NewDeleteGeoIPPolicyAccepted()
+}
+
+func geoIPStatus(s haproxy.GeoIPStatus) geo_ip.GetGeoIPOKBody {
+	status := geo_ip.GetGeoIPOKBody{
+		URL:            s.URL,
+		MapFile:        s.MapFile,
+		Entries:        s.Entries,
+		LastError:      s.LastError,
+		RuntimeUpdated: s.RuntimeUpdated,
+	}
+	if !s.LastUpdate.IsZero() {
+		lastUpdate := strfmt.DateTime(s.LastUpdate)
+		status.LastUpdate = &lastUpdate
+	}
+	if !s.NextUpdate.IsZero() {
+		nextUpdate := strfmt.DateTime(s.NextUpdate)
+		status.NextUpdate = &nextUpdate
+	}
+	return status
+}
+
+func geoIPPolicyNotFound(frontend string) error {
+	return native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("GeoIP policy of frontend %s does not exist", frontend))
+}
+
+// geoIPCondPrefix returns the beginning of the condition matching the country
+// of the client address looked up in the map file
+func geoIPCondPrefix(mapFile string) string {
+	return fmt.Sprintf("{ src,map_ip(%s) -m str ", mapFile)
+}
+
+// geoIPRule returns the deny rule of a policy, denying the listed countries in
+// deny mode and all other countries in allow mode
+func geoIPRule(mapFile, mode string, countries []string, denyStatus int64) *models.HTTPRequestRule {
+	cond := "if"
+	if mode == "allow" {
+		cond = "unless"
+	}
+	return &models.HTTPRequestRule{
+		Index:      misc.Int64P(0),
+		Type:       "deny",
+		DenyStatus: &denyStatus,
+		Cond:       cond,
+		CondTest:   geoIPCondPrefix(mapFile) + strings.Join(countries, " ") + " }",
+	}
+}
+
+// getGeoIPPolicy returns the GeoIP policy of a frontend and the index of its rule,
+// or nil and -1 when the frontend has no policy
+func getGeoIPPolicy(client *client_native.HAProxyClient, mapFile, frontend, t string) (*geo_ip.ReplaceGeoIPPolicyBody, int64, error) {
+	_, rules, err := client.Configuration.GetHTTPRequestRules("frontend", frontend, t)
+	if err != nil {
+		return nil, -1, err
+	}
+	prefix := geoIPCondPrefix(mapFile)
+	for _, r := range rules {
+		if r.Type != "deny" || !strings.HasPrefix(r.CondTest, prefix) || !strings.HasSuffix(r.CondTest, " }") {
+			continue
+		}
+		mode := "deny"
+		if r.Cond == "unless" {
+			mode = "allow"
+		}
+		countries := strings.Fields(strings.TrimSuffix(strings.TrimPrefix(r.CondTest, prefix), " }"))
+		p := &geo_ip.ReplaceGeoIPPolicyBody{
+			Frontend:  frontend,
+			Mode:      misc.StringP(mode),
+			Countries: countries,
+		}
+		if r.DenyStatus != nil {
+			p.DenyStatus = *r.DenyStatus
+		}
+		return p, *r.Index, nil
+	}
+	return nil, -1, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	api_errors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/haproxytech/dataplaneapi/gitmode"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/git"
+)
+
+// maxWebhookSize is the size of the largest webhook payload GitHub sends
+const maxWebhookSize = 25 << 20
+
+//GetGitKnownHostsHandlerImpl implementation of the GetGitKnownHostsHandler interface
+type GetGitKnownHostsHandlerImpl struct {
+	Repository *gitmode.Repository
+}
+
+//ReplaceGitKnownHostsHandlerImpl implementation of the ReplaceGitKnownHostsHandler interface
+type ReplaceGitKnownHostsHandlerImpl struct {
+	Repository *gitmode.Repository
+}
+
+//GetGitConflictsHandlerImpl implementation of the GetGitConflictsHandler interface
+type GetGitConflictsHandlerImpl struct {
+	Repository *gitmode.Repository
+}
+
+//ResolveGitConflictsHandlerImpl implementation of the ResolveGitConflictsHandler interface
+type ResolveGitConflictsHandlerImpl struct {
+	Repository *gitmode.Repository
+}
+
+//GitWebhookHandlerImpl implementation of the GitWebhookHandler interface
+type GitWebhookHandlerImpl struct {
+	Repository *gitmode.Repository
+	Secret     string
+}
+
+//TestGitRemoteHandlerImpl implementation of the TestGitRemoteHandler interface
+type TestGitRemoteHandlerImpl struct {
+	Repository *gitmode.Repository
+}
+
+//Handle executing the request and returning a response
+func (h *GetGitKnownHostsHandlerImpl) Handle(params git.GetGitKnownHostsParams, principal interface{}) middleware.Responder {
+	if h.Repository == nil {
+		e := misc.HandleError(errGitModeDisabled())
+		return git.NewGetGitKnownHostsDefault(int(*e.Code)).WithPayload(e)
+	}
+	lines, err := h.Repository.KnownHosts()
+	if err != nil {
+		e := misc.HandleError(err)
+		return git.NewGetGitKnownHostsDefault(int(*e.Code)).WithPayload(e)
+	}
+	return git.NewGetGitKnownHostsOK().WithPayload(lines)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceGitKnownHostsHandlerImpl) Handle(params git.ReplaceGitKnownHostsParams, principal interface{}) middleware.Responder {
+	if h.Repository == nil {
+		e := misc.HandleError(errGitModeDisabled())
+		return git.NewReplaceGitKnownHostsBadRequest().WithPayload(e)
+	}
+	if err := h.Repository.ReplaceKnownHosts(params.Data); err != nil {
+		e := misc.HandleError(api_errors.New(http.StatusBadRequest, "%s", err.Error()))
+		return git.NewReplaceGitKnownHostsBadRequest().WithPayload(e)
+	}
+	lines, err := h.Repository.KnownHosts()
+	if err != nil {
+		e := misc.HandleError(err)
+		return git.NewReplaceGitKnownHostsDefault(int(*e.Code)).WithPayload(e)
+	}
+	return git.NewReplaceGitKnownHostsOK().WithPayload(lines)
+}
+
+//Handle executing the request and returning a response
+func (h *TestGitRemoteHandlerImpl) Handle(params git.TestGitRemoteParams, principal interface{}) middleware.Responder {
+	if h.Repository == nil {
+		e := misc.HandleError(errGitModeDisabled())
+		return git.NewTestGitRemoteBadRequest().WithPayload(e)
+	}
+	result := &git.TestGitRemoteOKBody{Reachable: true}
+	if err := h.Repository.TestRemote(); err != nil {
+		result.Reachable = false
+		result.Message = err.Error()
+	}
+	return git.NewTestGitRemoteOK().WithPayload(result)
+}
+
+//Handle executing the request and returning a response
+func (h *GetGitConflictsHandlerImpl) Handle(params git.GetGitConflictsParams, principal interface{}) middleware.Responder {
+	if h.Repository == nil {
+		e := misc.HandleError(errGitModeDisabled())
+		return git.NewGetGitConflictsDefault(int(*e.Code)).WithPayload(e)
+	}
+	return git.NewGetGitConflictsOK().WithPayload(gitConflict(h.Repository.Conflict()))
+}
+
+//Handle executing the request and returning a response
+func (h *ResolveGitConflictsHandlerImpl) Handle(params git.ResolveGitConflictsParams, principal interface{}) middleware.Responder {
+	if h.Repository == nil {
+		e := misc.HandleError(errGitModeDisabled())
+		return git.NewResolveGitConflictsBadRequest().WithPayload(e)
+	}
+	contents := make(map[string]string)
+	for _, f := range params.Data.Files {
+		contents[*f.Path] = *f.Content
+	}
+	if err := h.Repository.Resolve(*params.Data.Strategy, contents); err != nil {
+		var resolutionErr *gitmode.ResolutionError
+		if errors.As(err, &resolutionErr) {
+			e := misc.HandleError(api_errors.New(http.StatusBadRequest, "%s", err.Error()))
+			return git.NewResolveGitConflictsBadRequest().WithPayload(e)
+		}
+		e := misc.HandleError(err)
+		return git.NewResolveGitConflictsDefault(int(*e.Code)).WithPayload(e)
+	}
+	// the push of the merge finds a new conflict when the remote branch
+	// diverged again
+	c := gitConflict(h.Repository.Conflict())
+	files := make([]*git.ResolveGitConflictsOKBodyFilesItems0, 0, len(c.Files))
+	for _, f := range c.Files {
+		files = append(files, &git.ResolveGitConflictsOKBodyFilesItems0{Path: f.Path, Local: f.Local, Remote: f.Remote})
+	}
+	return git.NewResolveGitConflictsOK().WithPayload(&git.ResolveGitConflictsOKBody{
+		Conflicted:   c.Conflicted,
+		LocalCommit:  c.LocalCommit,
+		RemoteCommit: c.RemoteCommit,
+		BaseCommit:   c.BaseCommit,
+		Detected:     c.Detected,
+		Files:        files,
+		RemoteOnly:   c.RemoteOnly,
+	})
+}
+
+func gitConflict(c *gitmode.Conflict) *git.GetGitConflictsOKBody {
+	body := &git.GetGitConflictsOKBody{
+		Files:      make([]*git.GetGitConflictsOKBodyFilesItems0, 0),
+		RemoteOnly: make([]string, 0),
+	}
+	if c == nil {
+		return body
+	}
+	body.Conflicted = true
+	body.LocalCommit = c.LocalCommit
+	body.RemoteCommit = c.RemoteCommit
+	body.BaseCommit = c.BaseCommit
+	body.Detected = strfmt.DateTime(c.Detected)
+	for _, f := range c.Files {
+		body.Files = append(body.Files, &git.GetGitConflictsOKBodyFilesItems0{Path: f.Path, Local: f.Local, Remote: f.Remote})
+	}
+	body.RemoteOnly = append(body.RemoteOnly, c.RemoteOnly...)
+	return body
+}
+
+//Handle executing the request and returning a response
+func (h *GitWebhookHandlerImpl) Handle(params git.GitWebhookParams, principal interface{}) middleware.Responder {
+	if h.Repository == nil {
+		e := misc.HandleError(errGitModeDisabled())
+		return git.NewGitWebhookBadRequest().WithPayload(e)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(params.HTTPRequest.Body, maxWebhookSize))
+	if err != nil {
+		e := misc.HandleError(api_errors.New(http.StatusBadRequest, "%s", err.Error()))
+		return git.NewGitWebhookBadRequest().WithPayload(e)
+	}
+	event, err := gitmode.ParseWebhook(h.Secret, params.HTTPRequest.Header, body)
+	if err != nil {
+		if errors.Is(err, gitmode.ErrInvalidSignature) {
+			e := misc.HandleError(api_errors.New(http.StatusUnauthorized, "%s", err.Error()))
+			return git.NewGitWebhookUnauthorized().WithPayload(e)
+		}
+		e := misc.HandleError(api_errors.New(http.StatusBadRequest, "%s", err.Error()))
+		return git.NewGitWebhookBadRequest().WithPayload(e)
+	}
+	if !h.Repository.Pushes(event) {
+		return git.NewGitWebhookOK().WithPayload(&git.GitWebhookOKBody{Message: "not a push to the remote branch, ignored"})
+	}
+	pulled, err := h.Repository.Pull()
+	if err != nil {
+		if errors.Is(err, gitmode.ErrDiverged) {
+			e := misc.HandleError(api_errors.New(http.StatusConflict, "%s", err.Error()))
+			return git.NewGitWebhookConflict().WithPayload(e)
+		}
+		e := misc.HandleError(err)
+		return git.NewGitWebhookDefault(int(*e.Code)).WithPayload(e)
+	}
+	if !pulled {
+		return git.NewGitWebhookOK().WithPayload(&git.GitWebhookOKBody{Message: "already up to date"})
+	}
+	return git.NewGitWebhookOK().WithPayload(&git.GitWebhookOKBody{Pulled: true, Message: "configuration pulled and applied"})
+}
+
+func errGitModeDisabled() error {
+	return api_errors.New(http.StatusBadRequest, "git mode is not enabled")
+}
@@ -0,0 +1,161 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/operations/health"
+)
+
+//GetHAProxyHealthHandlerImpl implementation of the GetHAProxyHealthHandler interface
+type GetHAProxyHealthHandlerImpl struct {
+	Client       *client_native.HAProxyClient
+	ReloadAgent  haproxy.IReloadAgent
+	MasterSocket string
+	Check        *haproxy.ConfigurationCheck
+}
+
+//Handle executing the request and returning a response
+func (h *GetHAProxyHealthHandlerImpl) Handle(params health.GetHAProxyHealthParams, principal interface{}) middleware.Responder {
+	body := &health.GetHAProxyHealthOKBody{
+		Process:       &health.GetHAProxyHealthOKBodyProcess{Status: health.GetHAProxyHealthOKBodyProcessStatusUnknown},
+		Configuration: &health.GetHAProxyHealthOKBodyConfiguration{Valid: true},
+		Reload:        h.reloadHealth(),
+	}
+
+	var info *models.ProcessInfoItem
+	body.Runtime, info = h.runtimeHealth()
+	if info != nil {
+		body.Process.Status = health.GetHAProxyHealthOKBodyProcessStatusRunning
+		if info.Pid != nil {
+			body.Process.Pid = *info.Pid
+		}
+		if info.Uptime != nil {
+			body.Process.Uptime = *info.Uptime
+		}
+		body.Process.Version = info.Version
+	}
+	if h.MasterSocket != "" {
+		h.processHealth(body.Process)
+	}
+
+	checked, err := h.Check.Check()
+	body.Configuration.Checked = checked.Unix()
+	if err != nil {
+		body.Configuration.Valid = false
+		body.Configuration.Message = err.Error()
+	}
+
+	switch {
+	case body.Process.Status == health.GetHAProxyHealthOKBodyProcessStatusStopped ||
+		body.Runtime.Status == health.GetHAProxyHealthOKBodyRuntimeStatusUnresponsive:
+		body.Status = health.GetHAProxyHealthOKBodyStatusDown
+		return health.NewGetHAProxyHealthServiceUnavailable().WithPayload(body)
+	case body.Process.Status == health.GetHAProxyHealthOKBodyProcessStatusUnknown ||
+		!body.Configuration.Valid || body.Reload.Status == models.ReloadStatusFailed || body.Reload.Frozen:
+		body.Status = health.GetHAProxyHealthOKBodyStatusDegraded
+	default:
+		body.Status = health.GetHAProxyHealthOKBodyStatusUp
+	}
+	return health.NewGetHAProxyHealthOK().WithPayload(body)
+}
+
+// runtimeHealth sends show info on the runtime sockets and measures the response
+// time, it returns the information of the first process answering
+func (h *GetHAProxyHealthHandlerImpl) runtimeHealth() (*health.GetHAProxyHealthOKBodyRuntime, *models.ProcessInfoItem) {
+	if h.Client.Runtime == nil {
+		return &health.GetHAProxyHealthOKBodyRuntime{
+			Status:  health.GetHAProxyHealthOKBodyRuntimeStatusNotConfigured,
+			Message: "runtime API not configured",
+		}, nil
+	}
+	start := time.Now()
+	infos, err := h.Client.Runtime.GetInfo()
+	r := &health.GetHAProxyHealthOKBodyRuntime{
+		Status:  health.GetHAProxyHealthOKBodyRuntimeStatusResponsive,
+		Latency: time.Since(start).Milliseconds(),
+	}
+	if err == nil && len(infos) == 0 {
+		r.Status = health.GetHAProxyHealthOKBodyRuntimeStatusNotConfigured
+		r.Message = "no runtime socket"
+		return r, nil
+	}
+	var info *models.ProcessInfoItem
+	for _, i := range infos {
+		switch {
+		case i.Error != "" && err == nil:
+			err = fmt.Errorf("%s: %s", i.RuntimeAPI, i.Error)
+		case i.Error == "" && i.Info != nil && info == nil:
+			info = i.Info
+		}
+	}
+	if err != nil {
+		r.Status = health.GetHAProxyHealthOKBodyRuntimeStatusUnresponsive
+		r.Message = err.Error()
+	}
+	return r, info
+}
+
+// processHealth checks a current worker is listed by the master socket
+func (h *GetHAProxyHealthHandlerImpl) processHealth(p *health.GetHAProxyHealthOKBodyProcess) {
+	procs, err := haproxy.ShowProc(h.MasterSocket)
+	if err != nil {
+		p.Status = health.GetHAProxyHealthOKBodyProcessStatusStopped
+		p.Message = err.Error()
+		return
+	}
+	for _, proc := range procs {
+		if proc.Type == "worker" && !proc.Old {
+			p.Status = health.GetHAProxyHealthOKBodyProcessStatusRunning
+			p.Pid = proc.PID
+			p.Uptime = proc.Uptime
+			p.Version = proc.Version
+			return
+		}
+	}
+	p.Status = health.GetHAProxyHealthOKBodyProcessStatusStopped
+	p.Message = "the master process has no current worker"
+}
+
+// reloadHealth returns the last reload and whether reloads are frozen
+func (h *GetHAProxyHealthHandlerImpl) reloadHealth() *health.GetHAProxyHealthOKBodyReload {
+	r := &health.GetHAProxyHealthOKBodyReload{Frozen: h.ReloadAgent.FreezeStatus().Frozen}
+	var last *models.Reload
+	for _, reload := range h.ReloadAgent.GetReloads() {
+		switch {
+		case reload.Status == models.ReloadStatusInProgress:
+			last = reload
+		case last == nil || (last.Status != models.ReloadStatusInProgress && reload.ReloadTimestamp > last.ReloadTimestamp):
+			last = reload
+		}
+	}
+	if last != nil {
+		r.ID = last.ID
+		r.Status = last.Status
+		r.Timestamp = last.ReloadTimestamp
+		if last.Status == models.ReloadStatusFailed {
+			r.Message = last.Response
+		}
+	}
+	return r
+}
@@ -0,0 +1,282 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/client-native/v2/runtime"
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/host_routing"
+	"github.com/haproxytech/dataplaneapi/system"
+)
+
+// hostRoutesMu serializes changes of the host routes map files
+var hostRoutesMu sync.Mutex
+
+//GetHostRoutesHandlerImpl implementation of the GetHostRoutesHandler interface using client-native client
+type GetHostRoutesHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	MapsDir string
+}
+
+//GetHostRouteHandlerImpl implementation of the GetHostRouteHandler interface using client-native client
+type GetHostRouteHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	MapsDir string
+}
+
+//CreateHostRouteHandlerImpl implementation of the CreateHostRouteHandler interface using client-native client
+type CreateHostRouteHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	MapsDir     string
+}
+
+//DeleteHostRouteHandlerImpl implementation of the DeleteHostRouteHandler interface using client-native client
+type DeleteHostRouteHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	MapsDir     string
+}
+
+//Handle executing the request and returning a response
+func (h *GetHostRoutesHandlerImpl) Handle(params host_routing.GetHostRoutesParams, principal interface{}) middleware.Responder {
+	if _, _, err := h.Client.Configuration.GetFrontend(params.Frontend, ""); err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewGetHostRoutesDefault(int(*e.Code)).WithPayload(e)
+	}
+	entries, err := readHostRoutes(hostRoutesMapFile(h.MapsDir, params.Frontend))
+	if err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewGetHostRoutesDefault(int(*e.Code)).WithPayload(e)
+	}
+	routes := make([]*host_routing.GetHostRoutesOKBodyItems0, 0, len(entries))
+	for _, e := range entries {
+		routes = append(routes, &host_routing.GetHostRoutesOKBodyItems0{
+			Host:    misc.StringP(e.Key),
+			Backend: misc.StringP(e.Value),
+		})
+	}
+	return host_routing.NewGetHostRoutesOK().WithPayload(routes)
+}
+
+//Handle executing the request and returning a response
+func (h *GetHostRouteHandlerImpl) Handle(params host_routing.GetHostRouteParams, principal interface{}) middleware.Responder {
+	entries, err := readHostRoutes(hostRoutesMapFile(h.MapsDir, params.Frontend))
+	if err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewGetHostRouteDefault(int(*e.Code)).WithPayload(e)
+	}
+	host := strings.ToLower(params.Host)
+	for _, e := range entries {
+		if e.Key == host {
+			return host_routing.NewGetHostRouteOK().WithPayload(&host_routing.GetHostRouteOKBody{
+				Host:    misc.StringP(e.Key),
+				Backend: misc.StringP(e.Value),
+			})
+		}
+	}
+	msg := fmt.Sprintf("host route %s does not exist in frontend %s", params.Host, params.Frontend)
+	c := misc.ErrHTTPNotFound
+	return host_routing.NewGetHostRouteNotFound().WithPayload(&models.Error{Code: &c, Message: &msg})
+}
+
+//Handle executing the request and returning a response
+func (h *CreateHostRouteHandlerImpl) Handle(params host_routing.CreateHostRouteParams, principal interface{}) middleware.Responder {
+	host := strings.ToLower(*params.Data.Host)
+	backend := *params.Data.Backend
+	mapFile := hostRoutesMapFile(h.MapsDir, params.Frontend)
+
+	if _, _, err := h.Client.Configuration.GetFrontend(params.Frontend, ""); err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewCreateHostRouteDefault(int(*e.Code)).WithPayload(e)
+	}
+	if _, _, err := h.Client.Configuration.GetBackend(backend, ""); err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewCreateHostRouteDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	hostRoutesMu.Lock()
+	defer hostRoutesMu.Unlock()
+
+	entries, err := readHostRoutes(mapFile)
+	if err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewCreateHostRouteDefault(int(*e.Code)).WithPayload(e)
+	}
+	for _, e := range entries {
+		if e.Key == host {
+			msg := fmt.Sprintf("host route %s already exists in frontend %s", host, params.Frontend)
+			c := misc.ErrHTTPConflict
+			return host_routing.NewCreateHostRouteConflict().WithPayload(&models.Error{Code: &c, Message: &msg})
+		}
+	}
+	entries = append(entries, &models.MapEntry{Key: host, Value: backend})
+	if err := writeHostRoutes(mapFile, entries); err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewCreateHostRouteDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	// the use_backend rule is only added with the first route, HAProxy then
+	// has to be reloaded to load the map file
+	ruleCreated, err := ensureHostRoutingRule(h.Client, params.Frontend, mapFile)
+	if err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewCreateHostRouteDefault(int(*e.Code)).WithPayload(e)
+	}
+	if !ruleCreated {
+		err := addHostRouteRuntime(h.Client, mapFile, host, backend)
+		if err == nil {
+			return host_routing.NewCreateHostRouteCreated().WithPayload(&host_routing.CreateHostRouteCreatedBody{
+				Host:    misc.StringP(host),
+				Backend: misc.StringP(backend),
+			})
+		}
+		log.Warningf("Adding host route %s through runtime API failed, reloading: %s", host, err.Error())
+	}
+	rID := h.ReloadAgent.Reload()
+	return host_routing.NewCreateHostRouteAccepted().WithReloadID(rID).WithPayload(&host_routing.CreateHostRouteAcceptedBody{
+		Host:    misc.StringP(host),
+		Backend: misc.StringP(backend),
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteHostRouteHandlerImpl) Handle(params host_routing.DeleteHostRouteParams, principal interface{}) middleware.Responder {
+	host := strings.ToLower(params.Host)
+	mapFile := hostRoutesMapFile(h.MapsDir, params.Frontend)
+
+	hostRoutesMu.Lock()
+	defer hostRoutesMu.Unlock()
+
+	entries, err := readHostRoutes(mapFile)
+	if err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewDeleteHostRouteDefault(int(*e.Code)).WithPayload(e)
+	}
+	remaining := make([]*models.MapEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.Key != host {
+			remaining = append(remaining, e)
+		}
+	}
+	if len(remaining) == len(entries) {
+		msg := fmt.Sprintf("host route %s does not exist in frontend %s", params.Host, params.Frontend)
+		c := misc.ErrHTTPNotFound
+		return host_routing.NewDeleteHostRouteNotFound().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	if err := writeHostRoutes(mapFile, remaining); err != nil {
+		e := misc.HandleError(err)
+		return host_routing.NewDeleteHostRouteDefault(int(*e.Code)).WithPayload(e)
+	}
+	if err := deleteHostRouteRuntime(h.Client, mapFile, host); err != nil {
+		log.Warningf("Deleting host route %s through runtime API failed, reloading: %s", host, err.Error())
+		h.ReloadAgent.Reload()
+	}
+	return host_routing.NewDeleteHostRouteNoContent()
+}
+
+// hostRoutesMapFile returns the path of the map file holding the host routes of a frontend
+func hostRoutesMapFile(mapsDir, frontend string) string {
+	return filepath.Join(mapsDir, fmt.Sprintf("%s_hosts.map", frontend))
+}
+
+// hostRoutingRule returns the use_backend rule name selecting the backend from the map file
+func hostRoutingRule(mapFile string) string {
+	return fmt.Sprintf("%%[req.hdr(host),field(1,:),lower,map(%s)]", mapFile)
+}
+
+func readHostRoutes(mapFile string) (models.MapEntries, error) {
+	raw, err := ioutil.ReadFile(mapFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return models.MapEntries{}, nil
+		}
+		return nil, err
+	}
+	return runtime.ParseMapEntries(string(raw), false), nil
+}
+
+func writeHostRoutes(mapFile string, entries models.MapEntries) error {
+	var b strings.Builder
+	for _, e := range entries {
+		b.WriteString(fmt.Sprintf("%s %s\n", e.Key, e.Value))
+	}
+	if err := system.MkdirAll(filepath.Dir(mapFile), 0755); err != nil {
+		return err
+	}
+	return system.WriteFile(mapFile, []byte(b.String()), 0644)
+}
+
+// ensureHostRoutingRule adds the use_backend rule using the map file after the
+// other rules of the frontend, and returns true if the rule was added
+func ensureHostRoutingRule(client *client_native.HAProxyClient, frontend, mapFile string) (bool, error) {
+	_, rules, err := client.Configuration.GetBackendSwitchingRules(frontend, "")
+	if err != nil {
+		return false, err
+	}
+	name := hostRoutingRule(mapFile)
+	for _, r := range rules {
+		if r.Name == name {
+			return false, nil
+		}
+	}
+	v, err := client.Configuration.GetVersion("")
+	if err != nil {
+		return false, err
+	}
+	rule := &models.BackendSwitchingRule{
+		Index: misc.Int64P(len(rules)),
+		Name:  name,
+	}
+	if err := client.Configuration.CreateBackendSwitchingRule(frontend, rule, "", v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func addHostRouteRuntime(client *client_native.HAProxyClient, mapFile, host, backend string) error {
+	if client.Runtime == nil {
+		return native_configuration.NewConfError(native_configuration.ErrGeneralError, "runtime API not configured")
+	}
+	return client.Runtime.AddMapEntry(runtimeMapName(mapFile), host, backend)
+}
+
+func deleteHostRouteRuntime(client *client_native.HAProxyClient, mapFile, host string) error {
+	if client.Runtime == nil {
+		return native_configuration.NewConfError(native_configuration.ErrGeneralError, "runtime API not configured")
+	}
+	return client.Runtime.DeleteMapEntry(runtimeMapName(mapFile), host)
+}
+
+// runtimeMapName returns the map name the runtime client resolves to the map file,
+// either in the maps directory or among the maps loaded by HAProxy
+func runtimeMapName(mapFile string) string {
+	return strings.TrimSuffix(filepath.Base(mapFile), filepath.Ext(mapFile))
+}
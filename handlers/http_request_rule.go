@@ -28,6 +28,7 @@ import (
 type CreateHTTPRequestRuleHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
 }
 
 //DeleteHTTPRequestRuleHandlerImpl implementation of the DeleteHTTPRequestRuleHandler interface using client-native client
@@ -50,6 +51,7 @@ type GetHTTPRequestRulesHandlerImpl struct {
 type ReplaceHTTPRequestRuleHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
 }
 
 //Handle executing the request and returning a response
@@ -73,7 +75,10 @@ func (h *CreateHTTPRequestRuleHandlerImpl) Handle(params http_request_rule.Creat
 		return http_request_rule.NewCreateHTTPRequestRuleDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.CreateHTTPRequestRule(params.ParentType, params.ParentName, params.Data, t, v)
+	err := validateHTTPRequestRule(h.Validator, params.Data)
+	if err == nil {
+		err = h.Client.Configuration.CreateHTTPRequestRule(params.ParentType, params.ParentName, params.Data, t, v)
+	}
 	if err != nil {
 		e := misc.HandleError(err)
 		return http_request_rule.NewCreateHTTPRequestRuleDefault(int(*e.Code)).WithPayload(e)
@@ -186,7 +191,10 @@ func (h *ReplaceHTTPRequestRuleHandlerImpl) Handle(params http_request_rule.Repl
 		return http_request_rule.NewReplaceHTTPRequestRuleDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.EditHTTPRequestRule(params.Index, params.ParentType, params.ParentName, params.Data, t, v)
+	err := validateHTTPRequestRule(h.Validator, params.Data)
+	if err == nil {
+		err = h.Client.Configuration.EditHTTPRequestRule(params.Index, params.ParentType, params.ParentName, params.Data, t, v)
+	}
 	if err != nil {
 		e := misc.HandleError(err)
 		return http_request_rule.NewReplaceHTTPRequestRuleDefault(int(*e.Code)).WithPayload(e)
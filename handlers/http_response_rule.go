@@ -28,6 +28,7 @@ import (
 type CreateHTTPResponseRuleHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
 }
 
 //DeleteHTTPResponseRuleHandlerImpl implementation of the DeleteHTTPResponseRuleHandler interface using client-native client
@@ -50,6 +51,7 @@ type GetHTTPResponseRulesHandlerImpl struct {
 type ReplaceHTTPResponseRuleHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
 }
 
 //Handle executing the request and returning a response
@@ -73,7 +75,10 @@ func (h *CreateHTTPResponseRuleHandlerImpl) Handle(params http_response_rule.Cre
 		return http_response_rule.NewCreateHTTPResponseRuleDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.CreateHTTPResponseRule(params.ParentType, params.ParentName, params.Data, t, v)
+	err := validateHTTPResponseRule(h.Validator, params.Data)
+	if err == nil {
+		err = h.Client.Configuration.CreateHTTPResponseRule(params.ParentType, params.ParentName, params.Data, t, v)
+	}
 	if err != nil {
 		e := misc.HandleError(err)
 		return http_response_rule.NewCreateHTTPResponseRuleDefault(int(*e.Code)).WithPayload(e)
@@ -186,7 +191,10 @@ func (h *ReplaceHTTPResponseRuleHandlerImpl) Handle(params http_response_rule.Re
 		return http_response_rule.NewReplaceHTTPResponseRuleDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.EditHTTPResponseRule(params.Index, params.ParentType, params.ParentName, params.Data, t, v)
+	err := validateHTTPResponseRule(h.Validator, params.Data)
+	if err == nil {
+		err = h.Client.Configuration.EditHTTPResponseRule(params.Index, params.ParentType, params.ParentName, params.Data, t, v)
+	}
 	if err != nil {
 		e := misc.HandleError(err)
 		return http_response_rule.NewReplaceHTTPResponseRuleDefault(int(*e.Code)).WithPayload(e)
@@ -0,0 +1,306 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/frontend"
+)
+
+const (
+	// httpsRedirectCondTest matches requests received over TLS
+	httpsRedirectCondTest = "{ ssl_fc }"
+	// hstsHeader is the name of the HSTS response header
+	hstsHeader = "Strict-Transport-Security"
+
+	httpsRedirectDefaultCode   = 301
+	httpsRedirectDefaultMaxAge = 31536000
+)
+
+//GetHTTPSRedirectHandlerImpl implementation of the GetHTTPSRedirectHandler interface using client-native client
+type GetHTTPSRedirectHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceHTTPSRedirectHandlerImpl implementation of the ReplaceHTTPSRedirectHandler interface using client-native client
+type ReplaceHTTPSRedirectHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//DeleteHTTPSRedirectHandlerImpl implementation of the DeleteHTTPSRedirectHandler interface using client-native client
+type DeleteHTTPSRedirectHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetHTTPSRedirectHandlerImpl) Handle(params frontend.GetHTTPSRedirectParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewGetHTTPSRedirectDefault(int(*e.Code)).WithPayload(e)
+	}
+	r, _, _, err := getHTTPSRedirect(h.Client, params.Frontend, t)
+	if err == nil && r == nil {
+		err = httpsRedirectNotFound(params.Frontend)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewGetHTTPSRedirectDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := frontend.GetHTTPSRedirectOKBodyData(*r)
+	return frontend.NewGetHTTPSRedirectOK().WithPayload(&frontend.GetHTTPSRedirectOKBody{Version: v, Data: &data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceHTTPSRedirectHandlerImpl) Handle(params frontend.ReplaceHTTPSRedirectParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return frontend.NewReplaceHTTPSRedirectDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	r := params.Data
+	r.Frontend = params.Frontend
+	if r.Code == 0 {
+		r.Code = httpsRedirectDefaultCode
+	}
+	if r.Hsts && r.HstsMaxAge == 0 {
+		r.HstsMaxAge = httpsRedirectDefaultMaxAge
+	}
+	if !r.Hsts {
+		r.HstsMaxAge = 0
+		r.HstsIncludeSubdomains = false
+		r.HstsPreload = false
+	}
+
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		if err := deleteHTTPSRedirect(h.Client, params.Frontend, t, false); err != nil {
+			return err
+		}
+		rule := &models.HTTPRequestRule{
+			Index:      misc.Int64P(0),
+			Type:       "redirect",
+			RedirType:  "scheme",
+			RedirValue: "https",
+			RedirCode:  &r.Code,
+			Cond:       "unless",
+			CondTest:   httpsRedirectCondTest,
+		}
+		if err := h.Client.Configuration.CreateHTTPRequestRule("frontend", params.Frontend, rule, t, 0); err != nil {
+			return err
+		}
+		if !r.Hsts {
+			return nil
+		}
+		return h.Client.Configuration.CreateHTTPResponseRule("frontend", params.Frontend, &models.HTTPResponseRule{
+			Index:     misc.Int64P(0),
+			Type:      "set-header",
+			HdrName:   hstsHeader,
+			HdrFormat: hstsValue(&r),
+			Cond:      "if",
+			CondTest:  httpsRedirectCondTest,
+		}, t, 0)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewReplaceHTTPSRedirectDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return frontend.NewReplaceHTTPSRedirectDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := frontend.ReplaceHTTPSRedirectOKBody(r)
+			return frontend.NewReplaceHTTPSRedirectOK().WithPayload(&ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := frontend.ReplaceHTTPSRedirectAcceptedBody(r)
+		return frontend.NewReplaceHTTPSRedirectAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := frontend.ReplaceHTTPSRedirectAcceptedBody(r)
+	return frontend.NewReplaceHTTPSRedirectAccepted().WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteHTTPSRedirectHandlerImpl) Handle(params frontend.DeleteHTTPSRedirectParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return frontend.NewDeleteHTTPSRedirectDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		return deleteHTTPSRedirect(h.Client, params.Frontend, t, true)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewDeleteHTTPSRedirectDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return frontend.NewDeleteHTTPSRedirectDefault(int(*e.Code)).WithPayload(e)
+			}
+			return frontend.NewDeleteHTTPSRedirectNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return frontend.NewDeleteHTTPSRedirectAccepted().WithReloadID(rID)
+	}
+	return frontend.NewDeleteHTTPSRedirectAccepted()
+}
+
+func httpsRedirectNotFound(fe string) error {
+	return native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("frontend %s has no HTTPS redirect", fe))
+}
+
+// hstsValue returns the Strict-Transport-Security header value of a redirect,
+// without spaces which header formats can not hold
+func hstsValue(r *frontend.ReplaceHTTPSRedirectBody) string {
+	value := fmt.Sprintf("max-age=%d", r.HstsMaxAge)
+	if r.HstsIncludeSubdomains {
+		value += ";includeSubDomains"
+	}
+	if r.HstsPreload {
+		value += ";preload"
+	}
+	return value
+}
+
+// getHTTPSRedirect returns the HTTPS redirect of a frontend with the indexes of
+// its request and response rules, -1 for missing rules. The redirect is nil when
+// the frontend has no HTTPS redirect rule.
+func getHTTPSRedirect(client *client_native.HAProxyClient, fe, t string) (*frontend.ReplaceHTTPSRedirectBody, int64, int64, error) {
+	if _, _, err := client.Configuration.GetFrontend(fe, t); err != nil {
+		return nil, -1, -1, err
+	}
+	_, reqRules, err := client.Configuration.GetHTTPRequestRules("frontend", fe, t)
+	if err != nil {
+		return nil, -1, -1, err
+	}
+	_, resRules, err := client.Configuration.GetHTTPResponseRules("frontend", fe, t)
+	if err != nil {
+		return nil, -1, -1, err
+	}
+
+	var r *frontend.ReplaceHTTPSRedirectBody
+	reqIndex := int64(-1)
+	for _, rule := range reqRules {
+		if rule.Type == "redirect" && rule.RedirType == "scheme" && rule.RedirValue == "https" && rule.Cond == "unless" && rule.CondTest == httpsRedirectCondTest {
+			r = &frontend.ReplaceHTTPSRedirectBody{Frontend: fe, Code: httpsRedirectDefaultCode}
+			if rule.RedirCode != nil {
+				r.Code = *rule.RedirCode
+			}
+			reqIndex = *rule.Index
+			break
+		}
+	}
+	resIndex := int64(-1)
+	for _, rule := range resRules {
+		if rule.Type == "set-header" && rule.HdrName == hstsHeader && rule.Cond == "if" && rule.CondTest == httpsRedirectCondTest {
+			resIndex = *rule.Index
+			if r != nil {
+				r.Hsts = true
+				parseHSTSValue(strings.Trim(rule.HdrFormat, "\""), r)
+			}
+			break
+		}
+	}
+	return r, reqIndex, resIndex, nil
+}
+
+func parseHSTSValue(value string, r *frontend.ReplaceHTTPSRedirectBody) {
+	for _, d := range strings.Split(value, ";") {
+		d = strings.TrimSpace(d)
+		switch {
+		case strings.HasPrefix(d, "max-age="):
+			if maxAge, err := strconv.ParseInt(strings.TrimPrefix(d, "max-age="), 10, 64); err == nil {
+				r.HstsMaxAge = maxAge
+			}
+		case strings.EqualFold(d, "includeSubDomains"):
+			r.HstsIncludeSubdomains = true
+		case strings.EqualFold(d, "preload"):
+			r.HstsPreload = true
+		}
+	}
+}
+
+// deleteHTTPSRedirect removes the rules of the HTTPS redirect of a frontend in
+// transaction t, with an error if mustExist is set and there is no redirect
+func deleteHTTPSRedirect(client *client_native.HAProxyClient, fe, t string, mustExist bool) error {
+	r, reqIndex, resIndex, err := getHTTPSRedirect(client, fe, t)
+	if err != nil {
+		return err
+	}
+	if r == nil && mustExist {
+		return httpsRedirectNotFound(fe)
+	}
+	if resIndex >= 0 {
+		if err := client.Configuration.DeleteHTTPResponseRule(resIndex, "frontend", fe, t, 0); err != nil {
+			return err
+		}
+	}
+	if reqIndex >= 0 {
+		return client.Configuration.DeleteHTTPRequestRule(reqIndex, "frontend", fe, t, 0)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/importer"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/configuration"
+)
+
+//ImportConfigurationHandlerImpl implementation of the ImportConfigurationHandler interface
+type ImportConfigurationHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//Handle executing the request and returning a response
+func (h *ImportConfigurationHandlerImpl) Handle(params configuration.ImportConfigurationParams, principal interface{}) middleware.Responder {
+	if params.Version == nil || *params.Version == 0 {
+		c := misc.ErrHTTPBadRequest
+		msg := "version is required to start the transaction of the imported configuration"
+		return configuration.NewImportConfigurationBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	converted, err := importer.Convert(params.Format, params.Data)
+	if err != nil {
+		c := misc.ErrHTTPBadRequest
+		msg := err.Error()
+		return configuration.NewImportConfigurationBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	tr, err := h.Client.Configuration.StartTransaction(*params.Version)
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewImportConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	// the transaction is left open, the imported configuration is committed once reviewed
+	if err := createImported(h.Client.Configuration, converted, tr.ID); err != nil {
+		e := misc.HandleError(discardParserChange(h.Client, tr.ID, true, err))
+		return configuration.NewImportConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	body := &configuration.ImportConfigurationCreatedBody{
+		TransactionID: tr.ID,
+		Frontends:     make([]string, 0, len(converted.Frontends)),
+		Backends:      make([]string, 0, len(converted.Backends)),
+		Issues:        make([]*configuration.ImportConfigurationCreatedBodyIssuesItems0, 0, len(converted.Issues)),
+	}
+	for _, f := range converted.Frontends {
+		body.Frontends = append(body.Frontends, f.Frontend.Name)
+	}
+	for _, b := range converted.Backends {
+		body.Backends = append(body.Backends, b.Backend.Name)
+	}
+	for _, i := range converted.Issues {
+		body.Issues = append(body.Issues, &configuration.ImportConfigurationCreatedBodyIssuesItems0{
+			Line:      int64(i.Line),
+			Directive: i.Directive,
+			Message:   i.Message,
+		})
+	}
+	return configuration.NewImportConfigurationCreated().WithPayload(body)
+}
+
+// createImported creates the converted backends and frontends in the transaction t
+func createImported(c *native_configuration.Client, converted *importer.Configuration, t string) error {
+	for _, b := range converted.Backends {
+		if err := c.CreateBackend(b.Backend, t, 0); err != nil {
+			return err
+		}
+		for _, s := range b.Servers {
+			if err := c.CreateServer(b.Backend.Name, s, t, 0); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range converted.Frontends {
+		name := f.Frontend.Name
+		if err := c.CreateFrontend(f.Frontend, t, 0); err != nil {
+			return err
+		}
+		for _, b := range f.Binds {
+			if err := c.CreateBind(name, b, t, 0); err != nil {
+				return err
+			}
+		}
+		for _, a := range f.ACLs {
+			if err := c.CreateACL("frontend", name, a, t, 0); err != nil {
+				return err
+			}
+		}
+		for _, r := range f.HTTPRequestRules {
+			if err := c.CreateHTTPRequestRule("frontend", name, r, t, 0); err != nil {
+				return err
+			}
+		}
+		for _, r := range f.SwitchingRules {
+			if err := c.CreateBackendSwitchingRule(name, r, t, 0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -16,23 +16,24 @@
 package handlers
 
 import (
-	"bufio"
-	"bytes"
 	"fmt"
 	"os"
 	"runtime"
-	"strings"
 	"time"
 
 	"github.com/shirou/gopsutil/host"
 	"github.com/shirou/gopsutil/mem"
-	"golang.org/x/sys/unix"
+	"github.com/shirou/gopsutil/process"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/dataplaneapi/configuration"
+	sc "github.com/haproxytech/dataplaneapi/discovery"
+	"github.com/haproxytech/dataplaneapi/haproxy"
 	"github.com/haproxytech/dataplaneapi/misc"
 	"github.com/haproxytech/dataplaneapi/operations/information"
+	"github.com/haproxytech/dataplaneapi/system"
 	"github.com/haproxytech/models/v2"
 )
 
@@ -57,17 +58,121 @@ func (h *GetHaproxyProcessInfoHandlerImpl) Handle(params information.GetHaproxyP
 	return information.NewGetHaproxyProcessInfoOK().WithPayload(info)
 }
 
+//GetHaproxyProcessesHandlerImpl implementation of the GetHaproxyProcessesHandler interface
+type GetHaproxyProcessesHandlerImpl struct {
+	MasterSocket string
+}
+
+//Handle executing the request and returning a response
+func (h *GetHaproxyProcessesHandlerImpl) Handle(params information.GetHaproxyProcessesParams, principal interface{}) middleware.Responder {
+	procs, err := haproxy.ShowProc(h.MasterSocket)
+	if err != nil {
+		e := misc.HandleError(err)
+		return information.NewGetHaproxyProcessesDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := make([]*information.GetHaproxyProcessesOKBodyItems0, 0, len(procs))
+	for _, p := range procs {
+		item := &information.GetHaproxyProcessesOKBodyItems0{
+			Pid:         p.PID,
+			Type:        p.Type,
+			RelativePid: p.RelativePID,
+			Reloads:     p.Reloads,
+			Uptime:      p.Uptime,
+			Version:     p.Version,
+			Old:         p.Old,
+		}
+		// usage is only available when HAProxy runs in the same PID namespace
+		if proc, err := process.NewProcess(int32(p.PID)); err == nil {
+			if m, err := proc.MemoryInfo(); err == nil {
+				rss := int64(m.RSS)
+				item.Memory = &rss
+			}
+			if cpu, err := proc.CPUPercent(); err == nil {
+				item.CPUPercent = &cpu
+			}
+		}
+		data = append(data, item)
+	}
+	return information.NewGetHaproxyProcessesOK().WithPayload(data)
+}
+
+//StopOldWorkersHandlerImpl implementation of the StopOldWorkersHandler interface
+type StopOldWorkersHandlerImpl struct {
+	MasterSocket string
+}
+
+//Handle executing the request and returning a response
+func (h *StopOldWorkersHandlerImpl) Handle(params information.StopOldWorkersParams, principal interface{}) middleware.Responder {
+	olderThan := int64(0)
+	if params.OlderThan != nil {
+		olderThan = *params.OlderThan
+	}
+	procs, err := haproxy.StopOldWorkers(h.MasterSocket, olderThan)
+	if err != nil {
+		e := misc.HandleError(err)
+		return information.NewStopOldWorkersDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := make([]*information.StopOldWorkersOKBodyItems0, 0, len(procs))
+	for _, p := range procs {
+		data = append(data, &information.StopOldWorkersOKBodyItems0{
+			Pid:         p.PID,
+			Type:        p.Type,
+			RelativePid: p.RelativePID,
+			Reloads:     p.Reloads,
+			Uptime:      p.Uptime,
+			Version:     p.Version,
+			Old:         p.Old,
+		})
+	}
+	return information.NewStopOldWorkersOK().WithPayload(data)
+}
+
+// connectionMemory is a rough estimate of the memory used by one connection, two
+// buffers of the default tune.bufsize
+const connectionMemory = 2 * 16384
+
 //GetInfoHandlerImpl implementation of the GetInfoHandler interface
 type GetInfoHandlerImpl struct {
-	SystemInfo bool
-	BuildTime  string
-	Version    string
+	Client       *client_native.HAProxyClient
+	Config       *configuration.Configuration
+	Discovery    sc.ServiceDiscoveries
+	Validator    *haproxy.SampleValidator
+	MasterSocket string
+	SystemInfo   bool
+	BuildTime    string
+	Version      string
+	GitCommit    string
+	GitTag       string
+	GitRepo      string
+}
+
+//GetDataplaneConfigurationHandlerImpl implementation of the GetDataplaneConfigurationHandler interface
+type GetDataplaneConfigurationHandlerImpl struct {
+	Config *configuration.Configuration
+	// HAProxyOptions are the HAProxy options the API runs with, environment
+	// overrides applied
+	HAProxyOptions configuration.HAProxyConfiguration
+}
+
+//GetPreflightHandlerImpl implementation of the GetPreflightHandler interface
+type GetPreflightHandlerImpl struct {
+	Result *haproxy.PreflightResult
+}
+
+//GetPrivilegesHandlerImpl implementation of the GetPrivilegesHandler interface
+type GetPrivilegesHandlerImpl struct {
+	Files []haproxy.ManagedFile
 }
 
 //Handle executing the request and returning a response
 func (h *GetInfoHandlerImpl) Handle(params information.GetInfoParams, principal interface{}) middleware.Responder {
-	api := &models.InfoAPI{
-		Version: h.Version,
+	api := &information.GetInfoOKBodyAPI{
+		Version:   h.Version,
+		GitCommit: h.GitCommit,
+		GitTag:    h.GitTag,
+		GitRepo:   h.GitRepo,
 	}
 	date, err := time.Parse("2006-01-02T15:04:05", h.BuildTime)
 	if err == nil {
@@ -76,7 +181,7 @@ func (h *GetInfoHandlerImpl) Handle(params information.GetInfoParams, principal
 		fmt.Println(err.Error())
 	}
 
-	sys := &models.InfoSystem{}
+	sys := &information.GetInfoOKBodySystem{}
 
 	if h.SystemInfo {
 		hName, err := os.Hostname()
@@ -84,13 +189,14 @@ func (h *GetInfoHandlerImpl) Handle(params information.GetInfoParams, principal
 			sys.Hostname = hName
 		}
 
-		sys.MemInfo = &models.InfoSystemMemInfo{}
-		sys.CPUInfo = &models.InfoSystemCPUInfo{}
+		sys.MemInfo = &information.GetInfoOKBodySystemMemInfo{}
+		sys.CPUInfo = &information.GetInfoOKBodySystemCPUInfo{}
 
 		mem, err := mem.VirtualMemory()
 		if err == nil {
 			sys.MemInfo.TotalMemory = int64(mem.Total)
 			sys.MemInfo.FreeMemory = int64(mem.Free)
+			sys.MemInfo.AvailableMemory = int64(mem.Available)
 		}
 		//nolint:govet
 		if uptime, err := host.Uptime(); err == nil {
@@ -104,38 +210,196 @@ func (h *GetInfoHandlerImpl) Handle(params information.GetInfoParams, principal
 		runtime.ReadMemStats(m)
 		sys.MemInfo.DataplaneapiMemory = int64(m.Sys)
 
-		sys.CPUInfo.Model = parseCPUModel()
+		sys.CPUInfo.Model = system.CPUModel()
 
-		uName := &unix.Utsname{}
-		err = unix.Uname(uName)
-		if err == nil {
-			sys.OsString = string(bytes.Trim(uName.Sysname[:], "\x00")) + " " + string(bytes.Trim(uName.Release[:], "\x00")) + " " + string(bytes.Trim(uName.Version[:], "\x00"))
-		}
+		sys.OsString = system.OSString()
 		sys.Time = time.Now().Unix()
+
+		sys.Limits = systemLimits()
+		sys.Warnings = h.capacityWarnings(sys)
+	}
+
+	hap := &information.GetInfoOKBodyHaproxy{
+		MinVersion: haproxy.MinVersion,
+		MaxVersion: haproxy.MaxVersion,
+	}
+	if h.Validator != nil && h.Validator.Version != "" {
+		hap.Version = h.Validator.Version
+		hap.Supported = haproxy.SupportedVersion(hap.Version)
+	}
+
+	features := &information.GetInfoOKBodyFeatures{
+		Disabled:           []string{},
+		DiscoveryProviders: []string{},
+		MasterRuntime:      h.MasterSocket != "",
+		RuntimeAPI:         h.Client != nil && h.Client.Runtime != nil,
+		SystemInfo:         h.SystemInfo,
+	}
+	if h.Discovery != nil {
+		features.DiscoveryProviders = h.Discovery.Services()
+	}
+
+	conf := &information.GetInfoOKBodyConfiguration{}
+	if h.Config != nil {
+		features.ClusterMode = h.Config.Mode.Load() == "cluster"
+		features.Disabled = h.Config.Features.Disabled()
+		conf.ConfigFile = h.Config.HAProxy.ConfigFile
+		conf.DataplaneConfigFile = h.Config.HAProxy.DataplaneConfig
+		conf.HaproxyBin = h.Config.HAProxy.HAProxy
+		conf.TransactionDir = h.Config.HAProxy.TransactionDir
+		conf.MapsDir = h.Config.HAProxy.MapsDir
 	}
 
-	return information.NewGetInfoOK().WithPayload(&models.Info{API: api, System: sys})
+	return information.NewGetInfoOK().WithPayload(&information.GetInfoOKBody{
+		API:           api,
+		Haproxy:       hap,
+		Features:      features,
+		Configuration: conf,
+		System:        sys,
+	})
 }
 
-func parseCPUModel() string {
-	file, err := os.Open("/proc/cpuinfo")
-	if err != nil {
-		return ""
+func systemLimits() *information.GetInfoOKBodySystemLimits {
+	limits := &information.GetInfoOKBodySystemLimits{
+		FileMax:        system.Sysctl("fs.file-max"),
+		NrOpen:         system.Sysctl("fs.nr_open"),
+		Somaxconn:      system.Sysctl("net.core.somaxconn"),
+		ConntrackMax:   system.Sysctl("net.netfilter.nf_conntrack_max"),
+		ConntrackCount: system.Sysctl("net.netfilter.nf_conntrack_count"),
+	}
+	if soft, hard, ok := system.FileLimits(); ok {
+		limits.FdSoftLimit = soft
+		limits.FdHardLimit = hard
+	}
+	return limits
+}
+
+// capacityWarnings compares the configured maxconn values with the system limits
+func (h *GetInfoHandlerImpl) capacityWarnings(sys *information.GetInfoOKBodySystem) []string {
+	warnings := make([]string, 0)
+	if h.Client == nil {
+		return warnings
+	}
+	limits := sys.Limits
+
+	_, g, err := h.Client.Configuration.GetGlobalConfiguration("")
+	if err == nil && g.Maxconn > 0 {
+		// every connection uses a socket on the client side and one on the server side
+		fds := 2 * g.Maxconn
+		if limits.FdHardLimit > 0 && fds > limits.FdHardLimit {
+			warnings = append(warnings, fmt.Sprintf("global maxconn %d requires about %d file descriptors, open files hard limit is %d", g.Maxconn, fds, limits.FdHardLimit))
+		}
+		if limits.FileMax != nil && fds > *limits.FileMax {
+			warnings = append(warnings, fmt.Sprintf("global maxconn %d requires about %d file descriptors, fs.file-max is %d", g.Maxconn, fds, *limits.FileMax))
+		}
+		if limits.ConntrackMax != nil && g.Maxconn > *limits.ConntrackMax {
+			warnings = append(warnings, fmt.Sprintf("global maxconn %d exceeds net.netfilter.nf_conntrack_max %d", g.Maxconn, *limits.ConntrackMax))
+		}
+		if sys.MemInfo != nil && sys.MemInfo.AvailableMemory > 0 && g.Maxconn*connectionMemory > sys.MemInfo.AvailableMemory {
+			warnings = append(warnings, fmt.Sprintf("global maxconn %d may use up to %d bytes of buffers, available memory is %d bytes", g.Maxconn, g.Maxconn*connectionMemory, sys.MemInfo.AvailableMemory))
+		}
 	}
-	defer file.Close()
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		l := scanner.Text()
-		if strings.HasPrefix(l, "model name") {
-			s := strings.Split(l, ":")
-			return strings.TrimSpace(strings.Join(s[1:], ":"))
+	if limits.Somaxconn != nil {
+		_, frontends, err := h.Client.Configuration.GetFrontends("")
+		if err == nil {
+			for _, f := range frontends {
+				// the listen backlog defaults to the frontend maxconn and is capped by somaxconn
+				if f.Maxconn != nil && *f.Maxconn > *limits.Somaxconn {
+					warnings = append(warnings, fmt.Sprintf("frontend %s maxconn %d exceeds net.core.somaxconn %d, its listen backlog is capped", f.Name, *f.Maxconn, *limits.Somaxconn))
+				}
+			}
 		}
 	}
+	return warnings
+}
+
+//Handle executing the request and returning a response
+func (h *GetDataplaneConfigurationHandlerImpl) Handle(params information.GetDataplaneConfigurationParams, principal interface{}) middleware.Responder {
+	settings, err := h.Config.Settings(h.HAProxyOptions)
+	if err != nil {
+		e := misc.HandleError(err)
+		return information.NewGetDataplaneConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	body := &information.GetDataplaneConfigurationOKBody{
+		Settings: make([]*information.GetDataplaneConfigurationOKBodySettingsItems0, 0, len(settings)),
+	}
+	for _, s := range settings {
+		body.Settings = append(body.Settings, &information.GetDataplaneConfigurationOKBodySettingsItems0{
+			Name:     s.Name,
+			Value:    s.Value,
+			Source:   s.Source,
+			Redacted: s.Redacted,
+		})
+	}
+	return information.NewGetDataplaneConfigurationOK().WithPayload(body)
+}
 
-	if err := scanner.Err(); err != nil {
-		return ""
+//Handle executing the request and returning a response
+func (h *GetPreflightHandlerImpl) Handle(params information.GetPreflightParams, principal interface{}) middleware.Responder {
+	body := &information.GetPreflightOKBody{
+		Time:   strfmt.DateTime(h.Result.Time),
+		Passed: h.Result.Passed(),
+		Checks: make([]*information.GetPreflightOKBodyChecksItems0, 0, len(h.Result.Checks)),
+	}
+	for _, c := range h.Result.Checks {
+		body.Checks = append(body.Checks, &information.GetPreflightOKBodyChecksItems0{
+			Name:    c.Name,
+			Status:  c.Status,
+			Hard:    c.Hard,
+			Message: c.Message,
+		})
 	}
+	return information.NewGetPreflightOK().WithPayload(body)
+}
 
-	return ""
+//Handle executing the request and returning a response
+func (h *GetPrivilegesHandlerImpl) Handle(params information.GetPrivilegesParams, principal interface{}) middleware.Responder {
+	id := system.CurrentIdentity()
+	body := &information.GetPrivilegesOKBody{
+		UID:           int64(id.UID),
+		Gid:           int64(id.GID),
+		User:          id.User,
+		Group:         id.Group,
+		Root:          id.UID == 0,
+		Dropped:       system.PrivilegesDropped(),
+		Chroot:        system.ChrootDir(),
+		Capabilities:  system.Capabilities(),
+		ConfigLocking: system.ConfigWindow.Enabled(),
+		ConfigLocked:  system.ConfigWindow.Locked(),
+		Files:         make([]*information.GetPrivilegesOKBodyFilesItems0, 0, len(h.Files)),
+		Warnings:      make([]string, 0),
+	}
+	for _, g := range id.Groups {
+		body.Groups = append(body.Groups, int64(g))
+	}
+	if body.Root {
+		body.Warnings = append(body.Warnings, "the API runs as root, set --run-as-user to drop its privileges once its listeners are bound")
+	}
+	if !body.ConfigLocking {
+		body.Warnings = append(body.Warnings, "the configuration directory is always writable, set --config-unlock-cmd and --config-lock-cmd to keep it read-only outside of the changes of the API")
+	}
+	for _, p := range haproxy.CheckPermissions(h.Files) {
+		item := &information.GetPrivilegesOKBodyFilesItems0{
+			Type:           p.Type,
+			Path:           p.Path,
+			Exists:         p.Exists,
+			Readable:       p.Readable,
+			Writable:       p.Writable,
+			RequiredAccess: "read",
+			Issues:         append(p.Failures, p.Warnings...),
+		}
+		if p.Write {
+			item.RequiredAccess = "write"
+		}
+		if p.Exists {
+			item.Mode = fmt.Sprintf("%04o", p.Mode)
+		}
+		if p.UID != -1 {
+			item.UID = misc.Int64P(p.UID)
+			item.Gid = misc.Int64P(p.GID)
+		}
+		body.Files = append(body.Files, item)
+	}
+	return information.NewGetPrivilegesOK().WithPayload(body)
 }
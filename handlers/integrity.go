@@ -0,0 +1,90 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	dataplaneapi_config "github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/configuration"
+)
+
+//GetConfigurationIntegrityHandlerImpl implementation of the GetConfigurationIntegrityHandler interface
+type GetConfigurationIntegrityHandlerImpl struct {
+	Client         *client_native.HAProxyClient
+	HAProxyOptions dataplaneapi_config.HAProxyConfiguration
+}
+
+//Handle executing the request and returning a response
+func (h *GetConfigurationIntegrityHandlerImpl) Handle(params configuration.GetConfigurationIntegrityParams, principal interface{}) middleware.Responder {
+	v, err := h.Client.Configuration.GetVersion("")
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewGetConfigurationIntegrityDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser("")
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewGetConfigurationIntegrityDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	body := &configuration.GetConfigurationIntegrityOKBody{Version: v, Intact: true}
+	if err := haproxy.VerifyConfiguration(h.HAProxyOptions.ConfigFile, p); err != nil {
+		body.Intact = false
+		body.Message = err.Error()
+	}
+
+	checksums := make([]haproxy.FileChecksum, 0)
+	files := []struct {
+		path string
+		kind string
+	}{
+		{h.HAProxyOptions.ConfigFile, "configuration"},
+		{h.HAProxyOptions.DataplaneConfig, "dataplane"},
+		{h.HAProxyOptions.UserListFile, "userlist"},
+	}
+	for _, f := range files {
+		if f.path == "" {
+			continue
+		}
+		c, err := haproxy.Checksum(f.path, f.kind)
+		if err != nil {
+			e := misc.HandleError(err)
+			return configuration.NewGetConfigurationIntegrityDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+		}
+		checksums = append(checksums, c)
+	}
+	if h.HAProxyOptions.MapsDir != "" {
+		maps, err := haproxy.DirChecksums(h.HAProxyOptions.MapsDir, "map")
+		if err != nil {
+			e := misc.HandleError(err)
+			return configuration.NewGetConfigurationIntegrityDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+		}
+		checksums = append(checksums, maps...)
+	}
+	body.Files = make([]*configuration.GetConfigurationIntegrityOKBodyFilesItems0, 0, len(checksums))
+	for _, c := range checksums {
+		body.Files = append(body.Files, &configuration.GetConfigurationIntegrityOKBodyFilesItems0{
+			Type:     c.Type,
+			Path:     c.Path,
+			Sha256:   c.SHA256,
+			Size:     c.Size,
+			Modified: c.Modified,
+		})
+	}
+	return configuration.NewGetConfigurationIntegrityOK().WithPayload(body).WithConfigurationVersion(v)
+}
@@ -0,0 +1,697 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	parser_errors "github.com/haproxytech/config-parser/v2/errors"
+	"github.com/haproxytech/config-parser/v2/parsers"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/listen"
+)
+
+// Listen sections are kept by the configuration parser as plain lines. To give a
+// structured view of them, the lines are parsed as a frontend and a backend with
+// the same name in a scratch parser.
+
+//GetListensHandlerImpl implementation of the GetListensHandler interface
+type GetListensHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//GetListenHandlerImpl implementation of the GetListenHandler interface
+type GetListenHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//CreateListenHandlerImpl implementation of the CreateListenHandler interface
+type CreateListenHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//ReplaceListenHandlerImpl implementation of the ReplaceListenHandler interface
+type ReplaceListenHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//DeleteListenHandlerImpl implementation of the DeleteListenHandler interface
+type DeleteListenHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//GetListenServersHandlerImpl implementation of the GetListenServersHandler interface
+type GetListenServersHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//GetListenServerHandlerImpl implementation of the GetListenServerHandler interface
+type GetListenServerHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//CreateListenServerHandlerImpl implementation of the CreateListenServerHandler interface
+type CreateListenServerHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//ReplaceListenServerHandlerImpl implementation of the ReplaceListenServerHandler interface
+type ReplaceListenServerHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//DeleteListenServerHandlerImpl implementation of the DeleteListenServerHandler interface
+type DeleteListenServerHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetListensHandlerImpl) Handle(params listen.GetListensParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListensDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListensDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	names, err := p.SectionsGet(parser.Listen)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListensDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := make([]*listen.GetListensOKBodyDataItems0, 0, len(names))
+	for _, name := range names {
+		l, err := parseListen(p, name)
+		if err != nil {
+			e := misc.HandleError(err)
+			return listen.NewGetListensDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+		}
+		item := listen.GetListensOKBodyDataItems0(*l)
+		data = append(data, &item)
+	}
+	return listen.NewGetListensOK().WithPayload(&listen.GetListensOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *GetListenHandlerImpl) Handle(params listen.GetListenParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	if err := checkSectionExists(p, parser.Listen, params.Name); err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	l, err := parseListen(p, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return listen.NewGetListenOK().WithPayload(&listen.GetListenOKBody{Version: v, Data: l}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateListenHandlerImpl) Handle(params listen.CreateListenParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return listen.NewCreateListenDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	name := *params.Data.Name
+	lines, err := validateListenLines(params.Data.Lines)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewCreateListenDefault(int(*e.Code)).WithPayload(e)
+	}
+	var data *listen.GetListenOKBodyData
+	err = changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		if err := checkSectionExists(p, parser.Listen, name); err == nil {
+			return configuration.NewConfError(configuration.ErrObjectAlreadyExists, fmt.Sprintf("listen %s already exists", name))
+		}
+		if err := p.SectionsCreate(parser.Listen, name); err != nil {
+			return err
+		}
+		if err := setListenLines(p, name, lines); err != nil {
+			return err
+		}
+		data, err = parseListen(p, name)
+		return err
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewCreateListenDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return listen.NewCreateListenDefault(int(*e.Code)).WithPayload(e)
+			}
+			createdBody := listen.CreateListenCreatedBody(*data)
+			return listen.NewCreateListenCreated().WithPayload(&createdBody)
+		}
+		rID := h.ReloadAgent.Reload()
+		acceptedBody := listen.CreateListenAcceptedBody(*data)
+		return listen.NewCreateListenAccepted().WithReloadID(rID).WithPayload(&acceptedBody)
+	}
+	acceptedBody := listen.CreateListenAcceptedBody(*data)
+	return listen.NewCreateListenAccepted().WithPayload(&acceptedBody)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceListenHandlerImpl) Handle(params listen.ReplaceListenParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return listen.NewReplaceListenDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	name := *params.Data.Name
+	lines, err := validateListenLines(params.Data.Lines)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewReplaceListenDefault(int(*e.Code)).WithPayload(e)
+	}
+	var data *listen.GetListenOKBodyData
+	err = changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		if err := checkSectionExists(p, parser.Listen, params.Name); err != nil {
+			return err
+		}
+		if name != params.Name {
+			// rename the section
+			if err := checkSectionExists(p, parser.Listen, name); err == nil {
+				return configuration.NewConfError(configuration.ErrObjectAlreadyExists, fmt.Sprintf("listen %s already exists", name))
+			}
+			if err := p.SectionsDelete(parser.Listen, params.Name); err != nil {
+				return err
+			}
+			if err := p.SectionsCreate(parser.Listen, name); err != nil {
+				return err
+			}
+		}
+		if err := setListenLines(p, name, lines); err != nil {
+			return err
+		}
+		data, err = parseListen(p, name)
+		return err
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewReplaceListenDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return listen.NewReplaceListenDefault(int(*e.Code)).WithPayload(e)
+			}
+			okBody := listen.ReplaceListenOKBody(*data)
+			return listen.NewReplaceListenOK().WithPayload(&okBody)
+		}
+		rID := h.ReloadAgent.Reload()
+		acceptedBody := listen.ReplaceListenAcceptedBody(*data)
+		return listen.NewReplaceListenAccepted().WithReloadID(rID).WithPayload(&acceptedBody)
+	}
+	acceptedBody := listen.ReplaceListenAcceptedBody(*data)
+	return listen.NewReplaceListenAccepted().WithPayload(&acceptedBody)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteListenHandlerImpl) Handle(params listen.DeleteListenParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return listen.NewDeleteListenDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		if err := checkSectionExists(p, parser.Listen, params.Name); err != nil {
+			return err
+		}
+		return p.SectionsDelete(parser.Listen, params.Name)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewDeleteListenDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return listen.NewDeleteListenDefault(int(*e.Code)).WithPayload(e)
+			}
+			return listen.NewDeleteListenNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return listen.NewDeleteListenAccepted().WithReloadID(rID)
+	}
+	return listen.NewDeleteListenAccepted()
+}
+
+//Handle executing the request and returning a response
+func (h *GetListenServersHandlerImpl) Handle(params listen.GetListenServersParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenServersDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenServersDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	scratch, err := listenScratchParser(p, params.Listen)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenServersDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	servers, err := configuration.ParseServers(params.Listen, scratch)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenServersDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return listen.NewGetListenServersOK().WithPayload(&listen.GetListenServersOKBody{Version: v, Data: servers}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *GetListenServerHandlerImpl) Handle(params listen.GetListenServerParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenServerDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenServerDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	scratch, err := listenScratchParser(p, params.Listen)
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewGetListenServerDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	s, _ := configuration.GetServerByName(params.Name, params.Listen, scratch)
+	if s == nil {
+		e := misc.HandleError(configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("Server %s does not exist in listen %s", params.Name, params.Listen)))
+		return listen.NewGetListenServerDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return listen.NewGetListenServerOK().WithPayload(&listen.GetListenServerOKBody{Version: v, Data: s}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateListenServerHandlerImpl) Handle(params listen.CreateListenServerParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return listen.NewCreateListenServerDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeListenServers(h.Client, t, v, params.Listen, func(scratch *parser.Parser) error {
+		if s, _ := configuration.GetServerByName(params.Data.Name, params.Listen, scratch); s != nil {
+			return configuration.NewConfError(configuration.ErrObjectAlreadyExists, fmt.Sprintf("Server %s already exists in listen %s", params.Data.Name, params.Listen))
+		}
+		return scratch.Insert(parser.Backends, params.Listen, "server", configuration.SerializeServer(*params.Data), -1)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewCreateListenServerDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return listen.NewCreateListenServerDefault(int(*e.Code)).WithPayload(e)
+			}
+			return listen.NewCreateListenServerCreated().WithPayload(params.Data)
+		}
+		rID := h.ReloadAgent.Reload()
+		return listen.NewCreateListenServerAccepted().WithReloadID(rID).WithPayload(params.Data)
+	}
+	return listen.NewCreateListenServerAccepted().WithPayload(params.Data)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceListenServerHandlerImpl) Handle(params listen.ReplaceListenServerParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return listen.NewReplaceListenServerDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeListenServers(h.Client, t, v, params.Listen, func(scratch *parser.Parser) error {
+		s, i := configuration.GetServerByName(params.Name, params.Listen, scratch)
+		if s == nil {
+			return configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("Server %s does not exist in listen %s", params.Name, params.Listen))
+		}
+		return scratch.Set(parser.Backends, params.Listen, "server", configuration.SerializeServer(*params.Data), i)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewReplaceListenServerDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return listen.NewReplaceListenServerDefault(int(*e.Code)).WithPayload(e)
+			}
+			return listen.NewReplaceListenServerOK().WithPayload(params.Data)
+		}
+		rID := h.ReloadAgent.Reload()
+		return listen.NewReplaceListenServerAccepted().WithReloadID(rID).WithPayload(params.Data)
+	}
+	return listen.NewReplaceListenServerAccepted().WithPayload(params.Data)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteListenServerHandlerImpl) Handle(params listen.DeleteListenServerParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return listen.NewDeleteListenServerDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeListenServers(h.Client, t, v, params.Listen, func(scratch *parser.Parser) error {
+		s, i := configuration.GetServerByName(params.Name, params.Listen, scratch)
+		if s == nil {
+			return configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("Server %s does not exist in listen %s", params.Name, params.Listen))
+		}
+		return scratch.Delete(parser.Backends, params.Listen, "server", i)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return listen.NewDeleteListenServerDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return listen.NewDeleteListenServerDefault(int(*e.Code)).WithPayload(e)
+			}
+			return listen.NewDeleteListenServerNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return listen.NewDeleteListenServerAccepted().WithReloadID(rID)
+	}
+	return listen.NewDeleteListenServerAccepted()
+}
+
+// getListenLines returns the configuration lines of a listen section
+func getListenLines(p *parser.Parser, name string) ([]string, error) {
+	lines := make([]string, 0)
+	data, err := p.Get(parser.Listen, name, "")
+	if err != nil {
+		if err == parser_errors.ErrFetch {
+			return lines, nil
+		}
+		return nil, err
+	}
+	for _, l := range data.([]types.UnProcessed) {
+		lines = append(lines, l.Value)
+	}
+	return lines, nil
+}
+
+func setListenLines(p *parser.Parser, name string, lines []string) error {
+	if len(lines) == 0 {
+		return p.Set(parser.Listen, name, "", nil)
+	}
+	data := make([]types.UnProcessed, 0, len(lines))
+	for _, l := range lines {
+		data = append(data, types.UnProcessed{Value: l})
+	}
+	return p.Set(parser.Listen, name, "", data)
+}
+
+// validateListenLines trims the lines and rejects the ones starting a new section
+func validateListenLines(lines []string) ([]string, error) {
+	result := make([]string, 0, len(lines))
+	for _, l := range lines {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		if haproxy.IsSectionKeyword(strings.Fields(l)[0]) {
+			return nil, configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("line %s starts a new section", l))
+		}
+		result = append(result, l)
+	}
+	return result, nil
+}
+
+// listenScratchParser parses the listen section lines as a frontend and a backend with the same name
+func listenScratchParser(p *parser.Parser, name string) (*parser.Parser, error) {
+	if err := checkSectionExists(p, parser.Listen, name); err != nil {
+		return nil, err
+	}
+	lines, err := getListenLines(p, name)
+	if err != nil {
+		return nil, err
+	}
+	var sb strings.Builder
+	for _, section := range []parser.Section{parser.Frontends, parser.Backends} {
+		fmt.Fprintf(&sb, "%s %s\n", section, name)
+		for _, l := range lines {
+			fmt.Fprintf(&sb, "  %s\n", l)
+		}
+	}
+	scratch := &parser.Parser{}
+	if err := scratch.ParseData(sb.String()); err != nil {
+		return nil, configuration.NewConfError(configuration.ErrGeneralError, err.Error())
+	}
+	return scratch, nil
+}
+
+func parseListen(p *parser.Parser, name string) (*listen.GetListenOKBodyData, error) {
+	lines, err := getListenLines(p, name)
+	if err != nil {
+		return nil, err
+	}
+	scratch, err := listenScratchParser(p, name)
+	if err != nil {
+		return nil, err
+	}
+	fe := &models.Frontend{Name: name}
+	if err := configuration.ParseSection(fe, parser.Frontends, name, scratch); err != nil {
+		return nil, err
+	}
+	be := &models.Backend{Name: name}
+	if err := configuration.ParseSection(be, parser.Backends, name, scratch); err != nil {
+		return nil, err
+	}
+	binds, err := configuration.ParseBinds(name, scratch)
+	if err != nil {
+		return nil, err
+	}
+	servers, err := configuration.ParseServers(name, scratch)
+	if err != nil {
+		return nil, err
+	}
+	return &listen.GetListenOKBodyData{
+		Name:     &name,
+		Lines:    lines,
+		Frontend: fe,
+		Backend:  be,
+		Binds:    binds,
+		Servers:  servers,
+	}, nil
+}
+
+// changeListenServers applies fn on the servers of the listen scratch parser, and
+// writes the resulting server lines back in place of the previous ones
+func changeListenServers(client *client_native.HAProxyClient, transactionID string, version int64, name string, fn func(scratch *parser.Parser) error) error {
+	return changeParser(client, transactionID, version, func(p *parser.Parser) error {
+		scratch, err := listenScratchParser(p, name)
+		if err != nil {
+			return err
+		}
+		if err := fn(scratch); err != nil {
+			return err
+		}
+		servers := make([]string, 0)
+		data, err := scratch.Get(parser.Backends, name, "server")
+		if err == nil {
+			sp := &parsers.Server{}
+			sp.Init()
+			if err := sp.Set(data, -1); err != nil {
+				return err
+			}
+			result, err := sp.Result()
+			if err != nil && err != parser_errors.ErrFetch {
+				return err
+			}
+			for _, r := range result {
+				servers = append(servers, r.Data)
+			}
+		}
+
+		lines, err := getListenLines(p, name)
+		if err != nil {
+			return err
+		}
+		newLines := make([]string, 0, len(lines)+1)
+		inserted := false
+		for _, l := range lines {
+			if strings.HasPrefix(l, "server ") {
+				if !inserted {
+					newLines = append(newLines, servers...)
+					inserted = true
+				}
+				continue
+			}
+			newLines = append(newLines, l)
+		}
+		if !inserted {
+			newLines = append(newLines, servers...)
+		}
+		return setListenLines(p, name, newLines)
+	})
+}
@@ -0,0 +1,439 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/log_format"
+)
+
+// Types of the sections a log format is applied to
+const (
+	logFormatFrontend = "frontend"
+	logFormatDefaults = "defaults"
+)
+
+// builtinLogFormats reproduce the formats of the tcplog, httplog and clf log options
+var builtinLogFormats = []configuration.LogFormat{
+	{
+		Name:        "tcplog",
+		Format:      "%ci:%cp [%t] %ft %b/%s %Tw/%Tc/%Tt %B %ts %ac/%fc/%bc/%sc/%rc %sq/%bq",
+		Description: "Format of option tcplog",
+	},
+	{
+		Name:        "httplog",
+		Format:      "%ci:%cp [%tr] %ft %b/%s %TR/%Tw/%Tc/%Tr/%Ta %ST %B %CC %CS %tsc %ac/%fc/%bc/%sc/%rc %sq/%bq %hr %hs %{+Q}r",
+		Description: "Format of option httplog",
+	},
+	{
+		Name:        "clf",
+		Format:      `%{+Q}o %{-Q}ci - - [%trg] %r %ST %B "" "" %cp %ms %ft %b %s %TR %Tw %Tc %Tr %Ta %tsc %ac %fc %bc %sc %rc %sq %bq %CC %CS %hrl %hsl`,
+		Description: "Format of option httplog clf",
+	},
+}
+
+//GetLogFormatsHandlerImpl implementation of the GetLogFormatsHandler interface
+type GetLogFormatsHandlerImpl struct {
+	Config    *configuration.Configuration
+	Validator *haproxy.SampleValidator
+}
+
+//CreateLogFormatHandlerImpl implementation of the CreateLogFormatHandler interface
+type CreateLogFormatHandlerImpl struct {
+	Config    *configuration.Configuration
+	Validator *haproxy.SampleValidator
+}
+
+//GetLogFormatHandlerImpl implementation of the GetLogFormatHandler interface
+type GetLogFormatHandlerImpl struct {
+	Config    *configuration.Configuration
+	Validator *haproxy.SampleValidator
+}
+
+//ReplaceLogFormatHandlerImpl implementation of the ReplaceLogFormatHandler interface
+type ReplaceLogFormatHandlerImpl struct {
+	Config    *configuration.Configuration
+	Validator *haproxy.SampleValidator
+}
+
+//DeleteLogFormatHandlerImpl implementation of the DeleteLogFormatHandler interface
+type DeleteLogFormatHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//ApplyLogFormatHandlerImpl implementation of the ApplyLogFormatHandler interface using client-native client
+type ApplyLogFormatHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	Config      *configuration.Configuration
+	Validator   *haproxy.SampleValidator
+}
+
+//ValidateLogFormatHandlerImpl implementation of the ValidateLogFormatHandler interface
+type ValidateLogFormatHandlerImpl struct {
+	Validator *haproxy.SampleValidator
+}
+
+//Handle executing the request and returning a response
+func (h *GetLogFormatsHandlerImpl) Handle(params log_format.GetLogFormatsParams, principal interface{}) middleware.Responder {
+	custom, assignments := h.Config.GetLogFormats()
+	data := make([]*log_format.GetLogFormatsOKBodyItems0, 0, len(builtinLogFormats)+len(custom))
+	for i, f := range append(append([]configuration.LogFormat{}, builtinLogFormats...), custom...) {
+		item := &log_format.GetLogFormatsOKBodyItems0{}
+		if err := convertBody(logFormat(h.Validator, f, i < len(builtinLogFormats), assignments), item); err != nil {
+			e := misc.HandleError(err)
+			return log_format.NewGetLogFormatsDefault(int(*e.Code)).WithPayload(e)
+		}
+		data = append(data, item)
+	}
+	return log_format.NewGetLogFormatsOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateLogFormatHandlerImpl) Handle(params log_format.CreateLogFormatParams, principal interface{}) middleware.Responder {
+	f := configuration.LogFormat{Name: *params.Data.Name, Format: *params.Data.Format, Description: params.Data.Description}
+	err := validateLogFormat(h.Validator, f.Format, "", "")
+	if err == nil {
+		err = h.Config.UpdateLogFormats(func(custom []configuration.LogFormat, assignments []configuration.LogFormatAssignment) ([]configuration.LogFormat, []configuration.LogFormatAssignment, error) {
+			if _, _, ok := findLogFormat(custom, f.Name); ok {
+				return nil, nil, native_configuration.NewConfError(native_configuration.ErrObjectAlreadyExists, fmt.Sprintf("log format %s already exists", f.Name))
+			}
+			return append(custom, f), assignments, nil
+		})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return log_format.NewCreateLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := &log_format.CreateLogFormatCreatedBody{}
+	if err := convertBody(logFormat(h.Validator, f, false, nil), data); err != nil {
+		e := misc.HandleError(err)
+		return log_format.NewCreateLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+	return log_format.NewCreateLogFormatCreated().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *GetLogFormatHandlerImpl) Handle(params log_format.GetLogFormatParams, principal interface{}) middleware.Responder {
+	custom, assignments := h.Config.GetLogFormats()
+	f, builtin, ok := findLogFormat(custom, params.Name)
+	if !ok {
+		e := misc.HandleError(logFormatNotFound(params.Name))
+		return log_format.NewGetLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := &log_format.GetLogFormatOKBody{}
+	if err := convertBody(logFormat(h.Validator, f, builtin, assignments), data); err != nil {
+		e := misc.HandleError(err)
+		return log_format.NewGetLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+	return log_format.NewGetLogFormatOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceLogFormatHandlerImpl) Handle(params log_format.ReplaceLogFormatParams, principal interface{}) middleware.Responder {
+	f := configuration.LogFormat{Name: params.Name, Format: *params.Data.Format, Description: params.Data.Description}
+	var assigned []configuration.LogFormatAssignment
+	err := validateLogFormat(h.Validator, f.Format, "", "")
+	if err == nil {
+		err = h.Config.UpdateLogFormats(func(custom []configuration.LogFormat, assignments []configuration.LogFormatAssignment) ([]configuration.LogFormat, []configuration.LogFormatAssignment, error) {
+			_, builtin, ok := findLogFormat(custom, params.Name)
+			if !ok {
+				return nil, nil, logFormatNotFound(params.Name)
+			}
+			if builtin {
+				return nil, nil, native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("log format %s is built in", params.Name))
+			}
+			for i, c := range custom {
+				if c.Name == params.Name {
+					custom[i] = f
+				}
+			}
+			assigned = assignments
+			return custom, assignments, nil
+		})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return log_format.NewReplaceLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := &log_format.ReplaceLogFormatOKBody{}
+	if err := convertBody(logFormat(h.Validator, f, false, assigned), data); err != nil {
+		e := misc.HandleError(err)
+		return log_format.NewReplaceLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+	return log_format.NewReplaceLogFormatOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteLogFormatHandlerImpl) Handle(params log_format.DeleteLogFormatParams, principal interface{}) middleware.Responder {
+	err := h.Config.UpdateLogFormats(func(custom []configuration.LogFormat, assignments []configuration.LogFormatAssignment) ([]configuration.LogFormat, []configuration.LogFormatAssignment, error) {
+		_, builtin, ok := findLogFormat(custom, params.Name)
+		if !ok {
+			return nil, nil, logFormatNotFound(params.Name)
+		}
+		if builtin {
+			return nil, nil, native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("log format %s is built in", params.Name))
+		}
+		formats := make([]configuration.LogFormat, 0, len(custom))
+		for _, f := range custom {
+			if f.Name != params.Name {
+				formats = append(formats, f)
+			}
+		}
+		kept := make([]configuration.LogFormatAssignment, 0, len(assignments))
+		for _, a := range assignments {
+			if a.Format != params.Name {
+				kept = append(kept, a)
+			}
+		}
+		return formats, kept, nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return log_format.NewDeleteLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+	return log_format.NewDeleteLogFormatNoContent()
+}
+
+//Handle executing the request and returning a response
+func (h *ApplyLogFormatHandlerImpl) Handle(params log_format.ApplyLogFormatParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return log_format.NewApplyLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	custom, _ := h.Config.GetLogFormats()
+	f, builtin, ok := findLogFormat(custom, params.Name)
+	if !ok {
+		e := misc.HandleError(logFormatNotFound(params.Name))
+		return log_format.NewApplyLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	targets := make([]configuration.LogFormatAssignment, 0, len(params.Data.Frontends)+1)
+	for _, name := range params.Data.Frontends {
+		targets = append(targets, configuration.LogFormatAssignment{Format: f.Name, Type: logFormatFrontend, Name: name})
+	}
+	if params.Data.Defaults {
+		targets = append(targets, configuration.LogFormatAssignment{Format: f.Name, Type: logFormatDefaults})
+	}
+
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		for _, target := range targets {
+			if err := applyLogFormat(h.Client, h.Validator, f, target, t); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return log_format.NewApplyLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	var assigned []configuration.LogFormatAssignment
+	err = h.Config.UpdateLogFormats(func(custom []configuration.LogFormat, assignments []configuration.LogFormatAssignment) ([]configuration.LogFormat, []configuration.LogFormatAssignment, error) {
+		kept := make([]configuration.LogFormatAssignment, 0, len(assignments)+len(targets))
+		for _, a := range assignments {
+			replaced := false
+			for _, target := range targets {
+				if a.Type == target.Type && a.Name == target.Name {
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				kept = append(kept, a)
+			}
+		}
+		assigned = append(kept, targets...)
+		return custom, assigned, nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return log_format.NewApplyLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := logFormat(h.Validator, f, builtin, assigned)
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return log_format.NewApplyLogFormatDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := &log_format.ApplyLogFormatOKBody{}
+			if err := convertBody(data, ok); err != nil {
+				e := misc.HandleError(err)
+				return log_format.NewApplyLogFormatDefault(int(*e.Code)).WithPayload(e)
+			}
+			return log_format.NewApplyLogFormatOK().WithPayload(ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := &log_format.ApplyLogFormatAcceptedBody{}
+		if err := convertBody(data, accepted); err != nil {
+			e := misc.HandleError(err)
+			return log_format.NewApplyLogFormatDefault(int(*e.Code)).WithPayload(e)
+		}
+		return log_format.NewApplyLogFormatAccepted().WithReloadID(rID).WithPayload(accepted)
+	}
+	accepted := &log_format.ApplyLogFormatAcceptedBody{}
+	if err := convertBody(data, accepted); err != nil {
+		e := misc.HandleError(err)
+		return log_format.NewApplyLogFormatDefault(int(*e.Code)).WithPayload(e)
+	}
+	return log_format.NewApplyLogFormatAccepted().WithPayload(accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *ValidateLogFormatHandlerImpl) Handle(params log_format.ValidateLogFormatParams, principal interface{}) middleware.Responder {
+	r := h.Validator.CheckLogFormat(*params.Data.Format, params.Data.Mode)
+	return log_format.NewValidateLogFormatOK().WithPayload(&log_format.ValidateLogFormatOKBody{
+		Valid:       len(r.Errors) == 0,
+		Variables:   r.Variables,
+		Expressions: r.Expressions,
+		Errors:      r.Errors,
+		Warnings:    r.Warnings,
+	})
+}
+
+func logFormatNotFound(name string) error {
+	return native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("log format %s does not exist", name))
+}
+
+// findLogFormat returns the built in or custom log format with the name
+func findLogFormat(custom []configuration.LogFormat, name string) (configuration.LogFormat, bool, bool) {
+	for _, f := range builtinLogFormats {
+		if f.Name == name {
+			return f, true, true
+		}
+	}
+	for _, f := range custom {
+		if f.Name == name {
+			return f, false, true
+		}
+	}
+	return configuration.LogFormat{}, false, false
+}
+
+// validateLogFormat checks a log-format string for a proxy in mode, variables
+// only filled in http mode are rejected in tcp mode. section names the section
+// the format is set in for the error message, empty for the library.
+func validateLogFormat(v *haproxy.SampleValidator, format, mode, section string) error {
+	if v == nil || format == "" {
+		return nil
+	}
+	r := v.CheckLogFormat(unquoteLogFormat(format), mode)
+	if mode == "tcp" {
+		r.Errors = append(r.Errors, r.Warnings...)
+	}
+	if len(r.Errors) > 0 {
+		if section != "" {
+			section = " of " + section
+		}
+		return native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("invalid log format%s: %s", section, strings.Join(r.Errors, "; ")))
+	}
+	return nil
+}
+
+func logFormat(v *haproxy.SampleValidator, f configuration.LogFormat, builtin bool, assignments []configuration.LogFormatAssignment) *log_format.CreateLogFormatBody {
+	data := &log_format.CreateLogFormatBody{
+		Name:        misc.StringP(f.Name),
+		Builtin:     builtin,
+		Format:      misc.StringP(f.Format),
+		Description: f.Description,
+		Variables:   make([]string, 0),
+		Frontends:   make([]string, 0),
+	}
+	if v != nil {
+		data.Variables = v.CheckLogFormat(f.Format, "").Variables
+	}
+	for _, a := range assignments {
+		if a.Format != f.Name {
+			continue
+		}
+		switch a.Type {
+		case logFormatFrontend:
+			data.Frontends = append(data.Frontends, a.Name)
+		case logFormatDefaults:
+			data.Defaults = true
+		}
+	}
+	return data
+}
+
+// applyLogFormat sets the log-format of a frontend or of the defaults section
+// in the transaction t, checking the format against its mode
+func applyLogFormat(client *client_native.HAProxyClient, v *haproxy.SampleValidator, f configuration.LogFormat, target configuration.LogFormatAssignment, t string) error {
+	if target.Type == logFormatDefaults {
+		_, defaults, err := client.Configuration.GetDefaultsConfiguration(t)
+		if err != nil {
+			return err
+		}
+		if err := validateLogFormat(v, f.Format, defaults.Mode, "defaults"); err != nil {
+			return err
+		}
+		defaults.LogFormat = quoteLogFormat(f.Format)
+		return client.Configuration.PushDefaultsConfiguration(defaults, t, 0)
+	}
+	_, frontend, err := client.Configuration.GetFrontend(target.Name, t)
+	if err != nil {
+		return err
+	}
+	if err := validateLogFormat(v, f.Format, frontend.Mode, "frontend "+target.Name); err != nil {
+		return err
+	}
+	frontend.LogFormat = quoteLogFormat(f.Format)
+	return client.Configuration.EditFrontend(target.Name, frontend, t, 0)
+}
+
+// quoteLogFormat returns a log-format string as a single quoted argument of the
+// log-format directive
+func quoteLogFormat(format string) string {
+	return `"` + strings.ReplaceAll(format, `"`, `\"`) + `"`
+}
+
+// unquoteLogFormat returns the log-format string of the argument of a
+// log-format directive, as stored in the frontend and defaults models
+func unquoteLogFormat(value string) string {
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return value
+	}
+	return strings.ReplaceAll(value[1:len(value)-1], `\"`, `"`)
+}
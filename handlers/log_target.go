@@ -16,37 +16,64 @@
 package handlers
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/go-openapi/runtime/middleware"
 	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	parser_errors "github.com/haproxytech/config-parser/v2/errors"
+	"github.com/haproxytech/config-parser/v2/types"
 	"github.com/haproxytech/dataplaneapi/haproxy"
 	"github.com/haproxytech/dataplaneapi/misc"
 	"github.com/haproxytech/dataplaneapi/operations/log_target"
 	"github.com/haproxytech/models/v2"
 )
 
-//CreateLogTargetHandlerImpl implementation of the CreateLogTargetHandler interface using client-native client
+// Log targets are handled on the configuration parser so that the log directives of
+// the global and defaults sections are managed too. The parser does not know the
+// sample option: the log directives of a section with a sampled target are kept as
+// unprocessed lines, in their order.
+
+// logFacilities are the syslog facilities accepted by the log directive
+var logFacilities = map[string]bool{
+	"kern": true, "user": true, "mail": true, "daemon": true, "auth": true, "syslog": true,
+	"lpr": true, "news": true, "uucp": true, "cron": true, "auth2": true, "ftp": true,
+	"ntp": true, "audit": true, "alert": true, "cron2": true, "local0": true, "local1": true,
+	"local2": true, "local3": true, "local4": true, "local5": true, "local6": true, "local7": true,
+}
+
+// logLevels are the syslog levels accepted by the log directive
+var logLevels = map[string]bool{
+	"emerg": true, "alert": true, "crit": true, "err": true,
+	"warning": true, "notice": true, "info": true, "debug": true,
+}
+
+//CreateLogTargetHandlerImpl implementation of the CreateLogTargetHandler interface
 type CreateLogTargetHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
 }
 
-//DeleteLogTargetHandlerImpl implementation of the DeleteLogTargetHandler interface using client-native client
+//DeleteLogTargetHandlerImpl implementation of the DeleteLogTargetHandler interface
 type DeleteLogTargetHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
 }
 
-//GetLogTargetHandlerImpl implementation of the GetLogTargetHandler interface using client-native client
+//GetLogTargetHandlerImpl implementation of the GetLogTargetHandler interface
 type GetLogTargetHandlerImpl struct {
 	Client *client_native.HAProxyClient
 }
 
-//GetLogTargetsHandlerImpl implementation of the GetLogTargetsHandler interface using client-native client
+//GetLogTargetsHandlerImpl implementation of the GetLogTargetsHandler interface
 type GetLogTargetsHandlerImpl struct {
 	Client *client_native.HAProxyClient
 }
 
-//ReplaceLogTargetHandlerImpl implementation of the ReplaceLogTargetHandler interface using client-native client
+//ReplaceLogTargetHandlerImpl implementation of the ReplaceLogTargetHandler interface
 type ReplaceLogTargetHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
@@ -73,7 +100,32 @@ func (h *CreateLogTargetHandlerImpl) Handle(params log_target.CreateLogTargetPar
 		return log_target.NewCreateLogTargetDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.CreateLogTarget(params.ParentType, params.ParentName, params.Data, t, v)
+	data := &log_target.GetLogTargetsOKBodyDataItems0{}
+	if err := convertBody(&params.Data, data); err != nil {
+		e := misc.HandleError(err)
+		return log_target.NewCreateLogTargetDefault(int(*e.Code)).WithPayload(e)
+	}
+	if err := validateLogTarget(data, params.ParentType); err != nil {
+		e := misc.HandleError(err)
+		return log_target.NewCreateLogTargetDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		section, name, err := logTargetSection(p, params.ParentType, params.ParentName)
+		if err != nil {
+			return err
+		}
+		targets, err := parseLogTargets(p, section, name)
+		if err != nil {
+			return err
+		}
+		i := int(*data.Index)
+		if i < 0 || i > len(targets) {
+			return configuration.NewConfError(configuration.ErrObjectIndexOutOfRange, fmt.Sprintf("Log target %d in %s %s out of range", i, section, name))
+		}
+		targets = append(targets[:i], append([]*log_target.GetLogTargetsOKBodyDataItems0{data}, targets[i:]...)...)
+		return serializeLogTargets(p, section, name, targets)
+	})
 	if err != nil {
 		e := misc.HandleError(err)
 		return log_target.NewCreateLogTargetDefault(int(*e.Code)).WithPayload(e)
@@ -86,12 +138,21 @@ func (h *CreateLogTargetHandlerImpl) Handle(params log_target.CreateLogTargetPar
 				e := misc.HandleError(err)
 				return log_target.NewCreateLogTargetDefault(int(*e.Code)).WithPayload(e)
 			}
-			return log_target.NewCreateLogTargetCreated().WithPayload(params.Data)
+			createdBody := &log_target.CreateLogTargetCreatedBody{}
+			// nolint:errcheck
+			convertBody(data, createdBody)
+			return log_target.NewCreateLogTargetCreated().WithPayload(createdBody)
 		}
 		rID := h.ReloadAgent.Reload()
-		return log_target.NewCreateLogTargetAccepted().WithReloadID(rID).WithPayload(params.Data)
+		acceptedBody := &log_target.CreateLogTargetAcceptedBody{}
+		// nolint:errcheck
+		convertBody(data, acceptedBody)
+		return log_target.NewCreateLogTargetAccepted().WithReloadID(rID).WithPayload(acceptedBody)
 	}
-	return log_target.NewCreateLogTargetAccepted().WithPayload(params.Data)
+	acceptedBody := &log_target.CreateLogTargetAcceptedBody{}
+	// nolint:errcheck
+	convertBody(data, acceptedBody)
+	return log_target.NewCreateLogTargetAccepted().WithPayload(acceptedBody)
 }
 
 //Handle executing the request and returning a response
@@ -115,7 +176,22 @@ func (h *DeleteLogTargetHandlerImpl) Handle(params log_target.DeleteLogTargetPar
 		return log_target.NewDeleteLogTargetDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.DeleteLogTarget(params.Index, params.ParentType, params.ParentName, t, v)
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		section, name, err := logTargetSection(p, params.ParentType, params.ParentName)
+		if err != nil {
+			return err
+		}
+		targets, err := parseLogTargets(p, section, name)
+		if err != nil {
+			return err
+		}
+		i := int(params.Index)
+		if i < 0 || i >= len(targets) {
+			return logTargetNotFound(params.Index, section, name)
+		}
+		targets = append(targets[:i], targets[i+1:]...)
+		return serializeLogTargets(p, section, name, targets)
+	})
 	if err != nil {
 		e := misc.HandleError(err)
 		return log_target.NewDeleteLogTargetDefault(int(*e.Code)).WithPayload(e)
@@ -143,12 +219,19 @@ func (h *GetLogTargetHandlerImpl) Handle(params log_target.GetLogTargetParams, p
 		t = *params.TransactionID
 	}
 
-	v, logTarget, err := h.Client.Configuration.GetLogTarget(params.Index, params.ParentType, params.ParentName, t)
+	v, targets, section, name, err := getLogTargets(h.Client, params.ParentType, params.ParentName, t)
 	if err != nil {
 		e := misc.HandleError(err)
 		return log_target.NewGetLogTargetDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
 	}
-	return log_target.NewGetLogTargetOK().WithPayload(&log_target.GetLogTargetOKBody{Version: v, Data: logTarget}).WithConfigurationVersion(v)
+	if params.Index < 0 || params.Index >= int64(len(targets)) {
+		e := misc.HandleError(logTargetNotFound(params.Index, section, name))
+		return log_target.NewGetLogTargetDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := &log_target.GetLogTargetOKBodyData{}
+	// nolint:errcheck
+	convertBody(targets[params.Index], data)
+	return log_target.NewGetLogTargetOK().WithPayload(&log_target.GetLogTargetOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
 }
 
 //Handle executing the request and returning a response
@@ -158,12 +241,12 @@ func (h *GetLogTargetsHandlerImpl) Handle(params log_target.GetLogTargetsParams,
 		t = *params.TransactionID
 	}
 
-	v, logTargets, err := h.Client.Configuration.GetLogTargets(params.ParentType, params.ParentName, t)
+	v, targets, _, _, err := getLogTargets(h.Client, params.ParentType, params.ParentName, t)
 	if err != nil {
 		e := misc.HandleError(err)
 		return log_target.NewGetLogTargetsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
 	}
-	return log_target.NewGetLogTargetsOK().WithPayload(&log_target.GetLogTargetsOKBody{Version: v, Data: logTargets}).WithConfigurationVersion(v)
+	return log_target.NewGetLogTargetsOK().WithPayload(&log_target.GetLogTargetsOKBody{Version: v, Data: targets}).WithConfigurationVersion(v)
 }
 
 //Handle executing the request and returning a response
@@ -187,7 +270,33 @@ func (h *ReplaceLogTargetHandlerImpl) Handle(params log_target.ReplaceLogTargetP
 		return log_target.NewReplaceLogTargetDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.EditLogTarget(params.Index, params.ParentType, params.ParentName, params.Data, t, v)
+	data := &log_target.GetLogTargetsOKBodyDataItems0{}
+	if err := convertBody(&params.Data, data); err != nil {
+		e := misc.HandleError(err)
+		return log_target.NewReplaceLogTargetDefault(int(*e.Code)).WithPayload(e)
+	}
+	data.Index = &params.Index
+	if err := validateLogTarget(data, params.ParentType); err != nil {
+		e := misc.HandleError(err)
+		return log_target.NewReplaceLogTargetDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		section, name, err := logTargetSection(p, params.ParentType, params.ParentName)
+		if err != nil {
+			return err
+		}
+		targets, err := parseLogTargets(p, section, name)
+		if err != nil {
+			return err
+		}
+		i := int(params.Index)
+		if i < 0 || i >= len(targets) {
+			return logTargetNotFound(params.Index, section, name)
+		}
+		targets[i] = data
+		return serializeLogTargets(p, section, name, targets)
+	})
 	if err != nil {
 		e := misc.HandleError(err)
 		return log_target.NewReplaceLogTargetDefault(int(*e.Code)).WithPayload(e)
@@ -199,10 +308,261 @@ func (h *ReplaceLogTargetHandlerImpl) Handle(params log_target.ReplaceLogTargetP
 				e := misc.HandleError(err)
 				return log_target.NewReplaceLogTargetDefault(int(*e.Code)).WithPayload(e)
 			}
-			return log_target.NewReplaceLogTargetOK().WithPayload(params.Data)
+			okBody := &log_target.ReplaceLogTargetOKBody{}
+			// nolint:errcheck
+			convertBody(data, okBody)
+			return log_target.NewReplaceLogTargetOK().WithPayload(okBody)
 		}
 		rID := h.ReloadAgent.Reload()
-		return log_target.NewReplaceLogTargetAccepted().WithReloadID(rID).WithPayload(params.Data)
+		acceptedBody := &log_target.ReplaceLogTargetAcceptedBody{}
+		// nolint:errcheck
+		convertBody(data, acceptedBody)
+		return log_target.NewReplaceLogTargetAccepted().WithReloadID(rID).WithPayload(acceptedBody)
+	}
+	acceptedBody := &log_target.ReplaceLogTargetAcceptedBody{}
+	// nolint:errcheck
+	convertBody(data, acceptedBody)
+	return log_target.NewReplaceLogTargetAccepted().WithPayload(acceptedBody)
+}
+
+// getLogTargets returns the configuration version and the log targets of a parent, with its section
+func getLogTargets(client *client_native.HAProxyClient, parentType string, parentName *string, t string) (int64, []*log_target.GetLogTargetsOKBodyDataItems0, parser.Section, string, error) {
+	v, err := client.Configuration.GetVersion(t)
+	if err != nil {
+		return 0, nil, "", "", err
+	}
+	p, err := client.Configuration.GetParser(t)
+	if err != nil {
+		return v, nil, "", "", err
+	}
+	section, name, err := logTargetSection(p, parentType, parentName)
+	if err != nil {
+		return v, nil, "", "", err
+	}
+	targets, err := parseLogTargets(p, section, name)
+	if err != nil {
+		return v, nil, "", "", err
+	}
+	return v, targets, section, name, nil
+}
+
+// logTargetSection returns the section and section name of a log target parent, checking it exists
+func logTargetSection(p *parser.Parser, parentType string, parentName *string) (parser.Section, string, error) {
+	if parentType == "global" {
+		return parser.Global, parser.GlobalSectionName, nil
+	}
+	return proxySection(p, parentType, parentName)
+}
+
+func logTargetNotFound(index int64, section parser.Section, name string) error {
+	return configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("Log target %d does not exist in %s %s", index, section, name))
+}
+
+// validateLogTarget checks the fields the log directive requires together
+func validateLogTarget(data *log_target.GetLogTargetsOKBodyDataItems0, parentType string) error {
+	invalid := func(format string, a ...interface{}) error {
+		return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf(format, a...))
+	}
+	if data.Global || data.Nolog {
+		if parentType == "global" {
+			return invalid("log global and no log are not allowed in the global section")
+		}
+		if data.Global && data.Nolog {
+			return invalid("global and nolog are exclusive")
+		}
+		return nil
+	}
+	if data.Address == "" || data.Facility == "" {
+		return invalid("address and facility are required")
+	}
+	if data.Minlevel != "" && data.Level == "" {
+		return invalid("minlevel requires level")
+	}
+	if data.Sample == nil {
+		return nil
+	}
+	for _, r := range data.Sample.Ranges {
+		low, high, err := parseLogSampleRange(r)
+		if err != nil || low < 1 || high < low || high > *data.Sample.Size {
+			return invalid("sample range %s must be between 1 and %d", r, *data.Sample.Size)
+		}
+	}
+	return nil
+}
+
+func parseLogSampleRange(r string) (int64, int64, error) {
+	bounds := strings.SplitN(r, "-", 2)
+	low, err := strconv.ParseInt(bounds[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(bounds) == 1 {
+		return low, low, nil
+	}
+	high, err := strconv.ParseInt(bounds[1], 10, 64)
+	return low, high, err
+}
+
+// parseLogTargets returns the log targets of a section, the ones known to the
+// configuration parser first, then the ones kept as unprocessed lines
+func parseLogTargets(p *parser.Parser, section parser.Section, name string) ([]*log_target.GetLogTargetsOKBodyDataItems0, error) {
+	targets := []*log_target.GetLogTargetsOKBodyDataItems0{}
+	data, err := p.Get(section, name, "log")
+	if err != nil && err != parser_errors.ErrFetch {
+		return nil, err
+	}
+	if err == nil {
+		for _, l := range data.([]types.Log) {
+			targets = append(targets, &log_target.GetLogTargetsOKBodyDataItems0{
+				Address:  l.Address,
+				Facility: l.Facility,
+				Format:   l.Format,
+				Global:   l.Global,
+				Length:   l.Length,
+				Level:    l.Level,
+				Minlevel: l.MinLevel,
+				Nolog:    l.NoLog,
+			})
+		}
+	}
+	lines, err := getSectionUnprocessed(p, section, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		if target := parseLogTargetLine(l.Value); target != nil {
+			targets = append(targets, target)
+		}
+	}
+	for i, target := range targets {
+		target.Index = misc.Int64P(i)
+	}
+	return targets, nil
+}
+
+// serializeLogTargets replaces the log directives of a section
+func serializeLogTargets(p *parser.Parser, section parser.Section, name string, targets []*log_target.GetLogTargetsOKBodyDataItems0) error {
+	lines, err := getSectionUnprocessed(p, section, name)
+	if err != nil {
+		return err
+	}
+	unprocessed := make([]types.UnProcessed, 0, len(lines)+len(targets))
+	for _, l := range lines {
+		if parseLogTargetLine(l.Value) == nil {
+			unprocessed = append(unprocessed, l)
+		}
+	}
+	sampled := false
+	for _, target := range targets {
+		sampled = sampled || target.Sample != nil
+	}
+	logs := make([]types.Log, 0, len(targets))
+	for _, target := range targets {
+		if sampled {
+			unprocessed = append(unprocessed, types.UnProcessed{Value: logTargetLine(target)})
+			continue
+		}
+		logs = append(logs, types.Log{
+			Address:  target.Address,
+			Facility: target.Facility,
+			Format:   target.Format,
+			Global:   target.Global,
+			Length:   target.Length,
+			Level:    target.Level,
+			MinLevel: target.Minlevel,
+			NoLog:    target.Nolog,
+		})
+	}
+	if len(logs) == 0 {
+		err = p.Set(section, name, "log", nil)
+	} else {
+		err = p.Set(section, name, "log", logs)
+	}
+	if err != nil {
+		return err
+	}
+	return p.Set(section, name, "", unprocessed)
+}
+
+// parseLogTargetLine parses a log directive kept as an unprocessed line, returns nil for any other line
+func parseLogTargetLine(line string) *log_target.GetLogTargetsOKBodyDataItems0 {
+	f := strings.Fields(line)
+	if len(f) == 2 && f[0] == "no" && f[1] == "log" {
+		return &log_target.GetLogTargetsOKBodyDataItems0{Nolog: true}
+	}
+	if len(f) < 2 || f[0] != "log" {
+		return nil
+	}
+	if f[1] == "global" {
+		return &log_target.GetLogTargetsOKBodyDataItems0{Global: true}
+	}
+	target := &log_target.GetLogTargetsOKBodyDataItems0{Address: f[1]}
+	i := 2
+options:
+	for ; i < len(f)-1; i += 2 {
+		switch f[i] {
+		case "len":
+			length, err := strconv.ParseInt(f[i+1], 10, 64)
+			if err != nil {
+				return nil
+			}
+			target.Length = length
+		case "format":
+			target.Format = f[i+1]
+		case "sample":
+			sep := strings.LastIndex(f[i+1], ":")
+			if sep < 0 {
+				return nil
+			}
+			size, err := strconv.ParseInt(f[i+1][sep+1:], 10, 64)
+			if err != nil {
+				return nil
+			}
+			target.Sample = &log_target.GetLogTargetsOKBodyDataItems0Sample{
+				Ranges: strings.Split(f[i+1][:sep], ","),
+				Size:   &size,
+			}
+		default:
+			break options
+		}
+	}
+	if i >= len(f) || !logFacilities[f[i]] {
+		return nil
+	}
+	target.Facility = f[i]
+	if i+1 < len(f) && logLevels[f[i+1]] {
+		target.Level = f[i+1]
+		if i+2 < len(f) && logLevels[f[i+2]] {
+			target.Minlevel = f[i+2]
+		}
+	}
+	return target
+}
+
+// logTargetLine returns the log directive of a log target
+func logTargetLine(target *log_target.GetLogTargetsOKBodyDataItems0) string {
+	if target.Global {
+		return "log global"
+	}
+	if target.Nolog {
+		return "no log"
+	}
+	line := []string{"log", target.Address}
+	if target.Length > 0 {
+		line = append(line, "len", strconv.FormatInt(target.Length, 10))
+	}
+	if target.Format != "" {
+		line = append(line, "format", target.Format)
+	}
+	if target.Sample != nil {
+		line = append(line, "sample", fmt.Sprintf("%s:%d", strings.Join(target.Sample.Ranges, ","), *target.Sample.Size))
+	}
+	line = append(line, target.Facility)
+	if target.Level != "" {
+		line = append(line, target.Level)
+		if target.Minlevel != "" {
+			line = append(line, target.Minlevel)
+		}
 	}
-	return log_target.NewReplaceLogTargetAccepted().WithPayload(params.Data)
+	return strings.Join(line, " ")
 }
@@ -0,0 +1,399 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/maintenance"
+	"github.com/haproxytech/dataplaneapi/system"
+)
+
+// maintenanceFlag is the pattern of the flag ACL file enabling the maintenance mode
+const maintenanceFlag = "on"
+
+// maintenanceMu serializes changes of the maintenance ACL files
+var maintenanceMu sync.Mutex
+
+//GetMaintenancesHandlerImpl implementation of the GetMaintenancesHandler interface using client-native client
+type GetMaintenancesHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	MapsDir string
+}
+
+//GetMaintenanceHandlerImpl implementation of the GetMaintenanceHandler interface using client-native client
+type GetMaintenanceHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	MapsDir string
+}
+
+//ReplaceMaintenanceHandlerImpl implementation of the ReplaceMaintenanceHandler interface using client-native client
+type ReplaceMaintenanceHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	MapsDir     string
+}
+
+//DeleteMaintenanceHandlerImpl implementation of the DeleteMaintenanceHandler interface using client-native client
+type DeleteMaintenanceHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	MapsDir     string
+}
+
+//Handle executing the request and returning a response
+func (h *GetMaintenancesHandlerImpl) Handle(params maintenance.GetMaintenancesParams, principal interface{}) middleware.Responder {
+	_, frontends, err := h.Client.Configuration.GetFrontends("")
+	if err != nil {
+		e := misc.HandleError(err)
+		return maintenance.NewGetMaintenancesDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := make([]*maintenance.GetMaintenancesOKBodyItems0, 0)
+	for _, f := range frontends {
+		m, _, err := getMaintenance(h.Client, h.MapsDir, f.Name)
+		if err != nil {
+			e := misc.HandleError(err)
+			return maintenance.NewGetMaintenancesDefault(int(*e.Code)).WithPayload(e)
+		}
+		if m == nil {
+			continue
+		}
+		item := maintenance.GetMaintenancesOKBodyItems0(*m)
+		data = append(data, &item)
+	}
+	return maintenance.NewGetMaintenancesOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *GetMaintenanceHandlerImpl) Handle(params maintenance.GetMaintenanceParams, principal interface{}) middleware.Responder {
+	m, _, err := getMaintenance(h.Client, h.MapsDir, params.Frontend)
+	if err == nil && m == nil {
+		err = maintenanceNotFound(params.Frontend)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return maintenance.NewGetMaintenanceDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := maintenance.GetMaintenanceOKBody(*m)
+	return maintenance.NewGetMaintenanceOK().WithPayload(&data)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceMaintenanceHandlerImpl) Handle(params maintenance.ReplaceMaintenanceParams, principal interface{}) middleware.Responder {
+	maintenanceMu.Lock()
+	defer maintenanceMu.Unlock()
+
+	current, index, err := getMaintenance(h.Client, h.MapsDir, params.Frontend)
+	if err != nil {
+		e := misc.HandleError(err)
+		return maintenance.NewReplaceMaintenanceDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	// omitted fields keep their current value
+	m := maintenance.ReplaceMaintenanceBody{
+		Frontend:     params.Frontend,
+		Mode:         "errorfile",
+		Status:       503,
+		RedirectCode: 302,
+		Allowlist:    []string{},
+	}
+	if current != nil {
+		m = *current
+	}
+	m.Enabled = params.Data.Enabled
+	if params.Data.Mode != "" {
+		m.Mode = params.Data.Mode
+	}
+	if params.Data.Status != 0 {
+		m.Status = params.Data.Status
+	}
+	if params.Data.Location != "" {
+		m.Location = params.Data.Location
+	}
+	if params.Data.RedirectCode != 0 {
+		m.RedirectCode = params.Data.RedirectCode
+	}
+	if params.Data.Allowlist != nil {
+		m.Allowlist = params.Data.Allowlist
+	}
+	if err := validateMaintenance(&m); err != nil {
+		msg := err.Error()
+		c := misc.ErrHTTPBadRequest
+		return maintenance.NewReplaceMaintenanceBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	flagFile := maintenanceFlagFile(h.MapsDir, params.Frontend)
+	allowFile := maintenanceAllowFile(h.MapsDir, params.Frontend)
+	flag := []string{}
+	if *m.Enabled {
+		flag = append(flag, maintenanceFlag)
+	}
+	if err := writePatternFile(flagFile, flag); err != nil {
+		e := misc.HandleError(err)
+		return maintenance.NewReplaceMaintenanceDefault(int(*e.Code)).WithPayload(e)
+	}
+	if err := writePatternFile(allowFile, m.Allowlist); err != nil {
+		e := misc.HandleError(err)
+		return maintenance.NewReplaceMaintenanceDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	// the rule is only changed when its action changes, HAProxy then has to be
+	// reloaded to load the ACL files
+	if current == nil || current.Mode != m.Mode || current.Status != m.Status ||
+		current.Location != m.Location || current.RedirectCode != m.RedirectCode {
+		v, err := h.Client.Configuration.GetVersion("")
+		if err != nil {
+			e := misc.HandleError(err)
+			return maintenance.NewReplaceMaintenanceDefault(int(*e.Code)).WithPayload(e)
+		}
+		err = changeTransaction(h.Client, "", v, func(t string) error {
+			if index >= 0 {
+				if err := h.Client.Configuration.DeleteHTTPRequestRule(index, "frontend", params.Frontend, t, 0); err != nil {
+					return err
+				}
+			}
+			return h.Client.Configuration.CreateHTTPRequestRule("frontend", params.Frontend, maintenanceRule(&m, flagFile, allowFile), t, 0)
+		})
+		if err != nil {
+			e := misc.HandleError(err)
+			return maintenance.NewReplaceMaintenanceDefault(int(*e.Code)).WithPayload(e)
+		}
+	} else {
+		err := switchMaintenanceRuntime(h.Client, current, &m, flagFile, allowFile)
+		if err == nil {
+			ok := maintenance.ReplaceMaintenanceOKBody(m)
+			return maintenance.NewReplaceMaintenanceOK().WithPayload(&ok)
+		}
+		log.Warningf("Switching maintenance mode of frontend %s through runtime API failed, reloading: %s", params.Frontend, err.Error())
+	}
+	rID := h.ReloadAgent.Reload()
+	accepted := maintenance.ReplaceMaintenanceAcceptedBody(m)
+	return maintenance.NewReplaceMaintenanceAccepted().WithReloadID(rID).WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteMaintenanceHandlerImpl) Handle(params maintenance.DeleteMaintenanceParams, principal interface{}) middleware.Responder {
+	maintenanceMu.Lock()
+	defer maintenanceMu.Unlock()
+
+	m, index, err := getMaintenance(h.Client, h.MapsDir, params.Frontend)
+	if err == nil && m == nil {
+		err = maintenanceNotFound(params.Frontend)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return maintenance.NewDeleteMaintenanceDefault(int(*e.Code)).WithPayload(e)
+	}
+	v, err := h.Client.Configuration.GetVersion("")
+	if err != nil {
+		e := misc.HandleError(err)
+		return maintenance.NewDeleteMaintenanceDefault(int(*e.Code)).WithPayload(e)
+	}
+	err = changeTransaction(h.Client, "", v, func(t string) error {
+		return h.Client.Configuration.DeleteHTTPRequestRule(index, "frontend", params.Frontend, t, 0)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return maintenance.NewDeleteMaintenanceDefault(int(*e.Code)).WithPayload(e)
+	}
+	for _, f := range []string{maintenanceFlagFile(h.MapsDir, params.Frontend), maintenanceAllowFile(h.MapsDir, params.Frontend)} {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			log.Warningf("Removing maintenance file %s: %s", f, err.Error())
+		}
+	}
+	rID := h.ReloadAgent.Reload()
+	return maintenance.NewDeleteMaintenanceAccepted().WithReloadID(rID)
+}
+
+func maintenanceNotFound(frontend string) error {
+	return native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("maintenance mode of frontend %s does not exist", frontend))
+}
+
+// maintenanceFlagFile returns the path of the ACL file holding the maintenance flag of a frontend
+func maintenanceFlagFile(mapsDir, frontend string) string {
+	return filepath.Join(mapsDir, fmt.Sprintf("%s_maintenance.acl", frontend))
+}
+
+// maintenanceAllowFile returns the path of the ACL file holding the maintenance allowlist of a frontend
+func maintenanceAllowFile(mapsDir, frontend string) string {
+	return filepath.Join(mapsDir, fmt.Sprintf("%s_maintenance_allow.acl", frontend))
+}
+
+// maintenanceCondTest returns the condition matching requests in maintenance: the
+// flag file holds the flag and the client address is not in the allowlist file
+func maintenanceCondTest(flagFile, allowFile string) string {
+	return fmt.Sprintf("{ str(%s) -m str -f %s } !{ src -f %s }", maintenanceFlag, flagFile, allowFile)
+}
+
+func maintenanceRule(m *maintenance.ReplaceMaintenanceBody, flagFile, allowFile string) *models.HTTPRequestRule {
+	rule := &models.HTTPRequestRule{
+		Index:    misc.Int64P(0),
+		Cond:     "if",
+		CondTest: maintenanceCondTest(flagFile, allowFile),
+	}
+	if m.Mode == "redirect" {
+		rule.Type = "redirect"
+		rule.RedirType = "location"
+		rule.RedirValue = m.Location
+		rule.RedirCode = &m.RedirectCode
+	} else {
+		rule.Type = "deny"
+		rule.DenyStatus = &m.Status
+	}
+	return rule
+}
+
+func validateMaintenance(m *maintenance.ReplaceMaintenanceBody) error {
+	if m.Mode == "redirect" && m.Location == "" {
+		return fmt.Errorf("location is required in redirect mode")
+	}
+	for _, a := range m.Allowlist {
+		if _, _, err := net.ParseCIDR(a); err != nil && net.ParseIP(a) == nil {
+			return fmt.Errorf("invalid allowlist address: %s", a)
+		}
+	}
+	return nil
+}
+
+// getMaintenance returns the maintenance mode of a frontend and the index of its
+// rule, or nil and -1 when the frontend has no maintenance rule
+func getMaintenance(client *client_native.HAProxyClient, mapsDir, frontend string) (*maintenance.ReplaceMaintenanceBody, int64, error) {
+	_, rules, err := client.Configuration.GetHTTPRequestRules("frontend", frontend, "")
+	if err != nil {
+		return nil, -1, err
+	}
+	flagFile := maintenanceFlagFile(mapsDir, frontend)
+	allowFile := maintenanceAllowFile(mapsDir, frontend)
+	condTest := maintenanceCondTest(flagFile, allowFile)
+	for _, r := range rules {
+		if r.CondTest != condTest {
+			continue
+		}
+		flag, err := readPatternFile(flagFile)
+		if err != nil {
+			return nil, -1, err
+		}
+		allowlist, err := readPatternFile(allowFile)
+		if err != nil {
+			return nil, -1, err
+		}
+		enabled := len(flag) > 0 && flag[0] == maintenanceFlag
+		m := &maintenance.ReplaceMaintenanceBody{
+			Frontend:  frontend,
+			Enabled:   &enabled,
+			Allowlist: allowlist,
+		}
+		if r.Type == "redirect" {
+			m.Mode = "redirect"
+			m.Location = r.RedirValue
+			if r.RedirCode != nil {
+				m.RedirectCode = *r.RedirCode
+			}
+		} else {
+			m.Mode = "errorfile"
+			if r.DenyStatus != nil {
+				m.Status = *r.DenyStatus
+			}
+		}
+		return m, *r.Index, nil
+	}
+	return nil, -1, nil
+}
+
+// switchMaintenanceRuntime applies the flag and allowlist changes on the ACLs
+// loaded by HAProxy
+func switchMaintenanceRuntime(client *client_native.HAProxyClient, current, m *maintenance.ReplaceMaintenanceBody, flagFile, allowFile string) error {
+	if client.Runtime == nil {
+		return native_configuration.NewConfError(native_configuration.ErrGeneralError, "runtime API not configured")
+	}
+	commands := make([]string, 0)
+	if !equalStrings(current.Allowlist, m.Allowlist) {
+		commands = append(commands, fmt.Sprintf("clear acl %s", allowFile))
+		for _, a := range m.Allowlist {
+			commands = append(commands, fmt.Sprintf("add acl %s %s", allowFile, a))
+		}
+	}
+	if *current.Enabled != *m.Enabled {
+		if *m.Enabled {
+			commands = append(commands, fmt.Sprintf("add acl %s %s", flagFile, maintenanceFlag))
+		} else {
+			commands = append(commands, fmt.Sprintf("del acl %s %s", flagFile, maintenanceFlag))
+		}
+	}
+	for _, c := range commands {
+		if err := haproxy.ExecuteRuntimeCommand(client.Runtime, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// readPatternFile returns the patterns of an ACL file, one per line
+func readPatternFile(file string) ([]string, error) {
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	patterns := make([]string, 0)
+	for _, l := range strings.Split(string(raw), "\n") {
+		l = strings.TrimSpace(l)
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		patterns = append(patterns, l)
+	}
+	return patterns, nil
+}
+
+func writePatternFile(file string, patterns []string) error {
+	var b strings.Builder
+	for _, p := range patterns {
+		b.WriteString(p + "\n")
+	}
+	if err := system.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return system.WriteFile(file, []byte(b.String()), 0644)
+}
@@ -16,15 +16,29 @@
 package handlers
 
 import (
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+
 	"github.com/go-openapi/runtime/middleware"
 	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/haproxy"
 	"github.com/haproxytech/dataplaneapi/misc"
 	"github.com/haproxytech/dataplaneapi/operations/maps"
+	"github.com/haproxytech/models/v2"
 )
 
 //MapsCreateRuntimeMapHandlerImpl implementation of the MapsCreateRuntimeMapHandler interface using client-native client
 type MapsCreateRuntimeMapHandlerImpl struct {
 	Client *client_native.HAProxyClient
+	// Quota, if set, rejects the files exceeding the quota of the maps storage
+	Quota *configuration.StorageQuota
+	// TransactionStorage stages the map files uploaded in transactions
+	TransactionStorage *haproxy.TransactionStorage
 }
 
 func (h *MapsCreateRuntimeMapHandlerImpl) Handle(params maps.CreateRuntimeMapParams, principal interface{}) middleware.Responder {
@@ -34,6 +48,24 @@ func (h *MapsCreateRuntimeMapHandlerImpl) Handle(params maps.CreateRuntimeMapPar
 	}
 	defer file.Close()
 
+	if params.TransactionID != nil {
+		return h.stage(*params.TransactionID, file, header)
+	}
+
+	if h.Quota != nil {
+		path, err := h.Client.Runtime.GetMapsPath(header.Filename)
+		if err == nil {
+			err = haproxy.CheckStorageQuota(path, header.Size, h.Quota.MaxFiles, h.Quota.MaxFileSize, h.Quota.MaxSize)
+		}
+		if err != nil {
+			status := misc.GetHTTPStatusFromErr(err)
+			if _, ok := err.(*haproxy.StorageQuotaError); ok {
+				status = http.StatusRequestEntityTooLarge
+			}
+			return maps.NewCreateRuntimeMapDefault(status).WithPayload(misc.SetError(status, err.Error()))
+		}
+	}
+
 	me, err := h.Client.Runtime.CreateMap(file, *header)
 	if err != nil {
 		status := misc.GetHTTPStatusFromErr(err)
@@ -42,6 +74,45 @@ func (h *MapsCreateRuntimeMapHandlerImpl) Handle(params maps.CreateRuntimeMapPar
 	return maps.NewCreateRuntimeMapCreated().WithPayload(me)
 }
 
+// stage stages the uploaded map file in the transaction id, named as the
+// runtime API names the map files it creates
+func (h *MapsCreateRuntimeMapHandlerImpl) stage(id string, file multipart.File, header *multipart.FileHeader) middleware.Responder {
+	if _, err := stagingTransaction(h.Client, h.TransactionStorage, id); err != nil {
+		e := misc.HandleError(err)
+		return maps.NewCreateRuntimeMapDefault(int(*e.Code)).WithPayload(e)
+	}
+	name := header.Filename
+	if filepath.Ext(name) != ".map" {
+		name += ".map"
+	}
+	path, err := h.TransactionStorage.Path("maps", name)
+	if err != nil {
+		c := misc.ErrHTTPBadRequest
+		msg := err.Error()
+		return maps.NewCreateRuntimeMapBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	if h.TransactionStorage.Exists(id, "maps", name) {
+		e := misc.HandleError(native_configuration.NewConfError(native_configuration.ErrObjectAlreadyExists, fmt.Sprintf("map file %s already exists", name)))
+		return maps.NewCreateRuntimeMapConflict().WithPayload(e)
+	}
+	if h.Quota != nil {
+		if err := haproxy.CheckStorageQuota(path, header.Size, h.Quota.MaxFiles, h.Quota.MaxFileSize, h.Quota.MaxSize); err != nil {
+			e := storageError(err)
+			return maps.NewCreateRuntimeMapDefault(int(*e.Code)).WithPayload(e)
+		}
+	}
+	content, err := ioutil.ReadAll(file)
+	if err != nil {
+		e := misc.HandleError(err)
+		return maps.NewCreateRuntimeMapDefault(int(*e.Code)).WithPayload(e)
+	}
+	if _, err := h.TransactionStorage.Stage(id, "maps", name, content); err != nil {
+		e := misc.HandleError(err)
+		return maps.NewCreateRuntimeMapDefault(int(*e.Code)).WithPayload(e)
+	}
+	return maps.NewCreateRuntimeMapAccepted()
+}
+
 //GetMapsHandlerImpl implementation of the GetAllRuntimeMapFilesHandler interface using client-native client
 type GetMapsHandlerImpl struct {
 	Client *client_native.HAProxyClient
@@ -0,0 +1,90 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/configuration"
+)
+
+//MigrateConfigurationHandlerImpl implementation of the MigrateConfigurationHandler interface
+type MigrateConfigurationHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//Handle executing the request and returning a response
+func (h *MigrateConfigurationHandlerImpl) Handle(params configuration.MigrateConfigurationParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	v := int64(0)
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	p, tID, err := loadParserForChange(h.Client, t, v)
+	if err != nil {
+		e := misc.HandleError(err)
+		return configuration.NewMigrateConfigurationDefault(int(*e.Code)).WithPayload(e)
+	}
+	migrated, rewrites, manual := haproxy.MigrateConfiguration(p.String())
+	if len(rewrites) == 0 {
+		if t == "" {
+			// nolint:errcheck
+			h.Client.Configuration.DeleteTransaction(tID)
+			tID = ""
+		}
+	} else {
+		// the transaction is left open, the rewrites are committed once reviewed
+		if err := p.ParseData(migrated); err != nil {
+			e := misc.HandleError(discardParserChange(h.Client, tID, t == "", err))
+			return configuration.NewMigrateConfigurationDefault(int(*e.Code)).WithPayload(e)
+		}
+		if err := saveParser(h.Client, p, tID, false); err != nil {
+			e := misc.HandleError(discardParserChange(h.Client, tID, t == "", err))
+			return configuration.NewMigrateConfigurationDefault(int(*e.Code)).WithPayload(e)
+		}
+	}
+
+	body := &configuration.MigrateConfigurationOKBody{
+		TransactionID: tID,
+		Rewrites:      make([]*configuration.MigrateConfigurationOKBodyRewritesItems0, 0, len(rewrites)),
+		Manual:        make([]*configuration.MigrateConfigurationOKBodyManualItems0, 0, len(manual)),
+	}
+	for _, r := range rewrites {
+		body.Rewrites = append(body.Rewrites, &configuration.MigrateConfigurationOKBodyRewritesItems0{
+			Line:        int64(r.Line),
+			Section:     r.Section,
+			Directive:   r.Directive,
+			Replacement: r.Replacement,
+			Message:     r.Message,
+		})
+	}
+	for _, r := range manual {
+		body.Manual = append(body.Manual, &configuration.MigrateConfigurationOKBodyManualItems0{
+			Line:      int64(r.Line),
+			Section:   r.Section,
+			Directive: r.Directive,
+			Message:   r.Message,
+		})
+	}
+	return configuration.NewMigrateConfigurationOK().WithPayload(body)
+}
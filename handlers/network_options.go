@@ -0,0 +1,439 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/params"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/bind"
+	"github.com/haproxytech/dataplaneapi/operations/server"
+)
+
+// Network options are not part of the server and bind models, they are read and
+// written on the parsed lines. Servers keep them in configuration order, usesrc
+// and interface being arguments of source.
+var (
+	serverNetworkOptions = []string{"source", "usesrc", "interface", "namespace"}
+	bindNetworkOptions   = []string{"interface", "namespace"}
+
+	// serverKeptOptions and bindKeptOptions are the options kept when a server
+	// or a bind is replaced
+	serverKeptOptions = append(append([]string{}, serverNetworkOptions...), serverSSLOptions...)
+	bindKeptOptions   = append(append([]string{}, bindNetworkOptions...), bindSSLOptions...)
+)
+
+//GetServerNetworkHandlerImpl implementation of the GetServerNetworkHandler interface using client-native client
+type GetServerNetworkHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceServerNetworkHandlerImpl implementation of the ReplaceServerNetworkHandler interface using client-native client
+type ReplaceServerNetworkHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//GetBindNetworkHandlerImpl implementation of the GetBindNetworkHandler interface using client-native client
+type GetBindNetworkHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceBindNetworkHandlerImpl implementation of the ReplaceBindNetworkHandler interface using client-native client
+type ReplaceBindNetworkHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetServerNetworkHandlerImpl) Handle(params server.GetServerNetworkParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return server.NewGetServerNetworkDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return server.NewGetServerNetworkDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	opts, err := getServerNetwork(p, params.Backend, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return server.NewGetServerNetworkDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := server.GetServerNetworkOKBodyData(serverNetwork(opts))
+	return server.NewGetServerNetworkOK().WithPayload(&server.GetServerNetworkOKBody{Version: v, Data: &data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceServerNetworkHandlerImpl) Handle(params server.ReplaceServerNetworkParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return server.NewReplaceServerNetworkDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	opts := map[string]string{
+		"source":    params.Data.Source,
+		"usesrc":    params.Data.Usesrc,
+		"interface": params.Data.Interface,
+		"namespace": params.Data.Namespace,
+	}
+	if opts["source"] == "" && (opts["usesrc"] != "" || opts["interface"] != "") {
+		msg := "usesrc and interface require source"
+		c := misc.ErrHTTPBadRequest
+		return server.NewReplaceServerNetworkBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		return setServerNetwork(p, params.Backend, params.Name, opts)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return server.NewReplaceServerNetworkDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return server.NewReplaceServerNetworkDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := server.ReplaceServerNetworkOKBody(params.Data)
+			return server.NewReplaceServerNetworkOK().WithPayload(&ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := server.ReplaceServerNetworkAcceptedBody(params.Data)
+		return server.NewReplaceServerNetworkAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := server.ReplaceServerNetworkAcceptedBody(params.Data)
+	return server.NewReplaceServerNetworkAccepted().WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *GetBindNetworkHandlerImpl) Handle(params bind.GetBindNetworkParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewGetBindNetworkDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewGetBindNetworkDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	opts, err := getBindNetwork(p, params.Frontend, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewGetBindNetworkDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := &bind.GetBindNetworkOKBodyData{Interface: opts["interface"], Namespace: opts["namespace"]}
+	return bind.NewGetBindNetworkOK().WithPayload(&bind.GetBindNetworkOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceBindNetworkHandlerImpl) Handle(params bind.ReplaceBindNetworkParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return bind.NewReplaceBindNetworkDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	opts := map[string]string{
+		"interface": params.Data.Interface,
+		"namespace": params.Data.Namespace,
+	}
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		return setBindNetwork(p, params.Frontend, params.Name, opts)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return bind.NewReplaceBindNetworkDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return bind.NewReplaceBindNetworkDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := bind.ReplaceBindNetworkOKBody(params.Data)
+			return bind.NewReplaceBindNetworkOK().WithPayload(&ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := bind.ReplaceBindNetworkAcceptedBody(params.Data)
+		return bind.NewReplaceBindNetworkAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := bind.ReplaceBindNetworkAcceptedBody(params.Data)
+	return bind.NewReplaceBindNetworkAccepted().WithPayload(&accepted)
+}
+
+func serverNetwork(opts map[string]string) server.ReplaceServerNetworkBody {
+	return server.ReplaceServerNetworkBody{
+		Source:    opts["source"],
+		Usesrc:    opts["usesrc"],
+		Interface: opts["interface"],
+		Namespace: opts["namespace"],
+	}
+}
+
+func getServerLine(p *parser.Parser, backend, name string) (types.Server, int, error) {
+	if s, i := configuration.GetServerByName(name, backend, p); s != nil {
+		data, err := p.GetOne(parser.Backends, backend, "server", i)
+		if err != nil {
+			return types.Server{}, 0, err
+		}
+		return data.(types.Server), i, nil
+	}
+	return types.Server{}, 0, configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("server %s does not exist in backend %s", name, backend))
+}
+
+func getBindLine(p *parser.Parser, frontend, name string) (types.Bind, int, error) {
+	if b, i := configuration.GetBindByName(name, frontend, p); b != nil {
+		data, err := p.GetOne(parser.Frontends, frontend, "bind", i)
+		if err != nil {
+			return types.Bind{}, 0, err
+		}
+		return data.(types.Bind), i, nil
+	}
+	return types.Bind{}, 0, configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("bind %s does not exist in frontend %s", name, frontend))
+}
+
+// getServerNetwork returns the network options set on a server, by option name
+func getServerNetwork(p *parser.Parser, backend, name string) (map[string]string, error) {
+	serverParams, err := getServerParams(p, backend, name, serverNetworkOptions)
+	if err != nil {
+		return nil, err
+	}
+	opts := make(map[string]string)
+	for _, o := range serverParams {
+		if v, ok := o.(*params.ServerOptionValue); ok {
+			opts[v.Name] = v.Value
+		}
+	}
+	return opts, nil
+}
+
+// setServerNetwork replaces the network options of a server, empty values are removed
+func setServerNetwork(p *parser.Parser, backend, name string, opts map[string]string) error {
+	serverParams := make([]params.ServerOption, 0, len(serverNetworkOptions))
+	for _, n := range serverNetworkOptions {
+		if opts[n] != "" {
+			serverParams = append(serverParams, &params.ServerOptionValue{Name: n, Value: opts[n]})
+		}
+	}
+	return setServerParams(p, backend, name, serverNetworkOptions, serverParams)
+}
+
+// getServerParams returns the options of a server whose keyword is one of names
+func getServerParams(p *parser.Parser, backend, name string, names []string) ([]params.ServerOption, error) {
+	line, _, err := getServerLine(p, backend, name)
+	if err != nil {
+		return nil, err
+	}
+	serverParams := make([]params.ServerOption, 0)
+	for _, o := range line.Params {
+		if containsOption(serverOptionName(o), names) {
+			serverParams = append(serverParams, o)
+		}
+	}
+	return serverParams, nil
+}
+
+// setServerParams replaces the options of a server whose keyword is one of names with opts
+func setServerParams(p *parser.Parser, backend, name string, names []string, opts []params.ServerOption) error {
+	line, i, err := getServerLine(p, backend, name)
+	if err != nil {
+		return err
+	}
+	serverParams := make([]params.ServerOption, 0, len(line.Params)+len(opts))
+	for _, o := range line.Params {
+		if !containsOption(serverOptionName(o), names) {
+			serverParams = append(serverParams, o)
+		}
+	}
+	line.Params = append(serverParams, opts...)
+	return p.Set(parser.Backends, backend, "server", line, i)
+}
+
+func serverOptionName(o params.ServerOption) string {
+	switch v := o.(type) {
+	case *params.ServerOptionWord:
+		return v.Name
+	case *params.ServerOptionDoubleWord:
+		return v.Name
+	case *params.ServerOptionValue:
+		return v.Name
+	}
+	return ""
+}
+
+// getBindNetwork returns the network options set on a bind, by option name
+func getBindNetwork(p *parser.Parser, frontend, name string) (map[string]string, error) {
+	bindParams, err := getBindParams(p, frontend, name, bindNetworkOptions)
+	if err != nil {
+		return nil, err
+	}
+	opts := make(map[string]string)
+	for _, o := range bindParams {
+		if v, ok := o.(*params.BindOptionValue); ok {
+			opts[v.Name] = v.Value
+		}
+	}
+	return opts, nil
+}
+
+// setBindNetwork replaces the network options of a bind, empty values are removed
+func setBindNetwork(p *parser.Parser, frontend, name string, opts map[string]string) error {
+	bindParams := make([]params.BindOption, 0, len(bindNetworkOptions))
+	for _, n := range bindNetworkOptions {
+		if opts[n] != "" {
+			bindParams = append(bindParams, &params.BindOptionValue{Name: n, Value: opts[n]})
+		}
+	}
+	return setBindParams(p, frontend, name, bindNetworkOptions, bindParams)
+}
+
+// getBindParams returns the options of a bind whose keyword is one of names
+func getBindParams(p *parser.Parser, frontend, name string, names []string) ([]params.BindOption, error) {
+	line, _, err := getBindLine(p, frontend, name)
+	if err != nil {
+		return nil, err
+	}
+	bindParams := make([]params.BindOption, 0)
+	for _, o := range line.Params {
+		if containsOption(bindOptionName(o), names) {
+			bindParams = append(bindParams, o)
+		}
+	}
+	return bindParams, nil
+}
+
+// setBindParams replaces the options of a bind whose keyword is one of names with opts
+func setBindParams(p *parser.Parser, frontend, name string, names []string, opts []params.BindOption) error {
+	line, i, err := getBindLine(p, frontend, name)
+	if err != nil {
+		return err
+	}
+	bindParams := make([]params.BindOption, 0, len(line.Params)+len(opts))
+	for _, o := range line.Params {
+		if !containsOption(bindOptionName(o), names) {
+			bindParams = append(bindParams, o)
+		}
+	}
+	line.Params = append(bindParams, opts...)
+	return p.Set(parser.Frontends, frontend, "bind", line, i)
+}
+
+func bindOptionName(o params.BindOption) string {
+	switch v := o.(type) {
+	case *params.BindOptionWord:
+		return v.Name
+	case *params.BindOptionDoubleWord:
+		return v.Name
+	case *params.BindOptionValue:
+		return v.Name
+	}
+	return ""
+}
+
+// keepServerParams sets back the options of a server not in the server model after
+// client-native replaced its line, which drops the options it does not know
+func keepServerParams(client *client_native.HAProxyClient, backend, name string, opts []params.ServerOption, t string) error {
+	if len(opts) == 0 {
+		return nil
+	}
+	p, err := client.Configuration.GetParser(t)
+	if err != nil {
+		return err
+	}
+	if err := setServerParams(p, backend, name, serverKeptOptions, opts); err != nil {
+		return err
+	}
+	return saveParser(client, p, t, false)
+}
+
+// keepBindParams sets back the options of a bind not in the bind model after
+// client-native replaced its line, which drops the options it does not know
+func keepBindParams(client *client_native.HAProxyClient, frontend, name string, opts []params.BindOption, t string) error {
+	if len(opts) == 0 {
+		return nil
+	}
+	p, err := client.Configuration.GetParser(t)
+	if err != nil {
+		return err
+	}
+	if err := setBindParams(p, frontend, name, bindKeptOptions, opts); err != nil {
+		return err
+	}
+	return saveParser(client, p, t, false)
+}
+
+// containsOption returns true if name is one of options
+func containsOption(name string, options []string) bool {
+	for _, o := range options {
+		if o == name {
+			return true
+		}
+	}
+	return false
+}
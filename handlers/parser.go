@@ -0,0 +1,167 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"path/filepath"
+
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	parser_errors "github.com/haproxytech/config-parser/v2/errors"
+	"github.com/haproxytech/config-parser/v2/types"
+)
+
+// Helpers for configuration not yet covered by client-native. They follow the same
+// transaction handling as client-native: changes are made on the transaction parser,
+// and when only a version is given an implicit transaction is started and committed.
+
+// loadParserForChange returns the parser to change and the transaction it belongs to
+func loadParserForChange(client *client_native.HAProxyClient, transactionID string, version int64) (*parser.Parser, string, error) {
+	if transactionID != "" && version != 0 {
+		return nil, "", configuration.NewConfError(configuration.ErrBothVersionTransaction, "Both version and transaction specified, specify only one")
+	}
+	if transactionID == "" && version == 0 {
+		return nil, "", configuration.NewConfError(configuration.ErrNoVersionTransaction, "Version or transaction not specified, specify only one")
+	}
+	t := transactionID
+	if t == "" {
+		tr, err := client.Configuration.StartTransaction(version)
+		if err != nil {
+			return nil, "", err
+		}
+		t = tr.ID
+	}
+	p, err := client.Configuration.GetParser(t)
+	if err != nil {
+		if transactionID == "" {
+			// nolint:errcheck
+			client.Configuration.DeleteTransaction(t)
+		}
+		return nil, "", err
+	}
+	return p, t, nil
+}
+
+// saveParser persists the transaction parser and commits implicit transactions
+func saveParser(client *client_native.HAProxyClient, p *parser.Parser, t string, commitImplicit bool) error {
+	if client.Configuration.PersistentTransactions {
+		tFile := filepath.Join(client.Configuration.TransactionDir, filepath.Base(filepath.Clean(client.Configuration.ConfigurationFile))+"."+t)
+		if err := p.Save(tFile); err != nil {
+			e := configuration.NewConfError(configuration.ErrErrorChangingConfig, err.Error())
+			if commitImplicit {
+				// nolint:errcheck
+				client.Configuration.DeleteTransaction(t)
+			}
+			return e
+		}
+	}
+	if commitImplicit {
+		if _, err := client.Configuration.CommitTransaction(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changeParser applies fn on the transaction parser and saves the change
+func changeParser(client *client_native.HAProxyClient, transactionID string, version int64, fn func(p *parser.Parser) error) error {
+	p, t, err := loadParserForChange(client, transactionID, version)
+	if err != nil {
+		return err
+	}
+	if err := fn(p); err != nil {
+		return discardParserChange(client, t, transactionID == "", err)
+	}
+	return saveParser(client, p, t, transactionID == "")
+}
+
+// discardParserChange deletes an implicit transaction after a failed change
+func discardParserChange(client *client_native.HAProxyClient, t string, implicit bool, err error) error {
+	if implicit {
+		// nolint:errcheck
+		client.Configuration.DeleteTransaction(t)
+	}
+	return err
+}
+
+// checkSectionExists returns an error if the section does not exist in the parser
+func checkSectionExists(p *parser.Parser, section parser.Section, name string) error {
+	names, err := p.SectionsGet(section)
+	if err == nil {
+		for _, n := range names {
+			if n == name {
+				return nil
+			}
+		}
+	}
+	return configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("%s %s does not exist", section, name))
+}
+
+// proxySection returns the section and section name of a frontend, a backend or
+// the defaults section from parent_type and parent_name, checking it exists
+func proxySection(p *parser.Parser, parentType string, parentName *string) (parser.Section, string, error) {
+	if parentType == "defaults" {
+		return parser.Defaults, parser.DefaultSectionName, nil
+	}
+	if parentName == nil || *parentName == "" {
+		return "", "", configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("parent_name is required for %s", parentType))
+	}
+	section := parser.Frontends
+	if parentType == "backend" {
+		section = parser.Backends
+	}
+	if err := checkSectionExists(p, section, *parentName); err != nil {
+		return "", "", err
+	}
+	return section, *parentName, nil
+}
+
+// getSectionUnprocessed returns the lines of a section not handled by the configuration parser
+func getSectionUnprocessed(p *parser.Parser, section parser.Section, name string) ([]types.UnProcessed, error) {
+	data, err := p.Get(section, name, "")
+	if err != nil {
+		if err == parser_errors.ErrFetch {
+			return []types.UnProcessed{}, nil
+		}
+		return nil, err
+	}
+	return data.([]types.UnProcessed), nil
+}
+
+// changeTransaction applies fn, which changes the configuration through client-native
+// calls, in the given transaction or in an implicit one committed when fn succeeds
+func changeTransaction(client *client_native.HAProxyClient, transactionID string, version int64, fn func(t string) error) error {
+	if transactionID != "" && version != 0 {
+		return configuration.NewConfError(configuration.ErrBothVersionTransaction, "Both version and transaction specified, specify only one")
+	}
+	if transactionID == "" && version == 0 {
+		return configuration.NewConfError(configuration.ErrNoVersionTransaction, "Version or transaction not specified, specify only one")
+	}
+	if transactionID != "" {
+		return fn(transactionID)
+	}
+	tr, err := client.Configuration.StartTransaction(version)
+	if err != nil {
+		return err
+	}
+	if err := fn(tr.ID); err != nil {
+		return discardParserChange(client, tr.ID, true, err)
+	}
+	_, err = client.Configuration.CommitTransaction(tr.ID)
+	return err
+}
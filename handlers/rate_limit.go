@@ -0,0 +1,531 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	native_misc "github.com/haproxytech/client-native/v2/misc"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/rate_limit"
+)
+
+// rateLimitBackendPrefix prefixes the names of the backends holding the stick
+// tables of rate limit policies
+const rateLimitBackendPrefix = "ratelimit_"
+
+var (
+	rateLimitDenyRegexp  = regexp.MustCompile(`^\{ sc_http_req_rate\((\d),(\S+)\) gt (\d+) \}$`)
+	rateLimitStoreRegexp = regexp.MustCompile(`http_req_rate\(([^)]+)\)`)
+)
+
+//GetRateLimitsHandlerImpl implementation of the GetRateLimitsHandler interface using client-native client
+type GetRateLimitsHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//GetRateLimitHandlerImpl implementation of the GetRateLimitHandler interface using client-native client
+type GetRateLimitHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//CreateRateLimitHandlerImpl implementation of the CreateRateLimitHandler interface using client-native client
+type CreateRateLimitHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//ReplaceRateLimitHandlerImpl implementation of the ReplaceRateLimitHandler interface using client-native client
+type ReplaceRateLimitHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//DeleteRateLimitHandlerImpl implementation of the DeleteRateLimitHandler interface using client-native client
+type DeleteRateLimitHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//GetRateLimitOffendersHandlerImpl implementation of the GetRateLimitOffendersHandler interface using client-native client
+type GetRateLimitOffendersHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//Handle executing the request and returning a response
+func (h *GetRateLimitsHandlerImpl) Handle(params rate_limit.GetRateLimitsParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return rate_limit.NewGetRateLimitsDefault(int(*e.Code)).WithPayload(e)
+	}
+	policies, err := getRateLimits(h.Client, t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return rate_limit.NewGetRateLimitsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := make([]*rate_limit.GetRateLimitsOKBodyDataItems0, 0, len(policies))
+	for _, p := range policies {
+		if params.Frontend != nil && *p.Frontend != *params.Frontend {
+			continue
+		}
+		item := rate_limit.GetRateLimitsOKBodyDataItems0(*p)
+		data = append(data, &item)
+	}
+	return rate_limit.NewGetRateLimitsOK().WithPayload(&rate_limit.GetRateLimitsOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *GetRateLimitHandlerImpl) Handle(params rate_limit.GetRateLimitParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return rate_limit.NewGetRateLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := getRateLimit(h.Client, params.Name, t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return rate_limit.NewGetRateLimitDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := rate_limit.GetRateLimitOKBodyData(*p)
+	return rate_limit.NewGetRateLimitOK().WithPayload(&rate_limit.GetRateLimitOKBody{Version: v, Data: &data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateRateLimitHandlerImpl) Handle(params rate_limit.CreateRateLimitParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return rate_limit.NewCreateRateLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	p := params.Data
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		return createRateLimit(h.Client, &p, t)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return rate_limit.NewCreateRateLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	created := rate_limit.CreateRateLimitCreatedBody(p)
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return rate_limit.NewCreateRateLimitDefault(int(*e.Code)).WithPayload(e)
+			}
+			return rate_limit.NewCreateRateLimitCreated().WithPayload(&created)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := rate_limit.CreateRateLimitAcceptedBody(p)
+		return rate_limit.NewCreateRateLimitAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := rate_limit.CreateRateLimitAcceptedBody(p)
+	return rate_limit.NewCreateRateLimitAccepted().WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceRateLimitHandlerImpl) Handle(params rate_limit.ReplaceRateLimitParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return rate_limit.NewReplaceRateLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	p := rate_limit.CreateRateLimitBody(params.Data)
+	p.Name = misc.StringP(params.Name)
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		if err := deleteRateLimit(h.Client, params.Name, t); err != nil {
+			return err
+		}
+		return createRateLimit(h.Client, &p, t)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return rate_limit.NewReplaceRateLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	replaced := rate_limit.ReplaceRateLimitOKBody(p)
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return rate_limit.NewReplaceRateLimitDefault(int(*e.Code)).WithPayload(e)
+			}
+			return rate_limit.NewReplaceRateLimitOK().WithPayload(&replaced)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := rate_limit.ReplaceRateLimitAcceptedBody(p)
+		return rate_limit.NewReplaceRateLimitAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := rate_limit.ReplaceRateLimitAcceptedBody(p)
+	return rate_limit.NewReplaceRateLimitAccepted().WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteRateLimitHandlerImpl) Handle(params rate_limit.DeleteRateLimitParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return rate_limit.NewDeleteRateLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		return deleteRateLimit(h.Client, params.Name, t)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return rate_limit.NewDeleteRateLimitDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return rate_limit.NewDeleteRateLimitDefault(int(*e.Code)).WithPayload(e)
+			}
+			return rate_limit.NewDeleteRateLimitNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return rate_limit.NewDeleteRateLimitAccepted().WithReloadID(rID)
+	}
+	return rate_limit.NewDeleteRateLimitAccepted()
+}
+
+//Handle executing the request and returning a response
+func (h *GetRateLimitOffendersHandlerImpl) Handle(params rate_limit.GetRateLimitOffendersParams, principal interface{}) middleware.Responder {
+	p, err := getRateLimit(h.Client, params.Name, "")
+	if err != nil {
+		e := misc.HandleError(err)
+		return rate_limit.NewGetRateLimitOffendersDefault(int(*e.Code)).WithPayload(e)
+	}
+	if h.Client.Runtime == nil {
+		msg := "Runtime API not configured"
+		c := misc.ErrHTTPInternalServerError
+		return rate_limit.NewGetRateLimitOffendersDefault(int(c)).WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	entries, err := h.Client.Runtime.GetTableEntries(rateLimitBackendPrefix+params.Name, int(*params.Process), nil, "")
+	if err != nil {
+		e := misc.HandleError(err)
+		return rate_limit.NewGetRateLimitOffendersDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	offenders := make([]*rate_limit.GetRateLimitOffendersOKBodyItems0, 0, len(entries))
+	for _, e := range entries {
+		if e.HTTPReqRate == nil {
+			continue
+		}
+		offenders = append(offenders, &rate_limit.GetRateLimitOffendersOKBodyItems0{
+			Key:         e.Key,
+			HTTPReqRate: *e.HTTPReqRate,
+			Denied:      *e.HTTPReqRate > *p.Limit,
+		})
+	}
+	sort.SliceStable(offenders, func(i, j int) bool {
+		return offenders[i].HTTPReqRate > offenders[j].HTTPReqRate
+	})
+	if int64(len(offenders)) > *params.Count {
+		offenders = offenders[:*params.Count]
+	}
+	return rate_limit.NewGetRateLimitOffendersOK().WithPayload(offenders)
+}
+
+// getRateLimits reads rate limit policies from the backends holding their stick
+// tables and the http-request rules of the frontends using them
+func getRateLimits(client *client_native.HAProxyClient, t string) ([]*rate_limit.CreateRateLimitBody, error) {
+	_, backends, err := client.Configuration.GetBackends(t)
+	if err != nil {
+		return nil, err
+	}
+	_, frontends, err := client.Configuration.GetFrontends(t)
+	if err != nil {
+		return nil, err
+	}
+	rules := make(map[string]models.HTTPRequestRules)
+	for _, f := range frontends {
+		_, r, err := client.Configuration.GetHTTPRequestRules("frontend", f.Name, t)
+		if err != nil {
+			return nil, err
+		}
+		rules[f.Name] = r
+	}
+
+	policies := make([]*rate_limit.CreateRateLimitBody, 0)
+	for _, b := range backends {
+		if !strings.HasPrefix(b.Name, rateLimitBackendPrefix) || b.StickTable == nil {
+			continue
+		}
+		p := &rate_limit.CreateRateLimitBody{
+			Name:     misc.StringP(strings.TrimPrefix(b.Name, rateLimitBackendPrefix)),
+			Frontend: misc.StringP(""),
+			Limit:    misc.Int64P(0),
+		}
+		if b.StickTable.Size != nil {
+			p.TableSize = *b.StickTable.Size
+		}
+		if b.StickTable.Expire != nil {
+			p.Expire = *b.StickTable.Expire
+		}
+		if m := rateLimitStoreRegexp.FindStringSubmatch(b.StickTable.Store); m != nil {
+			if period := native_misc.ParseTimeout(m[1]); period != nil {
+				p.Period = *period
+			}
+		}
+		for _, f := range frontends {
+			if parseRateLimitRules(p, b.Name, rules[f.Name]) {
+				p.Frontend = misc.StringP(f.Name)
+				break
+			}
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+func getRateLimit(client *client_native.HAProxyClient, name, t string) (*rate_limit.CreateRateLimitBody, error) {
+	policies, err := getRateLimits(client, t)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range policies {
+		if *p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("rate limit policy %s does not exist", name))
+}
+
+// parseRateLimitRules sets the policy fields read from its track and deny rules,
+// and returns true if the rules use the policy stick table
+func parseRateLimitRules(p *rate_limit.CreateRateLimitBody, table string, rules models.HTTPRequestRules) bool {
+	found := false
+	for _, r := range rules {
+		if counter, key, t, ok := trackRule(r); ok && t == table {
+			p.StickyCounter = counter
+			p.Key = key
+			found = true
+			continue
+		}
+		if r.Type != "deny" {
+			continue
+		}
+		m := rateLimitDenyRegexp.FindStringSubmatch(r.CondTest)
+		if m == nil || m[2] != table {
+			continue
+		}
+		limit, _ := strconv.ParseInt(m[3], 10, 64)
+		p.Limit = &limit
+		p.DenyStatus = 403
+		if r.DenyStatus != nil {
+			p.DenyStatus = *r.DenyStatus
+		}
+	}
+	return found
+}
+
+// trackRule returns the sticky counter, key and table of track-sc rules
+func trackRule(r *models.HTTPRequestRule) (int64, string, string, bool) {
+	switch r.Type {
+	case "track-sc0":
+		return 0, r.TrackSc0Key, r.TrackSc0Table, true
+	case "track-sc1":
+		return 1, r.TrackSc1Key, r.TrackSc1Table, true
+	case "track-sc2":
+		return 2, r.TrackSc2Key, r.TrackSc2Table, true
+	}
+	return 0, "", "", false
+}
+
+// rateLimitRuleIndexes returns the indexes of the http-request rules of a policy
+func rateLimitRuleIndexes(rules models.HTTPRequestRules, table string) []int64 {
+	indexes := make([]int64, 0, 2)
+	for _, r := range rules {
+		if _, _, t, ok := trackRule(r); ok && t == table {
+			indexes = append(indexes, *r.Index)
+			continue
+		}
+		if m := rateLimitDenyRegexp.FindStringSubmatch(r.CondTest); r.Type == "deny" && m != nil && m[2] == table {
+			indexes = append(indexes, *r.Index)
+		}
+	}
+	return indexes
+}
+
+func createRateLimit(client *client_native.HAProxyClient, p *rate_limit.CreateRateLimitBody, t string) error {
+	if p.Key == "" {
+		p.Key = "src"
+	}
+	if p.Period == 0 {
+		p.Period = 10000
+	}
+	if p.TableSize == 0 {
+		p.TableSize = 100000
+	}
+	if p.Expire == 0 {
+		p.Expire = 60000
+	}
+	if p.DenyStatus == 0 {
+		p.DenyStatus = 429
+	}
+
+	backend := rateLimitBackendPrefix + *p.Name
+	if _, _, err := client.Configuration.GetBackend(backend, t); err == nil {
+		return native_configuration.NewConfError(native_configuration.ErrObjectAlreadyExists, fmt.Sprintf("rate limit policy %s already exists", *p.Name))
+	}
+	_, rules, err := client.Configuration.GetHTTPRequestRules("frontend", *p.Frontend, t)
+	if err != nil {
+		return err
+	}
+	used := make(map[int64]bool)
+	for _, r := range rules {
+		if counter, _, _, ok := trackRule(r); ok {
+			used[counter] = true
+		}
+	}
+	p.StickyCounter = -1
+	for c := int64(0); c < 3; c++ {
+		if !used[c] {
+			p.StickyCounter = c
+			break
+		}
+	}
+	if p.StickyCounter == -1 {
+		return native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("no free sticky counter in frontend %s", *p.Frontend))
+	}
+
+	st := &models.BackendStickTable{
+		Type:   "ipv6",
+		Size:   &p.TableSize,
+		Expire: &p.Expire,
+		Store:  fmt.Sprintf("http_req_rate(%d)", p.Period),
+	}
+	if p.Key != "src" {
+		st.Type = "string"
+		st.Keylen = misc.Int64P(64)
+	}
+	if err := client.Configuration.CreateBackend(&models.Backend{Name: backend, StickTable: st}, t, 0); err != nil {
+		return err
+	}
+
+	// rate limit rules are evaluated before the other http-request rules
+	track := &models.HTTPRequestRule{
+		Index: misc.Int64P(0),
+		Type:  fmt.Sprintf("track-sc%d", p.StickyCounter),
+	}
+	switch p.StickyCounter {
+	case 0:
+		track.TrackSc0Key, track.TrackSc0Table = p.Key, backend
+	case 1:
+		track.TrackSc1Key, track.TrackSc1Table = p.Key, backend
+	case 2:
+		track.TrackSc2Key, track.TrackSc2Table = p.Key, backend
+	}
+	if err := client.Configuration.CreateHTTPRequestRule("frontend", *p.Frontend, track, t, 0); err != nil {
+		return err
+	}
+	deny := &models.HTTPRequestRule{
+		Index:      misc.Int64P(1),
+		Type:       "deny",
+		DenyStatus: &p.DenyStatus,
+		Cond:       "if",
+		CondTest:   fmt.Sprintf("{ sc_http_req_rate(%d,%s) gt %d }", p.StickyCounter, backend, *p.Limit),
+	}
+	return client.Configuration.CreateHTTPRequestRule("frontend", *p.Frontend, deny, t, 0)
+}
+
+func deleteRateLimit(client *client_native.HAProxyClient, name, t string) error {
+	backend := rateLimitBackendPrefix + name
+	if _, _, err := client.Configuration.GetBackend(backend, t); err != nil {
+		return native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("rate limit policy %s does not exist", name))
+	}
+	_, frontends, err := client.Configuration.GetFrontends(t)
+	if err != nil {
+		return err
+	}
+	for _, f := range frontends {
+		_, rules, err := client.Configuration.GetHTTPRequestRules("frontend", f.Name, t)
+		if err != nil {
+			return err
+		}
+		indexes := rateLimitRuleIndexes(rules, backend)
+		for i := len(indexes) - 1; i >= 0; i-- {
+			if err := client.Configuration.DeleteHTTPRequestRule(indexes[i], "frontend", f.Name, t, 0); err != nil {
+				return err
+			}
+		}
+	}
+	return client.Configuration.DeleteBackend(backend, t, 0)
+}
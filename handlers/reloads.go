@@ -28,11 +28,33 @@ import (
 //GetReloadHandlerImpl implementation of the GetReloadHandler interface using client-native client
 type GetReloadHandlerImpl struct {
 	ReloadAgent haproxy.IReloadAgent
+	Metadata    *haproxy.TransactionMetadataStore
 }
 
 //GetReloadsHandlerImpl implementation of the GetReloadsHandler interface using client-native client
 type GetReloadsHandlerImpl struct {
 	ReloadAgent haproxy.IReloadAgent
+	Metadata    *haproxy.TransactionMetadataStore
+}
+
+//RetryReloadHandlerImpl implementation of the RetryReloadHandler interface
+type RetryReloadHandlerImpl struct {
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//GetReloadFreezeHandlerImpl implementation of the GetReloadFreezeHandler interface
+type GetReloadFreezeHandlerImpl struct {
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//FreezeReloadsHandlerImpl implementation of the FreezeReloadsHandler interface
+type FreezeReloadsHandlerImpl struct {
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//UnfreezeReloadsHandlerImpl implementation of the UnfreezeReloadsHandler interface
+type UnfreezeReloadsHandlerImpl struct {
+	ReloadAgent haproxy.IReloadAgent
 }
 
 //Handle executing the request and returning a response
@@ -47,11 +69,100 @@ func (rh *GetReloadHandlerImpl) Handle(params reloads.GetReloadParams, principal
 		}
 		return reloads.NewGetReloadDefault(404).WithPayload(e)
 	}
-	return reloads.NewGetReloadOK().WithPayload(r)
+	return reloads.NewGetReloadOK().WithPayload(&reloads.GetReloadOKBody{
+		ID:              r.ID,
+		ReloadTimestamp: r.ReloadTimestamp,
+		Response:        r.Response,
+		Status:          r.Status,
+		Transactions:    reloadTransactions(rh.Metadata, r.ID),
+	})
 }
 
 //Handle executing the request and returning a response
 func (rh *GetReloadsHandlerImpl) Handle(params reloads.GetReloadsParams, principal interface{}) middleware.Responder {
 	rs := rh.ReloadAgent.GetReloads()
-	return reloads.NewGetReloadsOK().WithPayload(rs)
+	items := make([]*reloads.GetReloadsOKBodyItems0, 0, len(rs))
+	for _, r := range rs {
+		item := &reloads.GetReloadsOKBodyItems0{
+			ID:              r.ID,
+			ReloadTimestamp: r.ReloadTimestamp,
+			Response:        r.Response,
+			Status:          r.Status,
+			Transactions:    make([]*reloads.GetReloadsOKBodyItems0TransactionsItems0, 0),
+		}
+		for _, t := range reloadTransactions(rh.Metadata, r.ID) {
+			item.Transactions = append(item.Transactions, &reloads.GetReloadsOKBodyItems0TransactionsItems0{ID: t.ID, Note: t.Note, Labels: t.Labels})
+		}
+		items = append(items, item)
+	}
+	return reloads.NewGetReloadsOK().WithPayload(items)
+}
+
+// reloadTransactions returns the transactions applied by a reload, with their
+// notes and labels
+func reloadTransactions(metadata *haproxy.TransactionMetadataStore, reloadID string) []*reloads.GetReloadOKBodyTransactionsItems0 {
+	ts := make([]*reloads.GetReloadOKBodyTransactionsItems0, 0)
+	for _, id := range metadata.ForReload(reloadID) {
+		m, _ := metadata.Get(id)
+		ts = append(ts, &reloads.GetReloadOKBodyTransactionsItems0{ID: id, Note: m.Note, Labels: m.Labels})
+	}
+	return ts
+}
+
+//Handle executing the request and returning a response
+func (rh *RetryReloadHandlerImpl) Handle(params reloads.RetryReloadParams, principal interface{}) middleware.Responder {
+	if rh.ReloadAgent.GetReload(params.ID) == nil {
+		msg := fmt.Sprintf("Reload with ID %s does not exist", params.ID)
+		c := misc.ErrHTTPNotFound
+		e := &models.Error{
+			Code:    &c,
+			Message: &msg,
+		}
+		return reloads.NewRetryReloadNotFound().WithPayload(e)
+	}
+	id, err := rh.ReloadAgent.RetryReload(params.ID)
+	if err != nil {
+		e := misc.HandleError(err)
+		return reloads.NewRetryReloadDefault(int(*e.Code)).WithPayload(e)
+	}
+	return reloads.NewRetryReloadAccepted().WithReloadID(id).WithPayload(rh.ReloadAgent.GetReload(id))
+}
+
+//Handle executing the request and returning a response
+func (rh *GetReloadFreezeHandlerImpl) Handle(params reloads.GetReloadFreezeParams, principal interface{}) middleware.Responder {
+	f := rh.ReloadAgent.FreezeStatus()
+	b := &reloads.GetReloadFreezeOKBody{Frozen: f.Frozen, Since: f.Since, Reason: f.Reason, User: f.User, ReloadID: f.ReloadID, Pending: make([]*reloads.GetReloadFreezeOKBodyPendingItems0, 0, len(f.Pending))}
+	for _, p := range f.Pending {
+		b.Pending = append(b.Pending, &reloads.GetReloadFreezeOKBodyPendingItems0{Version: p.Version, Timestamp: p.Timestamp})
+	}
+	return reloads.NewGetReloadFreezeOK().WithPayload(b)
+}
+
+//Handle executing the request and returning a response
+func (rh *FreezeReloadsHandlerImpl) Handle(params reloads.FreezeReloadsParams, principal interface{}) middleware.Responder {
+	user, _ := principal.(string)
+	f, err := rh.ReloadAgent.Freeze(params.Data.Reason, user)
+	if err != nil {
+		e := misc.HandleError(err)
+		return reloads.NewFreezeReloadsDefault(int(*e.Code)).WithPayload(e)
+	}
+	b := &reloads.FreezeReloadsOKBody{Frozen: f.Frozen, Since: f.Since, Reason: f.Reason, User: f.User, ReloadID: f.ReloadID, Pending: make([]*reloads.FreezeReloadsOKBodyPendingItems0, 0, len(f.Pending))}
+	for _, p := range f.Pending {
+		b.Pending = append(b.Pending, &reloads.FreezeReloadsOKBodyPendingItems0{Version: p.Version, Timestamp: p.Timestamp})
+	}
+	return reloads.NewFreezeReloadsOK().WithPayload(b)
+}
+
+//Handle executing the request and returning a response
+func (rh *UnfreezeReloadsHandlerImpl) Handle(params reloads.UnfreezeReloadsParams, principal interface{}) middleware.Responder {
+	rID, err := rh.ReloadAgent.Unfreeze()
+	if err != nil {
+		e := misc.HandleError(err)
+		return reloads.NewUnfreezeReloadsDefault(int(*e.Code)).WithPayload(e)
+	}
+	if rID != "" {
+		b := &reloads.UnfreezeReloadsAcceptedBody{ReloadID: rID, Pending: make([]*reloads.UnfreezeReloadsAcceptedBodyPendingItems0, 0)}
+		return reloads.NewUnfreezeReloadsAccepted().WithReloadID(rID).WithPayload(b)
+	}
+	return reloads.NewUnfreezeReloadsOK().WithPayload(&reloads.UnfreezeReloadsOKBody{Pending: make([]*reloads.UnfreezeReloadsOKBodyPendingItems0, 0)})
 }
@@ -0,0 +1,130 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/backend"
+)
+
+//GetRuntimeBackendsHandlerImpl implementation of the GetRuntimeBackendsHandler interface using client-native client
+type GetRuntimeBackendsHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//GetRuntimeBackendHandlerImpl implementation of the GetRuntimeBackendHandler interface using client-native client
+type GetRuntimeBackendHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceRuntimeBackendHandlerImpl implementation of the ReplaceRuntimeBackendHandler interface using client-native client
+type ReplaceRuntimeBackendHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//Handle executing the request and returning a response
+func (h *GetRuntimeBackendsHandlerImpl) Handle(params backend.GetRuntimeBackendsParams, principal interface{}) middleware.Responder {
+	if h.Client.Runtime == nil {
+		msg := "Runtime API not configured"
+		c := misc.ErrHTTPInternalServerError
+		return backend.NewGetRuntimeBackendsDefault(int(c)).WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	states, err := haproxy.BackendStates(h.Client.Runtime)
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewGetRuntimeBackendsDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	payload := make([]*backend.GetRuntimeBackendsOKBodyItems0, 0, len(states))
+	for _, s := range states {
+		state := s.State
+		payload = append(payload, &backend.GetRuntimeBackendsOKBodyItems0{Name: s.Name, State: &state, Servers: s.Servers, Maintenance: s.Maintenance})
+	}
+	return backend.NewGetRuntimeBackendsOK().WithPayload(payload)
+}
+
+//Handle executing the request and returning a response
+func (h *GetRuntimeBackendHandlerImpl) Handle(params backend.GetRuntimeBackendParams, principal interface{}) middleware.Responder {
+	if h.Client.Runtime == nil {
+		msg := "Runtime API not configured"
+		c := misc.ErrHTTPInternalServerError
+		return backend.NewGetRuntimeBackendDefault(int(c)).WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	s, err := haproxy.BackendState(h.Client.Runtime, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewGetRuntimeBackendDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if s == nil {
+		code := int64(404)
+		msg := fmt.Sprintf("Runtime backend %s not found", params.Name)
+		return backend.NewGetRuntimeBackendNotFound().WithPayload(&models.Error{Code: &code, Message: &msg})
+	}
+
+	return backend.NewGetRuntimeBackendOK().WithPayload(&backend.GetRuntimeBackendOKBody{Name: s.Name, State: &s.State, Servers: s.Servers, Maintenance: s.Maintenance})
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceRuntimeBackendHandlerImpl) Handle(params backend.ReplaceRuntimeBackendParams, principal interface{}) middleware.Responder {
+	if h.Client.Runtime == nil {
+		msg := "Runtime API not configured"
+		c := misc.ErrHTTPInternalServerError
+		return backend.NewReplaceRuntimeBackendDefault(int(c)).WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	s, err := haproxy.BackendState(h.Client.Runtime, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewReplaceRuntimeBackendDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if s == nil {
+		code := int64(404)
+		msg := fmt.Sprintf("Runtime backend %s not found", params.Name)
+		return backend.NewReplaceRuntimeBackendNotFound().WithPayload(&models.Error{Code: &code, Message: &msg})
+	}
+
+	// the servers left in maintenance or ready by a previous call are set as well
+	err = haproxy.SetBackendState(h.Client.Runtime, params.Name, *params.Data.State)
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewReplaceRuntimeBackendDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	s, err = haproxy.BackendState(h.Client.Runtime, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return backend.NewReplaceRuntimeBackendDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if s == nil {
+		code := int64(404)
+		msg := fmt.Sprintf("Runtime backend %s not found", params.Name)
+		return backend.NewReplaceRuntimeBackendNotFound().WithPayload(&models.Error{Code: &code, Message: &msg})
+	}
+
+	return backend.NewReplaceRuntimeBackendOK().WithPayload(&backend.ReplaceRuntimeBackendOKBody{Name: s.Name, State: &s.State, Servers: s.Servers, Maintenance: s.Maintenance})
+}
@@ -0,0 +1,120 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/frontend"
+)
+
+//GetRuntimeFrontendsHandlerImpl implementation of the GetRuntimeFrontendsHandler interface using client-native client
+type GetRuntimeFrontendsHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//GetRuntimeFrontendHandlerImpl implementation of the GetRuntimeFrontendHandler interface using client-native client
+type GetRuntimeFrontendHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceRuntimeFrontendHandlerImpl implementation of the ReplaceRuntimeFrontendHandler interface using client-native client
+type ReplaceRuntimeFrontendHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//Handle executing the request and returning a response
+func (h *GetRuntimeFrontendsHandlerImpl) Handle(params frontend.GetRuntimeFrontendsParams, principal interface{}) middleware.Responder {
+	if h.Client.Runtime == nil {
+		msg := "Runtime API not configured"
+		c := misc.ErrHTTPInternalServerError
+		return frontend.NewGetRuntimeFrontendsDefault(int(c)).WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	states, err := haproxy.FrontendStates(h.Client.Runtime)
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewGetRuntimeFrontendsDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	payload := make([]*frontend.GetRuntimeFrontendsOKBodyItems0, 0, len(states))
+	for _, s := range states {
+		state := s.State
+		payload = append(payload, &frontend.GetRuntimeFrontendsOKBodyItems0{Name: s.Name, State: &state})
+	}
+	return frontend.NewGetRuntimeFrontendsOK().WithPayload(payload)
+}
+
+//Handle executing the request and returning a response
+func (h *GetRuntimeFrontendHandlerImpl) Handle(params frontend.GetRuntimeFrontendParams, principal interface{}) middleware.Responder {
+	if h.Client.Runtime == nil {
+		msg := "Runtime API not configured"
+		c := misc.ErrHTTPInternalServerError
+		return frontend.NewGetRuntimeFrontendDefault(int(c)).WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	s, err := haproxy.FrontendState(h.Client.Runtime, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewGetRuntimeFrontendDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if s == nil {
+		code := int64(404)
+		msg := fmt.Sprintf("Runtime frontend %s not found", params.Name)
+		return frontend.NewGetRuntimeFrontendNotFound().WithPayload(&models.Error{Code: &code, Message: &msg})
+	}
+
+	return frontend.NewGetRuntimeFrontendOK().WithPayload(&frontend.GetRuntimeFrontendOKBody{Name: s.Name, State: &s.State})
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceRuntimeFrontendHandlerImpl) Handle(params frontend.ReplaceRuntimeFrontendParams, principal interface{}) middleware.Responder {
+	if h.Client.Runtime == nil {
+		msg := "Runtime API not configured"
+		c := misc.ErrHTTPInternalServerError
+		return frontend.NewReplaceRuntimeFrontendDefault(int(c)).WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	s, err := haproxy.FrontendState(h.Client.Runtime, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return frontend.NewReplaceRuntimeFrontendDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if s == nil {
+		code := int64(404)
+		msg := fmt.Sprintf("Runtime frontend %s not found", params.Name)
+		return frontend.NewReplaceRuntimeFrontendNotFound().WithPayload(&models.Error{Code: &code, Message: &msg})
+	}
+
+	if s.State != *params.Data.State {
+		err = haproxy.SetFrontendState(h.Client.Runtime, params.Name, *params.Data.State)
+		if err != nil {
+			e := misc.HandleError(err)
+			return frontend.NewReplaceRuntimeFrontendDefault(int(*e.Code)).WithPayload(e)
+		}
+		s.State = *params.Data.State
+	}
+
+	return frontend.NewReplaceRuntimeFrontendOK().WithPayload(&frontend.ReplaceRuntimeFrontendOKBody{Name: params.Name, State: &s.State})
+}
@@ -0,0 +1,55 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/operations/traces"
+)
+
+//GetRuntimeLogsHandlerImpl implementation of the GetRuntimeLogsHandler interface
+type GetRuntimeLogsHandlerImpl struct {
+	Client       *client_native.HAProxyClient
+	MasterSocket string
+}
+
+//Handle executing the request and returning a response
+func (h *GetRuntimeLogsHandlerImpl) Handle(params traces.GetRuntimeLogsParams, principal interface{}) middleware.Responder {
+	filter := haproxy.LogFilter{}
+	if params.Level != nil {
+		filter.Level = *params.Level
+	}
+	if params.Proxy != nil {
+		filter.Proxy = *params.Proxy
+	}
+	// every log entry is sent as a server-sent event
+	wrap := func(w io.Writer) io.Writer {
+		return haproxy.NewLogEntryWriter(filter, func(entry string) error {
+			_, err := fmt.Fprintf(w, "data: %s\n\n", entry)
+			return err
+		})
+	}
+	return ringStream(h.Client, h.MasterSocket, params.Ring, *params.Follow, *params.Timeout, "text/event-stream", wrap, func(e *models.Error) middleware.Responder {
+		return traces.NewGetRuntimeLogsDefault(int(*e.Code)).WithPayload(e)
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/server"
+)
+
+// maxServerWeight is the highest weight of a server
+const maxServerWeight = 256
+
+//ReplaceRuntimeWeightsHandlerImpl implementation of the ReplaceRuntimeWeightsHandler interface using client-native client
+type ReplaceRuntimeWeightsHandlerImpl struct {
+	Client *client_native.HAProxyClient
+	// WorkerPrefix is prepended to the commands pipelined after the first one
+	// when the runtime API is the master socket
+	WorkerPrefix string
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceRuntimeWeightsHandlerImpl) Handle(params server.ReplaceRuntimeWeightsParams, principal interface{}) middleware.Responder {
+	if h.Client.Runtime == nil {
+		msg := "Runtime API not configured"
+		c := misc.ErrHTTPInternalServerError
+		return server.NewReplaceRuntimeWeightsDefault(int(c)).WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	results := make([]*server.ReplaceRuntimeWeightsOKBodyWeightsItems0, 0, len(params.Data.Weights))
+	changes := make([]haproxy.WeightChange, 0, len(params.Data.Weights))
+	// index of the result of each change
+	indexes := make([]int, 0, len(params.Data.Weights))
+	for _, w := range params.Data.Weights {
+		mode := w.Mode
+		if mode == "" {
+			mode = "absolute"
+		}
+		weight := fmt.Sprint(*w.Weight)
+		if mode == "percent" {
+			weight += "%"
+		} else if *w.Weight > maxServerWeight {
+			msg := fmt.Sprintf("weight %d of backend %s is greater than %d", *w.Weight, *w.Backend, maxServerWeight)
+			c := misc.ErrHTTPBadRequest
+			return server.NewReplaceRuntimeWeightsBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+		}
+
+		servers := []string{w.Server}
+		if w.Server == "" {
+			rs, err := h.Client.Runtime.GetServersState(*w.Backend)
+			if err != nil {
+				e := misc.HandleError(err)
+				return server.NewReplaceRuntimeWeightsDefault(int(*e.Code)).WithPayload(e)
+			}
+			servers = servers[:0]
+			for _, s := range rs {
+				servers = append(servers, s.Name)
+			}
+			if len(servers) == 0 {
+				results = append(results, &server.ReplaceRuntimeWeightsOKBodyWeightsItems0{
+					Backend: *w.Backend,
+					Weight:  w.Weight,
+					Mode:    mode,
+					Status:  "failed",
+					Error:   fmt.Sprintf("no server in backend %s", *w.Backend),
+				})
+				continue
+			}
+		}
+		for _, s := range servers {
+			indexes = append(indexes, len(results))
+			changes = append(changes, haproxy.WeightChange{Backend: *w.Backend, Server: s, Weight: weight})
+			results = append(results, &server.ReplaceRuntimeWeightsOKBodyWeightsItems0{
+				Backend: *w.Backend,
+				Server:  s,
+				Weight:  w.Weight,
+				Mode:    mode,
+				Status:  "ok",
+			})
+		}
+	}
+
+	haproxy.SetWeights(h.Client.Runtime, h.WorkerPrefix, changes)
+
+	data := &server.ReplaceRuntimeWeightsOKBody{Weights: results}
+	for i, c := range changes {
+		if c.Error != "" {
+			results[indexes[i]].Status = "failed"
+			results[indexes[i]].Error = c.Error
+		}
+	}
+	for _, r := range results {
+		if r.Status == "failed" {
+			data.Failed++
+		} else {
+			data.Applied++
+		}
+	}
+	return server.NewReplaceRuntimeWeightsOK().WithPayload(data)
+}
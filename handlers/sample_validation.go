@@ -0,0 +1,126 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+)
+
+// ruleSamples are the sample expressions and log formats of a rule, as
+// field name and value pairs
+type ruleSamples struct {
+	varScope    string
+	varName     string
+	expressions [][2]string
+	formats     [][2]string
+}
+
+// validate checks the variable and the sample expressions of a rule, returning
+// a validation error naming the failing field
+func (s ruleSamples) validate(v *haproxy.SampleValidator) error {
+	if v == nil {
+		return nil
+	}
+	if s.varName != "" {
+		if err := haproxy.ValidateVariable(s.varScope, s.varName); err != nil {
+			return configuration.NewConfError(configuration.ErrValidationError, err.Error())
+		}
+	}
+	for _, e := range s.expressions {
+		if e[1] == "" {
+			continue
+		}
+		if err := v.ValidateExpression(e[1]); err != nil {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("%s: %s", e[0], err.Error()))
+		}
+	}
+	for _, f := range s.formats {
+		if f[1] == "" {
+			continue
+		}
+		if err := v.ValidateLogFormat(f[1]); err != nil {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("%s: %s", f[0], err.Error()))
+		}
+	}
+	return nil
+}
+
+func validateHTTPRequestRule(v *haproxy.SampleValidator, r *models.HTTPRequestRule) error {
+	return ruleSamples{
+		varScope: r.VarScope,
+		varName:  r.VarName,
+		expressions: [][2]string{
+			{"var_expr", r.VarExpr},
+			{"capture_sample", r.CaptureSample},
+			{"expr", r.Expr},
+			{"sc_expr", r.ScExpr},
+			{"track-sc0-key", r.TrackSc0Key},
+			{"track-sc1-key", r.TrackSc1Key},
+			{"track-sc2-key", r.TrackSc2Key},
+		},
+		formats: [][2]string{
+			{"hdr_format", r.HdrFormat},
+			{"redir_value", r.RedirValue},
+			{"uri-fmt", r.URIFmt},
+			{"path_fmt", r.PathFmt},
+			{"query-fmt", r.QueryFmt},
+			{"method_fmt", r.MethodFmt},
+			{"hint_format", r.HintFormat},
+			{"acl_keyfmt", r.ACLKeyfmt},
+			{"map_keyfmt", r.MapKeyfmt},
+			{"map_valuefmt", r.MapValuefmt},
+		},
+	}.validate(v)
+}
+
+func validateHTTPResponseRule(v *haproxy.SampleValidator, r *models.HTTPResponseRule) error {
+	return ruleSamples{
+		varScope: r.VarScope,
+		varName:  r.VarName,
+		expressions: [][2]string{
+			{"var_expr", r.VarExpr},
+			{"capture_sample", r.CaptureSample},
+			{"sc_expr", r.ScExpr},
+			{"track-sc0-key", r.TrackSc0Key},
+			{"track-sc1-key", r.TrackSc1Key},
+			{"track-sc2-key", r.TrackSc2Key},
+		},
+		formats: [][2]string{
+			{"hdr_format", r.HdrFormat},
+			{"redir_value", r.RedirValue},
+			{"acl_keyfmt", r.ACLKeyfmt},
+			{"map_keyfmt", r.MapKeyfmt},
+			{"map_valuefmt", r.MapValuefmt},
+		},
+	}.validate(v)
+}
+
+func validateTCPRequestRule(v *haproxy.SampleValidator, r *models.TCPRequestRule) error {
+	return ruleSamples{
+		varScope: r.VarScope,
+		varName:  r.VarName,
+		expressions: [][2]string{
+			{"expr", r.Expr},
+			{"capture_sample", r.CaptureSample},
+			{"track_key", r.TrackKey},
+		},
+	}.validate(v)
+}
@@ -0,0 +1,319 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/security_options"
+)
+
+const (
+	// restrictReqHdrNamesVersion is the first HAProxy version with option http-restrict-req-hdr-names
+	restrictReqHdrNamesVersion = "2.6"
+	// normalizeURIVersion is the first HAProxy version with http-request normalize-uri
+	// outside of experimental directives
+	normalizeURIVersion = "2.5"
+)
+
+// securityBaselineNormalizers are the URI normalizers of the security baseline
+var securityBaselineNormalizers = []string{"path-strip-dotdot", "path-merge-slashes", "percent-decode-unreserved", "percent-to-uppercase", "fragment-strip"}
+
+//GetSecurityOptionsHandlerImpl implementation of the GetSecurityOptionsHandler interface using client-native client
+type GetSecurityOptionsHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceSecurityOptionsHandlerImpl implementation of the ReplaceSecurityOptionsHandler interface using client-native client
+type ReplaceSecurityOptionsHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
+}
+
+//ApplySecurityBaselineHandlerImpl implementation of the ApplySecurityBaselineHandler interface using client-native client
+type ApplySecurityBaselineHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
+}
+
+//Handle executing the request and returning a response
+func (h *GetSecurityOptionsHandlerImpl) Handle(params security_options.GetSecurityOptionsParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return security_options.NewGetSecurityOptionsDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return security_options.NewGetSecurityOptionsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	var opts security_options.ReplaceSecurityOptionsBody
+	section, name, err := proxySection(p, params.ParentType, params.ParentName)
+	if err == nil {
+		opts, err = getSecurityOptions(p, section, name)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return security_options.NewGetSecurityOptionsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := security_options.GetSecurityOptionsOKBodyData(opts)
+	return security_options.NewGetSecurityOptionsOK().WithPayload(&security_options.GetSecurityOptionsOKBody{Version: v, Data: &data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceSecurityOptionsHandlerImpl) Handle(params security_options.ReplaceSecurityOptionsParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return security_options.NewReplaceSecurityOptionsDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := validateSecurityOptions(h.Validator, params.ParentType, params.Data)
+	if err == nil {
+		err = changeParser(h.Client, t, v, func(p *parser.Parser) error {
+			section, name, err := proxySection(p, params.ParentType, params.ParentName)
+			if err != nil {
+				return err
+			}
+			return setSecurityOptions(p, section, name, params.Data)
+		})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return security_options.NewReplaceSecurityOptionsDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return security_options.NewReplaceSecurityOptionsDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := security_options.ReplaceSecurityOptionsOKBody(params.Data)
+			return security_options.NewReplaceSecurityOptionsOK().WithPayload(&ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := security_options.ReplaceSecurityOptionsAcceptedBody(params.Data)
+		return security_options.NewReplaceSecurityOptionsAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := security_options.ReplaceSecurityOptionsAcceptedBody(params.Data)
+	return security_options.NewReplaceSecurityOptionsAccepted().WithPayload(&accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *ApplySecurityBaselineHandlerImpl) Handle(params security_options.ApplySecurityBaselineParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return security_options.NewApplySecurityBaselineDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	var opts security_options.ReplaceSecurityOptionsBody
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		section, name, err := proxySection(p, params.ParentType, params.ParentName)
+		if err != nil {
+			return err
+		}
+		opts, err = getSecurityOptions(p, section, name)
+		if err != nil {
+			return err
+		}
+		opts = securityBaseline(h.Validator, params.ParentType, opts)
+		return setSecurityOptions(p, section, name, opts)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return security_options.NewApplySecurityBaselineDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return security_options.NewApplySecurityBaselineDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := security_options.ApplySecurityBaselineOKBody(opts)
+			return security_options.NewApplySecurityBaselineOK().WithPayload(&ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := security_options.ApplySecurityBaselineAcceptedBody(opts)
+		return security_options.NewApplySecurityBaselineAccepted().WithReloadID(rID).WithPayload(&accepted)
+	}
+	accepted := security_options.ApplySecurityBaselineAcceptedBody(opts)
+	return security_options.NewApplySecurityBaselineAccepted().WithPayload(&accepted)
+}
+
+// validateSecurityOptions checks the options are supported by the HAProxy
+// version and allowed in the parent type
+func validateSecurityOptions(v *haproxy.SampleValidator, parentType string, opts security_options.ReplaceSecurityOptionsBody) error {
+	if opts.RestrictReqHdrNames != "" && v != nil && !v.Supports(restrictReqHdrNamesVersion) {
+		return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("restrict_req_hdr_names requires HAProxy %s, running %s", restrictReqHdrNamesVersion, v.Version))
+	}
+	if len(opts.NormalizeURI) > 0 {
+		if parentType == "defaults" {
+			return configuration.NewConfError(configuration.ErrValidationError, "normalize_uri can not be set in defaults")
+		}
+		if v != nil && !v.Supports(normalizeURIVersion) {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("normalize_uri requires HAProxy %s, running %s", normalizeURIVersion, v.Version))
+		}
+	}
+	for i, n := range opts.NormalizeURI {
+		for _, m := range opts.NormalizeURI[:i] {
+			if strings.Fields(n)[0] == strings.Fields(m)[0] {
+				return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("normalizer %s is listed more than once", strings.Fields(n)[0]))
+			}
+		}
+	}
+	if opts.HTTPIgnoreProbes && parentType == "backend" {
+		return configuration.NewConfError(configuration.ErrValidationError, "http_ignore_probes can not be set in a backend")
+	}
+	return nil
+}
+
+// securityBaseline returns the options with the security baseline applied,
+// leaving out the options the HAProxy version or the parent type do not support
+func securityBaseline(v *haproxy.SampleValidator, parentType string, opts security_options.ReplaceSecurityOptionsBody) security_options.ReplaceSecurityOptionsBody {
+	if v == nil || v.Supports(restrictReqHdrNamesVersion) {
+		opts.RestrictReqHdrNames = "delete"
+	}
+	if parentType != "defaults" && (v == nil || v.Supports(normalizeURIVersion)) {
+		for _, n := range securityBaselineNormalizers {
+			found := false
+			for _, m := range opts.NormalizeURI {
+				if strings.Fields(m)[0] == n {
+					found = true
+					break
+				}
+			}
+			if !found {
+				opts.NormalizeURI = append(opts.NormalizeURI, n)
+			}
+		}
+	}
+	if parentType != "backend" {
+		opts.HTTPIgnoreProbes = true
+	}
+	return opts
+}
+
+// normalizeURIRule returns the normalizer of an unconditional http-request
+// normalize-uri rule line, false for other lines
+func normalizeURIRule(f []string) (string, bool) {
+	if len(f) < 3 || len(f) > 4 || f[0] != "http-request" || f[1] != "normalize-uri" {
+		return "", false
+	}
+	return strings.Join(f[2:], " "), true
+}
+
+// getSecurityOptions returns the security options of a section. The options
+// and normalize-uri rules are not handled by the configuration parser.
+func getSecurityOptions(p *parser.Parser, section parser.Section, name string) (security_options.ReplaceSecurityOptionsBody, error) {
+	opts := security_options.ReplaceSecurityOptionsBody{NormalizeURI: []string{}}
+	lines, err := getSectionUnprocessed(p, section, name)
+	if err != nil {
+		return opts, err
+	}
+	for _, l := range lines {
+		f := strings.Fields(l.Value)
+		if n, ok := normalizeURIRule(f); ok {
+			opts.NormalizeURI = append(opts.NormalizeURI, n)
+			continue
+		}
+		switch {
+		case len(f) == 3 && f[0] == "option" && f[1] == "http-restrict-req-hdr-names":
+			opts.RestrictReqHdrNames = f[2]
+		case len(f) == 2 && f[0] == "option" && f[1] == "http-ignore-probes":
+			opts.HTTPIgnoreProbes = true
+		}
+	}
+	return opts, nil
+}
+
+// setSecurityOptions replaces the security options of a section
+func setSecurityOptions(p *parser.Parser, section parser.Section, name string, opts security_options.ReplaceSecurityOptionsBody) error {
+	lines, err := getSectionUnprocessed(p, section, name)
+	if err != nil {
+		return err
+	}
+	unprocessed := make([]types.UnProcessed, 0, len(lines)+len(opts.NormalizeURI)+2)
+	for _, l := range lines {
+		f := strings.Fields(l.Value)
+		if _, ok := normalizeURIRule(f); ok {
+			continue
+		}
+		if len(f) >= 2 && f[0] == "option" && (f[1] == "http-restrict-req-hdr-names" || f[1] == "http-ignore-probes") {
+			continue
+		}
+		unprocessed = append(unprocessed, l)
+	}
+	if opts.RestrictReqHdrNames != "" {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: "option http-restrict-req-hdr-names " + opts.RestrictReqHdrNames})
+	}
+	if opts.HTTPIgnoreProbes {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: "option http-ignore-probes"})
+	}
+	for _, n := range opts.NormalizeURI {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: "http-request normalize-uri " + n})
+	}
+	if len(unprocessed) == 0 {
+		return p.Set(section, name, "", nil)
+	}
+	return p.Set(section, name, "", unprocessed)
+}
@@ -16,8 +16,13 @@
 package handlers
 
 import (
+	"fmt"
+	"net"
+	"strings"
+
 	"github.com/go-openapi/runtime/middleware"
 	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
 	"github.com/haproxytech/dataplaneapi/haproxy"
 	"github.com/haproxytech/dataplaneapi/misc"
 	"github.com/haproxytech/dataplaneapi/operations/server"
@@ -73,6 +78,11 @@ func (h *CreateServerHandlerImpl) Handle(params server.CreateServerParams, princ
 		return server.NewCreateServerDefault(int(*e.Code)).WithPayload(e)
 	}
 
+	if err := validateServer(h.Client, params.Backend, params.Data, t); err != nil {
+		e := misc.HandleError(err)
+		return server.NewCreateServerDefault(int(*e.Code)).WithPayload(e)
+	}
+
 	err := h.Client.Configuration.CreateServer(params.Backend, params.Data, t, v)
 	if err != nil {
 		e := misc.HandleError(err)
@@ -192,7 +202,28 @@ func (h *ReplaceServerHandlerImpl) Handle(params server.ReplaceServerParams, pri
 		return server.NewReplaceServerDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err = h.Client.Configuration.EditServer(params.Name, params.Backend, params.Data, t, v)
+	if err := validateServer(h.Client, params.Backend, params.Data, t); err != nil {
+		e := misc.HandleError(err)
+		return server.NewReplaceServerDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return server.NewReplaceServerDefault(int(*e.Code)).WithPayload(e)
+	}
+	kept, err := getServerParams(p, params.Backend, params.Name, serverKeptOptions)
+	if err != nil {
+		e := misc.HandleError(err)
+		return server.NewReplaceServerDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err = changeTransaction(h.Client, t, v, func(t string) error {
+		if err := h.Client.Configuration.EditServer(params.Name, params.Backend, params.Data, t, 0); err != nil {
+			return err
+		}
+		return keepServerParams(h.Client, params.Backend, params.Data.Name, kept, t)
+	})
 	if err != nil {
 		e := misc.HandleError(err)
 		return server.NewReplaceServerDefault(int(*e.Code)).WithPayload(e)
@@ -215,3 +246,30 @@ func (h *ReplaceServerHandlerImpl) Handle(params server.ReplaceServerParams, pri
 	}
 	return server.NewReplaceServerAccepted().WithPayload(params.Data)
 }
+
+// validateServer checks the server options not validated by the schema: init-addr
+// methods, slowstart and the uniqueness of the cookie value in the backend
+func validateServer(client *client_native.HAProxyClient, backend string, s *models.Server, t string) error {
+	if s.InitAddr != "" {
+		for _, m := range strings.Split(s.InitAddr, ",") {
+			if m != "last" && m != "libc" && m != "none" && net.ParseIP(m) == nil {
+				return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("invalid init-addr method %s, expected last, libc, none or an IP address", m))
+			}
+		}
+	}
+	if s.Slowstart != nil && *s.Slowstart < 0 {
+		return configuration.NewConfError(configuration.ErrValidationError, "slowstart must not be negative")
+	}
+	if s.Cookie != "" {
+		_, servers, err := client.Configuration.GetServers(backend, t)
+		if err != nil {
+			return err
+		}
+		for _, srv := range servers {
+			if srv.Name != s.Name && srv.Cookie == s.Cookie {
+				return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("cookie %s already used by server %s", s.Cookie, srv.Name))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/server"
+)
+
+// Defaults of server warm-ups: 5% to 100% over 10 minutes, raised every 10 seconds
+const (
+	warmupDefaultStartPercent = 5
+	warmupDefaultDuration     = 600
+	warmupDefaultInterval     = 10
+)
+
+//GetServerWarmupHandlerImpl implementation of the GetServerWarmupHandler interface
+type GetServerWarmupHandlerImpl struct {
+	Warmups *haproxy.WarmupScheduler
+}
+
+//ReplaceServerWarmupHandlerImpl implementation of the ReplaceServerWarmupHandler interface using client-native client
+type ReplaceServerWarmupHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	Warmups *haproxy.WarmupScheduler
+}
+
+//DeleteServerWarmupHandlerImpl implementation of the DeleteServerWarmupHandler interface
+type DeleteServerWarmupHandlerImpl struct {
+	Warmups *haproxy.WarmupScheduler
+}
+
+//Handle executing the request and returning a response
+func (h *GetServerWarmupHandlerImpl) Handle(params server.GetServerWarmupParams, principal interface{}) middleware.Responder {
+	w := h.Warmups.Get(params.Backend, params.Name)
+	if w.Status == "" {
+		return server.NewGetServerWarmupNotFound().WithPayload(warmupNotFound(params.Backend, params.Name))
+	}
+	data := server.GetServerWarmupOKBody(serverWarmup(w))
+	return server.NewGetServerWarmupOK().WithPayload(&data)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceServerWarmupHandlerImpl) Handle(params server.ReplaceServerWarmupParams, principal interface{}) middleware.Responder {
+	if h.Client.Runtime == nil {
+		msg := "Runtime API not configured"
+		c := misc.ErrHTTPInternalServerError
+		return server.NewReplaceServerWarmupDefault(int(c)).WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	rs, err := h.Client.Runtime.GetServerState(params.Backend, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return server.NewReplaceServerWarmupDefault(int(*e.Code)).WithPayload(e)
+	}
+	if rs == nil {
+		code := int64(404)
+		msg := fmt.Sprintf("Runtime server %s not found in backend %s", params.Name, params.Backend)
+		return server.NewReplaceServerWarmupNotFound().WithPayload(&models.Error{Code: &code, Message: &msg})
+	}
+
+	startPercent := int64(warmupDefaultStartPercent)
+	if params.Data.StartPercent != nil {
+		startPercent = *params.Data.StartPercent
+	}
+	duration := int64(warmupDefaultDuration)
+	if params.Data.Duration != nil {
+		duration = *params.Data.Duration
+	}
+	interval := int64(warmupDefaultInterval)
+	if params.Data.Interval != nil {
+		interval = *params.Data.Interval
+	}
+	if interval > duration {
+		msg := "interval must not be greater than duration"
+		c := misc.ErrHTTPBadRequest
+		return server.NewReplaceServerWarmupBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	w, err := h.Warmups.Start(params.Backend, params.Name, startPercent, time.Duration(duration)*time.Second, time.Duration(interval)*time.Second)
+	if err != nil {
+		e := misc.HandleError(err)
+		return server.NewReplaceServerWarmupDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := server.ReplaceServerWarmupAcceptedBody(serverWarmup(w))
+	return server.NewReplaceServerWarmupAccepted().WithPayload(&data)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteServerWarmupHandlerImpl) Handle(params server.DeleteServerWarmupParams, principal interface{}) middleware.Responder {
+	if !h.Warmups.Cancel(params.Backend, params.Name) {
+		return server.NewDeleteServerWarmupNotFound().WithPayload(warmupNotFound(params.Backend, params.Name))
+	}
+	return server.NewDeleteServerWarmupNoContent()
+}
+
+func warmupNotFound(backend, name string) *models.Error {
+	code := int64(404)
+	msg := fmt.Sprintf("No warm-up of server %s in backend %s", name, backend)
+	return &models.Error{Code: &code, Message: &msg}
+}
+
+func serverWarmup(w haproxy.ServerWarmup) server.ReplaceServerWarmupBody {
+	duration := int64(w.Duration / time.Second)
+	interval := int64(w.Interval / time.Second)
+	data := server.ReplaceServerWarmupBody{
+		Backend:       w.Backend,
+		Server:        w.Server,
+		StartPercent:  &w.StartPercent,
+		Duration:      &duration,
+		Interval:      &interval,
+		Status:        w.Status,
+		Error:         w.Error,
+		WeightPercent: w.WeightPercent,
+		Started:       strfmt.DateTime(w.Started),
+	}
+	if !w.Finished.IsZero() {
+		finished := strfmt.DateTime(w.Finished)
+		data.Finished = &finished
+	}
+	return data
+}
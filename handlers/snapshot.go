@@ -0,0 +1,269 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/snapshot"
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+// snapshotDataplaneArea is the area of the dataplane configuration file in snapshots
+const snapshotDataplaneArea = "dataplane"
+
+//GetSnapshotHandlerImpl implementation of the GetSnapshotHandler interface
+type GetSnapshotHandlerImpl struct {
+	Client *client_native.HAProxyClient
+	// Areas are the directories of the storage areas by name, the ones
+	// not set being left out of snapshots
+	Areas map[string]string
+	// DataplaneConfig is the dataplane configuration file, unset when none
+	DataplaneConfig string
+}
+
+//RestoreSnapshotHandlerImpl implementation of the RestoreSnapshotHandler interface
+type RestoreSnapshotHandlerImpl struct {
+	Client          *client_native.HAProxyClient
+	Metadata        *haproxy.TransactionMetadataStore
+	Storage         *haproxy.TransactionStorage
+	Areas           map[string]string
+	DataplaneConfig string
+}
+
+//Handle executing the request and returning a response
+func (h *GetSnapshotHandlerImpl) Handle(params snapshot.GetSnapshotParams, principal interface{}) middleware.Responder {
+	version, config, err := h.Client.Configuration.GetRawConfiguration("", 0)
+	if err != nil {
+		e := misc.HandleError(err)
+		return snapshot.NewGetSnapshotDefault(int(*e.Code)).WithPayload(e)
+	}
+	snap := haproxy.NewSnapshot(version, config)
+	for area, dir := range h.Areas {
+		if dir == "" {
+			continue
+		}
+		if err := snap.AddDir(area, dir); err != nil {
+			e := misc.HandleError(err)
+			return snapshot.NewGetSnapshotDefault(int(*e.Code)).WithPayload(e)
+		}
+	}
+	if h.DataplaneConfig != "" {
+		content, err := ioutil.ReadFile(h.DataplaneConfig)
+		if err == nil {
+			err = snap.AddFile(snapshotDataplaneArea, filepath.Base(h.DataplaneConfig), content)
+		}
+		if err != nil && !os.IsNotExist(err) {
+			e := misc.HandleError(err)
+			return snapshot.NewGetSnapshotDefault(int(*e.Code)).WithPayload(e)
+		}
+	}
+	// written in full first for errors to be reported with their status
+	var data bytes.Buffer
+	if err := snap.Write(&data); err != nil {
+		e := misc.HandleError(err)
+		return snapshot.NewGetSnapshotDefault(int(*e.Code)).WithPayload(e)
+	}
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		rw.Header().Set(runtime.HeaderContentType, "application/gzip")
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("dataplaneapi-snapshot-%d.tar.gz", version)))
+		rw.Header().Set("Content-Length", strconv.Itoa(data.Len()))
+		rw.WriteHeader(http.StatusOK)
+		// nolint:errcheck
+		rw.Write(data.Bytes())
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *RestoreSnapshotHandlerImpl) Handle(params snapshot.RestoreSnapshotParams, principal interface{}) middleware.Responder {
+	defer params.FileUpload.Close()
+	snap, err := haproxy.ReadSnapshot(params.FileUpload)
+	if err == nil {
+		err = h.checkSnapshot(snap, *params.DataplaneSettings)
+	}
+	if err != nil {
+		c := misc.ErrHTTPBadRequest
+		msg := err.Error()
+		return snapshot.NewRestoreSnapshotBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+
+	tr, err := h.Client.Configuration.StartTransaction(params.Version)
+	if err != nil {
+		e := misc.HandleError(err)
+		return snapshot.NewRestoreSnapshotDefault(int(*e.Code)).WithPayload(e)
+	}
+	// the transaction is left open, the restored state is committed once reviewed
+	body, err := h.restore(tr.ID, tr.Version, snap, *params.DataplaneSettings)
+	if err == nil {
+		owner, _ := principal.(string)
+		note := fmt.Sprintf("Restore of the snapshot of configuration version %d", snap.Version)
+		if err = h.Metadata.Set(tr.ID, note, nil); err == nil {
+			err = h.Metadata.SetOwner(tr.ID, owner)
+		}
+	}
+	if err != nil {
+		if err := h.Storage.Discard(tr.ID); err != nil {
+			log.Warningf("Cannot discard storage files of transaction %s: %s", tr.ID, err.Error())
+		}
+		// nolint:errcheck
+		h.Metadata.Delete(tr.ID)
+		e := misc.HandleError(discardParserChange(h.Client, tr.ID, true, err))
+		return snapshot.NewRestoreSnapshotDefault(int(*e.Code)).WithPayload(e)
+	}
+	return snapshot.NewRestoreSnapshotCreated().WithPayload(body)
+}
+
+// checkSnapshot checks the files of the snapshot can be restored
+func (h *RestoreSnapshotHandlerImpl) checkSnapshot(snap *haproxy.Snapshot, dataplaneSettings bool) error {
+	for area, files := range snap.Files {
+		if area == snapshotDataplaneArea {
+			continue
+		}
+		dir, ok := h.Areas[area]
+		if !ok {
+			return fmt.Errorf("unknown storage area %s in the snapshot", area)
+		}
+		if dir == "" && len(files) > 0 {
+			return fmt.Errorf("storage area %s of the snapshot not configured", area)
+		}
+	}
+	if !dataplaneSettings {
+		return nil
+	}
+	if h.DataplaneConfig == "" {
+		return fmt.Errorf("no dataplane configuration file to restore")
+	}
+	for name := range snap.Files[snapshotDataplaneArea] {
+		if filepath.Ext(name) == filepath.Ext(h.DataplaneConfig) {
+			return nil
+		}
+	}
+	return fmt.Errorf("the snapshot has no dataplane configuration file in the format of %s", filepath.Base(h.DataplaneConfig))
+}
+
+// restore replaces the configuration of the transaction t, started on
+// version, with the one of the snapshot, and stages the storage files
+// differing from the snapshot in the transaction
+func (h *RestoreSnapshotHandlerImpl) restore(t string, version int64, snap *haproxy.Snapshot, dataplaneSettings bool) (*snapshot.RestoreSnapshotCreatedBody, error) {
+	_, current, err := h.Client.Configuration.GetRawConfiguration("", 0)
+	if err != nil {
+		return nil, err
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		return nil, err
+	}
+	// the version of the snapshot is replaced by the one of the transaction
+	lines := make([]string, 0)
+	for _, l := range strings.Split(snap.Configuration, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(l), "# _version") {
+			lines = append(lines, l)
+		}
+	}
+	if err := p.ParseData(fmt.Sprintf("# _version=%d\n%s", version, strings.Join(lines, "\n"))); err != nil {
+		return nil, native_configuration.NewConfError(native_configuration.ErrValidationError, err.Error())
+	}
+	if err := saveParser(h.Client, p, t, false); err != nil {
+		return nil, err
+	}
+
+	areas := make([]string, 0, len(h.Areas))
+	for area, dir := range h.Areas {
+		if dir != "" {
+			areas = append(areas, area)
+		}
+	}
+	sort.Strings(areas)
+	for _, area := range areas {
+		files, err := haproxy.StorageFiles(h.Areas[area])
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if _, ok := snap.Files[area][f.Name]; !ok {
+				if err := h.Storage.StageDelete(t, area, f.Name); err != nil {
+					return nil, err
+				}
+			}
+		}
+		for name, content := range snap.Files[area] {
+			if err := h.stageChanged(t, area, name, filepath.Join(h.Areas[area], name), content); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if dataplaneSettings {
+		for name, content := range snap.Files[snapshotDataplaneArea] {
+			if filepath.Ext(name) != filepath.Ext(h.DataplaneConfig) {
+				continue
+			}
+			if err := h.stageChanged(t, snapshotDataplaneArea, filepath.Base(h.DataplaneConfig), h.DataplaneConfig, content); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	_, restored, err := h.Client.Configuration.GetRawConfiguration(t, 0)
+	if err != nil {
+		return nil, err
+	}
+	staged, err := h.Storage.Staged(t)
+	if err != nil {
+		return nil, err
+	}
+	body := &snapshot.RestoreSnapshotCreatedBody{
+		TransactionID:   t,
+		SnapshotVersion: snap.Version,
+		SnapshotCreated: snap.Created.Unix(),
+		Sections:        haproxy.ChangedSections(current, restored),
+		Files:           make([]*snapshot.RestoreSnapshotCreatedBodyFilesItems0, 0, len(staged)),
+	}
+	for _, f := range staged {
+		body.Files = append(body.Files, &snapshot.RestoreSnapshotCreatedBodyFilesItems0{
+			Area:    f.Area,
+			Name:    f.Name,
+			Deleted: f.Deleted,
+			Size:    f.Size,
+		})
+	}
+	return body, nil
+}
+
+// stageChanged stages content as the file name of area in the transaction t
+// when it differs from the content of path, the file it replaces
+func (h *RestoreSnapshotHandlerImpl) stageChanged(t, area, name, path string, content []byte) error {
+	if current, err := ioutil.ReadFile(path); err == nil && bytes.Equal(current, content) {
+		return nil
+	}
+	_, err := h.Storage.Stage(t, area, name, content)
+	return err
+}
@@ -0,0 +1,146 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/specification"
+)
+
+const definitionsRef = "#/definitions/"
+
+//GetSpecificationSchemaHandlerImpl implementation of the GetSpecificationSchemaHandler interface
+type GetSpecificationSchemaHandlerImpl struct {
+	Spec      json.RawMessage
+	Validator *haproxy.SampleValidator
+}
+
+//Handle executing the request and returning a response
+func (h *GetSpecificationSchemaHandlerImpl) Handle(params specification.GetSpecificationSchemaParams, principal interface{}) middleware.Responder {
+	var spec struct {
+		Definitions map[string]map[string]interface{} `json:"definitions"`
+	}
+	if err := json.Unmarshal(h.Spec, &spec); err != nil {
+		e := misc.HandleError(err)
+		return specification.NewGetSpecificationSchemaDefault(int(*e.Code)).WithPayload(e)
+	}
+	schema, ok := spec.Definitions[params.Resource]
+	if !ok {
+		e := misc.HandleError(native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("unknown resource %s", params.Resource)))
+		return specification.NewGetSpecificationSchemaNotFound().WithPayload(e)
+	}
+	h.applyConstraints(params.Resource, schema)
+
+	// the definitions the resource refers to are embedded, keeping the
+	// references valid in the returned document
+	definitions := make(map[string]interface{})
+	pending := schemaRefs(schema)
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if _, done := definitions[name]; done {
+			continue
+		}
+		d, ok := spec.Definitions[name]
+		if !ok {
+			continue
+		}
+		h.applyConstraints(name, d)
+		definitions[name] = d
+		pending = append(pending, schemaRefs(d)...)
+	}
+
+	schema["$schema"] = "http://json-schema.org/draft-04/schema#"
+	schema["title"] = params.Resource
+	if h.Validator != nil && h.Validator.Version != "" {
+		schema["x-haproxy-version"] = h.Validator.Version
+	}
+	if len(definitions) > 0 {
+		schema["definitions"] = definitions
+	}
+	return specification.NewGetSpecificationSchemaOK().WithPayload(schema)
+}
+
+// applyConstraints removes the properties and enum values of a resource
+// schema not supported by the HAProxy version
+func (h *GetSpecificationSchemaHandlerImpl) applyConstraints(resource string, schema map[string]interface{}) {
+	properties, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for name, p := range properties {
+		if !h.Validator.SupportsProperty(resource, name) {
+			delete(properties, name)
+			continue
+		}
+		property, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		values, ok := property["enum"].([]interface{})
+		if !ok {
+			continue
+		}
+		supported := make([]interface{}, 0, len(values))
+		for _, v := range values {
+			if s, ok := v.(string); ok && !h.Validator.SupportsValue(resource, name, s) {
+				continue
+			}
+			supported = append(supported, v)
+		}
+		property["enum"] = supported
+	}
+	if required, ok := schema["required"].([]interface{}); ok {
+		kept := make([]interface{}, 0, len(required))
+		for _, r := range required {
+			if s, ok := r.(string); ok {
+				if _, exists := properties[s]; !exists {
+					continue
+				}
+			}
+			kept = append(kept, r)
+		}
+		schema["required"] = kept
+	}
+}
+
+// schemaRefs returns the names of the definitions referred to in a schema
+func schemaRefs(schema interface{}) []string {
+	refs := make([]string, 0)
+	switch s := schema.(type) {
+	case map[string]interface{}:
+		for k, v := range s {
+			if ref, ok := v.(string); ok && k == "$ref" && strings.HasPrefix(ref, definitionsRef) {
+				refs = append(refs, strings.TrimPrefix(ref, definitionsRef))
+				continue
+			}
+			refs = append(refs, schemaRefs(v)...)
+		}
+	case []interface{}:
+		for _, v := range s {
+			refs = append(refs, schemaRefs(v)...)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,678 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/spoe_agent"
+	"github.com/haproxytech/dataplaneapi/system"
+)
+
+// spoeAgentBackendPrefix prefixes the names of the backends of SPOE agent servers
+const spoeAgentBackendPrefix = "spoe_"
+
+var (
+	spoeAgentHeaderRegexp     = regexp.MustCompile(`^# spoe agent type (\S+) action (\S+)\n`)
+	spoeAgentTimeoutRegexp    = regexp.MustCompile(`timeout processing (\d+)ms`)
+	spoeAgentAppRegexp        = regexp.MustCompile(`args app=str\(([^)]+)\)`)
+	spoeAgentVarPrefixReplace = strings.NewReplacer("-", "_")
+)
+
+const modsecuritySpoeConfig = `[%s]
+spoe-agent modsecurity-agent
+    messages check-request
+    option var-prefix %s
+    timeout hello 100ms
+    timeout idle 30s
+    timeout processing %dms
+    use-backend %s
+    log global
+
+spoe-message check-request
+    args unique-id method path query req.ver req.hdrs_bin req.body_size req.body
+    event on-frontend-http-request
+`
+
+const corazaSpoeConfig = `[%s]
+spoe-agent coraza-agent
+    messages coraza-req
+    option var-prefix %s
+    option set-on-error error
+    timeout hello 2s
+    timeout idle 2m
+    timeout processing %dms
+    use-backend %s
+    log global
+
+spoe-message coraza-req
+    args app=str(%s) id=unique-id src-ip=src src-port=src_port dst-ip=dst dst-port=dst_port method=method path=path query=query version=req.ver headers=req.hdrs body=req.body
+    event on-frontend-http-request
+`
+
+//GetSpoeAgentsHandlerImpl implementation of the GetSpoeAgentsHandler interface using client-native client
+type GetSpoeAgentsHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	SpoeDir string
+}
+
+//GetSpoeAgentHandlerImpl implementation of the GetSpoeAgentHandler interface using client-native client
+type GetSpoeAgentHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	SpoeDir string
+}
+
+//CreateSpoeAgentHandlerImpl implementation of the CreateSpoeAgentHandler interface using client-native client
+type CreateSpoeAgentHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	SpoeDir     string
+}
+
+//ReplaceSpoeAgentHandlerImpl implementation of the ReplaceSpoeAgentHandler interface using client-native client
+type ReplaceSpoeAgentHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	SpoeDir     string
+}
+
+//DeleteSpoeAgentHandlerImpl implementation of the DeleteSpoeAgentHandler interface using client-native client
+type DeleteSpoeAgentHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	SpoeDir     string
+}
+
+//EnableSpoeAgentHandlerImpl implementation of the EnableSpoeAgentHandler interface using client-native client
+type EnableSpoeAgentHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	SpoeDir     string
+}
+
+//DisableSpoeAgentHandlerImpl implementation of the DisableSpoeAgentHandler interface using client-native client
+type DisableSpoeAgentHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetSpoeAgentsHandlerImpl) Handle(params spoe_agent.GetSpoeAgentsParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewGetSpoeAgentsDefault(int(*e.Code)).WithPayload(e)
+	}
+	agents, err := getSpoeAgents(h.Client, h.SpoeDir, t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewGetSpoeAgentsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := make([]*spoe_agent.GetSpoeAgentsOKBodyDataItems0, 0, len(agents))
+	for _, a := range agents {
+		item := &spoe_agent.GetSpoeAgentsOKBodyDataItems0{}
+		if err := convertBody(a, item); err != nil {
+			e := misc.HandleError(err)
+			return spoe_agent.NewGetSpoeAgentsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+		}
+		data = append(data, item)
+	}
+	return spoe_agent.NewGetSpoeAgentsOK().WithPayload(&spoe_agent.GetSpoeAgentsOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *GetSpoeAgentHandlerImpl) Handle(params spoe_agent.GetSpoeAgentParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewGetSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+	a, err := getSpoeAgent(h.Client, h.SpoeDir, params.Name, t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewGetSpoeAgentDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data := &spoe_agent.GetSpoeAgentOKBodyData{}
+	if err := convertBody(a, data); err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewGetSpoeAgentDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return spoe_agent.NewGetSpoeAgentOK().WithPayload(&spoe_agent.GetSpoeAgentOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateSpoeAgentHandlerImpl) Handle(params spoe_agent.CreateSpoeAgentParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return spoe_agent.NewCreateSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	a := params.Data
+	a.Frontends = []string{}
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		return createSpoeAgent(h.Client, h.SpoeDir, &a, t)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewCreateSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return spoe_agent.NewCreateSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+			}
+			created := &spoe_agent.CreateSpoeAgentCreatedBody{}
+			// nolint:errcheck
+			convertBody(&a, created)
+			return spoe_agent.NewCreateSpoeAgentCreated().WithPayload(created)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := &spoe_agent.CreateSpoeAgentAcceptedBody{}
+		// nolint:errcheck
+		convertBody(&a, accepted)
+		return spoe_agent.NewCreateSpoeAgentAccepted().WithReloadID(rID).WithPayload(accepted)
+	}
+	accepted := &spoe_agent.CreateSpoeAgentAcceptedBody{}
+	// nolint:errcheck
+	convertBody(&a, accepted)
+	return spoe_agent.NewCreateSpoeAgentAccepted().WithPayload(accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceSpoeAgentHandlerImpl) Handle(params spoe_agent.ReplaceSpoeAgentParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return spoe_agent.NewReplaceSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	a := &spoe_agent.CreateSpoeAgentBody{}
+	if err := convertBody(&params.Data, a); err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewReplaceSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+	a.Name = misc.StringP(params.Name)
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		current, err := getSpoeAgent(h.Client, h.SpoeDir, params.Name, t)
+		if err != nil {
+			return err
+		}
+		// the agent is enabled again on the same frontends, with updated rules
+		for _, f := range current.Frontends {
+			if err := disableSpoeAgent(h.Client, params.Name, f, t); err != nil {
+				return err
+			}
+		}
+		if err := h.Client.Configuration.DeleteBackend(spoeAgentBackendPrefix+params.Name, t, 0); err != nil {
+			return err
+		}
+		if err := createSpoeAgent(h.Client, h.SpoeDir, a, t); err != nil {
+			return err
+		}
+		for _, f := range current.Frontends {
+			if err := enableSpoeAgent(h.Client, h.SpoeDir, a, f, t); err != nil {
+				return err
+			}
+		}
+		a.Frontends = current.Frontends
+		return nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewReplaceSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return spoe_agent.NewReplaceSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+			}
+			replaced := &spoe_agent.ReplaceSpoeAgentOKBody{}
+			// nolint:errcheck
+			convertBody(a, replaced)
+			return spoe_agent.NewReplaceSpoeAgentOK().WithPayload(replaced)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := &spoe_agent.ReplaceSpoeAgentAcceptedBody{}
+		// nolint:errcheck
+		convertBody(a, accepted)
+		return spoe_agent.NewReplaceSpoeAgentAccepted().WithReloadID(rID).WithPayload(accepted)
+	}
+	accepted := &spoe_agent.ReplaceSpoeAgentAcceptedBody{}
+	// nolint:errcheck
+	convertBody(a, accepted)
+	return spoe_agent.NewReplaceSpoeAgentAccepted().WithPayload(accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteSpoeAgentHandlerImpl) Handle(params spoe_agent.DeleteSpoeAgentParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return spoe_agent.NewDeleteSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		current, err := getSpoeAgent(h.Client, h.SpoeDir, params.Name, t)
+		if err != nil {
+			return err
+		}
+		for _, f := range current.Frontends {
+			if err := disableSpoeAgent(h.Client, params.Name, f, t); err != nil {
+				return err
+			}
+		}
+		return h.Client.Configuration.DeleteBackend(spoeAgentBackendPrefix+params.Name, t, 0)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewDeleteSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		// the SPOE file is kept while a transaction may still use it
+		// nolint:errcheck
+		os.Remove(spoeAgentFile(h.SpoeDir, params.Name))
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return spoe_agent.NewDeleteSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+			}
+			return spoe_agent.NewDeleteSpoeAgentNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return spoe_agent.NewDeleteSpoeAgentAccepted().WithReloadID(rID)
+	}
+	return spoe_agent.NewDeleteSpoeAgentAccepted()
+}
+
+//Handle executing the request and returning a response
+func (h *EnableSpoeAgentHandlerImpl) Handle(params spoe_agent.EnableSpoeAgentParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return spoe_agent.NewEnableSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		a, err := getSpoeAgent(h.Client, h.SpoeDir, params.Name, t)
+		if err != nil {
+			return err
+		}
+		return enableSpoeAgent(h.Client, h.SpoeDir, a, params.Frontend, t)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewEnableSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return spoe_agent.NewEnableSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+			}
+			return spoe_agent.NewEnableSpoeAgentNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return spoe_agent.NewEnableSpoeAgentAccepted().WithReloadID(rID)
+	}
+	return spoe_agent.NewEnableSpoeAgentAccepted()
+}
+
+//Handle executing the request and returning a response
+func (h *DisableSpoeAgentHandlerImpl) Handle(params spoe_agent.DisableSpoeAgentParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return spoe_agent.NewDisableSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeTransaction(h.Client, t, v, func(t string) error {
+		return disableSpoeAgent(h.Client, params.Name, params.Frontend, t)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return spoe_agent.NewDisableSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+	}
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return spoe_agent.NewDisableSpoeAgentDefault(int(*e.Code)).WithPayload(e)
+			}
+			return spoe_agent.NewDisableSpoeAgentNoContent()
+		}
+		rID := h.ReloadAgent.Reload()
+		return spoe_agent.NewDisableSpoeAgentAccepted().WithReloadID(rID)
+	}
+	return spoe_agent.NewDisableSpoeAgentAccepted()
+}
+
+func spoeAgentFile(spoeDir, name string) string {
+	return filepath.Join(spoeDir, fmt.Sprintf("%s.conf", name))
+}
+
+// spoeAgentVarPrefix returns the prefix of the variables set by the agent, agent
+// names can contain characters not allowed in variable names
+func spoeAgentVarPrefix(name string) string {
+	return spoeAgentVarPrefixReplace.Replace(name)
+}
+
+// spoeAgentConfig returns the content of the SPOE configuration file of an agent
+func spoeAgentConfig(a *spoe_agent.CreateSpoeAgentBody) string {
+	header := fmt.Sprintf("# spoe agent type %s action %s\n", *a.Type, a.Action)
+	backend := spoeAgentBackendPrefix + *a.Name
+	switch *a.Type {
+	case "modsecurity":
+		return header + fmt.Sprintf(modsecuritySpoeConfig, *a.Name, spoeAgentVarPrefix(*a.Name), a.TimeoutProcessing, backend)
+	case "coraza":
+		return header + fmt.Sprintf(corazaSpoeConfig, *a.Name, spoeAgentVarPrefix(*a.Name), a.TimeoutProcessing, backend, a.App)
+	}
+	return header + a.SpoeConfig
+}
+
+// spoeAgentDenyCondition returns the condition of the rule denying requests
+// flagged by the agent, empty when the agent does not block requests
+func spoeAgentDenyCondition(a *spoe_agent.CreateSpoeAgentBody) string {
+	if a.Action != "block" {
+		return ""
+	}
+	switch *a.Type {
+	case "modsecurity":
+		return fmt.Sprintf("{ var(txn.%s.code) -m int gt 0 }", spoeAgentVarPrefix(*a.Name))
+	case "coraza":
+		return fmt.Sprintf("{ var(txn.%s.action) -m str deny }", spoeAgentVarPrefix(*a.Name))
+	}
+	return ""
+}
+
+func isSpoeAgentDenyRule(r *models.HTTPRequestRule, name string) bool {
+	return r.Type == "deny" && strings.Contains(r.CondTest, fmt.Sprintf("var(txn.%s.", spoeAgentVarPrefix(name)))
+}
+
+func getSpoeAgents(client *client_native.HAProxyClient, spoeDir, t string) ([]*spoe_agent.CreateSpoeAgentBody, error) {
+	_, backends, err := client.Configuration.GetBackends(t)
+	if err != nil {
+		return nil, err
+	}
+	agents := make([]*spoe_agent.CreateSpoeAgentBody, 0)
+	for _, b := range backends {
+		if !strings.HasPrefix(b.Name, spoeAgentBackendPrefix) {
+			continue
+		}
+		a, err := getSpoeAgent(client, spoeDir, strings.TrimPrefix(b.Name, spoeAgentBackendPrefix), t)
+		if err != nil {
+			// backends with the prefix but no SPOE file are not agents
+			continue
+		}
+		agents = append(agents, a)
+	}
+	return agents, nil
+}
+
+// getSpoeAgent reads an agent from its backend, its SPOE file and the filters of the frontends
+func getSpoeAgent(client *client_native.HAProxyClient, spoeDir, name, t string) (*spoe_agent.CreateSpoeAgentBody, error) {
+	notFound := native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("SPOE agent %s does not exist", name))
+	backend := spoeAgentBackendPrefix + name
+	if _, _, err := client.Configuration.GetBackend(backend, t); err != nil {
+		return nil, notFound
+	}
+	raw, err := ioutil.ReadFile(spoeAgentFile(spoeDir, name))
+	if err != nil {
+		return nil, notFound
+	}
+	config := string(raw)
+	m := spoeAgentHeaderRegexp.FindStringSubmatch(config)
+	if m == nil {
+		return nil, notFound
+	}
+	a := &spoe_agent.CreateSpoeAgentBody{
+		Name:      misc.StringP(name),
+		Type:      misc.StringP(m[1]),
+		Action:    m[2],
+		Servers:   make([]*spoe_agent.CreateSpoeAgentBodyServersItems0, 0),
+		Frontends: make([]string, 0),
+	}
+	if *a.Type == "custom" {
+		a.SpoeConfig = strings.TrimPrefix(config, m[0])
+	}
+	if m := spoeAgentTimeoutRegexp.FindStringSubmatch(config); m != nil {
+		a.TimeoutProcessing, _ = strconv.ParseInt(m[1], 10, 64)
+	}
+	if m := spoeAgentAppRegexp.FindStringSubmatch(config); m != nil {
+		a.App = m[1]
+	}
+
+	_, servers, err := client.Configuration.GetServers(backend, t)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range servers {
+		a.Servers = append(a.Servers, &spoe_agent.CreateSpoeAgentBodyServersItems0{
+			Name:    misc.StringP(s.Name),
+			Address: misc.StringP(s.Address),
+			Port:    s.Port,
+		})
+	}
+
+	_, frontends, err := client.Configuration.GetFrontends(t)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range frontends {
+		_, filters, err := client.Configuration.GetFilters("frontend", f.Name, t)
+		if err != nil {
+			return nil, err
+		}
+		for _, fl := range filters {
+			if fl.Type == "spoe" && fl.SpoeEngine == name {
+				a.Frontends = append(a.Frontends, f.Name)
+				break
+			}
+		}
+	}
+	return a, nil
+}
+
+// createSpoeAgent adds the backend of agent servers and writes the SPOE file
+func createSpoeAgent(client *client_native.HAProxyClient, spoeDir string, a *spoe_agent.CreateSpoeAgentBody, t string) error {
+	if a.Action == "" {
+		a.Action = "block"
+	}
+	if a.TimeoutProcessing == 0 {
+		a.TimeoutProcessing = 500
+	}
+	if *a.Type == "coraza" && a.App == "" {
+		a.App = "sample_app"
+	}
+	if *a.Type == "custom" && !strings.Contains(a.SpoeConfig, fmt.Sprintf("[%s]", *a.Name)) {
+		return native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("spoe_config of custom agents must have the [%s] scope", *a.Name))
+	}
+
+	backend := spoeAgentBackendPrefix + *a.Name
+	if _, _, err := client.Configuration.GetBackend(backend, t); err == nil {
+		return native_configuration.NewConfError(native_configuration.ErrObjectAlreadyExists, fmt.Sprintf("SPOE agent %s already exists", *a.Name))
+	}
+	if err := client.Configuration.CreateBackend(&models.Backend{Name: backend, Mode: "tcp"}, t, 0); err != nil {
+		return err
+	}
+	for _, s := range a.Servers {
+		server := &models.Server{Name: *s.Name, Address: *s.Address, Port: s.Port}
+		if err := client.Configuration.CreateServer(backend, server, t, 0); err != nil {
+			return err
+		}
+	}
+
+	if err := system.MkdirAll(spoeDir, 0755); err != nil {
+		return err
+	}
+	return system.WriteFile(spoeAgentFile(spoeDir, *a.Name), []byte(spoeAgentConfig(a)), 0644)
+}
+
+// enableSpoeAgent adds the SPOE filter of the agent to the frontend, and the deny
+// rule before the other http-request rules for blocking agents
+func enableSpoeAgent(client *client_native.HAProxyClient, spoeDir string, a *spoe_agent.CreateSpoeAgentBody, frontend, t string) error {
+	_, filters, err := client.Configuration.GetFilters("frontend", frontend, t)
+	if err != nil {
+		return err
+	}
+	for _, f := range filters {
+		if f.Type == "spoe" && f.SpoeEngine == *a.Name {
+			return nil
+		}
+	}
+	filter := &models.Filter{
+		Index:      misc.Int64P(len(filters)),
+		Type:       "spoe",
+		SpoeEngine: *a.Name,
+		SpoeConfig: spoeAgentFile(spoeDir, *a.Name),
+	}
+	if err := client.Configuration.CreateFilter("frontend", frontend, filter, t, 0); err != nil {
+		return err
+	}
+	cond := spoeAgentDenyCondition(a)
+	if cond == "" {
+		return nil
+	}
+	deny := &models.HTTPRequestRule{
+		Index:    misc.Int64P(0),
+		Type:     "deny",
+		Cond:     "if",
+		CondTest: cond,
+	}
+	return client.Configuration.CreateHTTPRequestRule("frontend", frontend, deny, t, 0)
+}
+
+// disableSpoeAgent removes the SPOE filter and the deny rule of the agent from the frontend
+func disableSpoeAgent(client *client_native.HAProxyClient, name, frontend, t string) error {
+	_, filters, err := client.Configuration.GetFilters("frontend", frontend, t)
+	if err != nil {
+		return err
+	}
+	for i := len(filters) - 1; i >= 0; i-- {
+		if filters[i].Type == "spoe" && filters[i].SpoeEngine == name {
+			if err := client.Configuration.DeleteFilter(*filters[i].Index, "frontend", frontend, t, 0); err != nil {
+				return err
+			}
+		}
+	}
+	_, rules, err := client.Configuration.GetHTTPRequestRules("frontend", frontend, t)
+	if err != nil {
+		return err
+	}
+	for i := len(rules) - 1; i >= 0; i-- {
+		if isSpoeAgentDenyRule(rules[i], name) {
+			if err := client.Configuration.DeleteHTTPRequestRule(*rules[i].Index, "frontend", frontend, t, 0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ package handlers
 import (
 	"github.com/go-openapi/runtime/middleware"
 	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/dataplaneapi/metrics"
 	"github.com/haproxytech/dataplaneapi/misc"
 	"github.com/haproxytech/dataplaneapi/operations/stats"
 	"github.com/haproxytech/models/v2"
@@ -28,6 +29,11 @@ type GetStatsHandlerImpl struct {
 	Client *client_native.HAProxyClient
 }
 
+//GetDataplaneStatsHandlerImpl implementation of the GetDataplaneStatsHandler interface
+type GetDataplaneStatsHandlerImpl struct {
+	Metrics *metrics.Store
+}
+
 //Handle executing the request and returning a response
 func (h *GetStatsHandlerImpl) Handle(params stats.GetStatsParams, principal interface{}) middleware.Responder {
 	if params.Name != nil {
@@ -87,3 +93,20 @@ func (h *GetStatsHandlerImpl) Handle(params stats.GetStatsParams, principal inte
 	}
 	return stats.NewGetStatsOK().WithPayload(s)
 }
+
+//Handle executing the request and returning a response
+func (h *GetDataplaneStatsHandlerImpl) Handle(params stats.GetDataplaneStatsParams, principal interface{}) middleware.Responder {
+	days := h.Metrics.Days(int(*params.Days))
+	data := make([]*stats.GetDataplaneStatsOKBodyItems0, 0, len(days))
+	for _, d := range days {
+		data = append(data, &stats.GetDataplaneStatsOKBodyItems0{
+			Date:               d.Date,
+			Transactions:       d.Transactions,
+			FailedTransactions: d.FailedTransactions,
+			ReloadsSucceeded:   d.ReloadsSucceeded,
+			ReloadsFailed:      d.ReloadsFailed,
+			AverageCommitTime:  d.AverageCommitTime(),
+		})
+	}
+	return stats.NewGetDataplaneStatsOK().WithPayload(data)
+}
@@ -0,0 +1,703 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	parser_errors "github.com/haproxytech/config-parser/v2/errors"
+	"github.com/haproxytech/config-parser/v2/params"
+	"github.com/haproxytech/config-parser/v2/parsers/stats"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/stats_page"
+)
+
+// statsPageKeywords are the stats keywords managed through the stats page endpoints,
+// other stats lines (maxconn, scope, show-desc...) are left untouched
+var statsPageKeywords = map[string]bool{
+	"enable":       true,
+	"uri":          true,
+	"realm":        true,
+	"auth":         true,
+	"refresh":      true,
+	"admin":        true,
+	"hide-version": true,
+	"show-legends": true,
+}
+
+// statsBindName is the name of the bind line holding the stats page address
+const statsBindName = "stats"
+
+//GetStatsPageHandlerImpl implementation of the GetStatsPageHandler interface
+type GetStatsPageHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceStatsPageHandlerImpl implementation of the ReplaceStatsPageHandler interface
+type ReplaceStatsPageHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//GetStatsSocketsHandlerImpl implementation of the GetStatsSocketsHandler interface
+type GetStatsSocketsHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceStatsSocketsHandlerImpl implementation of the ReplaceStatsSocketsHandler interface
+type ReplaceStatsSocketsHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+}
+
+//Handle executing the request and returning a response
+func (h *GetStatsPageHandlerImpl) Handle(params stats_page.GetStatsPageParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewGetStatsPageDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewGetStatsPageDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	section := parser.Section(params.ParentType)
+	if err := checkSectionExists(p, section, params.ParentName); err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewGetStatsPageDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	lines, err := getStatsLines(p, section, params.ParentName)
+	if err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewGetStatsPageDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	page := parseStatsPage(lines)
+	page.Bind, err = getStatsBind(p, section, params.ParentName)
+	if err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewGetStatsPageDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return stats_page.NewGetStatsPageOK().WithPayload(page).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceStatsPageHandlerImpl) Handle(params stats_page.ReplaceStatsPageParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return stats_page.NewReplaceStatsPageDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	page := stats_page.GetStatsPageOKBody(params.Data)
+	if err := validateStatsPage(&page, parser.Section(params.ParentType)); err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewReplaceStatsPageDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	section := parser.Section(params.ParentType)
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		if err := checkSectionExists(p, section, params.ParentName); err != nil {
+			return err
+		}
+		if err := setStatsLines(p, section, params.ParentName, statsPageLines(&page)); err != nil {
+			return err
+		}
+		return setStatsBind(p, section, params.ParentName, page.Bind)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewReplaceStatsPageDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return stats_page.NewReplaceStatsPageDefault(int(*e.Code)).WithPayload(e)
+			}
+			okBody := stats_page.ReplaceStatsPageOKBody(page)
+			return stats_page.NewReplaceStatsPageOK().WithPayload(&okBody)
+		}
+		rID := h.ReloadAgent.Reload()
+		acceptedBody := stats_page.ReplaceStatsPageAcceptedBody(page)
+		return stats_page.NewReplaceStatsPageAccepted().WithReloadID(rID).WithPayload(&acceptedBody)
+	}
+	acceptedBody := stats_page.ReplaceStatsPageAcceptedBody(page)
+	return stats_page.NewReplaceStatsPageAccepted().WithPayload(&acceptedBody)
+}
+
+//Handle executing the request and returning a response
+func (h *GetStatsSocketsHandlerImpl) Handle(params stats_page.GetStatsSocketsParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewGetStatsSocketsDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewGetStatsSocketsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	sockets, err := getStatsSockets(p)
+	if err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewGetStatsSocketsDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return stats_page.NewGetStatsSocketsOK().WithPayload(sockets).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceStatsSocketsHandlerImpl) Handle(params stats_page.ReplaceStatsSocketsParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return stats_page.NewReplaceStatsSocketsDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	sockets := make([]*stats_page.GetStatsSocketsOKBodyItems0, 0, len(params.Data))
+	for _, d := range params.Data {
+		s := stats_page.GetStatsSocketsOKBodyItems0(*d)
+		sockets = append(sockets, &s)
+	}
+	if err := validateStatsSockets(sockets); err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewReplaceStatsSocketsDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		return setStatsSockets(p, sockets)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return stats_page.NewReplaceStatsSocketsDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return stats_page.NewReplaceStatsSocketsDefault(int(*e.Code)).WithPayload(e)
+			}
+			okBody := make([]*stats_page.ReplaceStatsSocketsOKBodyItems0, 0, len(sockets))
+			for _, s := range sockets {
+				i := stats_page.ReplaceStatsSocketsOKBodyItems0(*s)
+				okBody = append(okBody, &i)
+			}
+			return stats_page.NewReplaceStatsSocketsOK().WithPayload(okBody)
+		}
+		rID := h.ReloadAgent.Reload()
+		return stats_page.NewReplaceStatsSocketsAccepted().WithReloadID(rID).WithPayload(acceptedStatsSockets(sockets))
+	}
+	return stats_page.NewReplaceStatsSocketsAccepted().WithPayload(acceptedStatsSockets(sockets))
+}
+
+func acceptedStatsSockets(sockets []*stats_page.GetStatsSocketsOKBodyItems0) []*stats_page.ReplaceStatsSocketsAcceptedBodyItems0 {
+	body := make([]*stats_page.ReplaceStatsSocketsAcceptedBodyItems0, 0, len(sockets))
+	for _, s := range sockets {
+		i := stats_page.ReplaceStatsSocketsAcceptedBodyItems0(*s)
+		body = append(body, &i)
+	}
+	return body
+}
+
+func validateStatsPage(page *stats_page.GetStatsPageOKBody, section parser.Section) error {
+	fields := map[string]string{
+		"uri":             page.URI,
+		"realm":           page.Realm,
+		"admin_cond_test": page.AdminCondTest,
+		"bind":            page.Bind,
+	}
+	for i, a := range page.Auth {
+		fields[fmt.Sprintf("auth[%d]", i)] = a
+	}
+	if err := validateNoLineBreaks(fields); err != nil {
+		return err
+	}
+	if page.Bind != "" && section == parser.Backends {
+		return configuration.NewConfError(configuration.ErrValidationError, "bind is not available in backends")
+	}
+	for _, a := range page.Auth {
+		userPass := strings.SplitN(a, ":", 2)
+		if len(userPass) != 2 || userPass[0] == "" || userPass[1] == "" || strings.ContainsAny(a, " \t") {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("invalid stats auth %s, expected user:password", a))
+		}
+	}
+	if (page.AdminCond == "") != (page.AdminCondTest == "") {
+		return configuration.NewConfError(configuration.ErrValidationError, "admin_cond and admin_cond_test must be set together")
+	}
+	if page.Refresh != nil && *page.Refresh <= 0 {
+		return configuration.NewConfError(configuration.ErrValidationError, "refresh must be a positive number of seconds")
+	}
+	return nil
+}
+
+// validateNoLineBreaks rejects values that would split a configuration line,
+// fields maps the field names reported in the error to their values
+func validateNoLineBreaks(fields map[string]string) error {
+	names := make([]string, 0, len(fields))
+	for n := range fields {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		if strings.ContainsAny(fields[n], "\r\n") {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("%s must not contain line breaks", n))
+		}
+	}
+	return nil
+}
+
+// getStatsLines returns all stats lines of a section. Listen sections are not
+// handled by the configuration parser, their stats lines are read as is.
+func getStatsLines(p *parser.Parser, section parser.Section, name string) ([]string, error) {
+	lines := make([]string, 0)
+	if section == parser.Listen {
+		data, err := p.Get(section, name, "")
+		if err != nil {
+			if err == parser_errors.ErrFetch {
+				return lines, nil
+			}
+			return nil, err
+		}
+		for _, l := range data.([]types.UnProcessed) {
+			if strings.HasPrefix(l.Value, "stats ") {
+				lines = append(lines, l.Value)
+			}
+		}
+		return lines, nil
+	}
+	data, err := p.Get(section, name, "stats")
+	if err != nil {
+		if err == parser_errors.ErrFetch {
+			return lines, nil
+		}
+		return nil, err
+	}
+	for _, s := range data.([]types.StatsSettings) {
+		lines = append(lines, "stats "+s.String())
+	}
+	return lines, nil
+}
+
+// setStatsLines replaces the managed stats lines of a section with the given ones
+func setStatsLines(p *parser.Parser, section parser.Section, name string, lines []string) error {
+	current, err := getStatsLines(p, section, name)
+	if err != nil {
+		return err
+	}
+	kept := make([]string, 0, len(current))
+	for _, l := range current {
+		if !isStatsPageLine(l) {
+			kept = append(kept, l)
+		}
+	}
+	lines = append(lines, kept...)
+
+	if section == parser.Listen {
+		data, err := p.Get(section, name, "", true)
+		if err != nil {
+			return err
+		}
+		unprocessed := make([]types.UnProcessed, 0)
+		for _, l := range data.([]types.UnProcessed) {
+			if !strings.HasPrefix(l.Value, "stats ") {
+				unprocessed = append(unprocessed, l)
+			}
+		}
+		for _, l := range lines {
+			unprocessed = append(unprocessed, types.UnProcessed{Value: l})
+		}
+		return p.Set(section, name, "", unprocessed)
+	}
+
+	if len(lines) == 0 {
+		return p.Set(section, name, "stats", nil)
+	}
+	sp := &stats.Stats{Mode: string(section)}
+	sp.Init()
+	for _, l := range lines {
+		if _, err := sp.Parse(l, strings.Fields(l), nil, ""); err != nil {
+			return configuration.NewConfError(configuration.ErrValidationError, err.Error())
+		}
+	}
+	data, err := sp.Get(true)
+	if err != nil {
+		return err
+	}
+	return p.Set(section, name, "stats", data)
+}
+
+func isStatsPageLine(line string) bool {
+	fields := strings.Fields(line)
+	return len(fields) > 1 && statsPageKeywords[fields[1]]
+}
+
+func parseStatsPage(lines []string) *stats_page.GetStatsPageOKBody {
+	page := &stats_page.GetStatsPageOKBody{
+		Auth: make([]string, 0),
+	}
+	for _, l := range lines {
+		fields := strings.Fields(l)
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[1] {
+		case "enable":
+			page.Enable = true
+		case "hide-version":
+			page.HideVersion = true
+		case "show-legends":
+			page.ShowLegends = true
+		case "uri":
+			if len(fields) > 2 {
+				page.URI = fields[2]
+			}
+		case "realm":
+			page.Realm = strings.Replace(strings.Join(fields[2:], " "), `\ `, " ", -1)
+		case "auth":
+			if len(fields) > 2 {
+				page.Auth = append(page.Auth, fields[2])
+			}
+		case "refresh":
+			if len(fields) > 2 {
+				page.Refresh = parseRefresh(fields[2])
+			}
+		case "admin":
+			if len(fields) > 3 {
+				page.AdminCond = fields[2]
+				page.AdminCondTest = strings.Join(fields[3:], " ")
+			}
+		}
+	}
+	return page
+}
+
+// parseRefresh returns the refresh delay in seconds, HAProxy defaults to seconds when no unit is set
+func parseRefresh(delay string) *int64 {
+	if v, err := strconv.ParseInt(delay, 10, 64); err == nil {
+		return &v
+	}
+	units := []struct {
+		suffix string
+		factor int64
+	}{{"ms", 0}, {"s", 1}, {"m", 60}, {"h", 3600}, {"d", 86400}}
+	for _, u := range units {
+		if strings.HasSuffix(delay, u.suffix) {
+			v, err := strconv.ParseInt(strings.TrimSuffix(delay, u.suffix), 10, 64)
+			if err != nil {
+				return nil
+			}
+			if u.factor == 0 {
+				v = v / 1000
+			} else {
+				v = v * u.factor
+			}
+			return &v
+		}
+	}
+	return nil
+}
+
+func statsPageLines(page *stats_page.GetStatsPageOKBody) []string {
+	lines := make([]string, 0)
+	if page.Enable {
+		lines = append(lines, "stats enable")
+	}
+	if page.URI != "" {
+		lines = append(lines, "stats uri "+page.URI)
+	}
+	if page.Realm != "" {
+		lines = append(lines, "stats realm "+strings.Replace(page.Realm, " ", `\ `, -1))
+	}
+	for _, a := range page.Auth {
+		lines = append(lines, "stats auth "+a)
+	}
+	if page.Refresh != nil {
+		lines = append(lines, fmt.Sprintf("stats refresh %ds", *page.Refresh))
+	}
+	if page.AdminCond != "" {
+		lines = append(lines, fmt.Sprintf("stats admin %s %s", page.AdminCond, page.AdminCondTest))
+	}
+	if page.HideVersion {
+		lines = append(lines, "stats hide-version")
+	}
+	if page.ShowLegends {
+		lines = append(lines, "stats show-legends")
+	}
+	return lines
+}
+
+// getStatsBind returns the address of the bind line named stats of a frontend
+// or listen section
+func getStatsBind(p *parser.Parser, section parser.Section, name string) (string, error) {
+	switch section {
+	case parser.Frontends:
+		data, err := p.Get(section, name, "bind")
+		if err != nil {
+			if err == parser_errors.ErrFetch {
+				return "", nil
+			}
+			return "", err
+		}
+		for _, b := range data.([]types.Bind) {
+			if isStatsBind(b.Params) {
+				return b.Path, nil
+			}
+		}
+	case parser.Listen:
+		data, err := p.Get(section, name, "")
+		if err != nil {
+			if err == parser_errors.ErrFetch {
+				return "", nil
+			}
+			return "", err
+		}
+		for _, l := range data.([]types.UnProcessed) {
+			if address, ok := parseStatsBindLine(l.Value); ok {
+				return address, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// setStatsBind replaces the bind line named stats of a frontend or listen
+// section, an empty address removes it
+func setStatsBind(p *parser.Parser, section parser.Section, name string, address string) error {
+	switch section {
+	case parser.Frontends:
+		binds := make([]types.Bind, 0)
+		data, err := p.Get(section, name, "bind")
+		if err != nil && err != parser_errors.ErrFetch {
+			return err
+		}
+		if err == nil {
+			for _, b := range data.([]types.Bind) {
+				if !isStatsBind(b.Params) {
+					binds = append(binds, b)
+				}
+			}
+		}
+		if address != "" {
+			binds = append(binds, types.Bind{
+				Path:   address,
+				Params: []params.BindOption{&params.BindOptionValue{Name: "name", Value: statsBindName}},
+			})
+		}
+		if len(binds) == 0 {
+			return p.Set(section, name, "bind", nil)
+		}
+		return p.Set(section, name, "bind", binds)
+	case parser.Listen:
+		data, err := p.Get(section, name, "", true)
+		if err != nil {
+			return err
+		}
+		unprocessed := make([]types.UnProcessed, 0)
+		for _, l := range data.([]types.UnProcessed) {
+			if _, ok := parseStatsBindLine(l.Value); !ok {
+				unprocessed = append(unprocessed, l)
+			}
+		}
+		if address != "" {
+			unprocessed = append(unprocessed, types.UnProcessed{Value: fmt.Sprintf("bind %s name %s", address, statsBindName)})
+		}
+		return p.Set(section, name, "", unprocessed)
+	}
+	return nil
+}
+
+// parseStatsBindLine returns the address of a raw bind line if it is named stats
+func parseStatsBindLine(line string) (string, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 || fields[0] != "bind" {
+		return "", false
+	}
+	return fields[1], isStatsBind(params.ParseBindOptions(fields[2:]))
+}
+
+func isStatsBind(options []params.BindOption) bool {
+	for _, o := range options {
+		if v, ok := o.(*params.BindOptionValue); ok && v.Name == "name" && v.Value == statsBindName {
+			return true
+		}
+	}
+	return false
+}
+
+// statsSocketOptions are the stats socket options managed through the stats
+// sockets endpoints, other options (process, ...) are kept on replace
+var statsSocketOptions = []string{"level", "mode", "user", "group", "expose-fd"}
+
+func validateStatsSockets(sockets []*stats_page.GetStatsSocketsOKBodyItems0) error {
+	paths := make(map[string]bool)
+	for _, s := range sockets {
+		if s.Path == nil || *s.Path == "" {
+			return configuration.NewConfError(configuration.ErrValidationError, "stats socket path is required")
+		}
+		err := validateNoLineBreaks(map[string]string{
+			"path":  *s.Path,
+			"mode":  s.Mode,
+			"user":  s.User,
+			"group": s.Group,
+		})
+		if err != nil {
+			return err
+		}
+		if strings.ContainsAny(*s.Path+s.User+s.Group, " \t") {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("stats socket %s: path, user and group must not contain spaces", *s.Path))
+		}
+		if paths[*s.Path] {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("stats socket %s is set more than once", *s.Path))
+		}
+		paths[*s.Path] = true
+	}
+	return nil
+}
+
+// getStatsSockets returns the stats sockets of the global section
+func getStatsSockets(p *parser.Parser) ([]*stats_page.GetStatsSocketsOKBodyItems0, error) {
+	sockets := make([]*stats_page.GetStatsSocketsOKBodyItems0, 0)
+	data, err := p.Get(parser.Global, parser.GlobalSectionName, "stats socket")
+	if err != nil {
+		if err == parser_errors.ErrFetch {
+			return sockets, nil
+		}
+		return nil, err
+	}
+	for _, socket := range data.([]types.Socket) {
+		path := socket.Path
+		s := &stats_page.GetStatsSocketsOKBodyItems0{Path: &path}
+		for _, o := range socket.Params {
+			switch v := o.(type) {
+			case *params.BindOptionDoubleWord:
+				if v.Name == "expose-fd" && v.Value == "listeners" {
+					s.ExposeFdListeners = true
+				}
+			case *params.BindOptionValue:
+				switch v.Name {
+				case "level":
+					s.Level = v.Value
+				case "mode":
+					s.Mode = v.Value
+				case "user":
+					s.User = v.Value
+				case "group":
+					s.Group = v.Value
+				}
+			}
+		}
+		sockets = append(sockets, s)
+	}
+	return sockets, nil
+}
+
+// setStatsSockets replaces the stats sockets of the global section, options not
+// managed by the endpoints are kept on sockets with the same path
+func setStatsSockets(p *parser.Parser, sockets []*stats_page.GetStatsSocketsOKBodyItems0) error {
+	kept := make(map[string][]params.BindOption)
+	data, err := p.Get(parser.Global, parser.GlobalSectionName, "stats socket")
+	if err != nil && err != parser_errors.ErrFetch {
+		return err
+	}
+	if err == nil {
+		for _, socket := range data.([]types.Socket) {
+			for _, o := range socket.Params {
+				if !containsOption(bindOptionName(o), statsSocketOptions) {
+					kept[socket.Path] = append(kept[socket.Path], o)
+				}
+			}
+		}
+	}
+	lines := make([]types.Socket, 0, len(sockets))
+	for _, s := range sockets {
+		lines = append(lines, types.Socket{
+			Path:   *s.Path,
+			Params: append(statsSocketParams(s), kept[*s.Path]...),
+		})
+	}
+	if len(lines) == 0 {
+		return p.Set(parser.Global, parser.GlobalSectionName, "stats socket", nil)
+	}
+	return p.Set(parser.Global, parser.GlobalSectionName, "stats socket", lines)
+}
+
+func statsSocketParams(s *stats_page.GetStatsSocketsOKBodyItems0) []params.BindOption {
+	options := make([]params.BindOption, 0)
+	values := []struct {
+		name  string
+		value string
+	}{{"level", s.Level}, {"mode", s.Mode}, {"user", s.User}, {"group", s.Group}}
+	for _, v := range values {
+		if v.value != "" {
+			options = append(options, &params.BindOptionValue{Name: v.name, Value: v.value})
+		}
+	}
+	if s.ExposeFdListeners {
+		options = append(options, &params.BindOptionDoubleWord{Name: "expose-fd", Value: "listeners"})
+	}
+	return options
+}
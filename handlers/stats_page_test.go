@@ -0,0 +1,225 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	parser "github.com/haproxytech/config-parser/v2"
+
+	"github.com/haproxytech/dataplaneapi/operations/stats_page"
+)
+
+const statsTestConfig = `
+global
+  stats socket /var/run/haproxy.sock level admin mode 660 process 1
+  stats socket 127.0.0.1:9999 level user
+
+frontend web
+  bind :80 name http
+  bind :8404 name stats
+
+backend app
+  server s1 10.0.0.1:80
+
+listen admin
+  bind :9000
+  bind 127.0.0.1:8405 name stats
+  stats enable
+`
+
+func statsTestParser(t *testing.T) *parser.Parser {
+	p := &parser.Parser{}
+	if err := p.ParseData(statsTestConfig); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestValidateStatsPage(t *testing.T) {
+	refresh := int64(0)
+	tests := []struct {
+		name    string
+		page    stats_page.GetStatsPageOKBody
+		section parser.Section
+		err     string
+	}{
+		{"valid", stats_page.GetStatsPageOKBody{URI: "/stats", Auth: []string{"admin:secret"}, Bind: ":8404"}, parser.Frontends, ""},
+		{"line break in uri", stats_page.GetStatsPageOKBody{URI: "/stats\n  stats enable"}, parser.Frontends, "uri must not contain line breaks"},
+		{"carriage return in realm", stats_page.GetStatsPageOKBody{Realm: "HAProxy\r"}, parser.Frontends, "realm must not contain line breaks"},
+		{"line break in auth", stats_page.GetStatsPageOKBody{Auth: []string{"admin:secret", "a:b\nc"}}, parser.Frontends, "auth[1] must not contain line breaks"},
+		{"line break in admin test", stats_page.GetStatsPageOKBody{AdminCond: "if", AdminCondTest: "TRUE\nstats enable"}, parser.Frontends, "admin_cond_test must not contain line breaks"},
+		{"line break in bind", stats_page.GetStatsPageOKBody{Bind: ":8404\nbind :80"}, parser.Frontends, "bind must not contain line breaks"},
+		{"bind in backend", stats_page.GetStatsPageOKBody{Bind: ":8404"}, parser.Backends, "bind is not available in backends"},
+		{"invalid auth", stats_page.GetStatsPageOKBody{Auth: []string{"admin"}}, parser.Listen, "invalid stats auth"},
+		{"admin cond alone", stats_page.GetStatsPageOKBody{AdminCond: "if"}, parser.Listen, "must be set together"},
+		{"zero refresh", stats_page.GetStatsPageOKBody{Refresh: &refresh}, parser.Listen, "refresh must be a positive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateStatsPage(&tt.page, tt.section)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestStatsBind(t *testing.T) {
+	tests := []struct {
+		name     string
+		section  parser.Section
+		parent   string
+		current  string
+		address  string
+		contains []string
+		absent   []string
+	}{
+		{"replace frontend bind", parser.Frontends, "web", ":8404", "127.0.0.1:8080", []string{"bind :80 name http", "bind 127.0.0.1:8080 name stats"}, []string{":8404"}},
+		{"remove frontend bind", parser.Frontends, "web", ":8404", "", []string{"bind :80 name http"}, []string{":8404"}},
+		{"replace listen bind", parser.Listen, "admin", "127.0.0.1:8405", ":8406", []string{"bind :9000", "bind :8406 name stats", "stats enable"}, []string{"8405"}},
+		{"remove listen bind", parser.Listen, "admin", "127.0.0.1:8405", "", []string{"bind :9000"}, []string{"8405"}},
+		{"backend", parser.Backends, "app", "", "", []string{"server s1 10.0.0.1:80", "bind :8404 name stats", "bind 127.0.0.1:8405 name stats"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := statsTestParser(t)
+			current, err := getStatsBind(p, tt.section, tt.parent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if current != tt.current {
+				t.Fatalf("expected bind %q, got %q", tt.current, current)
+			}
+			if err := setStatsBind(p, tt.section, tt.parent, tt.address); err != nil {
+				t.Fatal(err)
+			}
+			got, err := getStatsBind(p, tt.section, tt.parent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.address {
+				t.Fatalf("expected bind %q after replace, got %q", tt.address, got)
+			}
+			conf := p.String()
+			for _, c := range tt.contains {
+				if !strings.Contains(conf, c) {
+					t.Errorf("expected %q in configuration:\n%s", c, conf)
+				}
+			}
+			for _, c := range tt.absent {
+				if strings.Contains(conf, c) {
+					t.Errorf("unexpected %q in configuration:\n%s", c, conf)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStatsSockets(t *testing.T) {
+	str := func(s string) *string { return &s }
+	tests := []struct {
+		name    string
+		sockets []*stats_page.GetStatsSocketsOKBodyItems0
+		err     string
+	}{
+		{"valid", []*stats_page.GetStatsSocketsOKBodyItems0{{Path: str("/var/run/haproxy.sock"), Level: "admin", Mode: "660", User: "haproxy"}}, ""},
+		{"missing path", []*stats_page.GetStatsSocketsOKBodyItems0{{Level: "admin"}}, "path is required"},
+		{"line break in path", []*stats_page.GetStatsSocketsOKBodyItems0{{Path: str("/tmp/a.sock\n  nbproc 2")}}, "path must not contain line breaks"},
+		{"carriage return in user", []*stats_page.GetStatsSocketsOKBodyItems0{{Path: str("/tmp/a.sock"), User: "haproxy\r"}}, "user must not contain line breaks"},
+		{"line break in group", []*stats_page.GetStatsSocketsOKBodyItems0{{Path: str("/tmp/a.sock"), Group: "\nhaproxy"}}, "group must not contain line breaks"},
+		{"line break in mode", []*stats_page.GetStatsSocketsOKBodyItems0{{Path: str("/tmp/a.sock"), Mode: "600\n"}}, "mode must not contain line breaks"},
+		{"space in path", []*stats_page.GetStatsSocketsOKBodyItems0{{Path: str("/tmp/a.sock level admin")}}, "must not contain spaces"},
+		{"duplicate path", []*stats_page.GetStatsSocketsOKBodyItems0{{Path: str("/tmp/a.sock")}, {Path: str("/tmp/a.sock")}}, "more than once"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateStatsSockets(tt.sockets)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestStatsSockets(t *testing.T) {
+	str := func(s string) *string { return &s }
+	p := statsTestParser(t)
+	sockets, err := getStatsSockets(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []*stats_page.GetStatsSocketsOKBodyItems0{
+		{Path: str("/var/run/haproxy.sock"), Level: "admin", Mode: "660"},
+		{Path: str("127.0.0.1:9999"), Level: "user"},
+	}
+	if !reflect.DeepEqual(sockets, expected) {
+		t.Fatalf("unexpected sockets %+v", sockets)
+	}
+
+	replaced := []*stats_page.GetStatsSocketsOKBodyItems0{
+		{Path: str("/var/run/haproxy.sock"), Level: "operator", Mode: "600", User: "haproxy", Group: "haproxy", ExposeFdListeners: true},
+		{Path: str("/var/run/admin.sock"), Level: "admin"},
+	}
+	if err := setStatsSockets(p, replaced); err != nil {
+		t.Fatal(err)
+	}
+	conf := p.String()
+	for _, c := range []string{
+		"stats socket /var/run/haproxy.sock level operator mode 600 user haproxy group haproxy expose-fd listeners process 1",
+		"stats socket /var/run/admin.sock level admin",
+	} {
+		if !strings.Contains(conf, c) {
+			t.Errorf("expected %q in configuration:\n%s", c, conf)
+		}
+	}
+	if strings.Contains(conf, "9999") {
+		t.Errorf("unexpected removed socket in configuration:\n%s", conf)
+	}
+
+	reloaded := &parser.Parser{}
+	if err := reloaded.ParseData(conf); err != nil {
+		t.Fatal(err)
+	}
+	sockets, err = getStatsSockets(reloaded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sockets, replaced) {
+		t.Fatalf("unexpected sockets after reload %+v", sockets)
+	}
+
+	if err := setStatsSockets(p, nil); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(p.String(), "stats socket") {
+		t.Errorf("unexpected stats socket in configuration:\n%s", p.String())
+	}
+}
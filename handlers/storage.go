@@ -0,0 +1,510 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/storage"
+	"github.com/haproxytech/models/v2"
+)
+
+//GetStorageCleanupHandlerImpl implementation of the GetStorageCleanupHandler interface
+type GetStorageCleanupHandlerImpl struct {
+	Client *client_native.HAProxyClient
+	Config *configuration.Configuration
+	// MapsDir is the maps storage area, unset when map files are not stored
+	MapsDir string
+	// GeneralDir is the general storage area
+	GeneralDir string
+}
+
+//GetAllStorageGeneralFilesHandlerImpl implementation of the GetAllStorageGeneralFilesHandler interface
+type GetAllStorageGeneralFilesHandlerImpl struct {
+	Client *client_native.HAProxyClient
+	Dir    string
+}
+
+//CreateStorageGeneralFileHandlerImpl implementation of the CreateStorageGeneralFileHandler interface
+type CreateStorageGeneralFileHandlerImpl struct {
+	Client *client_native.HAProxyClient
+	Dir    string
+	// Quota, if set, rejects the files exceeding the quota of the general storage
+	Quota *configuration.StorageQuota
+	// SNIConflictPolicy is haproxy.SNIConflictWarn or haproxy.SNIConflictReject
+	SNIConflictPolicy string
+	// TransactionStorage stages the files uploaded in transactions
+	TransactionStorage *haproxy.TransactionStorage
+}
+
+//GetOneStorageGeneralFileHandlerImpl implementation of the GetOneStorageGeneralFileHandler interface
+type GetOneStorageGeneralFileHandlerImpl struct {
+	Dir string
+}
+
+//ReplaceStorageGeneralFileHandlerImpl implementation of the ReplaceStorageGeneralFileHandler interface
+type ReplaceStorageGeneralFileHandlerImpl struct {
+	Client             *client_native.HAProxyClient
+	ReloadAgent        haproxy.IReloadAgent
+	Dir                string
+	Quota              *configuration.StorageQuota
+	SNIConflictPolicy  string
+	TransactionStorage *haproxy.TransactionStorage
+}
+
+//DeleteStorageGeneralFileHandlerImpl implementation of the DeleteStorageGeneralFileHandler interface
+type DeleteStorageGeneralFileHandlerImpl struct {
+	Client             *client_native.HAProxyClient
+	Dir                string
+	TransactionStorage *haproxy.TransactionStorage
+}
+
+//Handle executing the request and returning a response
+func (h *GetStorageCleanupHandlerImpl) Handle(params storage.GetStorageCleanupParams, principal interface{}) middleware.Responder {
+	body := &storage.GetStorageCleanupOKBody{
+		Areas:        make([]*storage.GetStorageCleanupOKBodyAreasItems0, 0),
+		Unreferenced: make([]*storage.GetStorageCleanupOKBodyUnreferencedItems0, 0),
+	}
+	config, err := currentConfiguration(h.Client)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewGetStorageCleanupDefault(int(*e.Code)).WithPayload(e)
+	}
+	loaded := make(map[string]bool)
+	if h.Client.Runtime != nil {
+		// maps are not listed when the runtime API is unavailable, the
+		// configuration still references the maps HAProxy loads
+		if mapFiles, err := h.Client.Runtime.ShowMaps(); err == nil {
+			for _, m := range mapFiles {
+				loaded[m.File] = true
+			}
+		}
+	}
+
+	areas := []struct {
+		name  string
+		dir   string
+		quota *configuration.StorageQuota
+	}{
+		{"maps", h.MapsDir, nil},
+		{"general", h.GeneralDir, nil},
+	}
+	if h.Config.Storage != nil {
+		areas[0].quota = h.Config.Storage.Maps
+		areas[1].quota = h.Config.Storage.General
+	}
+	for _, a := range areas {
+		if a.dir == "" {
+			continue
+		}
+		files, err := haproxy.StorageFiles(a.dir)
+		if err != nil {
+			e := misc.HandleError(err)
+			return storage.NewGetStorageCleanupDefault(int(*e.Code)).WithPayload(e)
+		}
+		area := &storage.GetStorageCleanupOKBodyAreasItems0{Name: a.name, Path: a.dir}
+		if a.quota != nil {
+			area.MaxFiles = int64(a.quota.MaxFiles)
+			area.MaxFileSize = a.quota.MaxFileSize
+			area.MaxSize = a.quota.MaxSize
+		}
+		for _, f := range files {
+			area.Files++
+			area.Size += f.Size
+			if loaded[f.Path] || haproxy.IsReferenced(config, f.Name) {
+				continue
+			}
+			body.Unreferenced = append(body.Unreferenced, &storage.GetStorageCleanupOKBodyUnreferencedItems0{
+				Area:     a.name,
+				Name:     f.Name,
+				Path:     f.Path,
+				Size:     f.Size,
+				Modified: f.Modified,
+			})
+		}
+		body.Areas = append(body.Areas, area)
+	}
+	return storage.NewGetStorageCleanupOK().WithPayload(body)
+}
+
+//Handle executing the request and returning a response
+func (h *GetAllStorageGeneralFilesHandlerImpl) Handle(params storage.GetAllStorageGeneralFilesParams, principal interface{}) middleware.Responder {
+	config, err := currentConfiguration(h.Client)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewGetAllStorageGeneralFilesDefault(int(*e.Code)).WithPayload(e)
+	}
+	files, err := haproxy.StorageFiles(h.Dir)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewGetAllStorageGeneralFilesDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := make([]*storage.GetAllStorageGeneralFilesOKBodyItems0, 0, len(files))
+	for _, f := range files {
+		data = append(data, generalFile(f, config))
+	}
+	return storage.NewGetAllStorageGeneralFilesOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateStorageGeneralFileHandlerImpl) Handle(params storage.CreateStorageGeneralFileParams, principal interface{}) middleware.Responder {
+	defer params.FileUpload.Close()
+	upload := params.FileUpload.(*runtime.File)
+	name := upload.Header.Filename
+	if err := haproxy.ValidStorageName(name); err != nil {
+		c := misc.ErrHTTPBadRequest
+		msg := err.Error()
+		return storage.NewCreateStorageGeneralFileBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	var config string
+	var exists bool
+	if params.TransactionID != nil {
+		var err error
+		if config, err = stagingTransaction(h.Client, h.TransactionStorage, *params.TransactionID); err != nil {
+			e := misc.HandleError(err)
+			return storage.NewCreateStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+		}
+		exists = h.TransactionStorage.Exists(*params.TransactionID, "general", name)
+	} else {
+		_, err := haproxy.StorageFileInfo(h.Dir, name)
+		exists = err == nil
+	}
+	if exists {
+		e := misc.HandleError(native_configuration.NewConfError(native_configuration.ErrObjectAlreadyExists, fmt.Sprintf("file %s already exists", name)))
+		return storage.NewCreateStorageGeneralFileConflict().WithPayload(e)
+	}
+	content, err := ioutil.ReadAll(upload.Data)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewCreateStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	warnings, err := sniConflicts(h.Dir, name, content)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewCreateStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	if len(warnings) > 0 && h.SNIConflictPolicy == haproxy.SNIConflictReject {
+		return storage.NewCreateStorageGeneralFileConflict().WithPayload(misc.SetError(http.StatusConflict, strings.Join(warnings, "; ")))
+	}
+	if params.TransactionID != nil {
+		f, err := stageGeneralFile(h.TransactionStorage, *params.TransactionID, h.Dir, name, content, h.Quota)
+		if err != nil {
+			e := storageError(err)
+			return storage.NewCreateStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+		}
+		staged := &storage.CreateStorageGeneralFileAcceptedBody{}
+		if err := convertBody(generalFile(f, config), staged); err != nil {
+			e := misc.HandleError(err)
+			return storage.NewCreateStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+		}
+		staged.Warnings = warnings
+		return storage.NewCreateStorageGeneralFileAccepted().WithPayload(staged)
+	}
+	f, err := storeGeneralFile(h.Dir, name, bytes.NewReader(content), int64(len(content)), h.Quota)
+	if err != nil {
+		e := storageError(err)
+		return storage.NewCreateStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	config, err = currentConfiguration(h.Client)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewCreateStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	created := &storage.CreateStorageGeneralFileCreatedBody{}
+	if err := convertBody(generalFile(f, config), created); err != nil {
+		e := misc.HandleError(err)
+		return storage.NewCreateStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	created.Warnings = warnings
+	return storage.NewCreateStorageGeneralFileCreated().WithPayload(created)
+}
+
+//Handle executing the request and returning a response
+func (h *GetOneStorageGeneralFileHandlerImpl) Handle(params storage.GetOneStorageGeneralFileParams, principal interface{}) middleware.Responder {
+	f, err := generalFileInfo(h.Dir, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewGetOneStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	content, err := os.Open(f.Path)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewGetOneStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		defer content.Close()
+		rw.Header().Set(runtime.HeaderContentType, "application/octet-stream")
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", f.Name))
+		rw.Header().Set("Content-Length", strconv.FormatInt(f.Size, 10))
+		rw.WriteHeader(http.StatusOK)
+		// nolint:errcheck
+		io.Copy(rw, content)
+	})
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceStorageGeneralFileHandlerImpl) Handle(params storage.ReplaceStorageGeneralFileParams, principal interface{}) middleware.Responder {
+	defer params.FileUpload.Close()
+	var config string
+	var err error
+	if params.TransactionID != nil {
+		config, err = stagedGeneralFile(h.Client, h.TransactionStorage, *params.TransactionID, params.Name)
+	} else {
+		_, err = generalFileInfo(h.Dir, params.Name)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	content, err := ioutil.ReadAll(params.FileUpload)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	warnings, err := sniConflicts(h.Dir, params.Name, content)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	if len(warnings) > 0 && h.SNIConflictPolicy == haproxy.SNIConflictReject {
+		return storage.NewReplaceStorageGeneralFileConflict().WithPayload(misc.SetError(http.StatusConflict, strings.Join(warnings, "; ")))
+	}
+	if params.TransactionID != nil {
+		// the transaction reloads HAProxy when committed
+		f, err := stageGeneralFile(h.TransactionStorage, *params.TransactionID, h.Dir, params.Name, content, h.Quota)
+		if err != nil {
+			e := storageError(err)
+			return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+		}
+		staged := &storage.ReplaceStorageGeneralFileAcceptedBody{}
+		if err := convertBody(generalFile(f, config), staged); err != nil {
+			e := misc.HandleError(err)
+			return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+		}
+		staged.Warnings = warnings
+		return storage.NewReplaceStorageGeneralFileAccepted().WithPayload(staged)
+	}
+	f, err := storeGeneralFile(h.Dir, params.Name, bytes.NewReader(content), int64(len(content)), h.Quota)
+	if err != nil {
+		e := storageError(err)
+		return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	config, err = currentConfiguration(h.Client)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := generalFile(f, config)
+	if !data.Referenced || *params.ForceReload {
+		if data.Referenced {
+			if err := h.ReloadAgent.ForceReload(); err != nil {
+				e := misc.HandleError(err)
+				return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+			}
+		}
+		replaced := &storage.ReplaceStorageGeneralFileOKBody{}
+		if err := convertBody(data, replaced); err != nil {
+			e := misc.HandleError(err)
+			return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+		}
+		replaced.Warnings = warnings
+		return storage.NewReplaceStorageGeneralFileOK().WithPayload(replaced)
+	}
+	// HAProxy reads the files the configuration references on reload only
+	rID := h.ReloadAgent.Reload()
+	accepted := &storage.ReplaceStorageGeneralFileAcceptedBody{}
+	if err := convertBody(data, accepted); err != nil {
+		e := misc.HandleError(err)
+		return storage.NewReplaceStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	accepted.Warnings = warnings
+	return storage.NewReplaceStorageGeneralFileAccepted().WithReloadID(rID).WithPayload(accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteStorageGeneralFileHandlerImpl) Handle(params storage.DeleteStorageGeneralFileParams, principal interface{}) middleware.Responder {
+	if params.TransactionID != nil {
+		config, err := stagedGeneralFile(h.Client, h.TransactionStorage, *params.TransactionID, params.Name)
+		if err != nil {
+			e := misc.HandleError(err)
+			return storage.NewDeleteStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+		}
+		if haproxy.IsReferenced(config, params.Name) {
+			return storage.NewDeleteStorageGeneralFileConflict().WithPayload(misc.SetError(http.StatusConflict, fmt.Sprintf("file %s is referenced in the configuration of transaction %s", params.Name, *params.TransactionID)))
+		}
+		if err := h.TransactionStorage.StageDelete(*params.TransactionID, "general", params.Name); err != nil {
+			e := misc.HandleError(err)
+			return storage.NewDeleteStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+		}
+		return storage.NewDeleteStorageGeneralFileAccepted()
+	}
+	f, err := generalFileInfo(h.Dir, params.Name)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewDeleteStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	config, err := currentConfiguration(h.Client)
+	if err != nil {
+		e := misc.HandleError(err)
+		return storage.NewDeleteStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	if haproxy.IsReferenced(config, f.Name) {
+		return storage.NewDeleteStorageGeneralFileConflict().WithPayload(misc.SetError(http.StatusConflict, fmt.Sprintf("file %s is referenced in the configuration", f.Name)))
+	}
+	if err := os.Remove(f.Path); err != nil {
+		e := misc.HandleError(err)
+		return storage.NewDeleteStorageGeneralFileDefault(int(*e.Code)).WithPayload(e)
+	}
+	return storage.NewDeleteStorageGeneralFileNoContent()
+}
+
+// currentConfiguration returns the HAProxy configuration the API holds
+func currentConfiguration(client *client_native.HAProxyClient) (string, error) {
+	p, err := client.Configuration.GetParser("")
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
+// stagingTransaction checks the transaction id is in progress for staging
+// storage files changes in, returning its configuration
+func stagingTransaction(client *client_native.HAProxyClient, ts *haproxy.TransactionStorage, id string) (string, error) {
+	if ts == nil {
+		return "", native_configuration.NewConfError(native_configuration.ErrGeneralError, "storage files cannot be staged in transactions")
+	}
+	t, err := client.Configuration.GetTransaction(id)
+	if err != nil {
+		return "", err
+	}
+	if t.Status != "in_progress" {
+		return "", native_configuration.NewConfError(native_configuration.ErrTransactionDoesNotExist, fmt.Sprintf("transaction %s is not in progress", id))
+	}
+	p, err := client.Configuration.GetParser(id)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
+// stagedGeneralFile checks the file name of the general storage exists as seen
+// by the transaction id, returning the configuration of the transaction
+func stagedGeneralFile(client *client_native.HAProxyClient, ts *haproxy.TransactionStorage, id, name string) (string, error) {
+	config, err := stagingTransaction(client, ts, id)
+	if err != nil {
+		return "", err
+	}
+	if err := haproxy.ValidStorageName(name); err != nil {
+		return "", native_configuration.NewConfError(native_configuration.ErrValidationError, err.Error())
+	}
+	if !ts.Exists(id, "general", name) {
+		return "", native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("file %s does not exist", name))
+	}
+	return config, nil
+}
+
+// generalFileInfo returns the file name of the general storage dir, with a not
+// found error when it does not exist
+func generalFileInfo(dir, name string) (haproxy.StorageFile, error) {
+	if err := haproxy.ValidStorageName(name); err != nil {
+		return haproxy.StorageFile{}, native_configuration.NewConfError(native_configuration.ErrValidationError, err.Error())
+	}
+	f, err := haproxy.StorageFileInfo(dir, name)
+	if os.IsNotExist(err) {
+		return f, native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("file %s does not exist", name))
+	}
+	return f, err
+}
+
+// storeGeneralFile stores the content of r of size bytes as the file name of
+// the general storage dir, checking its quota first
+func storeGeneralFile(dir, name string, r io.Reader, size int64, quota *configuration.StorageQuota) (haproxy.StorageFile, error) {
+	if quota != nil {
+		if err := haproxy.CheckStorageQuota(filepath.Join(dir, name), size, quota.MaxFiles, quota.MaxFileSize, quota.MaxSize); err != nil {
+			return haproxy.StorageFile{}, err
+		}
+	}
+	return haproxy.StoreFile(dir, name, r)
+}
+
+// stageGeneralFile stages content as the file name of the general storage dir
+// in the transaction id, checking the quota of the storage first
+func stageGeneralFile(ts *haproxy.TransactionStorage, id, dir, name string, content []byte, quota *configuration.StorageQuota) (haproxy.StorageFile, error) {
+	path := filepath.Join(dir, name)
+	if quota != nil {
+		if err := haproxy.CheckStorageQuota(path, int64(len(content)), quota.MaxFiles, quota.MaxFileSize, quota.MaxSize); err != nil {
+			return haproxy.StorageFile{}, err
+		}
+	}
+	f, err := ts.Stage(id, "general", name, content)
+	if err != nil {
+		return haproxy.StorageFile{}, err
+	}
+	return haproxy.StorageFile{Name: name, Path: path, Size: f.Size}, nil
+}
+
+// sniConflicts returns the warnings about the hostnames the certificate of
+// content, stored as the file name of dir, shares with the other certificates
+// of dir, none when content is not a certificate
+func sniConflicts(dir, name string, content []byte) ([]string, error) {
+	hostnames, ok := haproxy.CertificateHostnames(content)
+	if !ok {
+		return []string{}, nil
+	}
+	conflicts, err := haproxy.FindSNIConflicts(dir, name, hostnames)
+	if err != nil {
+		return nil, err
+	}
+	warnings := make([]string, 0, len(conflicts))
+	for _, c := range conflicts {
+		warnings = append(warnings, c.String())
+	}
+	return warnings, nil
+}
+
+// storageError converts the errors of stored files, exceeding a quota being
+// rejected as too large
+func storageError(err error) *models.Error {
+	if _, ok := err.(*haproxy.StorageQuotaError); ok {
+		return misc.SetError(http.StatusRequestEntityTooLarge, err.Error())
+	}
+	return misc.HandleError(err)
+}
+
+func generalFile(f haproxy.StorageFile, config string) *storage.GetAllStorageGeneralFilesOKBodyItems0 {
+	return &storage.GetAllStorageGeneralFilesOKBodyItems0{
+		StorageName: f.Name,
+		File:        f.Path,
+		Size:        f.Size,
+		Modified:    f.Modified,
+		Referenced:  haproxy.IsReferenced(config, f.Name),
+	}
+}
@@ -28,6 +28,7 @@ import (
 type CreateTCPRequestRuleHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
 }
 
 //DeleteTCPRequestRuleHandlerImpl implementation of the DeleteTCPRequestRuleHandler interface using client-native client
@@ -50,6 +51,7 @@ type GetTCPRequestRulesHandlerImpl struct {
 type ReplaceTCPRequestRuleHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Validator   *haproxy.SampleValidator
 }
 
 //Handle executing the request and returning a response
@@ -73,7 +75,10 @@ func (h *CreateTCPRequestRuleHandlerImpl) Handle(params tcp_request_rule.CreateT
 		return tcp_request_rule.NewCreateTCPRequestRuleDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.CreateTCPRequestRule(params.ParentType, params.ParentName, params.Data, t, v)
+	err := validateTCPRequestRule(h.Validator, params.Data)
+	if err == nil {
+		err = h.Client.Configuration.CreateTCPRequestRule(params.ParentType, params.ParentName, params.Data, t, v)
+	}
 	if err != nil {
 		e := misc.HandleError(err)
 		return tcp_request_rule.NewCreateTCPRequestRuleDefault(int(*e.Code)).WithPayload(e)
@@ -187,7 +192,10 @@ func (h *ReplaceTCPRequestRuleHandlerImpl) Handle(params tcp_request_rule.Replac
 		return tcp_request_rule.NewReplaceTCPRequestRuleDefault(int(*e.Code)).WithPayload(e)
 	}
 
-	err := h.Client.Configuration.EditTCPRequestRule(params.Index, params.ParentType, params.ParentName, params.Data, t, v)
+	err := validateTCPRequestRule(h.Validator, params.Data)
+	if err == nil {
+		err = h.Client.Configuration.EditTCPRequestRule(params.Index, params.ParentType, params.ParentName, params.Data, t, v)
+	}
 	if err != nil {
 		e := misc.HandleError(err)
 		return tcp_request_rule.NewReplaceTCPRequestRuleDefault(int(*e.Code)).WithPayload(e)
@@ -0,0 +1,448 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	parser_errors "github.com/haproxytech/config-parser/v2/errors"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/global"
+)
+
+// Thread groups and the thread bind option of stats sockets are not known to the
+// configuration parser. thread-groups is kept as an unprocessed global line, and
+// stats sockets bound to a thread group are kept in the global config snippet so
+// the thread option is not dropped when the configuration is written back.
+
+var (
+	cpuMapProcessRegexp = regexp.MustCompile(`^(auto:)?(all|odd|even|\d+(-\d+)?)(/(all|odd|even|\d+(-\d+)?))?$`)
+	cpuSetRegexp        = regexp.MustCompile(`^\d+(-\d+)?$`)
+	threadSetRegexp     = regexp.MustCompile(`^(all|odd|even|\d+(-\d+)?(,\d+(-\d+)?)*)$`)
+)
+
+//GetThreadingHandlerImpl implementation of the GetThreadingHandler interface
+type GetThreadingHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceThreadingHandlerImpl implementation of the ReplaceThreadingHandler interface
+type ReplaceThreadingHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	SystemInfo  bool
+}
+
+//Handle executing the request and returning a response
+func (h *GetThreadingHandlerImpl) Handle(params global.GetThreadingParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	v, err := h.Client.Configuration.GetVersion(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetThreadingDefault(int(*e.Code)).WithPayload(e)
+	}
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetThreadingDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	data, err := parseThreading(p)
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewGetThreadingDefault(int(*e.Code)).WithPayload(e).WithConfigurationVersion(v)
+	}
+	return global.NewGetThreadingOK().WithPayload(&global.GetThreadingOKBody{Version: v, Data: data}).WithConfigurationVersion(v)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceThreadingHandlerImpl) Handle(params global.ReplaceThreadingParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return global.NewReplaceThreadingDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := &global.GetThreadingOKBodyData{}
+	if err := convertBody(&params.Data, data); err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceThreadingDefault(int(*e.Code)).WithPayload(e)
+	}
+	if err := validateThreading(data, h.SystemInfo); err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceThreadingDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		return serializeThreading(p, data)
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return global.NewReplaceThreadingDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return global.NewReplaceThreadingDefault(int(*e.Code)).WithPayload(e)
+			}
+			okBody := &global.ReplaceThreadingOKBody{}
+			// nolint:errcheck
+			convertBody(data, okBody)
+			return global.NewReplaceThreadingOK().WithPayload(okBody)
+		}
+		rID := h.ReloadAgent.Reload()
+		acceptedBody := &global.ReplaceThreadingAcceptedBody{}
+		// nolint:errcheck
+		convertBody(data, acceptedBody)
+		return global.NewReplaceThreadingAccepted().WithReloadID(rID).WithPayload(acceptedBody)
+	}
+	acceptedBody := &global.ReplaceThreadingAcceptedBody{}
+	// nolint:errcheck
+	convertBody(data, acceptedBody)
+	return global.NewReplaceThreadingAccepted().WithPayload(acceptedBody)
+}
+
+// convertBody converts between generated body types of the same schema, which
+// only differ by the names of their item types
+func convertBody(src interface{ MarshalBinary() ([]byte, error) }, dst interface{ UnmarshalBinary([]byte) error }) error {
+	b, err := src.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return dst.UnmarshalBinary(b)
+}
+
+func parseThreading(p *parser.Parser) (*global.GetThreadingOKBodyData, error) {
+	data := &global.GetThreadingOKBodyData{
+		CPUMaps:      make([]*global.GetThreadingOKBodyDataCPUMapsItems0, 0),
+		StatsSockets: make([]*global.GetThreadingOKBodyDataStatsSocketsItems0, 0),
+	}
+
+	nbthread, err := p.Get(parser.Global, parser.GlobalSectionName, "nbthread")
+	if err == nil {
+		v := nbthread.(*types.Int64C).Value
+		data.Nbthread = &v
+	}
+
+	cpuMaps, err := p.Get(parser.Global, parser.GlobalSectionName, "cpu-map")
+	if err == nil {
+		for _, c := range cpuMaps.([]types.CPUMap) {
+			process := c.Process
+			cpuSet := c.CPUSet
+			data.CPUMaps = append(data.CPUMaps, &global.GetThreadingOKBodyDataCPUMapsItems0{Process: &process, CPUSet: &cpuSet})
+		}
+	}
+
+	lines, err := getGlobalUnprocessed(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		fields := strings.Fields(l.Value)
+		if len(fields) == 2 && fields[0] == "thread-groups" {
+			if v, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+				data.ThreadGroups = &v
+			}
+		}
+	}
+
+	snippet, err := getGlobalSnippet(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range snippet {
+		if s := parseThreadStatsSocket(l); s != nil {
+			data.StatsSockets = append(data.StatsSockets, s)
+		}
+	}
+	return data, nil
+}
+
+func serializeThreading(p *parser.Parser, data *global.GetThreadingOKBodyData) error {
+	var nbthread *types.Int64C
+	if data.Nbthread != nil {
+		nbthread = &types.Int64C{Value: *data.Nbthread}
+	}
+	if err := p.Set(parser.Global, parser.GlobalSectionName, "nbthread", nbthread); err != nil {
+		return err
+	}
+
+	cpuMaps := make([]types.CPUMap, 0, len(data.CPUMaps))
+	for _, c := range data.CPUMaps {
+		cpuMaps = append(cpuMaps, types.CPUMap{Process: *c.Process, CPUSet: *c.CPUSet})
+	}
+	if len(cpuMaps) == 0 {
+		if err := p.Set(parser.Global, parser.GlobalSectionName, "cpu-map", nil); err != nil {
+			return err
+		}
+	} else if err := p.Set(parser.Global, parser.GlobalSectionName, "cpu-map", cpuMaps); err != nil {
+		return err
+	}
+
+	lines, err := getGlobalUnprocessed(p)
+	if err != nil {
+		return err
+	}
+	unprocessed := make([]types.UnProcessed, 0, len(lines)+1)
+	for _, l := range lines {
+		if f := strings.Fields(l.Value); len(f) > 0 && f[0] == "thread-groups" {
+			continue
+		}
+		unprocessed = append(unprocessed, l)
+	}
+	if data.ThreadGroups != nil {
+		unprocessed = append(unprocessed, types.UnProcessed{Value: fmt.Sprintf("thread-groups %d", *data.ThreadGroups)})
+	}
+	if len(unprocessed) == 0 {
+		if err := p.Set(parser.Global, parser.GlobalSectionName, "", nil); err != nil {
+			return err
+		}
+	} else if err := p.Set(parser.Global, parser.GlobalSectionName, "", unprocessed); err != nil {
+		return err
+	}
+
+	snippet, err := getGlobalSnippet(p)
+	if err != nil {
+		return err
+	}
+	newSnippet := make([]string, 0, len(snippet)+len(data.StatsSockets))
+	for _, l := range snippet {
+		if parseThreadStatsSocket(l) == nil {
+			newSnippet = append(newSnippet, l)
+		}
+	}
+	for _, s := range data.StatsSockets {
+		newSnippet = append(newSnippet, threadStatsSocketLine(s))
+	}
+	if len(newSnippet) == 0 {
+		return p.Set(parser.Global, parser.GlobalSectionName, "config-snippet", nil)
+	}
+	return p.Set(parser.Global, parser.GlobalSectionName, "config-snippet", &types.StringSliceC{Value: newSnippet})
+}
+
+func getGlobalUnprocessed(p *parser.Parser) ([]types.UnProcessed, error) {
+	data, err := p.Get(parser.Global, parser.GlobalSectionName, "")
+	if err != nil {
+		if err == parser_errors.ErrFetch {
+			return []types.UnProcessed{}, nil
+		}
+		return nil, err
+	}
+	return data.([]types.UnProcessed), nil
+}
+
+func getGlobalSnippet(p *parser.Parser) ([]string, error) {
+	data, err := p.Get(parser.Global, parser.GlobalSectionName, "config-snippet")
+	if err != nil {
+		if err == parser_errors.ErrFetch {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	return data.(*types.StringSliceC).Value, nil
+}
+
+// parseThreadStatsSocket parses a stats socket line bound to a thread group,
+// returns nil for any other line
+func parseThreadStatsSocket(line string) *global.GetThreadingOKBodyDataStatsSocketsItems0 {
+	fields := strings.Fields(line)
+	if len(fields) < 3 || fields[0] != "stats" || fields[1] != "socket" {
+		return nil
+	}
+	address := fields[2]
+	s := &global.GetThreadingOKBodyDataStatsSocketsItems0{Address: &address}
+	for i := 3; i < len(fields)-1; i++ {
+		switch fields[i] {
+		case "thread":
+			i++
+			groupThreads := strings.SplitN(fields[i], "/", 2)
+			group, err := strconv.ParseInt(groupThreads[0], 10, 64)
+			if err != nil || len(groupThreads) != 2 {
+				return nil
+			}
+			s.ThreadGroup = &group
+			if groupThreads[1] != "all" {
+				s.Threads = groupThreads[1]
+			}
+		case "level":
+			i++
+			s.Level = fields[i]
+		case "mode":
+			i++
+			s.Mode = fields[i]
+		}
+	}
+	if s.ThreadGroup == nil {
+		return nil
+	}
+	return s
+}
+
+func threadStatsSocketLine(s *global.GetThreadingOKBodyDataStatsSocketsItems0) string {
+	threads := s.Threads
+	if threads == "" {
+		threads = "all"
+	}
+	line := fmt.Sprintf("stats socket %s thread %d/%s", *s.Address, *s.ThreadGroup, threads)
+	if s.Level != "" {
+		line += " level " + s.Level
+	}
+	if s.Mode != "" {
+		line += " mode " + s.Mode
+	}
+	return line
+}
+
+func validateThreading(data *global.GetThreadingOKBodyData, systemInfo bool) error {
+	nbthread := int64(0)
+	if data.Nbthread != nil {
+		nbthread = *data.Nbthread
+	}
+	groups := int64(1)
+	if data.ThreadGroups != nil {
+		groups = *data.ThreadGroups
+		if nbthread != 0 && groups > nbthread {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("thread_groups %d exceeds nbthread %d", groups, nbthread))
+		}
+	}
+
+	var online map[int]bool
+	if systemInfo {
+		online = onlineCPUs()
+		if nbthread > int64(len(online)) {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("nbthread %d exceeds the %d CPUs online", nbthread, len(online)))
+		}
+	}
+
+	for _, c := range data.CPUMaps {
+		if !cpuMapProcessRegexp.MatchString(*c.Process) {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("invalid cpu-map process set %s", *c.Process))
+		}
+		if nbthread != 0 {
+			if parts := strings.SplitN(*c.Process, "/", 2); len(parts) == 2 {
+				if max := maxOfSet(parts[1]); max > nbthread {
+					return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("cpu-map %s references thread %d, nbthread is %d", *c.Process, max, nbthread))
+				}
+			}
+		}
+		cpus, err := parseCPUSet(*c.CPUSet)
+		if err != nil {
+			return configuration.NewConfError(configuration.ErrValidationError, err.Error())
+		}
+		for _, cpu := range cpus {
+			if online != nil && !online[cpu] {
+				return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("cpu-map %s references CPU %d which is not online", *c.Process, cpu))
+			}
+		}
+	}
+
+	addresses := make(map[string]bool)
+	for _, s := range data.StatsSockets {
+		if addresses[*s.Address] {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("duplicate stats socket %s", *s.Address))
+		}
+		addresses[*s.Address] = true
+		if *s.ThreadGroup > groups {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("stats socket %s bound to thread group %d, only %d configured", *s.Address, *s.ThreadGroup, groups))
+		}
+		if s.Threads != "" && !threadSetRegexp.MatchString(s.Threads) {
+			return configuration.NewConfError(configuration.ErrValidationError, fmt.Sprintf("invalid thread set %s for stats socket %s", s.Threads, *s.Address))
+		}
+	}
+	return nil
+}
+
+// parseCPUSet returns the CPUs of a CPU set given as numbers or ranges separated by spaces or commas
+func parseCPUSet(set string) ([]int, error) {
+	cpus := make([]int, 0)
+	for _, r := range strings.FieldsFunc(set, func(c rune) bool { return c == ',' || c == ' ' || c == '\n' }) {
+		if !cpuSetRegexp.MatchString(r) {
+			return nil, fmt.Errorf("invalid CPU set %s", set)
+		}
+		bounds := strings.SplitN(r, "-", 2)
+		low, _ := strconv.Atoi(bounds[0])
+		high := low
+		if len(bounds) == 2 {
+			high, _ = strconv.Atoi(bounds[1])
+		}
+		if high < low {
+			return nil, fmt.Errorf("invalid CPU range %s", r)
+		}
+		for i := low; i <= high; i++ {
+			cpus = append(cpus, i)
+		}
+	}
+	if len(cpus) == 0 {
+		return nil, fmt.Errorf("empty CPU set")
+	}
+	return cpus, nil
+}
+
+// maxOfSet returns the highest number of a process or thread set, 0 for all, odd and even
+func maxOfSet(set string) int64 {
+	bounds := strings.SplitN(set, "-", 2)
+	max, _ := strconv.ParseInt(bounds[len(bounds)-1], 10, 64)
+	return max
+}
+
+// onlineCPUs returns the CPUs online on the host
+func onlineCPUs() map[int]bool {
+	online := make(map[int]bool)
+	if b, err := ioutil.ReadFile("/sys/devices/system/cpu/online"); err == nil {
+		if cpus, err := parseCPUSet(strings.TrimSpace(string(b))); err == nil {
+			for _, cpu := range cpus {
+				online[cpu] = true
+			}
+			return online
+		}
+	}
+	for i := 0; i < runtime.NumCPU(); i++ {
+		online[i] = true
+	}
+	return online
+}
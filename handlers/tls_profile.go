@@ -0,0 +1,546 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	native_configuration "github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/params"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/tls_profile"
+)
+
+// Types of the objects a TLS profile is applied to
+const (
+	tlsProfileBind   = "bind"
+	tlsProfileServer = "server"
+)
+
+// Cipher lists of the Mozilla server side TLS recommendations
+const (
+	tlsCiphersuites        = "TLS_AES_128_GCM_SHA256:TLS_AES_256_GCM_SHA384:TLS_CHACHA20_POLY1305_SHA256"
+	tlsIntermediateCiphers = "ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256:ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384:ECDHE-ECDSA-CHACHA20-POLY1305:ECDHE-RSA-CHACHA20-POLY1305:DHE-RSA-AES128-GCM-SHA256:DHE-RSA-AES256-GCM-SHA384"
+	tlsOldCiphers          = "ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256:ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384:ECDHE-ECDSA-CHACHA20-POLY1305:ECDHE-RSA-CHACHA20-POLY1305:DHE-RSA-AES128-GCM-SHA256:DHE-RSA-AES256-GCM-SHA384:DHE-RSA-CHACHA20-POLY1305:ECDHE-ECDSA-AES128-SHA256:ECDHE-RSA-AES128-SHA256:ECDHE-ECDSA-AES128-SHA:ECDHE-RSA-AES128-SHA:ECDHE-ECDSA-AES256-SHA384:ECDHE-RSA-AES256-SHA384:ECDHE-ECDSA-AES256-SHA:ECDHE-RSA-AES256-SHA:DHE-RSA-AES128-SHA256:DHE-RSA-AES256-SHA256:AES128-GCM-SHA256:AES256-GCM-SHA384:AES128-SHA256:AES256-SHA256:AES128-SHA:AES256-SHA:DES-CBC3-SHA"
+)
+
+var (
+	// serverSSLOptions are the TLS options of a server not in the server model
+	serverSSLOptions = []string{"ssl-min-ver", "ssl-max-ver", "ciphers", "ciphersuites"}
+	// tlsProfileBindOptions are the bind options set by TLS profiles, servers
+	// get the serverSSLOptions ones
+	tlsProfileBindOptions = []string{"ssl-min-ver", "ssl-max-ver", "ciphers", "ciphersuites", "curves", "prefer-client-ciphers"}
+
+	builtinTLSProfiles = []configuration.TLSProfile{
+		{Name: "modern", SslMinVer: "TLSv1.3", Ciphersuites: tlsCiphersuites, PreferClientCiphers: true},
+		{Name: "intermediate", SslMinVer: "TLSv1.2", Ciphers: tlsIntermediateCiphers, Ciphersuites: tlsCiphersuites, PreferClientCiphers: true},
+		{Name: "old", SslMinVer: "TLSv1.0", Ciphers: tlsOldCiphers, Ciphersuites: tlsCiphersuites},
+	}
+)
+
+//GetTLSProfilesHandlerImpl implementation of the GetTLSProfilesHandler interface
+type GetTLSProfilesHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//CreateTLSProfileHandlerImpl implementation of the CreateTLSProfileHandler interface
+type CreateTLSProfileHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//GetTLSProfileHandlerImpl implementation of the GetTLSProfileHandler interface
+type GetTLSProfileHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//ReplaceTLSProfileHandlerImpl implementation of the ReplaceTLSProfileHandler interface
+type ReplaceTLSProfileHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//DeleteTLSProfileHandlerImpl implementation of the DeleteTLSProfileHandler interface
+type DeleteTLSProfileHandlerImpl struct {
+	Config *configuration.Configuration
+}
+
+//ApplyTLSProfileHandlerImpl implementation of the ApplyTLSProfileHandler interface using client-native client
+type ApplyTLSProfileHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	ReloadAgent haproxy.IReloadAgent
+	Config      *configuration.Configuration
+}
+
+//GetTLSProfileDeviationsHandlerImpl implementation of the GetTLSProfileDeviationsHandler interface using client-native client
+type GetTLSProfileDeviationsHandlerImpl struct {
+	Client *client_native.HAProxyClient
+	Config *configuration.Configuration
+}
+
+//Handle executing the request and returning a response
+func (h *GetTLSProfilesHandlerImpl) Handle(params tls_profile.GetTLSProfilesParams, principal interface{}) middleware.Responder {
+	custom, assignments := h.Config.GetTLSProfiles()
+	data := make([]*tls_profile.GetTLSProfilesOKBodyItems0, 0, len(builtinTLSProfiles)+len(custom))
+	for i, p := range append(append([]configuration.TLSProfile{}, builtinTLSProfiles...), custom...) {
+		item := &tls_profile.GetTLSProfilesOKBodyItems0{}
+		if err := convertBody(tlsProfile(p, i < len(builtinTLSProfiles), assignments), item); err != nil {
+			e := misc.HandleError(err)
+			return tls_profile.NewGetTLSProfilesDefault(int(*e.Code)).WithPayload(e)
+		}
+		data = append(data, item)
+	}
+	return tls_profile.NewGetTLSProfilesOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *CreateTLSProfileHandlerImpl) Handle(params tls_profile.CreateTLSProfileParams, principal interface{}) middleware.Responder {
+	profile := tlsProfileConfig(&params.Data)
+	err := validateTLSProfile(profile)
+	if err == nil {
+		err = h.Config.UpdateTLSProfiles(func(custom []configuration.TLSProfile, assignments []configuration.TLSProfileAssignment) ([]configuration.TLSProfile, []configuration.TLSProfileAssignment, error) {
+			if _, _, ok := findTLSProfile(custom, profile.Name); ok {
+				return nil, nil, native_configuration.NewConfError(native_configuration.ErrObjectAlreadyExists, fmt.Sprintf("TLS profile %s already exists", profile.Name))
+			}
+			return append(custom, profile), assignments, nil
+		})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewCreateTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := &tls_profile.CreateTLSProfileCreatedBody{}
+	if err := convertBody(tlsProfile(profile, false, nil), data); err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewCreateTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+	return tls_profile.NewCreateTLSProfileCreated().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *GetTLSProfileHandlerImpl) Handle(params tls_profile.GetTLSProfileParams, principal interface{}) middleware.Responder {
+	custom, assignments := h.Config.GetTLSProfiles()
+	profile, builtin, ok := findTLSProfile(custom, params.Name)
+	if !ok {
+		e := misc.HandleError(tlsProfileNotFound(params.Name))
+		return tls_profile.NewGetTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := &tls_profile.GetTLSProfileOKBody{}
+	if err := convertBody(tlsProfile(profile, builtin, assignments), data); err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewGetTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+	return tls_profile.NewGetTLSProfileOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceTLSProfileHandlerImpl) Handle(params tls_profile.ReplaceTLSProfileParams, principal interface{}) middleware.Responder {
+	body := &tls_profile.CreateTLSProfileBody{}
+	if err := convertBody(&params.Data, body); err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewReplaceTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+	profile := tlsProfileConfig(body)
+	profile.Name = params.Name
+	var assigned []configuration.TLSProfileAssignment
+	err := validateTLSProfile(profile)
+	if err == nil {
+		err = h.Config.UpdateTLSProfiles(func(custom []configuration.TLSProfile, assignments []configuration.TLSProfileAssignment) ([]configuration.TLSProfile, []configuration.TLSProfileAssignment, error) {
+			_, builtin, ok := findTLSProfile(custom, params.Name)
+			if !ok {
+				return nil, nil, tlsProfileNotFound(params.Name)
+			}
+			if builtin {
+				return nil, nil, native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("TLS profile %s is built in", params.Name))
+			}
+			for i, p := range custom {
+				if p.Name == params.Name {
+					custom[i] = profile
+				}
+			}
+			assigned = assignments
+			return custom, assignments, nil
+		})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewReplaceTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := &tls_profile.ReplaceTLSProfileOKBody{}
+	if err := convertBody(tlsProfile(profile, false, assigned), data); err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewReplaceTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+	return tls_profile.NewReplaceTLSProfileOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *DeleteTLSProfileHandlerImpl) Handle(params tls_profile.DeleteTLSProfileParams, principal interface{}) middleware.Responder {
+	err := h.Config.UpdateTLSProfiles(func(custom []configuration.TLSProfile, assignments []configuration.TLSProfileAssignment) ([]configuration.TLSProfile, []configuration.TLSProfileAssignment, error) {
+		_, builtin, ok := findTLSProfile(custom, params.Name)
+		if !ok {
+			return nil, nil, tlsProfileNotFound(params.Name)
+		}
+		if builtin {
+			return nil, nil, native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("TLS profile %s is built in", params.Name))
+		}
+		profiles := make([]configuration.TLSProfile, 0, len(custom))
+		for _, p := range custom {
+			if p.Name != params.Name {
+				profiles = append(profiles, p)
+			}
+		}
+		kept := make([]configuration.TLSProfileAssignment, 0, len(assignments))
+		for _, a := range assignments {
+			if a.Profile != params.Name {
+				kept = append(kept, a)
+			}
+		}
+		return profiles, kept, nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewDeleteTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+	return tls_profile.NewDeleteTLSProfileNoContent()
+}
+
+//Handle executing the request and returning a response
+func (h *ApplyTLSProfileHandlerImpl) Handle(params tls_profile.ApplyTLSProfileParams, principal interface{}) middleware.Responder {
+	t := ""
+	v := int64(0)
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+	if params.Version != nil {
+		v = *params.Version
+	}
+
+	if t != "" && *params.ForceReload {
+		msg := "Both force_reload and transaction specified, specify only one"
+		c := misc.ErrHTTPBadRequest
+		e := &models.Error{
+			Message: &msg,
+			Code:    &c,
+		}
+		return tls_profile.NewApplyTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	custom, _ := h.Config.GetTLSProfiles()
+	profile, builtin, ok := findTLSProfile(custom, params.Name)
+	if !ok {
+		e := misc.HandleError(tlsProfileNotFound(params.Name))
+		return tls_profile.NewApplyTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	targets := make([]configuration.TLSProfileAssignment, 0, len(params.Data.Binds)+len(params.Data.Servers))
+	for _, b := range params.Data.Binds {
+		targets = append(targets, configuration.TLSProfileAssignment{Profile: profile.Name, Type: tlsProfileBind, Parent: *b.Frontend, Name: *b.Name})
+	}
+	for _, s := range params.Data.Servers {
+		targets = append(targets, configuration.TLSProfileAssignment{Profile: profile.Name, Type: tlsProfileServer, Parent: *s.Backend, Name: *s.Name})
+	}
+
+	err := changeParser(h.Client, t, v, func(p *parser.Parser) error {
+		for _, target := range targets {
+			if err := applyTLSProfile(p, profile, target); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewApplyTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	var assigned []configuration.TLSProfileAssignment
+	err = h.Config.UpdateTLSProfiles(func(custom []configuration.TLSProfile, assignments []configuration.TLSProfileAssignment) ([]configuration.TLSProfile, []configuration.TLSProfileAssignment, error) {
+		kept := make([]configuration.TLSProfileAssignment, 0, len(assignments)+len(targets))
+		for _, a := range assignments {
+			replaced := false
+			for _, target := range targets {
+				if a.Type == target.Type && a.Parent == target.Parent && a.Name == target.Name {
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				kept = append(kept, a)
+			}
+		}
+		assigned = append(kept, targets...)
+		return custom, assigned, nil
+	})
+	if err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewApplyTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	data := tlsProfile(profile, builtin, assigned)
+	if params.TransactionID == nil {
+		if *params.ForceReload {
+			err := h.ReloadAgent.ForceReload()
+			if err != nil {
+				e := misc.HandleError(err)
+				return tls_profile.NewApplyTLSProfileDefault(int(*e.Code)).WithPayload(e)
+			}
+			ok := &tls_profile.ApplyTLSProfileOKBody{}
+			if err := convertBody(data, ok); err != nil {
+				e := misc.HandleError(err)
+				return tls_profile.NewApplyTLSProfileDefault(int(*e.Code)).WithPayload(e)
+			}
+			return tls_profile.NewApplyTLSProfileOK().WithPayload(ok)
+		}
+		rID := h.ReloadAgent.Reload()
+		accepted := &tls_profile.ApplyTLSProfileAcceptedBody{}
+		if err := convertBody(data, accepted); err != nil {
+			e := misc.HandleError(err)
+			return tls_profile.NewApplyTLSProfileDefault(int(*e.Code)).WithPayload(e)
+		}
+		return tls_profile.NewApplyTLSProfileAccepted().WithReloadID(rID).WithPayload(accepted)
+	}
+	accepted := &tls_profile.ApplyTLSProfileAcceptedBody{}
+	if err := convertBody(data, accepted); err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewApplyTLSProfileDefault(int(*e.Code)).WithPayload(e)
+	}
+	return tls_profile.NewApplyTLSProfileAccepted().WithPayload(accepted)
+}
+
+//Handle executing the request and returning a response
+func (h *GetTLSProfileDeviationsHandlerImpl) Handle(params tls_profile.GetTLSProfileDeviationsParams, principal interface{}) middleware.Responder {
+	t := ""
+	if params.TransactionID != nil {
+		t = *params.TransactionID
+	}
+
+	p, err := h.Client.Configuration.GetParser(t)
+	if err != nil {
+		e := misc.HandleError(err)
+		return tls_profile.NewGetTLSProfileDeviationsDefault(int(*e.Code)).WithPayload(e)
+	}
+	custom, assignments := h.Config.GetTLSProfiles()
+	data := make([]*tls_profile.GetTLSProfileDeviationsOKBodyItems0, 0)
+	for _, a := range assignments {
+		profile, _, ok := findTLSProfile(custom, a.Profile)
+		if !ok {
+			continue
+		}
+		expected := tlsProfileOptions(profile, a.Type)
+		names := make([]string, 0, len(expected))
+		for _, n := range tlsProfileBindOptions {
+			if _, ok := expected[n]; ok {
+				names = append(names, n)
+			}
+		}
+		actual, err := tlsOptionValues(p, a, names)
+		item := &tls_profile.GetTLSProfileDeviationsOKBodyItems0{
+			Profile: a.Profile,
+			Type:    a.Type,
+			Parent:  a.Parent,
+			Name:    a.Name,
+			Options: make([]*tls_profile.GetTLSProfileDeviationsOKBodyItems0OptionsItems0, 0),
+		}
+		if err != nil {
+			if !isNotFound(err) {
+				e := misc.HandleError(err)
+				return tls_profile.NewGetTLSProfileDeviationsDefault(int(*e.Code)).WithPayload(e)
+			}
+			item.Missing = true
+			data = append(data, item)
+			continue
+		}
+		for _, n := range names {
+			if expected[n] != actual[n] {
+				item.Options = append(item.Options, &tls_profile.GetTLSProfileDeviationsOKBodyItems0OptionsItems0{
+					Option:   n,
+					Expected: expected[n],
+					Actual:   actual[n],
+				})
+			}
+		}
+		if len(item.Options) > 0 {
+			data = append(data, item)
+		}
+	}
+	return tls_profile.NewGetTLSProfileDeviationsOK().WithPayload(data)
+}
+
+func tlsProfileNotFound(name string) error {
+	return native_configuration.NewConfError(native_configuration.ErrObjectDoesNotExist, fmt.Sprintf("TLS profile %s does not exist", name))
+}
+
+func isNotFound(err error) bool {
+	confErr, ok := err.(*native_configuration.ConfError)
+	return ok && confErr.Code() == native_configuration.ErrObjectDoesNotExist
+}
+
+// findTLSProfile returns the built in or custom profile with the name
+func findTLSProfile(custom []configuration.TLSProfile, name string) (configuration.TLSProfile, bool, bool) {
+	for _, p := range builtinTLSProfiles {
+		if p.Name == name {
+			return p, true, true
+		}
+	}
+	for _, p := range custom {
+		if p.Name == name {
+			return p, false, true
+		}
+	}
+	return configuration.TLSProfile{}, false, false
+}
+
+func validateTLSProfile(profile configuration.TLSProfile) error {
+	if profile.SslMinVer != "" && profile.SslMaxVer != "" && sslVersionIndex(profile.SslMinVer) > sslVersionIndex(profile.SslMaxVer) {
+		return native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("ssl_min_ver %s is higher than ssl_max_ver %s", profile.SslMinVer, profile.SslMaxVer))
+	}
+	return nil
+}
+
+func tlsProfileConfig(data *tls_profile.CreateTLSProfileBody) configuration.TLSProfile {
+	return configuration.TLSProfile{
+		Name:                *data.Name,
+		SslMinVer:           data.SslMinVer,
+		SslMaxVer:           data.SslMaxVer,
+		Ciphers:             data.Ciphers,
+		Ciphersuites:        data.Ciphersuites,
+		Curves:              data.Curves,
+		PreferClientCiphers: data.PreferClientCiphers,
+	}
+}
+
+func tlsProfile(profile configuration.TLSProfile, builtin bool, assignments []configuration.TLSProfileAssignment) *tls_profile.CreateTLSProfileBody {
+	data := &tls_profile.CreateTLSProfileBody{
+		Name:                misc.StringP(profile.Name),
+		Builtin:             builtin,
+		SslMinVer:           profile.SslMinVer,
+		SslMaxVer:           profile.SslMaxVer,
+		Ciphers:             profile.Ciphers,
+		Ciphersuites:        profile.Ciphersuites,
+		Curves:              profile.Curves,
+		PreferClientCiphers: profile.PreferClientCiphers,
+		Binds:               make([]*tls_profile.CreateTLSProfileBodyBindsItems0, 0),
+		Servers:             make([]*tls_profile.CreateTLSProfileBodyServersItems0, 0),
+	}
+	for _, a := range assignments {
+		if a.Profile != profile.Name {
+			continue
+		}
+		switch a.Type {
+		case tlsProfileBind:
+			data.Binds = append(data.Binds, &tls_profile.CreateTLSProfileBodyBindsItems0{Frontend: misc.StringP(a.Parent), Name: misc.StringP(a.Name)})
+		case tlsProfileServer:
+			data.Servers = append(data.Servers, &tls_profile.CreateTLSProfileBodyServersItems0{Backend: misc.StringP(a.Parent), Name: misc.StringP(a.Name)})
+		}
+	}
+	return data
+}
+
+// tlsProfileOptions returns the values a profile sets on a bind or a server by
+// option keyword, words having the "true" value
+func tlsProfileOptions(profile configuration.TLSProfile, target string) map[string]string {
+	opts := map[string]string{
+		"ssl-min-ver":  profile.SslMinVer,
+		"ssl-max-ver":  profile.SslMaxVer,
+		"ciphers":      profile.Ciphers,
+		"ciphersuites": profile.Ciphersuites,
+	}
+	if target == tlsProfileBind {
+		opts["curves"] = profile.Curves
+		opts["prefer-client-ciphers"] = ""
+		if profile.PreferClientCiphers {
+			opts["prefer-client-ciphers"] = "true"
+		}
+	}
+	return opts
+}
+
+// tlsOptionValues returns the values of the options of a bind or a server by
+// option keyword, words having the "true" value
+func tlsOptionValues(p *parser.Parser, target configuration.TLSProfileAssignment, names []string) (map[string]string, error) {
+	values := make(map[string]string)
+	if target.Type == tlsProfileBind {
+		bindParams, err := getBindParams(p, target.Parent, target.Name, names)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range bindParams {
+			switch v := o.(type) {
+			case *params.BindOptionWord:
+				values[v.Name] = "true"
+			case *params.BindOptionValue:
+				values[v.Name] = v.Value
+			}
+		}
+		return values, nil
+	}
+	serverParams, err := getServerParams(p, target.Parent, target.Name, names)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range serverParams {
+		if v, ok := o.(*params.ServerOptionValue); ok {
+			values[v.Name] = v.Value
+		}
+	}
+	return values, nil
+}
+
+// applyTLSProfile replaces the TLS options a profile sets on a bind or a server,
+// which must have ssl enabled
+func applyTLSProfile(p *parser.Parser, profile configuration.TLSProfile, target configuration.TLSProfileAssignment) error {
+	opts := tlsProfileOptions(profile, target.Type)
+	if target.Type == tlsProfileBind {
+		ssl, err := getBindParams(p, target.Parent, target.Name, []string{"ssl"})
+		if err != nil {
+			return err
+		}
+		if len(ssl) == 0 {
+			return native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("ssl is not enabled on bind %s of frontend %s", target.Name, target.Parent))
+		}
+		bindParams := make([]params.BindOption, 0, len(tlsProfileBindOptions))
+		for _, n := range tlsProfileBindOptions {
+			switch {
+			case opts[n] == "":
+			case n == "prefer-client-ciphers":
+				bindParams = append(bindParams, &params.BindOptionWord{Name: n})
+			default:
+				bindParams = append(bindParams, &params.BindOptionValue{Name: n, Value: opts[n]})
+			}
+		}
+		return setBindParams(p, target.Parent, target.Name, tlsProfileBindOptions, bindParams)
+	}
+	ssl, err := getServerParams(p, target.Parent, target.Name, []string{"ssl"})
+	if err != nil {
+		return err
+	}
+	if len(ssl) == 0 {
+		return native_configuration.NewConfError(native_configuration.ErrValidationError, fmt.Sprintf("ssl is not enabled on server %s of backend %s", target.Name, target.Parent))
+	}
+	serverParams := make([]params.ServerOption, 0, len(serverSSLOptions))
+	for _, n := range serverSSLOptions {
+		if opts[n] != "" {
+			serverParams = append(serverParams, &params.ServerOptionValue{Name: n, Value: opts[n]})
+		}
+	}
+	return setServerParams(p, target.Parent, target.Name, serverSSLOptions, serverParams)
+}
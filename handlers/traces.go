@@ -0,0 +1,211 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/traces"
+)
+
+//GetTracesHandlerImpl implementation of the GetTracesHandler interface using client-native client
+type GetTracesHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//ReplaceTraceHandlerImpl implementation of the ReplaceTraceHandler interface using client-native client
+type ReplaceTraceHandlerImpl struct {
+	Client *client_native.HAProxyClient
+}
+
+//GetRingEventsHandlerImpl implementation of the GetRingEventsHandler interface
+type GetRingEventsHandlerImpl struct {
+	Client       *client_native.HAProxyClient
+	MasterSocket string
+}
+
+//Handle executing the request and returning a response
+func (h *GetTracesHandlerImpl) Handle(params traces.GetTracesParams, principal interface{}) middleware.Responder {
+	sources, err := showTrace(h.Client)
+	if err != nil {
+		e := misc.HandleError(err)
+		return traces.NewGetTracesDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := make([]*traces.GetTracesOKBodyItems0, 0, len(sources))
+	for _, s := range sources {
+		data = append(data, traceSource(s))
+	}
+	return traces.NewGetTracesOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceTraceHandlerImpl) Handle(params traces.ReplaceTraceParams, principal interface{}) middleware.Responder {
+	sources, err := showTrace(h.Client)
+	if err == nil {
+		_, err = findTraceSource(sources, params.Source)
+	}
+	if err == nil {
+		err = configureTrace(h.Client, params.Source, params.Data)
+	}
+	if err == nil {
+		sources, err = showTrace(h.Client)
+	}
+	var s *haproxy.TraceSource
+	if err == nil {
+		s, err = findTraceSource(sources, params.Source)
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return traces.NewReplaceTraceDefault(int(*e.Code)).WithPayload(e)
+	}
+	ok := traces.ReplaceTraceOKBody(*traceSource(*s))
+	return traces.NewReplaceTraceOK().WithPayload(&ok)
+}
+
+//Handle executing the request and returning a response
+func (h *GetRingEventsHandlerImpl) Handle(params traces.GetRingEventsParams, principal interface{}) middleware.Responder {
+	return ringStream(h.Client, h.MasterSocket, params.Ring, *params.Follow, *params.Timeout, "text/plain", nil, func(e *models.Error) middleware.Responder {
+		return traces.NewGetRingEventsDefault(int(*e.Code)).WithPayload(e)
+	})
+}
+
+// ringStream returns a responder streaming the events of a ring buffer. The
+// events go through wrap when set, errors happening before anything is written
+// are returned with fail.
+func ringStream(client *client_native.HAProxyClient, masterSocket, ring string, follow bool, timeout int64, contentType string, wrap func(io.Writer) io.Writer, fail func(*models.Error) middleware.Responder) middleware.Responder {
+	socket, master := masterSocket, true
+	if socket == "" {
+		socket, master = runtimeSocket(client), false
+	}
+
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		lw := &lazyHeaderWriter{rw: rw, code: http.StatusOK}
+		var w io.Writer = lw
+		if wrap != nil {
+			w = wrap(lw)
+		}
+		flush := func() {
+			if f, ok := rw.(http.Flusher); ok {
+				f.Flush()
+			}
+		}
+		rw.Header().Set(runtime.HeaderContentType, contentType)
+		err := haproxy.StreamRingEvents(socket, master, ring, follow, time.Duration(timeout)*time.Second, w, flush)
+		if err == nil || lw.written {
+			return
+		}
+		if err == haproxy.ErrRingNotFound {
+			err = configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("ring buffer %s not found", ring))
+		}
+		rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+		fail(misc.HandleError(err)).WriteResponse(rw, runtime.JSONProducer())
+	})
+}
+
+// lazyHeaderWriter postpones writing the status code until the first write, so
+// an error can still be returned when the stream fails before producing output
+type lazyHeaderWriter struct {
+	rw      http.ResponseWriter
+	code    int
+	written bool
+}
+
+func (w *lazyHeaderWriter) Write(b []byte) (int, error) {
+	if !w.written {
+		w.rw.WriteHeader(w.code)
+		w.written = true
+	}
+	return w.rw.Write(b)
+}
+
+func showTrace(client *client_native.HAProxyClient) ([]haproxy.TraceSource, error) {
+	if client.Runtime == nil {
+		return nil, configuration.NewConfError(configuration.ErrGeneralError, "runtime API not configured")
+	}
+	return haproxy.ShowTrace(client.Runtime)
+}
+
+func findTraceSource(sources []haproxy.TraceSource, name string) (*haproxy.TraceSource, error) {
+	for i := range sources {
+		if sources[i].Name == name {
+			return &sources[i], nil
+		}
+	}
+	return nil, configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("trace source %s not found", name))
+}
+
+// configureTrace sends the trace commands for the given settings, the state is
+// changed last so that tracing starts with the new sink, level and events
+func configureTrace(client *client_native.HAProxyClient, source string, data traces.ReplaceTraceBody) error {
+	commands := make([]string, 0)
+	if data.Sink != "" {
+		commands = append(commands, fmt.Sprintf("trace %s sink %s", source, data.Sink))
+	}
+	if data.Level != "" {
+		commands = append(commands, fmt.Sprintf("trace %s level %s", source, data.Level))
+	}
+	if data.Verbosity != "" {
+		commands = append(commands, fmt.Sprintf("trace %s verbosity %s", source, data.Verbosity))
+	}
+	for _, e := range data.Events {
+		commands = append(commands, fmt.Sprintf("trace %s event %s", source, e))
+	}
+	if data.State != "" {
+		commands = append(commands, fmt.Sprintf("trace %s %s now", source, data.State))
+	}
+	for _, c := range commands {
+		if err := haproxy.ExecuteRuntimeCommand(client.Runtime, c); err != nil {
+			return configuration.NewConfError(configuration.ErrValidationError, err.Error())
+		}
+	}
+	return nil
+}
+
+// runtimeSocket returns the first unix socket of the runtime API configured in
+// the global section
+func runtimeSocket(client *client_native.HAProxyClient) string {
+	_, global, err := client.Configuration.GetGlobalConfiguration("")
+	if err != nil {
+		return ""
+	}
+	for _, r := range global.RuntimeAPIs {
+		if r.Address != nil && misc.IsUnixSocketAddr(*r.Address) {
+			return *r.Address
+		}
+	}
+	return ""
+}
+
+func traceSource(s haproxy.TraceSource) *traces.GetTracesOKBodyItems0 {
+	return &traces.GetTracesOKBodyItems0{
+		Name:        s.Name,
+		Description: s.Description,
+		State:       s.State,
+		Sink:        s.Sink,
+		Dropped:     s.Dropped,
+	}
+}
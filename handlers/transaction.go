@@ -16,30 +16,66 @@
 package handlers
 
 import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	api_errors "github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/client-native/v2/configuration"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/dataplaneapi/auth"
+	dataplaneapi_config "github.com/haproxytech/dataplaneapi/configuration"
 	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/hooks"
+	"github.com/haproxytech/dataplaneapi/metrics"
 	"github.com/haproxytech/dataplaneapi/misc"
 	"github.com/haproxytech/dataplaneapi/operations/transactions"
+	"github.com/haproxytech/dataplaneapi/system"
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
 )
 
 //StartTransactionHandlerImpl implementation of the StartTransactionHandler interface using client-native client
 type StartTransactionHandlerImpl struct {
-	Client *client_native.HAProxyClient
+	Client   *client_native.HAProxyClient
+	Metadata *haproxy.TransactionMetadataStore
+	// MaxOpen, if set, is the number of transactions in progress beyond
+	// which no transaction is started
+	MaxOpen int
+	mu      sync.Mutex
 }
 
 //DeleteTransactionHandlerImpl implementation of the DeleteTransactionHandler interface using client-native client
 type DeleteTransactionHandlerImpl struct {
-	Client *client_native.HAProxyClient
+	Client   *client_native.HAProxyClient
+	Metadata *haproxy.TransactionMetadataStore
+	// Storage, if set, holds the storage files staged in the transactions
+	Storage *haproxy.TransactionStorage
+	// RBAC restricts the users with a scoped role to their own transactions
+	RBAC *dataplaneapi_config.RBAC
 }
 
 //GetTransactionHandlerImpl implementation of the GetTransactionHandler interface using client-native client
 type GetTransactionHandlerImpl struct {
-	Client *client_native.HAProxyClient
+	Client   *client_native.HAProxyClient
+	Metadata *haproxy.TransactionMetadataStore
 }
 
 //GetTransactionsHandlerImpl implementation of the GetTransactionsHandler interface using client-native client
 type GetTransactionsHandlerImpl struct {
+	Client   *client_native.HAProxyClient
+	Metadata *haproxy.TransactionMetadataStore
+}
+
+//GetTransactionImpactHandlerImpl implementation of the GetTransactionImpactHandler interface using client-native client
+type GetTransactionImpactHandlerImpl struct {
 	Client *client_native.HAProxyClient
 }
 
@@ -47,67 +83,718 @@ type GetTransactionsHandlerImpl struct {
 type CommitTransactionHandlerImpl struct {
 	Client      *client_native.HAProxyClient
 	ReloadAgent haproxy.IReloadAgent
+	Hooks       *hooks.Runner
+	Metrics     metrics.Recorder
+	Metadata    *haproxy.TransactionMetadataStore
+	// Runtime, if set, returns the runtime API client the changes of the
+	// transactions supported by the runtime API are applied with on commit,
+	// nil when not configured
+	Runtime func() haproxy.RuntimeExecutor
+	// InWindow schedules the reloads of the transactions with ReloadInWindow
+	InWindow bool
+	// Dir is the directory the configurations checked by dry runs are written to
+	Dir string
+	// Validate checks a configuration file with HAProxy, returning its output
+	Validate func(file string) (string, error)
+	// Storage, if set, holds the storage files staged in the transactions,
+	// installed on commit
+	Storage *haproxy.TransactionStorage
+	// RBAC restricts the users with a scoped role to their own transactions
+	RBAC *dataplaneapi_config.RBAC
+}
+
+//GetTransactionStorageHandlerImpl implementation of the GetTransactionStorageHandler interface
+type GetTransactionStorageHandlerImpl struct {
+	Client  *client_native.HAProxyClient
+	Storage *haproxy.TransactionStorage
+}
+
+//ReplaceTransactionMetadataHandlerImpl implementation of the ReplaceTransactionMetadataHandler interface using client-native client
+type ReplaceTransactionMetadataHandlerImpl struct {
+	Client   *client_native.HAProxyClient
+	Metadata *haproxy.TransactionMetadataStore
+	// RBAC restricts the users with a scoped role to their own transactions
+	RBAC *dataplaneapi_config.RBAC
 }
 
 //Handle executing the request and returning a response
 func (th *StartTransactionHandlerImpl) Handle(params transactions.StartTransactionParams, principal interface{}) middleware.Responder {
+	labels, err := haproxy.ParseLabels(params.Label)
+	if err != nil {
+		e := misc.HandleError(configuration.NewConfError(configuration.ErrValidationError, err.Error()))
+		return transactions.NewStartTransactionDefault(int(*e.Code)).WithPayload(e)
+	}
+	note := ""
+	if params.Note != nil {
+		note = *params.Note
+	}
+	owner, _ := principal.(string)
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	if th.MaxOpen > 0 {
+		_, _, open, err := openTransactions(th.Client, th.Metadata)
+		if err != nil {
+			e := misc.HandleError(err)
+			return transactions.NewStartTransactionDefault(int(*e.Code)).WithPayload(e)
+		}
+		if len(open) >= th.MaxOpen {
+			code := int64(http.StatusConflict)
+			msg := fmt.Sprintf("%d transactions are in progress, the limit of open transactions is %d", len(open), th.MaxOpen)
+			body := &transactions.StartTransactionConflictBody{
+				Code:      &code,
+				Message:   &msg,
+				Conflicts: make([]*transactions.StartTransactionConflictBodyConflictsItems0, 0, len(open)),
+			}
+			for _, o := range open {
+				body.Conflicts = append(body.Conflicts, &transactions.StartTransactionConflictBodyConflictsItems0{
+					ID:       o.ID,
+					Owner:    o.Owner,
+					Status:   "in_progress",
+					Sections: o.Sections,
+				})
+			}
+			return transactions.NewStartTransactionConflict().WithPayload(body)
+		}
+	}
 	t, err := th.Client.Configuration.StartTransaction(params.Version)
 	if err != nil {
 		e := misc.HandleError(err)
 		return transactions.NewStartTransactionDefault(int(*e.Code)).WithPayload(e)
 	}
-	return transactions.NewStartTransactionCreated().WithPayload(t)
+	if note != "" || len(labels) > 0 {
+		err = th.Metadata.Set(t.ID, note, labels)
+	}
+	if err == nil {
+		err = th.Metadata.SetOwner(t.ID, owner)
+	}
+	if err != nil {
+		// nolint:errcheck
+		th.Client.Configuration.DeleteTransaction(t.ID)
+		e := misc.HandleError(err)
+		return transactions.NewStartTransactionDefault(int(*e.Code)).WithPayload(e)
+	}
+	return transactions.NewStartTransactionCreated().WithPayload(&transactions.StartTransactionCreatedBody{
+		Version: t.Version,
+		ID:      t.ID,
+		Status:  t.Status,
+		Note:    note,
+		Labels:  labels,
+		Owner:   owner,
+	})
 }
 
 //Handle executing the request and returning a response
 func (th *DeleteTransactionHandlerImpl) Handle(params transactions.DeleteTransactionParams, principal interface{}) middleware.Responder {
+	if err := checkTransactionOwner(th.RBAC, th.Metadata, params.ID, principal, params.HTTPRequest); err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewDeleteTransactionDefault(int(*e.Code)).WithPayload(e)
+	}
+	// deleting an expired transaction removes its record
+	if m, _ := th.Metadata.Get(params.ID); m.Expired != nil {
+		if err := th.Metadata.Delete(params.ID); err != nil {
+			e := misc.HandleError(err)
+			return transactions.NewDeleteTransactionDefault(int(*e.Code)).WithPayload(e)
+		}
+		return transactions.NewDeleteTransactionNoContent()
+	}
 	err := th.Client.Configuration.DeleteTransaction(params.ID)
 	if err != nil {
 		e := misc.HandleError(err)
 		return transactions.NewDeleteTransactionDefault(int(*e.Code)).WithPayload(e)
 	}
+	if err := th.Metadata.Delete(params.ID); err != nil {
+		log.Warningf("Cannot delete metadata of transaction %s: %s", params.ID, err.Error())
+	}
+	if th.Storage != nil {
+		if err := th.Storage.Discard(params.ID); err != nil {
+			log.Warningf("Cannot discard storage files of transaction %s: %s", params.ID, err.Error())
+		}
+	}
 	return transactions.NewDeleteTransactionNoContent()
 }
 
 //Handle executing the request and returning a response
 func (th *GetTransactionHandlerImpl) Handle(params transactions.GetTransactionParams, principal interface{}) middleware.Responder {
+	if m, _ := th.Metadata.Get(params.ID); m.Expired != nil {
+		expired := strfmt.DateTime(*m.Expired)
+		return transactions.NewGetTransactionOK().WithPayload(&transactions.GetTransactionOKBody{
+			Version:   m.Version,
+			ID:        params.ID,
+			Status:    transactions.GetTransactionOKBodyStatusExpired,
+			Note:      m.Note,
+			Labels:    m.Labels,
+			Owner:     m.Owner,
+			ExpiredAt: &expired,
+		})
+	}
 	t, err := th.Client.Configuration.GetTransaction(params.ID)
 	if err != nil {
 		e := misc.HandleError(err)
 		return transactions.NewGetTransactionsDefault(int(*e.Code)).WithPayload(e)
 	}
-	return transactions.NewGetTransactionOK().WithPayload(t)
+	m, _ := th.Metadata.Get(t.ID)
+	return transactions.NewGetTransactionOK().WithPayload(&transactions.GetTransactionOKBody{
+		Version: t.Version,
+		ID:      t.ID,
+		Status:  t.Status,
+		Note:    m.Note,
+		Labels:  m.Labels,
+		Owner:   m.Owner,
+	})
 }
 
 //Handle executing the request and returning a response
 func (th *GetTransactionsHandlerImpl) Handle(params transactions.GetTransactionsParams, principal interface{}) middleware.Responder {
+	selector, err := haproxy.ParseLabels(params.Label)
+	if err != nil {
+		e := misc.HandleError(configuration.NewConfError(configuration.ErrValidationError, err.Error()))
+		return transactions.NewGetTransactionsDefault(int(*e.Code)).WithPayload(e)
+	}
 	s := ""
 	if params.Status != nil {
 		s = *params.Status
 	}
-	ts, err := th.Client.Configuration.GetTransactions(s)
-	if err != nil {
+	ts := &models.Transactions{}
+	if s != transactions.GetTransactionsOKBodyItems0StatusExpired {
+		var err error
+		ts, err = th.Client.Configuration.GetTransactions(s)
+		if err != nil {
+			e := misc.HandleError(err)
+			return transactions.NewGetTransactionsDefault(int(*e.Code)).WithPayload(e)
+		}
+	}
+	items := make([]*transactions.GetTransactionsOKBodyItems0, 0, len(*ts))
+	for _, t := range *ts {
+		m, _ := th.Metadata.Get(t.ID)
+		if !m.Matches(selector) {
+			continue
+		}
+		items = append(items, &transactions.GetTransactionsOKBodyItems0{
+			Version: t.Version,
+			ID:      t.ID,
+			Status:  t.Status,
+			Note:    m.Note,
+			Labels:  m.Labels,
+			Owner:   m.Owner,
+		})
+	}
+	if s == "" || s == transactions.GetTransactionsOKBodyItems0StatusExpired {
+		for _, id := range th.Metadata.Expired() {
+			m, _ := th.Metadata.Get(id)
+			if m.Expired == nil || !m.Matches(selector) {
+				continue
+			}
+			expired := strfmt.DateTime(*m.Expired)
+			items = append(items, &transactions.GetTransactionsOKBodyItems0{
+				Version:   m.Version,
+				ID:        id,
+				Status:    transactions.GetTransactionsOKBodyItems0StatusExpired,
+				Note:      m.Note,
+				Labels:    m.Labels,
+				Owner:     m.Owner,
+				ExpiredAt: &expired,
+			})
+		}
+	}
+	return transactions.NewGetTransactionsOK().WithPayload(items)
+}
+
+//Handle executing the request and returning a response
+func (th *ReplaceTransactionMetadataHandlerImpl) Handle(params transactions.ReplaceTransactionMetadataParams, principal interface{}) middleware.Responder {
+	if err := checkTransactionOwner(th.RBAC, th.Metadata, params.ID, principal, params.HTTPRequest); err != nil {
 		e := misc.HandleError(err)
-		return transactions.NewGetTransactionsDefault(int(*e.Code)).WithPayload(e)
+		return transactions.NewReplaceTransactionMetadataDefault(int(*e.Code)).WithPayload(e)
+	}
+	if _, err := th.Client.Configuration.GetTransaction(params.ID); err != nil {
+		e := misc.HandleError(configuration.NewConfError(configuration.ErrObjectDoesNotExist, err.Error()))
+		return transactions.NewReplaceTransactionMetadataNotFound().WithPayload(e)
 	}
-	return transactions.NewGetTransactionsOK().WithPayload(*ts)
+	if err := haproxy.ValidateLabels(params.Data.Labels); err != nil {
+		e := misc.HandleError(configuration.NewConfError(configuration.ErrValidationError, err.Error()))
+		return transactions.NewReplaceTransactionMetadataBadRequest().WithPayload(e)
+	}
+	if err := th.Metadata.Set(params.ID, params.Data.Note, params.Data.Labels); err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewReplaceTransactionMetadataDefault(int(*e.Code)).WithPayload(e)
+	}
+	return transactions.NewReplaceTransactionMetadataOK().WithPayload(&transactions.ReplaceTransactionMetadataOKBody{
+		Note:   params.Data.Note,
+		Labels: params.Data.Labels,
+	})
 }
 
 //Handle executing the request and returning a response
 func (th *CommitTransactionHandlerImpl) Handle(params transactions.CommitTransactionParams, principal interface{}) middleware.Responder {
+	if err := checkTransactionOwner(th.RBAC, th.Metadata, params.ID, principal, params.HTTPRequest); err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+	}
+	if m, _ := th.Metadata.Get(params.ID); m.Expired != nil {
+		e := misc.HandleError(configuration.NewConfError(configuration.ErrObjectDoesNotExist, fmt.Sprintf("transaction %s expired at %s", params.ID, m.Expired.Format(time.RFC3339))))
+		return transactions.NewCommitTransactionNotFound().WithPayload(e)
+	}
+	if *params.DryRun {
+		return th.dryRun(params.ID)
+	}
+	sections, conflict, err := th.checkConflicts(params.ID, *params.IgnoreConflicts)
+	if err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+	}
+	if conflict != nil {
+		return transactions.NewCommitTransactionConflict().WithPayload(conflict)
+	}
+	start := time.Now()
+	if err := th.runCommitHooks(params.ID); err != nil {
+		th.Metrics.TransactionFailed()
+		e := misc.HandleError(err)
+		return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+	}
+	var staged []haproxy.StagedFile
+	if th.Storage != nil {
+		var err error
+		if staged, err = th.Storage.Staged(params.ID); err != nil {
+			th.Metrics.TransactionFailed()
+			e := misc.HandleError(err)
+			return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+		}
+	}
+	var rt haproxy.RuntimeExecutor
+	var plan *haproxy.ChangePlan
+	// HAProxy reads the staged storage files on reload only
+	if th.Runtime != nil && !*params.ForceReload && len(staged) == 0 {
+		rt = th.Runtime()
+	}
+	if rt != nil {
+		var err error
+		plan, err = th.planChanges(params.ID)
+		if err != nil {
+			log.Warningf("Cannot plan the changes of transaction %s, reloading: %s", params.ID, err.Error())
+		}
+	}
+	// the staged files are installed first for the configuration referencing
+	// them to be validated, and restored when the commit fails
+	restore := func() {}
+	if len(staged) > 0 {
+		var err error
+		if restore, err = th.Storage.Install(params.ID); err != nil {
+			th.Metrics.TransactionFailed()
+			e := misc.HandleError(err)
+			return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+		}
+	}
 	t, err := th.Client.Configuration.CommitTransaction(params.ID)
 	if err != nil {
+		restore()
+		th.Metrics.TransactionFailed()
 		e := misc.HandleError(err)
 		return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
 	}
+	if th.Storage != nil {
+		if err := th.Storage.Discard(params.ID); err != nil {
+			log.Warningf("Cannot discard storage files of transaction %s: %s", params.ID, err.Error())
+		}
+	}
+	if err := th.Metadata.SetCommitted(params.ID, t.Version+1, sections); err != nil {
+		log.Warningf("Cannot record commit of transaction %s: %s", params.ID, err.Error())
+	}
+	th.Metrics.TransactionCommitted(time.Since(start))
+	event := hooks.CommitEvent{Event: "commit", TransactionID: params.ID, Version: t.Version, Time: time.Now().Unix()}
+	if plan != nil {
+		plan.ApplyRuntime(rt)
+		applied, reload := 0, 0
+		for _, c := range plan.Changes {
+			switch c.Status {
+			case haproxy.PlannedApplied:
+				applied++
+			case haproxy.PlannedFailed:
+				log.Warningf("Transaction %s: %s %s not applied at runtime, reloading: %s", params.ID, c.Section, c.Name, c.Error)
+				reload++
+			default:
+				reload++
+			}
+		}
+		log.Infof("Transaction %s: %d changes applied at runtime, %d requiring a reload", params.ID, applied, reload)
+		if !plan.ReloadRequired() {
+			th.Hooks.Notify(event)
+			return transactions.NewCommitTransactionOK().WithPayload(committedTransaction(t))
+		}
+	}
 	if *params.ForceReload {
 		err := th.ReloadAgent.ForceReload()
 		if err != nil {
 			e := misc.HandleError(err)
 			return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
 		}
-		return transactions.NewCommitTransactionOK().WithPayload(t)
+		th.Hooks.Notify(event)
+		return transactions.NewCommitTransactionOK().WithPayload(committedTransaction(t))
+	}
+	var rID string
+	if th.InWindow {
+		rID = th.ReloadAgent.ReloadInWindow()
+	} else {
+		rID = th.ReloadAgent.Reload()
+	}
+	if err := th.Metadata.SetReload(params.ID, rID); err != nil {
+		log.Warningf("Cannot record reload of transaction %s: %s", params.ID, err.Error())
 	}
-	rID := th.ReloadAgent.Reload()
+	event.ReloadID = rID
+	th.Hooks.Notify(event)
 	return transactions.NewCommitTransactionAccepted().WithReloadID(rID).WithPayload(t)
 }
+
+// checkConflicts returns the sections changed by the transaction id and, when
+// it conflicts with other transactions, the response listing them: the
+// transactions committed since it was started on an older configuration
+// version, or unless ignoreOpen the open ones changing the same sections
+func (th *CommitTransactionHandlerImpl) checkConflicts(id string, ignoreOpen bool) ([]string, *transactions.CommitTransactionConflictBody, error) {
+	t, err := th.Client.Configuration.GetTransaction(id)
+	if err != nil || t.Status != "in_progress" {
+		// left to the commit to fail
+		return nil, nil, nil
+	}
+	version, _, open, err := openTransactions(th.Client, th.Metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	code := int64(http.StatusConflict)
+	body := &transactions.CommitTransactionConflictBody{
+		Code:      &code,
+		Conflicts: make([]*transactions.CommitTransactionConflictBodyConflictsItems0, 0),
+	}
+	if t.Version != version {
+		msg := fmt.Sprintf("transaction %s was started on configuration version %d, the configuration is at version %d", id, t.Version, version)
+		body.Message = &msg
+		for _, committed := range th.Metadata.CommittedAfter(t.Version) {
+			m, _ := th.Metadata.Get(committed)
+			body.Conflicts = append(body.Conflicts, &transactions.CommitTransactionConflictBodyConflictsItems0{
+				ID:       committed,
+				Owner:    m.Owner,
+				Status:   "success",
+				Sections: append(make([]string, 0, len(m.Sections)), m.Sections...),
+			})
+		}
+		return nil, body, nil
+	}
+	sections := make([]string, 0)
+	for _, o := range open {
+		if o.ID == id {
+			sections = o.Sections
+		}
+	}
+	if ignoreOpen {
+		return sections, nil, nil
+	}
+	changed := make(map[string]bool, len(sections))
+	for _, s := range sections {
+		changed[s] = true
+	}
+	for _, o := range open {
+		if o.ID == id {
+			continue
+		}
+		shared := make([]string, 0)
+		for _, s := range o.Sections {
+			if changed[s] {
+				shared = append(shared, s)
+			}
+		}
+		if len(shared) > 0 {
+			body.Conflicts = append(body.Conflicts, &transactions.CommitTransactionConflictBodyConflictsItems0{
+				ID:       o.ID,
+				Owner:    o.Owner,
+				Status:   "in_progress",
+				Sections: shared,
+			})
+		}
+	}
+	if len(body.Conflicts) == 0 {
+		return sections, nil, nil
+	}
+	msg := fmt.Sprintf("transaction %s changes sections changed by %d other open transactions", id, len(body.Conflicts))
+	body.Message = &msg
+	return sections, body, nil
+}
+
+// openTransaction is a transaction in progress with the sections it changes
+type openTransaction struct {
+	ID       string
+	Owner    string
+	Sections []string
+}
+
+// openTransactions returns the transactions in progress with the sections
+// they change, none for the ones started on an older configuration version,
+// and the current configuration with its version
+func openTransactions(client *client_native.HAProxyClient, metadata *haproxy.TransactionMetadataStore) (int64, string, []openTransaction, error) {
+	version, current, err := client.Configuration.GetRawConfiguration("", 0)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	ts, err := client.Configuration.GetTransactions("in_progress")
+	if err != nil {
+		return 0, "", nil, err
+	}
+	open := make([]openTransaction, 0, len(*ts))
+	for _, t := range *ts {
+		o := openTransaction{ID: t.ID, Sections: make([]string, 0)}
+		if m, ok := metadata.Get(t.ID); ok {
+			o.Owner = m.Owner
+		}
+		if t.Version == version {
+			_, staged, err := client.Configuration.GetRawConfiguration(t.ID, 0)
+			if err != nil {
+				return 0, "", nil, err
+			}
+			o.Sections = haproxy.ChangedSections(current, staged)
+		}
+		open = append(open, o)
+	}
+	return version, current, open, nil
+}
+
+// dryRun runs the hooks and the HAProxy configuration check on the transaction
+// as it would be committed, without committing it or saving the configuration
+// returned by mutators
+func (th *CommitTransactionHandlerImpl) dryRun(id string) middleware.Responder {
+	t, err := th.Client.Configuration.GetTransaction(id)
+	if err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+	}
+	_, current, err := th.Client.Configuration.GetRawConfiguration("", 0)
+	if err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+	}
+	_, staged, err := th.Client.Configuration.GetRawConfiguration(id, 0)
+	if err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	report := &transactions.CommitTransactionOKBodyDryRun{
+		Errors:   make([]string, 0),
+		Warnings: make([]string, 0),
+		Changes:  make([]*transactions.CommitTransactionOKBodyDryRunChangesItems0, 0),
+	}
+	if th.Hooks.HasBeforeCommit() {
+		p, err := th.Client.Configuration.GetParser(id)
+		if err != nil {
+			e := misc.HandleError(err)
+			return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+		}
+		config := p.String()
+		mutated, err := th.Hooks.BeforeCommit(id, config)
+		switch {
+		case err != nil:
+			report.Errors = append(report.Errors, err.Error())
+		case mutated != config:
+			staged = mutated
+			report.Mutated = true
+		}
+	}
+	if th.Storage != nil {
+		staged, err := th.Storage.Staged(id)
+		if err != nil {
+			e := misc.HandleError(err)
+			return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+		}
+		if len(staged) > 0 {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("%d storage files staged in the transaction are not installed by dry runs, the configuration is checked with the stored files", len(staged)))
+			report.ReloadRequired = true
+		}
+	}
+	if th.Validate != nil {
+		file := filepath.Join(th.Dir, fmt.Sprintf(".dry_run_%s.cfg", id))
+		if err := system.WriteFile(file, []byte(staged), 0600); err != nil {
+			e := misc.HandleError(err)
+			return transactions.NewCommitTransactionDefault(int(*e.Code)).WithPayload(e)
+		}
+		output, err := th.Validate(file)
+		os.Remove(file)
+		report.Output = output
+		alerts := 0
+		for _, l := range strings.Split(output, "\n") {
+			switch {
+			case strings.Contains(l, "[ALERT]"):
+				report.Errors = append(report.Errors, strings.TrimSpace(l))
+				alerts++
+			case strings.Contains(l, "[WARNING]"):
+				report.Warnings = append(report.Warnings, strings.TrimSpace(l))
+			}
+		}
+		if err != nil && alerts == 0 {
+			report.Errors = append(report.Errors, err.Error())
+		}
+	}
+	for _, c := range haproxy.DiffConfigurations(current, staged) {
+		report.Changes = append(report.Changes, &transactions.CommitTransactionOKBodyDryRunChangesItems0{
+			Section:     c.Section,
+			Name:        c.Name,
+			Runtime:     c.Runtime,
+			Description: c.Description,
+		})
+		if !c.Runtime {
+			report.ReloadRequired = true
+		}
+	}
+	report.Valid = len(report.Errors) == 0
+
+	data := committedTransaction(t)
+	data.DryRun = report
+	return transactions.NewCommitTransactionOK().WithPayload(data)
+}
+
+//Handle executing the request and returning a response
+func (th *GetTransactionStorageHandlerImpl) Handle(params transactions.GetTransactionStorageParams, principal interface{}) middleware.Responder {
+	if _, err := th.Client.Configuration.GetTransaction(params.ID); err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewGetTransactionStorageDefault(int(*e.Code)).WithPayload(e)
+	}
+	data := make([]*transactions.GetTransactionStorageOKBodyItems0, 0)
+	if th.Storage != nil {
+		staged, err := th.Storage.Staged(params.ID)
+		if err != nil {
+			e := misc.HandleError(err)
+			return transactions.NewGetTransactionStorageDefault(int(*e.Code)).WithPayload(e)
+		}
+		for _, f := range staged {
+			data = append(data, &transactions.GetTransactionStorageOKBodyItems0{
+				Area:    f.Area,
+				Name:    f.Name,
+				Deleted: f.Deleted,
+				Size:    f.Size,
+			})
+		}
+	}
+	return transactions.NewGetTransactionStorageOK().WithPayload(data)
+}
+
+func committedTransaction(t *models.Transaction) *transactions.CommitTransactionOKBody {
+	return &transactions.CommitTransactionOKBody{
+		Version: t.Version,
+		ID:      t.ID,
+		Status:  t.Status,
+	}
+}
+
+// planChanges plans the changes of the transaction from the running
+// configuration
+func (th *CommitTransactionHandlerImpl) planChanges(t string) (*haproxy.ChangePlan, error) {
+	_, current, err := th.Client.Configuration.GetRawConfiguration("", 0)
+	if err != nil {
+		return nil, err
+	}
+	_, staged, err := th.Client.Configuration.GetRawConfiguration(t, 0)
+	if err != nil {
+		return nil, err
+	}
+	return haproxy.PlanChanges(current, staged), nil
+}
+
+// runCommitHooks runs the validator and mutator hooks on the transaction, saving
+// the configuration returned by mutators
+func (th *CommitTransactionHandlerImpl) runCommitHooks(t string) error {
+	if !th.Hooks.HasBeforeCommit() {
+		return nil
+	}
+	p, err := th.Client.Configuration.GetParser(t)
+	if err != nil {
+		return err
+	}
+	current := p.String()
+	config, err := th.Hooks.BeforeCommit(t, current)
+	if err != nil {
+		return configuration.NewConfError(configuration.ErrValidationError, err.Error())
+	}
+	if config == current {
+		return nil
+	}
+	// parse in a scratch parser first so that the transaction stays intact
+	// when the mutated configuration is invalid
+	scratch := &parser.Parser{}
+	if err := scratch.ParseData(config); err != nil {
+		return configuration.NewConfError(configuration.ErrValidationError, "invalid configuration returned by hooks: "+err.Error())
+	}
+	if err := p.ParseData(config); err != nil {
+		return err
+	}
+	return saveParser(th.Client, p, t, false)
+}
+
+//Handle executing the request and returning a response
+func (th *GetTransactionImpactHandlerImpl) Handle(params transactions.GetTransactionImpactParams, principal interface{}) middleware.Responder {
+	_, current, err := th.Client.Configuration.GetRawConfiguration("", 0)
+	if err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewGetTransactionImpactDefault(int(*e.Code)).WithPayload(e)
+	}
+	_, staged, err := th.Client.Configuration.GetRawConfiguration(params.ID, 0)
+	if err != nil {
+		e := misc.HandleError(err)
+		return transactions.NewGetTransactionImpactDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	impact := &transactions.GetTransactionImpactOKBody{
+		Changes: make([]*transactions.GetTransactionImpactOKBodyChangesItems0, 0),
+	}
+	servers := make(map[string]bool)
+	for _, c := range haproxy.DiffConfigurations(current, staged) {
+		impact.Changes = append(impact.Changes, &transactions.GetTransactionImpactOKBodyChangesItems0{
+			Section:     c.Section,
+			Name:        c.Name,
+			Runtime:     c.Runtime,
+			Description: c.Description,
+		})
+		if !c.Runtime {
+			impact.ReloadRequired = true
+		}
+		for _, srv := range c.Servers {
+			servers[c.Name+"/"+srv] = true
+		}
+	}
+	impact.ActiveConnections = th.connectionsAtRisk(impact.ReloadRequired, servers)
+
+	return transactions.NewGetTransactionImpactOK().WithPayload(impact)
+}
+
+// connectionsAtRisk sums current sessions of all frontends when a reload is required,
+// otherwise of the servers changed through the runtime API
+func (th *GetTransactionImpactHandlerImpl) connectionsAtRisk(reload bool, servers map[string]bool) int64 {
+	if th.Client.Runtime == nil || (!reload && len(servers) == 0) {
+		return 0
+	}
+	var sessions int64
+	for _, nStat := range th.Client.Runtime.GetStats() {
+		if nStat.Error != "" {
+			continue
+		}
+		for _, item := range nStat.Stats {
+			if item.Stats == nil || item.Stats.Scur == nil {
+				continue
+			}
+			if reload && item.Type == "frontend" {
+				sessions += *item.Stats.Scur
+			} else if !reload && item.Type == "server" && servers[item.BackendName+"/"+item.Name] {
+				sessions += *item.Stats.Scur
+			}
+		}
+	}
+	return sessions
+}
+
+// checkTransactionOwner returns an error when the user of principal has a
+// scoped role and did not start the transaction id. The changes are checked
+// against the scopes when they are staged, so the scoped users only commit,
+// delete and annotate their own transactions.
+func checkTransactionOwner(rbac *dataplaneapi_config.RBAC, metadata *haproxy.TransactionMetadataStore, id string, principal interface{}, r *http.Request) error {
+	user, _ := principal.(string)
+	if r == nil || rbac.Admin(user, auth.Roles(r)) {
+		return nil
+	}
+	if m, _ := metadata.Get(id); user != "" && m.Owner == user {
+		return nil
+	}
+	return api_errors.New(http.StatusForbidden, "user %s is not allowed to change transaction %s, started by another user", user, id)
+}
@@ -0,0 +1,428 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	client_native "github.com/haproxytech/client-native/v2"
+	"github.com/haproxytech/dataplaneapi/auth"
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/system"
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+)
+
+// types of the messages of the transaction channel
+const (
+	channelStart      = "start"
+	channelChange     = "change"
+	channelValidate   = "validate"
+	channelCommit     = "commit"
+	channelAbort      = "abort"
+	channelStaged     = "staged"
+	channelValidation = "validation"
+	channelDiff       = "diff"
+	channelCommitted  = "committed"
+	channelAborted    = "aborted"
+	channelError      = "error"
+	channelOpened     = "transaction"
+)
+
+// configurationPrefix is the prefix of the paths the changes streamed to a
+// transaction channel are staged on
+const configurationPrefix = "/services/haproxy/configuration/"
+
+// TransactionChannel stages the changes a client streams over a WebSocket in
+// a transaction, answering each change with the validation of the staged
+// configuration by HAProxy and its diff with the running one. The changes are
+// requests of the API dispatched to API, the handler serving the API without
+// the global middleware, with the credentials of the handshake.
+type TransactionChannel struct {
+	Client *client_native.HAProxyClient
+	API    http.Handler
+	// BasePath is the base path of the API, the changes having paths relative
+	// to it
+	BasePath string
+	// Dir is the directory the staged configurations are written to for
+	// Validate
+	Dir          string
+	Validate     func(file string) error
+	Authenticate func(user, pass string) (interface{}, error)
+	// AuthenticateToken authenticates the handshakes with a bearer token
+	AuthenticateToken func(token string) (*auth.Principal, error)
+	// AllowedOrigins are the origins browsers may open the channel from
+	// besides the API host, as scheme://host[:port]
+	AllowedOrigins []string
+}
+
+// channelRequest is a message sent by the client
+type channelRequest struct {
+	Type string `json:"type"`
+	// ID is echoed in the messages answering the request
+	ID            string            `json:"id,omitempty"`
+	Version       int64             `json:"version,omitempty"`
+	TransactionID string            `json:"transaction_id,omitempty"`
+	Method        string            `json:"method,omitempty"`
+	Path          string            `json:"path,omitempty"`
+	Query         map[string]string `json:"query,omitempty"`
+	Body          json.RawMessage   `json:"body,omitempty"`
+	ForceReload   bool              `json:"force_reload,omitempty"`
+}
+
+// channelMessage is a message sent to the client
+type channelMessage struct {
+	Type           string                 `json:"type"`
+	ID             string                 `json:"id,omitempty"`
+	TransactionID  string                 `json:"transaction_id,omitempty"`
+	Status         int                    `json:"status,omitempty"`
+	Response       json.RawMessage        `json:"response,omitempty"`
+	Valid          *bool                  `json:"valid,omitempty"`
+	Message        string                 `json:"message,omitempty"`
+	ReloadRequired *bool                  `json:"reload_required,omitempty"`
+	Changes        []channelSectionChange `json:"changes,omitempty"`
+}
+
+// channelSectionChange is a change of a section of the staged configuration
+type channelSectionChange struct {
+	Section     string `json:"section"`
+	Name        string `json:"name"`
+	Runtime     bool   `json:"runtime"`
+	Description string `json:"description"`
+}
+
+// channelSession is the state of a connection to the channel
+type channelSession struct {
+	ws            *websocket.Conn
+	authorization string
+	// principal is the user of the client certificate of the handshake
+	principal     *auth.Principal
+	transactionID string
+	// started is set when the transaction was started by the channel, which
+	// deletes it when the client disconnects before committing it
+	started bool
+}
+
+// ServeHTTP authenticates the handshake and serves the channel on the connection
+func (c *TransactionChannel) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := c.authenticate(r); err != nil {
+		w.Header().Set("WWW-Authenticate", `Basic realm="transactions"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		// nolint:errcheck
+		w.Write(channelErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+	websocket.Server{
+		Handshake: c.checkOrigin,
+		Handler: func(ws *websocket.Conn) {
+			c.serve(&channelSession{ws: ws, authorization: r.Header.Get("Authorization"), principal: auth.FromRequest(r)})
+		},
+	}.ServeHTTP(w, r)
+}
+
+// checkOrigin refuses the handshakes sent by browsers from pages of other
+// origins than the API host and AllowedOrigins, as browsers attach the
+// credentials they cached for the API to the handshakes of any page
+func (c *TransactionChannel) checkOrigin(config *websocket.Config, r *http.Request) error {
+	u, err := websocket.Origin(config, r)
+	if err != nil {
+		return err
+	}
+	// clients other than browsers send no origin
+	if u == nil {
+		return nil
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return nil
+	}
+	origin := u.Scheme + "://" + u.Host
+	for _, o := range c.AllowedOrigins {
+		if strings.EqualFold(strings.TrimSuffix(o, "/"), origin) {
+			return nil
+		}
+	}
+	return fmt.Errorf("origin %s is not allowed to open the transaction channel", origin)
+}
+
+// authenticate checks the bearer token or the Basic Authentication of the
+// handshake, the handshakes authenticated with a client certificate having a
+// principal
+func (c *TransactionChannel) authenticate(r *http.Request) error {
+	header := r.Header.Get("Authorization")
+	if header == "" && auth.FromRequest(r) != nil {
+		return nil
+	}
+	if c.AuthenticateToken != nil && len(header) > 7 && strings.EqualFold(header[:7], "bearer ") {
+		_, err := c.AuthenticateToken(strings.TrimSpace(header[7:]))
+		return err
+	}
+	if c.Authenticate == nil {
+		return nil
+	}
+	user, pass, ok := r.BasicAuth()
+	if !ok {
+		return errors.New("unauthenticated for invalid credentials")
+	}
+	_, err := c.Authenticate(user, pass)
+	return err
+}
+
+func (c *TransactionChannel) serve(s *channelSession) {
+	defer s.ws.Close()
+	log.Debugf("transaction channel opened by %s", s.ws.Request().RemoteAddr)
+	for {
+		var req channelRequest
+		if err := websocket.JSON.Receive(s.ws, &req); err != nil {
+			if err == io.EOF {
+				break
+			}
+			if _, ok := err.(*json.SyntaxError); ok {
+				c.send(s, channelMessage{Type: channelError, Message: "invalid message: " + err.Error()})
+				continue
+			}
+			log.Warningf("transaction channel: %s", err.Error())
+			break
+		}
+		if err := c.handle(s, req); err != nil {
+			c.send(s, channelMessage{Type: channelError, ID: req.ID, TransactionID: s.transactionID, Message: err.Error()})
+		}
+	}
+	if s.started && s.transactionID != "" {
+		status, _ := c.dispatch(s, http.MethodDelete, "/services/haproxy/transactions/"+s.transactionID, nil, nil)
+		if status >= http.StatusMultipleChoices {
+			log.Warningf("transaction channel: cannot delete transaction %s: status %d", s.transactionID, status)
+		}
+	}
+	log.Debugf("transaction channel closed by %s", s.ws.Request().RemoteAddr)
+}
+
+func (c *TransactionChannel) handle(s *channelSession, req channelRequest) error {
+	if req.Type != channelStart && s.transactionID == "" {
+		return fmt.Errorf("no transaction, send a %s message first", channelStart)
+	}
+	switch req.Type {
+	case channelStart:
+		return c.start(s, req)
+	case channelChange:
+		if !strings.HasPrefix(req.Path, configurationPrefix) || strings.Contains(req.Path, "..") {
+			return fmt.Errorf("invalid path %s, changes are staged on %s", req.Path, configurationPrefix)
+		}
+		method := strings.ToUpper(req.Method)
+		if method != http.MethodPost && method != http.MethodPut && method != http.MethodDelete {
+			return fmt.Errorf("invalid method %s, expected POST, PUT or DELETE", req.Method)
+		}
+		query := url.Values{}
+		for k, v := range req.Query {
+			query.Set(k, v)
+		}
+		query.Del("version")
+		query.Set("transaction_id", s.transactionID)
+		status, response := c.dispatch(s, method, req.Path, query, req.Body)
+		c.send(s, channelMessage{Type: channelStaged, ID: req.ID, TransactionID: s.transactionID, Status: status, Response: response})
+		if status < http.StatusMultipleChoices {
+			c.feedback(s, req.ID)
+		}
+	case channelValidate:
+		c.feedback(s, req.ID)
+	case channelCommit:
+		query := url.Values{}
+		if req.ForceReload {
+			query.Set("force_reload", "true")
+		}
+		status, response := c.dispatch(s, http.MethodPut, "/services/haproxy/transactions/"+s.transactionID, query, nil)
+		c.send(s, channelMessage{Type: channelCommitted, ID: req.ID, TransactionID: s.transactionID, Status: status, Response: response})
+		if status < http.StatusMultipleChoices {
+			s.transactionID = ""
+		}
+	case channelAbort:
+		status, response := c.dispatch(s, http.MethodDelete, "/services/haproxy/transactions/"+s.transactionID, nil, nil)
+		c.send(s, channelMessage{Type: channelAborted, ID: req.ID, TransactionID: s.transactionID, Status: status, Response: response})
+		if status < http.StatusMultipleChoices {
+			s.transactionID = ""
+		}
+	default:
+		return fmt.Errorf("unknown message type %s", req.Type)
+	}
+	return nil
+}
+
+// start starts a transaction on the given version, the current one when not
+// set, or attaches the channel to the transaction of the request
+func (c *TransactionChannel) start(s *channelSession, req channelRequest) error {
+	if s.transactionID != "" {
+		return fmt.Errorf("transaction %s is open, commit or abort it first", s.transactionID)
+	}
+	var status int
+	var response json.RawMessage
+	if req.TransactionID != "" {
+		status, response = c.dispatch(s, http.MethodGet, "/services/haproxy/transactions/"+req.TransactionID, nil, nil)
+	} else {
+		version := req.Version
+		if version == 0 {
+			v, err := c.Client.Configuration.GetVersion("")
+			if err != nil {
+				return err
+			}
+			version = v
+		}
+		query := url.Values{"version": {strconv.FormatInt(version, 10)}}
+		status, response = c.dispatch(s, http.MethodPost, "/services/haproxy/transactions", query, nil)
+	}
+	if status >= http.StatusMultipleChoices {
+		c.send(s, channelMessage{Type: channelOpened, ID: req.ID, Status: status, Response: response})
+		return nil
+	}
+	t := &models.Transaction{}
+	if err := json.Unmarshal(response, t); err != nil {
+		return err
+	}
+	s.transactionID = t.ID
+	s.started = req.TransactionID == ""
+	c.send(s, channelMessage{Type: channelOpened, ID: req.ID, TransactionID: s.transactionID, Status: status, Response: response})
+	return nil
+}
+
+// feedback sends the validation of the staged configuration and its diff
+// with the running one
+func (c *TransactionChannel) feedback(s *channelSession, id string) {
+	_, current, err := c.Client.Configuration.GetRawConfiguration("", 0)
+	if err != nil {
+		c.send(s, channelMessage{Type: channelError, ID: id, TransactionID: s.transactionID, Message: err.Error()})
+		return
+	}
+	_, staged, err := c.Client.Configuration.GetRawConfiguration(s.transactionID, 0)
+	if err != nil {
+		c.send(s, channelMessage{Type: channelError, ID: id, TransactionID: s.transactionID, Message: err.Error()})
+		return
+	}
+
+	if c.Validate != nil {
+		valid := true
+		validation := channelMessage{Type: channelValidation, ID: id, TransactionID: s.transactionID, Valid: &valid}
+		if err := c.validate(s.transactionID, staged); err != nil {
+			valid = false
+			validation.Message = err.Error()
+		}
+		c.send(s, validation)
+	}
+
+	reload := false
+	diff := channelMessage{Type: channelDiff, ID: id, TransactionID: s.transactionID, ReloadRequired: &reload, Changes: make([]channelSectionChange, 0)}
+	for _, ch := range haproxy.DiffConfigurations(current, staged) {
+		diff.Changes = append(diff.Changes, channelSectionChange{
+			Section:     ch.Section,
+			Name:        ch.Name,
+			Runtime:     ch.Runtime,
+			Description: ch.Description,
+		})
+		if !ch.Runtime {
+			reload = true
+		}
+	}
+	c.send(s, diff)
+}
+
+// validate checks the staged configuration with HAProxy
+func (c *TransactionChannel) validate(transactionID, staged string) error {
+	file := filepath.Join(c.Dir, fmt.Sprintf(".channel_%s.cfg", transactionID))
+	if err := system.WriteFile(file, []byte(staged), 0600); err != nil {
+		return err
+	}
+	defer os.Remove(file)
+	return c.Validate(file)
+}
+
+// dispatch serves a request of the API with the credentials of the session,
+// returning the status and the body of the response
+func (c *TransactionChannel) dispatch(s *channelSession, method, path string, query url.Values, body []byte) (int, json.RawMessage) {
+	u := url.URL{Path: c.BasePath + path, RawQuery: query.Encode()}
+	r, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return http.StatusBadRequest, channelErrorResponse(http.StatusBadRequest, err.Error())
+	}
+	r.RemoteAddr = s.ws.Request().RemoteAddr
+	if s.authorization != "" {
+		r.Header.Set("Authorization", s.authorization)
+	} else if s.principal != nil {
+		r = auth.WithPrincipal(r, s.principal)
+	}
+	if len(body) > 0 {
+		r.Header.Set("Content-Type", "application/json")
+	}
+	w := &channelResponseWriter{header: http.Header{}}
+	c.API.ServeHTTP(w, r)
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	data := bytes.TrimSpace(w.body.Bytes())
+	switch {
+	case len(data) == 0:
+		return w.status, nil
+	case json.Valid(data):
+		return w.status, data
+	}
+	text, _ := json.Marshal(string(data))
+	return w.status, text
+}
+
+func (c *TransactionChannel) send(s *channelSession, m channelMessage) {
+	if err := websocket.JSON.Send(s.ws, m); err != nil {
+		log.Warningf("transaction channel: %s", err.Error())
+	}
+}
+
+func channelErrorResponse(status int, msg string) json.RawMessage {
+	code := int64(status)
+	e, _ := (&models.Error{Code: &code, Message: &msg}).MarshalJSON()
+	return e
+}
+
+// channelResponseWriter records the response of a request dispatched by the
+// transaction channel
+type channelResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *channelResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *channelResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *channelResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
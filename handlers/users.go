@@ -0,0 +1,76 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handlers
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	client_native "github.com/haproxytech/client-native/v2"
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/dataplaneapi/configuration"
+	"github.com/haproxytech/dataplaneapi/haproxy"
+	"github.com/haproxytech/dataplaneapi/misc"
+	"github.com/haproxytech/dataplaneapi/operations/users"
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+//ReplaceSelfPasswordHandlerImpl implementation of the ReplaceSelfPasswordHandler interface
+type ReplaceSelfPasswordHandlerImpl struct {
+	Client      *client_native.HAProxyClient
+	Config      *configuration.Configuration
+	ReloadAgent haproxy.IReloadAgent
+	Users       *configuration.Users
+}
+
+//Handle executing the request and returning a response
+func (h *ReplaceSelfPasswordHandlerImpl) Handle(params users.ReplaceSelfPasswordParams, principal interface{}) middleware.Responder {
+	name, ok := principal.(string)
+	if !ok || name == "" {
+		msg := "no authenticated user"
+		c := misc.ErrHTTPBadRequest
+		return users.NewReplaceSelfPasswordBadRequest().WithPayload(&models.Error{Code: &c, Message: &msg})
+	}
+	hash, err := configuration.HashPassword(*params.Data.Password)
+	if err != nil {
+		e := misc.HandleError(err)
+		return users.NewReplaceSelfPasswordDefault(int(*e.Code)).WithPayload(e)
+	}
+
+	if !h.Users.InHAProxyConfiguration() {
+		if err := h.Users.SetPassword(name, hash); err != nil {
+			e := misc.HandleError(err)
+			return users.NewReplaceSelfPasswordDefault(int(*e.Code)).WithPayload(e)
+		}
+		return users.NewReplaceSelfPasswordOK()
+	}
+
+	// the userlist is part of the HAProxy configuration, change it like any
+	// other section and reload
+	v, err := h.Client.Configuration.GetVersion("")
+	if err == nil {
+		err = changeParser(h.Client, "", v, func(p *parser.Parser) error {
+			return configuration.SetUserlistPassword(p, h.Config.HAProxyOptions().Userlist, name, hash)
+		})
+	}
+	if err != nil {
+		e := misc.HandleError(err)
+		return users.NewReplaceSelfPasswordDefault(int(*e.Code)).WithPayload(e)
+	}
+	if err := h.Users.Init(); err != nil {
+		log.Warningf("Reading the users again after changing the password of %s failed: %s", name, err.Error())
+	}
+	return users.NewReplaceSelfPasswordAccepted().WithReloadID(h.ReloadAgent.Reload())
+}
@@ -0,0 +1,591 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// aclMatchMethods are the pattern matching methods of the -m ACL flag
+var aclMatchMethods = []string{"found", "bool", "int", "ip", "bin", "len", "str", "sub", "reg", "beg", "end", "dir", "dom"}
+
+// aclIntOperators are the operators accepted before integer patterns
+var aclIntOperators = []string{"eq", "ge", "gt", "le", "lt"}
+
+// aclFetchMatch are the default matching methods of the sample fetches not
+// returning strings
+var aclFetchMatch = map[string]string{
+	"always_false": "bool", "always_true": "bool", "dst_is_local": "bool", "src_is_local": "bool",
+	"fc_rcvd_proxy": "bool", "http_first_req": "bool", "req.proto_http": "bool", "req_proto_http": "bool",
+	"ssl_bc": "bool", "ssl_bc_is_resumed": "bool", "ssl_c_used": "bool", "ssl_fc": "bool",
+	"ssl_fc_has_crt": "bool", "ssl_fc_has_early": "bool", "ssl_fc_has_sni": "bool",
+	"ssl_fc_is_resumed": "bool", "stopping": "bool", "wait_end": "bool", "sc_tracked": "bool",
+	"src": "ip", "dst": "ip", "hdr_ip": "ip", "req.hdr_ip": "ip", "res.hdr_ip": "ip", "shdr_ip": "ip", "url_ip": "ip",
+	"be_conn": "int", "dst_conn": "int", "dst_port": "int", "fe_conn": "int", "hdr_cnt": "int",
+	"req.hdr_cnt": "int", "req.len": "int", "req_len": "int", "src_port": "int", "status": "int",
+	"nbsrv": "int", "queue": "int", "srv_conn": "int", "url_port": "int", "hdr_val": "int",
+	"req.hdr_val": "int", "sc_conn_cur": "int", "sc_http_req_rate": "int", "src_conn_cur": "int",
+	"src_conn_rate": "int", "src_http_req_rate": "int", "src_http_err_rate": "int",
+}
+
+// ACLExpression is a parsed ACL expression, made of a criterion, flags and patterns
+type ACLExpression struct {
+	Criterion string
+	// Fetch is the sample fetch of the criterion, without the matching method suffix
+	Fetch      string
+	FetchArgs  []string
+	Converters []string
+	// Match is the pattern matching method, explicit or derived from the criterion
+	Match           string
+	CaseInsensitive bool
+	Patterns        []string
+	Files           []string
+}
+
+// ACLSampleHeader is a header of an ACL sample request
+type ACLSampleHeader struct {
+	Name  string
+	Value string
+}
+
+// ACLSample describes a request an ACL expression is evaluated against
+type ACLSample struct {
+	Method  string
+	Path    string
+	Query   string
+	Version string
+	Headers []ACLSampleHeader
+	Src     string
+	SrcPort int64
+	Dst     string
+	DstPort int64
+	SSL     bool
+}
+
+// ParseACLExpression splits an ACL expression into its criterion, flags and patterns
+func ParseACLExpression(expr string) (*ACLExpression, error) {
+	words, err := splitACLWords(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("empty ACL expression")
+	}
+	a := &ACLExpression{Criterion: words[0], Patterns: make([]string, 0), Files: make([]string, 0)}
+	terms, err := splitExpression(a.Criterion)
+	if err != nil {
+		return nil, err
+	}
+	a.Fetch, a.FetchArgs = splitTerm(terms[0])
+	a.Converters = terms[1:]
+
+	i := 1
+flags:
+	for ; i < len(words); i++ {
+		switch words[i] {
+		case "-i":
+			a.CaseInsensitive = true
+		case "-n", "-M":
+		case "-f", "-m", "-u":
+			if i+1 == len(words) {
+				return nil, fmt.Errorf("missing argument of ACL flag %s", words[i])
+			}
+			i++
+			switch words[i-1] {
+			case "-f":
+				a.Files = append(a.Files, words[i])
+			case "-m":
+				if !inSlice(words[i], aclMatchMethods) {
+					return nil, fmt.Errorf("unknown ACL matching method '%s', expected one of %s", words[i], strings.Join(aclMatchMethods, ", "))
+				}
+				a.Match = words[i]
+			}
+		case "--":
+			i++
+			break flags
+		default:
+			if strings.HasPrefix(words[i], "-") && len(words[i]) > 1 && !isNumber(words[i]) {
+				return nil, fmt.Errorf("unknown ACL flag %s", words[i])
+			}
+			break flags
+		}
+	}
+	a.Patterns = append(a.Patterns, words[i:]...)
+
+	// derived criteria such as hdr_beg or path_reg set the matching method
+	if _, ok := sampleFetches[trackCounterRegexp.ReplaceAllString(a.Fetch, "sc_")]; !ok {
+		if p := strings.LastIndex(a.Fetch, "_"); p > 0 && inSlice(a.Fetch[p+1:], aclMatchMethods) {
+			if a.Match == "" {
+				a.Match = a.Fetch[p+1:]
+			}
+			a.Fetch = a.Fetch[:p]
+		}
+	}
+	if a.Match == "" {
+		a.Match = "str"
+		if len(a.Converters) == 0 {
+			if m, ok := aclFetchMatch[trackCounterRegexp.ReplaceAllString(a.Fetch, "sc_")]; ok {
+				a.Match = m
+			}
+		}
+	}
+	return a, nil
+}
+
+// ValidateACL checks the sample fetch, converters, pattern files and patterns of
+// an ACL expression. Relative pattern files are looked up in baseDir. It returns
+// the errors HAProxy would fail on and warnings about patterns it may not handle
+// as expected.
+func (v *SampleValidator) ValidateACL(a *ACLExpression, baseDir string) (errs []string, warnings []string) {
+	errs = make([]string, 0)
+	warnings = make([]string, 0)
+	expr := a.Fetch
+	if len(a.FetchArgs) > 0 {
+		expr += "(" + strings.Join(a.FetchArgs, ",") + ")"
+	}
+	if len(a.Converters) > 0 {
+		expr += "," + strings.Join(a.Converters, ",")
+	}
+	if err := v.ValidateExpression(expr); err != nil {
+		errs = append(errs, err.Error())
+	}
+	for _, f := range a.Files {
+		if _, err := os.Stat(aclFilePath(f, baseDir)); err != nil {
+			errs = append(errs, fmt.Sprintf("pattern file %s: %s", f, err.Error()))
+		}
+	}
+	if len(a.Patterns) == 0 && len(a.Files) == 0 && a.Match != "found" && a.Match != "bool" {
+		errs = append(errs, fmt.Sprintf("no pattern to match with method '%s'", a.Match))
+	}
+	for i, p := range a.Patterns {
+		switch a.Match {
+		case "int", "len":
+			if inSlice(p, aclIntOperators) {
+				if i+1 == len(a.Patterns) {
+					errs = append(errs, fmt.Sprintf("missing value after operator '%s'", p))
+				}
+				continue
+			}
+			if _, _, err := parseIntRange(p); err != nil {
+				errs = append(errs, err.Error())
+			}
+		case "ip":
+			if !isIPPattern(p) {
+				warnings = append(warnings, fmt.Sprintf("'%s' is not an IP address or network, it will be resolved as a host name when HAProxy starts", p))
+			}
+		case "reg":
+			if _, err := regexp.Compile(p); err != nil {
+				warnings = append(warnings, fmt.Sprintf("regular expression '%s' could not be checked: %s", p, err.Error()))
+			}
+		case "found", "bool":
+			errs = append(errs, fmt.Sprintf("method '%s' does not take patterns, got '%s'", a.Match, p))
+		}
+	}
+	return errs, warnings
+}
+
+// EvaluateACL evaluates an ACL expression against a sample request, with an
+// error when its fetch, converters or pattern files can not be evaluated
+func EvaluateACL(a *ACLExpression, s *ACLSample, baseDir string) (bool, string, error) {
+	values, err := aclFetchValues(a, s)
+	if err != nil {
+		return false, "", err
+	}
+	for _, c := range a.Converters {
+		name, _ := splitTerm(c)
+		for i := range values {
+			switch name {
+			case "lower":
+				values[i] = strings.ToLower(values[i])
+			case "upper":
+				values[i] = strings.ToUpper(values[i])
+			case "length":
+				values[i] = strconv.Itoa(len(values[i]))
+			default:
+				return false, "", fmt.Errorf("converter '%s' can not be evaluated without HAProxy", name)
+			}
+		}
+	}
+
+	switch a.Match {
+	case "found":
+		return len(values) > 0, fmt.Sprintf("%s returned %d value(s)", a.Criterion, len(values)), nil
+	case "bool":
+		for _, v := range values {
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil && n != 0 {
+				return true, fmt.Sprintf("%s is true", a.Criterion), nil
+			}
+		}
+		return false, fmt.Sprintf("%s is false", a.Criterion), nil
+	}
+
+	patterns := append([]string{}, a.Patterns...)
+	for _, f := range a.Files {
+		lines, err := readACLFile(aclFilePath(f, baseDir))
+		if err != nil {
+			return false, "", err
+		}
+		patterns = append(patterns, lines...)
+	}
+	if len(values) == 0 {
+		return false, fmt.Sprintf("%s returned no value", a.Criterion), nil
+	}
+	for _, v := range values {
+		op := ""
+		for _, p := range patterns {
+			if (a.Match == "int" || a.Match == "len") && inSlice(p, aclIntOperators) {
+				op = p
+				continue
+			}
+			ok, err := aclMatch(a.Match, a.CaseInsensitive, op, v, p)
+			if err != nil {
+				return false, "", err
+			}
+			if ok {
+				return true, fmt.Sprintf("value '%s' matched pattern '%s' with method '%s'", v, p, a.Match), nil
+			}
+		}
+	}
+	return false, fmt.Sprintf("no pattern matched value(s) '%s' with method '%s'", strings.Join(values, "', '"), a.Match), nil
+}
+
+// aclFetchValues returns the values of the fetch of an ACL expression for a sample request
+func aclFetchValues(a *ACLExpression, s *ACLSample) ([]string, error) {
+	arg := ""
+	if len(a.FetchArgs) > 0 {
+		arg = a.FetchArgs[0]
+	}
+	boolValue := func(b bool) []string {
+		if b {
+			return []string{"1"}
+		}
+		return []string{"0"}
+	}
+	version := s.Version
+	if version == "" {
+		version = "1.1"
+	}
+	url := s.Path
+	if s.Query != "" {
+		url += "?" + s.Query
+	}
+
+	switch a.Fetch {
+	case "method":
+		return []string{s.Method}, nil
+	case "path":
+		return []string{s.Path}, nil
+	case "query":
+		return []string{s.Query}, nil
+	case "url":
+		return []string{url}, nil
+	case "base":
+		host := ""
+		if h := headerValues(s, "host", false); len(h) > 0 {
+			host = h[0]
+		}
+		return []string{host + s.Path}, nil
+	case "req.ver", "req_ver":
+		return []string{version}, nil
+	case "hdr", "req.hdr":
+		return headerValues(s, arg, true), nil
+	case "req.fhdr":
+		return headerValues(s, arg, false), nil
+	case "hdr_cnt", "req.hdr_cnt":
+		return []string{strconv.Itoa(len(headerValues(s, arg, true)))}, nil
+	case "cook", "req.cook":
+		values := make([]string, 0)
+		for _, h := range headerValues(s, "cookie", false) {
+			for _, c := range strings.Split(h, ";") {
+				kv := strings.SplitN(strings.TrimSpace(c), "=", 2)
+				if len(kv) == 2 && (arg == "" || kv[0] == arg) {
+					values = append(values, kv[1])
+				}
+			}
+		}
+		return values, nil
+	case "url_param", "urlp":
+		values := make([]string, 0)
+		for _, p := range strings.Split(s.Query, "&") {
+			kv := strings.SplitN(p, "=", 2)
+			if len(kv) == 2 && kv[0] == arg {
+				values = append(values, kv[1])
+			}
+		}
+		return values, nil
+	case "src":
+		return []string{s.Src}, nil
+	case "dst":
+		return []string{s.Dst}, nil
+	case "src_port":
+		return []string{strconv.FormatInt(s.SrcPort, 10)}, nil
+	case "dst_port":
+		return []string{strconv.FormatInt(s.DstPort, 10)}, nil
+	case "ssl_fc":
+		return boolValue(s.SSL), nil
+	case "always_true":
+		return boolValue(true), nil
+	case "always_false":
+		return boolValue(false), nil
+	}
+	return nil, fmt.Errorf("sample fetch '%s' can not be evaluated without HAProxy", a.Fetch)
+}
+
+// headerValues returns the values of the sample request headers named name, all
+// headers when name is empty, split on commas when split is set
+func headerValues(s *ACLSample, name string, split bool) []string {
+	values := make([]string, 0)
+	for _, h := range s.Headers {
+		if name != "" && !strings.EqualFold(h.Name, name) {
+			continue
+		}
+		if !split {
+			values = append(values, h.Value)
+			continue
+		}
+		for _, v := range strings.Split(h.Value, ",") {
+			values = append(values, strings.TrimSpace(v))
+		}
+	}
+	return values
+}
+
+func aclMatch(method string, ci bool, op, value, pattern string) (bool, error) {
+	if ci && method != "reg" {
+		value = strings.ToLower(value)
+		pattern = strings.ToLower(pattern)
+	}
+	switch method {
+	case "str", "bin":
+		return value == pattern, nil
+	case "beg":
+		return strings.HasPrefix(value, pattern), nil
+	case "end":
+		return strings.HasSuffix(value, pattern), nil
+	case "sub":
+		return strings.Contains(value, pattern), nil
+	case "dir":
+		return delimitedContains(value, pattern, "/?"), nil
+	case "dom":
+		return delimitedContains(value, pattern, "/?.:"), nil
+	case "reg":
+		if ci {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, fmt.Errorf("regular expression '%s' can not be evaluated: %s", pattern, err.Error())
+		}
+		return re.MatchString(value), nil
+	case "int", "len":
+		n, err := strconv.ParseInt(value, 10, 64)
+		if method == "len" {
+			n, err = int64(len(value)), nil
+		}
+		if err != nil {
+			return false, nil
+		}
+		return intPatternMatch(n, op, pattern)
+	case "ip":
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return false, nil
+		}
+		if _, network, err := net.ParseCIDR(pattern); err == nil {
+			return network.Contains(ip), nil
+		}
+		if p := net.ParseIP(pattern); p != nil {
+			return p.Equal(ip), nil
+		}
+		return false, fmt.Errorf("IP pattern '%s' can not be evaluated without resolving it", pattern)
+	}
+	return false, fmt.Errorf("matching method '%s' can not be evaluated without HAProxy", method)
+}
+
+func intPatternMatch(n int64, op, pattern string) (bool, error) {
+	low, high, err := parseIntRange(pattern)
+	if err != nil {
+		return false, err
+	}
+	switch op {
+	case "ge":
+		return n >= low, nil
+	case "gt":
+		return n > low, nil
+	case "le":
+		return n <= low, nil
+	case "lt":
+		return n < low, nil
+	}
+	return n >= low && n <= high, nil
+}
+
+// parseIntRange parses an integer pattern, a single value or a low:high range
+// with optional bounds
+func parseIntRange(p string) (int64, int64, error) {
+	low, high := int64(-1<<63), int64(1<<63-1)
+	bounds := strings.SplitN(p, ":", 2)
+	var err error
+	if bounds[0] != "" {
+		if low, err = strconv.ParseInt(bounds[0], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid integer pattern '%s'", p)
+		}
+	}
+	if len(bounds) == 1 {
+		return low, low, nil
+	}
+	if bounds[1] != "" {
+		if high, err = strconv.ParseInt(bounds[1], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid integer pattern '%s'", p)
+		}
+	}
+	if low > high {
+		return 0, 0, fmt.Errorf("invalid integer range '%s'", p)
+	}
+	return low, high, nil
+}
+
+// delimitedContains reports whether pattern is found in value delimited by one
+// of the delimiters characters or the ends of value
+func delimitedContains(value, pattern, delimiters string) bool {
+	if pattern == "" {
+		return false
+	}
+	for i := strings.Index(value, pattern); i >= 0; {
+		end := i + len(pattern)
+		if (i == 0 || strings.ContainsRune(delimiters, rune(value[i-1]))) && (end == len(value) || strings.ContainsRune(delimiters, rune(value[end]))) {
+			return true
+		}
+		next := strings.Index(value[i+1:], pattern)
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return false
+}
+
+func isIPPattern(p string) bool {
+	if _, _, err := net.ParseCIDR(p); err == nil {
+		return true
+	}
+	return net.ParseIP(p) != nil
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.ParseInt(s, 10, 64)
+	return err == nil
+}
+
+func inSlice(s string, list []string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+// splitTerm returns the keyword and arguments of a fetch or converter term
+func splitTerm(term string) (string, []string) {
+	p := strings.Index(term, "(")
+	if p < 0 {
+		return strings.TrimSpace(term), nil
+	}
+	args := strings.TrimSuffix(term[p+1:], ")")
+	if args == "" {
+		return strings.TrimSpace(term[:p]), nil
+	}
+	return strings.TrimSpace(term[:p]), strings.Split(args, ",")
+}
+
+// splitACLWords splits an ACL expression into words on spaces, honouring quotes
+// and backslash escapes
+func splitACLWords(expr string) ([]string, error) {
+	words := make([]string, 0)
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, c := range expr {
+		switch {
+		case escaped:
+			word.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				word.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			inWord = true
+		case c == ' ' || c == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in ACL expression '%s'", expr)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+func aclFilePath(f, baseDir string) string {
+	if filepath.IsAbs(f) || baseDir == "" {
+		return f
+	}
+	return filepath.Join(baseDir, f)
+}
+
+// readACLFile returns the patterns of an ACL pattern file, skipping empty lines and comments
+func readACLFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	patterns := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns, scanner.Err()
+}
@@ -0,0 +1,287 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+// Circuit breaker statuses
+const (
+	BreakerActive   = "active"
+	BreakerRestored = "restored"
+	BreakerFailed   = "failed"
+)
+
+var (
+	// ErrBreakerNoRuntime is returned when the runtime API is not configured
+	ErrBreakerNoRuntime = errors.New("runtime API not configured")
+	// ErrBreakerNoFrontend is returned when the frontend does not exist
+	ErrBreakerNoFrontend = errors.New("frontend not found")
+)
+
+// BreakerRuntime is the part of the runtime API client used by the circuit
+// breakers
+type BreakerRuntime interface {
+	RuntimeExecutor
+	GetStats() models.NativeStats
+	GetInfo() (models.ProcessInfos, error)
+}
+
+// FrontendBreaker limits the connections of a frontend during an overload,
+// its previous limits being restored once Duration elapsed
+type FrontendBreaker struct {
+	Frontend string
+	// MaxConn is the maximum number of connections of the frontend
+	MaxConn *int64
+	// SessionRateLimit is the global limit of new sessions per second
+	SessionRateLimit *int64
+	// RejectConnections disables the frontend, which stops listening
+	RejectConnections bool
+	Duration          time.Duration
+	Status            string
+	Error             string
+	Started           time.Time
+	Finished          time.Time
+
+	PreviousMaxConn          int64
+	PreviousSessionRateLimit int64
+
+	cancel chan struct{}
+}
+
+// Expires returns when the previous limits of the frontend are restored
+func (b FrontendBreaker) Expires() time.Time {
+	return b.Started.Add(b.Duration)
+}
+
+// CircuitBreakers trip and restore the circuit breakers of the frontends
+type CircuitBreakers struct {
+	// Runtime returns the runtime API client, nil when not configured
+	Runtime func() BreakerRuntime
+
+	mu       sync.Mutex
+	breakers map[string]*FrontendBreaker
+}
+
+// Trip applies the limits of breaker to its frontend for its duration,
+// restoring first the limits of a breaker active on the frontend
+func (c *CircuitBreakers) Trip(breaker FrontendBreaker) (FrontendBreaker, error) {
+	var rt BreakerRuntime
+	if c.Runtime != nil {
+		rt = c.Runtime()
+	}
+	if rt == nil {
+		return FrontendBreaker{}, ErrBreakerNoRuntime
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.breakers == nil {
+		c.breakers = make(map[string]*FrontendBreaker)
+	}
+	if old, ok := c.breakers[breaker.Frontend]; ok && old.Status == BreakerActive {
+		if err := c.restore(old, rt); err != nil {
+			return FrontendBreaker{}, err
+		}
+	}
+
+	maxconn, err := frontendMaxConn(rt.GetStats(), breaker.Frontend)
+	if err != nil {
+		return FrontendBreaker{}, err
+	}
+	rateLimit, err := sessionRateLimit(rt)
+	if err != nil {
+		return FrontendBreaker{}, err
+	}
+	b := &breaker
+	b.PreviousMaxConn = maxconn
+	b.PreviousSessionRateLimit = rateLimit
+	b.Status = BreakerActive
+	b.Error = ""
+	b.Started = time.Now()
+	b.Finished = time.Time{}
+	b.cancel = make(chan struct{})
+	c.breakers[b.Frontend] = b
+
+	commands := make([]string, 0, 3)
+	if b.MaxConn != nil {
+		commands = append(commands, fmt.Sprintf("set maxconn frontend %s %d", b.Frontend, *b.MaxConn))
+	}
+	if b.SessionRateLimit != nil {
+		commands = append(commands, fmt.Sprintf("set rate-limit sessions global %d", *b.SessionRateLimit))
+	}
+	if b.RejectConnections {
+		commands = append(commands, "disable frontend "+b.Frontend)
+	}
+	for _, command := range commands {
+		if err := executeSilent(rt, command); err != nil {
+			// the limits already set are restored
+			// nolint:errcheck
+			c.restore(b, rt)
+			b.Status = BreakerFailed
+			b.Error = err.Error()
+			return *b, err
+		}
+	}
+	log.Warningf("Circuit breaker of frontend %s tripped for %s", b.Frontend, b.Duration)
+
+	go c.expire(b, rt)
+	return *b, nil
+}
+
+// Get returns the active or last circuit breaker of the frontend, with an
+// empty Status if it never tripped
+func (c *CircuitBreakers) Get(frontend string) FrontendBreaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if b, ok := c.breakers[frontend]; ok {
+		return *b
+	}
+	return FrontendBreaker{}
+}
+
+// Reset restores the previous limits of the frontend and returns false if no
+// circuit breaker is active on it
+func (c *CircuitBreakers) Reset(frontend string) (bool, error) {
+	var rt BreakerRuntime
+	if c.Runtime != nil {
+		rt = c.Runtime()
+	}
+	if rt == nil {
+		return false, ErrBreakerNoRuntime
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.breakers[frontend]
+	if !ok || b.Status != BreakerActive {
+		return false, nil
+	}
+	return true, c.restore(b, rt)
+}
+
+// restore sets the previous limits of the frontend of an active breaker, c.mu
+// must be held
+func (c *CircuitBreakers) restore(b *FrontendBreaker, rt BreakerRuntime) error {
+	commands := make([]string, 0, 3)
+	if b.RejectConnections {
+		commands = append(commands, "enable frontend "+b.Frontend)
+	}
+	if b.SessionRateLimit != nil {
+		commands = append(commands, fmt.Sprintf("set rate-limit sessions global %d", b.PreviousSessionRateLimit))
+	}
+	if b.MaxConn != nil {
+		commands = append(commands, fmt.Sprintf("set maxconn frontend %s %d", b.Frontend, b.PreviousMaxConn))
+	}
+	errs := make([]string, 0)
+	for _, command := range commands {
+		if err := executeSilent(rt, command); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	b.Finished = time.Now()
+	if b.cancel != nil {
+		close(b.cancel)
+		b.cancel = nil
+	}
+	if len(errs) > 0 {
+		b.Status = BreakerFailed
+		b.Error = strings.Join(errs, ", ")
+		return fmt.Errorf("restoring the limits of frontend %s: %s", b.Frontend, b.Error)
+	}
+	b.Status = BreakerRestored
+	return nil
+}
+
+func (c *CircuitBreakers) expire(b *FrontendBreaker, rt BreakerRuntime) {
+	c.mu.Lock()
+	cancel := b.cancel
+	c.mu.Unlock()
+	timer := time.NewTimer(b.Duration)
+	defer timer.Stop()
+	select {
+	case <-cancel:
+		return
+	case <-timer.C:
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if b.Status != BreakerActive {
+		return
+	}
+	if err := c.restore(b, rt); err != nil {
+		log.Warningf("Circuit breaker of frontend %s: %s", b.Frontend, err.Error())
+		return
+	}
+	log.Infof("Circuit breaker of frontend %s expired, limits restored", b.Frontend)
+}
+
+// frontendMaxConn returns the maximum number of connections of a frontend
+func frontendMaxConn(stats models.NativeStats, frontend string) (int64, error) {
+	for _, c := range stats {
+		if c == nil {
+			continue
+		}
+		if c.Error != "" {
+			return 0, fmt.Errorf("%s: %s", c.RuntimeAPI, c.Error)
+		}
+		for _, s := range c.Stats {
+			if s.Type == models.NativeStatTypeFrontend && s.Name == frontend && s.Stats != nil && s.Stats.Slim != nil {
+				return *s.Stats.Slim, nil
+			}
+		}
+	}
+	return 0, ErrBreakerNoFrontend
+}
+
+// sessionRateLimit returns the global limit of new sessions per second
+func sessionRateLimit(rt BreakerRuntime) (int64, error) {
+	infos, err := rt.GetInfo()
+	if err != nil {
+		return 0, err
+	}
+	for _, i := range infos {
+		if i.Error != "" {
+			return 0, fmt.Errorf("%s: %s", i.RuntimeAPI, i.Error)
+		}
+		if i.Info != nil && i.Info.SessRateLimit != nil {
+			return *i.Info.SessRateLimit, nil
+		}
+	}
+	return 0, nil
+}
+
+// executeSilent executes a runtime API command which only answers on errors
+func executeSilent(rt RuntimeExecutor, command string) error {
+	out, err := rt.ExecuteRaw(command)
+	if err != nil {
+		return err
+	}
+	for _, o := range out {
+		if o = strings.TrimSpace(o); o != "" {
+			return fmt.Errorf("%s: %s", command, o)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// keywordVersions is when a configuration keyword was introduced and removed,
+// empty when it is available in all supported versions or not removed
+type keywordVersions struct {
+	since   string
+	removed string
+}
+
+// configKeywords are the sections, directives, options and rule actions whose
+// availability changed between the supported versions. Options are listed as
+// option <name>, rule actions as <rule> <action> with the tcp-request and
+// tcp-response rule types, http-request return for instance.
+var configKeywords = map[string]keywordVersions{
+	// sections
+	"program":     {since: "2.0"},
+	"fcgi-app":    {since: "2.1"},
+	"http-errors": {since: "2.2"},
+	"ring":        {since: "2.2"},
+	"log-forward": {since: "2.3"},
+	// global directives
+	"nbproc":                         {removed: "2.5"},
+	"profiling.tasks":                {since: "2.0"},
+	"set-dumpable":                   {since: "2.1"},
+	"strict-limits":                  {since: "2.1"},
+	"insecure-fork-wanted":           {since: "2.2"},
+	"insecure-setuid-wanted":         {since: "2.2"},
+	"ssl-load-extra-files":           {since: "2.2"},
+	"ssl-skip-self-issued-ca":        {since: "2.2"},
+	"numa-cpu-mapping":               {since: "2.4"},
+	"default-path":                   {since: "2.4"},
+	"lua-load-per-thread":            {since: "2.4"},
+	"expose-experimental-directives": {since: "2.5"},
+	"httpclient.resolvers.id":        {since: "2.6"},
+	"httpclient.resolvers.prefer":    {since: "2.6"},
+	"httpclient.ssl.ca-file":         {since: "2.6"},
+	"httpclient.ssl.verify":          {since: "2.6"},
+	"thread-groups":                  {since: "2.7"},
+	"httpclient.resolvers.disabled":  {since: "2.7"},
+	"httpclient.retries":             {since: "2.7"},
+	"httpclient.timeout.connect":     {since: "2.7"},
+	"tune.ssl.ocsp-update.mindelay":  {since: "2.8"},
+	"tune.ssl.ocsp-update.maxdelay":  {since: "2.8"},
+	// proxy directives
+	"bind-process":        {removed: "2.5"},
+	"monitor-net":         {removed: "2.5"},
+	"block":               {removed: "2.1"},
+	"reqadd":              {removed: "2.1"},
+	"reqallow":            {removed: "2.1"},
+	"reqdel":              {removed: "2.1"},
+	"reqdeny":             {removed: "2.1"},
+	"reqiallow":           {removed: "2.1"},
+	"reqidel":             {removed: "2.1"},
+	"reqideny":            {removed: "2.1"},
+	"reqipass":            {removed: "2.1"},
+	"reqirep":             {removed: "2.1"},
+	"reqitarpit":          {removed: "2.1"},
+	"reqpass":             {removed: "2.1"},
+	"reqrep":              {removed: "2.1"},
+	"reqtarpit":           {removed: "2.1"},
+	"rspadd":              {removed: "2.1"},
+	"rspdel":              {removed: "2.1"},
+	"rspdeny":             {removed: "2.1"},
+	"rspidel":             {removed: "2.1"},
+	"rspideny":            {removed: "2.1"},
+	"rspirep":             {removed: "2.1"},
+	"rsprep":              {removed: "2.1"},
+	"retry-on":            {since: "2.0"},
+	"use-fcgi-app":        {since: "2.1"},
+	"http-after-response": {since: "2.2"},
+	"http-error":          {since: "2.2"},
+	"errorfiles":          {since: "2.2"},
+	"http-check send":     {since: "2.2"},
+	"http-check connect":  {since: "2.2"},
+	// options
+	"option http-tunnel":                 {removed: "2.1"},
+	"option http-use-htx":                {removed: "2.1"},
+	"option http-restrict-req-hdr-names": {since: "2.6"},
+	"option idle-close-on-response":      {since: "2.6"},
+	// filters
+	"filter fcgi-app":  {since: "2.1"},
+	"filter bwlim-in":  {since: "2.7"},
+	"filter bwlim-out": {since: "2.7"},
+	// rule actions
+	"http-request do-resolve":                  {since: "2.0"},
+	"http-request disable-l7-retry":            {since: "2.0"},
+	"http-request replace-path":                {since: "2.1"},
+	"http-request return":                      {since: "2.2"},
+	"http-request replace-pathq":               {since: "2.2"},
+	"http-request set-pathq":                   {since: "2.2"},
+	"http-request strict-mode":                 {since: "2.2"},
+	"http-request normalize-uri":               {since: "2.4"},
+	"http-request wait-for-body":               {since: "2.4"},
+	"http-request set-timeout":                 {since: "2.4"},
+	"http-request set-var-fmt":                 {since: "2.5"},
+	"http-request set-bandwidth-limit":         {since: "2.7"},
+	"http-response return":                     {since: "2.2"},
+	"http-response strict-mode":                {since: "2.2"},
+	"http-response wait-for-body":              {since: "2.4"},
+	"http-response set-var-fmt":                {since: "2.5"},
+	"http-response set-bandwidth-limit":        {since: "2.7"},
+	"tcp-request content do-resolve":           {since: "2.0"},
+	"tcp-request content set-var-fmt":          {since: "2.5"},
+	"tcp-request content set-bandwidth-limit":  {since: "2.7"},
+	"tcp-response content set-bandwidth-limit": {since: "2.7"},
+}
+
+// CompatibilityIssue is a configuration line breaking on a HAProxy version
+type CompatibilityIssue struct {
+	Line    int
+	Section string
+	Keyword string
+	Since   string
+	Removed string
+	Message string
+}
+
+// CheckCompatibility scans the configuration config and returns the lines using
+// keywords not available in the major.minor HAProxy version
+func CheckCompatibility(config, version string) ([]CompatibilityIssue, error) {
+	if !SupportedVersion(version) {
+		return nil, fmt.Errorf("unknown HAProxy version %s, versions from %s to %s are known", version, MinVersion, MaxVersion)
+	}
+	issues := make([]CompatibilityIssue, 0)
+	section := ""
+	for i, line := range strings.Split(config, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if IsSectionKeyword(fields[0]) {
+			section = strings.Join(fields, " ")
+		}
+		keyword, v, ok := configKeyword(fields)
+		if !ok {
+			continue
+		}
+		issue := CompatibilityIssue{Line: i + 1, Section: section, Keyword: keyword, Since: v.since, Removed: v.removed}
+		switch {
+		case v.since != "" && compareVersions(version, v.since) < 0:
+			issue.Message = fmt.Sprintf("%s requires HAProxy %s", keyword, v.since)
+		case v.removed != "" && compareVersions(version, v.removed) >= 0:
+			issue.Message = fmt.Sprintf("%s was removed in HAProxy %s", keyword, v.removed)
+		default:
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+// configKeyword returns the keyword of the matrix the configuration line fields
+// match, the longest one first. Arguments of actions, as in set-var(txn.foo),
+// are not part of the keyword.
+func configKeyword(fields []string) (string, keywordVersions, bool) {
+	if fields[0] == "no" && len(fields) > 1 {
+		fields = fields[1:]
+	}
+	words := make([]string, 0, 3)
+	for i := 0; i < len(fields) && i < 3; i++ {
+		words = append(words, strings.SplitN(fields[i], "(", 2)[0])
+	}
+	for n := len(words); n > 0; n-- {
+		keyword := strings.Join(words[:n], " ")
+		if v, ok := configKeywords[keyword]; ok {
+			return keyword, v, true
+		}
+	}
+	return "", keywordVersions{}, false
+}
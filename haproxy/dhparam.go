@@ -0,0 +1,208 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/haproxytech/dataplaneapi/system"
+)
+
+// MinDHParamBits is the size under which DH parameters are weak
+const MinDHParamBits = 2048
+
+// dhParams is the PKCS #3 DHParameter structure
+type dhParams struct {
+	P *big.Int
+	G *big.Int
+	// PrivateValueLength is optional
+	Rest asn1.RawContent `asn1:"optional"`
+}
+
+// ParseDHParams returns the size of the prime of the DH parameters of the
+// PEM data, which can also hold certificates and keys like HAProxy allows
+func ParseDHParams(data []byte) (int, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return 0, fmt.Errorf("no DH PARAMETERS block found")
+		}
+		if block.Type != "DH PARAMETERS" {
+			continue
+		}
+		var params dhParams
+		if _, err := asn1.Unmarshal(block.Bytes, &params); err != nil {
+			return 0, fmt.Errorf("invalid DH parameters: %s", err.Error())
+		}
+		if params.P == nil || params.P.Sign() <= 0 || params.G == nil || params.G.Sign() <= 0 {
+			return 0, fmt.Errorf("invalid DH parameters")
+		}
+		return params.P.BitLen(), nil
+	}
+}
+
+// DHParamsFile returns the size of the prime of the DH parameters of the file path
+func DHParamsFile(path string) (int, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return ParseDHParams(data)
+}
+
+// DHParamsWarning returns the warning about DH parameters of bits, empty
+// when they are not weak
+func DHParamsWarning(bits int) string {
+	if bits >= MinDHParamBits {
+		return ""
+	}
+	return fmt.Sprintf("DH parameters of %d bits are weak, use at least %d bits", bits, MinDHParamBits)
+}
+
+// smallPrimes are the odd primes under 10000, sieving the candidates before the
+// primality tests
+var smallPrimes = func() []uint64 {
+	composite := make([]bool, 10000)
+	primes := make([]uint64, 0, 1228)
+	for i := 3; i < len(composite); i += 2 {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, uint64(i))
+		for j := i * i; j < len(composite); j += 2 * i {
+			composite[j] = true
+		}
+	}
+	return primes
+}()
+
+// GenerateDHParams returns PEM encoded DH parameters with a safe prime of bits
+// and the generator 2, the prime being congruent to 23 modulo 24 as OpenSSL
+// requires for this generator
+func GenerateDHParams(bits int) ([]byte, error) {
+	if bits < 64 {
+		return nil, fmt.Errorf("DH parameters of %d bits are too small", bits)
+	}
+	p := new(big.Int)
+	r := new(big.Int)
+	twelve := big.NewInt(12)
+	for {
+		// q is the candidate of the Sophie Germain prime, p = 2q + 1
+		q, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), uint(bits-1)))
+		if err != nil {
+			return nil, err
+		}
+		q.SetBit(q, bits-2, 1)
+		q.SetBit(q, bits-3, 1)
+		// q ≡ 11 (mod 12) gives p ≡ 23 (mod 24)
+		q.Sub(q, r.Mod(q, twelve)).Add(q, big.NewInt(11))
+		if q.BitLen() != bits-1 {
+			continue
+		}
+		if !sieve(q) {
+			continue
+		}
+		p.Lsh(q, 1).Add(p, big.NewInt(1))
+		if !q.ProbablyPrime(1) || !p.ProbablyPrime(20) || !q.ProbablyPrime(20) {
+			continue
+		}
+		der, err := asn1.Marshal(dhParams{P: p, G: big.NewInt(2)})
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "DH PARAMETERS", Bytes: der}), nil
+	}
+}
+
+// sieve reports whether neither q nor 2q + 1 has a small prime factor
+func sieve(q *big.Int) bool {
+	m := new(big.Int)
+	d := new(big.Int)
+	for _, sp := range smallPrimes {
+		r := m.Mod(q, d.SetUint64(sp)).Uint64()
+		if r == 0 || (2*r+1)%sp == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// DHParamGenerator generates DH parameter files in the background
+type DHParamGenerator struct {
+	mu      sync.Mutex
+	pending map[string]int
+}
+
+// Generating returns the sizes of the files being generated by their path
+func (g *DHParamGenerator) Generating() map[string]int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	pending := make(map[string]int, len(g.pending))
+	for path, bits := range g.pending {
+		pending[path] = bits
+	}
+	return pending
+}
+
+// Generate starts generating DH parameters of bits in the file name of the
+// storage area dir, it fails when the file is already being generated
+func (g *DHParamGenerator) Generate(dir, name string, bits int) error {
+	path := filepath.Join(dir, name)
+	g.mu.Lock()
+	if g.pending == nil {
+		g.pending = make(map[string]int)
+	}
+	if _, ok := g.pending[path]; ok {
+		g.mu.Unlock()
+		return fmt.Errorf("DH parameters %s are already being generated", name)
+	}
+	g.pending[path] = bits
+	g.mu.Unlock()
+
+	go func() {
+		defer func() {
+			g.mu.Lock()
+			delete(g.pending, path)
+			g.mu.Unlock()
+		}()
+		data, err := GenerateDHParams(bits)
+		// the storage directory is locked again once the request returned
+		if err == nil {
+			err = system.ConfigWindow.Open()
+		}
+		if err == nil {
+			_, err = StoreFile(dir, name, bytes.NewReader(data))
+			system.ConfigWindow.Close()
+		}
+		if err != nil {
+			log.Warningf("generating DH parameters %s: %s", path, err.Error())
+			return
+		}
+		log.Infof("DH parameters %s of %d bits generated", path, bits)
+	}()
+	return nil
+}
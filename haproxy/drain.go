@@ -0,0 +1,272 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// drainPollInterval is the delay between two checks of the sessions of drained servers
+	drainPollInterval = time.Second
+	// drainRetention is the number of host drains kept for reporting
+	drainRetention = 100
+)
+
+// Host drain statuses
+const (
+	DrainInProgress = "in_progress"
+	DrainDrained    = "drained"
+	DrainTimeout    = "timeout"
+	DrainFailed     = "failed"
+)
+
+var (
+	// ErrDrainNoRuntime is returned when the runtime API is not configured
+	ErrDrainNoRuntime = errors.New("runtime API not configured")
+	// ErrDrainNoServers is returned when no server uses the drained address
+	ErrDrainNoServers = errors.New("no server with the given address")
+)
+
+// DrainRuntime is the part of the runtime API client used to drain servers
+type DrainRuntime interface {
+	GetStats() models.NativeStats
+	SetServerState(backend, server string, state string) error
+}
+
+// DrainServer is a server of a drained host
+type DrainServer struct {
+	Backend  string
+	Name     string
+	Sessions int64
+}
+
+// HostDrain is the progress of the drain of all servers of a host
+type HostDrain struct {
+	ID          string
+	Address     string
+	Timeout     time.Duration
+	Maintenance bool
+	Status      string
+	Error       string
+	Sessions    int64
+	Started     time.Time
+	Finished    time.Time
+	Servers     []DrainServer
+}
+
+// HostDrainer drains all servers with an address across all backends and follows
+// their sessions until none are left
+type HostDrainer struct {
+	// Runtime returns the runtime API client, nil when not configured
+	Runtime func() DrainRuntime
+
+	mu     sync.RWMutex
+	drains []*HostDrain
+	index  int64
+}
+
+// Start sets the servers with the address to drain and follows them in the background
+func (d *HostDrainer) Start(address string, timeout time.Duration, maintenance bool) (HostDrain, error) {
+	var rt DrainRuntime
+	if d.Runtime != nil {
+		rt = d.Runtime()
+	}
+	if rt == nil {
+		return HostDrain{}, ErrDrainNoRuntime
+	}
+	servers := drainServers(rt.GetStats(), address)
+	if len(servers) == 0 {
+		return HostDrain{}, ErrDrainNoServers
+	}
+	for _, s := range servers {
+		if err := rt.SetServerState(s.Backend, s.Name, models.RuntimeServerAdminStateDrain); err != nil {
+			return HostDrain{}, fmt.Errorf("setting server %s/%s to drain: %s", s.Backend, s.Name, err.Error())
+		}
+	}
+
+	sessions := int64(0)
+	for _, s := range servers {
+		sessions += s.Sessions
+	}
+
+	d.mu.Lock()
+	drain := &HostDrain{
+		ID:          fmt.Sprintf("%s-%v", time.Now().Format("2006-01-02"), d.index),
+		Address:     address,
+		Timeout:     timeout,
+		Maintenance: maintenance,
+		Status:      DrainInProgress,
+		Sessions:    sessions,
+		Started:     time.Now(),
+		Servers:     servers,
+	}
+	d.index++
+	d.drains = append(d.drains, drain)
+	if len(d.drains) > drainRetention {
+		d.drains = d.drains[len(d.drains)-drainRetention:]
+	}
+	d.mu.Unlock()
+
+	log.Infof("Draining %d servers of host %s", len(servers), address)
+	go d.follow(drain, rt)
+	return d.Get(drain.ID), nil
+}
+
+// Get returns the drain with the given id, with an empty ID if it does not exist
+func (d *HostDrainer) Get(id string) HostDrain {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	for _, drain := range d.drains {
+		if drain.ID == id {
+			return copyDrain(drain)
+		}
+	}
+	return HostDrain{}
+}
+
+// List returns all kept drains, oldest first
+func (d *HostDrainer) List() []HostDrain {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	drains := make([]HostDrain, 0, len(d.drains))
+	for _, drain := range d.drains {
+		drains = append(drains, copyDrain(drain))
+	}
+	return drains
+}
+
+func copyDrain(drain *HostDrain) HostDrain {
+	c := *drain
+	c.Servers = append([]DrainServer{}, drain.Servers...)
+	return c
+}
+
+func (d *HostDrainer) follow(drain *HostDrain, rt DrainRuntime) {
+	deadline := drain.Started.Add(drain.Timeout)
+	for {
+		sessions := drainSessions(rt.GetStats())
+		total := int64(0)
+
+		d.mu.Lock()
+		for i, s := range drain.Servers {
+			drain.Servers[i].Sessions = sessions[s.Backend+"/"+s.Name]
+			total += drain.Servers[i].Sessions
+		}
+		drain.Sessions = total
+		d.mu.Unlock()
+
+		if total == 0 {
+			status := DrainDrained
+			errMsg := ""
+			if drain.Maintenance {
+				for _, s := range drain.Servers {
+					if err := rt.SetServerState(s.Backend, s.Name, models.RuntimeServerAdminStateMaint); err != nil {
+						status = DrainFailed
+						errMsg = fmt.Sprintf("setting server %s/%s to maintenance: %s", s.Backend, s.Name, err.Error())
+						break
+					}
+				}
+			}
+			d.finish(drain, status, errMsg)
+			return
+		}
+		if time.Now().After(deadline) {
+			d.finish(drain, DrainTimeout, fmt.Sprintf("%d sessions left after %s", total, drain.Timeout))
+			return
+		}
+		time.Sleep(drainPollInterval)
+	}
+}
+
+func (d *HostDrainer) finish(drain *HostDrain, status, errMsg string) {
+	d.mu.Lock()
+	drain.Status = status
+	drain.Error = errMsg
+	drain.Finished = time.Now()
+	d.mu.Unlock()
+	if errMsg != "" {
+		log.Warningf("Drain %s of host %s: %s", drain.ID, drain.Address, errMsg)
+		return
+	}
+	log.Infof("Drain %s of host %s finished: %s", drain.ID, drain.Address, status)
+}
+
+// drainServers returns the servers whose address matches, with their current
+// sessions summed over all processes. The address matches with or without the port.
+func drainServers(stats models.NativeStats, address string) []DrainServer {
+	sessions := drainSessions(stats)
+	servers := make([]DrainServer, 0)
+	seen := make(map[string]bool)
+	for _, c := range stats {
+		if c == nil {
+			continue
+		}
+		for _, s := range c.Stats {
+			if s.Type != models.NativeStatTypeServer || s.Stats == nil || seen[s.BackendName+"/"+s.Name] {
+				continue
+			}
+			if !matchServerAddress(s.Stats.Addr, address) {
+				continue
+			}
+			seen[s.BackendName+"/"+s.Name] = true
+			servers = append(servers, DrainServer{
+				Backend:  s.BackendName,
+				Name:     s.Name,
+				Sessions: sessions[s.BackendName+"/"+s.Name],
+			})
+		}
+	}
+	return servers
+}
+
+// drainSessions returns the current sessions of all servers, by backend/server
+func drainSessions(stats models.NativeStats) map[string]int64 {
+	sessions := make(map[string]int64)
+	for _, c := range stats {
+		if c == nil {
+			continue
+		}
+		for _, s := range c.Stats {
+			if s.Type != models.NativeStatTypeServer || s.Stats == nil || s.Stats.Scur == nil {
+				continue
+			}
+			sessions[s.BackendName+"/"+s.Name] += *s.Stats.Scur
+		}
+	}
+	return sessions
+}
+
+func matchServerAddress(addr, address string) bool {
+	if addr == "" {
+		return false
+	}
+	if addr == address {
+		return true
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	return host == address
+}
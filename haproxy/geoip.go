@@ -0,0 +1,274 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/haproxytech/dataplaneapi/system"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	geoIPDownloadTimeout = 5 * time.Minute
+	// geoIPBatchSize is the maximum size of a runtime API command line sent when
+	// loading the map, below the default HAProxy buffer size
+	geoIPBatchSize = 8000
+)
+
+var (
+	geoIPValueRegexp   = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	geoIPVersionRegexp = regexp.MustCompile(`New version created: (\d+)`)
+)
+
+// GeoIPStatus is the state of the GeoIP map updates
+type GeoIPStatus struct {
+	URL            string
+	MapFile        string
+	Entries        int64
+	LastUpdate     time.Time
+	NextUpdate     time.Time
+	LastError      string
+	RuntimeUpdated bool
+}
+
+// GeoIPUpdater periodically downloads a GeoIP to country map in HAProxy map format,
+// writes it to the map file and swaps it in HAProxy with a map version commit
+type GeoIPUpdater struct {
+	URL     string
+	MapFile string
+	// Interval between two downloads
+	Interval time.Duration
+	// Runtime returns the runtime API client, nil when not configured
+	Runtime func() RuntimeExecutor
+	// WorkerPrefix is prepended to the commands sent in a batch, when the runtime
+	// API is reached through the master socket
+	WorkerPrefix string
+
+	mu       sync.Mutex
+	statusMu sync.RWMutex
+	status   GeoIPStatus
+}
+
+// Start downloads the map periodically, immediately when the map file does not exist yet
+func (g *GeoIPUpdater) Start() {
+	if g.URL == "" || g.Interval <= 0 {
+		return
+	}
+	if _, err := os.Stat(g.MapFile); err != nil {
+		g.update()
+	}
+	ticker := time.NewTicker(g.Interval)
+	g.setNextUpdate(time.Now().Add(g.Interval))
+	for range ticker.C {
+		g.update()
+		g.setNextUpdate(time.Now().Add(g.Interval))
+	}
+}
+
+func (g *GeoIPUpdater) update() {
+	if err := g.Update(); err != nil {
+		log.Warning("GeoIP map update: ", err.Error())
+	}
+}
+
+// Status returns the state of the map updates
+func (g *GeoIPUpdater) Status() GeoIPStatus {
+	g.statusMu.RLock()
+	defer g.statusMu.RUnlock()
+	s := g.status
+	s.URL = g.URL
+	s.MapFile = g.MapFile
+	return s
+}
+
+func (g *GeoIPUpdater) setNextUpdate(t time.Time) {
+	g.statusMu.Lock()
+	g.status.NextUpdate = t
+	g.statusMu.Unlock()
+}
+
+func (g *GeoIPUpdater) setError(err error) error {
+	g.statusMu.Lock()
+	g.status.LastError = err.Error()
+	g.statusMu.Unlock()
+	return err
+}
+
+// Update downloads the map, replaces the map file and swaps the map in HAProxy.
+// The map file is not changed when the download fails or the map is not valid.
+func (g *GeoIPUpdater) Update() error {
+	if g.URL == "" {
+		return fmt.Errorf("GeoIP map URL not configured")
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	entries, err := downloadGeoIPMap(g.URL)
+	if err != nil {
+		return g.setError(err)
+	}
+	var b strings.Builder
+	for _, e := range entries {
+		b.WriteString(e[0] + " " + e[1] + "\n")
+	}
+	if err := system.MkdirAll(filepath.Dir(g.MapFile), 0755); err != nil {
+		return g.setError(err)
+	}
+	if err := system.WriteFile(g.MapFile, []byte(b.String()), 0644); err != nil {
+		return g.setError(err)
+	}
+
+	runtimeUpdated := false
+	if g.Runtime != nil {
+		if rt := g.Runtime(); rt != nil {
+			runtimeUpdated, err = g.swapRuntimeMap(rt, entries)
+			if err != nil {
+				// the map file is up to date and is loaded on the next reload
+				log.Warning("GeoIP map runtime update: ", err.Error())
+			}
+		}
+	}
+
+	g.statusMu.Lock()
+	g.status.Entries = int64(len(entries))
+	g.status.LastUpdate = time.Now()
+	g.status.LastError = ""
+	if err != nil {
+		g.status.LastError = err.Error()
+	}
+	g.status.RuntimeUpdated = runtimeUpdated
+	g.statusMu.Unlock()
+	log.Infof("GeoIP map %s updated with %d entries", g.MapFile, len(entries))
+	return nil
+}
+
+// swapRuntimeMap loads the entries in a new version of the map and commits it,
+// it returns false when the map is not loaded by HAProxy
+func (g *GeoIPUpdater) swapRuntimeMap(rt RuntimeExecutor, entries [][2]string) (bool, error) {
+	out, err := rt.ExecuteRaw(fmt.Sprintf("prepare map %s", g.MapFile))
+	if err != nil {
+		return false, err
+	}
+	versions := make([]string, 0, len(out))
+	for _, o := range out {
+		m := geoIPVersionRegexp.FindStringSubmatch(o)
+		if m == nil {
+			// the map is not used in the configuration yet
+			return false, nil
+		}
+		versions = append(versions, m[1])
+	}
+	if len(versions) == 0 {
+		return false, nil
+	}
+	// all processes share the map version as they loaded the same map
+	version := versions[0]
+
+	commands := make([]string, 0)
+	size := 0
+	for _, e := range entries {
+		c := fmt.Sprintf("add map @%s %s %s %s", version, g.MapFile, e[0], e[1])
+		if size+len(c) > geoIPBatchSize && len(commands) > 0 {
+			if err := g.executeBatch(rt, commands); err != nil {
+				return false, err
+			}
+			commands = commands[:0]
+			size = 0
+		}
+		commands = append(commands, c)
+		size += len(c) + len(g.WorkerPrefix) + 1
+	}
+	if len(commands) > 0 {
+		if err := g.executeBatch(rt, commands); err != nil {
+			return false, err
+		}
+	}
+	if err := g.executeBatch(rt, []string{fmt.Sprintf("commit map @%s %s", version, g.MapFile)}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (g *GeoIPUpdater) executeBatch(rt RuntimeExecutor, commands []string) error {
+	return ExecuteRuntimeCommand(rt, strings.Join(commands, ";"+g.WorkerPrefix))
+}
+
+// downloadGeoIPMap downloads and validates a map of addresses or networks to
+// country codes, optionally gzip compressed
+func downloadGeoIPMap(url string) ([][2]string, error) {
+	client := &http.Client{Timeout: geoIPDownloadTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading %s: %s", url, resp.Status)
+	}
+	var r io.Reader = resp.Body
+	if strings.HasSuffix(url, ".gz") || resp.Header.Get("Content-Type") == "application/gzip" {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	return parseGeoIPMap(r)
+}
+
+func parseGeoIPMap(r io.Reader) ([][2]string, error) {
+	entries := make([][2]string, 0)
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		l := strings.TrimSpace(scanner.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		fields := strings.Fields(l)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid GeoIP map line %d: %s", line, l)
+		}
+		if _, _, err := net.ParseCIDR(fields[0]); err != nil && net.ParseIP(fields[0]) == nil {
+			return nil, fmt.Errorf("invalid GeoIP map address on line %d: %s", line, fields[0])
+		}
+		if !geoIPValueRegexp.MatchString(fields[1]) {
+			return nil, fmt.Errorf("invalid GeoIP map country on line %d: %s", line, fields[1])
+		}
+		entries = append(entries, [2]string{fields[0], fields[1]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("GeoIP map is empty")
+	}
+	return entries, nil
+}
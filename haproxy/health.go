@@ -0,0 +1,51 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// ConfigurationCheck checks a configuration file with the haproxy binary, the
+// result being kept until the file changes so the check can be polled
+type ConfigurationCheck struct {
+	Bin  string
+	File string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	checked time.Time
+	err     error
+}
+
+// Check returns when the current configuration file was checked and the check error
+func (c *ConfigurationCheck) Check() (time.Time, error) {
+	info, err := os.Stat(c.File)
+	if err != nil {
+		return time.Now(), err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.checked.IsZero() || !info.ModTime().Equal(c.modTime) || info.Size() != c.size {
+		c.modTime, c.size = info.ModTime(), info.Size()
+		c.err = CheckConfiguration(c.Bin, c.File)
+		c.checked = time.Now()
+	}
+	return c.checked, c.err
+}
@@ -0,0 +1,248 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var sectionKeywords = map[string]bool{
+	"global":      true,
+	"defaults":    true,
+	"frontend":    true,
+	"backend":     true,
+	"listen":      true,
+	"userlist":    true,
+	"peers":       true,
+	"resolvers":   true,
+	"mailers":     true,
+	"program":     true,
+	"http-errors": true,
+	"cache":       true,
+	"ring":        true,
+	"fcgi-app":    true,
+	"log-forward": true,
+}
+
+// IsSectionKeyword returns true if the keyword starts a configuration section
+func IsSectionKeyword(keyword string) bool {
+	return sectionKeywords[keyword]
+}
+
+// ConfigChange describes a change of one configuration section
+type ConfigChange struct {
+	Section     string
+	Name        string
+	Runtime     bool
+	Description string
+	// Servers changed at runtime, only set when Runtime is true
+	Servers []string
+	// key of the section in the configurations compared
+	key string
+}
+
+type configSection struct {
+	section string
+	name    string
+	lines   []string
+}
+
+// DiffConfigurations compares two raw configurations section by section and
+// reports which changes can be applied through the runtime API
+func DiffConfigurations(current, staged string) []ConfigChange {
+	cSections := splitSections(current)
+	sSections := splitSections(staged)
+
+	keys := make([]string, 0, len(cSections)+len(sSections))
+	for k := range cSections {
+		keys = append(keys, k)
+	}
+	for k := range sSections {
+		if _, ok := cSections[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := make([]ConfigChange, 0)
+	for _, k := range keys {
+		c, inCurrent := cSections[k]
+		s, inStaged := sSections[k]
+		switch {
+		case !inCurrent:
+			changes = append(changes, ConfigChange{Section: s.section, Name: s.name, Description: "section added", key: k})
+		case !inStaged:
+			changes = append(changes, ConfigChange{Section: c.section, Name: c.name, Description: "section removed", key: k})
+		default:
+			removed, added := diffLines(c.lines, s.lines)
+			if len(removed) == 0 && len(added) == 0 {
+				continue
+			}
+			change := sectionChange(c, removed, added)
+			change.key = k
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// ChangedSections returns the sections two raw configurations differ in, as
+// their type and name, sorted, the sections sharing a name such as unnamed
+// defaults sections being listed once
+func ChangedSections(current, staged string) []string {
+	sections := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, c := range DiffConfigurations(current, staged) {
+		section := strings.TrimSpace(c.Section + " " + c.Name)
+		if !seen[section] {
+			seen[section] = true
+			sections = append(sections, section)
+		}
+	}
+	sort.Strings(sections)
+	return sections
+}
+
+// splitSections returns the sections of a raw configuration by their type,
+// name and occurrence, several sections such as the defaults ones possibly
+// having the same name or none
+func splitSections(config string) map[string]*configSection {
+	sections := make(map[string]*configSection)
+	occurrences := make(map[string]int)
+	var current *configSection
+	for _, line := range strings.Split(config, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if sectionKeywords[fields[0]] {
+			current = &configSection{section: fields[0]}
+			if len(fields) > 1 {
+				current.name = fields[1]
+			}
+			id := current.section + " " + current.name
+			occurrences[id]++
+			sections[fmt.Sprintf("%s %d", id, occurrences[id])] = current
+			continue
+		}
+		if current != nil {
+			current.lines = append(current.lines, strings.Join(fields, " "))
+		}
+	}
+	return sections
+}
+
+// diffLines returns lines only present in a and lines only present in b
+func diffLines(a, b []string) ([]string, []string) {
+	count := make(map[string]int)
+	for _, l := range a {
+		count[l]++
+	}
+	for _, l := range b {
+		count[l]--
+	}
+	removed := make([]string, 0)
+	for _, l := range a {
+		if count[l] > 0 {
+			removed = append(removed, l)
+			count[l]--
+		}
+	}
+	added := make([]string, 0)
+	for _, l := range b {
+		if count[l] < 0 {
+			added = append(added, l)
+			count[l]++
+		}
+	}
+	return removed, added
+}
+
+func sectionChange(s *configSection, removed, added []string) ConfigChange {
+	change := ConfigChange{Section: s.section, Name: s.name, Description: "section changed"}
+	switch s.section {
+	case "frontend":
+		if onlyKeyword(removed, "maxconn") && onlyKeyword(added, "maxconn") && len(removed) == 1 && len(added) == 1 {
+			change.Runtime = true
+			change.Description = "maxconn changed"
+		}
+	case "backend", "listen":
+		servers, ok := runtimeServerChanges(removed, added)
+		if ok {
+			change.Runtime = true
+			change.Servers = servers
+			change.Description = fmt.Sprintf("servers changed: %s", strings.Join(servers, ", "))
+		}
+	}
+	return change
+}
+
+func onlyKeyword(lines []string, keyword string) bool {
+	for _, l := range lines {
+		if strings.Fields(l)[0] != keyword {
+			return false
+		}
+	}
+	return true
+}
+
+// runtimeServerChanges checks that only address, weight or state of existing
+// servers were changed, which can be set through the runtime API
+func runtimeServerChanges(removed, added []string) ([]string, bool) {
+	if !onlyKeyword(removed, "server") || !onlyKeyword(added, "server") || len(removed) != len(added) {
+		return nil, false
+	}
+	old := make(map[string]string)
+	for _, l := range removed {
+		f := strings.Fields(l)
+		if len(f) < 3 {
+			return nil, false
+		}
+		old[f[1]] = runtimeServerParams(f)
+	}
+	servers := make([]string, 0, len(added))
+	for _, l := range added {
+		f := strings.Fields(l)
+		if len(f) < 3 {
+			return nil, false
+		}
+		p, ok := old[f[1]]
+		if !ok || p != runtimeServerParams(f) {
+			return nil, false
+		}
+		servers = append(servers, f[1])
+	}
+	sort.Strings(servers)
+	return servers, true
+}
+
+// runtimeServerParams returns server params without the ones settable at runtime
+func runtimeServerParams(fields []string) string {
+	params := make([]string, 0, len(fields))
+	for i := 3; i < len(fields); i++ {
+		switch fields[i] {
+		case "weight":
+			i++
+		case "disabled", "enabled":
+		default:
+			params = append(params, fields[i])
+		}
+	}
+	return strings.Join(params, " ")
+}
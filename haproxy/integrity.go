@@ -0,0 +1,98 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	parser "github.com/haproxytech/config-parser/v2"
+)
+
+// FileChecksum is the SHA-256 checksum of a file managed by the API
+type FileChecksum struct {
+	Path     string
+	Type     string
+	SHA256   string
+	Size     int64
+	Modified int64
+}
+
+// Checksum returns the checksum of the file path of type kind
+func Checksum(path, kind string) (FileChecksum, error) {
+	c := FileChecksum{Path: path, Type: kind}
+	f, err := os.Open(path)
+	if err != nil {
+		return c, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return c, err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return c, err
+	}
+	c.SHA256 = hex.EncodeToString(h.Sum(nil))
+	c.Size = fi.Size()
+	c.Modified = fi.ModTime().Unix()
+	return c, nil
+}
+
+// DirChecksums returns the checksums of the regular files of dir, sorted by path
+func DirChecksums(dir, kind string) ([]FileChecksum, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	checksums := make([]FileChecksum, 0, len(files))
+	for _, fi := range files {
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+		c, err := Checksum(filepath.Join(dir, fi.Name()), kind)
+		if err != nil {
+			return nil, err
+		}
+		checksums = append(checksums, c)
+	}
+	sort.Slice(checksums, func(i, j int) bool {
+		return checksums[i].Path < checksums[j].Path
+	})
+	return checksums, nil
+}
+
+// VerifyConfiguration returns an error when the configuration file differs
+// from the configuration p the API holds, which it writes on every commit.
+// Both are compared once parsed so that a file formatted by hand is intact,
+// while a file changed outside of the API or partially written is not.
+func VerifyConfiguration(file string, p *parser.Parser) error {
+	current := &parser.Parser{}
+	if err := current.LoadData(file); err != nil {
+		return fmt.Errorf("cannot read configuration file %s: %s", file, err.Error())
+	}
+	if current.String() != p.String() {
+		return fmt.Errorf("configuration file %s was changed outside of the API", file)
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	haproxyVersionRegexp = regexp.MustCompile(`version (\d+\.\d+)`)
+	// haproxyFeaturesRegexp matches the build features listed by haproxy -vv
+	haproxyFeaturesRegexp = regexp.MustCompile(`(?m)^Feature list\s*:(.*)$`)
+	varNameRegexp         = regexp.MustCompile(`^[A-Za-z0-9._]+$`)
+	// trackCounterRegexp matches the tracked counter number of sc0_*, sc1_* and sc2_* fetches
+	trackCounterRegexp = regexp.MustCompile(`^sc[0-9]+_`)
+	// mapOutputRegexp matches the output type suffix of map_<match>_<output> converters
+	mapOutputRegexp = regexp.MustCompile(`^(map_[a-z]+)_(str|int|ip)$`)
+)
+
+const (
+	// MinVersion is the oldest HAProxy version supported
+	MinVersion = "1.9"
+	// MaxVersion is the newest HAProxy version whose keywords are known
+	MaxVersion = "2.7"
+)
+
+// varScopes are the scopes of HAProxy variables
+var varScopes = []string{"proc", "sess", "txn", "req", "res"}
+
+// sampleFetches are the sample fetches with the HAProxy version introducing them,
+// empty for keywords available in all supported versions. Tracked counter
+// fetches are listed with the sc_ prefix.
+var sampleFetches = map[string]string{
+	// internal states
+	"always_false": "", "always_true": "", "avg_queue": "", "be_conn": "", "be_conn_free": "2.0",
+	"be_sess_rate": "", "bin": "", "bool": "", "connslots": "", "cpu_calls": "", "cpu_ns_avg": "",
+	"cpu_ns_tot": "", "date": "", "date_us": "1.9", "env": "", "fe_conn": "", "fe_req_rate": "",
+	"fe_sess_rate": "", "hostname": "", "int": "", "ipv4": "", "ipv6": "", "lat_ns_avg": "",
+	"lat_ns_tot": "", "meth": "", "nbproc": "", "nbsrv": "", "nbthread": "", "proc": "",
+	"queue": "", "rand": "", "srv_conn": "", "srv_conn_free": "2.0", "srv_is_up": "",
+	"srv_queue": "", "srv_sess_rate": "", "stopping": "", "str": "", "table_avl": "",
+	"table_cnt": "", "thread": "", "uuid": "2.1", "var": "",
+	// layer 4
+	"be_id": "", "be_name": "", "dst": "", "dst_conn": "", "dst_is_local": "", "dst_port": "",
+	"fc_http_major": "1.9", "fc_pp_authority": "2.2", "fc_rcvd_proxy": "", "fc_rtt": "",
+	"fc_rttvar": "", "fc_unacked": "", "fc_sacked": "", "fc_retrans": "", "fc_fackets": "",
+	"fc_lost": "", "fc_reordering": "", "fe_defbe": "", "fe_id": "", "fe_name": "",
+	"sc_bytes_in_rate": "", "sc_bytes_out_rate": "", "sc_clr_gpc0": "", "sc_clr_gpc1": "",
+	"sc_conn_cnt": "", "sc_conn_cur": "", "sc_conn_rate": "", "sc_get_gpc0": "",
+	"sc_get_gpc1": "", "sc_get_gpt0": "", "sc_gpc0_rate": "", "sc_gpc1_rate": "",
+	"sc_http_err_cnt": "", "sc_http_err_rate": "", "sc_http_req_cnt": "",
+	"sc_http_req_rate": "", "sc_inc_gpc0": "", "sc_inc_gpc1": "", "sc_kbytes_in": "",
+	"sc_kbytes_out": "", "sc_sess_cnt": "", "sc_sess_rate": "", "sc_tracked": "",
+	"sc_trackers": "", "so_id": "", "src": "", "src_bytes_in_rate": "",
+	"src_bytes_out_rate": "", "src_clr_gpc0": "", "src_clr_gpc1": "", "src_conn_cnt": "",
+	"src_conn_cur": "", "src_conn_rate": "", "src_get_gpc0": "", "src_get_gpc1": "",
+	"src_get_gpt0": "", "src_gpc0_rate": "", "src_gpc1_rate": "", "src_http_err_cnt": "",
+	"src_http_err_rate": "", "src_http_req_cnt": "", "src_http_req_rate": "",
+	"src_inc_gpc0": "", "src_inc_gpc1": "", "src_is_local": "", "src_kbytes_in": "",
+	"src_kbytes_out": "", "src_port": "", "src_sess_cnt": "", "src_sess_rate": "",
+	"src_updt_conn_cnt": "", "srv_id": "", "srv_name": "",
+	// layer 5
+	"ssl_bc": "", "ssl_bc_alg_keysize": "", "ssl_bc_alpn": "", "ssl_bc_cipher": "",
+	"ssl_bc_is_resumed": "", "ssl_bc_npn": "", "ssl_bc_protocol": "", "ssl_bc_session_id": "",
+	"ssl_bc_session_key": "", "ssl_bc_unique_id": "", "ssl_bc_use_keysize": "",
+	"ssl_c_ca_err": "", "ssl_c_ca_err_depth": "", "ssl_c_der": "", "ssl_c_err": "",
+	"ssl_c_i_dn": "", "ssl_c_key_alg": "", "ssl_c_notafter": "", "ssl_c_notbefore": "",
+	"ssl_c_s_dn": "", "ssl_c_serial": "", "ssl_c_sha1": "", "ssl_c_sig_alg": "",
+	"ssl_c_used": "", "ssl_c_verify": "", "ssl_c_version": "", "ssl_f_der": "",
+	"ssl_f_i_dn": "", "ssl_f_key_alg": "", "ssl_f_notafter": "", "ssl_f_notbefore": "",
+	"ssl_f_s_dn": "", "ssl_f_serial": "", "ssl_f_sha1": "", "ssl_f_sig_alg": "",
+	"ssl_f_version": "", "ssl_fc": "", "ssl_fc_alg_keysize": "", "ssl_fc_alpn": "",
+	"ssl_fc_cipher": "", "ssl_fc_cipherlist_bin": "", "ssl_fc_cipherlist_hex": "",
+	"ssl_fc_cipherlist_str": "", "ssl_fc_cipherlist_xxh": "", "ssl_fc_client_random": "2.0",
+	"ssl_fc_has_crt": "", "ssl_fc_has_early": "", "ssl_fc_has_sni": "",
+	"ssl_fc_is_resumed": "", "ssl_fc_npn": "", "ssl_fc_protocol": "",
+	"ssl_fc_server_random": "2.0", "ssl_fc_session_id": "", "ssl_fc_session_key": "",
+	"ssl_fc_sni": "", "ssl_fc_unique_id": "", "ssl_fc_use_keysize": "",
+	// layer 6
+	"payload": "", "payload_lv": "", "rdp_cookie": "", "rdp_cookie_cnt": "",
+	"rep_ssl_hello_type": "", "req.len": "", "req.payload": "", "req.payload_lv": "",
+	"req.proto_http": "", "req.rdp_cookie": "", "req.rdp_cookie_cnt": "",
+	"req.ssl_alpn": "", "req.ssl_ec_ext": "", "req.ssl_hello_type": "", "req.ssl_sni": "",
+	"req.ssl_st_ext": "", "req.ssl_ver": "", "req_len": "", "req_proto_http": "",
+	"req_ssl_hello_type": "", "req_ssl_sni": "", "req_ssl_ver": "", "res.len": "",
+	"res.payload": "", "res.payload_lv": "", "res.ssl_hello_type": "", "wait_end": "",
+	// layer 7
+	"base": "", "base32": "", "base32+src": "", "baseq": "2.2", "capture.req.hdr": "",
+	"capture.req.method": "", "capture.req.uri": "", "capture.req.ver": "",
+	"capture.res.hdr": "", "capture.res.ver": "", "cook": "", "cook_cnt": "",
+	"cook_val": "", "cookie": "", "hdr": "", "hdr_cnt": "", "hdr_ip": "", "hdr_val": "",
+	"http_auth": "", "http_auth_group": "", "http_first_req": "", "method": "",
+	"path": "", "pathq": "2.2", "query": "", "req.body": "", "req.body_len": "",
+	"req.body_param": "", "req.body_size": "", "req.cook": "", "req.cook_cnt": "",
+	"req.cook_val": "", "req.fhdr": "", "req.fhdr_cnt": "", "req.hdr": "",
+	"req.hdr_cnt": "", "req.hdr_ip": "", "req.hdr_names": "2.2", "req.hdr_val": "",
+	"req.hdrs": "", "req.hdrs_bin": "", "req.ver": "", "req_ver": "", "res.comp": "",
+	"res.comp_algo": "", "res.cook": "", "res.cook_cnt": "", "res.cook_val": "",
+	"res.fhdr": "", "res.fhdr_cnt": "", "res.hdr": "", "res.hdr_cnt": "",
+	"res.hdr_ip": "", "res.hdr_names": "2.2", "res.hdr_val": "", "res.hdrs": "2.2",
+	"res.hdrs_bin": "2.2", "res.ver": "", "resp_ver": "", "scook": "", "scook_cnt": "",
+	"scook_val": "", "set-cookie": "", "shdr": "", "shdr_cnt": "", "shdr_ip": "",
+	"shdr_val": "", "status": "", "unique-id": "", "url": "", "url32": "",
+	"url32+src": "", "url_ip": "", "url_param": "", "url_port": "", "urlp": "",
+	"urlp_val": "", "txn.status": "", "txn.sess_term_state": "2.2",
+	// device detection
+	"51d.all": "", "da-csv-fetch": "", "wurfl-get": "", "wurfl-get-all": "",
+}
+
+// sampleConverters are the converters with the HAProxy version introducing them,
+// empty for keywords available in all supported versions
+var sampleConverters = map[string]string{
+	"add": "", "aes_gcm_dec": "2.0", "and": "", "b64dec": "", "base64": "", "bool": "",
+	"bytes": "", "concat": "2.2", "cpl": "", "crc32": "", "crc32c": "", "da-csv-conv": "",
+	"debug": "", "digest": "2.1", "div": "", "djb2": "", "even": "", "field": "",
+	"hex": "", "hex2i": "", "hmac": "2.1", "http_date": "", "in_table": "", "ipmask": "",
+	"json": "", "language": "", "length": "", "lower": "", "ltime": "", "map": "",
+	"map_beg": "", "map_dir": "", "map_dom": "", "map_end": "", "map_int": "",
+	"map_ip": "", "map_reg": "", "map_regm": "", "map_str": "", "map_sub": "",
+	"mod": "", "mul": "", "nbsrv": "1.9", "neg": "", "not": "", "odd": "", "or": "",
+	"protobuf": "2.1", "regsub": "", "sdbm": "", "secure_memcmp": "2.2", "set-var": "",
+	"sha1": "", "sha2": "2.1", "srv_queue": "2.0", "strcmp": "2.2", "sub": "",
+	"table_bytes_in_rate": "", "table_bytes_out_rate": "", "table_conn_cnt": "",
+	"table_conn_cur": "", "table_conn_rate": "", "table_gpc0": "", "table_gpc0_rate": "",
+	"table_gpc1": "", "table_gpc1_rate": "", "table_gpt0": "", "table_http_err_cnt": "",
+	"table_http_err_rate": "", "table_http_req_cnt": "", "table_http_req_rate": "",
+	"table_kbytes_in": "", "table_kbytes_out": "", "table_server_id": "",
+	"table_sess_cnt": "", "table_sess_rate": "", "table_trackers": "", "ungrpc": "2.1",
+	"unset-var": "", "upper": "", "url_dec": "", "url_enc": "2.2", "utime": "",
+	"word": "", "wt6": "", "xor": "", "xxh32": "", "xxh64": "",
+	// device detection
+	"51d.single": "",
+}
+
+// sampleFeatures are the sample fetches and converters only available when
+// HAProxy is built with a feature
+var sampleFeatures = map[string]string{
+	"51d.all": "51DEGREES", "51d.single": "51DEGREES",
+	"da-csv-conv": "DEVICEATLAS", "da-csv-fetch": "DEVICEATLAS",
+	"wurfl-get": "WURFL", "wurfl-get-all": "WURFL",
+}
+
+// SampleValidator checks sample expressions against the sample fetches and
+// converters known to a HAProxy version
+type SampleValidator struct {
+	// Version is the major.minor version of HAProxy, empty when it could not be
+	// detected in which case all known keywords are accepted
+	Version string
+	// Features are the build features of HAProxy, nil when they could not be
+	// detected in which case all features are assumed to be available
+	Features map[string]bool
+}
+
+// NewSampleValidator returns a validator for the version of the haproxy binary bin
+func NewSampleValidator(bin string) *SampleValidator {
+	version, err := DetectVersion(bin)
+	if err != nil {
+		log.Warningf("Unable to detect HAProxy version, sample expressions are validated against all known keywords: %s", err.Error())
+	}
+	features, err := DetectFeatures(bin)
+	if err != nil {
+		log.Warningf("Unable to detect HAProxy build features, all features are assumed to be available: %s", err.Error())
+	}
+	return &SampleValidator{Version: version, Features: features}
+}
+
+// DetectVersion returns the major.minor version reported by the haproxy binary bin
+func DetectVersion(bin string) (string, error) {
+	out, err := exec.Command(bin, "-v").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%s -v: %s", bin, err.Error())
+	}
+	m := haproxyVersionRegexp.FindSubmatch(out)
+	if m == nil {
+		return "", fmt.Errorf("no version in %s -v output", bin)
+	}
+	return string(m[1]), nil
+}
+
+// DetectFeatures returns the build features reported as enabled by the haproxy
+// binary bin, such as 51DEGREES or WURFL
+func DetectFeatures(bin string) (map[string]bool, error) {
+	out, err := exec.Command(bin, "-vv").CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("%s -vv: %s", bin, err.Error())
+	}
+	return parseFeatures(out)
+}
+
+func parseFeatures(out []byte) (map[string]bool, error) {
+	m := haproxyFeaturesRegexp.FindSubmatch(out)
+	if m == nil {
+		return nil, fmt.Errorf("no feature list in haproxy -vv output")
+	}
+	features := make(map[string]bool)
+	for _, f := range strings.Fields(string(m[1])) {
+		if strings.HasPrefix(f, "+") {
+			features[f[1:]] = true
+		}
+	}
+	return features, nil
+}
+
+// CheckConfiguration checks a configuration file with the haproxy binary bin
+func CheckConfiguration(bin, file string) error {
+	_, err := CheckConfigurationOutput(bin, file)
+	return err
+}
+
+// CheckConfigurationOutput checks a configuration file with the haproxy binary
+// bin, returning the alerts and warnings it printed
+func CheckConfigurationOutput(bin, file string) (string, error) {
+	out, err := exec.Command(bin, "-c", "-f", file).CombinedOutput()
+	output := strings.TrimSpace(string(out))
+	if err != nil {
+		return output, fmt.Errorf("%s: %s", err.Error(), output)
+	}
+	return output, nil
+}
+
+// SupportedVersion reports whether a major.minor HAProxy version is between
+// MinVersion and MaxVersion
+func SupportedVersion(version string) bool {
+	return compareVersions(version, MinVersion) >= 0 && compareVersions(version, MaxVersion) <= 0
+}
+
+// ValidateVariable checks the scope and name of a variable set by a rule
+func ValidateVariable(scope, name string) error {
+	found := false
+	for _, s := range varScopes {
+		if s == scope {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("invalid variable scope '%s', expected one of %s", scope, strings.Join(varScopes, ", "))
+	}
+	if !varNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid variable name '%s', only letters, digits, '.' and '_' are allowed", name)
+	}
+	return nil
+}
+
+// ValidateExpression checks a sample expression, a sample fetch followed by
+// comma separated converters
+func (v *SampleValidator) ValidateExpression(expr string) error {
+	terms, err := splitExpression(expr)
+	if err != nil {
+		return err
+	}
+	for i, term := range terms {
+		name := term
+		if p := strings.Index(term, "("); p >= 0 {
+			name = term[:p]
+		}
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return fmt.Errorf("missing keyword in expression '%s'", expr)
+		}
+		if i == 0 {
+			err = v.checkKeyword("sample fetch", trackCounterRegexp.ReplaceAllString(name, "sc_"), name, sampleFetches)
+		} else {
+			err = v.checkKeyword("converter", mapOutputRegexp.ReplaceAllString(name, "$1"), name, sampleConverters)
+		}
+		if err != nil {
+			return fmt.Errorf("%s in expression '%s'", err.Error(), expr)
+		}
+	}
+	return nil
+}
+
+// Supports reports whether the HAProxy version is at least version, assuming it
+// is when the version could not be detected
+func (v *SampleValidator) Supports(version string) bool {
+	return v.Version == "" || compareVersions(v.Version, version) >= 0
+}
+
+// HasFeature reports whether HAProxy is built with a feature, assuming it is
+// when the features could not be detected
+func (v *SampleValidator) HasFeature(feature string) bool {
+	return v.Features == nil || v.Features[feature]
+}
+
+func (v *SampleValidator) checkKeyword(kind, key, name string, keywords map[string]string) error {
+	since, ok := keywords[key]
+	if !ok {
+		return fmt.Errorf("unknown %s '%s'", kind, name)
+	}
+	if feature, ok := sampleFeatures[key]; ok && !v.HasFeature(feature) {
+		return fmt.Errorf("%s '%s' requires HAProxy built with %s", kind, name, feature)
+	}
+	if since == "" || v.Supports(since) {
+		return nil
+	}
+	return fmt.Errorf("%s '%s' requires HAProxy %s, running %s", kind, name, since, v.Version)
+}
+
+// splitExpression splits a sample expression on the commas outside of arguments
+func splitExpression(expr string) ([]string, error) {
+	terms := make([]string, 0)
+	depth := 0
+	var quote byte
+	start := 0
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parenthesis in expression '%s'", expr)
+			}
+		case c == ',' && depth == 0:
+			terms = append(terms, expr[start:i])
+			start = i + 1
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in expression '%s'", expr)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parenthesis in expression '%s'", expr)
+	}
+	return append(terms, expr[start:]), nil
+}
+
+// compareVersions compares two major.minor versions, returning -1, 0 or 1
+func compareVersions(a, b string) int {
+	as := strings.SplitN(a, ".", 2)
+	bs := strings.SplitN(b, ".", 2)
+	for i := 0; i < 2; i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,186 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// logFormatVariable is a variable of log-format strings
+type logFormatVariable struct {
+	// since is the HAProxy version introducing the variable, empty when it is
+	// available in all supported versions
+	since string
+	// http is set for the variables only filled by HTTP proxies
+	http bool
+}
+
+// logFormatVariables are the variables of log-format strings by name, without the %
+var logFormatVariables = map[string]logFormatVariable{
+	"B": {}, "CC": {http: true}, "CS": {http: true}, "H": {}, "HM": {http: true},
+	"HP": {http: true}, "HPO": {since: "2.4", http: true}, "HQ": {http: true},
+	"HU": {http: true}, "HV": {http: true}, "ID": {}, "ST": {}, "T": {}, "Ta": {http: true},
+	"Tc": {}, "Td": {}, "Th": {}, "Ti": {http: true}, "Tq": {http: true}, "TR": {http: true},
+	"Tr": {http: true}, "Ts": {}, "Tt": {}, "Tu": {}, "Tw": {}, "U": {}, "ac": {},
+	"b": {}, "bc": {}, "bi": {}, "bp": {}, "bq": {}, "ci": {}, "cp": {}, "f": {},
+	"fc": {}, "fi": {}, "fp": {}, "ft": {}, "hr": {http: true}, "hrl": {http: true},
+	"hs": {http: true}, "hsl": {http: true}, "lc": {}, "ms": {}, "o": {}, "pid": {},
+	"r": {http: true}, "rc": {}, "rt": {}, "s": {}, "sc": {}, "si": {}, "sp": {},
+	"sq": {}, "sslc": {}, "sslv": {}, "t": {}, "tr": {}, "trg": {}, "trl": {}, "ts": {},
+	"tsc": {http: true},
+}
+
+// logFormatFlags are the flags of log-format variables, set with + and unset with -
+var logFormatFlags = []string{"Q", "X", "E"}
+
+// LogFormatReport is the result of the check of a log-format string
+type LogFormatReport struct {
+	// Variables are the variables used by the format, with the %, in order of
+	// first use
+	Variables []string
+	// Expressions are the sample expressions used by the format
+	Expressions []string
+	Errors      []string
+	Warnings    []string
+}
+
+// CheckLogFormat checks the variables, flags and sample expressions of a
+// log-format string against the HAProxy version. The variables only filled by
+// HTTP proxies are reported as warnings when mode is tcp.
+func (v *SampleValidator) CheckLogFormat(format, mode string) LogFormatReport {
+	r := LogFormatReport{
+		Variables:   make([]string, 0),
+		Expressions: make([]string, 0),
+		Errors:      make([]string, 0),
+		Warnings:    make([]string, 0),
+	}
+	seen := make(map[string]bool)
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		start := i
+		i++
+		if i >= len(format) {
+			r.Errors = append(r.Errors, fmt.Sprintf("missing variable after '%%' at position %d, use '%%%%' for a single '%%'", start))
+			break
+		}
+		if format[i] == '%' {
+			continue
+		}
+		if format[i] == '{' {
+			end := strings.IndexByte(format[i:], '}')
+			if end < 0 {
+				r.Errors = append(r.Errors, fmt.Sprintf("unterminated flags at position %d", start))
+				break
+			}
+			for _, flag := range strings.Split(format[i+1:i+end], ",") {
+				if err := checkLogFormatFlag(flag); err != nil {
+					r.Errors = append(r.Errors, err.Error())
+				}
+			}
+			i += end + 1
+			if i >= len(format) {
+				r.Errors = append(r.Errors, fmt.Sprintf("missing variable after flags at position %d", start))
+				break
+			}
+		}
+		if format[i] == '[' {
+			end := logFormatExpressionEnd(format, i)
+			if end < 0 {
+				r.Errors = append(r.Errors, fmt.Sprintf("unterminated expression at position %d", start))
+				break
+			}
+			expr := format[i+1 : end]
+			r.Expressions = append(r.Expressions, expr)
+			if err := v.ValidateExpression(expr); err != nil {
+				r.Errors = append(r.Errors, err.Error())
+			}
+			i = end
+			continue
+		}
+		end := i
+		for end < len(format) && isLetter(format[end]) {
+			end++
+		}
+		if end == i {
+			r.Errors = append(r.Errors, fmt.Sprintf("unexpected character '%c' after '%%' at position %d, use '%%%%' for a single '%%'", format[i], start))
+			continue
+		}
+		name := format[i:end]
+		i = end - 1
+		variable, ok := logFormatVariables[name]
+		if !ok {
+			r.Errors = append(r.Errors, fmt.Sprintf("unknown variable '%%%s' at position %d", name, start))
+			continue
+		}
+		if variable.since != "" && !v.Supports(variable.since) {
+			r.Errors = append(r.Errors, fmt.Sprintf("variable '%%%s' requires HAProxy %s, running %s", name, variable.since, v.Version))
+		}
+		if variable.http && mode == "tcp" {
+			r.Warnings = append(r.Warnings, fmt.Sprintf("variable '%%%s' is only filled in http mode", name))
+		}
+		if name != "o" && !seen[name] {
+			seen[name] = true
+			r.Variables = append(r.Variables, "%"+name)
+		}
+	}
+	return r
+}
+
+// ValidateLogFormat checks a log-format string, returning its first error
+func (v *SampleValidator) ValidateLogFormat(format string) error {
+	r := v.CheckLogFormat(format, "")
+	if len(r.Errors) > 0 {
+		return fmt.Errorf("%s in format '%s'", r.Errors[0], format)
+	}
+	return nil
+}
+
+func checkLogFormatFlag(flag string) error {
+	if len(flag) < 2 || (flag[0] != '+' && flag[0] != '-') {
+		return fmt.Errorf("invalid flag '%s', expected +<flag> or -<flag>", flag)
+	}
+	for _, f := range logFormatFlags {
+		if flag[1:] == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown flag '%s', expected one of %s", flag[1:], strings.Join(logFormatFlags, ", "))
+}
+
+// logFormatExpressionEnd returns the position of the bracket closing the
+// expression opened at start, -1 when it is not closed
+func logFormatExpressionEnd(format string, start int) int {
+	depth := 0
+	for j := start; j < len(format); j++ {
+		switch format[j] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
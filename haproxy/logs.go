@@ -0,0 +1,100 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var logPriorityRegexp = regexp.MustCompile(`^<(\d+)>`)
+
+// logLevels are the syslog levels from the most to the least severe
+var logLevels = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+// LogFilter selects log entries read from a ring buffer
+type LogFilter struct {
+	// Level is the least severe level returned, entries without a syslog
+	// priority, such as the ones of the raw format, are always returned
+	Level string
+	// Proxy is the frontend or backend that produced the entry
+	Proxy string
+}
+
+// Match returns true if the log entry passes the filter
+func (f LogFilter) Match(entry string) bool {
+	if f.Level != "" {
+		if m := logPriorityRegexp.FindStringSubmatch(entry); m != nil {
+			pri, _ := strconv.Atoi(m[1])
+			if pri%8 > logLevelIndex(f.Level) {
+				return false
+			}
+		}
+	}
+	if f.Proxy != "" {
+		// traffic logs carry the frontend, with a ~ suffix on SSL listeners,
+		// followed by backend/server, alerts name servers as backend/server
+		for _, field := range strings.Fields(entry) {
+			if strings.TrimSuffix(field, "~") == f.Proxy || strings.HasPrefix(field, f.Proxy+"/") {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func logLevelIndex(level string) int {
+	for i, l := range logLevels {
+		if l == level {
+			return i
+		}
+	}
+	return len(logLevels) - 1
+}
+
+type logEntryWriter struct {
+	filter LogFilter
+	fn     func(entry string) error
+	buf    []byte
+}
+
+// NewLogEntryWriter returns a writer splitting a ring buffer stream in log
+// entries and passing the ones matching the filter to fn
+func NewLogEntryWriter(filter LogFilter, fn func(entry string) error) io.Writer {
+	return &logEntryWriter{filter: filter, fn: fn}
+}
+
+func (w *logEntryWriter) Write(b []byte) (int, error) {
+	w.buf = append(w.buf, b...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			return len(b), nil
+		}
+		entry := strings.TrimRight(string(w.buf[:i]), "\r")
+		w.buf = w.buf[i+1:]
+		if entry == "" || !w.filter.Match(entry) {
+			continue
+		}
+		if err := w.fn(entry); err != nil {
+			return 0, err
+		}
+	}
+}
@@ -0,0 +1,376 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MigrationRewrite is a directive of a configuration written for HAProxy 1.8 or
+// 2.0 and its replacement, empty when the directive is dropped or needs a manual change
+type MigrationRewrite struct {
+	Line        int
+	Section     string
+	Directive   string
+	Replacement string
+	Message     string
+}
+
+// placement of a replacement rule in its section
+const (
+	migrateInPlace = iota
+	// before the http-request rules, where block rules were evaluated
+	migratePrepend
+	// after the http-request and http-response rules, where the req* and rsp* rules were evaluated
+	migrateAppend
+)
+
+type migration struct {
+	replacement string
+	placement   int
+	message     string
+}
+
+var (
+	headerSearch      = regexp.MustCompile(`^\^([A-Za-z0-9_-]+):(\\ \*|\\ \+|\\ )?(.*)$`)
+	headerReplacement = regexp.MustCompile(`^([A-Za-z0-9_-]+):(\\ )?(.*)$`)
+	requestLineSearch = regexp.MustCompile(`^\^(\(?)\[\^\\ :?\]\*(\)?)\\ (.*)$`)
+	backReference     = regexp.MustCompile(`\\([0-9])`)
+)
+
+// MigrateConfiguration rewrites the directives of config removed in HAProxy 2.1
+// and later into their replacements. It returns the migrated configuration,
+// the rewrites performed and the directives which need a manual change.
+func MigrateConfiguration(config string) (string, []MigrationRewrite, []MigrationRewrite) {
+	rewrites := make([]MigrationRewrite, 0)
+	manual := make([]MigrationRewrite, 0)
+
+	var result []string
+	var section string
+	var block []string
+	var prepend, appendLines []string
+	flush := func() {
+		result = append(result, migratedBlock(block, prepend, appendLines)...)
+		block, prepend, appendLines = nil, nil, nil
+	}
+	for i, line := range strings.Split(config, "\n") {
+		data, comment := splitComment(line)
+		args := splitArgs(data)
+		if len(args) > 0 && IsSectionKeyword(args[0]) {
+			flush()
+			section = strings.Join(args, " ")
+		}
+		if len(args) == 0 {
+			block = append(block, line)
+			continue
+		}
+		m, ok, reason := migrateDirective(args)
+		if reason != "" {
+			manual = append(manual, MigrationRewrite{Line: i + 1, Section: section, Directive: data, Message: reason})
+		}
+		if !ok {
+			block = append(block, line)
+			continue
+		}
+		rewrites = append(rewrites, MigrationRewrite{Line: i + 1, Section: section, Directive: data, Replacement: m.replacement, Message: m.message})
+		if m.replacement == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		replacement := indent + m.replacement
+		if comment != "" {
+			replacement += " # " + comment
+		}
+		switch m.placement {
+		case migratePrepend:
+			prepend = append(prepend, replacement)
+		case migrateAppend:
+			appendLines = append(appendLines, replacement)
+		default:
+			block = append(block, replacement)
+		}
+	}
+	flush()
+	return strings.Join(result, "\n"), rewrites, manual
+}
+
+// migratedBlock inserts the prepended rules before the first http-request rule of
+// the section lines and the appended ones after its last directive
+func migratedBlock(lines, prepend, appendLines []string) []string {
+	end := len(lines)
+	for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	result := make([]string, 0, len(lines)+len(prepend)+len(appendLines))
+	for i, line := range lines[:end] {
+		if len(prepend) > 0 && i > 0 && strings.HasPrefix(strings.TrimSpace(line), "http-request ") {
+			result = append(result, prepend...)
+			prepend = nil
+		}
+		result = append(result, line)
+	}
+	result = append(result, prepend...)
+	result = append(result, appendLines...)
+	return append(result, lines[end:]...)
+}
+
+// migrateDirective returns the replacement of the directive args, ok is false when
+// it is kept, with the reason when it needs a manual change
+func migrateDirective(args []string) (m migration, ok bool, reason string) {
+	command, cond := splitCondition(args)
+	keyword := command[0]
+	switch keyword {
+	case "option", "no":
+		option := strings.Join(command, " ")
+		switch option {
+		case "option http-tunnel", "no option http-tunnel":
+			return migration{message: "removed in HAProxy 2.1, tunnels are handled by the HTTP multiplexers"}, true, ""
+		case "option http-use-htx", "no option http-use-htx":
+			return migration{message: "removed in HAProxy 2.1, the HTX mode is always used"}, true, ""
+		case "option forceclose":
+			return migration{replacement: "option httpclose", message: "option forceclose was removed in HAProxy 2.0, option httpclose is equivalent"}, true, ""
+		}
+		return m, false, ""
+	case "block":
+		return migration{
+			replacement: joinArgs("http-request deny", cond),
+			placement:   migratePrepend,
+			message:     "block was removed in HAProxy 2.1",
+		}, true, ""
+	case "reqadd", "rspadd":
+		rule := ruleType(keyword)
+		if len(command) != 2 {
+			return m, false, fmt.Sprintf("%s expects a single header line", keyword)
+		}
+		header := strings.Replace(command[1], `\ `, " ", -1)
+		name := strings.SplitN(header, ":", 2)
+		if len(name) != 2 || strings.TrimSpace(name[0]) == "" {
+			return m, false, fmt.Sprintf("%s line is not a header", keyword)
+		}
+		value := strings.Replace(strings.TrimSpace(name[1]), "%", "%%", -1)
+		if value == "" {
+			value = `""`
+		}
+		return migration{
+			replacement: joinArgs(fmt.Sprintf("%s add-header %s %s", rule, strings.TrimSpace(name[0]), strings.Replace(value, " ", `\ `, -1)), cond),
+			placement:   migrateAppend,
+			message:     fmt.Sprintf("%s was removed in HAProxy 2.1", keyword),
+		}, true, ""
+	case "reqdel", "reqidel", "rspdel", "rspidel":
+		rule := ruleType(keyword)
+		if len(command) != 2 {
+			return m, false, fmt.Sprintf("%s expects a single regex", keyword)
+		}
+		h := headerSearch.FindStringSubmatch(command[1])
+		if h == nil || (h[3] != "" && h[3] != ".*") {
+			return m, false, fmt.Sprintf("%s regex does not match a whole header, use %s del-header or replace-header", keyword, rule)
+		}
+		return migration{
+			replacement: joinArgs(fmt.Sprintf("%s del-header %s", rule, h[1]), cond),
+			placement:   migrateAppend,
+			message:     fmt.Sprintf("%s was removed in HAProxy 2.1", keyword),
+		}, true, ""
+	case "reqrep", "rsprep":
+		if len(command) != 3 {
+			return m, false, fmt.Sprintf("%s expects a regex and a replacement", keyword)
+		}
+		replacement, reason := migrateReplace(keyword, command[1], command[2])
+		if reason != "" {
+			return m, false, reason
+		}
+		return migration{
+			replacement: joinArgs(replacement, cond),
+			placement:   migrateAppend,
+			message:     fmt.Sprintf("%s was removed in HAProxy 2.1", keyword),
+		}, true, ""
+	case "reqdeny", "reqideny", "reqtarpit", "reqitarpit", "reqallow", "reqiallow", "rspdeny", "rspideny":
+		if len(command) != 2 {
+			return m, false, fmt.Sprintf("%s expects a single regex", keyword)
+		}
+		replacement, reason := migrateFilter(keyword, command[1], cond)
+		if reason != "" {
+			return m, false, reason
+		}
+		return migration{
+			replacement: replacement,
+			placement:   migrateAppend,
+			message:     fmt.Sprintf("%s was removed in HAProxy 2.1", keyword),
+		}, true, ""
+	case "reqirep", "rspirep":
+		return m, false, fmt.Sprintf("%s was removed in HAProxy 2.1, case-insensitive rewrites need a manual %s replace-header or replace-value", keyword, ruleType(keyword))
+	case "reqpass", "reqipass":
+		return m, false, fmt.Sprintf("%s was removed in HAProxy 2.1 and has no equivalent", keyword)
+	case "nbproc":
+		return m, false, "nbproc was removed in HAProxy 2.5, use nbthread"
+	case "bind-process":
+		return m, false, "bind-process was removed in HAProxy 2.5, processes are replaced by threads"
+	case "monitor-net":
+		return m, false, "monitor-net was removed in HAProxy 2.5, use http-request return or tcp-request connection rules"
+	}
+	return m, false, ""
+}
+
+// migrateReplace returns the replace-header, replace-path or replace-uri rule of a
+// reqrep or rsprep header or request line rewrite
+func migrateReplace(keyword, search, replace string) (string, string) {
+	rule := ruleType(keyword)
+	replace = strings.Replace(replace, "%", "%%", -1)
+	if h := headerSearch.FindStringSubmatch(search); h != nil && h[2] != "" {
+		r := headerReplacement.FindStringSubmatch(replace)
+		if r == nil || !strings.EqualFold(r[1], h[1]) || strings.Contains(h[3], `\ `) || strings.Contains(r[3], `\ `) {
+			return "", fmt.Sprintf("%s changes the header name or matches several words, use %s replace-header or replace-value", keyword, rule)
+		}
+		if r[3] == "" {
+			r[3] = `""`
+		}
+		return fmt.Sprintf("%s replace-header %s %s %s", rule, h[1], h[3], r[3]), ""
+	}
+	if keyword == "rsprep" {
+		return "", "rsprep of the status line has no equivalent, use http-response set-status"
+	}
+	l := requestLineSearch.FindStringSubmatch(search)
+	if l == nil || l[1] != "(" || l[2] != ")" || !strings.HasPrefix(replace, `\1\ `) {
+		return "", "reqrep does not keep the request method, use http-request set-method, set-path or replace-uri"
+	}
+	uriSearch := l[3]
+	uriReplace := strings.TrimPrefix(replace, `\1\ `)
+	if strings.Contains(uriSearch, `\ `) || strings.Contains(uriReplace, `\ `) || strings.Contains(uriSearch, "HTTP/") || strings.Contains(uriReplace, "HTTP/") || strings.Contains(uriReplace, `\1`) {
+		return "", "reqrep changes the request method or version, use http-request set-method or replace-uri"
+	}
+	// the method is no longer the first group
+	uriReplace = backReference.ReplaceAllStringFunc(uriReplace, func(ref string) string {
+		return fmt.Sprintf(`\%c`, ref[1]-1)
+	})
+	action := "replace-path"
+	if strings.Contains(uriSearch, `\?`) || strings.Contains(uriReplace, "?") {
+		action = "replace-uri"
+	}
+	return fmt.Sprintf("http-request %s %s %s", action, uriSearch, uriReplace), ""
+}
+
+// migrateFilter returns the deny, tarpit or allow rule of a request or response
+// filter on a header or on the request line
+func migrateFilter(keyword, search string, cond []string) (string, string) {
+	rule := ruleType(keyword)
+	action := strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(keyword, "req"), "rsp"), "i")
+	flags := "-m reg"
+	if strings.HasPrefix(keyword, "reqi") || strings.HasPrefix(keyword, "rspi") {
+		flags = "-m reg -i"
+	}
+	fetch := "req"
+	if rule == "http-response" {
+		fetch = "res"
+	}
+	var match string
+	if h := headerSearch.FindStringSubmatch(search); h != nil {
+		switch {
+		case h[3] == "" || h[3] == ".*":
+			match = fmt.Sprintf("{ %s.hdr(%s) -m found }", fetch, h[1])
+		case h[2] != "":
+			match = fmt.Sprintf("{ %s.fhdr(%s) %s ^%s }", fetch, h[1], flags, h[3])
+		}
+	} else if l := requestLineSearch.FindStringSubmatch(search); l != nil && rule == "http-request" && l[1] == l[2] && !strings.Contains(l[3], "HTTP/") {
+		match = fmt.Sprintf("{ url %s ^%s }", flags, l[3])
+	}
+	if match == "" {
+		return "", fmt.Sprintf("%s regex does not match a header or the request URI, use %s %s with an ACL", keyword, rule, action)
+	}
+	condition := "if " + match
+	if len(cond) > 1 {
+		for _, c := range cond[1:] {
+			if c == "||" || c == "or" {
+				return "", fmt.Sprintf("%s condition cannot be combined with the regex, use %s %s with an ACL", keyword, rule, action)
+			}
+		}
+		switch {
+		case cond[0] == "if":
+			condition += " " + strings.Join(cond[1:], " ")
+		case len(cond) == 2 && strings.HasPrefix(cond[1], "!"):
+			condition += " " + strings.TrimPrefix(cond[1], "!")
+		case len(cond) == 2:
+			condition += " !" + cond[1]
+		default:
+			return "", fmt.Sprintf("%s condition cannot be combined with the regex, use %s %s with an ACL", keyword, rule, action)
+		}
+	}
+	return fmt.Sprintf("%s %s %s", rule, action, condition), ""
+}
+
+func ruleType(keyword string) string {
+	if strings.HasPrefix(keyword, "rsp") {
+		return "http-response"
+	}
+	return "http-request"
+}
+
+func joinArgs(rule string, cond []string) string {
+	if len(cond) == 0 {
+		return rule
+	}
+	return rule + " " + strings.Join(cond, " ")
+}
+
+// splitCondition splits the directive arguments at the if or unless keyword
+func splitCondition(args []string) ([]string, []string) {
+	for i, a := range args {
+		if i > 0 && (a == "if" || a == "unless") {
+			return args[:i], args[i:]
+		}
+	}
+	return args, nil
+}
+
+// splitComment returns the directive of a configuration line and its comment
+func splitComment(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '#':
+			return strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		}
+	}
+	return strings.TrimSpace(line), ""
+}
+
+// splitArgs splits a directive on whitespace not escaped by a backslash,
+// keeping the escapes in the arguments
+func splitArgs(data string) []string {
+	args := make([]string, 0)
+	var current strings.Builder
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '\\' && i+1 < len(data):
+			current.WriteByte(c)
+			current.WriteByte(data[i+1])
+			i++
+		case c == ' ' || c == '\t':
+			if current.Len() > 0 {
+				args = append(args, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteByte(c)
+		}
+	}
+	if current.Len() > 0 {
+		args = append(args, current.String())
+	}
+	return args
+}
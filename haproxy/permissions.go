@@ -0,0 +1,123 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/haproxytech/dataplaneapi/system"
+)
+
+// ManagedFile is a file or directory the API reads, or writes when Write is set
+type ManagedFile struct {
+	Path string
+	Type string
+	Dir  bool
+	// Write is the API changing it, Locked it being read-only outside of the
+	// changes of the API, in which case it is not checked for writing
+	Write  bool
+	Locked bool
+	// Secret is it holding credentials, it must not be readable by other users
+	Secret bool
+	// Optional is it not existing being fine
+	Optional bool
+}
+
+// FilePermission is the access of the API to a managed file, and the
+// problems of its permissions. Failures prevent the API from working.
+type FilePermission struct {
+	ManagedFile
+	Exists   bool
+	Mode     os.FileMode
+	UID      int
+	GID      int
+	Readable bool
+	Writable bool
+	Failures []string
+	Warnings []string
+}
+
+// CheckPermissions returns the access of the API to files, the minimal
+// permissions being read access, write access to the files it changes, no
+// write access for other users and no read access for other users to the
+// files holding credentials
+func CheckPermissions(files []ManagedFile) []FilePermission {
+	permissions := make([]FilePermission, 0, len(files))
+	for _, f := range files {
+		p := FilePermission{ManagedFile: f, UID: -1, GID: -1}
+		fi, err := os.Stat(f.Path)
+		if err != nil {
+			if !os.IsNotExist(err) || !f.Optional {
+				p.Failures = append(p.Failures, err.Error())
+			}
+			permissions = append(permissions, p)
+			continue
+		}
+		p.Exists = true
+		p.Mode = fi.Mode().Perm()
+		if uid, gid, ok := system.FileOwner(fi); ok {
+			p.UID, p.GID = uid, gid
+		}
+		p.Readable = canRead(f.Path)
+		p.Writable = canWrite(f.Path, f.Dir)
+		if !p.Readable {
+			p.Failures = append(p.Failures, "not readable by the user running the API")
+		}
+		if f.Write && !f.Locked && !p.Writable {
+			p.Failures = append(p.Failures, "not writable by the user running the API")
+		}
+		// directories with the sticky bit, such as /tmp, are writable by
+		// other users without them changing the files of the API
+		if p.Mode&0002 != 0 && (!fi.IsDir() || fi.Mode()&os.ModeSticky == 0) {
+			p.Failures = append(p.Failures, fmt.Sprintf("writable by other users, mode %04o", p.Mode))
+		}
+		if f.Secret && p.Mode&0004 != 0 {
+			p.Warnings = append(p.Warnings, fmt.Sprintf("readable by other users while it holds credentials, mode %04o", p.Mode))
+		}
+		permissions = append(permissions, p)
+	}
+	return permissions
+}
+
+func canRead(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return true
+}
+
+// canWrite reports whether path can be written to, without changing it
+func canWrite(path string, dir bool) bool {
+	if dir {
+		f, err := ioutil.TempFile(path, ".permissions")
+		if err != nil {
+			return false
+		}
+		f.Close()
+		os.Remove(f.Name())
+		return true
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return true
+}
@@ -0,0 +1,173 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"sort"
+	"strings"
+)
+
+// statuses of the planned changes
+const (
+	PlannedRuntime = "runtime"
+	PlannedReload  = "reload"
+	PlannedApplied = "applied"
+	PlannedFailed  = "failed"
+)
+
+// PlannedChange is a change of a configuration section with the runtime API
+// commands applying it without a reload, none when it requires one
+type PlannedChange struct {
+	ConfigChange
+	Commands []string
+	Status   string
+	Error    string
+}
+
+// ChangePlan classifies the changes of a configuration into the ones applied
+// through the runtime API and the ones requiring a reload
+type ChangePlan struct {
+	Changes []PlannedChange
+}
+
+// PlanChanges plans the changes from the current configuration to the staged
+// one, the changes DiffConfigurations reports as runtime changes being given
+// the commands applying them
+func PlanChanges(current, staged string) *ChangePlan {
+	cSections := splitSections(current)
+	sSections := splitSections(staged)
+	plan := &ChangePlan{Changes: make([]PlannedChange, 0)}
+	for _, c := range DiffConfigurations(current, staged) {
+		p := PlannedChange{ConfigChange: c, Status: PlannedReload}
+		if c.Runtime {
+			removed, added := diffLines(cSections[c.key].lines, sSections[c.key].lines)
+			p.Commands = runtimeCommands(c, removed, added)
+			if len(p.Commands) > 0 {
+				p.Status = PlannedRuntime
+			}
+		}
+		plan.Changes = append(plan.Changes, p)
+	}
+	return plan
+}
+
+// ReloadRequired reports whether a change requires a reload, the runtime
+// changes which failed included
+func (p *ChangePlan) ReloadRequired() bool {
+	for _, c := range p.Changes {
+		if c.Status == PlannedReload || c.Status == PlannedFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyRuntime applies the runtime changes, a failed change being left to the
+// reload
+func (p *ChangePlan) ApplyRuntime(rt RuntimeExecutor) {
+	for i, c := range p.Changes {
+		if c.Status != PlannedRuntime {
+			continue
+		}
+		p.Changes[i].Status = PlannedApplied
+		for _, command := range c.Commands {
+			if err := ExecuteRuntimeCommand(rt, command); err != nil {
+				p.Changes[i].Status = PlannedFailed
+				p.Changes[i].Error = err.Error()
+				break
+			}
+		}
+	}
+}
+
+// runtimeCommands returns the commands applying a runtime change, a frontend
+// maxconn or the address, weight or state of servers
+func runtimeCommands(c ConfigChange, removed, added []string) []string {
+	commands := make([]string, 0)
+	if c.Section == "frontend" {
+		for _, l := range added {
+			f := strings.Fields(l)
+			if len(f) != 2 {
+				return nil
+			}
+			commands = append(commands, "set maxconn frontend "+c.Name+" "+f[1])
+		}
+		return commands
+	}
+	old := make(map[string][]string)
+	for _, l := range removed {
+		f := strings.Fields(l)
+		old[f[1]] = f
+	}
+	sort.Strings(added)
+	for _, l := range added {
+		n := strings.Fields(l)
+		o := old[n[1]]
+		server := c.Name + "/" + n[1]
+		if o[2] != n[2] {
+			addr, port := splitServerAddress(n[2])
+			command := "set server " + server + " addr " + addr
+			if port != "" {
+				command += " port " + port
+			}
+			commands = append(commands, command)
+		}
+		if w := serverWeight(n); w != serverWeight(o) {
+			commands = append(commands, "set weight "+server+" "+w)
+		}
+		if d := serverDisabled(n); d != serverDisabled(o) {
+			state := "ready"
+			if d {
+				state = "maint"
+			}
+			commands = append(commands, "set server "+server+" state "+state)
+		}
+	}
+	return commands
+}
+
+// splitServerAddress splits the address of a server line into the address
+// and the port, IPv6 addresses having colons
+func splitServerAddress(address string) (string, string) {
+	i := strings.LastIndex(address, ":")
+	if i < 0 || strings.HasSuffix(address, "]") || strings.Count(address, ":") > 1 && !strings.Contains(address, "]") {
+		return strings.Trim(address, "[]"), ""
+	}
+	return strings.Trim(address[:i], "[]"), address[i+1:]
+}
+
+// serverWeight returns the weight of a server line, 1 by default
+func serverWeight(fields []string) string {
+	for i := 3; i < len(fields)-1; i++ {
+		if fields[i] == "weight" {
+			return fields[i+1]
+		}
+	}
+	return "1"
+}
+
+func serverDisabled(fields []string) bool {
+	disabled := false
+	for _, f := range fields[3:] {
+		switch f {
+		case "disabled":
+			disabled = true
+		case "enabled":
+			disabled = false
+		}
+	}
+	return disabled
+}
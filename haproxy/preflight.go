@@ -0,0 +1,231 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	parser "github.com/haproxytech/config-parser/v2"
+	"github.com/haproxytech/config-parser/v2/types"
+	"github.com/haproxytech/dataplaneapi/system"
+)
+
+// names of the preflight checks
+const (
+	PreflightHAProxyBinary     = "haproxy_binary"
+	PreflightHAProxyVersion    = "haproxy_version"
+	PreflightConfigurationFile = "configuration_file"
+	PreflightTransactionDir    = "transaction_dir"
+	PreflightRuntimeSocket     = "runtime_socket"
+	PreflightUserlist          = "userlist"
+	PreflightFilePermissions   = "file_permissions"
+	PreflightFileConfinement   = "file_confinement"
+)
+
+// statuses of the preflight checks
+const (
+	PreflightOK      = "ok"
+	PreflightWarning = "warning"
+	PreflightFailed  = "failed"
+)
+
+// PreflightCheck is the outcome of a check of the environment of the API run
+// on startup, a failed hard check preventing the API from working
+type PreflightCheck struct {
+	Name    string
+	Status  string
+	Hard    bool
+	Message string
+}
+
+// PreflightResult are the preflight checks run on startup
+type PreflightResult struct {
+	Time   time.Time
+	Checks []PreflightCheck
+}
+
+// PreflightParams are the settings checked by Preflight
+type PreflightParams struct {
+	Bin            string
+	ConfigFile     string
+	TransactionDir string
+	// MasterRuntime is the master socket, the stats sockets of the global
+	// section of the configuration are checked when not set
+	MasterRuntime string
+	// Users returns an error when the API has no user to authenticate requests
+	Users func() error
+	// Files are checked for the minimal permissions the API needs
+	Files []ManagedFile
+	// Confined checks that the files written are not in the temporary
+	// directory of the system, and that they can be labelled
+	Confined bool
+}
+
+// Passed reports whether no hard check failed
+func (r *PreflightResult) Passed() bool {
+	return len(r.Failures()) == 0
+}
+
+// Failures returns the hard checks which failed
+func (r *PreflightResult) Failures() []PreflightCheck {
+	failures := make([]PreflightCheck, 0)
+	for _, c := range r.Checks {
+		if c.Hard && c.Status == PreflightFailed {
+			failures = append(failures, c)
+		}
+	}
+	return failures
+}
+
+func (r *PreflightResult) add(name string, hard bool, err error, message string, args ...interface{}) {
+	c := PreflightCheck{Name: name, Status: PreflightOK, Hard: hard, Message: fmt.Sprintf(message, args...)}
+	if err != nil {
+		c.Status = PreflightFailed
+		c.Message = err.Error()
+	}
+	r.Checks = append(r.Checks, c)
+}
+
+func (r *PreflightResult) warn(name string, message string, args ...interface{}) {
+	r.Checks = append(r.Checks, PreflightCheck{Name: name, Status: PreflightWarning, Message: fmt.Sprintf(message, args...)})
+}
+
+// Preflight checks the haproxy binary and its version, the configuration file,
+// the transaction directory, the runtime sockets, the users of the API and the
+// permissions of its files, the messages of the failed checks telling how to fix them
+func Preflight(params PreflightParams) *PreflightResult {
+	r := &PreflightResult{Time: time.Now()}
+
+	bin, err := exec.LookPath(params.Bin)
+	if err != nil {
+		err = fmt.Errorf("haproxy binary %s not found, set --haproxy-bin to the path of the haproxy binary", params.Bin)
+	}
+	r.add(PreflightHAProxyBinary, true, err, "haproxy binary %s", bin)
+	if err == nil {
+		version, err := DetectVersion(bin)
+		switch {
+		case err != nil:
+			r.add(PreflightHAProxyVersion, true, fmt.Errorf("cannot run the haproxy binary: %s, check that %s is the haproxy binary of this host", err.Error(), bin), "")
+		case !SupportedVersion(version):
+			r.warn(PreflightHAProxyVersion, "HAProxy %s is not supported, the supported versions are %s to %s", version, MinVersion, MaxVersion)
+		default:
+			r.add(PreflightHAProxyVersion, false, nil, "HAProxy %s", version)
+		}
+	}
+
+	data, err := ioutil.ReadFile(params.ConfigFile)
+	if err != nil {
+		err = fmt.Errorf("cannot read the configuration file: %s, set --config-file to a file readable by the user running the API", err.Error())
+	}
+	r.add(PreflightConfigurationFile, true, err, "configuration file %s", params.ConfigFile)
+
+	r.add(PreflightTransactionDir, true, checkWritableDir(params.TransactionDir), "transaction directory %s", params.TransactionDir)
+
+	sockets := []string{}
+	if params.MasterRuntime != "" {
+		sockets = append(sockets, params.MasterRuntime)
+	} else if data != nil {
+		sockets = statsSockets(string(data))
+	}
+	if len(sockets) == 0 {
+		r.warn(PreflightRuntimeSocket, "no runtime socket, set --master-runtime or a stats socket in the global section of the configuration to use the runtime features")
+	}
+	for _, s := range sockets {
+		conn, err := net.DialTimeout("unix", s, time.Second)
+		if err != nil {
+			r.warn(PreflightRuntimeSocket, "cannot connect to the runtime socket %s: %s, check that HAProxy is running and that the socket is writable by the user running the API", s, err.Error())
+			continue
+		}
+		conn.Close()
+		r.add(PreflightRuntimeSocket, false, nil, "runtime socket %s", s)
+	}
+
+	if params.Users != nil {
+		err := params.Users()
+		if err != nil {
+			err = fmt.Errorf("no user to authenticate the requests: %s, add users to the userlist of --userlist, to --userlist-file or to the dataplane configuration file", err.Error())
+		}
+		r.add(PreflightUserlist, true, err, "users of the API found")
+	}
+
+	for _, p := range CheckPermissions(params.Files) {
+		switch {
+		case len(p.Failures) > 0:
+			r.add(PreflightFilePermissions, true, fmt.Errorf("%s %s: %s, change its owner or mode for the user running the API", p.Type, p.Path, strings.Join(p.Failures, ", ")), "")
+		case len(p.Warnings) > 0:
+			r.warn(PreflightFilePermissions, "%s %s: %s, remove the read access of other users", p.Type, p.Path, strings.Join(p.Warnings, ", "))
+		case p.Exists:
+			r.add(PreflightFilePermissions, false, nil, "%s %s: mode %04o", p.Type, p.Path, p.Mode)
+		}
+	}
+
+	if params.Confined {
+		for _, f := range params.Files {
+			if f.Write && system.InTempDir(f.Path) {
+				r.add(PreflightFileConfinement, true, fmt.Errorf("%s %s is in the temporary directory of the system, move it to a directory allowed by the confinement policy", f.Type, f.Path), "")
+			}
+		}
+		if label := system.Files.Label(); label != "" {
+			err := system.SetLabel(params.TransactionDir)
+			if err != nil {
+				err = fmt.Errorf("cannot label the files: %s, check that SELinux is enabled and that --file-label is a context the API may set", err.Error())
+			}
+			r.add(PreflightFileConfinement, true, err, "files labelled %s", label)
+		}
+	}
+	return r
+}
+
+// checkWritableDir creates dir when needed and a file in it
+func checkWritableDir(dir string) error {
+	if err := system.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("cannot create the transaction directory: %s, set --transaction-dir to a directory writable by the user running the API", err.Error())
+	}
+	f, err := ioutil.TempFile(dir, ".preflight")
+	if err != nil {
+		return fmt.Errorf("transaction directory %s is not writable: %s, set --transaction-dir to a directory writable by the user running the API", dir, err.Error())
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
+
+// statsSockets returns the paths of the unix stats sockets of the global
+// section of config
+func statsSockets(config string) []string {
+	p := &parser.Parser{}
+	if err := p.ParseData(config); err != nil {
+		return nil
+	}
+	data, err := p.Get(parser.Global, parser.GlobalSectionName, "stats socket")
+	if err != nil {
+		return nil
+	}
+	sockets := make([]string, 0)
+	for _, s := range data.([]types.Socket) {
+		path := strings.TrimPrefix(s.Path, "unix@")
+		if strings.Contains(path, "@") || strings.Contains(path, ":") {
+			continue
+		}
+		sockets = append(sockets, path)
+	}
+	return sockets
+}
@@ -0,0 +1,171 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/haproxytech/dataplaneapi/system"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	masterSocketTimeout = 5 * time.Second
+	// oldWorkersCheckInterval is the time between two checks for old workers to stop
+	oldWorkersCheckInterval = 10 * time.Second
+)
+
+var uptimeRegexp = regexp.MustCompile(`^(\d+)d(\d+)h(\d+)m(\d+)s$`)
+
+// Process is a HAProxy process as listed by the master socket show proc command
+type Process struct {
+	PID         int64
+	Type        string
+	RelativePID int64
+	Reloads     int64
+	// Uptime in seconds
+	Uptime  int64
+	Version string
+	// Old is set for workers of previous reloads
+	Old bool
+}
+
+// ShowProc lists HAProxy processes using the master socket
+func ShowProc(masterSocket string) ([]Process, error) {
+	if masterSocket == "" {
+		return nil, fmt.Errorf("master runtime socket not configured")
+	}
+	conn, err := net.DialTimeout("unix", masterSocket, masterSocketTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	// nolint:errcheck
+	conn.SetDeadline(time.Now().Add(masterSocketTimeout))
+	if _, err := conn.Write([]byte("show proc\n")); err != nil {
+		return nil, err
+	}
+	out, err := ioutil.ReadAll(conn)
+	if err != nil {
+		return nil, err
+	}
+	return parseShowProc(string(out)), nil
+}
+
+func parseShowProc(out string) []Process {
+	processes := make([]Process, 0)
+	old := false
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			// sections are introduced by "# workers", "# old workers" and "# programs"
+			old = strings.Contains(line, "old")
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 5 {
+			continue
+		}
+		pid, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		p := Process{
+			PID:    pid,
+			Type:   fields[1],
+			Uptime: parseUptime(fields[4]),
+			Old:    old && fields[1] == "worker",
+		}
+		p.RelativePID, _ = strconv.ParseInt(fields[2], 10, 64)
+		p.Reloads, _ = strconv.ParseInt(fields[3], 10, 64)
+		if len(fields) > 5 {
+			p.Version = fields[5]
+		}
+		processes = append(processes, p)
+	}
+	return processes
+}
+
+// DrainingTime returns the number of seconds an old worker has been draining
+// connections. show proc does not report when a worker became old, so the uptime
+// of the oldest newer worker still running is used, which is a lower bound.
+func DrainingTime(procs []Process, old Process) int64 {
+	draining := int64(0)
+	for _, p := range procs {
+		if p.Type == "worker" && p.Reloads < old.Reloads && p.Uptime > draining {
+			draining = p.Uptime
+		}
+	}
+	return draining
+}
+
+// StopOldWorkers sends SIGTERM to old workers draining connections for at least
+// olderThan seconds, and returns the stopped workers
+func StopOldWorkers(masterSocket string, olderThan int64) ([]Process, error) {
+	procs, err := ShowProc(masterSocket)
+	if err != nil {
+		return nil, err
+	}
+	stopped := make([]Process, 0)
+	for _, p := range procs {
+		if !p.Old || DrainingTime(procs, p) < olderThan {
+			continue
+		}
+		if err := system.StopProcess(int(p.PID)); err != nil {
+			return stopped, fmt.Errorf("stopping old worker %d: %s", p.PID, err.Error())
+		}
+		stopped = append(stopped, p)
+	}
+	return stopped, nil
+}
+
+// CleanupOldWorkers periodically stops old workers draining connections for
+// longer than timeout seconds
+func CleanupOldWorkers(masterSocket string, timeout int) {
+	ticker := time.NewTicker(oldWorkersCheckInterval)
+	for range ticker.C {
+		stopped, err := StopOldWorkers(masterSocket, int64(timeout))
+		if err != nil {
+			log.Warning("Old workers cleanup: ", err.Error())
+		}
+		for _, p := range stopped {
+			log.Infof("Stopped old worker %d after %d reloads", p.PID, p.Reloads)
+		}
+	}
+}
+
+// parseUptime parses uptimes in the 0d00h00m00s format into seconds
+func parseUptime(uptime string) int64 {
+	m := uptimeRegexp.FindStringSubmatch(uptime)
+	if m == nil {
+		return 0
+	}
+	var seconds int64
+	for i, factor := range []int64{86400, 3600, 60, 1} {
+		v, _ := strconv.ParseInt(m[i+1], 10, 64)
+		seconds += v * factor
+	}
+	return seconds
+}
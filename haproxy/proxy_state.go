@@ -0,0 +1,191 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+
+	"github.com/haproxytech/models/v2"
+)
+
+// runtime states of the frontends and backends
+const (
+	ProxyEnabled  = "enabled"
+	ProxyDisabled = "disabled"
+)
+
+// ProxyRuntime is the part of the runtime API client used to enable and
+// disable frontends and backends
+type ProxyRuntime interface {
+	RuntimeExecutor
+	GetStats() models.NativeStats
+	GetServersState(backend string) (models.RuntimeServers, error)
+	SetServerState(backend, server string, state string) error
+}
+
+// ProxyState is the runtime state of a frontend or a backend, a backend being
+// disabled when all its servers are in maintenance
+type ProxyState struct {
+	Name        string
+	State       string
+	Servers     int64
+	Maintenance int64
+}
+
+// FrontendStates returns the states of the frontends, a frontend being
+// disabled when a process stopped it
+func FrontendStates(rt ProxyRuntime) ([]ProxyState, error) {
+	names, stopped, err := statsProxies(rt.GetStats(), models.NativeStatTypeFrontend)
+	if err != nil {
+		return nil, err
+	}
+	states := make([]ProxyState, 0, len(names))
+	for _, name := range names {
+		state := ProxyState{Name: name, State: ProxyEnabled}
+		if stopped[name] {
+			state.State = ProxyDisabled
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// FrontendState returns the state of the frontend name, nil when it does
+// not exist
+func FrontendState(rt ProxyRuntime, name string) (*ProxyState, error) {
+	states, err := FrontendStates(rt)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range states {
+		if s.Name == name {
+			return &s, nil
+		}
+	}
+	return nil, nil
+}
+
+// SetFrontendState enables or disables the frontend name with the enable
+// frontend and disable frontend commands
+func SetFrontendState(rt ProxyRuntime, name, state string) error {
+	command := "enable frontend " + name
+	if state == ProxyDisabled {
+		command = "disable frontend " + name
+	}
+	return executeSilent(rt, command)
+}
+
+// BackendStates returns the states of the backends
+func BackendStates(rt ProxyRuntime) ([]ProxyState, error) {
+	names, _, err := statsProxies(rt.GetStats(), models.NativeStatTypeBackend)
+	if err != nil {
+		return nil, err
+	}
+	states := make([]ProxyState, 0, len(names))
+	for _, name := range names {
+		state, err := backendState(rt, name)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// BackendState returns the state of the backend name, nil when it does not
+// exist
+func BackendState(rt ProxyRuntime, name string) (*ProxyState, error) {
+	names, _, err := statsProxies(rt.GetStats(), models.NativeStatTypeBackend)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range names {
+		if n == name {
+			state, err := backendState(rt, name)
+			return &state, err
+		}
+	}
+	return nil, nil
+}
+
+// SetBackendState disables the backend name by putting all its servers in
+// maintenance, or enables it by setting its servers in maintenance to ready
+func SetBackendState(rt ProxyRuntime, name, state string) error {
+	servers, err := rt.GetServersState(name)
+	if err != nil {
+		return err
+	}
+	for _, s := range servers {
+		switch {
+		case state == ProxyDisabled && s.AdminState != models.RuntimeServerAdminStateMaint:
+			err = rt.SetServerState(name, s.Name, models.RuntimeServerAdminStateMaint)
+		case state == ProxyEnabled && s.AdminState == models.RuntimeServerAdminStateMaint:
+			err = rt.SetServerState(name, s.Name, models.RuntimeServerAdminStateReady)
+		default:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("setting server %s/%s: %s", name, s.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+func backendState(rt ProxyRuntime, name string) (ProxyState, error) {
+	servers, err := rt.GetServersState(name)
+	if err != nil {
+		return ProxyState{}, err
+	}
+	state := ProxyState{Name: name, State: ProxyEnabled, Servers: int64(len(servers))}
+	for _, s := range servers {
+		if s.AdminState == models.RuntimeServerAdminStateMaint {
+			state.Maintenance++
+		}
+	}
+	if state.Servers > 0 && state.Maintenance == state.Servers {
+		state.State = ProxyDisabled
+	}
+	return state, nil
+}
+
+// statsProxies returns the names of the proxies of type typ in the stats of
+// all processes, and the ones a process reports as stopped
+func statsProxies(stats models.NativeStats, typ string) ([]string, map[string]bool, error) {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	stopped := make(map[string]bool)
+	for _, c := range stats {
+		if c == nil {
+			continue
+		}
+		if c.Error != "" {
+			return nil, nil, fmt.Errorf("%s: %s", c.RuntimeAPI, c.Error)
+		}
+		for _, s := range c.Stats {
+			if s.Type != typ {
+				continue
+			}
+			if !seen[s.Name] {
+				seen[s.Name] = true
+				names = append(names, s.Name)
+			}
+			if s.Stats != nil && s.Stats.Status == "STOP" {
+				stopped[s.Name] = true
+			}
+		}
+	}
+	return names, stopped, nil
+}
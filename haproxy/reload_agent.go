@@ -17,32 +17,50 @@ package haproxy
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/google/renameio"
+	"github.com/haproxytech/dataplaneapi/system"
 	"github.com/haproxytech/models/v2"
 
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	// maxRetryBackoff caps the exponential backoff between two reload retries
+	maxRetryBackoff = 60 * time.Second
+	// processCheckDelay is the time given to the new HAProxy process to come up after a reload
+	processCheckDelay = 2 * time.Second
+	// webhookTimeout limits the time spent notifying the rollback webhook
+	webhookTimeout = 10 * time.Second
+)
+
 type IReloadAgent interface {
-	Init(delay int, reloadCmd string, restartCmd string, configFile string, retention int) error
+	Init(params ReloadAgentParams) error
 	Reload() string
+	ReloadInWindow() string
 	Restart() error
 	ForceReload() error
 	GetReloads() models.Reloads
 	GetReload(id string) *models.Reload
+	RetryReload(id string) (string, error)
+	Freeze(reason, user string) (ReloadFreeze, error)
+	Unfreeze() (string, error)
+	FreezeStatus() ReloadFreeze
 }
 
 type reloadCache struct {
 	failedReloads map[string]*models.Reload
+	attempts      map[string][]string
 	lastSuccess   *models.Reload
 	next          string
 	current       string
@@ -51,32 +69,104 @@ type reloadCache struct {
 	mu            sync.RWMutex
 }
 
+// ReloadAgentParams holds the reload agent settings
+type ReloadAgentParams struct {
+	Delay        int
+	ReloadCmd    string
+	RestartCmd   string
+	ConfigFile   string
+	Retention    int
+	Retries      int
+	RetryBackoff int
+	// Rollback restores the last known good configuration when a reload fails
+	Rollback        bool
+	RollbackWebhook string
+	// ProcessCheck, if set, is called after a successful reload to verify HAProxy is still running
+	ProcessCheck func() error
+	// SmokeTest, if set, is called after a successful reload to verify HAProxy serves traffic,
+	// its report is added to the reload response
+	SmokeTest func() (string, error)
+	// OnRollback, if set, is called after the configuration file has been rolled back
+	OnRollback func() error
+	// OnReload, if set, is called with the outcome and the duration of every reload
+	OnReload func(succeeded bool, d time.Duration)
+	// Version, if set, returns the configuration version recorded for the
+	// reloads deferred while reloads are frozen
+	Version func() (int64, error)
+	// Integrity, if set, is called before every reload and fails it when the
+	// configuration file is not the one the API wrote, leaving it untouched
+	Integrity func() error
+	// Windows are the daily time ranges the reloads scheduled with
+	// ReloadInWindow wait for
+	Windows []ReloadWindow
+}
+
 // ReloadAgent handles all reloads, scheduled or forced
 type ReloadAgent struct {
-	delay         int
-	reloadCmd     string
-	restartCmd    string
-	configFile    string
-	lkgConfigFile string
-	cache         reloadCache
+	delay           int64
+	retries         int
+	retryBackoff    time.Duration
+	reloadCmd       string
+	restartCmd      string
+	configFile      string
+	lkgConfigFile   string
+	rollback        bool
+	rollbackWebhook string
+	processCheck    func() error
+	smokeTest       func() (string, error)
+	onRollback      func() error
+	onReload        func(succeeded bool, d time.Duration)
+	version         func() (int64, error)
+	integrity       func() error
+	cache           reloadCache
+	freezeFile      string
+	freeze          ReloadFreeze
+	freezeMu        sync.Mutex
+	windows         []ReloadWindow
+	// held is set to 1 while the scheduled reload waits for a reload window
+	held int32
 }
 
 // Init a new reload agent
-func (ra *ReloadAgent) Init(delay int, reloadCmd string, restartCmd string, configFile string, retention int) error {
-	ra.reloadCmd = reloadCmd
-	ra.restartCmd = restartCmd
-	ra.configFile = configFile
-	if delay == 0 {
-		delay = 5
+func (ra *ReloadAgent) Init(params ReloadAgentParams) error {
+	ra.reloadCmd = params.ReloadCmd
+	ra.restartCmd = params.RestartCmd
+	ra.configFile = params.ConfigFile
+	ra.SetDelay(params.Delay)
+	ra.retries = params.Retries
+	if ra.retries < 0 {
+		ra.retries = 0
+	}
+	retryBackoff := params.RetryBackoff
+	if retryBackoff <= 0 {
+		retryBackoff = 1
 	}
-	ra.delay = delay
-	ra.lkgConfigFile = configFile + ".lkg"
+	ra.retryBackoff = time.Duration(retryBackoff) * time.Second
+	ra.rollback = params.Rollback
+	ra.rollbackWebhook = params.RollbackWebhook
+	ra.processCheck = params.ProcessCheck
+	ra.smokeTest = params.SmokeTest
+	ra.onRollback = params.OnRollback
+	ra.onReload = params.OnReload
+	ra.version = params.Version
+	ra.integrity = params.Integrity
+	ra.windows = params.Windows
+	ra.lkgConfigFile = ra.configFile + ".lkg"
+	ra.freezeFile = ra.configFile + ".freeze"
 
 	// create last known good file, assume it is valid when starting
-	if err := copyFile(ra.configFile, ra.lkgConfigFile); err != nil {
+	if err := system.ConfigWindow.Open(); err != nil {
+		return err
+	}
+	err := copyFile(ra.configFile, ra.lkgConfigFile)
+	system.ConfigWindow.Close()
+	if err != nil {
+		return err
+	}
+	ra.cache.Init(params.Retention)
+	if err := ra.loadFreeze(); err != nil {
 		return err
 	}
-	ra.cache.Init(retention)
 	go ra.handleReloads()
 	return nil
 }
@@ -85,24 +175,77 @@ func (ra *ReloadAgent) handleReloads() {
 	//nolint:gosimple
 	for {
 		select {
-		case <-time.After(time.Duration(ra.delay) * time.Second):
-			if ra.cache.next != "" {
+		case <-time.After(time.Duration(atomic.LoadInt64(&ra.delay)) * time.Second):
+			// the scheduled reload waits while reloads are frozen
+			if ra.cache.next != "" && !ra.frozen() && !ra.heldForWindow() {
+				// the reload writes the last known good configuration, or
+				// rolls the configuration back
+				if err := system.ConfigWindow.Open(); err != nil {
+					log.Warning("Reload postponed: " + err.Error())
+					continue
+				}
 				ra.cache.mu.Lock()
 				ra.cache.current = ra.cache.next
 				ra.cache.next = ""
 				ra.cache.mu.Unlock()
-				response, err := ra.reloadHAProxy()
+				atomic.StoreInt32(&ra.held, 0)
+				start := time.Now()
+				if err := ra.verifyIntegrity(); err != nil {
+					log.Warning("Reload refused " + err.Error())
+					ra.cache.failReload([]string{err.Error()})
+					ra.reloaded(false, time.Since(start))
+					system.ConfigWindow.Close()
+					continue
+				}
+				attempts, err := ra.reloadWithRetries()
 				if err != nil {
-					ra.cache.failReload(response)
 					log.Warning("Reload failed " + err.Error())
+					if ra.rollback {
+						attempts = append(attempts, ra.rollbackConfig())
+					}
+					r := ra.cache.failReload(attempts)
+					if ra.rollback {
+						ra.notifyRollback(r)
+					}
 				} else {
-					ra.cache.succeedReload(response)
+					ra.cache.succeedReload(attempts)
 				}
+				ra.reloaded(err == nil, time.Since(start))
+				system.ConfigWindow.Close()
 			}
 		}
 	}
 }
 
+// SetDelay sets the minimum delay between two reloads in seconds, applied
+// from the next scheduled reload, 5 when it is not set
+func (ra *ReloadAgent) SetDelay(delay int) {
+	if delay == 0 {
+		delay = 5
+	}
+	atomic.StoreInt64(&ra.delay, int64(delay))
+}
+
+// reloadWithRetries reloads HAProxy, retrying failed reloads up to the configured
+// number of times with an exponential backoff. Output of every attempt is returned.
+func (ra *ReloadAgent) reloadWithRetries() ([]string, error) {
+	attempts := make([]string, 0, 1)
+	backoff := ra.retryBackoff
+	for i := 0; ; i++ {
+		response, err := ra.reloadHAProxy()
+		attempts = append(attempts, response)
+		if err == nil || i >= ra.retries {
+			return attempts, err
+		}
+		log.Warningf("Reload attempt %d failed, retrying in %s: %s", i+1, backoff, err.Error())
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
 func (ra *ReloadAgent) reloadHAProxy() (string, error) {
 	// try the reload
 	log.Debug("Reload started...")
@@ -110,6 +253,25 @@ func (ra *ReloadAgent) reloadHAProxy() (string, error) {
 	output, err := execCmd(ra.reloadCmd)
 	log.Debug("Reload finished.")
 	log.Debug("Time elapsed: ", time.Since(t))
+	if err == nil && (ra.processCheck != nil || ra.smokeTest != nil) {
+		// reload command can succeed while the new process dies right after it
+		time.Sleep(processCheckDelay)
+	}
+	if err == nil && ra.processCheck != nil {
+		if checkErr := ra.processCheck(); checkErr != nil {
+			output = fmt.Sprintf("%s\nHAProxy is not running after reload: %s", strings.TrimSpace(output), checkErr.Error())
+			err = fmt.Errorf("process check after reload failed: %s", checkErr)
+		}
+	}
+	if err == nil && ra.smokeTest != nil {
+		report, testErr := ra.smokeTest()
+		if report != "" {
+			output = fmt.Sprintf("%s\n%s", strings.TrimSpace(output), strings.TrimSpace(report))
+		}
+		if testErr != nil {
+			err = fmt.Errorf("smoke test after reload failed: %s", testErr)
+		}
+	}
 	if err != nil {
 		reloadFailedError := err
 		// if failed, return to last known good file and restart and return the original file
@@ -139,6 +301,54 @@ func (ra *ReloadAgent) reloadHAProxy() (string, error) {
 	return output, nil
 }
 
+// rollbackConfig replaces the configuration file with the last known good one and reloads
+// HAProxy with it. The failed configuration is kept next to it with the .failed suffix.
+func (ra *ReloadAgent) rollbackConfig() string {
+	log.Info("Rolling back to last known good config...")
+	// nolint:errcheck
+	copyFile(ra.configFile, ra.configFile+".failed")
+	if err := copyFile(ra.lkgConfigFile, ra.configFile); err != nil {
+		log.Warning("Rollback failed: ", err)
+		return fmt.Sprintf("Rollback failed: %s", err.Error())
+	}
+	if ra.onRollback != nil {
+		if err := ra.onRollback(); err != nil {
+			log.Warning("Rereading configuration after rollback failed: ", err)
+		}
+	}
+	output, err := execCmd(ra.reloadCmd)
+	if err != nil {
+		log.Warning("Reload after rollback failed: ", err)
+		return fmt.Sprintf("Configuration rolled back to last known good version, reload failed: %s", output)
+	}
+	log.Info("Configuration rolled back to last known good version")
+	return fmt.Sprintf("Configuration rolled back to last known good version: %s", output)
+}
+
+// notifyRollback posts the failed reload to the rollback webhook, if configured
+func (ra *ReloadAgent) notifyRollback(r *models.Reload) {
+	if ra.rollbackWebhook == "" {
+		return
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		log.Warning("Rollback webhook: ", err)
+		return
+	}
+	go func() {
+		c := &http.Client{Timeout: webhookTimeout}
+		resp, err := c.Post(ra.rollbackWebhook, "application/json", bytes.NewReader(data))
+		if err != nil {
+			log.Warning("Rollback webhook: ", err)
+			return
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			log.Warningf("Rollback webhook: unexpected status %s", resp.Status)
+		}
+	}()
+}
+
 func (ra *ReloadAgent) restartHAProxy() error {
 	_, err := execCmd(ra.restartCmd)
 	if err != nil {
@@ -170,25 +380,85 @@ func execCmd(cmd string) (string, error) {
 
 // Reload schedules a reload
 func (ra *ReloadAgent) Reload() string {
+	ra.deferReload()
+	atomic.StoreInt32(&ra.held, 0)
+	return ra.schedule()
+}
+
+func (ra *ReloadAgent) schedule() string {
 	if ra.cache.next == "" {
 		ra.cache.newReload()
 	}
 	return ra.cache.next
 }
 
-// ForceReload calls reload directly
+// ForceReload calls reload directly, it is scheduled instead while reloads
+// are frozen
 func (ra *ReloadAgent) ForceReload() error {
+	if ra.deferReload() {
+		log.Infof("Reloads are frozen, forced reload deferred as reload %s", ra.schedule())
+		return nil
+	}
+	start := time.Now()
+	if err := ra.verifyIntegrity(); err != nil {
+		ra.reloaded(false, time.Since(start))
+		return NewReloadError(fmt.Sprintf("Reload refused: %v", err))
+	}
 	r, err := ra.reloadHAProxy()
+	ra.reloaded(err == nil, time.Since(start))
 	if err != nil {
+		if ra.rollback {
+			r = formatAttempts([]string{r, ra.rollbackConfig()})
+			ra.notifyRollback(&models.Reload{
+				Status:          "failed",
+				Response:        r,
+				ReloadTimestamp: time.Now().Unix(),
+			})
+		}
 		return NewReloadError(fmt.Sprintf("Reload failed: %v, %v", err, r))
 	}
 	return nil
 }
 
+// verifyIntegrity checks the configuration file before a reload
+func (ra *ReloadAgent) verifyIntegrity() error {
+	if ra.integrity == nil {
+		return nil
+	}
+	return ra.integrity()
+}
+
+func (ra *ReloadAgent) reloaded(succeeded bool, d time.Duration) {
+	if ra.onReload != nil {
+		ra.onReload(succeeded, d)
+	}
+}
+
+// RetryReload reschedules a failed reload, returning the ID of the reload that will apply the configuration
+func (ra *ReloadAgent) RetryReload(id string) (string, error) {
+	ra.cache.mu.Lock()
+	defer ra.cache.mu.Unlock()
+
+	if _, ok := ra.cache.failedReloads[id]; !ok {
+		return "", NewReloadError(fmt.Sprintf("Reload with ID %s is not a failed reload", id))
+	}
+	delete(ra.cache.failedReloads, id)
+	if ra.cache.next != "" {
+		// a scheduled reload will apply the same configuration, it carries
+		// the attempts of the retried reload
+		ra.cache.attempts[ra.cache.next] = append(ra.cache.attempts[id], ra.cache.attempts[ra.cache.next]...)
+		delete(ra.cache.attempts, id)
+		return ra.cache.next, nil
+	}
+	ra.cache.next = id
+	return id, nil
+}
+
 func (rc *reloadCache) Init(retention int) {
 	rc.mu.Lock()
 	defer rc.mu.Unlock()
 	rc.failedReloads = make(map[string]*models.Reload)
+	rc.attempts = make(map[string][]string)
 	rc.current = ""
 	rc.next = ""
 	rc.lastSuccess = nil
@@ -202,43 +472,60 @@ func (rc *reloadCache) newReload() {
 	rc.next = rc.generateID()
 }
 
-func (rc *reloadCache) failReload(response string) {
+func (rc *reloadCache) failReload(attempts []string) *models.Reload {
 	rc.mu.Lock()
 	defer rc.mu.Unlock()
 
+	rc.attempts[rc.current] = append(rc.attempts[rc.current], attempts...)
 	r := &models.Reload{
 		ID:              rc.current,
 		Status:          "failed",
-		Response:        response,
+		Response:        formatAttempts(rc.attempts[rc.current]),
 		ReloadTimestamp: time.Now().Unix(),
 	}
 
 	rc.failedReloads[rc.current] = r
 	rc.current = ""
 	rc.clearReloads()
+	return r
 }
 
-func (rc *reloadCache) succeedReload(response string) {
+func (rc *reloadCache) succeedReload(attempts []string) {
 	rc.mu.Lock()
 	defer rc.mu.Unlock()
 
 	r := &models.Reload{
 		ID:              rc.current,
 		Status:          "succeeded",
-		Response:        response,
+		Response:        formatAttempts(append(rc.attempts[rc.current], attempts...)),
 		ReloadTimestamp: time.Now().Unix(),
 	}
 
 	rc.lastSuccess = r
+	delete(rc.attempts, rc.current)
 	rc.current = ""
 }
 
+// formatAttempts records the output of every reload attempt, including the ones
+// made before the reload was manually retried. A single attempt is returned as is.
+func formatAttempts(attempts []string) string {
+	if len(attempts) == 1 {
+		return attempts[0]
+	}
+	var sb strings.Builder
+	for i, a := range attempts {
+		fmt.Fprintf(&sb, "attempt %d: %s\n", i+1, strings.TrimSpace(a))
+	}
+	return sb.String()
+}
+
 func (rc *reloadCache) clearReloads() {
 	now := time.Now().Unix()
 
 	for k, v := range rc.failedReloads {
 		if (now - v.ReloadTimestamp) > int64((rc.retention * 86400)) {
 			delete(rc.failedReloads, k)
+			delete(rc.attempts, k)
 		}
 	}
 }
@@ -286,7 +573,7 @@ func (ra *ReloadAgent) GetReload(id string) *models.Reload {
 	}
 	if ra.cache.next == id {
 		return &models.Reload{
-			ID:     ra.cache.current,
+			ID:     ra.cache.next,
 			Status: "in_progress",
 		}
 	}
@@ -379,5 +666,5 @@ func copyFile(src, dest string) error {
 	if err != nil {
 		return err
 	}
-	return renameio.WriteFile(dest, data, 0644)
+	return system.WriteFile(dest, data, 0644)
 }
@@ -0,0 +1,79 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/haproxytech/models/v2"
+)
+
+func TestRetryReload(t *testing.T) {
+	tests := []struct {
+		name     string
+		next     string
+		retry    string
+		id       string
+		err      bool
+		attempts []string
+	}{
+		{"schedule failed reload", "", "1", "1", false, []string{"failed once"}},
+		{"merge into scheduled reload", "2", "1", "2", false, []string{"failed once", "pending"}},
+		{"not a failed reload", "", "3", "", true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ra := &ReloadAgent{}
+			ra.cache.Init(1)
+			ra.cache.failedReloads["1"] = &models.Reload{ID: "1", Status: "failed"}
+			ra.cache.attempts["1"] = []string{"failed once"}
+			if tt.next != "" {
+				ra.cache.next = tt.next
+				ra.cache.attempts[tt.next] = []string{"pending"}
+			}
+
+			id, err := ra.RetryReload(tt.retry)
+			if tt.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if _, ok := ra.cache.failedReloads["1"]; !ok {
+					t.Fatal("failed reload removed on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id != tt.id || ra.cache.next != tt.id {
+				t.Fatalf("expected reload %s scheduled, got %s (next %s)", tt.id, id, ra.cache.next)
+			}
+			if _, ok := ra.cache.failedReloads[tt.retry]; ok {
+				t.Fatalf("reload %s still listed as failed", tt.retry)
+			}
+			if r := ra.GetReload(tt.retry); tt.retry != tt.id && r != nil {
+				t.Fatalf("retried reload %s still returned: %+v", tt.retry, r)
+			}
+			if !reflect.DeepEqual(ra.cache.attempts[tt.id], tt.attempts) {
+				t.Fatalf("unexpected attempts %v", ra.cache.attempts[tt.id])
+			}
+			if _, ok := ra.cache.attempts[tt.retry]; tt.retry != tt.id && ok {
+				t.Fatalf("attempts of reload %s not cleared", tt.retry)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/haproxytech/dataplaneapi/system"
+	log "github.com/sirupsen/logrus"
+)
+
+// ReloadFreeze is the state of the reload freeze switch
+type ReloadFreeze struct {
+	Frozen bool   `json:"frozen"`
+	Since  int64  `json:"since,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	User   string `json:"user,omitempty"`
+	// Pending are the configuration versions committed while frozen
+	Pending []PendingReload `json:"pending,omitempty"`
+	// ReloadID is the reload deferred until reloads are unfrozen
+	ReloadID string `json:"-"`
+}
+
+// PendingReload is a configuration version waiting for reloads to be unfrozen
+type PendingReload struct {
+	Version   int64 `json:"version"`
+	Timestamp int64 `json:"timestamp"`
+}
+
+// Freeze defers all reloads, forced ones included, until Unfreeze is called.
+// Freezing again updates the reason and keeps the pending versions.
+func (ra *ReloadAgent) Freeze(reason, user string) (ReloadFreeze, error) {
+	ra.freezeMu.Lock()
+	defer ra.freezeMu.Unlock()
+	if !ra.freeze.Frozen {
+		ra.freeze.Frozen = true
+		ra.freeze.Since = time.Now().Unix()
+		log.Warningf("Reloads frozen by %s: %s", user, reason)
+	}
+	ra.freeze.Reason = reason
+	ra.freeze.User = user
+	if err := ra.saveFreeze(); err != nil {
+		return ra.freezeStatus(), err
+	}
+	return ra.freezeStatus(), nil
+}
+
+// Unfreeze resumes reloads, returning the ID of the reload applying the
+// changes deferred while frozen, empty when there were none
+func (ra *ReloadAgent) Unfreeze() (string, error) {
+	ra.freezeMu.Lock()
+	defer ra.freezeMu.Unlock()
+	if ra.freeze.Frozen {
+		log.Infof("Reloads unfrozen, %d configuration versions pending", len(ra.freeze.Pending))
+	}
+	ra.freeze = ReloadFreeze{}
+	if err := ra.saveFreeze(); err != nil {
+		return "", err
+	}
+	ra.cache.mu.RLock()
+	defer ra.cache.mu.RUnlock()
+	return ra.cache.next, nil
+}
+
+// FreezeStatus returns the state of the reload freeze switch
+func (ra *ReloadAgent) FreezeStatus() ReloadFreeze {
+	ra.freezeMu.Lock()
+	defer ra.freezeMu.Unlock()
+	return ra.freezeStatus()
+}
+
+func (ra *ReloadAgent) freezeStatus() ReloadFreeze {
+	f := ra.freeze
+	f.Pending = append(make([]PendingReload, 0, len(ra.freeze.Pending)), ra.freeze.Pending...)
+	if f.Frozen && len(f.Pending) > 0 {
+		ra.cache.mu.RLock()
+		f.ReloadID = ra.cache.next
+		ra.cache.mu.RUnlock()
+	}
+	return f
+}
+
+func (ra *ReloadAgent) frozen() bool {
+	ra.freezeMu.Lock()
+	defer ra.freezeMu.Unlock()
+	return ra.freeze.Frozen
+}
+
+// deferReload records the configuration version to reload when unfrozen,
+// reporting whether reloads are frozen
+func (ra *ReloadAgent) deferReload() bool {
+	ra.freezeMu.Lock()
+	defer ra.freezeMu.Unlock()
+	if !ra.freeze.Frozen {
+		return false
+	}
+	var version int64
+	if ra.version != nil {
+		v, err := ra.version()
+		if err != nil {
+			log.Warningf("Cannot read the configuration version of a deferred reload: %s", err.Error())
+		}
+		version = v
+	}
+	if n := len(ra.freeze.Pending); n > 0 && ra.freeze.Pending[n-1].Version == version {
+		return true
+	}
+	ra.freeze.Pending = append(ra.freeze.Pending, PendingReload{Version: version, Timestamp: time.Now().Unix()})
+	if err := ra.saveFreeze(); err != nil {
+		log.Warning(err)
+	}
+	return true
+}
+
+// loadFreeze restores the freeze of a restarted API, the deferred reload is
+// scheduled again
+func (ra *ReloadAgent) loadFreeze() error {
+	data, err := ioutil.ReadFile(ra.freezeFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if err := json.Unmarshal(data, &ra.freeze); err != nil {
+		return err
+	}
+	if ra.freeze.Frozen {
+		log.Warningf("Reloads are frozen since %s: %s", time.Unix(ra.freeze.Since, 0).Format(time.RFC3339), ra.freeze.Reason)
+		if len(ra.freeze.Pending) > 0 {
+			ra.cache.newReload()
+		}
+	}
+	return nil
+}
+
+func (ra *ReloadAgent) saveFreeze() error {
+	if !ra.freeze.Frozen {
+		if err := os.Remove(ra.freezeFile); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.Marshal(ra.freeze)
+	if err != nil {
+		return err
+	}
+	return system.WriteFile(ra.freezeFile, data, 0644)
+}
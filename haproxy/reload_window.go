@@ -0,0 +1,93 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// ReloadWindow is a daily time range in local time, from Start to End in
+// minutes since midnight, ending the next day when End is before Start
+type ReloadWindow struct {
+	Start int
+	End   int
+}
+
+// ParseReloadWindow parses a window written HH:MM-HH:MM
+func ParseReloadWindow(s string) (ReloadWindow, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return ReloadWindow{}, fmt.Errorf("invalid reload window %s, expected HH:MM-HH:MM", s)
+	}
+	var w ReloadWindow
+	for i, p := range parts {
+		t, err := time.Parse("15:04", strings.TrimSpace(p))
+		if err != nil {
+			return ReloadWindow{}, fmt.Errorf("invalid reload window %s, expected HH:MM-HH:MM", s)
+		}
+		m := t.Hour()*60 + t.Minute()
+		if i == 0 {
+			w.Start = m
+		} else {
+			w.End = m
+		}
+	}
+	if w.Start == w.End {
+		return ReloadWindow{}, fmt.Errorf("empty reload window %s", s)
+	}
+	return w, nil
+}
+
+// Contains reports whether t is in the window
+func (w ReloadWindow) Contains(t time.Time) bool {
+	m := t.Hour()*60 + t.Minute()
+	if w.Start < w.End {
+		return m >= w.Start && m < w.End
+	}
+	return m >= w.Start || m < w.End
+}
+
+// inReloadWindow reports whether t is in one of windows
+func inReloadWindow(windows []ReloadWindow, t time.Time) bool {
+	for _, w := range windows {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReloadInWindow schedules a reload held until a reload window opens, reloading
+// like Reload when there is none. A reload scheduled with Reload meanwhile is
+// not held.
+func (ra *ReloadAgent) ReloadInWindow() string {
+	if len(ra.windows) == 0 {
+		return ra.Reload()
+	}
+	ra.deferReload()
+	if ra.cache.next == "" {
+		atomic.StoreInt32(&ra.held, 1)
+	}
+	return ra.schedule()
+}
+
+// heldForWindow reports whether the scheduled reload waits for a reload window
+func (ra *ReloadAgent) heldForWindow() bool {
+	return atomic.LoadInt32(&ra.held) == 1 && !inReloadWindow(ra.windows, time.Now())
+}
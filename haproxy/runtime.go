@@ -0,0 +1,44 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var runtimeErrorRegexp = regexp.MustCompile(`\[[0-9]\]: (.*)`)
+
+// RuntimeExecutor executes raw runtime API commands on all HAProxy processes
+type RuntimeExecutor interface {
+	ExecuteRaw(command string) ([]string, error)
+}
+
+// ExecuteRuntimeCommand executes a runtime API command expecting no output,
+// returning the error reported by HAProxy if any
+func ExecuteRuntimeCommand(rt RuntimeExecutor, command string) error {
+	out, err := rt.ExecuteRaw(command)
+	if err != nil {
+		return err
+	}
+	for _, o := range out {
+		if m := runtimeErrorRegexp.FindStringSubmatch(o); m != nil {
+			return fmt.Errorf("%s", strings.TrimSpace(m[1]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+// availability is the range of HAProxy versions supporting a keyword, an
+// empty bound being open
+type availability struct {
+	since string
+	until string
+}
+
+// schemaProperties are the properties of resources whose keyword is not
+// supported by all HAProxy versions
+var schemaProperties = map[string]map[string]availability{
+	"backend": {
+		"bind_process": {until: "2.4"},
+		"http-use-htx": {until: "2.1"},
+	},
+	"bind": {
+		"process": {until: "2.4"},
+	},
+	"defaults": {
+		"bind_process": {until: "2.4"},
+		"http-use-htx": {until: "2.1"},
+	},
+	"frontend": {
+		"bind_process": {until: "2.4"},
+		"http-use-htx": {until: "2.1"},
+	},
+	"global": {
+		"nbproc": {until: "2.4"},
+	},
+}
+
+// schemaValues are the enum values of resource properties whose keyword is
+// not supported by all HAProxy versions
+var schemaValues = map[string]map[string]map[string]availability{
+	"http_request_rule": {
+		"type": {
+			"disable-l7-retry": {since: "2.0"},
+			"do-resolve":       {since: "2.0"},
+			"replace-path":     {since: "2.1"},
+			"strict-mode":      {since: "2.0"},
+		},
+	},
+	"http_response_rule": {
+		"type": {
+			"strict-mode": {since: "2.0"},
+		},
+	},
+	"tcp_request_rule": {
+		"action": {
+			"do-resolve": {since: "2.0"},
+		},
+	},
+}
+
+// SupportsProperty reports whether the HAProxy version supports the keyword
+// of a property of a resource
+func (v *SampleValidator) SupportsProperty(resource, property string) bool {
+	return v.available(schemaProperties[resource][property])
+}
+
+// SupportsValue reports whether the HAProxy version supports the keyword
+// of an enum value of a property of a resource
+func (v *SampleValidator) SupportsValue(resource, property, value string) bool {
+	return v.available(schemaValues[resource][property][value])
+}
+
+func (v *SampleValidator) available(a availability) bool {
+	if v == nil || v.Version == "" {
+		return true
+	}
+	if a.since != "" && !v.Supports(a.since) {
+		return false
+	}
+	return a.until == "" || compareVersions(v.Version, a.until) <= 0
+}
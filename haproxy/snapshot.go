@@ -0,0 +1,215 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"time"
+)
+
+const (
+	snapshotManifest      = "manifest.json"
+	snapshotConfiguration = "haproxy.cfg"
+	// maxSnapshotFileSize is the size beyond which a file of a snapshot is rejected
+	maxSnapshotFileSize = 256 << 20
+)
+
+// Snapshot is the state the API manages: the HAProxy configuration and the
+// files of the storage areas, the dataplane configuration file being stored
+// as the area dataplane. It is written as a gzipped tarball holding a manifest,
+// the configuration as haproxy.cfg and the files as <area>/<name>.
+type Snapshot struct {
+	// Version is the version of the configuration
+	Version       int64
+	Created       time.Time
+	Configuration string
+	// Files are the contents of the files by area and name
+	Files map[string]map[string][]byte
+}
+
+type snapshotManifestFile struct {
+	Version int64               `json:"version"`
+	Created int64               `json:"created"`
+	Files   map[string][]string `json:"files"`
+}
+
+// NewSnapshot returns a snapshot of the configuration of version
+func NewSnapshot(version int64, configuration string) *Snapshot {
+	return &Snapshot{
+		Version:       version,
+		Created:       time.Now(),
+		Configuration: configuration,
+		Files:         make(map[string]map[string][]byte),
+	}
+}
+
+// AddFile adds the file name of area to the snapshot
+func (s *Snapshot) AddFile(area, name string, content []byte) error {
+	if err := ValidStorageName(area); err != nil {
+		return err
+	}
+	if err := ValidStorageName(name); err != nil {
+		return err
+	}
+	if s.Files[area] == nil {
+		s.Files[area] = make(map[string][]byte)
+	}
+	s.Files[area][name] = content
+	return nil
+}
+
+// AddDir adds the files of the storage area dir to the snapshot as the area
+func (s *Snapshot) AddDir(area, dir string) error {
+	files, err := StorageFiles(dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		content, err := ioutil.ReadFile(f.Path)
+		if err != nil {
+			return err
+		}
+		if err := s.AddFile(area, f.Name, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Write writes the snapshot to w as a gzipped tarball
+func (s *Snapshot) Write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	manifest := snapshotManifestFile{Version: s.Version, Created: s.Created.Unix(), Files: make(map[string][]string)}
+	for _, area := range s.areas() {
+		manifest.Files[area] = s.names(area)
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	write := func(name string, content []byte) error {
+		h := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), ModTime: s.Created, Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(h); err != nil {
+			return err
+		}
+		_, err := tw.Write(content)
+		return err
+	}
+	if err := write(snapshotManifest, data); err != nil {
+		return err
+	}
+	if err := write(snapshotConfiguration, []byte(s.Configuration)); err != nil {
+		return err
+	}
+	for _, area := range s.areas() {
+		for _, name := range s.names(area) {
+			if err := write(path.Join(area, name), s.Files[area][name]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// ReadSnapshot reads a snapshot written by Snapshot.Write
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	s := &Snapshot{Files: make(map[string]map[string][]byte)}
+	var manifest *snapshotManifestFile
+	configuration := false
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot: %w", err)
+		}
+		if h.Typeflag == tar.TypeDir {
+			continue
+		}
+		if h.Typeflag != tar.TypeReg || h.Size > maxSnapshotFileSize {
+			return nil, fmt.Errorf("invalid snapshot: unexpected entry %s", h.Name)
+		}
+		var content bytes.Buffer
+		if _, err := io.Copy(&content, tr); err != nil {
+			return nil, fmt.Errorf("invalid snapshot: %w", err)
+		}
+		switch h.Name {
+		case snapshotManifest:
+			manifest = &snapshotManifestFile{}
+			if err := json.Unmarshal(content.Bytes(), manifest); err != nil {
+				return nil, fmt.Errorf("invalid snapshot manifest: %w", err)
+			}
+		case snapshotConfiguration:
+			s.Configuration = content.String()
+			configuration = true
+		default:
+			area, name := path.Split(h.Name)
+			if err := s.AddFile(path.Clean(area), name, content.Bytes()); err != nil {
+				return nil, fmt.Errorf("invalid snapshot entry %s: %w", h.Name, err)
+			}
+		}
+	}
+	if manifest == nil || !configuration {
+		return nil, fmt.Errorf("invalid snapshot: %s or %s missing", snapshotManifest, snapshotConfiguration)
+	}
+	for area, names := range manifest.Files {
+		for _, name := range names {
+			if _, ok := s.Files[area][name]; !ok {
+				return nil, fmt.Errorf("invalid snapshot: %s/%s listed in the manifest is missing", area, name)
+			}
+		}
+	}
+	s.Version = manifest.Version
+	s.Created = time.Unix(manifest.Created, 0)
+	return s, nil
+}
+
+func (s *Snapshot) areas() []string {
+	areas := make([]string, 0, len(s.Files))
+	for area := range s.Files {
+		areas = append(areas, area)
+	}
+	sort.Strings(areas)
+	return areas
+}
+
+func (s *Snapshot) names(area string) []string {
+	names := make([]string, 0, len(s.Files[area]))
+	for name := range s.Files[area] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
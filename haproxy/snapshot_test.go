@@ -0,0 +1,193 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type snapshotEntry struct {
+	name     string
+	content  string
+	typeflag byte
+}
+
+func snapshotTarball(t *testing.T, entries []snapshotEntry) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		typeflag := e.typeflag
+		if typeflag == 0 {
+			typeflag = tar.TypeReg
+		}
+		h := &tar.Header{Name: e.name, Mode: 0644, Typeflag: typeflag}
+		if typeflag == tar.TypeReg {
+			h.Size = int64(len(e.content))
+		}
+		if typeflag == tar.TypeSymlink {
+			h.Linkname = e.content
+		}
+		if err := tw.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if typeflag == tar.TypeReg {
+			if _, err := tw.Write([]byte(e.content)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadSnapshot(t *testing.T) {
+	manifest := `{"version": 3, "created": 1600000000, "files": {"maps": ["hosts.map"]}}`
+	tests := []struct {
+		name    string
+		entries []snapshotEntry
+		files   map[string]map[string][]byte
+		err     string
+	}{
+		{
+			"valid",
+			[]snapshotEntry{
+				{name: "manifest.json", content: manifest},
+				{name: "haproxy.cfg", content: "global\n"},
+				{name: "maps", typeflag: tar.TypeDir},
+				{name: "maps/hosts.map", content: "example.com app\n"},
+			},
+			map[string]map[string][]byte{"maps": {"hosts.map": []byte("example.com app\n")}},
+			"",
+		},
+		{
+			"missing manifest",
+			[]snapshotEntry{{name: "haproxy.cfg", content: "global\n"}},
+			nil,
+			"manifest.json or haproxy.cfg missing",
+		},
+		{
+			"missing configuration",
+			[]snapshotEntry{{name: "manifest.json", content: `{"version": 1}`}},
+			nil,
+			"manifest.json or haproxy.cfg missing",
+		},
+		{
+			"invalid manifest",
+			[]snapshotEntry{{name: "manifest.json", content: "{"}},
+			nil,
+			"invalid snapshot manifest",
+		},
+		{
+			"file listed in the manifest missing",
+			[]snapshotEntry{
+				{name: "manifest.json", content: manifest},
+				{name: "haproxy.cfg", content: "global\n"},
+			},
+			nil,
+			"maps/hosts.map listed in the manifest is missing",
+		},
+		{
+			"path traversal",
+			[]snapshotEntry{
+				{name: "manifest.json", content: `{"version": 1}`},
+				{name: "haproxy.cfg", content: "global\n"},
+				{name: "../etc/passwd", content: "root"},
+			},
+			nil,
+			"invalid snapshot entry ../etc/passwd",
+		},
+		{
+			"nested area",
+			[]snapshotEntry{
+				{name: "manifest.json", content: `{"version": 1}`},
+				{name: "haproxy.cfg", content: "global\n"},
+				{name: "maps/sub/hosts.map", content: "example.com app\n"},
+			},
+			nil,
+			"invalid snapshot entry maps/sub/hosts.map",
+		},
+		{
+			"symlink",
+			[]snapshotEntry{
+				{name: "manifest.json", content: `{"version": 1}`},
+				{name: "haproxy.cfg", content: "global\n"},
+				{name: "maps/hosts.map", content: "/etc/passwd", typeflag: tar.TypeSymlink},
+			},
+			nil,
+			"unexpected entry maps/hosts.map",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ReadSnapshot(bytes.NewReader(snapshotTarball(t, tt.entries)))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Version != 3 || s.Created.Unix() != 1600000000 || s.Configuration != "global\n" {
+				t.Fatalf("unexpected snapshot %+v", s)
+			}
+			if !reflect.DeepEqual(s.Files, tt.files) {
+				t.Fatalf("unexpected files %v", s.Files)
+			}
+		})
+	}
+}
+
+func TestReadSnapshotNotGzip(t *testing.T) {
+	if _, err := ReadSnapshot(strings.NewReader("global\n")); err == nil || !strings.Contains(err.Error(), "invalid snapshot") {
+		t.Fatalf("expected an invalid snapshot error, got %v", err)
+	}
+}
+
+func TestSnapshotWriteRead(t *testing.T) {
+	s := NewSnapshot(7, "global\n  daemon\n")
+	s.Created = time.Unix(1600000000, 0)
+	if err := s.AddFile("ssl", "site.pem", []byte("cert")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddFile("dataplane", "dataplaneapi.yaml", []byte("config_version: 1\n")); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := s.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(read, s) {
+		t.Fatalf("expected %+v, got %+v", s, read)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// SNI conflict policies, applied when a stored certificate claims a hostname of
+// another one, HAProxy then selecting either of them for that hostname
+const (
+	SNIConflictWarn   = "warn"
+	SNIConflictReject = "reject"
+)
+
+// SNIConflict is a stored certificate claiming hostnames of another one
+type SNIConflict struct {
+	File      string
+	Hostnames []string
+}
+
+// String returns the warning about the conflict
+func (c SNIConflict) String() string {
+	return fmt.Sprintf("certificate %s also claims %s", c.File, strings.Join(c.Hostnames, ", "))
+}
+
+// CertificateHostnames returns the hostnames HAProxy selects the first
+// certificate of the PEM data for, its DNS subject alternative names or its
+// common name when it has none. ok is false when data holds no certificate.
+func CertificateHostnames(data []byte) (hostnames []string, ok bool) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, false
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, false
+		}
+		names := cert.DNSNames
+		if len(names) == 0 && cert.Subject.CommonName != "" {
+			names = []string{cert.Subject.CommonName}
+		}
+		hostnames = make([]string, 0, len(names))
+		for _, n := range names {
+			hostnames = append(hostnames, strings.ToLower(n))
+		}
+		return hostnames, true
+	}
+}
+
+// FindSNIConflicts returns the certificates of the storage area dir, other
+// than the file name, claiming any of hostnames. Wildcard names only conflict
+// with the same wildcard, HAProxy preferring exact names over wildcards.
+func FindSNIConflicts(dir, name string, hostnames []string) ([]SNIConflict, error) {
+	claimed := make(map[string]bool, len(hostnames))
+	for _, h := range hostnames {
+		claimed[h] = true
+	}
+	files, err := StorageFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	conflicts := make([]SNIConflict, 0)
+	for _, f := range files {
+		if f.Name == name {
+			continue
+		}
+		data, err := ioutil.ReadFile(f.Path)
+		if err != nil {
+			return nil, err
+		}
+		names, ok := CertificateHostnames(data)
+		if !ok {
+			continue
+		}
+		c := SNIConflict{File: f.Name}
+		for _, n := range names {
+			if claimed[n] {
+				c.Hostnames = append(c.Hostnames, n)
+			}
+		}
+		if len(c.Hostnames) > 0 {
+			sort.Strings(c.Hostnames)
+			conflicts = append(conflicts, c)
+		}
+	}
+	return conflicts, nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sslCertDateLayout is the layout of the dates of show ssl cert
+const sslCertDateLayout = "Jan _2 15:04:05 2006 MST"
+
+var sslCertAlgorithmRegexp = regexp.MustCompile(`^(RSA|EC|DSA)(\d+)$`)
+
+// ErrSSLCertNotFound is returned when HAProxy has not loaded the requested certificate
+var ErrSSLCertNotFound = fmt.Errorf("certificate not found")
+
+// SSLCertificate is a certificate loaded by HAProxy as shown by the show ssl cert command
+type SSLCertificate struct {
+	File   string
+	Status string
+	Serial string
+	// Subject and Issuer are in the /C=../CN=.. form HAProxy prints
+	Subject   string
+	Issuer    string
+	SANs      []string
+	NotBefore time.Time
+	NotAfter  time.Time
+	// KeyType is RSA, EC, DSA or unknown
+	KeyType         string
+	KeyBits         int
+	SHA1Fingerprint string
+	Chain           []SSLChainCertificate
+}
+
+// SSLChainCertificate is an intermediate certificate sent along a certificate
+type SSLChainCertificate struct {
+	Subject string
+	Issuer  string
+}
+
+// ShowSSLCerts lists the certificate files loaded by the first HAProxy process,
+// leaving out the ones of the ongoing certificate transaction
+func ShowSSLCerts(rt RuntimeExecutor) ([]string, error) {
+	out, err := rt.ExecuteRaw("show ssl cert")
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0)
+	if len(out) == 0 {
+		return files, nil
+	}
+	if err := sslCertCommandError(out[0]); err != nil {
+		return nil, err
+	}
+	committed := false
+	for _, line := range strings.Split(out[0], "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#"):
+			committed = line == "# filename"
+		case committed:
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// ShowSSLCert returns the details of the certificate file loaded by the first
+// HAProxy process
+func ShowSSLCert(rt RuntimeExecutor, file string) (*SSLCertificate, error) {
+	out, err := rt.ExecuteRaw("show ssl cert " + file)
+	if err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, ErrSSLCertNotFound
+	}
+	if err := sslCertCommandError(out[0]); err != nil {
+		return nil, err
+	}
+	c := parseShowSSLCert(out[0])
+	if c.File == "" {
+		return nil, ErrSSLCertNotFound
+	}
+	return c, nil
+}
+
+func sslCertCommandError(out string) error {
+	switch {
+	case strings.Contains(out, "Unknown command"):
+		return fmt.Errorf("show ssl cert is not supported, HAProxy 2.2 or newer is required")
+	case strings.Contains(out, "Can't display the certificate"):
+		return ErrSSLCertNotFound
+	}
+	return nil
+}
+
+func parseShowSSLCert(out string) *SSLCertificate {
+	c := &SSLCertificate{SANs: []string{}, Chain: []SSLChainCertificate{}, KeyType: "unknown"}
+	for _, line := range strings.Split(out, "\n") {
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(kv[1])
+		switch strings.TrimSpace(kv[0]) {
+		case "Filename":
+			// a leading * marks the version of the ongoing transaction
+			c.File = strings.TrimPrefix(value, "*")
+		case "Status":
+			c.Status = value
+		case "Serial":
+			c.Serial = value
+		case "notBefore":
+			c.NotBefore, _ = time.Parse(sslCertDateLayout, value)
+		case "notAfter":
+			c.NotAfter, _ = time.Parse(sslCertDateLayout, value)
+		case "Subject Alternative Name":
+			for _, san := range strings.Split(value, ",") {
+				if san = strings.TrimSpace(san); san != "" {
+					c.SANs = append(c.SANs, san)
+				}
+			}
+		case "Algorithm":
+			if m := sslCertAlgorithmRegexp.FindStringSubmatch(value); m != nil {
+				c.KeyType = m[1]
+				c.KeyBits, _ = strconv.Atoi(m[2])
+			}
+		case "SHA1 FingerPrint":
+			c.SHA1Fingerprint = value
+		case "Subject":
+			c.Subject = value
+		case "Issuer":
+			c.Issuer = value
+		case "Chain Subject":
+			c.Chain = append(c.Chain, SSLChainCertificate{Subject: value})
+		case "Chain Issuer":
+			if len(c.Chain) > 0 {
+				c.Chain[len(c.Chain)-1].Issuer = value
+			}
+		}
+	}
+	return c
+}
+
+// ChainError returns why the certificate is not valid at now, empty when it
+// is within its validity period and each certificate of its chain is issued
+// by the next one, a certificate not self-signed requiring a chain
+func (c *SSLCertificate) ChainError(now time.Time) string {
+	if !c.NotBefore.IsZero() && now.Before(c.NotBefore) {
+		return fmt.Sprintf("certificate not valid before %s", c.NotBefore.Format(time.RFC3339))
+	}
+	if !c.NotAfter.IsZero() && now.After(c.NotAfter) {
+		return fmt.Sprintf("certificate expired on %s", c.NotAfter.Format(time.RFC3339))
+	}
+	if len(c.Chain) == 0 {
+		if c.Issuer != c.Subject {
+			return fmt.Sprintf("no chain certificate of issuer %s", c.Issuer)
+		}
+		return ""
+	}
+	issuer := c.Issuer
+	for i, cc := range c.Chain {
+		if cc.Subject != issuer {
+			return fmt.Sprintf("chain certificate %d is %s, expected issuer %s", i+1, cc.Subject, issuer)
+		}
+		issuer = cc.Issuer
+	}
+	return ""
+}
@@ -0,0 +1,181 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/renameio"
+	"github.com/haproxytech/dataplaneapi/system"
+)
+
+// StorageFile is a file of a storage area
+type StorageFile struct {
+	Name     string
+	Path     string
+	Size     int64
+	Modified int64
+}
+
+// StorageFiles returns the regular files of the storage area dir sorted by name,
+// a missing directory has no file
+func StorageFiles(dir string) ([]StorageFile, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []StorageFile{}, nil
+		}
+		return nil, err
+	}
+	files := make([]StorageFile, 0, len(infos))
+	for _, fi := range infos {
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+		files = append(files, StorageFile{
+			Name:     fi.Name(),
+			Path:     filepath.Join(dir, fi.Name()),
+			Size:     fi.Size(),
+			Modified: fi.ModTime().Unix(),
+		})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+// StorageFileInfo returns the file name of the storage area dir, the error
+// satisfies os.IsNotExist when it does not exist
+func StorageFileInfo(dir, name string) (StorageFile, error) {
+	path := filepath.Join(dir, name)
+	fi, err := os.Stat(path)
+	if err != nil {
+		return StorageFile{}, err
+	}
+	if !fi.Mode().IsRegular() {
+		return StorageFile{}, fmt.Errorf("%s is not a regular file", path)
+	}
+	return StorageFile{Name: name, Path: path, Size: fi.Size(), Modified: fi.ModTime().Unix()}, nil
+}
+
+// ValidStorageName returns an error when name cannot name a file of a storage
+// area, the files being stored in the directory of the area itself
+func ValidStorageName(name string) error {
+	if name == "" || strings.HasPrefix(name, ".") || strings.ContainsAny(name, "/\\") {
+		return fmt.Errorf("invalid file name %s, it cannot be empty, start with a dot nor contain path separators", name)
+	}
+	return nil
+}
+
+// StoreFile atomically writes the content of r to the file name of the
+// storage area dir, creating the directory when missing
+func StoreFile(dir, name string, r io.Reader) (StorageFile, error) {
+	if err := system.MkdirAll(dir, 0755); err != nil {
+		return StorageFile{}, err
+	}
+	// the temporary file is created in dir, never in the temporary directory of the system
+	t, err := renameio.TempFile(dir, filepath.Join(dir, name))
+	if err != nil {
+		return StorageFile{}, err
+	}
+	// nolint:errcheck
+	defer t.Cleanup()
+	if _, err := io.Copy(t, r); err != nil {
+		return StorageFile{}, err
+	}
+	if err := t.Chmod(system.Files.FileMode(0644)); err != nil {
+		return StorageFile{}, err
+	}
+	if err := t.CloseAtomicallyReplace(); err != nil {
+		return StorageFile{}, err
+	}
+	if err := system.SetLabel(filepath.Join(dir, name)); err != nil {
+		return StorageFile{}, err
+	}
+	return StorageFileInfo(dir, name)
+}
+
+// IsReferenced reports whether the configuration config references the file
+// name of a storage area, in its own directory or any other one. The name is
+// referenced when it appears in config as a file name, not as a part of one.
+func IsReferenced(config, name string) bool {
+	for i := strings.Index(config, name); i >= 0; {
+		end := i + len(name)
+		if (i == 0 || strings.ContainsRune("/ \t\"'(,=", rune(config[i-1]))) &&
+			(end == len(config) || !isFileNameChar(config[end])) {
+			return true
+		}
+		next := strings.Index(config[i+1:], name)
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return false
+}
+
+func isFileNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '.' || c == '_' || c == '-'
+}
+
+// StorageQuotaError is returned when storing a file exceeds a quota
+type StorageQuotaError struct {
+	msg string
+}
+
+// Error implementation for StorageQuotaError
+func (e *StorageQuotaError) Error() string {
+	return e.msg
+}
+
+// CheckStorageQuota returns a StorageQuotaError when storing the file path of
+// size bytes, replacing the file of the same name, exceeds the limits of its
+// storage area, a zero limit being no limit
+func CheckStorageQuota(path string, size int64, maxFiles int, maxFileSize, maxSize int64) error {
+	if maxFileSize > 0 && size > maxFileSize {
+		return &StorageQuotaError{msg: fmt.Sprintf("file %s of %d bytes exceeds the maximum file size of %d bytes", filepath.Base(path), size, maxFileSize)}
+	}
+	if maxFiles == 0 && maxSize == 0 {
+		return nil
+	}
+	files, err := StorageFiles(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	count := 1
+	total := size
+	for _, f := range files {
+		if f.Path == filepath.Clean(path) {
+			continue
+		}
+		count++
+		total += f.Size
+	}
+	if maxFiles > 0 && count > maxFiles {
+		return &StorageQuotaError{msg: fmt.Sprintf("storing %s exceeds the maximum of %d files", filepath.Base(path), maxFiles)}
+	}
+	if maxSize > 0 && total > maxSize {
+		return &StorageQuotaError{msg: fmt.Sprintf("storing %s exceeds the maximum size of %d bytes, %d bytes are used", filepath.Base(path), maxSize, total-size)}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const ringSocketTimeout = 5 * time.Second
+
+var traceSourceRegexp = regexp.MustCompile(`^\s*\[(.)\]\s+(\S+)\s+->\s+(\S+)\s+\[drp (\d+)\]\s+\[(.*)\]`)
+
+// ErrRingNotFound is returned when HAProxy does not know the requested ring buffer
+var ErrRingNotFound = fmt.Errorf("ring buffer not found")
+
+// TraceSource is a HAProxy trace source as listed by the show trace command
+type TraceSource struct {
+	Name        string
+	Description string
+	// State is one of stopped, waiting or running
+	State   string
+	Sink    string
+	Dropped int64
+}
+
+// ShowTrace lists the trace sources of the first HAProxy process
+func ShowTrace(rt RuntimeExecutor) ([]TraceSource, error) {
+	out, err := rt.ExecuteRaw("show trace")
+	if err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return []TraceSource{}, nil
+	}
+	return parseShowTrace(out[0]), nil
+}
+
+func parseShowTrace(out string) []TraceSource {
+	sources := make([]TraceSource, 0)
+	for _, line := range strings.Split(out, "\n") {
+		m := traceSourceRegexp.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		s := TraceSource{
+			Name:        m[2],
+			Sink:        m[3],
+			Description: m[5],
+		}
+		switch m[1] {
+		case "R":
+			s.State = "running"
+		case "w":
+			s.State = "waiting"
+		default:
+			s.State = "stopped"
+		}
+		s.Dropped, _ = strconv.ParseInt(m[4], 10, 64)
+		sources = append(sources, s)
+	}
+	return sources
+}
+
+// StreamRingEvents writes the events of a ring buffer to w using the show events
+// command. When follow is set new events are written as they arrive until
+// timeout expires, flush is called after every write so they reach the client
+// immediately. Commands on the master socket are sent to the first worker.
+func StreamRingEvents(socket string, master bool, ring string, follow bool, timeout time.Duration, w io.Writer, flush func()) error {
+	if socket == "" {
+		return fmt.Errorf("runtime API not configured")
+	}
+	conn, err := net.DialTimeout("unix", socket, ringSocketTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	cmd := "show events " + ring
+	if follow {
+		cmd += " -w"
+	} else {
+		timeout = ringSocketTimeout
+	}
+	if master {
+		cmd = "@1 " + cmd
+	}
+	// nolint:errcheck
+	conn.SetDeadline(time.Now().Add(timeout))
+	if _, err := conn.Write([]byte(cmd + "\n")); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 4096)
+	first := true
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			if first && strings.HasPrefix(string(buf[:n]), "No such event sink") {
+				return ErrRingNotFound
+			}
+			first = false
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			flush()
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if ne, ok := err.(net.Error); ok && ne.Timeout() && follow {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/haproxytech/dataplaneapi/system"
+	log "github.com/sirupsen/logrus"
+)
+
+// labelKey is the format of label keys, they are set as git trailers and
+// query filters
+var labelKey = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.\-/]*$`)
+
+// TransactionMetadata is the note and labels attached to a transaction
+type TransactionMetadata struct {
+	Note   string            `json:"note,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// ReloadID is the reload applying the committed transaction
+	ReloadID string `json:"reload_id,omitempty"`
+	// Expired is when the transaction expired, deleted by the transaction
+	// reaper, and Version the version it was started on
+	Expired *time.Time `json:"expired,omitempty"`
+	Version int64      `json:"version,omitempty"`
+	// Owner is the user who started the transaction
+	Owner string `json:"owner,omitempty"`
+	// Committed is the configuration version the commit of the transaction
+	// produced, and Sections the sections it changed
+	Committed int64    `json:"committed,omitempty"`
+	Sections  []string `json:"sections,omitempty"`
+}
+
+// TransactionMetadataStore keeps the notes and labels of transactions, one
+// file per transaction in its directory. Metadata is kept after the commit
+// for the reload records, until the retention expires.
+type TransactionMetadataStore struct {
+	mu        sync.RWMutex
+	dir       string
+	retention time.Duration
+	entries   map[string]TransactionMetadata
+}
+
+// NewTransactionMetadataStore returns a store in dir, loading the metadata
+// saved there and removing the one older than retention days
+func NewTransactionMetadataStore(dir string, retention int) (*TransactionMetadataStore, error) {
+	if err := system.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	s := &TransactionMetadataStore{
+		dir:       dir,
+		retention: time.Duration(retention) * 24 * time.Hour,
+		entries:   make(map[string]TransactionMetadata),
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		if s.retention > 0 && time.Since(f.ModTime()) > s.retention {
+			if err := os.Remove(path); err != nil {
+				log.Warning(err)
+			}
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var m TransactionMetadata
+		if err := json.Unmarshal(data, &m); err != nil {
+			log.Warningf("Ignoring invalid transaction metadata %s: %s", path, err.Error())
+			continue
+		}
+		s.entries[strings.TrimSuffix(f.Name(), ".json")] = m
+	}
+	return s, nil
+}
+
+// ValidateLabels checks label keys and values, values can not span lines
+func ValidateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if !labelKey.MatchString(k) {
+			return fmt.Errorf("invalid label key %q", k)
+		}
+		if strings.ContainsAny(v, "\r\n") {
+			return fmt.Errorf("value of label %s can not span lines", k)
+		}
+	}
+	return nil
+}
+
+// ParseLabels parses labels given as key=value
+func ParseLabels(labels []string) (map[string]string, error) {
+	m := make(map[string]string, len(labels))
+	for _, l := range labels {
+		kv := strings.SplitN(l, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid label %q, expected key=value", l)
+		}
+		m[kv[0]] = kv[1]
+	}
+	return m, ValidateLabels(m)
+}
+
+// Get returns the metadata of a transaction
+func (s *TransactionMetadataStore) Get(id string) (TransactionMetadata, bool) {
+	if s == nil {
+		return TransactionMetadata{}, false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	m, ok := s.entries[id]
+	return m, ok
+}
+
+// Set replaces the note and labels of a transaction, an empty note and no
+// labels removing its metadata
+func (s *TransactionMetadataStore) Set(id, note string, labels map[string]string) error {
+	if s == nil {
+		return fmt.Errorf("transaction metadata store is not set up")
+	}
+	if err := ValidateLabels(labels); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := s.entries[id]
+	m.Note = note
+	m.Labels = labels
+	if m.Note == "" && len(m.Labels) == 0 && m.ReloadID == "" && m.Expired == nil && m.Owner == "" && m.Committed == 0 {
+		return s.delete(id)
+	}
+	return s.save(id, m)
+}
+
+// SetOwner records the user who started a transaction
+func (s *TransactionMetadataStore) SetOwner(id, owner string) error {
+	if s == nil || owner == "" {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := s.entries[id]
+	m.Owner = owner
+	return s.save(id, m)
+}
+
+// SetCommitted records the configuration version the commit of a transaction
+// produced and the sections it changed
+func (s *TransactionMetadataStore) SetCommitted(id string, version int64, sections []string) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := s.entries[id]
+	m.Committed = version
+	m.Sections = sections
+	return s.save(id, m)
+}
+
+// CommittedAfter returns the IDs of the transactions whose commit produced a
+// configuration version newer than version, in the order of their commits
+func (s *TransactionMetadataStore) CommittedAfter(version int64) []string {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ids := make([]string, 0)
+	for id, m := range s.entries {
+		if m.Committed > version {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return s.entries[ids[i]].Committed < s.entries[ids[j]].Committed
+	})
+	return ids
+}
+
+// SetReload records the reload applying a committed transaction
+func (s *TransactionMetadataStore) SetReload(id, reloadID string) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.entries[id]
+	if !ok {
+		return nil
+	}
+	m.ReloadID = reloadID
+	return s.save(id, m)
+}
+
+// SetExpired records that the transaction started on version expired at t
+func (s *TransactionMetadataStore) SetExpired(id string, version int64, t time.Time) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := s.entries[id]
+	m.Expired = &t
+	m.Version = version
+	return s.save(id, m)
+}
+
+// Expired returns the IDs of the expired transactions, sorted
+func (s *TransactionMetadataStore) Expired() []string {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ids := make([]string, 0)
+	for id, m := range s.entries {
+		if m.Expired != nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Delete removes the metadata of a transaction
+func (s *TransactionMetadataStore) Delete(id string) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.delete(id)
+}
+
+// ForReload returns the IDs of the transactions applied by a reload, sorted
+func (s *TransactionMetadataStore) ForReload(reloadID string) []string {
+	if s == nil || reloadID == "" {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ids := make([]string, 0)
+	for id, m := range s.entries {
+		if m.ReloadID == reloadID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Matches reports whether a transaction has all the labels of selector
+func (m TransactionMetadata) Matches(selector map[string]string) bool {
+	for k, v := range selector {
+		if l, ok := m.Labels[k]; !ok || l != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *TransactionMetadataStore) save(id string, m TransactionMetadata) error {
+	path, err := s.path(id)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err := system.WriteFile(path, data, 0644); err != nil {
+		return err
+	}
+	s.entries[id] = m
+	return nil
+}
+
+func (s *TransactionMetadataStore) delete(id string) error {
+	path, err := s.path(id)
+	if err != nil {
+		return err
+	}
+	delete(s.entries, id)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *TransactionMetadataStore) path(id string) (string, error) {
+	if id == "" || filepath.Base(id) != id || strings.HasPrefix(id, ".") {
+		return "", fmt.Errorf("invalid transaction id %q", id)
+	}
+	return filepath.Join(s.dir, id+".json"), nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/haproxytech/models/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+// reaperMaxInterval is the maximum time between two checks of the transactions
+const reaperMaxInterval = time.Minute
+
+// TransactionReaper expires the transactions in progress which were not
+// changed for TTL, deleting their files and recording them as expired in the
+// transaction metadata, so that abandoned transactions do not accumulate in
+// the transaction directory
+type TransactionReaper struct {
+	TTL time.Duration
+	// Dir is the transaction directory, the files of the transactions being
+	// named after ConfigFile
+	Dir        string
+	ConfigFile string
+	// Transactions returns the transactions with a status, such as
+	// in_progress, and Delete deletes a transaction
+	Transactions func(status string) (*models.Transactions, error)
+	Delete       func(id string) error
+	Metadata     *TransactionMetadataStore
+}
+
+// Start checks the transactions periodically, every tenth of TTL up to a minute
+func (r *TransactionReaper) Start() {
+	if r.TTL <= 0 {
+		return
+	}
+	interval := r.TTL / 10
+	if interval > reaperMaxInterval {
+		interval = reaperMaxInterval
+	}
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	for range ticker.C {
+		r.Reap(time.Now())
+	}
+}
+
+// Reap expires the transactions in progress whose file was not changed for TTL
+// at now, returning their IDs
+func (r *TransactionReaper) Reap(now time.Time) []string {
+	ts, err := r.Transactions("in_progress")
+	if err != nil {
+		log.Warningf("Cannot list the transactions to expire: %s", err.Error())
+		return nil
+	}
+	expired := make([]string, 0)
+	for _, t := range *ts {
+		if t.Status != "in_progress" {
+			continue
+		}
+		// the file of a transaction is written on each of its changes
+		info, err := os.Stat(filepath.Join(r.Dir, filepath.Base(filepath.Clean(r.ConfigFile))+"."+t.ID))
+		if err != nil || now.Sub(info.ModTime()) < r.TTL {
+			continue
+		}
+		if err := r.Delete(t.ID); err != nil {
+			log.Warningf("Cannot delete expired transaction %s: %s", t.ID, err.Error())
+			continue
+		}
+		if err := r.Metadata.SetExpired(t.ID, t.Version, now); err != nil {
+			log.Warningf("Cannot record expired transaction %s: %s", t.ID, err.Error())
+		}
+		log.Infof("Transaction %s expired after %s without changes", t.ID, r.TTL)
+		expired = append(expired, t.ID)
+	}
+	return expired
+}
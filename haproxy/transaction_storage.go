@@ -0,0 +1,252 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/haproxytech/dataplaneapi/system"
+)
+
+// TransactionStorage stages the changes of the files of the storage areas made
+// in transactions, so that a configuration and the files it references change
+// together: the files are installed when the transaction is committed and
+// discarded with it. The changes of a transaction are kept in the directory
+// named after it, the content of the written files in <area>/write and empty
+// files marking the deleted ones in <area>/delete.
+type TransactionStorage struct {
+	mu  sync.Mutex
+	dir string
+	// areas are the directories of the storage areas by name
+	areas map[string]string
+}
+
+// StagedFile is a file of a storage area changed in a transaction
+type StagedFile struct {
+	Area    string
+	Name    string
+	Deleted bool
+	Size    int64
+}
+
+// NewTransactionStorage returns a store staging the changes in dir of the files
+// of areas, the directories of the storage areas by name
+func NewTransactionStorage(dir string, areas map[string]string) (*TransactionStorage, error) {
+	if err := system.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &TransactionStorage{dir: dir, areas: areas}, nil
+}
+
+// Path returns the path of the file name of area once installed
+func (s *TransactionStorage) Path(area, name string) (string, error) {
+	dir, ok := s.areas[area]
+	if !ok || dir == "" {
+		return "", fmt.Errorf("storage area %s not configured", area)
+	}
+	if err := ValidStorageName(name); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// Stage records content as the new content of the file name of area in the
+// transaction id
+func (s *TransactionStorage) Stage(id, area, name string, content []byte) (StagedFile, error) {
+	if _, err := s.Path(area, name); err != nil {
+		return StagedFile{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := removeIfExists(filepath.Join(s.dir, id, area, "delete", name)); err != nil {
+		return StagedFile{}, err
+	}
+	f, err := StoreFile(filepath.Join(s.dir, id, area, "write"), name, bytes.NewReader(content))
+	if err != nil {
+		return StagedFile{}, err
+	}
+	return StagedFile{Area: area, Name: name, Size: f.Size}, nil
+}
+
+// StageDelete records the deletion of the file name of area in the transaction id
+func (s *TransactionStorage) StageDelete(id, area, name string) error {
+	if _, err := s.Path(area, name); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := removeIfExists(filepath.Join(s.dir, id, area, "write", name)); err != nil {
+		return err
+	}
+	_, err := StoreFile(filepath.Join(s.dir, id, area, "delete"), name, bytes.NewReader(nil))
+	return err
+}
+
+// Exists reports whether the file name of area exists as seen by the
+// transaction id, its staged changes applied
+func (s *TransactionStorage) Exists(id, area, name string) bool {
+	path, err := s.Path(area, name)
+	if err != nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := StorageFileInfo(filepath.Join(s.dir, id, area, "write"), name); err == nil {
+		return true
+	}
+	if _, err := StorageFileInfo(filepath.Join(s.dir, id, area, "delete"), name); err == nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// Staged returns the files changed in the transaction id, sorted by area and name
+func (s *TransactionStorage) Staged(id string) ([]StagedFile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.staged(id)
+}
+
+func (s *TransactionStorage) staged(id string) ([]StagedFile, error) {
+	files := make([]StagedFile, 0)
+	for area := range s.areas {
+		written, err := StorageFiles(filepath.Join(s.dir, id, area, "write"))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range written {
+			files = append(files, StagedFile{Area: area, Name: f.Name, Size: f.Size})
+		}
+		deleted, err := StorageFiles(filepath.Join(s.dir, id, area, "delete"))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range deleted {
+			files = append(files, StagedFile{Area: area, Name: f.Name, Deleted: true})
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].Area != files[j].Area {
+			return files[i].Area < files[j].Area
+		}
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+// Install installs the files staged in the transaction id in their storage
+// areas, keeping a copy of the files it replaces or deletes. It returns the
+// function restoring them, to call when the commit of the transaction fails.
+func (s *TransactionStorage) Install(id string) (func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, err := s.staged(id)
+	if err != nil {
+		return nil, err
+	}
+	backupDir := filepath.Join(s.dir, id, "backup")
+	if err := os.RemoveAll(backupDir); err != nil {
+		return nil, err
+	}
+	installed := make([]StagedFile, 0, len(files))
+	restore := func() {
+		for i := len(installed) - 1; i >= 0; i-- {
+			f := installed[i]
+			if err := s.restore(backupDir, f); err != nil {
+				log.Warningf("Cannot restore %s file %s of transaction %s: %s", f.Area, f.Name, id, err.Error())
+			}
+		}
+	}
+	for _, f := range files {
+		path, err := s.Path(f.Area, f.Name)
+		if err == nil {
+			err = backupFile(path, filepath.Join(backupDir, f.Area), f.Name)
+		}
+		if err != nil {
+			restore()
+			return nil, err
+		}
+		installed = append(installed, f)
+		if f.Deleted {
+			err = removeIfExists(path)
+		} else {
+			var content []byte
+			content, err = ioutil.ReadFile(filepath.Join(s.dir, id, f.Area, "write", f.Name))
+			if err == nil {
+				_, err = StoreFile(filepath.Dir(path), f.Name, bytes.NewReader(content))
+			}
+		}
+		if err != nil {
+			restore()
+			return nil, err
+		}
+	}
+	return restore, nil
+}
+
+// restore puts back the file of area replaced or deleted by an install, or
+// removes it when it did not exist before
+func (s *TransactionStorage) restore(backupDir string, f StagedFile) error {
+	path, err := s.Path(f.Area, f.Name)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(filepath.Join(backupDir, f.Area, f.Name))
+	if os.IsNotExist(err) {
+		return removeIfExists(path)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = StoreFile(filepath.Dir(path), f.Name, bytes.NewReader(content))
+	return err
+}
+
+// Discard deletes the files staged in the transaction id
+func (s *TransactionStorage) Discard(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return os.RemoveAll(filepath.Join(s.dir, id))
+}
+
+// backupFile copies the file path, when it exists, as the file name of dir
+func backupFile(path, dir, name string) error {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = StoreFile(dir, name, bytes.NewReader(content))
+	return err
+}
+
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Server warm-up statuses
+const (
+	WarmupInProgress = "in_progress"
+	WarmupDone       = "done"
+	WarmupCancelled  = "cancelled"
+	WarmupFailed     = "failed"
+)
+
+// ErrWarmupNoRuntime is returned when the runtime API is not configured
+var ErrWarmupNoRuntime = errors.New("runtime API not configured")
+
+// WarmupRuntime is the part of the runtime API client used to warm up servers
+type WarmupRuntime interface {
+	SetServerWeight(backend, server string, weight string) error
+}
+
+// ServerWarmup is the progress of the weight ramp-up of a server
+type ServerWarmup struct {
+	Backend       string
+	Server        string
+	StartPercent  int64
+	Duration      time.Duration
+	Interval      time.Duration
+	Status        string
+	Error         string
+	WeightPercent int64
+	Started       time.Time
+	Finished      time.Time
+
+	cancel chan struct{}
+}
+
+// WarmupScheduler raises the weight of servers gradually, from a percentage of
+// their configured weight to 100%, with runtime API set weight calls
+type WarmupScheduler struct {
+	// Runtime returns the runtime API client, nil when not configured
+	Runtime func() WarmupRuntime
+
+	mu      sync.Mutex
+	warmups map[string]*ServerWarmup
+}
+
+// Start sets the weight of the server to startPercent and ramps it up to 100%
+// over duration in the background, cancelling a running warm-up of the server
+func (w *WarmupScheduler) Start(backend, server string, startPercent int64, duration, interval time.Duration) (ServerWarmup, error) {
+	var rt WarmupRuntime
+	if w.Runtime != nil {
+		rt = w.Runtime()
+	}
+	if rt == nil {
+		return ServerWarmup{}, ErrWarmupNoRuntime
+	}
+	if err := rt.SetServerWeight(backend, server, fmt.Sprintf("%d%%", startPercent)); err != nil {
+		return ServerWarmup{}, err
+	}
+
+	w.mu.Lock()
+	if w.warmups == nil {
+		w.warmups = make(map[string]*ServerWarmup)
+	}
+	key := backend + "/" + server
+	if old, ok := w.warmups[key]; ok && old.Status == WarmupInProgress {
+		w.stop(old, WarmupCancelled, "replaced by a new warm-up")
+	}
+	warmup := &ServerWarmup{
+		Backend:       backend,
+		Server:        server,
+		StartPercent:  startPercent,
+		Duration:      duration,
+		Interval:      interval,
+		Status:        WarmupInProgress,
+		WeightPercent: startPercent,
+		Started:       time.Now(),
+		cancel:        make(chan struct{}),
+	}
+	w.warmups[key] = warmup
+	w.mu.Unlock()
+
+	go w.ramp(warmup, rt)
+	return w.Get(backend, server), nil
+}
+
+// Get returns the running or last warm-up of the server, with an empty Status if
+// the server was never warmed up
+func (w *WarmupScheduler) Get(backend, server string) ServerWarmup {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if warmup, ok := w.warmups[backend+"/"+server]; ok {
+		return *warmup
+	}
+	return ServerWarmup{}
+}
+
+// Cancel stops the running warm-up of the server, leaving its current weight,
+// and returns false if no warm-up is running
+func (w *WarmupScheduler) Cancel(backend, server string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	warmup, ok := w.warmups[backend+"/"+server]
+	if !ok || warmup.Status != WarmupInProgress {
+		return false
+	}
+	w.stop(warmup, WarmupCancelled, "")
+	return true
+}
+
+// stop finishes a running warm-up, w.mu must be held
+func (w *WarmupScheduler) stop(warmup *ServerWarmup, status, errMsg string) {
+	warmup.Status = status
+	warmup.Error = errMsg
+	warmup.Finished = time.Now()
+	close(warmup.cancel)
+}
+
+func (w *WarmupScheduler) ramp(warmup *ServerWarmup, rt WarmupRuntime) {
+	ticker := time.NewTicker(warmup.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-warmup.cancel:
+			return
+		case <-ticker.C:
+		}
+
+		elapsed := time.Since(warmup.Started)
+		percent := int64(100)
+		if elapsed < warmup.Duration {
+			percent = warmup.StartPercent + (100-warmup.StartPercent)*int64(elapsed)/int64(warmup.Duration)
+		}
+		err := rt.SetServerWeight(warmup.Backend, warmup.Server, fmt.Sprintf("%d%%", percent))
+
+		w.mu.Lock()
+		if warmup.Status != WarmupInProgress {
+			// cancelled while setting the weight
+			w.mu.Unlock()
+			return
+		}
+		if err != nil {
+			w.stop(warmup, WarmupFailed, err.Error())
+			w.mu.Unlock()
+			log.Warningf("Warm-up of server %s/%s failed: %s", warmup.Backend, warmup.Server, err.Error())
+			return
+		}
+		warmup.WeightPercent = percent
+		if percent == 100 {
+			w.stop(warmup, WarmupDone, "")
+			w.mu.Unlock()
+			log.Infof("Warm-up of server %s/%s done", warmup.Backend, warmup.Server)
+			return
+		}
+		w.mu.Unlock()
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package haproxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// weightBatchSize is the maximum length of the set weight commands pipelined
+// in one runtime API call
+const weightBatchSize = 16384
+
+// WeightChange is a weight set to a server through the runtime API
+type WeightChange struct {
+	Backend string
+	Server  string
+	// Weight is an absolute weight, or a percentage of the configured weight
+	// of the server followed by %
+	Weight string
+	// Error is set when the weight could not be set
+	Error string
+}
+
+func (c WeightChange) command() string {
+	return fmt.Sprintf("set weight %s/%s %s", c.Backend, c.Server, c.Weight)
+}
+
+// SetWeights sets the weights of servers with set weight commands pipelined
+// in batches, workerPrefix being prepended to the commands after the first one
+// of a batch as for GeoIPUpdater. set weight answers nothing on success, the
+// commands of a batch with an output are sent again one by one to tell which
+// changes failed, setting a weight twice being harmless.
+func SetWeights(rt RuntimeExecutor, workerPrefix string, changes []WeightChange) {
+	start := 0
+	size := 0
+	for i, c := range changes {
+		l := len(c.command()) + len(workerPrefix) + 1
+		if size+l > weightBatchSize && i > start {
+			setWeightsBatch(rt, workerPrefix, changes[start:i])
+			start = i
+			size = 0
+		}
+		size += l
+	}
+	if start < len(changes) {
+		setWeightsBatch(rt, workerPrefix, changes[start:])
+	}
+}
+
+func setWeightsBatch(rt RuntimeExecutor, workerPrefix string, changes []WeightChange) {
+	commands := make([]string, 0, len(changes))
+	for _, c := range changes {
+		commands = append(commands, c.command())
+	}
+	out, err := rt.ExecuteRaw(strings.Join(commands, ";"+workerPrefix))
+	if err != nil {
+		for i := range changes {
+			changes[i].Error = err.Error()
+		}
+		return
+	}
+	if weightOutput(out) == "" {
+		return
+	}
+	if len(changes) == 1 {
+		changes[0].Error = weightOutput(out)
+		return
+	}
+	for i, c := range changes {
+		out, err := rt.ExecuteRaw(c.command())
+		if err != nil {
+			changes[i].Error = err.Error()
+			continue
+		}
+		changes[i].Error = weightOutput(out)
+	}
+}
+
+// weightOutput returns the error reported by the processes to set weight
+func weightOutput(out []string) string {
+	for _, o := range out {
+		if m := runtimeErrorRegexp.FindStringSubmatch(o); m != nil {
+			return strings.TrimSpace(m[1])
+		}
+		if o = strings.TrimSpace(o); o != "" {
+			return o
+		}
+	}
+	return ""
+}
@@ -0,0 +1,170 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/haproxytech/dataplaneapi/configuration"
+)
+
+const (
+	// TypeValidator hooks can reject a transaction before it is committed
+	TypeValidator = "validator"
+	// TypeMutator hooks can change the configuration of a transaction before it is committed
+	TypeMutator = "mutator"
+	// TypeNotifier hooks are told about committed transactions
+	TypeNotifier = "notifier"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// CommitEvent describes a committed transaction to notifiers
+type CommitEvent struct {
+	Event         string `json:"event"`
+	TransactionID string `json:"transaction_id"`
+	Version       int64  `json:"version"`
+	ReloadID      string `json:"reload_id,omitempty"`
+	Time          int64  `json:"time"`
+}
+
+// Runner runs the hooks configured in the dataplane configuration file
+type Runner struct {
+	hooks []configuration.Hook
+}
+
+// NewRunner returns a runner for hooks, ignoring the ones with an unknown type
+// or without a command
+func NewRunner(hooks []configuration.Hook) *Runner {
+	r := &Runner{hooks: make([]configuration.Hook, 0, len(hooks))}
+	for _, h := range hooks {
+		switch {
+		case h.Command == "":
+			log.Warningf("Hook %s has no command, ignoring it", h.Name)
+		case h.Type != TypeValidator && h.Type != TypeMutator && h.Type != TypeNotifier:
+			log.Warningf("Hook %s has unknown type %s, ignoring it", h.Name, h.Type)
+		default:
+			r.hooks = append(r.hooks, h)
+		}
+	}
+	return r
+}
+
+// BeforeCommit runs the mutators and then the validators on the configuration
+// of a transaction. It returns the configuration to commit, or an error when a
+// hook failed or rejected it, or a mutator returned an empty configuration.
+func (r *Runner) BeforeCommit(transactionID, config string) (string, error) {
+	if r == nil {
+		return config, nil
+	}
+	for _, h := range r.byType(TypeMutator) {
+		out, err := run(h, transactionID, config)
+		if err != nil {
+			return "", err
+		}
+		// an empty configuration would delete every section of the transaction
+		if strings.TrimSpace(out) == "" {
+			return "", fmt.Errorf("hook %s returned an empty configuration", h.Name)
+		}
+		config = out
+	}
+	for _, h := range r.byType(TypeValidator) {
+		if _, err := run(h, transactionID, config); err != nil {
+			return "", err
+		}
+	}
+	return config, nil
+}
+
+// HasBeforeCommit reports whether there are hooks to run before a commit
+func (r *Runner) HasBeforeCommit() bool {
+	return r != nil && len(r.byType(TypeMutator))+len(r.byType(TypeValidator)) > 0
+}
+
+// Notify runs the notifiers in the background, failures are only logged
+func (r *Runner) Notify(e CommitEvent) {
+	if r == nil {
+		return
+	}
+	notifiers := r.byType(TypeNotifier)
+	if len(notifiers) == 0 {
+		return
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		log.Warning("Error encoding hook event: " + err.Error())
+		return
+	}
+	go func() {
+		for _, h := range notifiers {
+			if _, err := run(h, e.TransactionID, string(data)); err != nil {
+				log.Warning(err.Error())
+			}
+		}
+	}()
+}
+
+func (r *Runner) byType(t string) []configuration.Hook {
+	hooks := make([]configuration.Hook, 0)
+	for _, h := range r.hooks {
+		if h.Type == t {
+			hooks = append(hooks, h)
+		}
+	}
+	return hooks
+}
+
+// run executes a hook with input on its standard input and returns its
+// standard output
+func run(h configuration.Hook, transactionID, input string) (string, error) {
+	timeout := defaultTimeout
+	if h.Timeout > 0 {
+		timeout = time.Duration(h.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// nolint:gosec
+	cmd := exec.CommandContext(ctx, h.Command, h.Args...)
+	cmd.Env = append(os.Environ(), "DATAPLANEAPI_HOOK="+h.Name, "DATAPLANEAPI_HOOK_TYPE="+h.Type, "DATAPLANEAPI_TRANSACTION_ID="+transactionID)
+	cmd.Stdin = strings.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("hook %s timed out after %s", h.Name, timeout)
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		if h.Type == TypeValidator {
+			return "", fmt.Errorf("hook %s rejected the transaction: %s", h.Name, msg)
+		}
+		return "", fmt.Errorf("hook %s failed: %s", h.Name, msg)
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,471 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package importer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/haproxytech/models/v2"
+	"gopkg.in/yaml.v2"
+
+	"github.com/haproxytech/dataplaneapi/misc"
+)
+
+// Subset of the Envoy bootstrap configuration, in its v3 and v2 forms, the
+// converter reads. YAML being a superset of JSON, both encodings are accepted.
+
+type envoyBootstrap struct {
+	Admin            interface{} `yaml:"admin"`
+	DynamicResources interface{} `yaml:"dynamic_resources"`
+	StaticResources  struct {
+		Listeners []envoyListener `yaml:"listeners"`
+		Clusters  []envoyCluster  `yaml:"clusters"`
+	} `yaml:"static_resources"`
+}
+
+type envoyAddress struct {
+	SocketAddress *struct {
+		Address   string `yaml:"address"`
+		PortValue int64  `yaml:"port_value"`
+	} `yaml:"socket_address"`
+}
+
+type envoyListener struct {
+	Name         string             `yaml:"name"`
+	Address      envoyAddress       `yaml:"address"`
+	FilterChains []envoyFilterChain `yaml:"filter_chains"`
+}
+
+type envoyFilterChain struct {
+	Filters         []envoyFilter         `yaml:"filters"`
+	TransportSocket *envoyTransportSocket `yaml:"transport_socket"`
+	TLSContext      *envoyTLSContext      `yaml:"tls_context"`
+}
+
+type envoyTransportSocket struct {
+	Name        string          `yaml:"name"`
+	TypedConfig envoyTLSContext `yaml:"typed_config"`
+}
+
+type envoyDataSource struct {
+	Filename string `yaml:"filename"`
+}
+
+type envoyTLSContext struct {
+	CommonTLSContext struct {
+		TLSCertificates []struct {
+			CertificateChain envoyDataSource `yaml:"certificate_chain"`
+			PrivateKey       envoyDataSource `yaml:"private_key"`
+		} `yaml:"tls_certificates"`
+		AlpnProtocols []string `yaml:"alpn_protocols"`
+	} `yaml:"common_tls_context"`
+}
+
+type envoyFilter struct {
+	Name        string            `yaml:"name"`
+	TypedConfig envoyFilterConfig `yaml:"typed_config"`
+	Config      envoyFilterConfig `yaml:"config"`
+}
+
+type envoyFilterConfig struct {
+	RouteConfig *struct {
+		VirtualHosts []envoyVirtualHost `yaml:"virtual_hosts"`
+	} `yaml:"route_config"`
+	Rds         interface{} `yaml:"rds"`
+	HTTPFilters []struct {
+		Name string `yaml:"name"`
+	} `yaml:"http_filters"`
+	// upstream cluster of tcp_proxy
+	Cluster string `yaml:"cluster"`
+}
+
+type envoyVirtualHost struct {
+	Name       string       `yaml:"name"`
+	Domains    []string     `yaml:"domains"`
+	Routes     []envoyRoute `yaml:"routes"`
+	RequireTLS string       `yaml:"require_tls"`
+}
+
+type envoyRoute struct {
+	Match struct {
+		Prefix    *string `yaml:"prefix"`
+		Path      *string `yaml:"path"`
+		Regex     *string `yaml:"regex"`
+		SafeRegex *struct {
+			Regex string `yaml:"regex"`
+		} `yaml:"safe_regex"`
+		CaseSensitive   *bool         `yaml:"case_sensitive"`
+		Headers         []interface{} `yaml:"headers"`
+		QueryParameters []interface{} `yaml:"query_parameters"`
+	} `yaml:"match"`
+	Route *struct {
+		Cluster          string      `yaml:"cluster"`
+		WeightedClusters interface{} `yaml:"weighted_clusters"`
+		ClusterHeader    string      `yaml:"cluster_header"`
+		PrefixRewrite    string      `yaml:"prefix_rewrite"`
+		Timeout          string      `yaml:"timeout"`
+	} `yaml:"route"`
+	Redirect *struct {
+		HTTPSRedirect bool   `yaml:"https_redirect"`
+		ResponseCode  string `yaml:"response_code"`
+	} `yaml:"redirect"`
+	DirectResponse *struct {
+		Status int64 `yaml:"status"`
+	} `yaml:"direct_response"`
+}
+
+type envoyCluster struct {
+	Name           string `yaml:"name"`
+	Type           string `yaml:"type"`
+	ConnectTimeout string `yaml:"connect_timeout"`
+	LbPolicy       string `yaml:"lb_policy"`
+	LoadAssignment struct {
+		Endpoints []struct {
+			Priority    int64 `yaml:"priority"`
+			LbEndpoints []struct {
+				Endpoint struct {
+					Address envoyAddress `yaml:"address"`
+				} `yaml:"endpoint"`
+				LoadBalancingWeight int64 `yaml:"load_balancing_weight"`
+			} `yaml:"lb_endpoints"`
+		} `yaml:"endpoints"`
+	} `yaml:"load_assignment"`
+	Hosts            []envoyAddress        `yaml:"hosts"`
+	HealthChecks     []interface{}         `yaml:"health_checks"`
+	TransportSocket  *envoyTransportSocket `yaml:"transport_socket"`
+	TLSContext       *envoyTLSContext      `yaml:"tls_context"`
+	CircuitBreakers  interface{}           `yaml:"circuit_breakers"`
+	OutlierDetection interface{}           `yaml:"outlier_detection"`
+}
+
+var envoyBalanceAlgorithms = map[string]string{
+	"":              "roundrobin",
+	"ROUND_ROBIN":   "roundrobin",
+	"LEAST_REQUEST": "leastconn",
+	"RANDOM":        "random",
+	"RING_HASH":     "source",
+	"MAGLEV":        "source",
+}
+
+var envoyRedirectCodes = map[string]int64{
+	"":                   301,
+	"MOVED_PERMANENTLY":  301,
+	"FOUND":              302,
+	"SEE_OTHER":          303,
+	"TEMPORARY_REDIRECT": 307,
+	"PERMANENT_REDIRECT": 308,
+}
+
+type envoyConverter struct {
+	c *Configuration
+	// backends of the clusters
+	clusters map[string]string
+}
+
+func convertEnvoy(data string) (*Configuration, error) {
+	var bootstrap envoyBootstrap
+	if err := yaml.Unmarshal([]byte(data), &bootstrap); err != nil {
+		return nil, err
+	}
+	e := &envoyConverter{c: newConfiguration(), clusters: make(map[string]string)}
+	if bootstrap.Admin != nil {
+		e.c.issue(0, "admin", "the admin interface is not converted, use the stats page of HAProxy")
+	}
+	if bootstrap.DynamicResources != nil {
+		e.c.issue(0, "dynamic_resources", "dynamic resources are not converted")
+	}
+	for _, cluster := range bootstrap.StaticResources.Clusters {
+		e.cluster(cluster)
+	}
+	for _, l := range bootstrap.StaticResources.Listeners {
+		e.listener(l)
+	}
+	return e.c, nil
+}
+
+func (e *envoyConverter) cluster(cluster envoyCluster) {
+	directive := "cluster " + cluster.Name
+	b := e.c.addBackend(cluster.Name, "http")
+	e.clusters[cluster.Name] = b.Backend.Name
+	algorithm, ok := envoyBalanceAlgorithms[cluster.LbPolicy]
+	if !ok {
+		algorithm = "roundrobin"
+		e.c.issue(0, directive, "lb_policy %s is not converted", cluster.LbPolicy)
+	}
+	if algorithm == "source" {
+		e.c.issue(0, directive, "lb_policy %s is converted to a hash of the client address, the hash policy of the routes is not converted", cluster.LbPolicy)
+	}
+	b.Backend.Balance.Algorithm = misc.StringP(algorithm)
+	b.Backend.ConnectTimeout = envoyDuration(cluster.ConnectTimeout)
+
+	for _, locality := range cluster.LoadAssignment.Endpoints {
+		for _, endpoint := range locality.LbEndpoints {
+			s := e.server(b, directive, endpoint.Endpoint.Address)
+			if s == nil {
+				continue
+			}
+			if endpoint.LoadBalancingWeight != 0 {
+				s.Weight = misc.Int64P(int(endpoint.LoadBalancingWeight))
+			}
+			if locality.Priority > 0 {
+				s.Backup = "enabled"
+			}
+		}
+	}
+	for _, host := range cluster.Hosts {
+		e.server(b, directive, host)
+	}
+	if len(b.Servers) == 0 {
+		e.c.issue(0, directive, "cluster of type %s has no static endpoints, add the servers of %s", cluster.Type, b.Backend.Name)
+	}
+	if len(cluster.HealthChecks) > 0 {
+		for _, s := range b.Servers {
+			s.Check = "enabled"
+		}
+		e.c.issue(0, directive, "health checks are enabled, their parameters are not converted")
+	}
+	if cluster.TransportSocket != nil || cluster.TLSContext != nil {
+		for _, s := range b.Servers {
+			s.Ssl = "enabled"
+			s.Verify = "none"
+		}
+		e.c.issue(0, directive, "the certificates of the servers of %s are not verified", b.Backend.Name)
+	}
+	if cluster.CircuitBreakers != nil {
+		e.c.issue(0, directive, "circuit breakers are not converted, use the maxconn and maxqueue server parameters")
+	}
+	if cluster.OutlierDetection != nil {
+		e.c.issue(0, directive, "outlier detection is not converted, use health checks with observe layer7")
+	}
+}
+
+func (e *envoyConverter) server(b *Backend, directive string, address envoyAddress) *models.Server {
+	if address.SocketAddress == nil {
+		e.c.issue(0, directive, "only socket addresses are converted")
+		return nil
+	}
+	return b.addServer(address.SocketAddress.Address, address.SocketAddress.PortValue)
+}
+
+func (e *envoyConverter) listener(el envoyListener) {
+	directive := "listener " + el.Name
+	if el.Address.SocketAddress == nil {
+		e.c.issue(0, directive, "only socket addresses are converted")
+		return
+	}
+	if len(el.FilterChains) == 0 {
+		e.c.issue(0, directive, "listener without filter chains is not converted")
+		return
+	}
+	if len(el.FilterChains) > 1 {
+		e.c.issue(0, directive, "only the first filter chain is converted")
+	}
+	base := el.Name
+	if base == "" {
+		base = fmt.Sprintf("listener_%d", el.Address.SocketAddress.PortValue)
+	}
+	l := &listener{
+		base:    base,
+		address: el.Address.SocketAddress.Address,
+		port:    el.Address.SocketAddress.PortValue,
+		routes:  make([]*route, 0),
+	}
+	chain := el.FilterChains[0]
+	tls := chain.TLSContext
+	if chain.TransportSocket != nil {
+		tls = &chain.TransportSocket.TypedConfig
+	}
+	if tls != nil {
+		l.ssl = true
+		certificates := tls.CommonTLSContext.TLSCertificates
+		if len(certificates) == 0 || certificates[0].CertificateChain.Filename == "" {
+			e.c.issue(0, directive, "only certificates read from files are converted, set the certificate of the bind")
+		} else {
+			l.certificate = certificates[0].CertificateChain.Filename
+			if key := certificates[0].PrivateKey.Filename; key != "" && key != l.certificate {
+				e.c.issue(0, directive, "HAProxy loads the certificate and its key from one PEM file, concatenate %s and %s", l.certificate, key)
+			}
+			if len(certificates) > 1 {
+				e.c.issue(0, directive, "only the certificate %s is used, put the certificates in a directory for HAProxy to select them by SNI", l.certificate)
+			}
+		}
+		l.alpn = strings.Join(tls.CommonTLSContext.AlpnProtocols, ",")
+	}
+
+	for _, f := range chain.Filters {
+		config := f.TypedConfig
+		if config.RouteConfig == nil && config.Cluster == "" && config.Rds == nil {
+			config = f.Config
+		}
+		switch f.Name {
+		case "envoy.filters.network.http_connection_manager", "envoy.http_connection_manager":
+			l.mode = "http"
+			e.httpConnectionManager(directive, config, l)
+		case "envoy.filters.network.tcp_proxy", "envoy.tcp_proxy":
+			l.mode = "tcp"
+			b, ok := e.clusters[config.Cluster]
+			if !ok {
+				e.c.issue(0, directive, "tcp_proxy to the unknown cluster %s is not converted", config.Cluster)
+				continue
+			}
+			e.c.backend(b).Backend.Mode = "tcp"
+			l.defaultBackend = b
+		default:
+			e.c.issue(0, directive, "filter %s is not converted", f.Name)
+		}
+	}
+	if l.mode == "" {
+		e.c.issue(0, directive, "listener without http_connection_manager or tcp_proxy filter is not converted")
+		return
+	}
+	e.c.addFrontend(l)
+}
+
+func (e *envoyConverter) httpConnectionManager(directive string, config envoyFilterConfig, l *listener) {
+	for _, f := range config.HTTPFilters {
+		if f.Name != "envoy.filters.http.router" && f.Name != "envoy.router" {
+			e.c.issue(0, directive, "HTTP filter %s is not converted", f.Name)
+		}
+	}
+	if config.RouteConfig == nil {
+		e.c.issue(0, directive, "only inline route configurations are converted")
+		return
+	}
+	// the most specific domains first, as Envoy selects virtual hosts
+	vhosts := config.RouteConfig.VirtualHosts
+	sort.SliceStable(vhosts, func(i, j int) bool {
+		return domainsOrder(vhosts[i].Domains) < domainsOrder(vhosts[j].Domains)
+	})
+	for _, vh := range vhosts {
+		hosts := make([]string, 0, len(vh.Domains))
+		for _, d := range vh.Domains {
+			if d == "*" {
+				hosts = hosts[:0]
+				break
+			}
+			if host, _ := splitHostPort(d); !containsString(hosts, host) {
+				hosts = append(hosts, host)
+			}
+		}
+		if vh.RequireTLS == "ALL" || vh.RequireTLS == "EXTERNAL_ONLY" {
+			l.routes = append(l.routes, &route{hosts: hosts, match: matchPrefix, path: "/", redirectHTTPS: true, redirectCode: 301})
+		}
+		for _, er := range vh.Routes {
+			if r := e.route(fmt.Sprintf("virtual host %s", vh.Name), er); r != nil {
+				r.hosts = hosts
+				l.routes = append(l.routes, r)
+			}
+		}
+	}
+}
+
+// domainsOrder sorts virtual hosts with exact domains first and the catch-all last
+func domainsOrder(domains []string) int {
+	order := 0
+	for _, d := range domains {
+		switch {
+		case d == "*":
+			return 2
+		case strings.Contains(d, "*"):
+			order = 1
+		}
+	}
+	return order
+}
+
+func (e *envoyConverter) route(directive string, er envoyRoute) *route {
+	r := &route{}
+	m := er.Match
+	switch {
+	case m.Prefix != nil:
+		r.match, r.path = matchPrefix, *m.Prefix
+		directive += " prefix " + *m.Prefix
+	case m.Path != nil:
+		r.match, r.path = matchPath, *m.Path
+		directive += " path " + *m.Path
+	case m.SafeRegex != nil:
+		r.match, r.path = matchRegex, m.SafeRegex.Regex
+		directive += " regex " + m.SafeRegex.Regex
+	case m.Regex != nil:
+		r.match, r.path = matchRegex, *m.Regex
+		directive += " regex " + *m.Regex
+	default:
+		e.c.issue(0, directive, "route without prefix, path or regex match is not converted")
+		return nil
+	}
+	if r.match == matchRegex {
+		r.path = "^" + strings.TrimSuffix(strings.TrimPrefix(r.path, "^"), "$") + "$"
+	}
+	r.caseInsensitive = m.CaseSensitive != nil && !*m.CaseSensitive
+	if len(m.Headers) > 0 || len(m.QueryParameters) > 0 {
+		e.c.issue(0, directive, "route matching headers or query parameters is not converted")
+		return nil
+	}
+	switch {
+	case er.Route != nil:
+		if er.Route.WeightedClusters != nil || er.Route.ClusterHeader != "" {
+			e.c.issue(0, directive, "weighted clusters and cluster headers are not converted")
+			return nil
+		}
+		b, ok := e.clusters[er.Route.Cluster]
+		if !ok {
+			e.c.issue(0, directive, "route to the unknown cluster %s is not converted", er.Route.Cluster)
+			return nil
+		}
+		r.backend = b
+		r.rewrite = er.Route.PrefixRewrite
+		if timeout := envoyDuration(er.Route.Timeout); timeout != nil {
+			e.c.backend(b).Backend.ServerTimeout = timeout
+		}
+	case er.Redirect != nil && er.Redirect.HTTPSRedirect:
+		r.redirectHTTPS = true
+		r.redirectCode = envoyRedirectCodes[er.Redirect.ResponseCode]
+	case er.DirectResponse != nil && denyCodes[er.DirectResponse.Status]:
+		r.denyStatus = er.DirectResponse.Status
+	default:
+		e.c.issue(0, directive, "only routes to a cluster, redirects to https and direct responses with the status codes HAProxy denies with are converted")
+		return nil
+	}
+	return r
+}
+
+// envoyDuration returns a duration such as 0.25s in milliseconds
+func envoyDuration(d string) *int64 {
+	if d == "" {
+		return nil
+	}
+	duration, err := time.ParseDuration(d)
+	if err != nil || duration <= 0 {
+		return nil
+	}
+	ms := duration.Milliseconds()
+	if ms == 0 {
+		ms = 1
+	}
+	return &ms
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,399 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package importer converts the configuration of other proxies into HAProxy
+// frontends, backends and their rules, on a best-effort basis
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/misc"
+)
+
+// Formats of the configurations Convert accepts
+const (
+	FormatNginx = "nginx"
+	FormatEnvoy = "envoy"
+)
+
+// Frontend is a converted frontend with its binds and rules
+type Frontend struct {
+	Frontend         *models.Frontend
+	Binds            []*models.Bind
+	ACLs             []*models.ACL
+	HTTPRequestRules []*models.HTTPRequestRule
+	SwitchingRules   []*models.BackendSwitchingRule
+}
+
+// Backend is a converted backend with its servers
+type Backend struct {
+	Backend *models.Backend
+	Servers []*models.Server
+}
+
+// Issue is a directive of the source configuration which was not converted,
+// or was converted with a different behaviour
+type Issue struct {
+	// Line of the directive, 0 when the format does not keep lines
+	Line      int
+	Directive string
+	Message   string
+}
+
+// Configuration is the result of a conversion
+type Configuration struct {
+	Frontends []*Frontend
+	Backends  []*Backend
+	Issues    []Issue
+
+	names map[string]bool
+}
+
+// Convert converts data, in the given format, into HAProxy configuration objects
+func Convert(format, data string) (*Configuration, error) {
+	switch format {
+	case FormatNginx:
+		return convertNginx(data)
+	case FormatEnvoy:
+		return convertEnvoy(data)
+	}
+	return nil, fmt.Errorf("unknown format %s, %s and %s are supported", format, FormatNginx, FormatEnvoy)
+}
+
+func newConfiguration() *Configuration {
+	return &Configuration{
+		Frontends: make([]*Frontend, 0),
+		Backends:  make([]*Backend, 0),
+		Issues:    make([]Issue, 0),
+		names:     make(map[string]bool),
+	}
+}
+
+func (c *Configuration) issue(line int, directive, format string, a ...interface{}) {
+	c.Issues = append(c.Issues, Issue{Line: line, Directive: directive, Message: fmt.Sprintf(format, a...)})
+}
+
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9_.:-]`)
+
+// name returns a proxy name derived from base not used yet
+func (c *Configuration) name(base string) string {
+	base = invalidNameChars.ReplaceAllString(base, "_")
+	if base == "" {
+		base = "proxy"
+	}
+	name := base
+	for i := 2; c.names[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	c.names[name] = true
+	return name
+}
+
+func (c *Configuration) backend(name string) *Backend {
+	for _, b := range c.Backends {
+		if b.Backend.Name == name {
+			return b
+		}
+	}
+	return nil
+}
+
+// addBackend adds an empty backend named after base and returns it
+func (c *Configuration) addBackend(base, mode string) *Backend {
+	b := &Backend{
+		Backend: &models.Backend{
+			Name:    c.name(base),
+			Mode:    mode,
+			Balance: &models.Balance{Algorithm: misc.StringP("roundrobin")},
+		},
+		Servers: make([]*models.Server, 0),
+	}
+	c.Backends = append(c.Backends, b)
+	return b
+}
+
+// addServer adds a server to the backend b
+func (b *Backend) addServer(address string, port int64) *models.Server {
+	s := &models.Server{
+		Name:    fmt.Sprintf("srv%d", len(b.Servers)+1),
+		Address: address,
+	}
+	if port != 0 {
+		s.Port = misc.Int64P(int(port))
+	}
+	b.Servers = append(b.Servers, s)
+	return s
+}
+
+// Kinds of path match of a route
+const (
+	matchPrefix = "prefix"
+	matchPath   = "path"
+	matchRegex  = "regex"
+)
+
+// route sends the requests for the host names hosts, all of them when empty,
+// matching the path to a backend, or redirects or denies them
+type route struct {
+	hosts           []string
+	match           string
+	path            string
+	caseInsensitive bool
+
+	backend string
+	// prefix replacing the matched prefix, for prefix routes
+	rewrite string
+	// redirection to https, or to the location
+	redirectHTTPS bool
+	location      string
+	redirectCode  int64
+	denyStatus    int64
+}
+
+// listener is an address the source proxy listens on and the routes of its requests
+type listener struct {
+	base        string
+	address     string
+	port        int64
+	mode        string
+	ssl         bool
+	certificate string
+	alpn        string
+	acceptProxy bool
+	routes      []*route
+	// backend of the requests no route matched
+	defaultBackend string
+	clientTimeout  *int64
+}
+
+// addFrontend converts the listener into a frontend, the routes into ACLs on the
+// Host header and on the path, and backend switching, redirect and deny rules
+func (c *Configuration) addFrontend(l *listener) *Frontend {
+	f := &Frontend{
+		Frontend: &models.Frontend{
+			Name:          c.name(l.base),
+			Mode:          l.mode,
+			ClientTimeout: l.clientTimeout,
+		},
+		Binds:            make([]*models.Bind, 0),
+		ACLs:             make([]*models.ACL, 0),
+		HTTPRequestRules: make([]*models.HTTPRequestRule, 0),
+		SwitchingRules:   make([]*models.BackendSwitchingRule, 0),
+	}
+	bind := &models.Bind{
+		Name:        f.Frontend.Name,
+		Address:     l.address,
+		Port:        misc.Int64P(int(l.port)),
+		AcceptProxy: l.acceptProxy,
+		Alpn:        l.alpn,
+	}
+	if l.ssl {
+		bind.Ssl = true
+		bind.SslCertificate = l.certificate
+	}
+	f.Binds = append(f.Binds, bind)
+
+	acls := make(map[string]string)
+	acl := func(prefix, key string, criteria [][2]string) string {
+		if name, ok := acls[prefix+key]; ok {
+			return name
+		}
+		name := fmt.Sprintf("%s_%d", prefix, len(acls)+1)
+		acls[prefix+key] = name
+		for _, cr := range criteria {
+			f.ACLs = append(f.ACLs, &models.ACL{ACLName: name, Criterion: cr[0], Value: cr[1], Index: misc.Int64P(len(f.ACLs))})
+		}
+		return name
+	}
+	conds := make([]string, 0, len(l.routes))
+	selected := false
+	for _, r := range l.routes {
+		var cond []string
+		if len(r.hosts) > 0 {
+			cond = append(cond, acl("host", strings.Join(r.hosts, " "), hostCriteria(r.hosts)))
+		}
+		if crit := pathCriterion(r); crit[0] != "" {
+			cond = append(cond, acl("path", strings.Join(crit[:], " "), [][2]string{crit}))
+		}
+		conds = append(conds, strings.Join(cond, " "))
+		if r.backend == "" || r.rewrite != "" {
+			selected = true
+		}
+	}
+
+	if !selected {
+		// backend switching rules apply the first route matching
+		for i, r := range l.routes {
+			if conds[i] == "" {
+				if l.defaultBackend == "" {
+					l.defaultBackend = r.backend
+				}
+				break
+			}
+			f.SwitchingRules = append(f.SwitchingRules, &models.BackendSwitchingRule{
+				Name:     r.backend,
+				Cond:     "if",
+				CondTest: conds[i],
+				Index:    misc.Int64P(len(f.SwitchingRules)),
+			})
+		}
+		f.Frontend.DefaultBackend = l.defaultBackend
+		c.Frontends = append(c.Frontends, f)
+		return f
+	}
+
+	// http-request rules are evaluated before the backend switching rules, the
+	// first route matching is stored in txn.route for the rewrites to not change
+	// the path the routes match and for the first route to win over redirects
+	rule := func(r *models.HTTPRequestRule, condTest string) {
+		r.Cond = "if"
+		r.CondTest = condTest
+		r.Index = misc.Int64P(len(f.HTTPRequestRules))
+		f.HTTPRequestRules = append(f.HTTPRequestRules, r)
+	}
+	for i, r := range l.routes {
+		if conds[i] == "" && r.backend != "" && r.rewrite == "" {
+			if l.defaultBackend == "" {
+				l.defaultBackend = r.backend
+			}
+			break
+		}
+		rule(&models.HTTPRequestRule{Type: "set-var", VarScope: "txn", VarName: "route", VarExpr: fmt.Sprintf("int(%d)", i+1)},
+			strings.TrimSpace(conds[i]+" !{ var(txn.route) -m found }"))
+		if conds[i] == "" {
+			break
+		}
+	}
+	for i, r := range l.routes {
+		if conds[i] == "" && r.backend != "" && r.rewrite == "" {
+			break
+		}
+		selection := fmt.Sprintf("{ var(txn.route) -m int %d }", i+1)
+		switch {
+		case r.redirectHTTPS || r.location != "":
+			redirect := &models.HTTPRequestRule{Type: "redirect", RedirType: "scheme", RedirValue: "https"}
+			if r.location != "" {
+				redirect.RedirType = "location"
+				redirect.RedirValue = r.location
+			}
+			if r.redirectCode != 0 {
+				redirect.RedirCode = misc.Int64P(int(r.redirectCode))
+			}
+			rule(redirect, selection)
+		case r.denyStatus != 0:
+			rule(&models.HTTPRequestRule{Type: "deny", DenyStatus: misc.Int64P(int(r.denyStatus))}, selection)
+		default:
+			if r.rewrite != "" && r.match == matchPrefix {
+				rule(&models.HTTPRequestRule{
+					Type:      "replace-path",
+					PathMatch: "^" + regexp.QuoteMeta(r.path) + "(.*)",
+					PathFmt:   r.rewrite + `\1`,
+				}, selection)
+			}
+			f.SwitchingRules = append(f.SwitchingRules, &models.BackendSwitchingRule{
+				Name:     r.backend,
+				Cond:     "if",
+				CondTest: selection,
+				Index:    misc.Int64P(len(f.SwitchingRules)),
+			})
+		}
+		if conds[i] == "" {
+			break
+		}
+	}
+	f.Frontend.DefaultBackend = l.defaultBackend
+	c.Frontends = append(c.Frontends, f)
+	return f
+}
+
+// hostCriteria returns the ACL criteria matching the host names, *.example.com
+// and example.* wildcards included and ~ prefixing regular expressions
+func hostCriteria(hosts []string) [][2]string {
+	host := "req.hdr(host),field(1,:)"
+	var exact, suffix, prefix []string
+	var criteria [][2]string
+	for _, h := range hosts {
+		switch {
+		case strings.HasPrefix(h, "~"):
+			criteria = append(criteria, [2]string{host, "-m reg -i " + strings.TrimPrefix(h, "~")})
+		case strings.HasPrefix(h, "*."):
+			suffix = append(suffix, strings.TrimPrefix(h, "*"))
+		case strings.HasPrefix(h, "."):
+			exact = append(exact, strings.TrimPrefix(h, "."))
+			suffix = append(suffix, h)
+		case strings.HasSuffix(h, ".*"):
+			prefix = append(prefix, strings.TrimSuffix(h, "*"))
+		default:
+			exact = append(exact, h)
+		}
+	}
+	if len(exact) > 0 {
+		criteria = append(criteria, [2]string{host, "-i " + strings.Join(exact, " ")})
+	}
+	if len(suffix) > 0 {
+		criteria = append(criteria, [2]string{host, "-m end -i " + strings.Join(suffix, " ")})
+	}
+	if len(prefix) > 0 {
+		criteria = append(criteria, [2]string{host, "-m beg -i " + strings.Join(prefix, " ")})
+	}
+	return criteria
+}
+
+// pathCriterion returns the ACL criterion and value matching the path of the
+// route, empty when it matches all paths
+func pathCriterion(r *route) [2]string {
+	flags := ""
+	if r.caseInsensitive {
+		flags = "-i "
+	}
+	switch r.match {
+	case matchPrefix:
+		if r.path != "/" && r.path != "" {
+			return [2]string{"path_beg", flags + r.path}
+		}
+	case matchPath:
+		return [2]string{"path", flags + r.path}
+	case matchRegex:
+		return [2]string{"path_reg", flags + r.path}
+	}
+	return [2]string{}
+}
+
+// splitHostPort splits an address with an optional port, IPv6 addresses
+// being enclosed in brackets
+func splitHostPort(address string) (string, string) {
+	if strings.HasPrefix(address, "[") {
+		end := strings.Index(address, "]")
+		if end == -1 {
+			return address, ""
+		}
+		return address[1:end], strings.TrimPrefix(address[end+1:], ":")
+	}
+	if i := strings.LastIndex(address, ":"); i != -1 && strings.Count(address, ":") == 1 {
+		return address[:i], address[i+1:]
+	}
+	return address, ""
+}
+
+// redirect status codes HAProxy supports
+var redirectCodes = map[int64]bool{301: true, 302: true, 303: true, 307: true, 308: true}
+
+// deny status codes HAProxy supports
+var denyCodes = map[int64]bool{200: true, 400: true, 403: true, 405: true, 408: true, 425: true, 429: true, 500: true, 502: true, 503: true, 504: true}
@@ -0,0 +1,635 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/haproxytech/models/v2"
+
+	"github.com/haproxytech/dataplaneapi/misc"
+)
+
+// nginxDirective is a directive of an nginx configuration, with its block
+type nginxDirective struct {
+	name    string
+	args    []string
+	line    int
+	isBlock bool
+	block   []*nginxDirective
+}
+
+func (d *nginxDirective) String() string {
+	return strings.Join(append([]string{d.name}, d.args...), " ")
+}
+
+type nginxToken struct {
+	value  string
+	line   int
+	quoted bool
+}
+
+// tokenizeNginx splits an nginx configuration into words, quoted strings and
+// the ; { } delimiters, dropping comments
+func tokenizeNginx(data string) ([]nginxToken, error) {
+	tokens := make([]nginxToken, 0)
+	line := 1
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '\n':
+			line++
+		case c == ' ' || c == '\t' || c == '\r':
+		case c == '#':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			line++
+		case c == ';' || c == '{' || c == '}':
+			tokens = append(tokens, nginxToken{value: string(c), line: line})
+		case c == '"' || c == '\'':
+			start := line
+			var value strings.Builder
+			i++
+			for ; i < len(data) && data[i] != c; i++ {
+				if data[i] == '\\' && i+1 < len(data) {
+					i++
+				}
+				if data[i] == '\n' {
+					line++
+				}
+				value.WriteByte(data[i])
+			}
+			if i == len(data) {
+				return nil, fmt.Errorf("line %d: unterminated string", start)
+			}
+			tokens = append(tokens, nginxToken{value: value.String(), line: start, quoted: true})
+		default:
+			start := i
+			for i < len(data) && !strings.ContainsRune(" \t\r\n;{}", rune(data[i])) {
+				i++
+			}
+			tokens = append(tokens, nginxToken{value: data[start:i], line: line})
+			i--
+		}
+	}
+	return tokens, nil
+}
+
+// parseNginx builds the directives of the tokens from pos, up to the end of
+// the enclosing block when nested
+func parseNginx(tokens []nginxToken, pos *int, nested bool) ([]*nginxDirective, error) {
+	directives := make([]*nginxDirective, 0)
+	var current *nginxDirective
+	for *pos < len(tokens) {
+		t := tokens[*pos]
+		*pos++
+		if t.quoted {
+			if current == nil {
+				current = &nginxDirective{name: t.value, line: t.line}
+			} else {
+				current.args = append(current.args, t.value)
+			}
+			continue
+		}
+		switch t.value {
+		case ";":
+			if current == nil {
+				return nil, fmt.Errorf("line %d: unexpected ;", t.line)
+			}
+			directives = append(directives, current)
+			current = nil
+		case "{":
+			if current == nil {
+				return nil, fmt.Errorf("line %d: unexpected {", t.line)
+			}
+			block, err := parseNginx(tokens, pos, true)
+			if err != nil {
+				return nil, err
+			}
+			current.isBlock = true
+			current.block = block
+			directives = append(directives, current)
+			current = nil
+		case "}":
+			if !nested || current != nil {
+				return nil, fmt.Errorf("line %d: unexpected }", t.line)
+			}
+			return directives, nil
+		default:
+			if current == nil {
+				current = &nginxDirective{name: t.value, line: t.line}
+			} else {
+				current.args = append(current.args, t.value)
+			}
+		}
+	}
+	if nested {
+		return nil, fmt.Errorf("unexpected end of file, expecting }")
+	}
+	if current != nil {
+		return nil, fmt.Errorf("line %d: unexpected end of file, expecting ;", current.line)
+	}
+	return directives, nil
+}
+
+type nginxConverter struct {
+	c *Configuration
+	// backends of the upstreams and of the proxied addresses
+	upstreams map[string]string
+	addresses map[string]string
+	listeners map[string]*listener
+	order     []string
+	// listeners whose default backend was set by a default_server
+	explicitDefault map[*listener]bool
+}
+
+func convertNginx(data string) (*Configuration, error) {
+	tokens, err := tokenizeNginx(data)
+	if err != nil {
+		return nil, err
+	}
+	pos := 0
+	directives, err := parseNginx(tokens, &pos, false)
+	if err != nil {
+		return nil, err
+	}
+	c := newConfiguration()
+	for _, d := range directives {
+		switch d.name {
+		case "http":
+			newNginxConverter(c).context(d, "http")
+		case "stream":
+			newNginxConverter(c).context(d, "tcp")
+		case "include":
+			c.issue(d.line, d.String(), "included files are not read, convert them separately")
+		default:
+			c.issue(d.line, d.String(), "%s is not converted", d.name)
+		}
+	}
+	return c, nil
+}
+
+func newNginxConverter(c *Configuration) *nginxConverter {
+	return &nginxConverter{
+		c:               c,
+		upstreams:       make(map[string]string),
+		addresses:       make(map[string]string),
+		listeners:       make(map[string]*listener),
+		explicitDefault: make(map[*listener]bool),
+	}
+}
+
+// context converts an http or a stream block, with mode http or tcp
+func (n *nginxConverter) context(d *nginxDirective, mode string) {
+	for _, sd := range d.block {
+		if sd.name == "upstream" && len(sd.args) == 1 {
+			n.upstream(sd, mode)
+		}
+	}
+	for _, sd := range d.block {
+		switch {
+		case sd.name == "upstream" && len(sd.args) == 1:
+		case sd.name == "server" && sd.isBlock:
+			if mode == "http" {
+				n.server(sd)
+			} else {
+				n.streamServer(sd)
+			}
+		case sd.name == "include":
+			n.c.issue(sd.line, sd.String(), "included files are not read, convert them separately")
+		default:
+			n.c.issue(sd.line, sd.String(), "%s is not converted", sd.name)
+		}
+	}
+	for _, key := range n.order {
+		l := n.listeners[key]
+		// routes of the servers without names only apply when no name matched
+		sort.SliceStable(l.routes, func(i, j int) bool {
+			return len(l.routes[i].hosts) > 0 && len(l.routes[j].hosts) == 0
+		})
+		n.c.addFrontend(l)
+	}
+}
+
+// upstream converts an upstream block into a backend
+func (n *nginxConverter) upstream(d *nginxDirective, mode string) {
+	b := n.c.addBackend(d.args[0], mode)
+	n.upstreams[d.args[0]] = b.Backend.Name
+	for _, sd := range d.block {
+		switch sd.name {
+		case "server":
+			n.upstreamServer(b, sd, mode)
+		case "least_conn":
+			b.Backend.Balance.Algorithm = misc.StringP("leastconn")
+		case "ip_hash":
+			b.Backend.Balance.Algorithm = misc.StringP("source")
+		case "random":
+			b.Backend.Balance.Algorithm = misc.StringP("random")
+		case "hash":
+			switch {
+			case len(sd.args) > 0 && sd.args[0] == "$request_uri":
+				b.Backend.Balance.Algorithm = misc.StringP("uri")
+			case len(sd.args) > 0 && sd.args[0] == "$remote_addr":
+				b.Backend.Balance.Algorithm = misc.StringP("source")
+			default:
+				n.c.issue(sd.line, sd.String(), "only hashes of $request_uri and $remote_addr are converted")
+			}
+		case "keepalive", "keepalive_requests", "keepalive_timeout", "zone":
+			// HAProxy reuses idle server connections by default
+		default:
+			n.c.issue(sd.line, sd.String(), "%s is not converted", sd.name)
+		}
+	}
+}
+
+func (n *nginxConverter) upstreamServer(b *Backend, d *nginxDirective, mode string) {
+	if len(d.args) == 0 || strings.HasPrefix(d.args[0], "unix:") {
+		n.c.issue(d.line, d.String(), "only servers with an IP address or a host name are converted")
+		return
+	}
+	host, port := splitHostPort(d.args[0])
+	p, _ := strconv.ParseInt(port, 10, 64)
+	if p == 0 && mode == "http" {
+		p = 80
+	}
+	s := b.addServer(host, p)
+	for _, arg := range d.args[1:] {
+		kv := strings.SplitN(arg, "=", 2)
+		switch {
+		case kv[0] == "backup":
+			s.Backup = "enabled"
+		case kv[0] == "down":
+			s.Maintenance = "enabled"
+		case kv[0] == "weight" && len(kv) == 2:
+			w, _ := strconv.ParseInt(kv[1], 10, 64)
+			s.Weight = misc.Int64P(int(w))
+		case kv[0] == "max_conns" && len(kv) == 2:
+			m, _ := strconv.ParseInt(kv[1], 10, 64)
+			s.Maxconn = misc.Int64P(int(m))
+		default:
+			n.c.issue(d.line, d.String(), "server parameter %s is not converted", arg)
+		}
+	}
+}
+
+// listener returns the listener of the listen directive, created on first use
+func (n *nginxConverter) listener(d *nginxDirective, mode string) (*listener, bool) {
+	address := "80"
+	if len(d.args) > 0 {
+		address = d.args[0]
+	}
+	if strings.HasPrefix(address, "unix:") {
+		n.c.issue(d.line, d.String(), "UNIX sockets are not converted")
+		return nil, false
+	}
+	host, port := splitHostPort(address)
+	if _, err := strconv.Atoi(host); err == nil && port == "" {
+		host, port = "", host
+	}
+	if port == "" {
+		port = "80"
+	}
+	if host == "" {
+		host = "*"
+	}
+	p, _ := strconv.ParseInt(port, 10, 64)
+	key := fmt.Sprintf("%s:%d", host, p)
+	l, ok := n.listeners[key]
+	if !ok {
+		l = &listener{base: fmt.Sprintf("%s_%d", mode, p), address: host, port: p, mode: mode, routes: make([]*route, 0)}
+		n.listeners[key] = l
+		n.order = append(n.order, key)
+	}
+	defaultServer := false
+	for _, arg := range d.args[1:] {
+		switch arg {
+		case "ssl":
+			l.ssl = true
+			if mode == "http" {
+				l.base = fmt.Sprintf("https_%d", p)
+			}
+		case "http2":
+			l.alpn = "h2,http/1.1"
+		case "proxy_protocol":
+			l.acceptProxy = true
+		case "default_server", "default":
+			defaultServer = true
+		}
+	}
+	return l, defaultServer
+}
+
+// server converts a virtual server of the http context into the routes of its listeners
+func (n *nginxConverter) server(d *nginxDirective) {
+	var listeners []*listener
+	var defaults []bool
+	var hosts []string
+	var certificate, key string
+	var certificateLine int
+	routes := make([]*route, 0)
+	for _, sd := range d.block {
+		switch sd.name {
+		case "listen":
+			if l, def := n.listener(sd, "http"); l != nil {
+				listeners = append(listeners, l)
+				defaults = append(defaults, def)
+			}
+		case "server_name":
+			for _, h := range sd.args {
+				if h != "_" && h != "" {
+					hosts = append(hosts, h)
+				}
+			}
+		case "ssl_certificate":
+			if len(sd.args) > 0 {
+				certificate, certificateLine = sd.args[0], sd.line
+			}
+		case "ssl_certificate_key":
+			if len(sd.args) > 0 {
+				key = sd.args[0]
+			}
+		case "location":
+			if r := n.location(sd); r != nil {
+				routes = append(routes, r)
+			}
+		case "return":
+			r := &route{match: matchPrefix, path: "/"}
+			if n.nginxReturn(sd, r) {
+				routes = append(routes, r)
+			}
+		default:
+			n.c.issue(sd.line, sd.String(), "%s is not converted", sd.name)
+		}
+	}
+	if len(listeners) == 0 {
+		l, def := n.listener(&nginxDirective{name: "listen", line: d.line}, "http")
+		listeners = append(listeners, l)
+		defaults = append(defaults, def)
+	}
+	if certificate != "" && key != "" && key != certificate {
+		n.c.issue(certificateLine, "ssl_certificate "+certificate, "HAProxy loads the certificate and its key from one PEM file, concatenate %s and %s", certificate, key)
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routeOrder(routes[i]) < routeOrder(routes[j]) ||
+			(routes[i].match == matchPrefix && routes[j].match == matchPrefix && len(routes[i].path) > len(routes[j].path))
+	})
+	catchAll := ""
+	for _, r := range routes {
+		r.hosts = hosts
+		if r.match == matchPrefix && r.path == "/" && r.backend != "" {
+			catchAll = r.backend
+		}
+	}
+	for i, l := range listeners {
+		if l.ssl && certificate != "" {
+			switch {
+			case l.certificate == "":
+				l.certificate = certificate
+			case l.certificate != certificate:
+				n.c.issue(certificateLine, "ssl_certificate "+certificate, "only the certificate %s is used on %s:%d, put the certificates in a directory for HAProxy to select them by SNI", l.certificate, l.address, l.port)
+			}
+		}
+		l.routes = append(l.routes, routes...)
+		if catchAll != "" && (defaults[i] || (!n.explicitDefault[l] && l.defaultBackend == "")) {
+			l.defaultBackend = catchAll
+			n.explicitDefault[l] = defaults[i]
+		}
+	}
+}
+
+// routeOrder sorts the routes of a server in the nginx location selection order:
+// exact paths, regular expressions, then prefixes from the longest
+func routeOrder(r *route) int {
+	switch r.match {
+	case matchPath:
+		return 0
+	case matchRegex:
+		return 1
+	}
+	return 2
+}
+
+// location converts a location block into a route, nil when it was not converted
+func (n *nginxConverter) location(d *nginxDirective) *route {
+	r := &route{match: matchPrefix}
+	switch {
+	case len(d.args) == 1 && strings.HasPrefix(d.args[0], "@"):
+		n.c.issue(d.line, d.String(), "named locations are not converted")
+		return nil
+	case len(d.args) == 1:
+		r.path = d.args[0]
+	case len(d.args) == 2 && d.args[0] == "=":
+		r.match, r.path = matchPath, d.args[1]
+	case len(d.args) == 2 && d.args[0] == "^~":
+		r.path = d.args[1]
+	case len(d.args) == 2 && (d.args[0] == "~" || d.args[0] == "~*"):
+		r.match, r.path, r.caseInsensitive = matchRegex, d.args[1], d.args[0] == "~*"
+	default:
+		n.c.issue(d.line, d.String(), "location is not converted")
+		return nil
+	}
+	var connectTimeout, serverTimeout *int64
+	forwardFor := false
+	for _, sd := range d.block {
+		switch sd.name {
+		case "proxy_pass":
+			n.proxyPass(sd, r)
+		case "return":
+			n.nginxReturn(sd, r)
+		case "proxy_connect_timeout":
+			if len(sd.args) == 1 {
+				connectTimeout = misc.ParseTimeout(sd.args[0])
+			}
+		case "proxy_read_timeout", "proxy_send_timeout":
+			if len(sd.args) == 1 {
+				serverTimeout = misc.ParseTimeout(sd.args[0])
+			}
+		case "proxy_set_header":
+			switch {
+			case len(sd.args) == 2 && strings.EqualFold(sd.args[0], "Host") && (sd.args[1] == "$host" || sd.args[1] == "$http_host"):
+				// HAProxy forwards the Host header
+			case len(sd.args) == 2 && strings.EqualFold(sd.args[0], "X-Forwarded-For"):
+				forwardFor = true
+			default:
+				n.c.issue(sd.line, sd.String(), "only the Host and X-Forwarded-For headers are converted")
+			}
+		case "location":
+			n.c.issue(sd.line, sd.String(), "nested locations are not converted")
+		default:
+			n.c.issue(sd.line, sd.String(), "%s is not converted", sd.name)
+		}
+	}
+	if r.backend == "" && !r.redirectHTTPS && r.location == "" && r.denyStatus == 0 {
+		n.c.issue(d.line, d.String(), "location does not proxy, redirect or deny requests, it is not converted")
+		return nil
+	}
+	if b := n.c.backend(r.backend); b != nil {
+		if connectTimeout != nil {
+			b.Backend.ConnectTimeout = connectTimeout
+		}
+		if serverTimeout != nil {
+			b.Backend.ServerTimeout = serverTimeout
+		}
+		if forwardFor {
+			b.Backend.Forwardfor = &models.Forwardfor{Enabled: misc.StringP("enabled")}
+		}
+	}
+	return r
+}
+
+var nginxHTTPSRedirect = regexp.MustCompile(`^https://\$(host|http_host|server_name)\$request_uri$`)
+
+// nginxReturn converts a return directive into a redirect or a deny of the route
+func (n *nginxConverter) nginxReturn(d *nginxDirective, r *route) bool {
+	if len(d.args) == 0 {
+		n.c.issue(d.line, d.String(), "return is not converted")
+		return false
+	}
+	if len(d.args) == 1 && strings.HasPrefix(d.args[0], "http") {
+		d.args = []string{"302", d.args[0]}
+	}
+	code, err := strconv.ParseInt(d.args[0], 10, 64)
+	switch {
+	case err != nil || len(d.args) > 2:
+		n.c.issue(d.line, d.String(), "return is not converted")
+	case len(d.args) == 2 && redirectCodes[code] && nginxHTTPSRedirect.MatchString(d.args[1]):
+		r.redirectHTTPS, r.redirectCode = true, code
+		return true
+	case len(d.args) == 2 && redirectCodes[code] && !strings.Contains(d.args[1], "$"):
+		r.location, r.redirectCode = d.args[1], code
+		return true
+	case len(d.args) == 1 && denyCodes[code]:
+		r.denyStatus = code
+		return true
+	default:
+		n.c.issue(d.line, d.String(), "only redirects without variables but $host$request_uri and the status codes HAProxy denies with are converted")
+	}
+	return false
+}
+
+// proxyPass sets the backend of the route to the upstream or the address proxied to
+func (n *nginxConverter) proxyPass(d *nginxDirective, r *route) {
+	if len(d.args) != 1 || strings.Contains(d.args[0], "$") {
+		n.c.issue(d.line, d.String(), "proxy_pass with variables is not converted")
+		return
+	}
+	target := d.args[0]
+	ssl := strings.HasPrefix(target, "https://")
+	target = strings.TrimPrefix(strings.TrimPrefix(target, "http://"), "https://")
+	uri := ""
+	if i := strings.Index(target, "/"); i != -1 {
+		target, uri = target[:i], target[i:]
+	}
+	if strings.HasPrefix(target, "unix:") {
+		n.c.issue(d.line, d.String(), "UNIX sockets are not converted")
+		return
+	}
+	r.backend = n.proxiedBackend(target, "http", 80)
+	if ssl {
+		for _, s := range n.c.backend(r.backend).Servers {
+			s.Ssl = "enabled"
+			s.Verify = "none"
+		}
+		n.c.issue(d.line, d.String(), "the certificates of the servers of %s are not verified", r.backend)
+	}
+	if uri != "" && uri != r.path {
+		if r.match != matchPrefix {
+			n.c.issue(d.line, d.String(), "the URI of proxy_pass is only converted in prefix locations")
+			return
+		}
+		r.rewrite = uri
+	}
+}
+
+// proxiedBackend returns the backend of the upstream target, or of a backend
+// with the target address as its server
+func (n *nginxConverter) proxiedBackend(target, mode string, defaultPort int64) string {
+	if b, ok := n.upstreams[target]; ok {
+		return b
+	}
+	if b, ok := n.addresses[target]; ok {
+		return b
+	}
+	host, port := splitHostPort(target)
+	p, _ := strconv.ParseInt(port, 10, 64)
+	if p == 0 {
+		p = defaultPort
+	}
+	b := n.c.addBackend(target, mode)
+	b.addServer(host, p)
+	n.addresses[target] = b.Backend.Name
+	return b.Backend.Name
+}
+
+// streamServer converts a server of the stream context into a TCP listener
+func (n *nginxConverter) streamServer(d *nginxDirective) {
+	var listeners []*listener
+	backend := ""
+	var connectTimeout, timeout *int64
+	for _, sd := range d.block {
+		switch sd.name {
+		case "listen":
+			if l, _ := n.listener(sd, "tcp"); l != nil {
+				listeners = append(listeners, l)
+			}
+		case "proxy_pass":
+			if len(sd.args) != 1 || strings.Contains(sd.args[0], "$") || strings.HasPrefix(sd.args[0], "unix:") {
+				n.c.issue(sd.line, sd.String(), "proxy_pass with variables or to UNIX sockets is not converted")
+				continue
+			}
+			backend = n.proxiedBackend(sd.args[0], "tcp", 0)
+		case "proxy_connect_timeout":
+			if len(sd.args) == 1 {
+				connectTimeout = misc.ParseTimeout(sd.args[0])
+			}
+		case "proxy_timeout":
+			if len(sd.args) == 1 {
+				timeout = misc.ParseTimeout(sd.args[0])
+			}
+		default:
+			n.c.issue(sd.line, sd.String(), "%s is not converted", sd.name)
+		}
+	}
+	if backend == "" {
+		n.c.issue(d.line, d.String(), "server without proxy_pass is not converted")
+		return
+	}
+	if b := n.c.backend(backend); b != nil {
+		if connectTimeout != nil {
+			b.Backend.ConnectTimeout = connectTimeout
+		}
+		if timeout != nil {
+			b.Backend.ServerTimeout = timeout
+		}
+	}
+	for _, l := range listeners {
+		if l.ssl {
+			n.c.issue(d.line, d.String(), "TLS termination of stream servers is not converted")
+			l.ssl = false
+		}
+		if l.defaultBackend != "" && l.defaultBackend != backend {
+			n.c.issue(d.line, d.String(), "%s:%d already proxies to %s", l.address, l.port, l.defaultBackend)
+			continue
+		}
+		l.defaultBackend = backend
+		l.clientTimeout = timeout
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package dataplaneapi
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var errListenerDetached = errors.New("listener detached")
+
+// inherited holds the listeners left open by a restarted server, they are
+// reused by the next server listening on the same address so connections
+// are never refused while the server restarts
+var inherited = struct {
+	sync.Mutex
+	listeners map[string]*sharedListener
+}{listeners: make(map[string]*sharedListener)}
+
+type acceptResult struct {
+	conn net.Conn
+	err  error
+}
+
+// sharedListener accepts connections on a socket and hands them to the
+// server currently serving it. Connections accepted while no server serves
+// it wait for the next one.
+type sharedListener struct {
+	net.Listener
+	key      string
+	accepted chan acceptResult
+	closed   chan struct{}
+	once     sync.Once
+}
+
+func newSharedListener(key string, l net.Listener) *sharedListener {
+	sl := &sharedListener{
+		Listener: l,
+		key:      key,
+		accepted: make(chan acceptResult),
+		closed:   make(chan struct{}),
+	}
+	go sl.run()
+	return sl
+}
+
+func (l *sharedListener) run() {
+	for {
+		conn, err := l.Listener.Accept()
+		select {
+		case l.accepted <- acceptResult{conn: conn, err: err}:
+		case <-l.closed:
+			if conn != nil {
+				conn.Close()
+			}
+			return
+		}
+	}
+}
+
+// view returns a listener that stops accepting when closed, without closing
+// the socket
+func (l *sharedListener) view() net.Listener {
+	return &listenerView{sharedListener: l, detached: make(chan struct{})}
+}
+
+func (l *sharedListener) close() error {
+	var err error
+	l.once.Do(func() {
+		close(l.closed)
+		err = l.Listener.Close()
+	})
+	return err
+}
+
+type listenerView struct {
+	*sharedListener
+	detached chan struct{}
+	once     sync.Once
+}
+
+func (v *listenerView) Accept() (net.Conn, error) {
+	select {
+	case <-v.detached:
+		return nil, errListenerDetached
+	default:
+	}
+	select {
+	case r := <-v.accepted:
+		return r.conn, r.err
+	case <-v.detached:
+		return nil, errListenerDetached
+	}
+}
+
+func (v *listenerView) Close() error {
+	v.once.Do(func() {
+		close(v.detached)
+	})
+	return nil
+}
+
+// listen returns a listener on address, reusing the one of a restarted server
+// when there is one
+func (s *Server) listen(network, address string) (net.Listener, error) {
+	key := network + "://" + address
+	inherited.Lock()
+	l, ok := inherited.listeners[key]
+	delete(inherited.listeners, key)
+	inherited.Unlock()
+	if ok {
+		log.Infof("Reusing listener at %s", key)
+	} else {
+		raw, err := net.Listen(network, address)
+		if err != nil {
+			return nil, err
+		}
+		l = newSharedListener(key, raw)
+	}
+	s.listeners = append(s.listeners, l)
+	return l.view(), nil
+}
+
+// closeInheritedListeners closes the listeners of a restarted server that
+// are not reused, the listen parameters having changed
+func closeInheritedListeners() {
+	inherited.Lock()
+	defer inherited.Unlock()
+	for key, l := range inherited.listeners {
+		log.Infof("Closing listener at %s", key)
+		if err := l.close(); err != nil {
+			log.Warning(err)
+		}
+		delete(inherited.listeners, key)
+	}
+}
+
+// releaseListeners hands the listeners over to the next server when
+// restarting, and closes them otherwise
+func (s *Server) releaseListeners() {
+	restarting := atomic.LoadInt32(&s.restarting) == 1
+	inherited.Lock()
+	defer inherited.Unlock()
+	for _, l := range s.listeners {
+		if restarting {
+			inherited.listeners[l.key] = l
+			continue
+		}
+		if err := l.close(); err != nil {
+			log.Warning(err)
+		}
+	}
+	s.listeners = nil
+}
+
+// Restart shuts the server down gracefully, letting in-flight requests
+// finish, and keeps its listeners open for the next server
+func (s *Server) Restart() error {
+	atomic.StoreInt32(&s.restarting, 1)
+	return s.Shutdown()
+}
@@ -0,0 +1,170 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/haproxytech/dataplaneapi/system"
+	log "github.com/sirupsen/logrus"
+)
+
+const dateFormat = "2006-01-02"
+
+// Day holds the counters of a day, in UTC
+type Day struct {
+	Date               string `json:"date"`
+	Transactions       int64  `json:"transactions"`
+	FailedTransactions int64  `json:"failed_transactions"`
+	ReloadsSucceeded   int64  `json:"reloads_succeeded"`
+	ReloadsFailed      int64  `json:"reloads_failed"`
+	// CommitTime is the time spent committing the transactions, in milliseconds
+	CommitTime int64 `json:"commit_time"`
+}
+
+// AverageCommitTime returns the average time spent committing a transaction, in milliseconds
+func (d Day) AverageCommitTime() int64 {
+	if d.Transactions == 0 {
+		return 0
+	}
+	return d.CommitTime / d.Transactions
+}
+
+// Store keeps daily counters of transactions and reloads, saved to a file
+// after every change when one is set
+type Store struct {
+	mu        sync.Mutex
+	file      string
+	retention int
+	// days are sorted from the oldest
+	days []Day
+}
+
+// NewStore returns a store keeping retention days of counters, read from file
+// if it exists
+func NewStore(file string, retention int) (*Store, error) {
+	s := &Store{file: file, retention: retention, days: make([]Day, 0)}
+	if file == "" {
+		return s, nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.days); err != nil {
+		return nil, fmt.Errorf("reading %s: %s", file, err.Error())
+	}
+	return s, nil
+}
+
+// TransactionCommitted counts a committed transaction and the time spent committing it
+func (s *Store) TransactionCommitted(d time.Duration) {
+	s.update(func(day *Day) {
+		day.Transactions++
+		day.CommitTime += d.Milliseconds()
+	})
+}
+
+// TransactionFailed counts a transaction whose commit failed
+func (s *Store) TransactionFailed() {
+	s.update(func(day *Day) {
+		day.FailedTransactions++
+	})
+}
+
+// Reload counts a reload
+func (s *Store) Reload(succeeded bool, d time.Duration) {
+	s.update(func(day *Day) {
+		if succeeded {
+			day.ReloadsSucceeded++
+		} else {
+			day.ReloadsFailed++
+		}
+	})
+}
+
+// Days returns the counters of the last n days including today, from the
+// oldest, with zero counters for days without activity
+func (s *Store) Days(n int) []Day {
+	days := make([]Day, 0, n)
+	byDate := make(map[string]Day)
+	if s != nil {
+		s.mu.Lock()
+		for _, d := range s.days {
+			byDate[d.Date] = d
+		}
+		s.mu.Unlock()
+	}
+	today := time.Now().UTC()
+	for i := n - 1; i >= 0; i-- {
+		date := today.AddDate(0, 0, -i).Format(dateFormat)
+		d, ok := byDate[date]
+		if !ok {
+			d = Day{Date: date}
+		}
+		days = append(days, d)
+	}
+	return days
+}
+
+func (s *Store) update(fn func(*Day)) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	date := now.Format(dateFormat)
+	if len(s.days) == 0 || s.days[len(s.days)-1].Date != date {
+		s.days = append(s.days, Day{Date: date})
+	}
+	fn(&s.days[len(s.days)-1])
+
+	if s.retention > 0 {
+		oldest := now.AddDate(0, 0, 1-s.retention).Format(dateFormat)
+		kept := s.days[:0]
+		for _, d := range s.days {
+			// dates in this format sort like strings
+			if d.Date >= oldest {
+				kept = append(kept, d)
+			}
+		}
+		s.days = kept
+	}
+	if err := s.save(); err != nil {
+		log.Warning("Error saving dataplane stats: " + err.Error())
+	}
+}
+
+func (s *Store) save() error {
+	if s.file == "" {
+		return nil
+	}
+	data, err := json.Marshal(s.days)
+	if err != nil {
+		return err
+	}
+	return system.WriteFile(s.file, data, 0644)
+}
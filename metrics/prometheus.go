@@ -0,0 +1,241 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	// requestBuckets are the upper bounds of the request duration histograms, in seconds
+	requestBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	// reloadBuckets are the upper bounds of the commit and reload duration histograms, in seconds
+	reloadBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+)
+
+// Prometheus exposes the API internals in the Prometheus text format: the
+// transactions, the reloads, the API requests and the liveness of HAProxy
+type Prometheus struct {
+	mu                    sync.Mutex
+	transactionsCommitted int64
+	transactionsFailed    int64
+	commitTime            *histogram
+	reloads               map[string]int64
+	reloadTime            *histogram
+	requests              map[string]int64
+	requestTime           map[string]*histogram
+
+	// Transactions, if set, returns the number of open transactions by status
+	Transactions func() (map[string]int64, error)
+	// HAProxyUp, if set, reports whether HAProxy answers, ok being false when
+	// it cannot be known
+	HAProxyUp func() (up bool, ok bool)
+}
+
+type histogram struct {
+	buckets []float64
+	counts  []int64
+	count   int64
+	sum     float64
+}
+
+// NewPrometheus returns an empty Prometheus registry
+func NewPrometheus() *Prometheus {
+	return &Prometheus{
+		commitTime:  newHistogram(reloadBuckets),
+		reloads:     make(map[string]int64),
+		reloadTime:  newHistogram(reloadBuckets),
+		requests:    make(map[string]int64),
+		requestTime: make(map[string]*histogram),
+	}
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{buckets: buckets, counts: make([]int64, len(buckets))}
+}
+
+func (h *histogram) observe(d time.Duration) {
+	v := d.Seconds()
+	for i, b := range h.buckets {
+		if v <= b {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// TransactionCommitted counts a committed transaction and times its commit
+func (p *Prometheus) TransactionCommitted(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.transactionsCommitted++
+	p.commitTime.observe(d)
+}
+
+// TransactionFailed counts a transaction whose commit failed
+func (p *Prometheus) TransactionFailed() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.transactionsFailed++
+}
+
+// Reload counts a reload and times it
+func (p *Prometheus) Reload(succeeded bool, d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	result := "failed"
+	if succeeded {
+		result = "succeeded"
+	}
+	p.reloads[result]++
+	p.reloadTime.observe(d)
+}
+
+// Request counts and times an API request, by handler
+func (p *Prometheus) Request(method, operation string, status int, d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests[labels("method", method, "operation", operation, "status", strconv.Itoa(status))]++
+	key := labels("operation", operation)
+	h, ok := p.requestTime[key]
+	if !ok {
+		h = newHistogram(requestBuckets)
+		p.requestTime[key] = h
+	}
+	h.observe(d)
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var b bytes.Buffer
+
+	var up, upKnown bool
+	if p.HAProxyUp != nil {
+		up, upKnown = p.HAProxyUp()
+	}
+	var transactions map[string]int64
+	if p.Transactions != nil {
+		var err error
+		if transactions, err = p.Transactions(); err != nil {
+			log.Debug("Cannot read the transactions for the metrics: " + err.Error())
+		}
+	}
+
+	p.mu.Lock()
+	writeHeader(&b, "dataplaneapi_transactions_committed_total", "counter", "Transactions committed.")
+	writeSample(&b, "dataplaneapi_transactions_committed_total", "", float64(p.transactionsCommitted))
+	writeHeader(&b, "dataplaneapi_transactions_failed_total", "counter", "Transactions whose commit failed.")
+	writeSample(&b, "dataplaneapi_transactions_failed_total", "", float64(p.transactionsFailed))
+	writeHistogram(&b, "dataplaneapi_transaction_commit_duration_seconds", "Time spent committing transactions.", map[string]*histogram{"": p.commitTime})
+	writeHeader(&b, "dataplaneapi_reloads_total", "counter", "HAProxy reloads by result.")
+	for _, result := range []string{"succeeded", "failed"} {
+		writeSample(&b, "dataplaneapi_reloads_total", labels("result", result), float64(p.reloads[result]))
+	}
+	writeHistogram(&b, "dataplaneapi_reload_duration_seconds", "Time spent reloading HAProxy, retries included.", map[string]*histogram{"": p.reloadTime})
+	writeHeader(&b, "dataplaneapi_requests_total", "counter", "API requests by method, operation and status.")
+	for _, key := range sortedKeys(p.requests) {
+		writeSample(&b, "dataplaneapi_requests_total", key, float64(p.requests[key]))
+	}
+	writeHistogram(&b, "dataplaneapi_request_duration_seconds", "API request latencies by operation.", p.requestTime)
+	p.mu.Unlock()
+
+	if transactions != nil {
+		writeHeader(&b, "dataplaneapi_transactions", "gauge", "Open transactions by status.")
+		for _, status := range sortedKeys(transactions) {
+			writeSample(&b, "dataplaneapi_transactions", labels("status", status), float64(transactions[status]))
+		}
+	}
+	if upKnown {
+		writeHeader(&b, "dataplaneapi_haproxy_up", "gauge", "HAProxy answers on its runtime API.")
+		value := 0.0
+		if up {
+			value = 1
+		}
+		writeSample(&b, "dataplaneapi_haproxy_up", "", value)
+	}
+
+	w.Header().Set("Content-Type", prometheusContentType)
+	w.WriteHeader(http.StatusOK)
+	//nolint:errcheck
+	w.Write(b.Bytes())
+}
+
+func writeHeader(b *bytes.Buffer, name, metricType, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+func writeSample(b *bytes.Buffer, name, labels string, value float64) {
+	if labels != "" {
+		labels = "{" + labels + "}"
+	}
+	fmt.Fprintf(b, "%s%s %s\n", name, labels, strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+func writeHistogram(b *bytes.Buffer, name, help string, histograms map[string]*histogram) {
+	writeHeader(b, name, "histogram", help)
+	for _, key := range sortedKeys(histograms) {
+		h := histograms[key]
+		prefix := key
+		if prefix != "" {
+			prefix += ","
+		}
+		for i, bound := range h.buckets {
+			writeSample(b, name+"_bucket", prefix+labels("le", strconv.FormatFloat(bound, 'g', -1, 64)), float64(h.counts[i]))
+		}
+		writeSample(b, name+"_bucket", prefix+labels("le", "+Inf"), float64(h.count))
+		writeSample(b, name+"_sum", key, h.sum)
+		writeSample(b, name+"_count", key, float64(h.count))
+	}
+}
+
+// labels formats pairs of label names and values
+func labels(pairs ...string) string {
+	parts := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, pairs[i], labelEscaper.Replace(pairs[i+1])))
+	}
+	return strings.Join(parts, ",")
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func sortedKeys(m interface{}) []string {
+	keys := make([]string, 0)
+	switch m := m.(type) {
+	case map[string]int64:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*histogram:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
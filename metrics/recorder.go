@@ -0,0 +1,49 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package metrics
+
+import "time"
+
+// Recorder records the outcome of transactions and reloads
+type Recorder interface {
+	TransactionCommitted(d time.Duration)
+	TransactionFailed()
+	Reload(succeeded bool, d time.Duration)
+}
+
+// Recorders passes every event to each of its recorders
+type Recorders []Recorder
+
+// TransactionCommitted implements Recorder
+func (rs Recorders) TransactionCommitted(d time.Duration) {
+	for _, r := range rs {
+		r.TransactionCommitted(d)
+	}
+}
+
+// TransactionFailed implements Recorder
+func (rs Recorders) TransactionFailed() {
+	for _, r := range rs {
+		r.TransactionFailed()
+	}
+}
+
+// Reload implements Recorder
+func (rs Recorders) Reload(succeeded bool, d time.Duration) {
+	for _, r := range rs {
+		r.Reload(succeeded, d)
+	}
+}
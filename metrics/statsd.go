@@ -0,0 +1,102 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultStatsDPrefix = "dataplaneapi."
+
+// StatsD emits metrics over UDP in the statsd format. With DogStatsD, metrics
+// carry tags, they are dropped otherwise.
+type StatsD struct {
+	conn      net.Conn
+	prefix    string
+	dogStatsD bool
+	tags      []string
+}
+
+// NewStatsD returns a statsd client sending metrics to address, named with
+// prefix, dataplaneapi. when empty
+func NewStatsD(address, prefix string, dogStatsD bool, tags []string) (*StatsD, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	if prefix == "" {
+		prefix = defaultStatsDPrefix
+	}
+	return &StatsD{conn: conn, prefix: prefix, dogStatsD: dogStatsD, tags: tags}, nil
+}
+
+// TransactionCommitted counts a committed transaction and times its commit
+func (s *StatsD) TransactionCommitted(d time.Duration) {
+	s.count("transactions.committed", 1)
+	s.timing("transactions.commit_time", d)
+}
+
+// TransactionFailed counts a transaction whose commit failed
+func (s *StatsD) TransactionFailed() {
+	s.count("transactions.failed", 1)
+}
+
+// Reload counts a reload and times it
+func (s *StatsD) Reload(succeeded bool, d time.Duration) {
+	if succeeded {
+		s.count("reloads.succeeded", 1)
+	} else {
+		s.count("reloads.failed", 1)
+	}
+	s.timing("reloads.reload_time", d)
+}
+
+// Request counts and times an API request
+func (s *StatsD) Request(method, operation string, status int, d time.Duration) {
+	tags := []string{"method:" + method, "operation:" + operation, "status:" + strconv.Itoa(status)}
+	s.count("api.requests", 1, tags...)
+	s.timing("api.request_time", d, tags...)
+}
+
+func (s *StatsD) count(name string, n int64, tags ...string) {
+	s.send(name, strconv.FormatInt(n, 10), "c", tags)
+}
+
+func (s *StatsD) timing(name string, d time.Duration, tags ...string) {
+	s.send(name, strconv.FormatInt(d.Milliseconds(), 10), "ms", tags)
+}
+
+func (s *StatsD) send(name, value, metricType string, tags []string) {
+	if s == nil {
+		return
+	}
+	line := fmt.Sprintf("%s%s:%s|%s", s.prefix, name, value, metricType)
+	if s.dogStatsD {
+		if tags = append(append([]string{}, s.tags...), tags...); len(tags) > 0 {
+			line += "|#" + strings.Join(tags, ",")
+		}
+	}
+	// metrics are lost rather than slowing down the API
+	if _, err := s.conn.Write([]byte(line)); err != nil {
+		log.Debug("Error sending statsd metric: " + err.Error())
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/haproxytech/dataplaneapi/haproxy"
 
+	api_errors "github.com/go-openapi/errors"
 	"github.com/haproxytech/client-native/v2/configuration"
 	client_errors "github.com/haproxytech/client-native/v2/errors"
 	"github.com/haproxytech/models/v2"
@@ -61,6 +62,10 @@ func HandleError(err error) *models.Error {
 		httpCode := ErrHTTPBadRequest
 		msg := t.Error()
 		return &models.Error{Code: &httpCode, Message: &msg}
+	case api_errors.Error:
+		httpCode := int64(t.Code())
+		msg := t.Error()
+		return &models.Error{Code: &httpCode, Message: &msg}
 	default:
 		msg := t.Error()
 		code := ErrHTTPInternalServerError
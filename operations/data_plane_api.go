@@ -35,38 +35,60 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/haproxytech/dataplaneapi/operations/acl"
+	"github.com/haproxytech/dataplaneapi/operations/annotations"
 	"github.com/haproxytech/dataplaneapi/operations/backend"
 	"github.com/haproxytech/dataplaneapi/operations/backend_switching_rule"
+	"github.com/haproxytech/dataplaneapi/operations/bandwidth_limit"
 	"github.com/haproxytech/dataplaneapi/operations/bind"
+	"github.com/haproxytech/dataplaneapi/operations/certificates"
 	"github.com/haproxytech/dataplaneapi/operations/cluster"
+	"github.com/haproxytech/dataplaneapi/operations/compression"
 	"github.com/haproxytech/dataplaneapi/operations/configuration"
 	"github.com/haproxytech/dataplaneapi/operations/defaults"
 	"github.com/haproxytech/dataplaneapi/operations/discovery"
+	"github.com/haproxytech/dataplaneapi/operations/drain"
 	"github.com/haproxytech/dataplaneapi/operations/filter"
+	"github.com/haproxytech/dataplaneapi/operations/fleet"
 	"github.com/haproxytech/dataplaneapi/operations/frontend"
+	"github.com/haproxytech/dataplaneapi/operations/geo_ip"
+	"github.com/haproxytech/dataplaneapi/operations/git"
 	"github.com/haproxytech/dataplaneapi/operations/global"
+	"github.com/haproxytech/dataplaneapi/operations/health"
+	"github.com/haproxytech/dataplaneapi/operations/host_routing"
 	"github.com/haproxytech/dataplaneapi/operations/http_request_rule"
 	"github.com/haproxytech/dataplaneapi/operations/http_response_rule"
 	"github.com/haproxytech/dataplaneapi/operations/information"
+	"github.com/haproxytech/dataplaneapi/operations/listen"
+	"github.com/haproxytech/dataplaneapi/operations/log_format"
 	"github.com/haproxytech/dataplaneapi/operations/log_target"
+	"github.com/haproxytech/dataplaneapi/operations/maintenance"
 	"github.com/haproxytech/dataplaneapi/operations/maps"
 	"github.com/haproxytech/dataplaneapi/operations/nameserver"
 	"github.com/haproxytech/dataplaneapi/operations/peer"
 	"github.com/haproxytech/dataplaneapi/operations/peer_entry"
+	"github.com/haproxytech/dataplaneapi/operations/rate_limit"
 	"github.com/haproxytech/dataplaneapi/operations/reloads"
 	"github.com/haproxytech/dataplaneapi/operations/resolver"
+	"github.com/haproxytech/dataplaneapi/operations/security_options"
 	"github.com/haproxytech/dataplaneapi/operations/server"
 	"github.com/haproxytech/dataplaneapi/operations/server_switching_rule"
 	"github.com/haproxytech/dataplaneapi/operations/service_discovery"
 	"github.com/haproxytech/dataplaneapi/operations/sites"
+	"github.com/haproxytech/dataplaneapi/operations/snapshot"
 	"github.com/haproxytech/dataplaneapi/operations/specification"
 	"github.com/haproxytech/dataplaneapi/operations/specification_openapiv3"
+	"github.com/haproxytech/dataplaneapi/operations/spoe_agent"
 	"github.com/haproxytech/dataplaneapi/operations/stats"
+	"github.com/haproxytech/dataplaneapi/operations/stats_page"
 	"github.com/haproxytech/dataplaneapi/operations/stick_rule"
 	"github.com/haproxytech/dataplaneapi/operations/stick_table"
+	"github.com/haproxytech/dataplaneapi/operations/storage"
 	"github.com/haproxytech/dataplaneapi/operations/tcp_request_rule"
 	"github.com/haproxytech/dataplaneapi/operations/tcp_response_rule"
+	"github.com/haproxytech/dataplaneapi/operations/tls_profile"
+	"github.com/haproxytech/dataplaneapi/operations/traces"
 	"github.com/haproxytech/dataplaneapi/operations/transactions"
+	"github.com/haproxytech/dataplaneapi/operations/users"
 )
 
 // NewDataPlaneAPI creates a new DataPlane instance
@@ -91,16 +113,41 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		TxtConsumer:           runtime.TextConsumer(),
 
 		JSONProducer: runtime.JSONProducer(),
+		TxtProducer:  runtime.TextProducer(),
 
+		FleetAbortFleetConfigurationHandler: fleet.AbortFleetConfigurationHandlerFunc(func(params fleet.AbortFleetConfigurationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fleet.AbortFleetConfiguration has not yet been implemented")
+		}),
 		MapsAddMapEntryHandler: maps.AddMapEntryHandlerFunc(func(params maps.AddMapEntryParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation maps.AddMapEntry has not yet been implemented")
 		}),
+		ConfigurationApplyBatchHandler: configuration.ApplyBatchHandlerFunc(func(params configuration.ApplyBatchParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ApplyBatch has not yet been implemented")
+		}),
+		ConfigurationApplyConfigurationHandler: configuration.ApplyConfigurationHandlerFunc(func(params configuration.ApplyConfigurationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ApplyConfiguration has not yet been implemented")
+		}),
+		LogFormatApplyLogFormatHandler: log_format.ApplyLogFormatHandlerFunc(func(params log_format.ApplyLogFormatParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation log_format.ApplyLogFormat has not yet been implemented")
+		}),
+		SecurityOptionsApplySecurityBaselineHandler: security_options.ApplySecurityBaselineHandlerFunc(func(params security_options.ApplySecurityBaselineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation security_options.ApplySecurityBaseline has not yet been implemented")
+		}),
+		TLSProfileApplyTLSProfileHandler: tls_profile.ApplyTLSProfileHandlerFunc(func(params tls_profile.ApplyTLSProfileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tls_profile.ApplyTLSProfile has not yet been implemented")
+		}),
 		MapsClearRuntimeMapHandler: maps.ClearRuntimeMapHandlerFunc(func(params maps.ClearRuntimeMapParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation maps.ClearRuntimeMap has not yet been implemented")
 		}),
+		FleetCommitFleetConfigurationHandler: fleet.CommitFleetConfigurationHandlerFunc(func(params fleet.CommitFleetConfigurationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fleet.CommitFleetConfiguration has not yet been implemented")
+		}),
 		TransactionsCommitTransactionHandler: transactions.CommitTransactionHandlerFunc(func(params transactions.CommitTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transactions.CommitTransaction has not yet been implemented")
 		}),
+		UsersCreateAPIKeyHandler: users.CreateAPIKeyHandlerFunc(func(params users.CreateAPIKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.CreateAPIKey has not yet been implemented")
+		}),
 		ACLCreateACLHandler: acl.CreateACLHandlerFunc(func(params acl.CreateACLParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation acl.CreateACL has not yet been implemented")
 		}),
@@ -110,12 +157,18 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		BackendSwitchingRuleCreateBackendSwitchingRuleHandler: backend_switching_rule.CreateBackendSwitchingRuleHandlerFunc(func(params backend_switching_rule.CreateBackendSwitchingRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation backend_switching_rule.CreateBackendSwitchingRule has not yet been implemented")
 		}),
+		BandwidthLimitCreateBandwidthLimitHandler: bandwidth_limit.CreateBandwidthLimitHandlerFunc(func(params bandwidth_limit.CreateBandwidthLimitParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.CreateBandwidthLimit has not yet been implemented")
+		}),
 		BindCreateBindHandler: bind.CreateBindHandlerFunc(func(params bind.CreateBindParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation bind.CreateBind has not yet been implemented")
 		}),
 		ServiceDiscoveryCreateConsulHandler: service_discovery.CreateConsulHandlerFunc(func(params service_discovery.CreateConsulParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation service_discovery.CreateConsul has not yet been implemented")
 		}),
+		DrainCreateDrainHandler: drain.CreateDrainHandlerFunc(func(params drain.CreateDrainParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation drain.CreateDrain has not yet been implemented")
+		}),
 		FilterCreateFilterHandler: filter.CreateFilterHandlerFunc(func(params filter.CreateFilterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation filter.CreateFilter has not yet been implemented")
 		}),
@@ -128,6 +181,18 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		HTTPResponseRuleCreateHTTPResponseRuleHandler: http_response_rule.CreateHTTPResponseRuleHandlerFunc(func(params http_response_rule.CreateHTTPResponseRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation http_response_rule.CreateHTTPResponseRule has not yet been implemented")
 		}),
+		HostRoutingCreateHostRouteHandler: host_routing.CreateHostRouteHandlerFunc(func(params host_routing.CreateHostRouteParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation host_routing.CreateHostRoute has not yet been implemented")
+		}),
+		ListenCreateListenHandler: listen.CreateListenHandlerFunc(func(params listen.CreateListenParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.CreateListen has not yet been implemented")
+		}),
+		ListenCreateListenServerHandler: listen.CreateListenServerHandlerFunc(func(params listen.CreateListenServerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.CreateListenServer has not yet been implemented")
+		}),
+		LogFormatCreateLogFormatHandler: log_format.CreateLogFormatHandlerFunc(func(params log_format.CreateLogFormatParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation log_format.CreateLogFormat has not yet been implemented")
+		}),
 		LogTargetCreateLogTargetHandler: log_target.CreateLogTargetHandlerFunc(func(params log_target.CreateLogTargetParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation log_target.CreateLogTarget has not yet been implemented")
 		}),
@@ -140,6 +205,9 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		PeerEntryCreatePeerEntryHandler: peer_entry.CreatePeerEntryHandlerFunc(func(params peer_entry.CreatePeerEntryParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation peer_entry.CreatePeerEntry has not yet been implemented")
 		}),
+		RateLimitCreateRateLimitHandler: rate_limit.CreateRateLimitHandlerFunc(func(params rate_limit.CreateRateLimitParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation rate_limit.CreateRateLimit has not yet been implemented")
+		}),
 		ResolverCreateResolverHandler: resolver.CreateResolverHandlerFunc(func(params resolver.CreateResolverParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation resolver.CreateResolver has not yet been implemented")
 		}),
@@ -155,45 +223,93 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		SitesCreateSiteHandler: sites.CreateSiteHandlerFunc(func(params sites.CreateSiteParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation sites.CreateSite has not yet been implemented")
 		}),
+		SpoeAgentCreateSpoeAgentHandler: spoe_agent.CreateSpoeAgentHandlerFunc(func(params spoe_agent.CreateSpoeAgentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation spoe_agent.CreateSpoeAgent has not yet been implemented")
+		}),
 		StickRuleCreateStickRuleHandler: stick_rule.CreateStickRuleHandlerFunc(func(params stick_rule.CreateStickRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation stick_rule.CreateStickRule has not yet been implemented")
 		}),
+		StorageCreateStorageDHParamHandler: storage.CreateStorageDHParamHandlerFunc(func(params storage.CreateStorageDHParamParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation storage.CreateStorageDHParam has not yet been implemented")
+		}),
+		StorageCreateStorageGeneralFileHandler: storage.CreateStorageGeneralFileHandlerFunc(func(params storage.CreateStorageGeneralFileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation storage.CreateStorageGeneralFile has not yet been implemented")
+		}),
 		TCPRequestRuleCreateTCPRequestRuleHandler: tcp_request_rule.CreateTCPRequestRuleHandlerFunc(func(params tcp_request_rule.CreateTCPRequestRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation tcp_request_rule.CreateTCPRequestRule has not yet been implemented")
 		}),
 		TCPResponseRuleCreateTCPResponseRuleHandler: tcp_response_rule.CreateTCPResponseRuleHandlerFunc(func(params tcp_response_rule.CreateTCPResponseRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation tcp_response_rule.CreateTCPResponseRule has not yet been implemented")
 		}),
+		TLSProfileCreateTLSProfileHandler: tls_profile.CreateTLSProfileHandlerFunc(func(params tls_profile.CreateTLSProfileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tls_profile.CreateTLSProfile has not yet been implemented")
+		}),
+		UsersDeleteAPIKeyHandler: users.DeleteAPIKeyHandlerFunc(func(params users.DeleteAPIKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.DeleteAPIKey has not yet been implemented")
+		}),
 		ACLDeleteACLHandler: acl.DeleteACLHandlerFunc(func(params acl.DeleteACLParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation acl.DeleteACL has not yet been implemented")
 		}),
+		AnnotationsDeleteAnnotationHandler: annotations.DeleteAnnotationHandlerFunc(func(params annotations.DeleteAnnotationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation annotations.DeleteAnnotation has not yet been implemented")
+		}),
 		BackendDeleteBackendHandler: backend.DeleteBackendHandlerFunc(func(params backend.DeleteBackendParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation backend.DeleteBackend has not yet been implemented")
 		}),
 		BackendSwitchingRuleDeleteBackendSwitchingRuleHandler: backend_switching_rule.DeleteBackendSwitchingRuleHandlerFunc(func(params backend_switching_rule.DeleteBackendSwitchingRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation backend_switching_rule.DeleteBackendSwitchingRule has not yet been implemented")
 		}),
+		BandwidthLimitDeleteBandwidthLimitHandler: bandwidth_limit.DeleteBandwidthLimitHandlerFunc(func(params bandwidth_limit.DeleteBandwidthLimitParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.DeleteBandwidthLimit has not yet been implemented")
+		}),
 		BindDeleteBindHandler: bind.DeleteBindHandlerFunc(func(params bind.DeleteBindParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation bind.DeleteBind has not yet been implemented")
 		}),
 		ServiceDiscoveryDeleteConsulHandler: service_discovery.DeleteConsulHandlerFunc(func(params service_discovery.DeleteConsulParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation service_discovery.DeleteConsul has not yet been implemented")
 		}),
+		BackendDeleteDynamicCookieKeyHandler: backend.DeleteDynamicCookieKeyHandlerFunc(func(params backend.DeleteDynamicCookieKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation backend.DeleteDynamicCookieKey has not yet been implemented")
+		}),
 		FilterDeleteFilterHandler: filter.DeleteFilterHandlerFunc(func(params filter.DeleteFilterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation filter.DeleteFilter has not yet been implemented")
 		}),
 		FrontendDeleteFrontendHandler: frontend.DeleteFrontendHandlerFunc(func(params frontend.DeleteFrontendParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation frontend.DeleteFrontend has not yet been implemented")
 		}),
+		FrontendDeleteFrontendCircuitBreakerHandler: frontend.DeleteFrontendCircuitBreakerHandlerFunc(func(params frontend.DeleteFrontendCircuitBreakerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.DeleteFrontendCircuitBreaker has not yet been implemented")
+		}),
+		GeoIPDeleteGeoIPPolicyHandler: geo_ip.DeleteGeoIPPolicyHandlerFunc(func(params geo_ip.DeleteGeoIPPolicyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation geo_ip.DeleteGeoIPPolicy has not yet been implemented")
+		}),
 		HTTPRequestRuleDeleteHTTPRequestRuleHandler: http_request_rule.DeleteHTTPRequestRuleHandlerFunc(func(params http_request_rule.DeleteHTTPRequestRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation http_request_rule.DeleteHTTPRequestRule has not yet been implemented")
 		}),
 		HTTPResponseRuleDeleteHTTPResponseRuleHandler: http_response_rule.DeleteHTTPResponseRuleHandlerFunc(func(params http_response_rule.DeleteHTTPResponseRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation http_response_rule.DeleteHTTPResponseRule has not yet been implemented")
 		}),
+		FrontendDeleteHTTPSRedirectHandler: frontend.DeleteHTTPSRedirectHandlerFunc(func(params frontend.DeleteHTTPSRedirectParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.DeleteHTTPSRedirect has not yet been implemented")
+		}),
+		HostRoutingDeleteHostRouteHandler: host_routing.DeleteHostRouteHandlerFunc(func(params host_routing.DeleteHostRouteParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation host_routing.DeleteHostRoute has not yet been implemented")
+		}),
+		ListenDeleteListenHandler: listen.DeleteListenHandlerFunc(func(params listen.DeleteListenParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.DeleteListen has not yet been implemented")
+		}),
+		ListenDeleteListenServerHandler: listen.DeleteListenServerHandlerFunc(func(params listen.DeleteListenServerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.DeleteListenServer has not yet been implemented")
+		}),
+		LogFormatDeleteLogFormatHandler: log_format.DeleteLogFormatHandlerFunc(func(params log_format.DeleteLogFormatParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation log_format.DeleteLogFormat has not yet been implemented")
+		}),
 		LogTargetDeleteLogTargetHandler: log_target.DeleteLogTargetHandlerFunc(func(params log_target.DeleteLogTargetParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation log_target.DeleteLogTarget has not yet been implemented")
 		}),
+		MaintenanceDeleteMaintenanceHandler: maintenance.DeleteMaintenanceHandlerFunc(func(params maintenance.DeleteMaintenanceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation maintenance.DeleteMaintenance has not yet been implemented")
+		}),
 		NameserverDeleteNameserverHandler: nameserver.DeleteNameserverHandlerFunc(func(params nameserver.DeleteNameserverParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation nameserver.DeleteNameserver has not yet been implemented")
 		}),
@@ -203,6 +319,9 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		PeerEntryDeletePeerEntryHandler: peer_entry.DeletePeerEntryHandlerFunc(func(params peer_entry.DeletePeerEntryParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation peer_entry.DeletePeerEntry has not yet been implemented")
 		}),
+		RateLimitDeleteRateLimitHandler: rate_limit.DeleteRateLimitHandlerFunc(func(params rate_limit.DeleteRateLimitParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation rate_limit.DeleteRateLimit has not yet been implemented")
+		}),
 		ResolverDeleteResolverHandler: resolver.DeleteResolverHandlerFunc(func(params resolver.DeleteResolverParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation resolver.DeleteResolver has not yet been implemented")
 		}),
@@ -215,24 +334,54 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		ServerSwitchingRuleDeleteServerSwitchingRuleHandler: server_switching_rule.DeleteServerSwitchingRuleHandlerFunc(func(params server_switching_rule.DeleteServerSwitchingRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation server_switching_rule.DeleteServerSwitchingRule has not yet been implemented")
 		}),
+		ServerDeleteServerWarmupHandler: server.DeleteServerWarmupHandlerFunc(func(params server.DeleteServerWarmupParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation server.DeleteServerWarmup has not yet been implemented")
+		}),
 		SitesDeleteSiteHandler: sites.DeleteSiteHandlerFunc(func(params sites.DeleteSiteParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation sites.DeleteSite has not yet been implemented")
 		}),
+		SpoeAgentDeleteSpoeAgentHandler: spoe_agent.DeleteSpoeAgentHandlerFunc(func(params spoe_agent.DeleteSpoeAgentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation spoe_agent.DeleteSpoeAgent has not yet been implemented")
+		}),
 		StickRuleDeleteStickRuleHandler: stick_rule.DeleteStickRuleHandlerFunc(func(params stick_rule.DeleteStickRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation stick_rule.DeleteStickRule has not yet been implemented")
 		}),
+		StorageDeleteStorageGeneralFileHandler: storage.DeleteStorageGeneralFileHandlerFunc(func(params storage.DeleteStorageGeneralFileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation storage.DeleteStorageGeneralFile has not yet been implemented")
+		}),
 		TCPRequestRuleDeleteTCPRequestRuleHandler: tcp_request_rule.DeleteTCPRequestRuleHandlerFunc(func(params tcp_request_rule.DeleteTCPRequestRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation tcp_request_rule.DeleteTCPRequestRule has not yet been implemented")
 		}),
 		TCPResponseRuleDeleteTCPResponseRuleHandler: tcp_response_rule.DeleteTCPResponseRuleHandlerFunc(func(params tcp_response_rule.DeleteTCPResponseRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation tcp_response_rule.DeleteTCPResponseRule has not yet been implemented")
 		}),
+		TLSProfileDeleteTLSProfileHandler: tls_profile.DeleteTLSProfileHandlerFunc(func(params tls_profile.DeleteTLSProfileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tls_profile.DeleteTLSProfile has not yet been implemented")
+		}),
 		TransactionsDeleteTransactionHandler: transactions.DeleteTransactionHandlerFunc(func(params transactions.DeleteTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transactions.DeleteTransaction has not yet been implemented")
 		}),
+		SpoeAgentDisableSpoeAgentHandler: spoe_agent.DisableSpoeAgentHandlerFunc(func(params spoe_agent.DisableSpoeAgentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation spoe_agent.DisableSpoeAgent has not yet been implemented")
+		}),
+		SpoeAgentEnableSpoeAgentHandler: spoe_agent.EnableSpoeAgentHandlerFunc(func(params spoe_agent.EnableSpoeAgentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation spoe_agent.EnableSpoeAgent has not yet been implemented")
+		}),
+		ReloadsFreezeReloadsHandler: reloads.FreezeReloadsHandlerFunc(func(params reloads.FreezeReloadsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation reloads.FreezeReloads has not yet been implemented")
+		}),
+		StorageGenerateStorageDHParamHandler: storage.GenerateStorageDHParamHandlerFunc(func(params storage.GenerateStorageDHParamParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation storage.GenerateStorageDHParam has not yet been implemented")
+		}),
 		DiscoveryGetAPIEndpointsHandler: discovery.GetAPIEndpointsHandlerFunc(func(params discovery.GetAPIEndpointsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation discovery.GetAPIEndpoints has not yet been implemented")
 		}),
+		UsersGetAPIKeyHandler: users.GetAPIKeyHandlerFunc(func(params users.GetAPIKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetAPIKey has not yet been implemented")
+		}),
+		UsersGetAPIKeysHandler: users.GetAPIKeysHandlerFunc(func(params users.GetAPIKeysParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetAPIKeys has not yet been implemented")
+		}),
 		ACLGetACLHandler: acl.GetACLHandlerFunc(func(params acl.GetACLParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation acl.GetACL has not yet been implemented")
 		}),
@@ -242,9 +391,24 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		MapsGetAllRuntimeMapFilesHandler: maps.GetAllRuntimeMapFilesHandlerFunc(func(params maps.GetAllRuntimeMapFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation maps.GetAllRuntimeMapFiles has not yet been implemented")
 		}),
+		StorageGetAllStorageDHParamsHandler: storage.GetAllStorageDHParamsHandlerFunc(func(params storage.GetAllStorageDHParamsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation storage.GetAllStorageDHParams has not yet been implemented")
+		}),
+		StorageGetAllStorageGeneralFilesHandler: storage.GetAllStorageGeneralFilesHandlerFunc(func(params storage.GetAllStorageGeneralFilesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation storage.GetAllStorageGeneralFiles has not yet been implemented")
+		}),
+		AnnotationsGetAnnotationHandler: annotations.GetAnnotationHandlerFunc(func(params annotations.GetAnnotationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation annotations.GetAnnotation has not yet been implemented")
+		}),
+		AnnotationsGetAnnotationsHandler: annotations.GetAnnotationsHandlerFunc(func(params annotations.GetAnnotationsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation annotations.GetAnnotations has not yet been implemented")
+		}),
 		BackendGetBackendHandler: backend.GetBackendHandlerFunc(func(params backend.GetBackendParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation backend.GetBackend has not yet been implemented")
 		}),
+		BackendGetBackendFullHandler: backend.GetBackendFullHandlerFunc(func(params backend.GetBackendFullParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation backend.GetBackendFull has not yet been implemented")
+		}),
 		BackendSwitchingRuleGetBackendSwitchingRuleHandler: backend_switching_rule.GetBackendSwitchingRuleHandlerFunc(func(params backend_switching_rule.GetBackendSwitchingRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation backend_switching_rule.GetBackendSwitchingRule has not yet been implemented")
 		}),
@@ -254,45 +418,123 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		BackendGetBackendsHandler: backend.GetBackendsHandlerFunc(func(params backend.GetBackendsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation backend.GetBackends has not yet been implemented")
 		}),
+		BandwidthLimitGetBandwidthLimitHandler: bandwidth_limit.GetBandwidthLimitHandlerFunc(func(params bandwidth_limit.GetBandwidthLimitParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.GetBandwidthLimit has not yet been implemented")
+		}),
+		BandwidthLimitGetBandwidthLimitsHandler: bandwidth_limit.GetBandwidthLimitsHandlerFunc(func(params bandwidth_limit.GetBandwidthLimitsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.GetBandwidthLimits has not yet been implemented")
+		}),
 		BindGetBindHandler: bind.GetBindHandlerFunc(func(params bind.GetBindParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation bind.GetBind has not yet been implemented")
 		}),
+		BindGetBindNetworkHandler: bind.GetBindNetworkHandlerFunc(func(params bind.GetBindNetworkParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bind.GetBindNetwork has not yet been implemented")
+		}),
+		BindGetBindSSLHandler: bind.GetBindSSLHandlerFunc(func(params bind.GetBindSSLParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bind.GetBindSSL has not yet been implemented")
+		}),
 		BindGetBindsHandler: bind.GetBindsHandlerFunc(func(params bind.GetBindsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation bind.GetBinds has not yet been implemented")
 		}),
+		ClusterGetBootstrapKeyRotationsHandler: cluster.GetBootstrapKeyRotationsHandlerFunc(func(params cluster.GetBootstrapKeyRotationsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.GetBootstrapKeyRotations has not yet been implemented")
+		}),
+		GlobalGetCertificateUpdateHandler: global.GetCertificateUpdateHandlerFunc(func(params global.GetCertificateUpdateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation global.GetCertificateUpdate has not yet been implemented")
+		}),
 		DiscoveryGetClusterHandler: discovery.GetClusterHandlerFunc(func(params discovery.GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation discovery.GetCluster has not yet been implemented")
 		}),
+		CompressionGetCompressionHandler: compression.GetCompressionHandlerFunc(func(params compression.GetCompressionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation compression.GetCompression has not yet been implemented")
+		}),
+		ConfigurationGetConfigurationCompatibilityHandler: configuration.GetConfigurationCompatibilityHandlerFunc(func(params configuration.GetConfigurationCompatibilityParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.GetConfigurationCompatibility has not yet been implemented")
+		}),
 		DiscoveryGetConfigurationEndpointsHandler: discovery.GetConfigurationEndpointsHandlerFunc(func(params discovery.GetConfigurationEndpointsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation discovery.GetConfigurationEndpoints has not yet been implemented")
 		}),
+		ConfigurationGetConfigurationIntegrityHandler: configuration.GetConfigurationIntegrityHandlerFunc(func(params configuration.GetConfigurationIntegrityParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.GetConfigurationIntegrity has not yet been implemented")
+		}),
 		ServiceDiscoveryGetConsulHandler: service_discovery.GetConsulHandlerFunc(func(params service_discovery.GetConsulParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation service_discovery.GetConsul has not yet been implemented")
 		}),
 		ServiceDiscoveryGetConsulsHandler: service_discovery.GetConsulsHandlerFunc(func(params service_discovery.GetConsulsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation service_discovery.GetConsuls has not yet been implemented")
 		}),
+		GlobalGetDHParamHandler: global.GetDHParamHandlerFunc(func(params global.GetDHParamParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation global.GetDHParam has not yet been implemented")
+		}),
+		InformationGetDataplaneConfigurationHandler: information.GetDataplaneConfigurationHandlerFunc(func(params information.GetDataplaneConfigurationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation information.GetDataplaneConfiguration has not yet been implemented")
+		}),
+		StatsGetDataplaneStatsHandler: stats.GetDataplaneStatsHandlerFunc(func(params stats.GetDataplaneStatsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation stats.GetDataplaneStats has not yet been implemented")
+		}),
 		DefaultsGetDefaultsHandler: defaults.GetDefaultsHandlerFunc(func(params defaults.GetDefaultsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation defaults.GetDefaults has not yet been implemented")
 		}),
+		GlobalGetDeviceDetectionHandler: global.GetDeviceDetectionHandlerFunc(func(params global.GetDeviceDetectionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation global.GetDeviceDetection has not yet been implemented")
+		}),
+		DrainGetDrainHandler: drain.GetDrainHandlerFunc(func(params drain.GetDrainParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation drain.GetDrain has not yet been implemented")
+		}),
+		DrainGetDrainsHandler: drain.GetDrainsHandlerFunc(func(params drain.GetDrainsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation drain.GetDrains has not yet been implemented")
+		}),
+		BackendGetDynamicCookieKeyHandler: backend.GetDynamicCookieKeyHandlerFunc(func(params backend.GetDynamicCookieKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation backend.GetDynamicCookieKey has not yet been implemented")
+		}),
 		FilterGetFilterHandler: filter.GetFilterHandlerFunc(func(params filter.GetFilterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation filter.GetFilter has not yet been implemented")
 		}),
 		FilterGetFiltersHandler: filter.GetFiltersHandlerFunc(func(params filter.GetFiltersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation filter.GetFilters has not yet been implemented")
 		}),
+		FleetGetFleetHandler: fleet.GetFleetHandlerFunc(func(params fleet.GetFleetParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fleet.GetFleet has not yet been implemented")
+		}),
+		FleetGetFleetNodeConfigurationHandler: fleet.GetFleetNodeConfigurationHandlerFunc(func(params fleet.GetFleetNodeConfigurationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fleet.GetFleetNodeConfiguration has not yet been implemented")
+		}),
 		FrontendGetFrontendHandler: frontend.GetFrontendHandlerFunc(func(params frontend.GetFrontendParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation frontend.GetFrontend has not yet been implemented")
 		}),
+		FrontendGetFrontendCircuitBreakerHandler: frontend.GetFrontendCircuitBreakerHandlerFunc(func(params frontend.GetFrontendCircuitBreakerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.GetFrontendCircuitBreaker has not yet been implemented")
+		}),
+		FrontendGetFrontendFullHandler: frontend.GetFrontendFullHandlerFunc(func(params frontend.GetFrontendFullParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.GetFrontendFull has not yet been implemented")
+		}),
 		FrontendGetFrontendsHandler: frontend.GetFrontendsHandlerFunc(func(params frontend.GetFrontendsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation frontend.GetFrontends has not yet been implemented")
 		}),
+		GeoIPGetGeoIPHandler: geo_ip.GetGeoIPHandlerFunc(func(params geo_ip.GetGeoIPParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation geo_ip.GetGeoIP has not yet been implemented")
+		}),
+		GeoIPGetGeoIPPoliciesHandler: geo_ip.GetGeoIPPoliciesHandlerFunc(func(params geo_ip.GetGeoIPPoliciesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation geo_ip.GetGeoIPPolicies has not yet been implemented")
+		}),
+		GeoIPGetGeoIPPolicyHandler: geo_ip.GetGeoIPPolicyHandlerFunc(func(params geo_ip.GetGeoIPPolicyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation geo_ip.GetGeoIPPolicy has not yet been implemented")
+		}),
+		GitGetGitConflictsHandler: git.GetGitConflictsHandlerFunc(func(params git.GetGitConflictsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation git.GetGitConflicts has not yet been implemented")
+		}),
+		GitGetGitKnownHostsHandler: git.GetGitKnownHostsHandlerFunc(func(params git.GetGitKnownHostsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation git.GetGitKnownHosts has not yet been implemented")
+		}),
 		GlobalGetGlobalHandler: global.GetGlobalHandlerFunc(func(params global.GetGlobalParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation global.GetGlobal has not yet been implemented")
 		}),
 		ConfigurationGetHAProxyConfigurationHandler: configuration.GetHAProxyConfigurationHandlerFunc(func(params configuration.GetHAProxyConfigurationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.GetHAProxyConfiguration has not yet been implemented")
 		}),
+		HealthGetHAProxyHealthHandler: health.GetHAProxyHealthHandlerFunc(func(params health.GetHAProxyHealthParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation health.GetHAProxyHealth has not yet been implemented")
+		}),
 		HTTPRequestRuleGetHTTPRequestRuleHandler: http_request_rule.GetHTTPRequestRuleHandlerFunc(func(params http_request_rule.GetHTTPRequestRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation http_request_rule.GetHTTPRequestRule has not yet been implemented")
 		}),
@@ -305,21 +547,57 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		HTTPResponseRuleGetHTTPResponseRulesHandler: http_response_rule.GetHTTPResponseRulesHandlerFunc(func(params http_response_rule.GetHTTPResponseRulesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation http_response_rule.GetHTTPResponseRules has not yet been implemented")
 		}),
+		FrontendGetHTTPSRedirectHandler: frontend.GetHTTPSRedirectHandlerFunc(func(params frontend.GetHTTPSRedirectParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.GetHTTPSRedirect has not yet been implemented")
+		}),
 		DiscoveryGetHaproxyEndpointsHandler: discovery.GetHaproxyEndpointsHandlerFunc(func(params discovery.GetHaproxyEndpointsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation discovery.GetHaproxyEndpoints has not yet been implemented")
 		}),
 		InformationGetHaproxyProcessInfoHandler: information.GetHaproxyProcessInfoHandlerFunc(func(params information.GetHaproxyProcessInfoParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation information.GetHaproxyProcessInfo has not yet been implemented")
 		}),
+		InformationGetHaproxyProcessesHandler: information.GetHaproxyProcessesHandlerFunc(func(params information.GetHaproxyProcessesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation information.GetHaproxyProcesses has not yet been implemented")
+		}),
+		HostRoutingGetHostRouteHandler: host_routing.GetHostRouteHandlerFunc(func(params host_routing.GetHostRouteParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation host_routing.GetHostRoute has not yet been implemented")
+		}),
+		HostRoutingGetHostRoutesHandler: host_routing.GetHostRoutesHandlerFunc(func(params host_routing.GetHostRoutesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation host_routing.GetHostRoutes has not yet been implemented")
+		}),
 		InformationGetInfoHandler: information.GetInfoHandlerFunc(func(params information.GetInfoParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation information.GetInfo has not yet been implemented")
 		}),
+		ListenGetListenHandler: listen.GetListenHandlerFunc(func(params listen.GetListenParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.GetListen has not yet been implemented")
+		}),
+		ListenGetListenServerHandler: listen.GetListenServerHandlerFunc(func(params listen.GetListenServerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.GetListenServer has not yet been implemented")
+		}),
+		ListenGetListenServersHandler: listen.GetListenServersHandlerFunc(func(params listen.GetListenServersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.GetListenServers has not yet been implemented")
+		}),
+		ListenGetListensHandler: listen.GetListensHandlerFunc(func(params listen.GetListensParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.GetListens has not yet been implemented")
+		}),
+		LogFormatGetLogFormatHandler: log_format.GetLogFormatHandlerFunc(func(params log_format.GetLogFormatParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation log_format.GetLogFormat has not yet been implemented")
+		}),
+		LogFormatGetLogFormatsHandler: log_format.GetLogFormatsHandlerFunc(func(params log_format.GetLogFormatsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation log_format.GetLogFormats has not yet been implemented")
+		}),
 		LogTargetGetLogTargetHandler: log_target.GetLogTargetHandlerFunc(func(params log_target.GetLogTargetParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation log_target.GetLogTarget has not yet been implemented")
 		}),
 		LogTargetGetLogTargetsHandler: log_target.GetLogTargetsHandlerFunc(func(params log_target.GetLogTargetsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation log_target.GetLogTargets has not yet been implemented")
 		}),
+		MaintenanceGetMaintenanceHandler: maintenance.GetMaintenanceHandlerFunc(func(params maintenance.GetMaintenanceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation maintenance.GetMaintenance has not yet been implemented")
+		}),
+		MaintenanceGetMaintenancesHandler: maintenance.GetMaintenancesHandlerFunc(func(params maintenance.GetMaintenancesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation maintenance.GetMaintenances has not yet been implemented")
+		}),
 		NameserverGetNameserverHandler: nameserver.GetNameserverHandlerFunc(func(params nameserver.GetNameserverParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation nameserver.GetNameserver has not yet been implemented")
 		}),
@@ -329,6 +607,9 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		MapsGetOneRuntimeMapHandler: maps.GetOneRuntimeMapHandlerFunc(func(params maps.GetOneRuntimeMapParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation maps.GetOneRuntimeMap has not yet been implemented")
 		}),
+		StorageGetOneStorageGeneralFileHandler: storage.GetOneStorageGeneralFileHandlerFunc(func(params storage.GetOneStorageGeneralFileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation storage.GetOneStorageGeneralFile has not yet been implemented")
+		}),
 		SpecificationOpenapiv3GetOpenapiv3SpecificationHandler: specification_openapiv3.GetOpenapiv3SpecificationHandlerFunc(func(params specification_openapiv3.GetOpenapiv3SpecificationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation specification_openapiv3.GetOpenapiv3Specification has not yet been implemented")
 		}),
@@ -344,9 +625,27 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		PeerGetPeerSectionsHandler: peer.GetPeerSectionsHandlerFunc(func(params peer.GetPeerSectionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation peer.GetPeerSections has not yet been implemented")
 		}),
+		InformationGetPreflightHandler: information.GetPreflightHandlerFunc(func(params information.GetPreflightParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation information.GetPreflight has not yet been implemented")
+		}),
+		InformationGetPrivilegesHandler: information.GetPrivilegesHandlerFunc(func(params information.GetPrivilegesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation information.GetPrivileges has not yet been implemented")
+		}),
+		RateLimitGetRateLimitHandler: rate_limit.GetRateLimitHandlerFunc(func(params rate_limit.GetRateLimitParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation rate_limit.GetRateLimit has not yet been implemented")
+		}),
+		RateLimitGetRateLimitOffendersHandler: rate_limit.GetRateLimitOffendersHandlerFunc(func(params rate_limit.GetRateLimitOffendersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation rate_limit.GetRateLimitOffenders has not yet been implemented")
+		}),
+		RateLimitGetRateLimitsHandler: rate_limit.GetRateLimitsHandlerFunc(func(params rate_limit.GetRateLimitsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation rate_limit.GetRateLimits has not yet been implemented")
+		}),
 		ReloadsGetReloadHandler: reloads.GetReloadHandlerFunc(func(params reloads.GetReloadParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation reloads.GetReload has not yet been implemented")
 		}),
+		ReloadsGetReloadFreezeHandler: reloads.GetReloadFreezeHandlerFunc(func(params reloads.GetReloadFreezeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation reloads.GetReloadFreeze has not yet been implemented")
+		}),
 		ReloadsGetReloadsHandler: reloads.GetReloadsHandlerFunc(func(params reloads.GetReloadsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation reloads.GetReloads has not yet been implemented")
 		}),
@@ -356,9 +655,27 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		ResolverGetResolversHandler: resolver.GetResolversHandlerFunc(func(params resolver.GetResolversParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation resolver.GetResolvers has not yet been implemented")
 		}),
+		TracesGetRingEventsHandler: traces.GetRingEventsHandlerFunc(func(params traces.GetRingEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation traces.GetRingEvents has not yet been implemented")
+		}),
+		BackendGetRuntimeBackendHandler: backend.GetRuntimeBackendHandlerFunc(func(params backend.GetRuntimeBackendParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation backend.GetRuntimeBackend has not yet been implemented")
+		}),
+		BackendGetRuntimeBackendsHandler: backend.GetRuntimeBackendsHandlerFunc(func(params backend.GetRuntimeBackendsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation backend.GetRuntimeBackends has not yet been implemented")
+		}),
 		DiscoveryGetRuntimeEndpointsHandler: discovery.GetRuntimeEndpointsHandlerFunc(func(params discovery.GetRuntimeEndpointsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation discovery.GetRuntimeEndpoints has not yet been implemented")
 		}),
+		FrontendGetRuntimeFrontendHandler: frontend.GetRuntimeFrontendHandlerFunc(func(params frontend.GetRuntimeFrontendParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.GetRuntimeFrontend has not yet been implemented")
+		}),
+		FrontendGetRuntimeFrontendsHandler: frontend.GetRuntimeFrontendsHandlerFunc(func(params frontend.GetRuntimeFrontendsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.GetRuntimeFrontends has not yet been implemented")
+		}),
+		TracesGetRuntimeLogsHandler: traces.GetRuntimeLogsHandlerFunc(func(params traces.GetRuntimeLogsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation traces.GetRuntimeLogs has not yet been implemented")
+		}),
 		MapsGetRuntimeMapEntryHandler: maps.GetRuntimeMapEntryHandlerFunc(func(params maps.GetRuntimeMapEntryParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation maps.GetRuntimeMapEntry has not yet been implemented")
 		}),
@@ -368,15 +685,27 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		ServerGetRuntimeServersHandler: server.GetRuntimeServersHandlerFunc(func(params server.GetRuntimeServersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation server.GetRuntimeServers has not yet been implemented")
 		}),
+		CertificatesGetSSLCertificatesHandler: certificates.GetSSLCertificatesHandlerFunc(func(params certificates.GetSSLCertificatesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation certificates.GetSSLCertificates has not yet been implemented")
+		}),
+		SecurityOptionsGetSecurityOptionsHandler: security_options.GetSecurityOptionsHandlerFunc(func(params security_options.GetSecurityOptionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation security_options.GetSecurityOptions has not yet been implemented")
+		}),
 		ServerGetServerHandler: server.GetServerHandlerFunc(func(params server.GetServerParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation server.GetServer has not yet been implemented")
 		}),
+		ServerGetServerNetworkHandler: server.GetServerNetworkHandlerFunc(func(params server.GetServerNetworkParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation server.GetServerNetwork has not yet been implemented")
+		}),
 		ServerSwitchingRuleGetServerSwitchingRuleHandler: server_switching_rule.GetServerSwitchingRuleHandlerFunc(func(params server_switching_rule.GetServerSwitchingRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation server_switching_rule.GetServerSwitchingRule has not yet been implemented")
 		}),
 		ServerSwitchingRuleGetServerSwitchingRulesHandler: server_switching_rule.GetServerSwitchingRulesHandlerFunc(func(params server_switching_rule.GetServerSwitchingRulesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation server_switching_rule.GetServerSwitchingRules has not yet been implemented")
 		}),
+		ServerGetServerWarmupHandler: server.GetServerWarmupHandlerFunc(func(params server.GetServerWarmupParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation server.GetServerWarmup has not yet been implemented")
+		}),
 		ServerGetServersHandler: server.GetServersHandlerFunc(func(params server.GetServersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation server.GetServers has not yet been implemented")
 		}),
@@ -389,15 +718,33 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		SitesGetSitesHandler: sites.GetSitesHandlerFunc(func(params sites.GetSitesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation sites.GetSites has not yet been implemented")
 		}),
+		SnapshotGetSnapshotHandler: snapshot.GetSnapshotHandlerFunc(func(params snapshot.GetSnapshotParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation snapshot.GetSnapshot has not yet been implemented")
+		}),
 		SpecificationGetSpecificationHandler: specification.GetSpecificationHandlerFunc(func(params specification.GetSpecificationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation specification.GetSpecification has not yet been implemented")
 		}),
+		SpecificationGetSpecificationSchemaHandler: specification.GetSpecificationSchemaHandlerFunc(func(params specification.GetSpecificationSchemaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation specification.GetSpecificationSchema has not yet been implemented")
+		}),
+		SpoeAgentGetSpoeAgentHandler: spoe_agent.GetSpoeAgentHandlerFunc(func(params spoe_agent.GetSpoeAgentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation spoe_agent.GetSpoeAgent has not yet been implemented")
+		}),
+		SpoeAgentGetSpoeAgentsHandler: spoe_agent.GetSpoeAgentsHandlerFunc(func(params spoe_agent.GetSpoeAgentsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation spoe_agent.GetSpoeAgents has not yet been implemented")
+		}),
 		StatsGetStatsHandler: stats.GetStatsHandlerFunc(func(params stats.GetStatsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation stats.GetStats has not yet been implemented")
 		}),
 		DiscoveryGetStatsEndpointsHandler: discovery.GetStatsEndpointsHandlerFunc(func(params discovery.GetStatsEndpointsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation discovery.GetStatsEndpoints has not yet been implemented")
 		}),
+		StatsPageGetStatsPageHandler: stats_page.GetStatsPageHandlerFunc(func(params stats_page.GetStatsPageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation stats_page.GetStatsPage has not yet been implemented")
+		}),
+		StatsPageGetStatsSocketsHandler: stats_page.GetStatsSocketsHandlerFunc(func(params stats_page.GetStatsSocketsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation stats_page.GetStatsSockets has not yet been implemented")
+		}),
 		StickRuleGetStickRuleHandler: stick_rule.GetStickRuleHandlerFunc(func(params stick_rule.GetStickRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation stick_rule.GetStickRule has not yet been implemented")
 		}),
@@ -413,6 +760,9 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		StickTableGetStickTablesHandler: stick_table.GetStickTablesHandlerFunc(func(params stick_table.GetStickTablesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation stick_table.GetStickTables has not yet been implemented")
 		}),
+		StorageGetStorageCleanupHandler: storage.GetStorageCleanupHandlerFunc(func(params storage.GetStorageCleanupParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation storage.GetStorageCleanup has not yet been implemented")
+		}),
 		TCPRequestRuleGetTCPRequestRuleHandler: tcp_request_rule.GetTCPRequestRuleHandlerFunc(func(params tcp_request_rule.GetTCPRequestRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation tcp_request_rule.GetTCPRequestRule has not yet been implemented")
 		}),
@@ -425,45 +775,126 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		TCPResponseRuleGetTCPResponseRulesHandler: tcp_response_rule.GetTCPResponseRulesHandlerFunc(func(params tcp_response_rule.GetTCPResponseRulesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation tcp_response_rule.GetTCPResponseRules has not yet been implemented")
 		}),
+		TLSProfileGetTLSProfileHandler: tls_profile.GetTLSProfileHandlerFunc(func(params tls_profile.GetTLSProfileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tls_profile.GetTLSProfile has not yet been implemented")
+		}),
+		TLSProfileGetTLSProfileDeviationsHandler: tls_profile.GetTLSProfileDeviationsHandlerFunc(func(params tls_profile.GetTLSProfileDeviationsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tls_profile.GetTLSProfileDeviations has not yet been implemented")
+		}),
+		TLSProfileGetTLSProfilesHandler: tls_profile.GetTLSProfilesHandlerFunc(func(params tls_profile.GetTLSProfilesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tls_profile.GetTLSProfiles has not yet been implemented")
+		}),
+		GlobalGetThreadingHandler: global.GetThreadingHandlerFunc(func(params global.GetThreadingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation global.GetThreading has not yet been implemented")
+		}),
+		TracesGetTracesHandler: traces.GetTracesHandlerFunc(func(params traces.GetTracesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation traces.GetTraces has not yet been implemented")
+		}),
 		TransactionsGetTransactionHandler: transactions.GetTransactionHandlerFunc(func(params transactions.GetTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transactions.GetTransaction has not yet been implemented")
 		}),
+		TransactionsGetTransactionImpactHandler: transactions.GetTransactionImpactHandlerFunc(func(params transactions.GetTransactionImpactParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transactions.GetTransactionImpact has not yet been implemented")
+		}),
+		TransactionsGetTransactionStorageHandler: transactions.GetTransactionStorageHandlerFunc(func(params transactions.GetTransactionStorageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transactions.GetTransactionStorage has not yet been implemented")
+		}),
 		TransactionsGetTransactionsHandler: transactions.GetTransactionsHandlerFunc(func(params transactions.GetTransactionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transactions.GetTransactions has not yet been implemented")
 		}),
+		GitGitWebhookHandler: git.GitWebhookHandlerFunc(func(params git.GitWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation git.GitWebhook has not yet been implemented")
+		}),
+		ConfigurationImportConfigurationHandler: configuration.ImportConfigurationHandlerFunc(func(params configuration.ImportConfigurationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ImportConfiguration has not yet been implemented")
+		}),
 		ClusterInitiateCertificateRefreshHandler: cluster.InitiateCertificateRefreshHandlerFunc(func(params cluster.InitiateCertificateRefreshParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation cluster.InitiateCertificateRefresh has not yet been implemented")
 		}),
+		ConfigurationMigrateConfigurationHandler: configuration.MigrateConfigurationHandlerFunc(func(params configuration.MigrateConfigurationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.MigrateConfiguration has not yet been implemented")
+		}),
+		ConfigurationPlanConfigurationHandler: configuration.PlanConfigurationHandlerFunc(func(params configuration.PlanConfigurationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.PlanConfiguration has not yet been implemented")
+		}),
 		ClusterPostClusterHandler: cluster.PostClusterHandlerFunc(func(params cluster.PostClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation cluster.PostCluster has not yet been implemented")
 		}),
 		ConfigurationPostHAProxyConfigurationHandler: configuration.PostHAProxyConfigurationHandlerFunc(func(params configuration.PostHAProxyConfigurationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.PostHAProxyConfiguration has not yet been implemented")
 		}),
+		FleetPrepareFleetConfigurationHandler: fleet.PrepareFleetConfigurationHandlerFunc(func(params fleet.PrepareFleetConfigurationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fleet.PrepareFleetConfiguration has not yet been implemented")
+		}),
+		FleetPushFleetHandler: fleet.PushFleetHandlerFunc(func(params fleet.PushFleetParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fleet.PushFleet has not yet been implemented")
+		}),
+		GeoIPRefreshGeoIPHandler: geo_ip.RefreshGeoIPHandlerFunc(func(params geo_ip.RefreshGeoIPParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation geo_ip.RefreshGeoIP has not yet been implemented")
+		}),
+		UsersReplaceAPIKeyHandler: users.ReplaceAPIKeyHandlerFunc(func(params users.ReplaceAPIKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.ReplaceAPIKey has not yet been implemented")
+		}),
 		ACLReplaceACLHandler: acl.ReplaceACLHandlerFunc(func(params acl.ReplaceACLParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation acl.ReplaceACL has not yet been implemented")
 		}),
+		AnnotationsReplaceAnnotationHandler: annotations.ReplaceAnnotationHandlerFunc(func(params annotations.ReplaceAnnotationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation annotations.ReplaceAnnotation has not yet been implemented")
+		}),
 		BackendReplaceBackendHandler: backend.ReplaceBackendHandlerFunc(func(params backend.ReplaceBackendParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation backend.ReplaceBackend has not yet been implemented")
 		}),
 		BackendSwitchingRuleReplaceBackendSwitchingRuleHandler: backend_switching_rule.ReplaceBackendSwitchingRuleHandlerFunc(func(params backend_switching_rule.ReplaceBackendSwitchingRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation backend_switching_rule.ReplaceBackendSwitchingRule has not yet been implemented")
 		}),
+		BandwidthLimitReplaceBandwidthLimitHandler: bandwidth_limit.ReplaceBandwidthLimitHandlerFunc(func(params bandwidth_limit.ReplaceBandwidthLimitParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.ReplaceBandwidthLimit has not yet been implemented")
+		}),
 		BindReplaceBindHandler: bind.ReplaceBindHandlerFunc(func(params bind.ReplaceBindParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation bind.ReplaceBind has not yet been implemented")
 		}),
+		BindReplaceBindNetworkHandler: bind.ReplaceBindNetworkHandlerFunc(func(params bind.ReplaceBindNetworkParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bind.ReplaceBindNetwork has not yet been implemented")
+		}),
+		BindReplaceBindSSLHandler: bind.ReplaceBindSSLHandlerFunc(func(params bind.ReplaceBindSSLParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bind.ReplaceBindSSL has not yet been implemented")
+		}),
+		GlobalReplaceCertificateUpdateHandler: global.ReplaceCertificateUpdateHandlerFunc(func(params global.ReplaceCertificateUpdateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation global.ReplaceCertificateUpdate has not yet been implemented")
+		}),
+		CompressionReplaceCompressionHandler: compression.ReplaceCompressionHandlerFunc(func(params compression.ReplaceCompressionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation compression.ReplaceCompression has not yet been implemented")
+		}),
 		ServiceDiscoveryReplaceConsulHandler: service_discovery.ReplaceConsulHandlerFunc(func(params service_discovery.ReplaceConsulParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation service_discovery.ReplaceConsul has not yet been implemented")
 		}),
+		GlobalReplaceDHParamHandler: global.ReplaceDHParamHandlerFunc(func(params global.ReplaceDHParamParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation global.ReplaceDHParam has not yet been implemented")
+		}),
 		DefaultsReplaceDefaultsHandler: defaults.ReplaceDefaultsHandlerFunc(func(params defaults.ReplaceDefaultsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation defaults.ReplaceDefaults has not yet been implemented")
 		}),
+		GlobalReplaceDeviceDetectionHandler: global.ReplaceDeviceDetectionHandlerFunc(func(params global.ReplaceDeviceDetectionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation global.ReplaceDeviceDetection has not yet been implemented")
+		}),
+		BackendReplaceDynamicCookieKeyHandler: backend.ReplaceDynamicCookieKeyHandlerFunc(func(params backend.ReplaceDynamicCookieKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation backend.ReplaceDynamicCookieKey has not yet been implemented")
+		}),
 		FilterReplaceFilterHandler: filter.ReplaceFilterHandlerFunc(func(params filter.ReplaceFilterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation filter.ReplaceFilter has not yet been implemented")
 		}),
 		FrontendReplaceFrontendHandler: frontend.ReplaceFrontendHandlerFunc(func(params frontend.ReplaceFrontendParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation frontend.ReplaceFrontend has not yet been implemented")
 		}),
+		FrontendReplaceFrontendCircuitBreakerHandler: frontend.ReplaceFrontendCircuitBreakerHandlerFunc(func(params frontend.ReplaceFrontendCircuitBreakerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.ReplaceFrontendCircuitBreaker has not yet been implemented")
+		}),
+		GeoIPReplaceGeoIPPolicyHandler: geo_ip.ReplaceGeoIPPolicyHandlerFunc(func(params geo_ip.ReplaceGeoIPPolicyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation geo_ip.ReplaceGeoIPPolicy has not yet been implemented")
+		}),
+		GitReplaceGitKnownHostsHandler: git.ReplaceGitKnownHostsHandlerFunc(func(params git.ReplaceGitKnownHostsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation git.ReplaceGitKnownHosts has not yet been implemented")
+		}),
 		GlobalReplaceGlobalHandler: global.ReplaceGlobalHandlerFunc(func(params global.ReplaceGlobalParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation global.ReplaceGlobal has not yet been implemented")
 		}),
@@ -473,48 +904,135 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 		HTTPResponseRuleReplaceHTTPResponseRuleHandler: http_response_rule.ReplaceHTTPResponseRuleHandlerFunc(func(params http_response_rule.ReplaceHTTPResponseRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation http_response_rule.ReplaceHTTPResponseRule has not yet been implemented")
 		}),
+		FrontendReplaceHTTPSRedirectHandler: frontend.ReplaceHTTPSRedirectHandlerFunc(func(params frontend.ReplaceHTTPSRedirectParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.ReplaceHTTPSRedirect has not yet been implemented")
+		}),
+		ListenReplaceListenHandler: listen.ReplaceListenHandlerFunc(func(params listen.ReplaceListenParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.ReplaceListen has not yet been implemented")
+		}),
+		ListenReplaceListenServerHandler: listen.ReplaceListenServerHandlerFunc(func(params listen.ReplaceListenServerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation listen.ReplaceListenServer has not yet been implemented")
+		}),
+		LogFormatReplaceLogFormatHandler: log_format.ReplaceLogFormatHandlerFunc(func(params log_format.ReplaceLogFormatParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation log_format.ReplaceLogFormat has not yet been implemented")
+		}),
 		LogTargetReplaceLogTargetHandler: log_target.ReplaceLogTargetHandlerFunc(func(params log_target.ReplaceLogTargetParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation log_target.ReplaceLogTarget has not yet been implemented")
 		}),
+		MaintenanceReplaceMaintenanceHandler: maintenance.ReplaceMaintenanceHandlerFunc(func(params maintenance.ReplaceMaintenanceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation maintenance.ReplaceMaintenance has not yet been implemented")
+		}),
 		NameserverReplaceNameserverHandler: nameserver.ReplaceNameserverHandlerFunc(func(params nameserver.ReplaceNameserverParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation nameserver.ReplaceNameserver has not yet been implemented")
 		}),
 		PeerEntryReplacePeerEntryHandler: peer_entry.ReplacePeerEntryHandlerFunc(func(params peer_entry.ReplacePeerEntryParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation peer_entry.ReplacePeerEntry has not yet been implemented")
 		}),
+		RateLimitReplaceRateLimitHandler: rate_limit.ReplaceRateLimitHandlerFunc(func(params rate_limit.ReplaceRateLimitParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation rate_limit.ReplaceRateLimit has not yet been implemented")
+		}),
 		ResolverReplaceResolverHandler: resolver.ReplaceResolverHandlerFunc(func(params resolver.ReplaceResolverParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation resolver.ReplaceResolver has not yet been implemented")
 		}),
+		BackendReplaceRuntimeBackendHandler: backend.ReplaceRuntimeBackendHandlerFunc(func(params backend.ReplaceRuntimeBackendParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation backend.ReplaceRuntimeBackend has not yet been implemented")
+		}),
+		FrontendReplaceRuntimeFrontendHandler: frontend.ReplaceRuntimeFrontendHandlerFunc(func(params frontend.ReplaceRuntimeFrontendParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation frontend.ReplaceRuntimeFrontend has not yet been implemented")
+		}),
 		MapsReplaceRuntimeMapEntryHandler: maps.ReplaceRuntimeMapEntryHandlerFunc(func(params maps.ReplaceRuntimeMapEntryParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation maps.ReplaceRuntimeMapEntry has not yet been implemented")
 		}),
 		ServerReplaceRuntimeServerHandler: server.ReplaceRuntimeServerHandlerFunc(func(params server.ReplaceRuntimeServerParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation server.ReplaceRuntimeServer has not yet been implemented")
 		}),
+		ServerReplaceRuntimeWeightsHandler: server.ReplaceRuntimeWeightsHandlerFunc(func(params server.ReplaceRuntimeWeightsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation server.ReplaceRuntimeWeights has not yet been implemented")
+		}),
+		SecurityOptionsReplaceSecurityOptionsHandler: security_options.ReplaceSecurityOptionsHandlerFunc(func(params security_options.ReplaceSecurityOptionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation security_options.ReplaceSecurityOptions has not yet been implemented")
+		}),
+		UsersReplaceSelfPasswordHandler: users.ReplaceSelfPasswordHandlerFunc(func(params users.ReplaceSelfPasswordParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.ReplaceSelfPassword has not yet been implemented")
+		}),
 		ServerReplaceServerHandler: server.ReplaceServerHandlerFunc(func(params server.ReplaceServerParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation server.ReplaceServer has not yet been implemented")
 		}),
+		ServerReplaceServerNetworkHandler: server.ReplaceServerNetworkHandlerFunc(func(params server.ReplaceServerNetworkParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation server.ReplaceServerNetwork has not yet been implemented")
+		}),
 		ServerSwitchingRuleReplaceServerSwitchingRuleHandler: server_switching_rule.ReplaceServerSwitchingRuleHandlerFunc(func(params server_switching_rule.ReplaceServerSwitchingRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation server_switching_rule.ReplaceServerSwitchingRule has not yet been implemented")
 		}),
+		ServerReplaceServerWarmupHandler: server.ReplaceServerWarmupHandlerFunc(func(params server.ReplaceServerWarmupParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation server.ReplaceServerWarmup has not yet been implemented")
+		}),
 		SitesReplaceSiteHandler: sites.ReplaceSiteHandlerFunc(func(params sites.ReplaceSiteParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation sites.ReplaceSite has not yet been implemented")
 		}),
+		SpoeAgentReplaceSpoeAgentHandler: spoe_agent.ReplaceSpoeAgentHandlerFunc(func(params spoe_agent.ReplaceSpoeAgentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation spoe_agent.ReplaceSpoeAgent has not yet been implemented")
+		}),
+		StatsPageReplaceStatsPageHandler: stats_page.ReplaceStatsPageHandlerFunc(func(params stats_page.ReplaceStatsPageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation stats_page.ReplaceStatsPage has not yet been implemented")
+		}),
+		StatsPageReplaceStatsSocketsHandler: stats_page.ReplaceStatsSocketsHandlerFunc(func(params stats_page.ReplaceStatsSocketsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation stats_page.ReplaceStatsSockets has not yet been implemented")
+		}),
 		StickRuleReplaceStickRuleHandler: stick_rule.ReplaceStickRuleHandlerFunc(func(params stick_rule.ReplaceStickRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation stick_rule.ReplaceStickRule has not yet been implemented")
 		}),
+		StorageReplaceStorageGeneralFileHandler: storage.ReplaceStorageGeneralFileHandlerFunc(func(params storage.ReplaceStorageGeneralFileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation storage.ReplaceStorageGeneralFile has not yet been implemented")
+		}),
 		TCPRequestRuleReplaceTCPRequestRuleHandler: tcp_request_rule.ReplaceTCPRequestRuleHandlerFunc(func(params tcp_request_rule.ReplaceTCPRequestRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation tcp_request_rule.ReplaceTCPRequestRule has not yet been implemented")
 		}),
 		TCPResponseRuleReplaceTCPResponseRuleHandler: tcp_response_rule.ReplaceTCPResponseRuleHandlerFunc(func(params tcp_response_rule.ReplaceTCPResponseRuleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation tcp_response_rule.ReplaceTCPResponseRule has not yet been implemented")
 		}),
+		TLSProfileReplaceTLSProfileHandler: tls_profile.ReplaceTLSProfileHandlerFunc(func(params tls_profile.ReplaceTLSProfileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tls_profile.ReplaceTLSProfile has not yet been implemented")
+		}),
+		GlobalReplaceThreadingHandler: global.ReplaceThreadingHandlerFunc(func(params global.ReplaceThreadingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation global.ReplaceThreading has not yet been implemented")
+		}),
+		TracesReplaceTraceHandler: traces.ReplaceTraceHandlerFunc(func(params traces.ReplaceTraceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation traces.ReplaceTrace has not yet been implemented")
+		}),
+		TransactionsReplaceTransactionMetadataHandler: transactions.ReplaceTransactionMetadataHandlerFunc(func(params transactions.ReplaceTransactionMetadataParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transactions.ReplaceTransactionMetadata has not yet been implemented")
+		}),
+		GitResolveGitConflictsHandler: git.ResolveGitConflictsHandlerFunc(func(params git.ResolveGitConflictsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation git.ResolveGitConflicts has not yet been implemented")
+		}),
+		SnapshotRestoreSnapshotHandler: snapshot.RestoreSnapshotHandlerFunc(func(params snapshot.RestoreSnapshotParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation snapshot.RestoreSnapshot has not yet been implemented")
+		}),
+		ReloadsRetryReloadHandler: reloads.RetryReloadHandlerFunc(func(params reloads.RetryReloadParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation reloads.RetryReload has not yet been implemented")
+		}),
 		MapsShowRuntimeMapHandler: maps.ShowRuntimeMapHandlerFunc(func(params maps.ShowRuntimeMapParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation maps.ShowRuntimeMap has not yet been implemented")
 		}),
 		TransactionsStartTransactionHandler: transactions.StartTransactionHandlerFunc(func(params transactions.StartTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transactions.StartTransaction has not yet been implemented")
 		}),
+		InformationStopOldWorkersHandler: information.StopOldWorkersHandlerFunc(func(params information.StopOldWorkersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation information.StopOldWorkers has not yet been implemented")
+		}),
+		GitTestGitRemoteHandler: git.TestGitRemoteHandlerFunc(func(params git.TestGitRemoteParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation git.TestGitRemote has not yet been implemented")
+		}),
+		ReloadsUnfreezeReloadsHandler: reloads.UnfreezeReloadsHandlerFunc(func(params reloads.UnfreezeReloadsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation reloads.UnfreezeReloads has not yet been implemented")
+		}),
+		ACLValidateACLHandler: acl.ValidateACLHandlerFunc(func(params acl.ValidateACLParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation acl.ValidateACL has not yet been implemented")
+		}),
+		LogFormatValidateLogFormatHandler: log_format.ValidateLogFormatHandlerFunc(func(params log_format.ValidateLogFormatParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation log_format.ValidateLogFormat has not yet been implemented")
+		}),
 
 		// Applies when the Authorization header is set with the Basic scheme
 		BasicAuthAuth: func(user string, pass string) (interface{}, error) {
@@ -525,7 +1043,8 @@ func NewDataPlaneAPI(spec *loads.Document) *DataPlaneAPI {
 	}
 }
 
-/*DataPlaneAPI API for editing and managing haproxy instances. Provides process information, configuration management,
+/*
+DataPlaneAPI API for editing and managing haproxy instances. Provides process information, configuration management,
 haproxy stats and logs.
 */
 type DataPlaneAPI struct {
@@ -562,6 +1081,9 @@ type DataPlaneAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TxtProducer registers a producer for the following mime types:
+	//   - text/plain
+	TxtProducer runtime.Producer
 
 	// BasicAuthAuth registers a function that takes username and password and returns a principal
 	// it performs authentication with basic auth
@@ -570,22 +1092,42 @@ type DataPlaneAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// FleetAbortFleetConfigurationHandler sets the operation handler for the abort fleet configuration operation
+	FleetAbortFleetConfigurationHandler fleet.AbortFleetConfigurationHandler
 	// MapsAddMapEntryHandler sets the operation handler for the add map entry operation
 	MapsAddMapEntryHandler maps.AddMapEntryHandler
+	// ConfigurationApplyBatchHandler sets the operation handler for the apply batch operation
+	ConfigurationApplyBatchHandler configuration.ApplyBatchHandler
+	// ConfigurationApplyConfigurationHandler sets the operation handler for the apply configuration operation
+	ConfigurationApplyConfigurationHandler configuration.ApplyConfigurationHandler
+	// LogFormatApplyLogFormatHandler sets the operation handler for the apply log format operation
+	LogFormatApplyLogFormatHandler log_format.ApplyLogFormatHandler
+	// SecurityOptionsApplySecurityBaselineHandler sets the operation handler for the apply security baseline operation
+	SecurityOptionsApplySecurityBaselineHandler security_options.ApplySecurityBaselineHandler
+	// TLSProfileApplyTLSProfileHandler sets the operation handler for the apply TLS profile operation
+	TLSProfileApplyTLSProfileHandler tls_profile.ApplyTLSProfileHandler
 	// MapsClearRuntimeMapHandler sets the operation handler for the clear runtime map operation
 	MapsClearRuntimeMapHandler maps.ClearRuntimeMapHandler
+	// FleetCommitFleetConfigurationHandler sets the operation handler for the commit fleet configuration operation
+	FleetCommitFleetConfigurationHandler fleet.CommitFleetConfigurationHandler
 	// TransactionsCommitTransactionHandler sets the operation handler for the commit transaction operation
 	TransactionsCommitTransactionHandler transactions.CommitTransactionHandler
+	// UsersCreateAPIKeyHandler sets the operation handler for the create API key operation
+	UsersCreateAPIKeyHandler users.CreateAPIKeyHandler
 	// ACLCreateACLHandler sets the operation handler for the create Acl operation
 	ACLCreateACLHandler acl.CreateACLHandler
 	// BackendCreateBackendHandler sets the operation handler for the create backend operation
 	BackendCreateBackendHandler backend.CreateBackendHandler
 	// BackendSwitchingRuleCreateBackendSwitchingRuleHandler sets the operation handler for the create backend switching rule operation
 	BackendSwitchingRuleCreateBackendSwitchingRuleHandler backend_switching_rule.CreateBackendSwitchingRuleHandler
+	// BandwidthLimitCreateBandwidthLimitHandler sets the operation handler for the create bandwidth limit operation
+	BandwidthLimitCreateBandwidthLimitHandler bandwidth_limit.CreateBandwidthLimitHandler
 	// BindCreateBindHandler sets the operation handler for the create bind operation
 	BindCreateBindHandler bind.CreateBindHandler
 	// ServiceDiscoveryCreateConsulHandler sets the operation handler for the create consul operation
 	ServiceDiscoveryCreateConsulHandler service_discovery.CreateConsulHandler
+	// DrainCreateDrainHandler sets the operation handler for the create drain operation
+	DrainCreateDrainHandler drain.CreateDrainHandler
 	// FilterCreateFilterHandler sets the operation handler for the create filter operation
 	FilterCreateFilterHandler filter.CreateFilterHandler
 	// FrontendCreateFrontendHandler sets the operation handler for the create frontend operation
@@ -594,6 +1136,14 @@ type DataPlaneAPI struct {
 	HTTPRequestRuleCreateHTTPRequestRuleHandler http_request_rule.CreateHTTPRequestRuleHandler
 	// HTTPResponseRuleCreateHTTPResponseRuleHandler sets the operation handler for the create HTTP response rule operation
 	HTTPResponseRuleCreateHTTPResponseRuleHandler http_response_rule.CreateHTTPResponseRuleHandler
+	// HostRoutingCreateHostRouteHandler sets the operation handler for the create host route operation
+	HostRoutingCreateHostRouteHandler host_routing.CreateHostRouteHandler
+	// ListenCreateListenHandler sets the operation handler for the create listen operation
+	ListenCreateListenHandler listen.CreateListenHandler
+	// ListenCreateListenServerHandler sets the operation handler for the create listen server operation
+	ListenCreateListenServerHandler listen.CreateListenServerHandler
+	// LogFormatCreateLogFormatHandler sets the operation handler for the create log format operation
+	LogFormatCreateLogFormatHandler log_format.CreateLogFormatHandler
 	// LogTargetCreateLogTargetHandler sets the operation handler for the create log target operation
 	LogTargetCreateLogTargetHandler log_target.CreateLogTargetHandler
 	// NameserverCreateNameserverHandler sets the operation handler for the create nameserver operation
@@ -602,6 +1152,8 @@ type DataPlaneAPI struct {
 	PeerCreatePeerHandler peer.CreatePeerHandler
 	// PeerEntryCreatePeerEntryHandler sets the operation handler for the create peer entry operation
 	PeerEntryCreatePeerEntryHandler peer_entry.CreatePeerEntryHandler
+	// RateLimitCreateRateLimitHandler sets the operation handler for the create rate limit operation
+	RateLimitCreateRateLimitHandler rate_limit.CreateRateLimitHandler
 	// ResolverCreateResolverHandler sets the operation handler for the create resolver operation
 	ResolverCreateResolverHandler resolver.CreateResolverHandler
 	// MapsCreateRuntimeMapHandler sets the operation handler for the create runtime map operation
@@ -612,38 +1164,72 @@ type DataPlaneAPI struct {
 	ServerSwitchingRuleCreateServerSwitchingRuleHandler server_switching_rule.CreateServerSwitchingRuleHandler
 	// SitesCreateSiteHandler sets the operation handler for the create site operation
 	SitesCreateSiteHandler sites.CreateSiteHandler
+	// SpoeAgentCreateSpoeAgentHandler sets the operation handler for the create spoe agent operation
+	SpoeAgentCreateSpoeAgentHandler spoe_agent.CreateSpoeAgentHandler
 	// StickRuleCreateStickRuleHandler sets the operation handler for the create stick rule operation
 	StickRuleCreateStickRuleHandler stick_rule.CreateStickRuleHandler
+	// StorageCreateStorageDHParamHandler sets the operation handler for the create storage d h param operation
+	StorageCreateStorageDHParamHandler storage.CreateStorageDHParamHandler
+	// StorageCreateStorageGeneralFileHandler sets the operation handler for the create storage general file operation
+	StorageCreateStorageGeneralFileHandler storage.CreateStorageGeneralFileHandler
 	// TCPRequestRuleCreateTCPRequestRuleHandler sets the operation handler for the create TCP request rule operation
 	TCPRequestRuleCreateTCPRequestRuleHandler tcp_request_rule.CreateTCPRequestRuleHandler
 	// TCPResponseRuleCreateTCPResponseRuleHandler sets the operation handler for the create TCP response rule operation
 	TCPResponseRuleCreateTCPResponseRuleHandler tcp_response_rule.CreateTCPResponseRuleHandler
+	// TLSProfileCreateTLSProfileHandler sets the operation handler for the create TLS profile operation
+	TLSProfileCreateTLSProfileHandler tls_profile.CreateTLSProfileHandler
+	// UsersDeleteAPIKeyHandler sets the operation handler for the delete API key operation
+	UsersDeleteAPIKeyHandler users.DeleteAPIKeyHandler
 	// ACLDeleteACLHandler sets the operation handler for the delete Acl operation
 	ACLDeleteACLHandler acl.DeleteACLHandler
+	// AnnotationsDeleteAnnotationHandler sets the operation handler for the delete annotation operation
+	AnnotationsDeleteAnnotationHandler annotations.DeleteAnnotationHandler
 	// BackendDeleteBackendHandler sets the operation handler for the delete backend operation
 	BackendDeleteBackendHandler backend.DeleteBackendHandler
 	// BackendSwitchingRuleDeleteBackendSwitchingRuleHandler sets the operation handler for the delete backend switching rule operation
 	BackendSwitchingRuleDeleteBackendSwitchingRuleHandler backend_switching_rule.DeleteBackendSwitchingRuleHandler
+	// BandwidthLimitDeleteBandwidthLimitHandler sets the operation handler for the delete bandwidth limit operation
+	BandwidthLimitDeleteBandwidthLimitHandler bandwidth_limit.DeleteBandwidthLimitHandler
 	// BindDeleteBindHandler sets the operation handler for the delete bind operation
 	BindDeleteBindHandler bind.DeleteBindHandler
 	// ServiceDiscoveryDeleteConsulHandler sets the operation handler for the delete consul operation
 	ServiceDiscoveryDeleteConsulHandler service_discovery.DeleteConsulHandler
+	// BackendDeleteDynamicCookieKeyHandler sets the operation handler for the delete dynamic cookie key operation
+	BackendDeleteDynamicCookieKeyHandler backend.DeleteDynamicCookieKeyHandler
 	// FilterDeleteFilterHandler sets the operation handler for the delete filter operation
 	FilterDeleteFilterHandler filter.DeleteFilterHandler
 	// FrontendDeleteFrontendHandler sets the operation handler for the delete frontend operation
 	FrontendDeleteFrontendHandler frontend.DeleteFrontendHandler
+	// FrontendDeleteFrontendCircuitBreakerHandler sets the operation handler for the delete frontend circuit breaker operation
+	FrontendDeleteFrontendCircuitBreakerHandler frontend.DeleteFrontendCircuitBreakerHandler
+	// GeoIPDeleteGeoIPPolicyHandler sets the operation handler for the delete geo IP policy operation
+	GeoIPDeleteGeoIPPolicyHandler geo_ip.DeleteGeoIPPolicyHandler
 	// HTTPRequestRuleDeleteHTTPRequestRuleHandler sets the operation handler for the delete HTTP request rule operation
 	HTTPRequestRuleDeleteHTTPRequestRuleHandler http_request_rule.DeleteHTTPRequestRuleHandler
 	// HTTPResponseRuleDeleteHTTPResponseRuleHandler sets the operation handler for the delete HTTP response rule operation
 	HTTPResponseRuleDeleteHTTPResponseRuleHandler http_response_rule.DeleteHTTPResponseRuleHandler
+	// FrontendDeleteHTTPSRedirectHandler sets the operation handler for the delete HTTPS redirect operation
+	FrontendDeleteHTTPSRedirectHandler frontend.DeleteHTTPSRedirectHandler
+	// HostRoutingDeleteHostRouteHandler sets the operation handler for the delete host route operation
+	HostRoutingDeleteHostRouteHandler host_routing.DeleteHostRouteHandler
+	// ListenDeleteListenHandler sets the operation handler for the delete listen operation
+	ListenDeleteListenHandler listen.DeleteListenHandler
+	// ListenDeleteListenServerHandler sets the operation handler for the delete listen server operation
+	ListenDeleteListenServerHandler listen.DeleteListenServerHandler
+	// LogFormatDeleteLogFormatHandler sets the operation handler for the delete log format operation
+	LogFormatDeleteLogFormatHandler log_format.DeleteLogFormatHandler
 	// LogTargetDeleteLogTargetHandler sets the operation handler for the delete log target operation
 	LogTargetDeleteLogTargetHandler log_target.DeleteLogTargetHandler
+	// MaintenanceDeleteMaintenanceHandler sets the operation handler for the delete maintenance operation
+	MaintenanceDeleteMaintenanceHandler maintenance.DeleteMaintenanceHandler
 	// NameserverDeleteNameserverHandler sets the operation handler for the delete nameserver operation
 	NameserverDeleteNameserverHandler nameserver.DeleteNameserverHandler
 	// PeerDeletePeerHandler sets the operation handler for the delete peer operation
 	PeerDeletePeerHandler peer.DeletePeerHandler
 	// PeerEntryDeletePeerEntryHandler sets the operation handler for the delete peer entry operation
 	PeerEntryDeletePeerEntryHandler peer_entry.DeletePeerEntryHandler
+	// RateLimitDeleteRateLimitHandler sets the operation handler for the delete rate limit operation
+	RateLimitDeleteRateLimitHandler rate_limit.DeleteRateLimitHandler
 	// ResolverDeleteResolverHandler sets the operation handler for the delete resolver operation
 	ResolverDeleteResolverHandler resolver.DeleteResolverHandler
 	// MapsDeleteRuntimeMapEntryHandler sets the operation handler for the delete runtime map entry operation
@@ -652,58 +1238,140 @@ type DataPlaneAPI struct {
 	ServerDeleteServerHandler server.DeleteServerHandler
 	// ServerSwitchingRuleDeleteServerSwitchingRuleHandler sets the operation handler for the delete server switching rule operation
 	ServerSwitchingRuleDeleteServerSwitchingRuleHandler server_switching_rule.DeleteServerSwitchingRuleHandler
+	// ServerDeleteServerWarmupHandler sets the operation handler for the delete server warmup operation
+	ServerDeleteServerWarmupHandler server.DeleteServerWarmupHandler
 	// SitesDeleteSiteHandler sets the operation handler for the delete site operation
 	SitesDeleteSiteHandler sites.DeleteSiteHandler
+	// SpoeAgentDeleteSpoeAgentHandler sets the operation handler for the delete spoe agent operation
+	SpoeAgentDeleteSpoeAgentHandler spoe_agent.DeleteSpoeAgentHandler
 	// StickRuleDeleteStickRuleHandler sets the operation handler for the delete stick rule operation
 	StickRuleDeleteStickRuleHandler stick_rule.DeleteStickRuleHandler
+	// StorageDeleteStorageGeneralFileHandler sets the operation handler for the delete storage general file operation
+	StorageDeleteStorageGeneralFileHandler storage.DeleteStorageGeneralFileHandler
 	// TCPRequestRuleDeleteTCPRequestRuleHandler sets the operation handler for the delete TCP request rule operation
 	TCPRequestRuleDeleteTCPRequestRuleHandler tcp_request_rule.DeleteTCPRequestRuleHandler
 	// TCPResponseRuleDeleteTCPResponseRuleHandler sets the operation handler for the delete TCP response rule operation
 	TCPResponseRuleDeleteTCPResponseRuleHandler tcp_response_rule.DeleteTCPResponseRuleHandler
+	// TLSProfileDeleteTLSProfileHandler sets the operation handler for the delete TLS profile operation
+	TLSProfileDeleteTLSProfileHandler tls_profile.DeleteTLSProfileHandler
 	// TransactionsDeleteTransactionHandler sets the operation handler for the delete transaction operation
 	TransactionsDeleteTransactionHandler transactions.DeleteTransactionHandler
+	// SpoeAgentDisableSpoeAgentHandler sets the operation handler for the disable spoe agent operation
+	SpoeAgentDisableSpoeAgentHandler spoe_agent.DisableSpoeAgentHandler
+	// SpoeAgentEnableSpoeAgentHandler sets the operation handler for the enable spoe agent operation
+	SpoeAgentEnableSpoeAgentHandler spoe_agent.EnableSpoeAgentHandler
+	// ReloadsFreezeReloadsHandler sets the operation handler for the freeze reloads operation
+	ReloadsFreezeReloadsHandler reloads.FreezeReloadsHandler
+	// StorageGenerateStorageDHParamHandler sets the operation handler for the generate storage d h param operation
+	StorageGenerateStorageDHParamHandler storage.GenerateStorageDHParamHandler
 	// DiscoveryGetAPIEndpointsHandler sets the operation handler for the get API endpoints operation
 	DiscoveryGetAPIEndpointsHandler discovery.GetAPIEndpointsHandler
+	// UsersGetAPIKeyHandler sets the operation handler for the get API key operation
+	UsersGetAPIKeyHandler users.GetAPIKeyHandler
+	// UsersGetAPIKeysHandler sets the operation handler for the get API keys operation
+	UsersGetAPIKeysHandler users.GetAPIKeysHandler
 	// ACLGetACLHandler sets the operation handler for the get Acl operation
 	ACLGetACLHandler acl.GetACLHandler
 	// ACLGetAclsHandler sets the operation handler for the get acls operation
 	ACLGetAclsHandler acl.GetAclsHandler
 	// MapsGetAllRuntimeMapFilesHandler sets the operation handler for the get all runtime map files operation
 	MapsGetAllRuntimeMapFilesHandler maps.GetAllRuntimeMapFilesHandler
+	// StorageGetAllStorageDHParamsHandler sets the operation handler for the get all storage d h params operation
+	StorageGetAllStorageDHParamsHandler storage.GetAllStorageDHParamsHandler
+	// StorageGetAllStorageGeneralFilesHandler sets the operation handler for the get all storage general files operation
+	StorageGetAllStorageGeneralFilesHandler storage.GetAllStorageGeneralFilesHandler
+	// AnnotationsGetAnnotationHandler sets the operation handler for the get annotation operation
+	AnnotationsGetAnnotationHandler annotations.GetAnnotationHandler
+	// AnnotationsGetAnnotationsHandler sets the operation handler for the get annotations operation
+	AnnotationsGetAnnotationsHandler annotations.GetAnnotationsHandler
 	// BackendGetBackendHandler sets the operation handler for the get backend operation
 	BackendGetBackendHandler backend.GetBackendHandler
+	// BackendGetBackendFullHandler sets the operation handler for the get backend full operation
+	BackendGetBackendFullHandler backend.GetBackendFullHandler
 	// BackendSwitchingRuleGetBackendSwitchingRuleHandler sets the operation handler for the get backend switching rule operation
 	BackendSwitchingRuleGetBackendSwitchingRuleHandler backend_switching_rule.GetBackendSwitchingRuleHandler
 	// BackendSwitchingRuleGetBackendSwitchingRulesHandler sets the operation handler for the get backend switching rules operation
 	BackendSwitchingRuleGetBackendSwitchingRulesHandler backend_switching_rule.GetBackendSwitchingRulesHandler
 	// BackendGetBackendsHandler sets the operation handler for the get backends operation
 	BackendGetBackendsHandler backend.GetBackendsHandler
+	// BandwidthLimitGetBandwidthLimitHandler sets the operation handler for the get bandwidth limit operation
+	BandwidthLimitGetBandwidthLimitHandler bandwidth_limit.GetBandwidthLimitHandler
+	// BandwidthLimitGetBandwidthLimitsHandler sets the operation handler for the get bandwidth limits operation
+	BandwidthLimitGetBandwidthLimitsHandler bandwidth_limit.GetBandwidthLimitsHandler
 	// BindGetBindHandler sets the operation handler for the get bind operation
 	BindGetBindHandler bind.GetBindHandler
+	// BindGetBindNetworkHandler sets the operation handler for the get bind network operation
+	BindGetBindNetworkHandler bind.GetBindNetworkHandler
+	// BindGetBindSSLHandler sets the operation handler for the get bind s s l operation
+	BindGetBindSSLHandler bind.GetBindSSLHandler
 	// BindGetBindsHandler sets the operation handler for the get binds operation
 	BindGetBindsHandler bind.GetBindsHandler
+	// ClusterGetBootstrapKeyRotationsHandler sets the operation handler for the get bootstrap key rotations operation
+	ClusterGetBootstrapKeyRotationsHandler cluster.GetBootstrapKeyRotationsHandler
+	// GlobalGetCertificateUpdateHandler sets the operation handler for the get certificate update operation
+	GlobalGetCertificateUpdateHandler global.GetCertificateUpdateHandler
 	// DiscoveryGetClusterHandler sets the operation handler for the get cluster operation
 	DiscoveryGetClusterHandler discovery.GetClusterHandler
+	// CompressionGetCompressionHandler sets the operation handler for the get compression operation
+	CompressionGetCompressionHandler compression.GetCompressionHandler
+	// ConfigurationGetConfigurationCompatibilityHandler sets the operation handler for the get configuration compatibility operation
+	ConfigurationGetConfigurationCompatibilityHandler configuration.GetConfigurationCompatibilityHandler
 	// DiscoveryGetConfigurationEndpointsHandler sets the operation handler for the get configuration endpoints operation
 	DiscoveryGetConfigurationEndpointsHandler discovery.GetConfigurationEndpointsHandler
+	// ConfigurationGetConfigurationIntegrityHandler sets the operation handler for the get configuration integrity operation
+	ConfigurationGetConfigurationIntegrityHandler configuration.GetConfigurationIntegrityHandler
 	// ServiceDiscoveryGetConsulHandler sets the operation handler for the get consul operation
 	ServiceDiscoveryGetConsulHandler service_discovery.GetConsulHandler
 	// ServiceDiscoveryGetConsulsHandler sets the operation handler for the get consuls operation
 	ServiceDiscoveryGetConsulsHandler service_discovery.GetConsulsHandler
+	// GlobalGetDHParamHandler sets the operation handler for the get d h param operation
+	GlobalGetDHParamHandler global.GetDHParamHandler
+	// InformationGetDataplaneConfigurationHandler sets the operation handler for the get dataplane configuration operation
+	InformationGetDataplaneConfigurationHandler information.GetDataplaneConfigurationHandler
+	// StatsGetDataplaneStatsHandler sets the operation handler for the get dataplane stats operation
+	StatsGetDataplaneStatsHandler stats.GetDataplaneStatsHandler
 	// DefaultsGetDefaultsHandler sets the operation handler for the get defaults operation
 	DefaultsGetDefaultsHandler defaults.GetDefaultsHandler
+	// GlobalGetDeviceDetectionHandler sets the operation handler for the get device detection operation
+	GlobalGetDeviceDetectionHandler global.GetDeviceDetectionHandler
+	// DrainGetDrainHandler sets the operation handler for the get drain operation
+	DrainGetDrainHandler drain.GetDrainHandler
+	// DrainGetDrainsHandler sets the operation handler for the get drains operation
+	DrainGetDrainsHandler drain.GetDrainsHandler
+	// BackendGetDynamicCookieKeyHandler sets the operation handler for the get dynamic cookie key operation
+	BackendGetDynamicCookieKeyHandler backend.GetDynamicCookieKeyHandler
 	// FilterGetFilterHandler sets the operation handler for the get filter operation
 	FilterGetFilterHandler filter.GetFilterHandler
 	// FilterGetFiltersHandler sets the operation handler for the get filters operation
 	FilterGetFiltersHandler filter.GetFiltersHandler
+	// FleetGetFleetHandler sets the operation handler for the get fleet operation
+	FleetGetFleetHandler fleet.GetFleetHandler
+	// FleetGetFleetNodeConfigurationHandler sets the operation handler for the get fleet node configuration operation
+	FleetGetFleetNodeConfigurationHandler fleet.GetFleetNodeConfigurationHandler
 	// FrontendGetFrontendHandler sets the operation handler for the get frontend operation
 	FrontendGetFrontendHandler frontend.GetFrontendHandler
+	// FrontendGetFrontendCircuitBreakerHandler sets the operation handler for the get frontend circuit breaker operation
+	FrontendGetFrontendCircuitBreakerHandler frontend.GetFrontendCircuitBreakerHandler
+	// FrontendGetFrontendFullHandler sets the operation handler for the get frontend full operation
+	FrontendGetFrontendFullHandler frontend.GetFrontendFullHandler
 	// FrontendGetFrontendsHandler sets the operation handler for the get frontends operation
 	FrontendGetFrontendsHandler frontend.GetFrontendsHandler
+	// GeoIPGetGeoIPHandler sets the operation handler for the get geo IP operation
+	GeoIPGetGeoIPHandler geo_ip.GetGeoIPHandler
+	// GeoIPGetGeoIPPoliciesHandler sets the operation handler for the get geo IP policies operation
+	GeoIPGetGeoIPPoliciesHandler geo_ip.GetGeoIPPoliciesHandler
+	// GeoIPGetGeoIPPolicyHandler sets the operation handler for the get geo IP policy operation
+	GeoIPGetGeoIPPolicyHandler geo_ip.GetGeoIPPolicyHandler
+	// GitGetGitConflictsHandler sets the operation handler for the get git conflicts operation
+	GitGetGitConflictsHandler git.GetGitConflictsHandler
+	// GitGetGitKnownHostsHandler sets the operation handler for the get git known hosts operation
+	GitGetGitKnownHostsHandler git.GetGitKnownHostsHandler
 	// GlobalGetGlobalHandler sets the operation handler for the get global operation
 	GlobalGetGlobalHandler global.GetGlobalHandler
 	// ConfigurationGetHAProxyConfigurationHandler sets the operation handler for the get h a proxy configuration operation
 	ConfigurationGetHAProxyConfigurationHandler configuration.GetHAProxyConfigurationHandler
+	// HealthGetHAProxyHealthHandler sets the operation handler for the get h a proxy health operation
+	HealthGetHAProxyHealthHandler health.GetHAProxyHealthHandler
 	// HTTPRequestRuleGetHTTPRequestRuleHandler sets the operation handler for the get HTTP request rule operation
 	HTTPRequestRuleGetHTTPRequestRuleHandler http_request_rule.GetHTTPRequestRuleHandler
 	// HTTPRequestRuleGetHTTPRequestRulesHandler sets the operation handler for the get HTTP request rules operation
@@ -712,22 +1380,48 @@ type DataPlaneAPI struct {
 	HTTPResponseRuleGetHTTPResponseRuleHandler http_response_rule.GetHTTPResponseRuleHandler
 	// HTTPResponseRuleGetHTTPResponseRulesHandler sets the operation handler for the get HTTP response rules operation
 	HTTPResponseRuleGetHTTPResponseRulesHandler http_response_rule.GetHTTPResponseRulesHandler
+	// FrontendGetHTTPSRedirectHandler sets the operation handler for the get HTTPS redirect operation
+	FrontendGetHTTPSRedirectHandler frontend.GetHTTPSRedirectHandler
 	// DiscoveryGetHaproxyEndpointsHandler sets the operation handler for the get haproxy endpoints operation
 	DiscoveryGetHaproxyEndpointsHandler discovery.GetHaproxyEndpointsHandler
 	// InformationGetHaproxyProcessInfoHandler sets the operation handler for the get haproxy process info operation
 	InformationGetHaproxyProcessInfoHandler information.GetHaproxyProcessInfoHandler
+	// InformationGetHaproxyProcessesHandler sets the operation handler for the get haproxy processes operation
+	InformationGetHaproxyProcessesHandler information.GetHaproxyProcessesHandler
+	// HostRoutingGetHostRouteHandler sets the operation handler for the get host route operation
+	HostRoutingGetHostRouteHandler host_routing.GetHostRouteHandler
+	// HostRoutingGetHostRoutesHandler sets the operation handler for the get host routes operation
+	HostRoutingGetHostRoutesHandler host_routing.GetHostRoutesHandler
 	// InformationGetInfoHandler sets the operation handler for the get info operation
 	InformationGetInfoHandler information.GetInfoHandler
+	// ListenGetListenHandler sets the operation handler for the get listen operation
+	ListenGetListenHandler listen.GetListenHandler
+	// ListenGetListenServerHandler sets the operation handler for the get listen server operation
+	ListenGetListenServerHandler listen.GetListenServerHandler
+	// ListenGetListenServersHandler sets the operation handler for the get listen servers operation
+	ListenGetListenServersHandler listen.GetListenServersHandler
+	// ListenGetListensHandler sets the operation handler for the get listens operation
+	ListenGetListensHandler listen.GetListensHandler
+	// LogFormatGetLogFormatHandler sets the operation handler for the get log format operation
+	LogFormatGetLogFormatHandler log_format.GetLogFormatHandler
+	// LogFormatGetLogFormatsHandler sets the operation handler for the get log formats operation
+	LogFormatGetLogFormatsHandler log_format.GetLogFormatsHandler
 	// LogTargetGetLogTargetHandler sets the operation handler for the get log target operation
 	LogTargetGetLogTargetHandler log_target.GetLogTargetHandler
 	// LogTargetGetLogTargetsHandler sets the operation handler for the get log targets operation
 	LogTargetGetLogTargetsHandler log_target.GetLogTargetsHandler
+	// MaintenanceGetMaintenanceHandler sets the operation handler for the get maintenance operation
+	MaintenanceGetMaintenanceHandler maintenance.GetMaintenanceHandler
+	// MaintenanceGetMaintenancesHandler sets the operation handler for the get maintenances operation
+	MaintenanceGetMaintenancesHandler maintenance.GetMaintenancesHandler
 	// NameserverGetNameserverHandler sets the operation handler for the get nameserver operation
 	NameserverGetNameserverHandler nameserver.GetNameserverHandler
 	// NameserverGetNameserversHandler sets the operation handler for the get nameservers operation
 	NameserverGetNameserversHandler nameserver.GetNameserversHandler
 	// MapsGetOneRuntimeMapHandler sets the operation handler for the get one runtime map operation
 	MapsGetOneRuntimeMapHandler maps.GetOneRuntimeMapHandler
+	// StorageGetOneStorageGeneralFileHandler sets the operation handler for the get one storage general file operation
+	StorageGetOneStorageGeneralFileHandler storage.GetOneStorageGeneralFileHandler
 	// SpecificationOpenapiv3GetOpenapiv3SpecificationHandler sets the operation handler for the get openapiv3 specification operation
 	SpecificationOpenapiv3GetOpenapiv3SpecificationHandler specification_openapiv3.GetOpenapiv3SpecificationHandler
 	// PeerEntryGetPeerEntriesHandler sets the operation handler for the get peer entries operation
@@ -738,28 +1432,60 @@ type DataPlaneAPI struct {
 	PeerGetPeerSectionHandler peer.GetPeerSectionHandler
 	// PeerGetPeerSectionsHandler sets the operation handler for the get peer sections operation
 	PeerGetPeerSectionsHandler peer.GetPeerSectionsHandler
+	// InformationGetPreflightHandler sets the operation handler for the get preflight operation
+	InformationGetPreflightHandler information.GetPreflightHandler
+	// InformationGetPrivilegesHandler sets the operation handler for the get privileges operation
+	InformationGetPrivilegesHandler information.GetPrivilegesHandler
+	// RateLimitGetRateLimitHandler sets the operation handler for the get rate limit operation
+	RateLimitGetRateLimitHandler rate_limit.GetRateLimitHandler
+	// RateLimitGetRateLimitOffendersHandler sets the operation handler for the get rate limit offenders operation
+	RateLimitGetRateLimitOffendersHandler rate_limit.GetRateLimitOffendersHandler
+	// RateLimitGetRateLimitsHandler sets the operation handler for the get rate limits operation
+	RateLimitGetRateLimitsHandler rate_limit.GetRateLimitsHandler
 	// ReloadsGetReloadHandler sets the operation handler for the get reload operation
 	ReloadsGetReloadHandler reloads.GetReloadHandler
+	// ReloadsGetReloadFreezeHandler sets the operation handler for the get reload freeze operation
+	ReloadsGetReloadFreezeHandler reloads.GetReloadFreezeHandler
 	// ReloadsGetReloadsHandler sets the operation handler for the get reloads operation
 	ReloadsGetReloadsHandler reloads.GetReloadsHandler
 	// ResolverGetResolverHandler sets the operation handler for the get resolver operation
 	ResolverGetResolverHandler resolver.GetResolverHandler
 	// ResolverGetResolversHandler sets the operation handler for the get resolvers operation
 	ResolverGetResolversHandler resolver.GetResolversHandler
+	// TracesGetRingEventsHandler sets the operation handler for the get ring events operation
+	TracesGetRingEventsHandler traces.GetRingEventsHandler
+	// BackendGetRuntimeBackendHandler sets the operation handler for the get runtime backend operation
+	BackendGetRuntimeBackendHandler backend.GetRuntimeBackendHandler
+	// BackendGetRuntimeBackendsHandler sets the operation handler for the get runtime backends operation
+	BackendGetRuntimeBackendsHandler backend.GetRuntimeBackendsHandler
 	// DiscoveryGetRuntimeEndpointsHandler sets the operation handler for the get runtime endpoints operation
 	DiscoveryGetRuntimeEndpointsHandler discovery.GetRuntimeEndpointsHandler
+	// FrontendGetRuntimeFrontendHandler sets the operation handler for the get runtime frontend operation
+	FrontendGetRuntimeFrontendHandler frontend.GetRuntimeFrontendHandler
+	// FrontendGetRuntimeFrontendsHandler sets the operation handler for the get runtime frontends operation
+	FrontendGetRuntimeFrontendsHandler frontend.GetRuntimeFrontendsHandler
+	// TracesGetRuntimeLogsHandler sets the operation handler for the get runtime logs operation
+	TracesGetRuntimeLogsHandler traces.GetRuntimeLogsHandler
 	// MapsGetRuntimeMapEntryHandler sets the operation handler for the get runtime map entry operation
 	MapsGetRuntimeMapEntryHandler maps.GetRuntimeMapEntryHandler
 	// ServerGetRuntimeServerHandler sets the operation handler for the get runtime server operation
 	ServerGetRuntimeServerHandler server.GetRuntimeServerHandler
 	// ServerGetRuntimeServersHandler sets the operation handler for the get runtime servers operation
 	ServerGetRuntimeServersHandler server.GetRuntimeServersHandler
+	// CertificatesGetSSLCertificatesHandler sets the operation handler for the get s s l certificates operation
+	CertificatesGetSSLCertificatesHandler certificates.GetSSLCertificatesHandler
+	// SecurityOptionsGetSecurityOptionsHandler sets the operation handler for the get security options operation
+	SecurityOptionsGetSecurityOptionsHandler security_options.GetSecurityOptionsHandler
 	// ServerGetServerHandler sets the operation handler for the get server operation
 	ServerGetServerHandler server.GetServerHandler
+	// ServerGetServerNetworkHandler sets the operation handler for the get server network operation
+	ServerGetServerNetworkHandler server.GetServerNetworkHandler
 	// ServerSwitchingRuleGetServerSwitchingRuleHandler sets the operation handler for the get server switching rule operation
 	ServerSwitchingRuleGetServerSwitchingRuleHandler server_switching_rule.GetServerSwitchingRuleHandler
 	// ServerSwitchingRuleGetServerSwitchingRulesHandler sets the operation handler for the get server switching rules operation
 	ServerSwitchingRuleGetServerSwitchingRulesHandler server_switching_rule.GetServerSwitchingRulesHandler
+	// ServerGetServerWarmupHandler sets the operation handler for the get server warmup operation
+	ServerGetServerWarmupHandler server.GetServerWarmupHandler
 	// ServerGetServersHandler sets the operation handler for the get servers operation
 	ServerGetServersHandler server.GetServersHandler
 	// DiscoveryGetServicesEndpointsHandler sets the operation handler for the get services endpoints operation
@@ -768,12 +1494,24 @@ type DataPlaneAPI struct {
 	SitesGetSiteHandler sites.GetSiteHandler
 	// SitesGetSitesHandler sets the operation handler for the get sites operation
 	SitesGetSitesHandler sites.GetSitesHandler
+	// SnapshotGetSnapshotHandler sets the operation handler for the get snapshot operation
+	SnapshotGetSnapshotHandler snapshot.GetSnapshotHandler
 	// SpecificationGetSpecificationHandler sets the operation handler for the get specification operation
 	SpecificationGetSpecificationHandler specification.GetSpecificationHandler
+	// SpecificationGetSpecificationSchemaHandler sets the operation handler for the get specification schema operation
+	SpecificationGetSpecificationSchemaHandler specification.GetSpecificationSchemaHandler
+	// SpoeAgentGetSpoeAgentHandler sets the operation handler for the get spoe agent operation
+	SpoeAgentGetSpoeAgentHandler spoe_agent.GetSpoeAgentHandler
+	// SpoeAgentGetSpoeAgentsHandler sets the operation handler for the get spoe agents operation
+	SpoeAgentGetSpoeAgentsHandler spoe_agent.GetSpoeAgentsHandler
 	// StatsGetStatsHandler sets the operation handler for the get stats operation
 	StatsGetStatsHandler stats.GetStatsHandler
 	// DiscoveryGetStatsEndpointsHandler sets the operation handler for the get stats endpoints operation
 	DiscoveryGetStatsEndpointsHandler discovery.GetStatsEndpointsHandler
+	// StatsPageGetStatsPageHandler sets the operation handler for the get stats page operation
+	StatsPageGetStatsPageHandler stats_page.GetStatsPageHandler
+	// StatsPageGetStatsSocketsHandler sets the operation handler for the get stats sockets operation
+	StatsPageGetStatsSocketsHandler stats_page.GetStatsSocketsHandler
 	// StickRuleGetStickRuleHandler sets the operation handler for the get stick rule operation
 	StickRuleGetStickRuleHandler stick_rule.GetStickRuleHandler
 	// StickRuleGetStickRulesHandler sets the operation handler for the get stick rules operation
@@ -784,6 +1522,8 @@ type DataPlaneAPI struct {
 	StickTableGetStickTableEntriesHandler stick_table.GetStickTableEntriesHandler
 	// StickTableGetStickTablesHandler sets the operation handler for the get stick tables operation
 	StickTableGetStickTablesHandler stick_table.GetStickTablesHandler
+	// StorageGetStorageCleanupHandler sets the operation handler for the get storage cleanup operation
+	StorageGetStorageCleanupHandler storage.GetStorageCleanupHandler
 	// TCPRequestRuleGetTCPRequestRuleHandler sets the operation handler for the get TCP request rule operation
 	TCPRequestRuleGetTCPRequestRuleHandler tcp_request_rule.GetTCPRequestRuleHandler
 	// TCPRequestRuleGetTCPRequestRulesHandler sets the operation handler for the get TCP request rules operation
@@ -792,66 +1532,178 @@ type DataPlaneAPI struct {
 	TCPResponseRuleGetTCPResponseRuleHandler tcp_response_rule.GetTCPResponseRuleHandler
 	// TCPResponseRuleGetTCPResponseRulesHandler sets the operation handler for the get TCP response rules operation
 	TCPResponseRuleGetTCPResponseRulesHandler tcp_response_rule.GetTCPResponseRulesHandler
+	// TLSProfileGetTLSProfileHandler sets the operation handler for the get TLS profile operation
+	TLSProfileGetTLSProfileHandler tls_profile.GetTLSProfileHandler
+	// TLSProfileGetTLSProfileDeviationsHandler sets the operation handler for the get TLS profile deviations operation
+	TLSProfileGetTLSProfileDeviationsHandler tls_profile.GetTLSProfileDeviationsHandler
+	// TLSProfileGetTLSProfilesHandler sets the operation handler for the get TLS profiles operation
+	TLSProfileGetTLSProfilesHandler tls_profile.GetTLSProfilesHandler
+	// GlobalGetThreadingHandler sets the operation handler for the get threading operation
+	GlobalGetThreadingHandler global.GetThreadingHandler
+	// TracesGetTracesHandler sets the operation handler for the get traces operation
+	TracesGetTracesHandler traces.GetTracesHandler
 	// TransactionsGetTransactionHandler sets the operation handler for the get transaction operation
 	TransactionsGetTransactionHandler transactions.GetTransactionHandler
+	// TransactionsGetTransactionImpactHandler sets the operation handler for the get transaction impact operation
+	TransactionsGetTransactionImpactHandler transactions.GetTransactionImpactHandler
+	// TransactionsGetTransactionStorageHandler sets the operation handler for the get transaction storage operation
+	TransactionsGetTransactionStorageHandler transactions.GetTransactionStorageHandler
 	// TransactionsGetTransactionsHandler sets the operation handler for the get transactions operation
 	TransactionsGetTransactionsHandler transactions.GetTransactionsHandler
+	// GitGitWebhookHandler sets the operation handler for the git webhook operation
+	GitGitWebhookHandler git.GitWebhookHandler
+	// ConfigurationImportConfigurationHandler sets the operation handler for the import configuration operation
+	ConfigurationImportConfigurationHandler configuration.ImportConfigurationHandler
 	// ClusterInitiateCertificateRefreshHandler sets the operation handler for the initiate certificate refresh operation
 	ClusterInitiateCertificateRefreshHandler cluster.InitiateCertificateRefreshHandler
+	// ConfigurationMigrateConfigurationHandler sets the operation handler for the migrate configuration operation
+	ConfigurationMigrateConfigurationHandler configuration.MigrateConfigurationHandler
+	// ConfigurationPlanConfigurationHandler sets the operation handler for the plan configuration operation
+	ConfigurationPlanConfigurationHandler configuration.PlanConfigurationHandler
 	// ClusterPostClusterHandler sets the operation handler for the post cluster operation
 	ClusterPostClusterHandler cluster.PostClusterHandler
 	// ConfigurationPostHAProxyConfigurationHandler sets the operation handler for the post h a proxy configuration operation
 	ConfigurationPostHAProxyConfigurationHandler configuration.PostHAProxyConfigurationHandler
+	// FleetPrepareFleetConfigurationHandler sets the operation handler for the prepare fleet configuration operation
+	FleetPrepareFleetConfigurationHandler fleet.PrepareFleetConfigurationHandler
+	// FleetPushFleetHandler sets the operation handler for the push fleet operation
+	FleetPushFleetHandler fleet.PushFleetHandler
+	// GeoIPRefreshGeoIPHandler sets the operation handler for the refresh geo IP operation
+	GeoIPRefreshGeoIPHandler geo_ip.RefreshGeoIPHandler
+	// UsersReplaceAPIKeyHandler sets the operation handler for the replace API key operation
+	UsersReplaceAPIKeyHandler users.ReplaceAPIKeyHandler
 	// ACLReplaceACLHandler sets the operation handler for the replace Acl operation
 	ACLReplaceACLHandler acl.ReplaceACLHandler
+	// AnnotationsReplaceAnnotationHandler sets the operation handler for the replace annotation operation
+	AnnotationsReplaceAnnotationHandler annotations.ReplaceAnnotationHandler
 	// BackendReplaceBackendHandler sets the operation handler for the replace backend operation
 	BackendReplaceBackendHandler backend.ReplaceBackendHandler
 	// BackendSwitchingRuleReplaceBackendSwitchingRuleHandler sets the operation handler for the replace backend switching rule operation
 	BackendSwitchingRuleReplaceBackendSwitchingRuleHandler backend_switching_rule.ReplaceBackendSwitchingRuleHandler
+	// BandwidthLimitReplaceBandwidthLimitHandler sets the operation handler for the replace bandwidth limit operation
+	BandwidthLimitReplaceBandwidthLimitHandler bandwidth_limit.ReplaceBandwidthLimitHandler
 	// BindReplaceBindHandler sets the operation handler for the replace bind operation
 	BindReplaceBindHandler bind.ReplaceBindHandler
+	// BindReplaceBindNetworkHandler sets the operation handler for the replace bind network operation
+	BindReplaceBindNetworkHandler bind.ReplaceBindNetworkHandler
+	// BindReplaceBindSSLHandler sets the operation handler for the replace bind s s l operation
+	BindReplaceBindSSLHandler bind.ReplaceBindSSLHandler
+	// GlobalReplaceCertificateUpdateHandler sets the operation handler for the replace certificate update operation
+	GlobalReplaceCertificateUpdateHandler global.ReplaceCertificateUpdateHandler
+	// CompressionReplaceCompressionHandler sets the operation handler for the replace compression operation
+	CompressionReplaceCompressionHandler compression.ReplaceCompressionHandler
 	// ServiceDiscoveryReplaceConsulHandler sets the operation handler for the replace consul operation
 	ServiceDiscoveryReplaceConsulHandler service_discovery.ReplaceConsulHandler
+	// GlobalReplaceDHParamHandler sets the operation handler for the replace d h param operation
+	GlobalReplaceDHParamHandler global.ReplaceDHParamHandler
 	// DefaultsReplaceDefaultsHandler sets the operation handler for the replace defaults operation
 	DefaultsReplaceDefaultsHandler defaults.ReplaceDefaultsHandler
+	// GlobalReplaceDeviceDetectionHandler sets the operation handler for the replace device detection operation
+	GlobalReplaceDeviceDetectionHandler global.ReplaceDeviceDetectionHandler
+	// BackendReplaceDynamicCookieKeyHandler sets the operation handler for the replace dynamic cookie key operation
+	BackendReplaceDynamicCookieKeyHandler backend.ReplaceDynamicCookieKeyHandler
 	// FilterReplaceFilterHandler sets the operation handler for the replace filter operation
 	FilterReplaceFilterHandler filter.ReplaceFilterHandler
 	// FrontendReplaceFrontendHandler sets the operation handler for the replace frontend operation
 	FrontendReplaceFrontendHandler frontend.ReplaceFrontendHandler
+	// FrontendReplaceFrontendCircuitBreakerHandler sets the operation handler for the replace frontend circuit breaker operation
+	FrontendReplaceFrontendCircuitBreakerHandler frontend.ReplaceFrontendCircuitBreakerHandler
+	// GeoIPReplaceGeoIPPolicyHandler sets the operation handler for the replace geo IP policy operation
+	GeoIPReplaceGeoIPPolicyHandler geo_ip.ReplaceGeoIPPolicyHandler
+	// GitReplaceGitKnownHostsHandler sets the operation handler for the replace git known hosts operation
+	GitReplaceGitKnownHostsHandler git.ReplaceGitKnownHostsHandler
 	// GlobalReplaceGlobalHandler sets the operation handler for the replace global operation
 	GlobalReplaceGlobalHandler global.ReplaceGlobalHandler
 	// HTTPRequestRuleReplaceHTTPRequestRuleHandler sets the operation handler for the replace HTTP request rule operation
 	HTTPRequestRuleReplaceHTTPRequestRuleHandler http_request_rule.ReplaceHTTPRequestRuleHandler
 	// HTTPResponseRuleReplaceHTTPResponseRuleHandler sets the operation handler for the replace HTTP response rule operation
 	HTTPResponseRuleReplaceHTTPResponseRuleHandler http_response_rule.ReplaceHTTPResponseRuleHandler
+	// FrontendReplaceHTTPSRedirectHandler sets the operation handler for the replace HTTPS redirect operation
+	FrontendReplaceHTTPSRedirectHandler frontend.ReplaceHTTPSRedirectHandler
+	// ListenReplaceListenHandler sets the operation handler for the replace listen operation
+	ListenReplaceListenHandler listen.ReplaceListenHandler
+	// ListenReplaceListenServerHandler sets the operation handler for the replace listen server operation
+	ListenReplaceListenServerHandler listen.ReplaceListenServerHandler
+	// LogFormatReplaceLogFormatHandler sets the operation handler for the replace log format operation
+	LogFormatReplaceLogFormatHandler log_format.ReplaceLogFormatHandler
 	// LogTargetReplaceLogTargetHandler sets the operation handler for the replace log target operation
 	LogTargetReplaceLogTargetHandler log_target.ReplaceLogTargetHandler
+	// MaintenanceReplaceMaintenanceHandler sets the operation handler for the replace maintenance operation
+	MaintenanceReplaceMaintenanceHandler maintenance.ReplaceMaintenanceHandler
 	// NameserverReplaceNameserverHandler sets the operation handler for the replace nameserver operation
 	NameserverReplaceNameserverHandler nameserver.ReplaceNameserverHandler
 	// PeerEntryReplacePeerEntryHandler sets the operation handler for the replace peer entry operation
 	PeerEntryReplacePeerEntryHandler peer_entry.ReplacePeerEntryHandler
+	// RateLimitReplaceRateLimitHandler sets the operation handler for the replace rate limit operation
+	RateLimitReplaceRateLimitHandler rate_limit.ReplaceRateLimitHandler
 	// ResolverReplaceResolverHandler sets the operation handler for the replace resolver operation
 	ResolverReplaceResolverHandler resolver.ReplaceResolverHandler
+	// BackendReplaceRuntimeBackendHandler sets the operation handler for the replace runtime backend operation
+	BackendReplaceRuntimeBackendHandler backend.ReplaceRuntimeBackendHandler
+	// FrontendReplaceRuntimeFrontendHandler sets the operation handler for the replace runtime frontend operation
+	FrontendReplaceRuntimeFrontendHandler frontend.ReplaceRuntimeFrontendHandler
 	// MapsReplaceRuntimeMapEntryHandler sets the operation handler for the replace runtime map entry operation
 	MapsReplaceRuntimeMapEntryHandler maps.ReplaceRuntimeMapEntryHandler
 	// ServerReplaceRuntimeServerHandler sets the operation handler for the replace runtime server operation
 	ServerReplaceRuntimeServerHandler server.ReplaceRuntimeServerHandler
+	// ServerReplaceRuntimeWeightsHandler sets the operation handler for the replace runtime weights operation
+	ServerReplaceRuntimeWeightsHandler server.ReplaceRuntimeWeightsHandler
+	// SecurityOptionsReplaceSecurityOptionsHandler sets the operation handler for the replace security options operation
+	SecurityOptionsReplaceSecurityOptionsHandler security_options.ReplaceSecurityOptionsHandler
+	// UsersReplaceSelfPasswordHandler sets the operation handler for the replace self password operation
+	UsersReplaceSelfPasswordHandler users.ReplaceSelfPasswordHandler
 	// ServerReplaceServerHandler sets the operation handler for the replace server operation
 	ServerReplaceServerHandler server.ReplaceServerHandler
+	// ServerReplaceServerNetworkHandler sets the operation handler for the replace server network operation
+	ServerReplaceServerNetworkHandler server.ReplaceServerNetworkHandler
 	// ServerSwitchingRuleReplaceServerSwitchingRuleHandler sets the operation handler for the replace server switching rule operation
 	ServerSwitchingRuleReplaceServerSwitchingRuleHandler server_switching_rule.ReplaceServerSwitchingRuleHandler
+	// ServerReplaceServerWarmupHandler sets the operation handler for the replace server warmup operation
+	ServerReplaceServerWarmupHandler server.ReplaceServerWarmupHandler
 	// SitesReplaceSiteHandler sets the operation handler for the replace site operation
 	SitesReplaceSiteHandler sites.ReplaceSiteHandler
+	// SpoeAgentReplaceSpoeAgentHandler sets the operation handler for the replace spoe agent operation
+	SpoeAgentReplaceSpoeAgentHandler spoe_agent.ReplaceSpoeAgentHandler
+	// StatsPageReplaceStatsPageHandler sets the operation handler for the replace stats page operation
+	StatsPageReplaceStatsPageHandler stats_page.ReplaceStatsPageHandler
+	// StatsPageReplaceStatsSocketsHandler sets the operation handler for the replace stats sockets operation
+	StatsPageReplaceStatsSocketsHandler stats_page.ReplaceStatsSocketsHandler
 	// StickRuleReplaceStickRuleHandler sets the operation handler for the replace stick rule operation
 	StickRuleReplaceStickRuleHandler stick_rule.ReplaceStickRuleHandler
+	// StorageReplaceStorageGeneralFileHandler sets the operation handler for the replace storage general file operation
+	StorageReplaceStorageGeneralFileHandler storage.ReplaceStorageGeneralFileHandler
 	// TCPRequestRuleReplaceTCPRequestRuleHandler sets the operation handler for the replace TCP request rule operation
 	TCPRequestRuleReplaceTCPRequestRuleHandler tcp_request_rule.ReplaceTCPRequestRuleHandler
 	// TCPResponseRuleReplaceTCPResponseRuleHandler sets the operation handler for the replace TCP response rule operation
 	TCPResponseRuleReplaceTCPResponseRuleHandler tcp_response_rule.ReplaceTCPResponseRuleHandler
+	// TLSProfileReplaceTLSProfileHandler sets the operation handler for the replace TLS profile operation
+	TLSProfileReplaceTLSProfileHandler tls_profile.ReplaceTLSProfileHandler
+	// GlobalReplaceThreadingHandler sets the operation handler for the replace threading operation
+	GlobalReplaceThreadingHandler global.ReplaceThreadingHandler
+	// TracesReplaceTraceHandler sets the operation handler for the replace trace operation
+	TracesReplaceTraceHandler traces.ReplaceTraceHandler
+	// TransactionsReplaceTransactionMetadataHandler sets the operation handler for the replace transaction metadata operation
+	TransactionsReplaceTransactionMetadataHandler transactions.ReplaceTransactionMetadataHandler
+	// GitResolveGitConflictsHandler sets the operation handler for the resolve git conflicts operation
+	GitResolveGitConflictsHandler git.ResolveGitConflictsHandler
+	// SnapshotRestoreSnapshotHandler sets the operation handler for the restore snapshot operation
+	SnapshotRestoreSnapshotHandler snapshot.RestoreSnapshotHandler
+	// ReloadsRetryReloadHandler sets the operation handler for the retry reload operation
+	ReloadsRetryReloadHandler reloads.RetryReloadHandler
 	// MapsShowRuntimeMapHandler sets the operation handler for the show runtime map operation
 	MapsShowRuntimeMapHandler maps.ShowRuntimeMapHandler
 	// TransactionsStartTransactionHandler sets the operation handler for the start transaction operation
 	TransactionsStartTransactionHandler transactions.StartTransactionHandler
+	// InformationStopOldWorkersHandler sets the operation handler for the stop old workers operation
+	InformationStopOldWorkersHandler information.StopOldWorkersHandler
+	// GitTestGitRemoteHandler sets the operation handler for the test git remote operation
+	GitTestGitRemoteHandler git.TestGitRemoteHandler
+	// ReloadsUnfreezeReloadsHandler sets the operation handler for the unfreeze reloads operation
+	ReloadsUnfreezeReloadsHandler reloads.UnfreezeReloadsHandler
+	// ACLValidateACLHandler sets the operation handler for the validate ACL operation
+	ACLValidateACLHandler acl.ValidateACLHandler
+	// LogFormatValidateLogFormatHandler sets the operation handler for the validate log format operation
+	LogFormatValidateLogFormatHandler log_format.ValidateLogFormatHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -923,20 +1775,47 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TxtProducer == nil {
+		unregistered = append(unregistered, "TxtProducer")
+	}
 
 	if o.BasicAuthAuth == nil {
 		unregistered = append(unregistered, "BasicAuthAuth")
 	}
 
+	if o.FleetAbortFleetConfigurationHandler == nil {
+		unregistered = append(unregistered, "fleet.AbortFleetConfigurationHandler")
+	}
 	if o.MapsAddMapEntryHandler == nil {
 		unregistered = append(unregistered, "maps.AddMapEntryHandler")
 	}
+	if o.ConfigurationApplyBatchHandler == nil {
+		unregistered = append(unregistered, "configuration.ApplyBatchHandler")
+	}
+	if o.ConfigurationApplyConfigurationHandler == nil {
+		unregistered = append(unregistered, "configuration.ApplyConfigurationHandler")
+	}
+	if o.LogFormatApplyLogFormatHandler == nil {
+		unregistered = append(unregistered, "log_format.ApplyLogFormatHandler")
+	}
+	if o.SecurityOptionsApplySecurityBaselineHandler == nil {
+		unregistered = append(unregistered, "security_options.ApplySecurityBaselineHandler")
+	}
+	if o.TLSProfileApplyTLSProfileHandler == nil {
+		unregistered = append(unregistered, "tls_profile.ApplyTLSProfileHandler")
+	}
 	if o.MapsClearRuntimeMapHandler == nil {
 		unregistered = append(unregistered, "maps.ClearRuntimeMapHandler")
 	}
+	if o.FleetCommitFleetConfigurationHandler == nil {
+		unregistered = append(unregistered, "fleet.CommitFleetConfigurationHandler")
+	}
 	if o.TransactionsCommitTransactionHandler == nil {
 		unregistered = append(unregistered, "transactions.CommitTransactionHandler")
 	}
+	if o.UsersCreateAPIKeyHandler == nil {
+		unregistered = append(unregistered, "users.CreateAPIKeyHandler")
+	}
 	if o.ACLCreateACLHandler == nil {
 		unregistered = append(unregistered, "acl.CreateACLHandler")
 	}
@@ -946,12 +1825,18 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.BackendSwitchingRuleCreateBackendSwitchingRuleHandler == nil {
 		unregistered = append(unregistered, "backend_switching_rule.CreateBackendSwitchingRuleHandler")
 	}
+	if o.BandwidthLimitCreateBandwidthLimitHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.CreateBandwidthLimitHandler")
+	}
 	if o.BindCreateBindHandler == nil {
 		unregistered = append(unregistered, "bind.CreateBindHandler")
 	}
 	if o.ServiceDiscoveryCreateConsulHandler == nil {
 		unregistered = append(unregistered, "service_discovery.CreateConsulHandler")
 	}
+	if o.DrainCreateDrainHandler == nil {
+		unregistered = append(unregistered, "drain.CreateDrainHandler")
+	}
 	if o.FilterCreateFilterHandler == nil {
 		unregistered = append(unregistered, "filter.CreateFilterHandler")
 	}
@@ -964,6 +1849,18 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.HTTPResponseRuleCreateHTTPResponseRuleHandler == nil {
 		unregistered = append(unregistered, "http_response_rule.CreateHTTPResponseRuleHandler")
 	}
+	if o.HostRoutingCreateHostRouteHandler == nil {
+		unregistered = append(unregistered, "host_routing.CreateHostRouteHandler")
+	}
+	if o.ListenCreateListenHandler == nil {
+		unregistered = append(unregistered, "listen.CreateListenHandler")
+	}
+	if o.ListenCreateListenServerHandler == nil {
+		unregistered = append(unregistered, "listen.CreateListenServerHandler")
+	}
+	if o.LogFormatCreateLogFormatHandler == nil {
+		unregistered = append(unregistered, "log_format.CreateLogFormatHandler")
+	}
 	if o.LogTargetCreateLogTargetHandler == nil {
 		unregistered = append(unregistered, "log_target.CreateLogTargetHandler")
 	}
@@ -976,6 +1873,9 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.PeerEntryCreatePeerEntryHandler == nil {
 		unregistered = append(unregistered, "peer_entry.CreatePeerEntryHandler")
 	}
+	if o.RateLimitCreateRateLimitHandler == nil {
+		unregistered = append(unregistered, "rate_limit.CreateRateLimitHandler")
+	}
 	if o.ResolverCreateResolverHandler == nil {
 		unregistered = append(unregistered, "resolver.CreateResolverHandler")
 	}
@@ -991,45 +1891,93 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.SitesCreateSiteHandler == nil {
 		unregistered = append(unregistered, "sites.CreateSiteHandler")
 	}
+	if o.SpoeAgentCreateSpoeAgentHandler == nil {
+		unregistered = append(unregistered, "spoe_agent.CreateSpoeAgentHandler")
+	}
 	if o.StickRuleCreateStickRuleHandler == nil {
 		unregistered = append(unregistered, "stick_rule.CreateStickRuleHandler")
 	}
+	if o.StorageCreateStorageDHParamHandler == nil {
+		unregistered = append(unregistered, "storage.CreateStorageDHParamHandler")
+	}
+	if o.StorageCreateStorageGeneralFileHandler == nil {
+		unregistered = append(unregistered, "storage.CreateStorageGeneralFileHandler")
+	}
 	if o.TCPRequestRuleCreateTCPRequestRuleHandler == nil {
 		unregistered = append(unregistered, "tcp_request_rule.CreateTCPRequestRuleHandler")
 	}
 	if o.TCPResponseRuleCreateTCPResponseRuleHandler == nil {
 		unregistered = append(unregistered, "tcp_response_rule.CreateTCPResponseRuleHandler")
 	}
+	if o.TLSProfileCreateTLSProfileHandler == nil {
+		unregistered = append(unregistered, "tls_profile.CreateTLSProfileHandler")
+	}
+	if o.UsersDeleteAPIKeyHandler == nil {
+		unregistered = append(unregistered, "users.DeleteAPIKeyHandler")
+	}
 	if o.ACLDeleteACLHandler == nil {
 		unregistered = append(unregistered, "acl.DeleteACLHandler")
 	}
+	if o.AnnotationsDeleteAnnotationHandler == nil {
+		unregistered = append(unregistered, "annotations.DeleteAnnotationHandler")
+	}
 	if o.BackendDeleteBackendHandler == nil {
 		unregistered = append(unregistered, "backend.DeleteBackendHandler")
 	}
 	if o.BackendSwitchingRuleDeleteBackendSwitchingRuleHandler == nil {
 		unregistered = append(unregistered, "backend_switching_rule.DeleteBackendSwitchingRuleHandler")
 	}
+	if o.BandwidthLimitDeleteBandwidthLimitHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.DeleteBandwidthLimitHandler")
+	}
 	if o.BindDeleteBindHandler == nil {
 		unregistered = append(unregistered, "bind.DeleteBindHandler")
 	}
 	if o.ServiceDiscoveryDeleteConsulHandler == nil {
 		unregistered = append(unregistered, "service_discovery.DeleteConsulHandler")
 	}
+	if o.BackendDeleteDynamicCookieKeyHandler == nil {
+		unregistered = append(unregistered, "backend.DeleteDynamicCookieKeyHandler")
+	}
 	if o.FilterDeleteFilterHandler == nil {
 		unregistered = append(unregistered, "filter.DeleteFilterHandler")
 	}
 	if o.FrontendDeleteFrontendHandler == nil {
 		unregistered = append(unregistered, "frontend.DeleteFrontendHandler")
 	}
+	if o.FrontendDeleteFrontendCircuitBreakerHandler == nil {
+		unregistered = append(unregistered, "frontend.DeleteFrontendCircuitBreakerHandler")
+	}
+	if o.GeoIPDeleteGeoIPPolicyHandler == nil {
+		unregistered = append(unregistered, "geo_ip.DeleteGeoIPPolicyHandler")
+	}
 	if o.HTTPRequestRuleDeleteHTTPRequestRuleHandler == nil {
 		unregistered = append(unregistered, "http_request_rule.DeleteHTTPRequestRuleHandler")
 	}
 	if o.HTTPResponseRuleDeleteHTTPResponseRuleHandler == nil {
 		unregistered = append(unregistered, "http_response_rule.DeleteHTTPResponseRuleHandler")
 	}
+	if o.FrontendDeleteHTTPSRedirectHandler == nil {
+		unregistered = append(unregistered, "frontend.DeleteHTTPSRedirectHandler")
+	}
+	if o.HostRoutingDeleteHostRouteHandler == nil {
+		unregistered = append(unregistered, "host_routing.DeleteHostRouteHandler")
+	}
+	if o.ListenDeleteListenHandler == nil {
+		unregistered = append(unregistered, "listen.DeleteListenHandler")
+	}
+	if o.ListenDeleteListenServerHandler == nil {
+		unregistered = append(unregistered, "listen.DeleteListenServerHandler")
+	}
+	if o.LogFormatDeleteLogFormatHandler == nil {
+		unregistered = append(unregistered, "log_format.DeleteLogFormatHandler")
+	}
 	if o.LogTargetDeleteLogTargetHandler == nil {
 		unregistered = append(unregistered, "log_target.DeleteLogTargetHandler")
 	}
+	if o.MaintenanceDeleteMaintenanceHandler == nil {
+		unregistered = append(unregistered, "maintenance.DeleteMaintenanceHandler")
+	}
 	if o.NameserverDeleteNameserverHandler == nil {
 		unregistered = append(unregistered, "nameserver.DeleteNameserverHandler")
 	}
@@ -1039,6 +1987,9 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.PeerEntryDeletePeerEntryHandler == nil {
 		unregistered = append(unregistered, "peer_entry.DeletePeerEntryHandler")
 	}
+	if o.RateLimitDeleteRateLimitHandler == nil {
+		unregistered = append(unregistered, "rate_limit.DeleteRateLimitHandler")
+	}
 	if o.ResolverDeleteResolverHandler == nil {
 		unregistered = append(unregistered, "resolver.DeleteResolverHandler")
 	}
@@ -1051,24 +2002,54 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.ServerSwitchingRuleDeleteServerSwitchingRuleHandler == nil {
 		unregistered = append(unregistered, "server_switching_rule.DeleteServerSwitchingRuleHandler")
 	}
+	if o.ServerDeleteServerWarmupHandler == nil {
+		unregistered = append(unregistered, "server.DeleteServerWarmupHandler")
+	}
 	if o.SitesDeleteSiteHandler == nil {
 		unregistered = append(unregistered, "sites.DeleteSiteHandler")
 	}
+	if o.SpoeAgentDeleteSpoeAgentHandler == nil {
+		unregistered = append(unregistered, "spoe_agent.DeleteSpoeAgentHandler")
+	}
 	if o.StickRuleDeleteStickRuleHandler == nil {
 		unregistered = append(unregistered, "stick_rule.DeleteStickRuleHandler")
 	}
+	if o.StorageDeleteStorageGeneralFileHandler == nil {
+		unregistered = append(unregistered, "storage.DeleteStorageGeneralFileHandler")
+	}
 	if o.TCPRequestRuleDeleteTCPRequestRuleHandler == nil {
 		unregistered = append(unregistered, "tcp_request_rule.DeleteTCPRequestRuleHandler")
 	}
 	if o.TCPResponseRuleDeleteTCPResponseRuleHandler == nil {
 		unregistered = append(unregistered, "tcp_response_rule.DeleteTCPResponseRuleHandler")
 	}
+	if o.TLSProfileDeleteTLSProfileHandler == nil {
+		unregistered = append(unregistered, "tls_profile.DeleteTLSProfileHandler")
+	}
 	if o.TransactionsDeleteTransactionHandler == nil {
 		unregistered = append(unregistered, "transactions.DeleteTransactionHandler")
 	}
+	if o.SpoeAgentDisableSpoeAgentHandler == nil {
+		unregistered = append(unregistered, "spoe_agent.DisableSpoeAgentHandler")
+	}
+	if o.SpoeAgentEnableSpoeAgentHandler == nil {
+		unregistered = append(unregistered, "spoe_agent.EnableSpoeAgentHandler")
+	}
+	if o.ReloadsFreezeReloadsHandler == nil {
+		unregistered = append(unregistered, "reloads.FreezeReloadsHandler")
+	}
+	if o.StorageGenerateStorageDHParamHandler == nil {
+		unregistered = append(unregistered, "storage.GenerateStorageDHParamHandler")
+	}
 	if o.DiscoveryGetAPIEndpointsHandler == nil {
 		unregistered = append(unregistered, "discovery.GetAPIEndpointsHandler")
 	}
+	if o.UsersGetAPIKeyHandler == nil {
+		unregistered = append(unregistered, "users.GetAPIKeyHandler")
+	}
+	if o.UsersGetAPIKeysHandler == nil {
+		unregistered = append(unregistered, "users.GetAPIKeysHandler")
+	}
 	if o.ACLGetACLHandler == nil {
 		unregistered = append(unregistered, "acl.GetACLHandler")
 	}
@@ -1078,9 +2059,24 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.MapsGetAllRuntimeMapFilesHandler == nil {
 		unregistered = append(unregistered, "maps.GetAllRuntimeMapFilesHandler")
 	}
+	if o.StorageGetAllStorageDHParamsHandler == nil {
+		unregistered = append(unregistered, "storage.GetAllStorageDHParamsHandler")
+	}
+	if o.StorageGetAllStorageGeneralFilesHandler == nil {
+		unregistered = append(unregistered, "storage.GetAllStorageGeneralFilesHandler")
+	}
+	if o.AnnotationsGetAnnotationHandler == nil {
+		unregistered = append(unregistered, "annotations.GetAnnotationHandler")
+	}
+	if o.AnnotationsGetAnnotationsHandler == nil {
+		unregistered = append(unregistered, "annotations.GetAnnotationsHandler")
+	}
 	if o.BackendGetBackendHandler == nil {
 		unregistered = append(unregistered, "backend.GetBackendHandler")
 	}
+	if o.BackendGetBackendFullHandler == nil {
+		unregistered = append(unregistered, "backend.GetBackendFullHandler")
+	}
 	if o.BackendSwitchingRuleGetBackendSwitchingRuleHandler == nil {
 		unregistered = append(unregistered, "backend_switching_rule.GetBackendSwitchingRuleHandler")
 	}
@@ -1090,45 +2086,123 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.BackendGetBackendsHandler == nil {
 		unregistered = append(unregistered, "backend.GetBackendsHandler")
 	}
+	if o.BandwidthLimitGetBandwidthLimitHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.GetBandwidthLimitHandler")
+	}
+	if o.BandwidthLimitGetBandwidthLimitsHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.GetBandwidthLimitsHandler")
+	}
 	if o.BindGetBindHandler == nil {
 		unregistered = append(unregistered, "bind.GetBindHandler")
 	}
+	if o.BindGetBindNetworkHandler == nil {
+		unregistered = append(unregistered, "bind.GetBindNetworkHandler")
+	}
+	if o.BindGetBindSSLHandler == nil {
+		unregistered = append(unregistered, "bind.GetBindSSLHandler")
+	}
 	if o.BindGetBindsHandler == nil {
 		unregistered = append(unregistered, "bind.GetBindsHandler")
 	}
+	if o.ClusterGetBootstrapKeyRotationsHandler == nil {
+		unregistered = append(unregistered, "cluster.GetBootstrapKeyRotationsHandler")
+	}
+	if o.GlobalGetCertificateUpdateHandler == nil {
+		unregistered = append(unregistered, "global.GetCertificateUpdateHandler")
+	}
 	if o.DiscoveryGetClusterHandler == nil {
 		unregistered = append(unregistered, "discovery.GetClusterHandler")
 	}
+	if o.CompressionGetCompressionHandler == nil {
+		unregistered = append(unregistered, "compression.GetCompressionHandler")
+	}
+	if o.ConfigurationGetConfigurationCompatibilityHandler == nil {
+		unregistered = append(unregistered, "configuration.GetConfigurationCompatibilityHandler")
+	}
 	if o.DiscoveryGetConfigurationEndpointsHandler == nil {
 		unregistered = append(unregistered, "discovery.GetConfigurationEndpointsHandler")
 	}
+	if o.ConfigurationGetConfigurationIntegrityHandler == nil {
+		unregistered = append(unregistered, "configuration.GetConfigurationIntegrityHandler")
+	}
 	if o.ServiceDiscoveryGetConsulHandler == nil {
 		unregistered = append(unregistered, "service_discovery.GetConsulHandler")
 	}
 	if o.ServiceDiscoveryGetConsulsHandler == nil {
 		unregistered = append(unregistered, "service_discovery.GetConsulsHandler")
 	}
+	if o.GlobalGetDHParamHandler == nil {
+		unregistered = append(unregistered, "global.GetDHParamHandler")
+	}
+	if o.InformationGetDataplaneConfigurationHandler == nil {
+		unregistered = append(unregistered, "information.GetDataplaneConfigurationHandler")
+	}
+	if o.StatsGetDataplaneStatsHandler == nil {
+		unregistered = append(unregistered, "stats.GetDataplaneStatsHandler")
+	}
 	if o.DefaultsGetDefaultsHandler == nil {
 		unregistered = append(unregistered, "defaults.GetDefaultsHandler")
 	}
+	if o.GlobalGetDeviceDetectionHandler == nil {
+		unregistered = append(unregistered, "global.GetDeviceDetectionHandler")
+	}
+	if o.DrainGetDrainHandler == nil {
+		unregistered = append(unregistered, "drain.GetDrainHandler")
+	}
+	if o.DrainGetDrainsHandler == nil {
+		unregistered = append(unregistered, "drain.GetDrainsHandler")
+	}
+	if o.BackendGetDynamicCookieKeyHandler == nil {
+		unregistered = append(unregistered, "backend.GetDynamicCookieKeyHandler")
+	}
 	if o.FilterGetFilterHandler == nil {
 		unregistered = append(unregistered, "filter.GetFilterHandler")
 	}
 	if o.FilterGetFiltersHandler == nil {
 		unregistered = append(unregistered, "filter.GetFiltersHandler")
 	}
+	if o.FleetGetFleetHandler == nil {
+		unregistered = append(unregistered, "fleet.GetFleetHandler")
+	}
+	if o.FleetGetFleetNodeConfigurationHandler == nil {
+		unregistered = append(unregistered, "fleet.GetFleetNodeConfigurationHandler")
+	}
 	if o.FrontendGetFrontendHandler == nil {
 		unregistered = append(unregistered, "frontend.GetFrontendHandler")
 	}
+	if o.FrontendGetFrontendCircuitBreakerHandler == nil {
+		unregistered = append(unregistered, "frontend.GetFrontendCircuitBreakerHandler")
+	}
+	if o.FrontendGetFrontendFullHandler == nil {
+		unregistered = append(unregistered, "frontend.GetFrontendFullHandler")
+	}
 	if o.FrontendGetFrontendsHandler == nil {
 		unregistered = append(unregistered, "frontend.GetFrontendsHandler")
 	}
+	if o.GeoIPGetGeoIPHandler == nil {
+		unregistered = append(unregistered, "geo_ip.GetGeoIPHandler")
+	}
+	if o.GeoIPGetGeoIPPoliciesHandler == nil {
+		unregistered = append(unregistered, "geo_ip.GetGeoIPPoliciesHandler")
+	}
+	if o.GeoIPGetGeoIPPolicyHandler == nil {
+		unregistered = append(unregistered, "geo_ip.GetGeoIPPolicyHandler")
+	}
+	if o.GitGetGitConflictsHandler == nil {
+		unregistered = append(unregistered, "git.GetGitConflictsHandler")
+	}
+	if o.GitGetGitKnownHostsHandler == nil {
+		unregistered = append(unregistered, "git.GetGitKnownHostsHandler")
+	}
 	if o.GlobalGetGlobalHandler == nil {
 		unregistered = append(unregistered, "global.GetGlobalHandler")
 	}
 	if o.ConfigurationGetHAProxyConfigurationHandler == nil {
 		unregistered = append(unregistered, "configuration.GetHAProxyConfigurationHandler")
 	}
+	if o.HealthGetHAProxyHealthHandler == nil {
+		unregistered = append(unregistered, "health.GetHAProxyHealthHandler")
+	}
 	if o.HTTPRequestRuleGetHTTPRequestRuleHandler == nil {
 		unregistered = append(unregistered, "http_request_rule.GetHTTPRequestRuleHandler")
 	}
@@ -1141,21 +2215,57 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.HTTPResponseRuleGetHTTPResponseRulesHandler == nil {
 		unregistered = append(unregistered, "http_response_rule.GetHTTPResponseRulesHandler")
 	}
+	if o.FrontendGetHTTPSRedirectHandler == nil {
+		unregistered = append(unregistered, "frontend.GetHTTPSRedirectHandler")
+	}
 	if o.DiscoveryGetHaproxyEndpointsHandler == nil {
 		unregistered = append(unregistered, "discovery.GetHaproxyEndpointsHandler")
 	}
 	if o.InformationGetHaproxyProcessInfoHandler == nil {
 		unregistered = append(unregistered, "information.GetHaproxyProcessInfoHandler")
 	}
+	if o.InformationGetHaproxyProcessesHandler == nil {
+		unregistered = append(unregistered, "information.GetHaproxyProcessesHandler")
+	}
+	if o.HostRoutingGetHostRouteHandler == nil {
+		unregistered = append(unregistered, "host_routing.GetHostRouteHandler")
+	}
+	if o.HostRoutingGetHostRoutesHandler == nil {
+		unregistered = append(unregistered, "host_routing.GetHostRoutesHandler")
+	}
 	if o.InformationGetInfoHandler == nil {
 		unregistered = append(unregistered, "information.GetInfoHandler")
 	}
+	if o.ListenGetListenHandler == nil {
+		unregistered = append(unregistered, "listen.GetListenHandler")
+	}
+	if o.ListenGetListenServerHandler == nil {
+		unregistered = append(unregistered, "listen.GetListenServerHandler")
+	}
+	if o.ListenGetListenServersHandler == nil {
+		unregistered = append(unregistered, "listen.GetListenServersHandler")
+	}
+	if o.ListenGetListensHandler == nil {
+		unregistered = append(unregistered, "listen.GetListensHandler")
+	}
+	if o.LogFormatGetLogFormatHandler == nil {
+		unregistered = append(unregistered, "log_format.GetLogFormatHandler")
+	}
+	if o.LogFormatGetLogFormatsHandler == nil {
+		unregistered = append(unregistered, "log_format.GetLogFormatsHandler")
+	}
 	if o.LogTargetGetLogTargetHandler == nil {
 		unregistered = append(unregistered, "log_target.GetLogTargetHandler")
 	}
 	if o.LogTargetGetLogTargetsHandler == nil {
 		unregistered = append(unregistered, "log_target.GetLogTargetsHandler")
 	}
+	if o.MaintenanceGetMaintenanceHandler == nil {
+		unregistered = append(unregistered, "maintenance.GetMaintenanceHandler")
+	}
+	if o.MaintenanceGetMaintenancesHandler == nil {
+		unregistered = append(unregistered, "maintenance.GetMaintenancesHandler")
+	}
 	if o.NameserverGetNameserverHandler == nil {
 		unregistered = append(unregistered, "nameserver.GetNameserverHandler")
 	}
@@ -1165,6 +2275,9 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.MapsGetOneRuntimeMapHandler == nil {
 		unregistered = append(unregistered, "maps.GetOneRuntimeMapHandler")
 	}
+	if o.StorageGetOneStorageGeneralFileHandler == nil {
+		unregistered = append(unregistered, "storage.GetOneStorageGeneralFileHandler")
+	}
 	if o.SpecificationOpenapiv3GetOpenapiv3SpecificationHandler == nil {
 		unregistered = append(unregistered, "specification_openapiv3.GetOpenapiv3SpecificationHandler")
 	}
@@ -1180,10 +2293,28 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.PeerGetPeerSectionsHandler == nil {
 		unregistered = append(unregistered, "peer.GetPeerSectionsHandler")
 	}
-	if o.ReloadsGetReloadHandler == nil {
-		unregistered = append(unregistered, "reloads.GetReloadHandler")
+	if o.InformationGetPreflightHandler == nil {
+		unregistered = append(unregistered, "information.GetPreflightHandler")
 	}
-	if o.ReloadsGetReloadsHandler == nil {
+	if o.InformationGetPrivilegesHandler == nil {
+		unregistered = append(unregistered, "information.GetPrivilegesHandler")
+	}
+	if o.RateLimitGetRateLimitHandler == nil {
+		unregistered = append(unregistered, "rate_limit.GetRateLimitHandler")
+	}
+	if o.RateLimitGetRateLimitOffendersHandler == nil {
+		unregistered = append(unregistered, "rate_limit.GetRateLimitOffendersHandler")
+	}
+	if o.RateLimitGetRateLimitsHandler == nil {
+		unregistered = append(unregistered, "rate_limit.GetRateLimitsHandler")
+	}
+	if o.ReloadsGetReloadHandler == nil {
+		unregistered = append(unregistered, "reloads.GetReloadHandler")
+	}
+	if o.ReloadsGetReloadFreezeHandler == nil {
+		unregistered = append(unregistered, "reloads.GetReloadFreezeHandler")
+	}
+	if o.ReloadsGetReloadsHandler == nil {
 		unregistered = append(unregistered, "reloads.GetReloadsHandler")
 	}
 	if o.ResolverGetResolverHandler == nil {
@@ -1192,9 +2323,27 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.ResolverGetResolversHandler == nil {
 		unregistered = append(unregistered, "resolver.GetResolversHandler")
 	}
+	if o.TracesGetRingEventsHandler == nil {
+		unregistered = append(unregistered, "traces.GetRingEventsHandler")
+	}
+	if o.BackendGetRuntimeBackendHandler == nil {
+		unregistered = append(unregistered, "backend.GetRuntimeBackendHandler")
+	}
+	if o.BackendGetRuntimeBackendsHandler == nil {
+		unregistered = append(unregistered, "backend.GetRuntimeBackendsHandler")
+	}
 	if o.DiscoveryGetRuntimeEndpointsHandler == nil {
 		unregistered = append(unregistered, "discovery.GetRuntimeEndpointsHandler")
 	}
+	if o.FrontendGetRuntimeFrontendHandler == nil {
+		unregistered = append(unregistered, "frontend.GetRuntimeFrontendHandler")
+	}
+	if o.FrontendGetRuntimeFrontendsHandler == nil {
+		unregistered = append(unregistered, "frontend.GetRuntimeFrontendsHandler")
+	}
+	if o.TracesGetRuntimeLogsHandler == nil {
+		unregistered = append(unregistered, "traces.GetRuntimeLogsHandler")
+	}
 	if o.MapsGetRuntimeMapEntryHandler == nil {
 		unregistered = append(unregistered, "maps.GetRuntimeMapEntryHandler")
 	}
@@ -1204,15 +2353,27 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.ServerGetRuntimeServersHandler == nil {
 		unregistered = append(unregistered, "server.GetRuntimeServersHandler")
 	}
+	if o.CertificatesGetSSLCertificatesHandler == nil {
+		unregistered = append(unregistered, "certificates.GetSSLCertificatesHandler")
+	}
+	if o.SecurityOptionsGetSecurityOptionsHandler == nil {
+		unregistered = append(unregistered, "security_options.GetSecurityOptionsHandler")
+	}
 	if o.ServerGetServerHandler == nil {
 		unregistered = append(unregistered, "server.GetServerHandler")
 	}
+	if o.ServerGetServerNetworkHandler == nil {
+		unregistered = append(unregistered, "server.GetServerNetworkHandler")
+	}
 	if o.ServerSwitchingRuleGetServerSwitchingRuleHandler == nil {
 		unregistered = append(unregistered, "server_switching_rule.GetServerSwitchingRuleHandler")
 	}
 	if o.ServerSwitchingRuleGetServerSwitchingRulesHandler == nil {
 		unregistered = append(unregistered, "server_switching_rule.GetServerSwitchingRulesHandler")
 	}
+	if o.ServerGetServerWarmupHandler == nil {
+		unregistered = append(unregistered, "server.GetServerWarmupHandler")
+	}
 	if o.ServerGetServersHandler == nil {
 		unregistered = append(unregistered, "server.GetServersHandler")
 	}
@@ -1225,15 +2386,33 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.SitesGetSitesHandler == nil {
 		unregistered = append(unregistered, "sites.GetSitesHandler")
 	}
+	if o.SnapshotGetSnapshotHandler == nil {
+		unregistered = append(unregistered, "snapshot.GetSnapshotHandler")
+	}
 	if o.SpecificationGetSpecificationHandler == nil {
 		unregistered = append(unregistered, "specification.GetSpecificationHandler")
 	}
+	if o.SpecificationGetSpecificationSchemaHandler == nil {
+		unregistered = append(unregistered, "specification.GetSpecificationSchemaHandler")
+	}
+	if o.SpoeAgentGetSpoeAgentHandler == nil {
+		unregistered = append(unregistered, "spoe_agent.GetSpoeAgentHandler")
+	}
+	if o.SpoeAgentGetSpoeAgentsHandler == nil {
+		unregistered = append(unregistered, "spoe_agent.GetSpoeAgentsHandler")
+	}
 	if o.StatsGetStatsHandler == nil {
 		unregistered = append(unregistered, "stats.GetStatsHandler")
 	}
 	if o.DiscoveryGetStatsEndpointsHandler == nil {
 		unregistered = append(unregistered, "discovery.GetStatsEndpointsHandler")
 	}
+	if o.StatsPageGetStatsPageHandler == nil {
+		unregistered = append(unregistered, "stats_page.GetStatsPageHandler")
+	}
+	if o.StatsPageGetStatsSocketsHandler == nil {
+		unregistered = append(unregistered, "stats_page.GetStatsSocketsHandler")
+	}
 	if o.StickRuleGetStickRuleHandler == nil {
 		unregistered = append(unregistered, "stick_rule.GetStickRuleHandler")
 	}
@@ -1249,6 +2428,9 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.StickTableGetStickTablesHandler == nil {
 		unregistered = append(unregistered, "stick_table.GetStickTablesHandler")
 	}
+	if o.StorageGetStorageCleanupHandler == nil {
+		unregistered = append(unregistered, "storage.GetStorageCleanupHandler")
+	}
 	if o.TCPRequestRuleGetTCPRequestRuleHandler == nil {
 		unregistered = append(unregistered, "tcp_request_rule.GetTCPRequestRuleHandler")
 	}
@@ -1261,45 +2443,126 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.TCPResponseRuleGetTCPResponseRulesHandler == nil {
 		unregistered = append(unregistered, "tcp_response_rule.GetTCPResponseRulesHandler")
 	}
+	if o.TLSProfileGetTLSProfileHandler == nil {
+		unregistered = append(unregistered, "tls_profile.GetTLSProfileHandler")
+	}
+	if o.TLSProfileGetTLSProfileDeviationsHandler == nil {
+		unregistered = append(unregistered, "tls_profile.GetTLSProfileDeviationsHandler")
+	}
+	if o.TLSProfileGetTLSProfilesHandler == nil {
+		unregistered = append(unregistered, "tls_profile.GetTLSProfilesHandler")
+	}
+	if o.GlobalGetThreadingHandler == nil {
+		unregistered = append(unregistered, "global.GetThreadingHandler")
+	}
+	if o.TracesGetTracesHandler == nil {
+		unregistered = append(unregistered, "traces.GetTracesHandler")
+	}
 	if o.TransactionsGetTransactionHandler == nil {
 		unregistered = append(unregistered, "transactions.GetTransactionHandler")
 	}
+	if o.TransactionsGetTransactionImpactHandler == nil {
+		unregistered = append(unregistered, "transactions.GetTransactionImpactHandler")
+	}
+	if o.TransactionsGetTransactionStorageHandler == nil {
+		unregistered = append(unregistered, "transactions.GetTransactionStorageHandler")
+	}
 	if o.TransactionsGetTransactionsHandler == nil {
 		unregistered = append(unregistered, "transactions.GetTransactionsHandler")
 	}
+	if o.GitGitWebhookHandler == nil {
+		unregistered = append(unregistered, "git.GitWebhookHandler")
+	}
+	if o.ConfigurationImportConfigurationHandler == nil {
+		unregistered = append(unregistered, "configuration.ImportConfigurationHandler")
+	}
 	if o.ClusterInitiateCertificateRefreshHandler == nil {
 		unregistered = append(unregistered, "cluster.InitiateCertificateRefreshHandler")
 	}
+	if o.ConfigurationMigrateConfigurationHandler == nil {
+		unregistered = append(unregistered, "configuration.MigrateConfigurationHandler")
+	}
+	if o.ConfigurationPlanConfigurationHandler == nil {
+		unregistered = append(unregistered, "configuration.PlanConfigurationHandler")
+	}
 	if o.ClusterPostClusterHandler == nil {
 		unregistered = append(unregistered, "cluster.PostClusterHandler")
 	}
 	if o.ConfigurationPostHAProxyConfigurationHandler == nil {
 		unregistered = append(unregistered, "configuration.PostHAProxyConfigurationHandler")
 	}
+	if o.FleetPrepareFleetConfigurationHandler == nil {
+		unregistered = append(unregistered, "fleet.PrepareFleetConfigurationHandler")
+	}
+	if o.FleetPushFleetHandler == nil {
+		unregistered = append(unregistered, "fleet.PushFleetHandler")
+	}
+	if o.GeoIPRefreshGeoIPHandler == nil {
+		unregistered = append(unregistered, "geo_ip.RefreshGeoIPHandler")
+	}
+	if o.UsersReplaceAPIKeyHandler == nil {
+		unregistered = append(unregistered, "users.ReplaceAPIKeyHandler")
+	}
 	if o.ACLReplaceACLHandler == nil {
 		unregistered = append(unregistered, "acl.ReplaceACLHandler")
 	}
+	if o.AnnotationsReplaceAnnotationHandler == nil {
+		unregistered = append(unregistered, "annotations.ReplaceAnnotationHandler")
+	}
 	if o.BackendReplaceBackendHandler == nil {
 		unregistered = append(unregistered, "backend.ReplaceBackendHandler")
 	}
 	if o.BackendSwitchingRuleReplaceBackendSwitchingRuleHandler == nil {
 		unregistered = append(unregistered, "backend_switching_rule.ReplaceBackendSwitchingRuleHandler")
 	}
+	if o.BandwidthLimitReplaceBandwidthLimitHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.ReplaceBandwidthLimitHandler")
+	}
 	if o.BindReplaceBindHandler == nil {
 		unregistered = append(unregistered, "bind.ReplaceBindHandler")
 	}
+	if o.BindReplaceBindNetworkHandler == nil {
+		unregistered = append(unregistered, "bind.ReplaceBindNetworkHandler")
+	}
+	if o.BindReplaceBindSSLHandler == nil {
+		unregistered = append(unregistered, "bind.ReplaceBindSSLHandler")
+	}
+	if o.GlobalReplaceCertificateUpdateHandler == nil {
+		unregistered = append(unregistered, "global.ReplaceCertificateUpdateHandler")
+	}
+	if o.CompressionReplaceCompressionHandler == nil {
+		unregistered = append(unregistered, "compression.ReplaceCompressionHandler")
+	}
 	if o.ServiceDiscoveryReplaceConsulHandler == nil {
 		unregistered = append(unregistered, "service_discovery.ReplaceConsulHandler")
 	}
+	if o.GlobalReplaceDHParamHandler == nil {
+		unregistered = append(unregistered, "global.ReplaceDHParamHandler")
+	}
 	if o.DefaultsReplaceDefaultsHandler == nil {
 		unregistered = append(unregistered, "defaults.ReplaceDefaultsHandler")
 	}
+	if o.GlobalReplaceDeviceDetectionHandler == nil {
+		unregistered = append(unregistered, "global.ReplaceDeviceDetectionHandler")
+	}
+	if o.BackendReplaceDynamicCookieKeyHandler == nil {
+		unregistered = append(unregistered, "backend.ReplaceDynamicCookieKeyHandler")
+	}
 	if o.FilterReplaceFilterHandler == nil {
 		unregistered = append(unregistered, "filter.ReplaceFilterHandler")
 	}
 	if o.FrontendReplaceFrontendHandler == nil {
 		unregistered = append(unregistered, "frontend.ReplaceFrontendHandler")
 	}
+	if o.FrontendReplaceFrontendCircuitBreakerHandler == nil {
+		unregistered = append(unregistered, "frontend.ReplaceFrontendCircuitBreakerHandler")
+	}
+	if o.GeoIPReplaceGeoIPPolicyHandler == nil {
+		unregistered = append(unregistered, "geo_ip.ReplaceGeoIPPolicyHandler")
+	}
+	if o.GitReplaceGitKnownHostsHandler == nil {
+		unregistered = append(unregistered, "git.ReplaceGitKnownHostsHandler")
+	}
 	if o.GlobalReplaceGlobalHandler == nil {
 		unregistered = append(unregistered, "global.ReplaceGlobalHandler")
 	}
@@ -1309,48 +2572,135 @@ func (o *DataPlaneAPI) Validate() error {
 	if o.HTTPResponseRuleReplaceHTTPResponseRuleHandler == nil {
 		unregistered = append(unregistered, "http_response_rule.ReplaceHTTPResponseRuleHandler")
 	}
+	if o.FrontendReplaceHTTPSRedirectHandler == nil {
+		unregistered = append(unregistered, "frontend.ReplaceHTTPSRedirectHandler")
+	}
+	if o.ListenReplaceListenHandler == nil {
+		unregistered = append(unregistered, "listen.ReplaceListenHandler")
+	}
+	if o.ListenReplaceListenServerHandler == nil {
+		unregistered = append(unregistered, "listen.ReplaceListenServerHandler")
+	}
+	if o.LogFormatReplaceLogFormatHandler == nil {
+		unregistered = append(unregistered, "log_format.ReplaceLogFormatHandler")
+	}
 	if o.LogTargetReplaceLogTargetHandler == nil {
 		unregistered = append(unregistered, "log_target.ReplaceLogTargetHandler")
 	}
+	if o.MaintenanceReplaceMaintenanceHandler == nil {
+		unregistered = append(unregistered, "maintenance.ReplaceMaintenanceHandler")
+	}
 	if o.NameserverReplaceNameserverHandler == nil {
 		unregistered = append(unregistered, "nameserver.ReplaceNameserverHandler")
 	}
 	if o.PeerEntryReplacePeerEntryHandler == nil {
 		unregistered = append(unregistered, "peer_entry.ReplacePeerEntryHandler")
 	}
+	if o.RateLimitReplaceRateLimitHandler == nil {
+		unregistered = append(unregistered, "rate_limit.ReplaceRateLimitHandler")
+	}
 	if o.ResolverReplaceResolverHandler == nil {
 		unregistered = append(unregistered, "resolver.ReplaceResolverHandler")
 	}
+	if o.BackendReplaceRuntimeBackendHandler == nil {
+		unregistered = append(unregistered, "backend.ReplaceRuntimeBackendHandler")
+	}
+	if o.FrontendReplaceRuntimeFrontendHandler == nil {
+		unregistered = append(unregistered, "frontend.ReplaceRuntimeFrontendHandler")
+	}
 	if o.MapsReplaceRuntimeMapEntryHandler == nil {
 		unregistered = append(unregistered, "maps.ReplaceRuntimeMapEntryHandler")
 	}
 	if o.ServerReplaceRuntimeServerHandler == nil {
 		unregistered = append(unregistered, "server.ReplaceRuntimeServerHandler")
 	}
+	if o.ServerReplaceRuntimeWeightsHandler == nil {
+		unregistered = append(unregistered, "server.ReplaceRuntimeWeightsHandler")
+	}
+	if o.SecurityOptionsReplaceSecurityOptionsHandler == nil {
+		unregistered = append(unregistered, "security_options.ReplaceSecurityOptionsHandler")
+	}
+	if o.UsersReplaceSelfPasswordHandler == nil {
+		unregistered = append(unregistered, "users.ReplaceSelfPasswordHandler")
+	}
 	if o.ServerReplaceServerHandler == nil {
 		unregistered = append(unregistered, "server.ReplaceServerHandler")
 	}
+	if o.ServerReplaceServerNetworkHandler == nil {
+		unregistered = append(unregistered, "server.ReplaceServerNetworkHandler")
+	}
 	if o.ServerSwitchingRuleReplaceServerSwitchingRuleHandler == nil {
 		unregistered = append(unregistered, "server_switching_rule.ReplaceServerSwitchingRuleHandler")
 	}
+	if o.ServerReplaceServerWarmupHandler == nil {
+		unregistered = append(unregistered, "server.ReplaceServerWarmupHandler")
+	}
 	if o.SitesReplaceSiteHandler == nil {
 		unregistered = append(unregistered, "sites.ReplaceSiteHandler")
 	}
+	if o.SpoeAgentReplaceSpoeAgentHandler == nil {
+		unregistered = append(unregistered, "spoe_agent.ReplaceSpoeAgentHandler")
+	}
+	if o.StatsPageReplaceStatsPageHandler == nil {
+		unregistered = append(unregistered, "stats_page.ReplaceStatsPageHandler")
+	}
+	if o.StatsPageReplaceStatsSocketsHandler == nil {
+		unregistered = append(unregistered, "stats_page.ReplaceStatsSocketsHandler")
+	}
 	if o.StickRuleReplaceStickRuleHandler == nil {
 		unregistered = append(unregistered, "stick_rule.ReplaceStickRuleHandler")
 	}
+	if o.StorageReplaceStorageGeneralFileHandler == nil {
+		unregistered = append(unregistered, "storage.ReplaceStorageGeneralFileHandler")
+	}
 	if o.TCPRequestRuleReplaceTCPRequestRuleHandler == nil {
 		unregistered = append(unregistered, "tcp_request_rule.ReplaceTCPRequestRuleHandler")
 	}
 	if o.TCPResponseRuleReplaceTCPResponseRuleHandler == nil {
 		unregistered = append(unregistered, "tcp_response_rule.ReplaceTCPResponseRuleHandler")
 	}
+	if o.TLSProfileReplaceTLSProfileHandler == nil {
+		unregistered = append(unregistered, "tls_profile.ReplaceTLSProfileHandler")
+	}
+	if o.GlobalReplaceThreadingHandler == nil {
+		unregistered = append(unregistered, "global.ReplaceThreadingHandler")
+	}
+	if o.TracesReplaceTraceHandler == nil {
+		unregistered = append(unregistered, "traces.ReplaceTraceHandler")
+	}
+	if o.TransactionsReplaceTransactionMetadataHandler == nil {
+		unregistered = append(unregistered, "transactions.ReplaceTransactionMetadataHandler")
+	}
+	if o.GitResolveGitConflictsHandler == nil {
+		unregistered = append(unregistered, "git.ResolveGitConflictsHandler")
+	}
+	if o.SnapshotRestoreSnapshotHandler == nil {
+		unregistered = append(unregistered, "snapshot.RestoreSnapshotHandler")
+	}
+	if o.ReloadsRetryReloadHandler == nil {
+		unregistered = append(unregistered, "reloads.RetryReloadHandler")
+	}
 	if o.MapsShowRuntimeMapHandler == nil {
 		unregistered = append(unregistered, "maps.ShowRuntimeMapHandler")
 	}
 	if o.TransactionsStartTransactionHandler == nil {
 		unregistered = append(unregistered, "transactions.StartTransactionHandler")
 	}
+	if o.InformationStopOldWorkersHandler == nil {
+		unregistered = append(unregistered, "information.StopOldWorkersHandler")
+	}
+	if o.GitTestGitRemoteHandler == nil {
+		unregistered = append(unregistered, "git.TestGitRemoteHandler")
+	}
+	if o.ReloadsUnfreezeReloadsHandler == nil {
+		unregistered = append(unregistered, "reloads.UnfreezeReloadsHandler")
+	}
+	if o.ACLValidateACLHandler == nil {
+		unregistered = append(unregistered, "acl.ValidateACLHandler")
+	}
+	if o.LogFormatValidateLogFormatHandler == nil {
+		unregistered = append(unregistered, "log_format.ValidateLogFormatHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1411,6 +2761,8 @@ func (o *DataPlaneAPI) ProducersFor(mediaTypes []string) map[string]runtime.Prod
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/plain":
+			result["text/plain"] = o.TxtProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -1451,10 +2803,34 @@ func (o *DataPlaneAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/fleet/prepared/{id}"] = fleet.NewAbortFleetConfiguration(o.context, o.FleetAbortFleetConfigurationHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/services/haproxy/runtime/maps_entries"] = maps.NewAddMapEntry(o.context, o.MapsAddMapEntryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/configuration/batch"] = configuration.NewApplyBatch(o.context, o.ConfigurationApplyBatchHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/declarative"] = configuration.NewApplyConfiguration(o.context, o.ConfigurationApplyConfigurationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/log_formats/{name}/apply"] = log_format.NewApplyLogFormat(o.context, o.LogFormatApplyLogFormatHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/configuration/security_options/baseline"] = security_options.NewApplySecurityBaseline(o.context, o.SecurityOptionsApplySecurityBaselineHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/tls_profiles/{name}/apply"] = tls_profile.NewApplyTLSProfile(o.context, o.TLSProfileApplyTLSProfileHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -1462,10 +2838,18 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/fleet/prepared/{id}"] = fleet.NewCommitFleetConfiguration(o.context, o.FleetCommitFleetConfigurationHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/transactions/{id}"] = transactions.NewCommitTransaction(o.context, o.TransactionsCommitTransactionHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/services/haproxy/api_keys"] = users.NewCreateAPIKey(o.context, o.UsersCreateAPIKeyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/services/haproxy/configuration/acls"] = acl.NewCreateACL(o.context, o.ACLCreateACLHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1478,6 +2862,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/services/haproxy/configuration/bandwidth_limits"] = bandwidth_limit.NewCreateBandwidthLimit(o.context, o.BandwidthLimitCreateBandwidthLimitHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/services/haproxy/configuration/binds"] = bind.NewCreateBind(o.context, o.BindCreateBindHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1486,6 +2874,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/services/haproxy/runtime/drains"] = drain.NewCreateDrain(o.context, o.DrainCreateDrainHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/services/haproxy/configuration/filters"] = filter.NewCreateFilter(o.context, o.FilterCreateFilterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1502,6 +2894,22 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/services/haproxy/host_routes"] = host_routing.NewCreateHostRoute(o.context, o.HostRoutingCreateHostRouteHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/configuration/listens"] = listen.NewCreateListen(o.context, o.ListenCreateListenHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/configuration/listen_servers"] = listen.NewCreateListenServer(o.context, o.ListenCreateListenServerHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/log_formats"] = log_format.NewCreateLogFormat(o.context, o.LogFormatCreateLogFormatHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/services/haproxy/configuration/log_targets"] = log_target.NewCreateLogTarget(o.context, o.LogTargetCreateLogTargetHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1518,6 +2926,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/services/haproxy/rate_limits"] = rate_limit.NewCreateRateLimit(o.context, o.RateLimitCreateRateLimitHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/services/haproxy/configuration/resolvers"] = resolver.NewCreateResolver(o.context, o.ResolverCreateResolverHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1538,15 +2950,35 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/services/haproxy/spoe_agents"] = spoe_agent.NewCreateSpoeAgent(o.context, o.SpoeAgentCreateSpoeAgentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/services/haproxy/configuration/stick_rules"] = stick_rule.NewCreateStickRule(o.context, o.StickRuleCreateStickRuleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/services/haproxy/storage/dhparams"] = storage.NewCreateStorageDHParam(o.context, o.StorageCreateStorageDHParamHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/storage/general"] = storage.NewCreateStorageGeneralFile(o.context, o.StorageCreateStorageGeneralFileHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/services/haproxy/configuration/tcp_request_rules"] = tcp_request_rule.NewCreateTCPRequestRule(o.context, o.TCPRequestRuleCreateTCPRequestRuleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/services/haproxy/configuration/tcp_response_rules"] = tcp_response_rule.NewCreateTCPResponseRule(o.context, o.TCPResponseRuleCreateTCPResponseRuleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/tls_profiles"] = tls_profile.NewCreateTLSProfile(o.context, o.TLSProfileCreateTLSProfileHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/api_keys/{id}"] = users.NewDeleteAPIKey(o.context, o.UsersDeleteAPIKeyHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -1554,6 +2986,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/annotations/{type}/{name}"] = annotations.NewDeleteAnnotation(o.context, o.AnnotationsDeleteAnnotationHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/configuration/backends/{name}"] = backend.NewDeleteBackend(o.context, o.BackendDeleteBackendHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1562,6 +2998,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/configuration/bandwidth_limits/{name}"] = bandwidth_limit.NewDeleteBandwidthLimit(o.context, o.BandwidthLimitDeleteBandwidthLimitHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/configuration/binds/{name}"] = bind.NewDeleteBind(o.context, o.BindDeleteBindHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1570,6 +3010,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/configuration/dynamic_cookie_keys/{backend}"] = backend.NewDeleteDynamicCookieKey(o.context, o.BackendDeleteDynamicCookieKeyHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/configuration/filters/{index}"] = filter.NewDeleteFilter(o.context, o.FilterDeleteFilterHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1578,6 +3022,14 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/runtime/frontends/{name}/circuit_breaker"] = frontend.NewDeleteFrontendCircuitBreaker(o.context, o.FrontendDeleteFrontendCircuitBreakerHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/geoip/policies/{frontend}"] = geo_ip.NewDeleteGeoIPPolicy(o.context, o.GeoIPDeleteGeoIPPolicyHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/configuration/http_request_rules/{index}"] = http_request_rule.NewDeleteHTTPRequestRule(o.context, o.HTTPRequestRuleDeleteHTTPRequestRuleHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1586,10 +3038,34 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/configuration/https_redirects/{frontend}"] = frontend.NewDeleteHTTPSRedirect(o.context, o.FrontendDeleteHTTPSRedirectHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/host_routes/{host}"] = host_routing.NewDeleteHostRoute(o.context, o.HostRoutingDeleteHostRouteHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/configuration/listens/{name}"] = listen.NewDeleteListen(o.context, o.ListenDeleteListenHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/configuration/listen_servers/{name}"] = listen.NewDeleteListenServer(o.context, o.ListenDeleteListenServerHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/log_formats/{name}"] = log_format.NewDeleteLogFormat(o.context, o.LogFormatDeleteLogFormatHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/configuration/log_targets/{index}"] = log_target.NewDeleteLogTarget(o.context, o.LogTargetDeleteLogTargetHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/maintenance/{frontend}"] = maintenance.NewDeleteMaintenance(o.context, o.MaintenanceDeleteMaintenanceHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/configuration/nameservers/{name}"] = nameserver.NewDeleteNameserver(o.context, o.NameserverDeleteNameserverHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1602,6 +3078,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/rate_limits/{name}"] = rate_limit.NewDeleteRateLimit(o.context, o.RateLimitDeleteRateLimitHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/configuration/resolvers/{name}"] = resolver.NewDeleteResolver(o.context, o.ResolverDeleteResolverHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1618,14 +3098,26 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/runtime/servers/{name}/warmup"] = server.NewDeleteServerWarmup(o.context, o.ServerDeleteServerWarmupHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/sites/{name}"] = sites.NewDeleteSite(o.context, o.SitesDeleteSiteHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/spoe_agents/{name}"] = spoe_agent.NewDeleteSpoeAgent(o.context, o.SpoeAgentDeleteSpoeAgentHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/configuration/stick_rules/{index}"] = stick_rule.NewDeleteStickRule(o.context, o.StickRuleDeleteStickRuleHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/storage/general/{name}"] = storage.NewDeleteStorageGeneralFile(o.context, o.StorageDeleteStorageGeneralFileHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/configuration/tcp_request_rules/{index}"] = tcp_request_rule.NewDeleteTCPRequestRule(o.context, o.TCPRequestRuleDeleteTCPRequestRuleHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1634,7 +3126,27 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/services/haproxy/tls_profiles/{name}"] = tls_profile.NewDeleteTLSProfile(o.context, o.TLSProfileDeleteTLSProfileHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/services/haproxy/transactions/{id}"] = transactions.NewDeleteTransaction(o.context, o.TransactionsDeleteTransactionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/spoe_agents/{name}/frontends/{frontend}"] = spoe_agent.NewDisableSpoeAgent(o.context, o.SpoeAgentDisableSpoeAgentHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/spoe_agents/{name}/frontends/{frontend}"] = spoe_agent.NewEnableSpoeAgent(o.context, o.SpoeAgentEnableSpoeAgentHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/reload_freeze"] = reloads.NewFreezeReloads(o.context, o.ReloadsFreezeReloadsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/storage/dhparams/generate"] = storage.NewGenerateStorageDHParam(o.context, o.StorageGenerateStorageDHParamHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1642,155 +3154,363 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/acls/{index}"] = acl.NewGetACL(o.context, o.ACLGetACLHandler)
+	o.handlers["GET"]["/services/haproxy/api_keys/{id}"] = users.NewGetAPIKey(o.context, o.UsersGetAPIKeyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/acls"] = acl.NewGetAcls(o.context, o.ACLGetAclsHandler)
+	o.handlers["GET"]["/services/haproxy/api_keys"] = users.NewGetAPIKeys(o.context, o.UsersGetAPIKeysHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/runtime/maps"] = maps.NewGetAllRuntimeMapFiles(o.context, o.MapsGetAllRuntimeMapFilesHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/acls/{index}"] = acl.NewGetACL(o.context, o.ACLGetACLHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/backends/{name}"] = backend.NewGetBackend(o.context, o.BackendGetBackendHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/acls"] = acl.NewGetAcls(o.context, o.ACLGetAclsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/backend_switching_rules/{index}"] = backend_switching_rule.NewGetBackendSwitchingRule(o.context, o.BackendSwitchingRuleGetBackendSwitchingRuleHandler)
+	o.handlers["GET"]["/services/haproxy/runtime/maps"] = maps.NewGetAllRuntimeMapFiles(o.context, o.MapsGetAllRuntimeMapFilesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/backend_switching_rules"] = backend_switching_rule.NewGetBackendSwitchingRules(o.context, o.BackendSwitchingRuleGetBackendSwitchingRulesHandler)
+	o.handlers["GET"]["/services/haproxy/storage/dhparams"] = storage.NewGetAllStorageDHParams(o.context, o.StorageGetAllStorageDHParamsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/backends"] = backend.NewGetBackends(o.context, o.BackendGetBackendsHandler)
+	o.handlers["GET"]["/services/haproxy/storage/general"] = storage.NewGetAllStorageGeneralFiles(o.context, o.StorageGetAllStorageGeneralFilesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/binds/{name}"] = bind.NewGetBind(o.context, o.BindGetBindHandler)
+	o.handlers["GET"]["/services/haproxy/annotations/{type}/{name}"] = annotations.NewGetAnnotation(o.context, o.AnnotationsGetAnnotationHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/binds"] = bind.NewGetBinds(o.context, o.BindGetBindsHandler)
+	o.handlers["GET"]["/services/haproxy/annotations"] = annotations.NewGetAnnotations(o.context, o.AnnotationsGetAnnotationsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/cluster"] = discovery.NewGetCluster(o.context, o.DiscoveryGetClusterHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/backends/{name}"] = backend.NewGetBackend(o.context, o.BackendGetBackendHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration"] = discovery.NewGetConfigurationEndpoints(o.context, o.DiscoveryGetConfigurationEndpointsHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/backends/{name}/full"] = backend.NewGetBackendFull(o.context, o.BackendGetBackendFullHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/service_discovery/consul/{id}"] = service_discovery.NewGetConsul(o.context, o.ServiceDiscoveryGetConsulHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/backend_switching_rules/{index}"] = backend_switching_rule.NewGetBackendSwitchingRule(o.context, o.BackendSwitchingRuleGetBackendSwitchingRuleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/service_discovery/consul"] = service_discovery.NewGetConsuls(o.context, o.ServiceDiscoveryGetConsulsHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/backend_switching_rules"] = backend_switching_rule.NewGetBackendSwitchingRules(o.context, o.BackendSwitchingRuleGetBackendSwitchingRulesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/defaults"] = defaults.NewGetDefaults(o.context, o.DefaultsGetDefaultsHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/backends"] = backend.NewGetBackends(o.context, o.BackendGetBackendsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/filters/{index}"] = filter.NewGetFilter(o.context, o.FilterGetFilterHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/bandwidth_limits/{name}"] = bandwidth_limit.NewGetBandwidthLimit(o.context, o.BandwidthLimitGetBandwidthLimitHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/filters"] = filter.NewGetFilters(o.context, o.FilterGetFiltersHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/bandwidth_limits"] = bandwidth_limit.NewGetBandwidthLimits(o.context, o.BandwidthLimitGetBandwidthLimitsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/frontends/{name}"] = frontend.NewGetFrontend(o.context, o.FrontendGetFrontendHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/binds/{name}"] = bind.NewGetBind(o.context, o.BindGetBindHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/frontends"] = frontend.NewGetFrontends(o.context, o.FrontendGetFrontendsHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/binds/{name}/network"] = bind.NewGetBindNetwork(o.context, o.BindGetBindNetworkHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/global"] = global.NewGetGlobal(o.context, o.GlobalGetGlobalHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/binds/{name}/ssl"] = bind.NewGetBindSSL(o.context, o.BindGetBindSSLHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/raw"] = configuration.NewGetHAProxyConfiguration(o.context, o.ConfigurationGetHAProxyConfigurationHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/binds"] = bind.NewGetBinds(o.context, o.BindGetBindsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/http_request_rules/{index}"] = http_request_rule.NewGetHTTPRequestRule(o.context, o.HTTPRequestRuleGetHTTPRequestRuleHandler)
+	o.handlers["GET"]["/cluster/bootstrap_key_rotations"] = cluster.NewGetBootstrapKeyRotations(o.context, o.ClusterGetBootstrapKeyRotationsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/http_request_rules"] = http_request_rule.NewGetHTTPRequestRules(o.context, o.HTTPRequestRuleGetHTTPRequestRulesHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/global/certificate_update"] = global.NewGetCertificateUpdate(o.context, o.GlobalGetCertificateUpdateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/http_response_rules/{index}"] = http_response_rule.NewGetHTTPResponseRule(o.context, o.HTTPResponseRuleGetHTTPResponseRuleHandler)
+	o.handlers["GET"]["/cluster"] = discovery.NewGetCluster(o.context, o.DiscoveryGetClusterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/http_response_rules"] = http_response_rule.NewGetHTTPResponseRules(o.context, o.HTTPResponseRuleGetHTTPResponseRulesHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/compression"] = compression.NewGetCompression(o.context, o.CompressionGetCompressionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy"] = discovery.NewGetHaproxyEndpoints(o.context, o.DiscoveryGetHaproxyEndpointsHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/compatibility"] = configuration.NewGetConfigurationCompatibility(o.context, o.ConfigurationGetConfigurationCompatibilityHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/runtime/info"] = information.NewGetHaproxyProcessInfo(o.context, o.InformationGetHaproxyProcessInfoHandler)
+	o.handlers["GET"]["/services/haproxy/configuration"] = discovery.NewGetConfigurationEndpoints(o.context, o.DiscoveryGetConfigurationEndpointsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/info"] = information.NewGetInfo(o.context, o.InformationGetInfoHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/integrity"] = configuration.NewGetConfigurationIntegrity(o.context, o.ConfigurationGetConfigurationIntegrityHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/log_targets/{index}"] = log_target.NewGetLogTarget(o.context, o.LogTargetGetLogTargetHandler)
+	o.handlers["GET"]["/service_discovery/consul/{id}"] = service_discovery.NewGetConsul(o.context, o.ServiceDiscoveryGetConsulHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/log_targets"] = log_target.NewGetLogTargets(o.context, o.LogTargetGetLogTargetsHandler)
+	o.handlers["GET"]["/service_discovery/consul"] = service_discovery.NewGetConsuls(o.context, o.ServiceDiscoveryGetConsulsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/nameservers/{name}"] = nameserver.NewGetNameserver(o.context, o.NameserverGetNameserverHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/global/dh_param"] = global.NewGetDHParam(o.context, o.GlobalGetDHParamHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/nameservers"] = nameserver.NewGetNameservers(o.context, o.NameserverGetNameserversHandler)
+	o.handlers["GET"]["/services/haproxy/dataplane/configuration"] = information.NewGetDataplaneConfiguration(o.context, o.InformationGetDataplaneConfigurationHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/runtime/maps/{name}"] = maps.NewGetOneRuntimeMap(o.context, o.MapsGetOneRuntimeMapHandler)
+	o.handlers["GET"]["/stats/dataplane"] = stats.NewGetDataplaneStats(o.context, o.StatsGetDataplaneStatsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/specification_openapiv3"] = specification_openapiv3.NewGetOpenapiv3Specification(o.context, o.SpecificationOpenapiv3GetOpenapiv3SpecificationHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/defaults"] = defaults.NewGetDefaults(o.context, o.DefaultsGetDefaultsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/peer_entries"] = peer_entry.NewGetPeerEntries(o.context, o.PeerEntryGetPeerEntriesHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/global/device_detection"] = global.NewGetDeviceDetection(o.context, o.GlobalGetDeviceDetectionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/peer_entries/{name}"] = peer_entry.NewGetPeerEntry(o.context, o.PeerEntryGetPeerEntryHandler)
+	o.handlers["GET"]["/services/haproxy/runtime/drains/{id}"] = drain.NewGetDrain(o.context, o.DrainGetDrainHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/peer_section/{name}"] = peer.NewGetPeerSection(o.context, o.PeerGetPeerSectionHandler)
+	o.handlers["GET"]["/services/haproxy/runtime/drains"] = drain.NewGetDrains(o.context, o.DrainGetDrainsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/configuration/peer_section"] = peer.NewGetPeerSections(o.context, o.PeerGetPeerSectionsHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/dynamic_cookie_keys/{backend}"] = backend.NewGetDynamicCookieKey(o.context, o.BackendGetDynamicCookieKeyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/services/haproxy/reloads/{id}"] = reloads.NewGetReload(o.context, o.ReloadsGetReloadHandler)
+	o.handlers["GET"]["/services/haproxy/configuration/filters/{index}"] = filter.NewGetFilter(o.context, o.FilterGetFilterHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/filters"] = filter.NewGetFilters(o.context, o.FilterGetFiltersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/fleet"] = fleet.NewGetFleet(o.context, o.FleetGetFleetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/fleet/nodes/{name}/configuration"] = fleet.NewGetFleetNodeConfiguration(o.context, o.FleetGetFleetNodeConfigurationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/frontends/{name}"] = frontend.NewGetFrontend(o.context, o.FrontendGetFrontendHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/runtime/frontends/{name}/circuit_breaker"] = frontend.NewGetFrontendCircuitBreaker(o.context, o.FrontendGetFrontendCircuitBreakerHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/frontends/{name}/full"] = frontend.NewGetFrontendFull(o.context, o.FrontendGetFrontendFullHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/frontends"] = frontend.NewGetFrontends(o.context, o.FrontendGetFrontendsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/geoip"] = geo_ip.NewGetGeoIP(o.context, o.GeoIPGetGeoIPHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/geoip/policies"] = geo_ip.NewGetGeoIPPolicies(o.context, o.GeoIPGetGeoIPPoliciesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/geoip/policies/{frontend}"] = geo_ip.NewGetGeoIPPolicy(o.context, o.GeoIPGetGeoIPPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/git/conflicts"] = git.NewGetGitConflicts(o.context, o.GitGetGitConflictsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/git/known_hosts"] = git.NewGetGitKnownHosts(o.context, o.GitGetGitKnownHostsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/global"] = global.NewGetGlobal(o.context, o.GlobalGetGlobalHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/raw"] = configuration.NewGetHAProxyConfiguration(o.context, o.ConfigurationGetHAProxyConfigurationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/health"] = health.NewGetHAProxyHealth(o.context, o.HealthGetHAProxyHealthHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/http_request_rules/{index}"] = http_request_rule.NewGetHTTPRequestRule(o.context, o.HTTPRequestRuleGetHTTPRequestRuleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/http_request_rules"] = http_request_rule.NewGetHTTPRequestRules(o.context, o.HTTPRequestRuleGetHTTPRequestRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/http_response_rules/{index}"] = http_response_rule.NewGetHTTPResponseRule(o.context, o.HTTPResponseRuleGetHTTPResponseRuleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/http_response_rules"] = http_response_rule.NewGetHTTPResponseRules(o.context, o.HTTPResponseRuleGetHTTPResponseRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/https_redirects/{frontend}"] = frontend.NewGetHTTPSRedirect(o.context, o.FrontendGetHTTPSRedirectHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy"] = discovery.NewGetHaproxyEndpoints(o.context, o.DiscoveryGetHaproxyEndpointsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/runtime/info"] = information.NewGetHaproxyProcessInfo(o.context, o.InformationGetHaproxyProcessInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/runtime/processes"] = information.NewGetHaproxyProcesses(o.context, o.InformationGetHaproxyProcessesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/host_routes/{host}"] = host_routing.NewGetHostRoute(o.context, o.HostRoutingGetHostRouteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/host_routes"] = host_routing.NewGetHostRoutes(o.context, o.HostRoutingGetHostRoutesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/info"] = information.NewGetInfo(o.context, o.InformationGetInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/listens/{name}"] = listen.NewGetListen(o.context, o.ListenGetListenHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/listen_servers/{name}"] = listen.NewGetListenServer(o.context, o.ListenGetListenServerHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/listen_servers"] = listen.NewGetListenServers(o.context, o.ListenGetListenServersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/listens"] = listen.NewGetListens(o.context, o.ListenGetListensHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/log_formats/{name}"] = log_format.NewGetLogFormat(o.context, o.LogFormatGetLogFormatHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/log_formats"] = log_format.NewGetLogFormats(o.context, o.LogFormatGetLogFormatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/log_targets/{index}"] = log_target.NewGetLogTarget(o.context, o.LogTargetGetLogTargetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/log_targets"] = log_target.NewGetLogTargets(o.context, o.LogTargetGetLogTargetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/maintenance/{frontend}"] = maintenance.NewGetMaintenance(o.context, o.MaintenanceGetMaintenanceHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/maintenance"] = maintenance.NewGetMaintenances(o.context, o.MaintenanceGetMaintenancesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/nameservers/{name}"] = nameserver.NewGetNameserver(o.context, o.NameserverGetNameserverHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/nameservers"] = nameserver.NewGetNameservers(o.context, o.NameserverGetNameserversHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/runtime/maps/{name}"] = maps.NewGetOneRuntimeMap(o.context, o.MapsGetOneRuntimeMapHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/storage/general/{name}"] = storage.NewGetOneStorageGeneralFile(o.context, o.StorageGetOneStorageGeneralFileHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/specification_openapiv3"] = specification_openapiv3.NewGetOpenapiv3Specification(o.context, o.SpecificationOpenapiv3GetOpenapiv3SpecificationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/peer_entries"] = peer_entry.NewGetPeerEntries(o.context, o.PeerEntryGetPeerEntriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/peer_entries/{name}"] = peer_entry.NewGetPeerEntry(o.context, o.PeerEntryGetPeerEntryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/peer_section/{name}"] = peer.NewGetPeerSection(o.context, o.PeerGetPeerSectionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/peer_section"] = peer.NewGetPeerSections(o.context, o.PeerGetPeerSectionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/info/preflight"] = information.NewGetPreflight(o.context, o.InformationGetPreflightHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/info/privileges"] = information.NewGetPrivileges(o.context, o.InformationGetPrivilegesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/rate_limits/{name}"] = rate_limit.NewGetRateLimit(o.context, o.RateLimitGetRateLimitHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/rate_limits/{name}/offenders"] = rate_limit.NewGetRateLimitOffenders(o.context, o.RateLimitGetRateLimitOffendersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/rate_limits"] = rate_limit.NewGetRateLimits(o.context, o.RateLimitGetRateLimitsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/reloads/{id}"] = reloads.NewGetReload(o.context, o.ReloadsGetReloadHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/reload_freeze"] = reloads.NewGetReloadFreeze(o.context, o.ReloadsGetReloadFreezeHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1806,10 +3526,34 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/runtime/events/{ring}"] = traces.NewGetRingEvents(o.context, o.TracesGetRingEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/runtime/backends/{name}"] = backend.NewGetRuntimeBackend(o.context, o.BackendGetRuntimeBackendHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/runtime/backends"] = backend.NewGetRuntimeBackends(o.context, o.BackendGetRuntimeBackendsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/runtime"] = discovery.NewGetRuntimeEndpoints(o.context, o.DiscoveryGetRuntimeEndpointsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/runtime/frontends/{name}"] = frontend.NewGetRuntimeFrontend(o.context, o.FrontendGetRuntimeFrontendHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/runtime/frontends"] = frontend.NewGetRuntimeFrontends(o.context, o.FrontendGetRuntimeFrontendsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/runtime/logs"] = traces.NewGetRuntimeLogs(o.context, o.TracesGetRuntimeLogsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/runtime/maps_entries/{id}"] = maps.NewGetRuntimeMapEntry(o.context, o.MapsGetRuntimeMapEntryHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1822,10 +3566,22 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/runtime/ssl_certs"] = certificates.NewGetSSLCertificates(o.context, o.CertificatesGetSSLCertificatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/security_options"] = security_options.NewGetSecurityOptions(o.context, o.SecurityOptionsGetSecurityOptionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/configuration/servers/{name}"] = server.NewGetServer(o.context, o.ServerGetServerHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/configuration/servers/{name}/network"] = server.NewGetServerNetwork(o.context, o.ServerGetServerNetworkHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/configuration/server_switching_rules/{index}"] = server_switching_rule.NewGetServerSwitchingRule(o.context, o.ServerSwitchingRuleGetServerSwitchingRuleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1834,6 +3590,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/runtime/servers/{name}/warmup"] = server.NewGetServerWarmup(o.context, o.ServerGetServerWarmupHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/configuration/servers"] = server.NewGetServers(o.context, o.ServerGetServersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1850,10 +3610,26 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/snapshot"] = snapshot.NewGetSnapshot(o.context, o.SnapshotGetSnapshotHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/specification"] = specification.NewGetSpecification(o.context, o.SpecificationGetSpecificationHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/specification/schema/{resource}"] = specification.NewGetSpecificationSchema(o.context, o.SpecificationGetSpecificationSchemaHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/spoe_agents/{name}"] = spoe_agent.NewGetSpoeAgent(o.context, o.SpoeAgentGetSpoeAgentHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/spoe_agents"] = spoe_agent.NewGetSpoeAgents(o.context, o.SpoeAgentGetSpoeAgentsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/stats/native"] = stats.NewGetStats(o.context, o.StatsGetStatsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1862,6 +3638,14 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/configuration/stats_page"] = stats_page.NewGetStatsPage(o.context, o.StatsPageGetStatsPageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/stats_sockets"] = stats_page.NewGetStatsSockets(o.context, o.StatsPageGetStatsSocketsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/configuration/stick_rules/{index}"] = stick_rule.NewGetStickRule(o.context, o.StickRuleGetStickRuleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1882,6 +3666,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/storage/cleanup"] = storage.NewGetStorageCleanup(o.context, o.StorageGetStorageCleanupHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/configuration/tcp_request_rules/{index}"] = tcp_request_rule.NewGetTCPRequestRule(o.context, o.TCPRequestRuleGetTCPRequestRuleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1898,23 +3686,83 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/tls_profiles/{name}"] = tls_profile.NewGetTLSProfile(o.context, o.TLSProfileGetTLSProfileHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/tls_profile_deviations"] = tls_profile.NewGetTLSProfileDeviations(o.context, o.TLSProfileGetTLSProfileDeviationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/tls_profiles"] = tls_profile.NewGetTLSProfiles(o.context, o.TLSProfileGetTLSProfilesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/configuration/global/threading"] = global.NewGetThreading(o.context, o.GlobalGetThreadingHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/runtime/traces"] = traces.NewGetTraces(o.context, o.TracesGetTracesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/transactions/{id}"] = transactions.NewGetTransaction(o.context, o.TransactionsGetTransactionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/haproxy/transactions/{id}/impact"] = transactions.NewGetTransactionImpact(o.context, o.TransactionsGetTransactionImpactHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/haproxy/transactions/{id}/storage"] = transactions.NewGetTransactionStorage(o.context, o.TransactionsGetTransactionStorageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/haproxy/transactions"] = transactions.NewGetTransactions(o.context, o.TransactionsGetTransactionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/services/haproxy/git/webhook"] = git.NewGitWebhook(o.context, o.GitGitWebhookHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/configuration/import"] = configuration.NewImportConfiguration(o.context, o.ConfigurationImportConfigurationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/cluster/certificate"] = cluster.NewInitiateCertificateRefresh(o.context, o.ClusterInitiateCertificateRefreshHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/services/haproxy/configuration/migrate"] = configuration.NewMigrateConfiguration(o.context, o.ConfigurationMigrateConfigurationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/configuration/declarative/plan"] = configuration.NewPlanConfiguration(o.context, o.ConfigurationPlanConfigurationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/cluster"] = cluster.NewPostCluster(o.context, o.ClusterPostClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/services/haproxy/configuration/raw"] = configuration.NewPostHAProxyConfiguration(o.context, o.ConfigurationPostHAProxyConfigurationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/fleet/prepared"] = fleet.NewPrepareFleetConfiguration(o.context, o.FleetPrepareFleetConfigurationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/fleet/push"] = fleet.NewPushFleet(o.context, o.FleetPushFleetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/geoip/refresh"] = geo_ip.NewRefreshGeoIP(o.context, o.GeoIPRefreshGeoIPHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/api_keys/{id}"] = users.NewReplaceAPIKey(o.context, o.UsersReplaceAPIKeyHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1922,6 +3770,10 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/annotations/{type}/{name}"] = annotations.NewReplaceAnnotation(o.context, o.AnnotationsReplaceAnnotationHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/backends/{name}"] = backend.NewReplaceBackend(o.context, o.BackendReplaceBackendHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -1930,18 +3782,50 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/configuration/bandwidth_limits/{name}"] = bandwidth_limit.NewReplaceBandwidthLimit(o.context, o.BandwidthLimitReplaceBandwidthLimitHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/binds/{name}"] = bind.NewReplaceBind(o.context, o.BindReplaceBindHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/configuration/binds/{name}/network"] = bind.NewReplaceBindNetwork(o.context, o.BindReplaceBindNetworkHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/binds/{name}/ssl"] = bind.NewReplaceBindSSL(o.context, o.BindReplaceBindSSLHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/global/certificate_update"] = global.NewReplaceCertificateUpdate(o.context, o.GlobalReplaceCertificateUpdateHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/compression"] = compression.NewReplaceCompression(o.context, o.CompressionReplaceCompressionHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/service_discovery/consul/{id}"] = service_discovery.NewReplaceConsul(o.context, o.ServiceDiscoveryReplaceConsulHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/configuration/global/dh_param"] = global.NewReplaceDHParam(o.context, o.GlobalReplaceDHParamHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/defaults"] = defaults.NewReplaceDefaults(o.context, o.DefaultsReplaceDefaultsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/configuration/global/device_detection"] = global.NewReplaceDeviceDetection(o.context, o.GlobalReplaceDeviceDetectionHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/dynamic_cookie_keys/{backend}"] = backend.NewReplaceDynamicCookieKey(o.context, o.BackendReplaceDynamicCookieKeyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/filters/{index}"] = filter.NewReplaceFilter(o.context, o.FilterReplaceFilterHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -1950,6 +3834,18 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/runtime/frontends/{name}/circuit_breaker"] = frontend.NewReplaceFrontendCircuitBreaker(o.context, o.FrontendReplaceFrontendCircuitBreakerHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/geoip/policies/{frontend}"] = geo_ip.NewReplaceGeoIPPolicy(o.context, o.GeoIPReplaceGeoIPPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/git/known_hosts"] = git.NewReplaceGitKnownHosts(o.context, o.GitReplaceGitKnownHostsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/global"] = global.NewReplaceGlobal(o.context, o.GlobalReplaceGlobalHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -1962,10 +3858,30 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/configuration/https_redirects/{frontend}"] = frontend.NewReplaceHTTPSRedirect(o.context, o.FrontendReplaceHTTPSRedirectHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/listens/{name}"] = listen.NewReplaceListen(o.context, o.ListenReplaceListenHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/listen_servers/{name}"] = listen.NewReplaceListenServer(o.context, o.ListenReplaceListenServerHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/log_formats/{name}"] = log_format.NewReplaceLogFormat(o.context, o.LogFormatReplaceLogFormatHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/log_targets/{index}"] = log_target.NewReplaceLogTarget(o.context, o.LogTargetReplaceLogTargetHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/maintenance/{frontend}"] = maintenance.NewReplaceMaintenance(o.context, o.MaintenanceReplaceMaintenanceHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/nameservers/{name}"] = nameserver.NewReplaceNameserver(o.context, o.NameserverReplaceNameserverHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -1974,10 +3890,22 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/rate_limits/{name}"] = rate_limit.NewReplaceRateLimit(o.context, o.RateLimitReplaceRateLimitHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/resolvers/{name}"] = resolver.NewReplaceResolver(o.context, o.ResolverReplaceResolverHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/runtime/backends/{name}"] = backend.NewReplaceRuntimeBackend(o.context, o.BackendReplaceRuntimeBackendHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/runtime/frontends/{name}"] = frontend.NewReplaceRuntimeFrontend(o.context, o.FrontendReplaceRuntimeFrontendHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/runtime/maps_entries/{id}"] = maps.NewReplaceRuntimeMapEntry(o.context, o.MapsReplaceRuntimeMapEntryHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -1986,27 +3914,91 @@ func (o *DataPlaneAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/runtime/weights"] = server.NewReplaceRuntimeWeights(o.context, o.ServerReplaceRuntimeWeightsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/security_options"] = security_options.NewReplaceSecurityOptions(o.context, o.SecurityOptionsReplaceSecurityOptionsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/users/self/password"] = users.NewReplaceSelfPassword(o.context, o.UsersReplaceSelfPasswordHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/servers/{name}"] = server.NewReplaceServer(o.context, o.ServerReplaceServerHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/configuration/servers/{name}/network"] = server.NewReplaceServerNetwork(o.context, o.ServerReplaceServerNetworkHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/server_switching_rules/{index}"] = server_switching_rule.NewReplaceServerSwitchingRule(o.context, o.ServerSwitchingRuleReplaceServerSwitchingRuleHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/runtime/servers/{name}/warmup"] = server.NewReplaceServerWarmup(o.context, o.ServerReplaceServerWarmupHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/sites/{name}"] = sites.NewReplaceSite(o.context, o.SitesReplaceSiteHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/spoe_agents/{name}"] = spoe_agent.NewReplaceSpoeAgent(o.context, o.SpoeAgentReplaceSpoeAgentHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/stats_page"] = stats_page.NewReplaceStatsPage(o.context, o.StatsPageReplaceStatsPageHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/stats_sockets"] = stats_page.NewReplaceStatsSockets(o.context, o.StatsPageReplaceStatsSocketsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/stick_rules/{index}"] = stick_rule.NewReplaceStickRule(o.context, o.StickRuleReplaceStickRuleHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/services/haproxy/storage/general/{name}"] = storage.NewReplaceStorageGeneralFile(o.context, o.StorageReplaceStorageGeneralFileHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/services/haproxy/configuration/tcp_request_rules/{index}"] = tcp_request_rule.NewReplaceTCPRequestRule(o.context, o.TCPRequestRuleReplaceTCPRequestRuleHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/services/haproxy/configuration/tcp_response_rules/{index}"] = tcp_response_rule.NewReplaceTCPResponseRule(o.context, o.TCPResponseRuleReplaceTCPResponseRuleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/tls_profiles/{name}"] = tls_profile.NewReplaceTLSProfile(o.context, o.TLSProfileReplaceTLSProfileHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/configuration/global/threading"] = global.NewReplaceThreading(o.context, o.GlobalReplaceThreadingHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/runtime/traces/{source}"] = traces.NewReplaceTrace(o.context, o.TracesReplaceTraceHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/transactions/{id}/metadata"] = transactions.NewReplaceTransactionMetadata(o.context, o.TransactionsReplaceTransactionMetadataHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/git/conflicts/resolve"] = git.NewResolveGitConflicts(o.context, o.GitResolveGitConflictsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/snapshot/restore"] = snapshot.NewRestoreSnapshot(o.context, o.SnapshotRestoreSnapshotHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/haproxy/reloads/{id}"] = reloads.NewRetryReload(o.context, o.ReloadsRetryReloadHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -2015,6 +4007,26 @@ func (o *DataPlaneAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/services/haproxy/transactions"] = transactions.NewStartTransaction(o.context, o.TransactionsStartTransactionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/runtime/processes"] = information.NewStopOldWorkers(o.context, o.InformationStopOldWorkersHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/git/test"] = git.NewTestGitRemote(o.context, o.GitTestGitRemoteHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/haproxy/reload_freeze"] = reloads.NewUnfreezeReloads(o.context, o.ReloadsUnfreezeReloadsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/configuration/acl/validate"] = acl.NewValidateACL(o.context, o.ACLValidateACLHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/haproxy/log_format_validation"] = log_format.NewValidateLogFormat(o.context, o.LogFormatValidateLogFormatHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -23,7 +23,11 @@ package information
 import (
 	"net/http"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // GetInfoHandlerFunc turns a function with the right signature into a get info handler
@@ -48,7 +52,7 @@ func NewGetInfo(ctx *middleware.Context, handler GetInfoHandler) *GetInfo {
 
 Return API, hardware and OS information
 
-Return API, hardware and OS information
+Return API, HAProxy, hardware and OS information. Reports the build of the API, the HAProxy versions it supports, the features enabled and the configuration files in use. When system info is enabled, also reports system limits and warns when they conflict with configured maxconn values.
 
 */
 type GetInfo struct {
@@ -86,3 +90,610 @@ func (o *GetInfo) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// GetInfoOKBody get info o k body
+//
+// swagger:model GetInfoOKBody
+type GetInfoOKBody struct {
+
+	// API
+	API *GetInfoOKBodyAPI `json:"api,omitempty"`
+
+	// configuration
+	Configuration *GetInfoOKBodyConfiguration `json:"configuration,omitempty"`
+
+	// features
+	Features *GetInfoOKBodyFeatures `json:"features,omitempty"`
+
+	// haproxy
+	Haproxy *GetInfoOKBodyHaproxy `json:"haproxy,omitempty"`
+
+	// system
+	System *GetInfoOKBodySystem `json:"system,omitempty"`
+}
+
+// Validate validates this get info o k body
+func (o *GetInfoOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAPI(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateConfiguration(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFeatures(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateHaproxy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSystem(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetInfoOKBody) validateAPI(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.API) { // not required
+		return nil
+	}
+
+	if o.API != nil {
+		if err := o.API.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("getInfoOK" + "." + "api")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *GetInfoOKBody) validateConfiguration(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Configuration) { // not required
+		return nil
+	}
+
+	if o.Configuration != nil {
+		if err := o.Configuration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("getInfoOK" + "." + "configuration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *GetInfoOKBody) validateFeatures(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Features) { // not required
+		return nil
+	}
+
+	if o.Features != nil {
+		if err := o.Features.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("getInfoOK" + "." + "features")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *GetInfoOKBody) validateHaproxy(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Haproxy) { // not required
+		return nil
+	}
+
+	if o.Haproxy != nil {
+		if err := o.Haproxy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("getInfoOK" + "." + "haproxy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *GetInfoOKBody) validateSystem(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.System) { // not required
+		return nil
+	}
+
+	if o.System != nil {
+		if err := o.System.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("getInfoOK" + "." + "system")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetInfoOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetInfoOKBody) UnmarshalBinary(b []byte) error {
+	var res GetInfoOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetInfoOKBodyAPI get info o k body API
+//
+// swagger:model GetInfoOKBodyAPI
+type GetInfoOKBodyAPI struct {
+
+	// HAProxy Dataplane API build date
+	BuildDate strfmt.DateTime `json:"build_date,omitempty"`
+
+	// Git commit the API was built from
+	GitCommit string `json:"git_commit,omitempty"`
+
+	// Git repository the API was built from
+	GitRepo string `json:"git_repo,omitempty"`
+
+	// Git tag the API was built from
+	GitTag string `json:"git_tag,omitempty"`
+
+	// HAProxy Dataplane API version string
+	Version string `json:"version,omitempty"`
+}
+
+// Validate validates this get info o k body API
+func (o *GetInfoOKBodyAPI) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateBuildDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetInfoOKBodyAPI) validateBuildDate(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.BuildDate) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("api"+"."+"build_date", "body", "date-time", o.BuildDate.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetInfoOKBodyAPI) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetInfoOKBodyAPI) UnmarshalBinary(b []byte) error {
+	var res GetInfoOKBodyAPI
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetInfoOKBodyConfiguration get info o k body configuration
+//
+// swagger:model GetInfoOKBodyConfiguration
+type GetInfoOKBodyConfiguration struct {
+
+	// HAProxy configuration file
+	ConfigFile string `json:"config_file,omitempty"`
+
+	// Data Plane API configuration file
+	DataplaneConfigFile string `json:"dataplane_config_file,omitempty"`
+
+	// HAProxy binary
+	HaproxyBin string `json:"haproxy_bin,omitempty"`
+
+	// Directory holding map files
+	MapsDir string `json:"maps_dir,omitempty"`
+
+	// Directory holding transaction files
+	TransactionDir string `json:"transaction_dir,omitempty"`
+}
+
+// Validate validates this get info o k body configuration
+func (o *GetInfoOKBodyConfiguration) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetInfoOKBodyConfiguration) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetInfoOKBodyConfiguration) UnmarshalBinary(b []byte) error {
+	var res GetInfoOKBodyConfiguration
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetInfoOKBodyFeatures get info o k body features
+//
+// swagger:model GetInfoOKBodyFeatures
+type GetInfoOKBodyFeatures struct {
+
+	// Whether the API is managed by a cluster
+	ClusterMode bool `json:"cluster_mode"`
+
+	// Endpoint groups disabled in the features section of the dataplane configuration file, their endpoints return 403
+	Disabled []string `json:"disabled"`
+
+	// Service discovery providers available
+	DiscoveryProviders []string `json:"discovery_providers"`
+
+	// Whether the master runtime API socket is configured
+	MasterRuntime bool `json:"master_runtime"`
+
+	// Whether the runtime API is available
+	RuntimeAPI bool `json:"runtime_api"`
+
+	// Whether system information is reported
+	SystemInfo bool `json:"system_info"`
+}
+
+// Validate validates this get info o k body features
+func (o *GetInfoOKBodyFeatures) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetInfoOKBodyFeatures) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetInfoOKBodyFeatures) UnmarshalBinary(b []byte) error {
+	var res GetInfoOKBodyFeatures
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetInfoOKBodyHaproxy get info o k body haproxy
+//
+// swagger:model GetInfoOKBodyHaproxy
+type GetInfoOKBodyHaproxy struct {
+
+	// Newest HAProxy version whose keywords are known
+	MaxVersion string `json:"max_version,omitempty"`
+
+	// Oldest HAProxy version supported
+	MinVersion string `json:"min_version,omitempty"`
+
+	// Whether the HAProxy binary version is in the supported range
+	Supported bool `json:"supported"`
+
+	// Version of the HAProxy binary, empty when it could not be detected
+	Version string `json:"version,omitempty"`
+}
+
+// Validate validates this get info o k body haproxy
+func (o *GetInfoOKBodyHaproxy) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetInfoOKBodyHaproxy) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetInfoOKBodyHaproxy) UnmarshalBinary(b []byte) error {
+	var res GetInfoOKBodyHaproxy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetInfoOKBodySystem get info o k body system
+//
+// swagger:model GetInfoOKBodySystem
+type GetInfoOKBodySystem struct {
+
+	// CPU info
+	CPUInfo *GetInfoOKBodySystemCPUInfo `json:"cpu_info,omitempty"`
+
+	// Hostname where the HAProxy is running
+	Hostname string `json:"hostname,omitempty"`
+
+	// File descriptor limits and kernel parameters constraining HAProxy capacity
+	Limits *GetInfoOKBodySystemLimits `json:"limits,omitempty"`
+
+	// mem info
+	MemInfo *GetInfoOKBodySystemMemInfo `json:"mem_info,omitempty"`
+
+	// OS string
+	OsString string `json:"os_string,omitempty"`
+
+	// Current time in milliseconds since Epoch.
+	Time int64 `json:"time,omitempty"`
+
+	// System uptime
+	Uptime *int64 `json:"uptime,omitempty"`
+
+	// Conflicts between system limits and configured maxconn values
+	Warnings []string `json:"warnings"`
+}
+
+// Validate validates this get info o k body system
+func (o *GetInfoOKBodySystem) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateCPUInfo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLimits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMemInfo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetInfoOKBodySystem) validateCPUInfo(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.CPUInfo) { // not required
+		return nil
+	}
+
+	if o.CPUInfo != nil {
+		if err := o.CPUInfo.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("system" + "." + "cpu_info")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *GetInfoOKBodySystem) validateLimits(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Limits) { // not required
+		return nil
+	}
+
+	if o.Limits != nil {
+		if err := o.Limits.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("system" + "." + "limits")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *GetInfoOKBodySystem) validateMemInfo(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.MemInfo) { // not required
+		return nil
+	}
+
+	if o.MemInfo != nil {
+		if err := o.MemInfo.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("system" + "." + "mem_info")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetInfoOKBodySystem) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetInfoOKBodySystem) UnmarshalBinary(b []byte) error {
+	var res GetInfoOKBodySystem
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetInfoOKBodySystemCPUInfo get info o k body system CPU info
+//
+// swagger:model GetInfoOKBodySystemCPUInfo
+type GetInfoOKBodySystemCPUInfo struct {
+
+	// model
+	Model string `json:"model,omitempty"`
+
+	// Number of logical CPUs
+	NumCpus int64 `json:"num_cpus,omitempty"`
+}
+
+// Validate validates this get info o k body system CPU info
+func (o *GetInfoOKBodySystemCPUInfo) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetInfoOKBodySystemCPUInfo) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetInfoOKBodySystemCPUInfo) UnmarshalBinary(b []byte) error {
+	var res GetInfoOKBodySystemCPUInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetInfoOKBodySystemLimits File descriptor limits and kernel parameters constraining HAProxy capacity
+//
+// swagger:model GetInfoOKBodySystemLimits
+type GetInfoOKBodySystemLimits struct {
+
+	// net.netfilter.nf_conntrack_count kernel parameter, not set when connection tracking is not loaded
+	ConntrackCount *int64 `json:"conntrack_count,omitempty"`
+
+	// net.netfilter.nf_conntrack_max kernel parameter, not set when connection tracking is not loaded
+	ConntrackMax *int64 `json:"conntrack_max,omitempty"`
+
+	// Hard limit of open files of the API process
+	FdHardLimit int64 `json:"fd_hard_limit,omitempty"`
+
+	// Soft limit of open files of the API process
+	FdSoftLimit int64 `json:"fd_soft_limit,omitempty"`
+
+	// fs.file-max kernel parameter
+	FileMax *int64 `json:"file_max,omitempty"`
+
+	// fs.nr_open kernel parameter
+	NrOpen *int64 `json:"nr_open,omitempty"`
+
+	// net.core.somaxconn kernel parameter
+	Somaxconn *int64 `json:"somaxconn,omitempty"`
+}
+
+// Validate validates this get info o k body system limits
+func (o *GetInfoOKBodySystemLimits) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetInfoOKBodySystemLimits) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetInfoOKBodySystemLimits) UnmarshalBinary(b []byte) error {
+	var res GetInfoOKBodySystemLimits
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetInfoOKBodySystemMemInfo get info o k body system mem info
+//
+// swagger:model GetInfoOKBodySystemMemInfo
+type GetInfoOKBodySystemMemInfo struct {
+
+	// Memory available for new processes
+	AvailableMemory int64 `json:"available_memory,omitempty"`
+
+	// dataplaneapi memory
+	DataplaneapiMemory int64 `json:"dataplaneapi_memory,omitempty"`
+
+	// free memory
+	FreeMemory int64 `json:"free_memory,omitempty"`
+
+	// total memory
+	TotalMemory int64 `json:"total_memory,omitempty"`
+}
+
+// Validate validates this get info o k body system mem info
+func (o *GetInfoOKBodySystemMemInfo) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetInfoOKBodySystemMemInfo) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetInfoOKBodySystemMemInfo) UnmarshalBinary(b []byte) error {
+	var res GetInfoOKBodySystemMemInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -41,7 +41,7 @@ type GetInfoOK struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.Info `json:"body,omitempty"`
+	Payload *GetInfoOKBody `json:"body,omitempty"`
 }
 
 // NewGetInfoOK creates GetInfoOK with default headers values
@@ -51,13 +51,13 @@ func NewGetInfoOK() *GetInfoOK {
 }
 
 // WithPayload adds the payload to the get info o k response
-func (o *GetInfoOK) WithPayload(payload *models.Info) *GetInfoOK {
+func (o *GetInfoOK) WithPayload(payload *GetInfoOKBody) *GetInfoOK {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the get info o k response
-func (o *GetInfoOK) SetPayload(payload *models.Info) {
+func (o *GetInfoOK) SetPayload(payload *GetInfoOKBody) {
 	o.Payload = payload
 }
 
@@ -21,9 +21,14 @@ package log_target
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // CreateLogTargetHandlerFunc turns a function with the right signature into a create log target handler
@@ -48,7 +53,7 @@ func NewCreateLogTarget(ctx *middleware.Context, handler CreateLogTargetHandler)
 
 Add a new Log Target
 
-Adds a new Log Target of the specified type in the specified parent.
+Adds a new Log Target of the specified type in the specified parent, the global section, the defaults section, a frontend or a backend.
 
 */
 type CreateLogTarget struct {
@@ -86,3 +91,1470 @@ func (o *CreateLogTarget) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// CreateLogTargetAcceptedBody Log target of the global section, the defaults section, a frontend or a backend (corresponds to a log directive).
+//
+// swagger:model CreateLogTargetAcceptedBody
+type CreateLogTargetAcceptedBody struct {
+
+	// address
+	Address string `json:"address,omitempty"`
+
+	// facility
+	// Enum: [kern user mail daemon auth syslog lpr news uucp cron auth2 ftp ntp audit alert cron2 local0 local1 local2 local3 local4 local5 local6 local7]
+	Facility string `json:"facility,omitempty"`
+
+	// format
+	// Enum: [rfc3164 rfc5424 short raw]
+	Format string `json:"format,omitempty"`
+
+	// global
+	Global bool `json:"global,omitempty"`
+
+	// index
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// length
+	Length int64 `json:"length,omitempty"`
+
+	// level
+	// Enum: [emerg alert crit err warning notice info debug]
+	Level string `json:"level,omitempty"`
+
+	// minlevel
+	// Enum: [emerg alert crit err warning notice info debug]
+	Minlevel string `json:"minlevel,omitempty"`
+
+	// nolog
+	Nolog bool `json:"nolog,omitempty"`
+
+	// Send only the logs whose position in each series of size logs is in one of the ranges
+	Sample *CreateLogTargetAcceptedBodySample `json:"sample,omitempty"`
+}
+
+// Validate validates this create log target accepted body
+func (o *CreateLogTargetAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFacility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMinlevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSample(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateLogTargetAcceptedBody) validateAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Address) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("createLogTargetAccepted"+"."+"address", "body", string(o.Address), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetAcceptedBodyTypeFacilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["kern","user","mail","daemon","auth","syslog","lpr","news","uucp","cron","auth2","ftp","ntp","audit","alert","cron2","local0","local1","local2","local3","local4","local5","local6","local7"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetAcceptedBodyTypeFacilityPropEnum = append(createLogTargetAcceptedBodyTypeFacilityPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetAcceptedBodyFacilityKern captures enum value "kern"
+	CreateLogTargetAcceptedBodyFacilityKern string = "kern"
+
+	// CreateLogTargetAcceptedBodyFacilityUser captures enum value "user"
+	CreateLogTargetAcceptedBodyFacilityUser string = "user"
+
+	// CreateLogTargetAcceptedBodyFacilityMail captures enum value "mail"
+	CreateLogTargetAcceptedBodyFacilityMail string = "mail"
+
+	// CreateLogTargetAcceptedBodyFacilityDaemon captures enum value "daemon"
+	CreateLogTargetAcceptedBodyFacilityDaemon string = "daemon"
+
+	// CreateLogTargetAcceptedBodyFacilityAuth captures enum value "auth"
+	CreateLogTargetAcceptedBodyFacilityAuth string = "auth"
+
+	// CreateLogTargetAcceptedBodyFacilitySyslog captures enum value "syslog"
+	CreateLogTargetAcceptedBodyFacilitySyslog string = "syslog"
+
+	// CreateLogTargetAcceptedBodyFacilityLpr captures enum value "lpr"
+	CreateLogTargetAcceptedBodyFacilityLpr string = "lpr"
+
+	// CreateLogTargetAcceptedBodyFacilityNews captures enum value "news"
+	CreateLogTargetAcceptedBodyFacilityNews string = "news"
+
+	// CreateLogTargetAcceptedBodyFacilityUucp captures enum value "uucp"
+	CreateLogTargetAcceptedBodyFacilityUucp string = "uucp"
+
+	// CreateLogTargetAcceptedBodyFacilityCron captures enum value "cron"
+	CreateLogTargetAcceptedBodyFacilityCron string = "cron"
+
+	// CreateLogTargetAcceptedBodyFacilityAuth2 captures enum value "auth2"
+	CreateLogTargetAcceptedBodyFacilityAuth2 string = "auth2"
+
+	// CreateLogTargetAcceptedBodyFacilityFtp captures enum value "ftp"
+	CreateLogTargetAcceptedBodyFacilityFtp string = "ftp"
+
+	// CreateLogTargetAcceptedBodyFacilityNtp captures enum value "ntp"
+	CreateLogTargetAcceptedBodyFacilityNtp string = "ntp"
+
+	// CreateLogTargetAcceptedBodyFacilityAudit captures enum value "audit"
+	CreateLogTargetAcceptedBodyFacilityAudit string = "audit"
+
+	// CreateLogTargetAcceptedBodyFacilityAlert captures enum value "alert"
+	CreateLogTargetAcceptedBodyFacilityAlert string = "alert"
+
+	// CreateLogTargetAcceptedBodyFacilityCron2 captures enum value "cron2"
+	CreateLogTargetAcceptedBodyFacilityCron2 string = "cron2"
+
+	// CreateLogTargetAcceptedBodyFacilityLocal0 captures enum value "local0"
+	CreateLogTargetAcceptedBodyFacilityLocal0 string = "local0"
+
+	// CreateLogTargetAcceptedBodyFacilityLocal1 captures enum value "local1"
+	CreateLogTargetAcceptedBodyFacilityLocal1 string = "local1"
+
+	// CreateLogTargetAcceptedBodyFacilityLocal2 captures enum value "local2"
+	CreateLogTargetAcceptedBodyFacilityLocal2 string = "local2"
+
+	// CreateLogTargetAcceptedBodyFacilityLocal3 captures enum value "local3"
+	CreateLogTargetAcceptedBodyFacilityLocal3 string = "local3"
+
+	// CreateLogTargetAcceptedBodyFacilityLocal4 captures enum value "local4"
+	CreateLogTargetAcceptedBodyFacilityLocal4 string = "local4"
+
+	// CreateLogTargetAcceptedBodyFacilityLocal5 captures enum value "local5"
+	CreateLogTargetAcceptedBodyFacilityLocal5 string = "local5"
+
+	// CreateLogTargetAcceptedBodyFacilityLocal6 captures enum value "local6"
+	CreateLogTargetAcceptedBodyFacilityLocal6 string = "local6"
+
+	// CreateLogTargetAcceptedBodyFacilityLocal7 captures enum value "local7"
+	CreateLogTargetAcceptedBodyFacilityLocal7 string = "local7"
+)
+
+// prop value enum
+func (o *CreateLogTargetAcceptedBody) validateFacilityEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetAcceptedBodyTypeFacilityPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetAcceptedBody) validateFacility(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Facility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFacilityEnum("createLogTargetAccepted"+"."+"facility", "body", o.Facility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetAcceptedBodyTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rfc3164","rfc5424","short","raw"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetAcceptedBodyTypeFormatPropEnum = append(createLogTargetAcceptedBodyTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetAcceptedBodyFormatRfc3164 captures enum value "rfc3164"
+	CreateLogTargetAcceptedBodyFormatRfc3164 string = "rfc3164"
+
+	// CreateLogTargetAcceptedBodyFormatRfc5424 captures enum value "rfc5424"
+	CreateLogTargetAcceptedBodyFormatRfc5424 string = "rfc5424"
+
+	// CreateLogTargetAcceptedBodyFormatShort captures enum value "short"
+	CreateLogTargetAcceptedBodyFormatShort string = "short"
+
+	// CreateLogTargetAcceptedBodyFormatRaw captures enum value "raw"
+	CreateLogTargetAcceptedBodyFormatRaw string = "raw"
+)
+
+// prop value enum
+func (o *CreateLogTargetAcceptedBody) validateFormatEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetAcceptedBodyTypeFormatPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetAcceptedBody) validateFormat(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFormatEnum("createLogTargetAccepted"+"."+"format", "body", o.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateLogTargetAcceptedBody) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("createLogTargetAccepted"+"."+"index", "body", o.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetAcceptedBodyTypeLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetAcceptedBodyTypeLevelPropEnum = append(createLogTargetAcceptedBodyTypeLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetAcceptedBodyLevelEmerg captures enum value "emerg"
+	CreateLogTargetAcceptedBodyLevelEmerg string = "emerg"
+
+	// CreateLogTargetAcceptedBodyLevelAlert captures enum value "alert"
+	CreateLogTargetAcceptedBodyLevelAlert string = "alert"
+
+	// CreateLogTargetAcceptedBodyLevelCrit captures enum value "crit"
+	CreateLogTargetAcceptedBodyLevelCrit string = "crit"
+
+	// CreateLogTargetAcceptedBodyLevelErr captures enum value "err"
+	CreateLogTargetAcceptedBodyLevelErr string = "err"
+
+	// CreateLogTargetAcceptedBodyLevelWarning captures enum value "warning"
+	CreateLogTargetAcceptedBodyLevelWarning string = "warning"
+
+	// CreateLogTargetAcceptedBodyLevelNotice captures enum value "notice"
+	CreateLogTargetAcceptedBodyLevelNotice string = "notice"
+
+	// CreateLogTargetAcceptedBodyLevelInfo captures enum value "info"
+	CreateLogTargetAcceptedBodyLevelInfo string = "info"
+
+	// CreateLogTargetAcceptedBodyLevelDebug captures enum value "debug"
+	CreateLogTargetAcceptedBodyLevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *CreateLogTargetAcceptedBody) validateLevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetAcceptedBodyTypeLevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetAcceptedBody) validateLevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Level) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLevelEnum("createLogTargetAccepted"+"."+"level", "body", o.Level); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetAcceptedBodyTypeMinlevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetAcceptedBodyTypeMinlevelPropEnum = append(createLogTargetAcceptedBodyTypeMinlevelPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetAcceptedBodyMinlevelEmerg captures enum value "emerg"
+	CreateLogTargetAcceptedBodyMinlevelEmerg string = "emerg"
+
+	// CreateLogTargetAcceptedBodyMinlevelAlert captures enum value "alert"
+	CreateLogTargetAcceptedBodyMinlevelAlert string = "alert"
+
+	// CreateLogTargetAcceptedBodyMinlevelCrit captures enum value "crit"
+	CreateLogTargetAcceptedBodyMinlevelCrit string = "crit"
+
+	// CreateLogTargetAcceptedBodyMinlevelErr captures enum value "err"
+	CreateLogTargetAcceptedBodyMinlevelErr string = "err"
+
+	// CreateLogTargetAcceptedBodyMinlevelWarning captures enum value "warning"
+	CreateLogTargetAcceptedBodyMinlevelWarning string = "warning"
+
+	// CreateLogTargetAcceptedBodyMinlevelNotice captures enum value "notice"
+	CreateLogTargetAcceptedBodyMinlevelNotice string = "notice"
+
+	// CreateLogTargetAcceptedBodyMinlevelInfo captures enum value "info"
+	CreateLogTargetAcceptedBodyMinlevelInfo string = "info"
+
+	// CreateLogTargetAcceptedBodyMinlevelDebug captures enum value "debug"
+	CreateLogTargetAcceptedBodyMinlevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *CreateLogTargetAcceptedBody) validateMinlevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetAcceptedBodyTypeMinlevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetAcceptedBody) validateMinlevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Minlevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateMinlevelEnum("createLogTargetAccepted"+"."+"minlevel", "body", o.Minlevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateLogTargetAcceptedBody) validateSample(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Sample) { // not required
+		return nil
+	}
+
+	if o.Sample != nil {
+		if err := o.Sample.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("createLogTargetAccepted" + "." + "sample")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateLogTargetAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateLogTargetAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res CreateLogTargetAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// CreateLogTargetAcceptedBodySample Send only the logs whose position in each series of size logs is in one of the ranges
+//
+// swagger:model CreateLogTargetAcceptedBodySample
+type CreateLogTargetAcceptedBodySample struct {
+
+	// Positions, from 1, of the logs sent in each series, a single position or a range
+	// Required: true
+	Ranges []string `json:"ranges"`
+
+	// Number of logs in a series
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this create log target accepted body sample
+func (o *CreateLogTargetAcceptedBodySample) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateLogTargetAcceptedBodySample) validateRanges(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"ranges", "body", o.Ranges); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateLogTargetAcceptedBodySample) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"size", "body", o.Size); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("sample"+"."+"size", "body", int64(*o.Size), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateLogTargetAcceptedBodySample) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateLogTargetAcceptedBodySample) UnmarshalBinary(b []byte) error {
+	var res CreateLogTargetAcceptedBodySample
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// CreateLogTargetBody Log target of the global section, the defaults section, a frontend or a backend (corresponds to a log directive).
+//
+// swagger:model CreateLogTargetBody
+type CreateLogTargetBody struct {
+
+	// address
+	Address string `json:"address,omitempty"`
+
+	// facility
+	// Enum: [kern user mail daemon auth syslog lpr news uucp cron auth2 ftp ntp audit alert cron2 local0 local1 local2 local3 local4 local5 local6 local7]
+	Facility string `json:"facility,omitempty"`
+
+	// format
+	// Enum: [rfc3164 rfc5424 short raw]
+	Format string `json:"format,omitempty"`
+
+	// global
+	Global bool `json:"global,omitempty"`
+
+	// index
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// length
+	Length int64 `json:"length,omitempty"`
+
+	// level
+	// Enum: [emerg alert crit err warning notice info debug]
+	Level string `json:"level,omitempty"`
+
+	// minlevel
+	// Enum: [emerg alert crit err warning notice info debug]
+	Minlevel string `json:"minlevel,omitempty"`
+
+	// nolog
+	Nolog bool `json:"nolog,omitempty"`
+
+	// Send only the logs whose position in each series of size logs is in one of the ranges
+	Sample *CreateLogTargetBodySample `json:"sample,omitempty"`
+}
+
+// Validate validates this create log target body
+func (o *CreateLogTargetBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFacility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMinlevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSample(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateLogTargetBody) validateAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Address) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("data"+"."+"address", "body", string(o.Address), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetBodyTypeFacilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["kern","user","mail","daemon","auth","syslog","lpr","news","uucp","cron","auth2","ftp","ntp","audit","alert","cron2","local0","local1","local2","local3","local4","local5","local6","local7"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetBodyTypeFacilityPropEnum = append(createLogTargetBodyTypeFacilityPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetBodyFacilityKern captures enum value "kern"
+	CreateLogTargetBodyFacilityKern string = "kern"
+
+	// CreateLogTargetBodyFacilityUser captures enum value "user"
+	CreateLogTargetBodyFacilityUser string = "user"
+
+	// CreateLogTargetBodyFacilityMail captures enum value "mail"
+	CreateLogTargetBodyFacilityMail string = "mail"
+
+	// CreateLogTargetBodyFacilityDaemon captures enum value "daemon"
+	CreateLogTargetBodyFacilityDaemon string = "daemon"
+
+	// CreateLogTargetBodyFacilityAuth captures enum value "auth"
+	CreateLogTargetBodyFacilityAuth string = "auth"
+
+	// CreateLogTargetBodyFacilitySyslog captures enum value "syslog"
+	CreateLogTargetBodyFacilitySyslog string = "syslog"
+
+	// CreateLogTargetBodyFacilityLpr captures enum value "lpr"
+	CreateLogTargetBodyFacilityLpr string = "lpr"
+
+	// CreateLogTargetBodyFacilityNews captures enum value "news"
+	CreateLogTargetBodyFacilityNews string = "news"
+
+	// CreateLogTargetBodyFacilityUucp captures enum value "uucp"
+	CreateLogTargetBodyFacilityUucp string = "uucp"
+
+	// CreateLogTargetBodyFacilityCron captures enum value "cron"
+	CreateLogTargetBodyFacilityCron string = "cron"
+
+	// CreateLogTargetBodyFacilityAuth2 captures enum value "auth2"
+	CreateLogTargetBodyFacilityAuth2 string = "auth2"
+
+	// CreateLogTargetBodyFacilityFtp captures enum value "ftp"
+	CreateLogTargetBodyFacilityFtp string = "ftp"
+
+	// CreateLogTargetBodyFacilityNtp captures enum value "ntp"
+	CreateLogTargetBodyFacilityNtp string = "ntp"
+
+	// CreateLogTargetBodyFacilityAudit captures enum value "audit"
+	CreateLogTargetBodyFacilityAudit string = "audit"
+
+	// CreateLogTargetBodyFacilityAlert captures enum value "alert"
+	CreateLogTargetBodyFacilityAlert string = "alert"
+
+	// CreateLogTargetBodyFacilityCron2 captures enum value "cron2"
+	CreateLogTargetBodyFacilityCron2 string = "cron2"
+
+	// CreateLogTargetBodyFacilityLocal0 captures enum value "local0"
+	CreateLogTargetBodyFacilityLocal0 string = "local0"
+
+	// CreateLogTargetBodyFacilityLocal1 captures enum value "local1"
+	CreateLogTargetBodyFacilityLocal1 string = "local1"
+
+	// CreateLogTargetBodyFacilityLocal2 captures enum value "local2"
+	CreateLogTargetBodyFacilityLocal2 string = "local2"
+
+	// CreateLogTargetBodyFacilityLocal3 captures enum value "local3"
+	CreateLogTargetBodyFacilityLocal3 string = "local3"
+
+	// CreateLogTargetBodyFacilityLocal4 captures enum value "local4"
+	CreateLogTargetBodyFacilityLocal4 string = "local4"
+
+	// CreateLogTargetBodyFacilityLocal5 captures enum value "local5"
+	CreateLogTargetBodyFacilityLocal5 string = "local5"
+
+	// CreateLogTargetBodyFacilityLocal6 captures enum value "local6"
+	CreateLogTargetBodyFacilityLocal6 string = "local6"
+
+	// CreateLogTargetBodyFacilityLocal7 captures enum value "local7"
+	CreateLogTargetBodyFacilityLocal7 string = "local7"
+)
+
+// prop value enum
+func (o *CreateLogTargetBody) validateFacilityEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetBodyTypeFacilityPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetBody) validateFacility(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Facility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFacilityEnum("data"+"."+"facility", "body", o.Facility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetBodyTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rfc3164","rfc5424","short","raw"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetBodyTypeFormatPropEnum = append(createLogTargetBodyTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetBodyFormatRfc3164 captures enum value "rfc3164"
+	CreateLogTargetBodyFormatRfc3164 string = "rfc3164"
+
+	// CreateLogTargetBodyFormatRfc5424 captures enum value "rfc5424"
+	CreateLogTargetBodyFormatRfc5424 string = "rfc5424"
+
+	// CreateLogTargetBodyFormatShort captures enum value "short"
+	CreateLogTargetBodyFormatShort string = "short"
+
+	// CreateLogTargetBodyFormatRaw captures enum value "raw"
+	CreateLogTargetBodyFormatRaw string = "raw"
+)
+
+// prop value enum
+func (o *CreateLogTargetBody) validateFormatEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetBodyTypeFormatPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetBody) validateFormat(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFormatEnum("data"+"."+"format", "body", o.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateLogTargetBody) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("data"+"."+"index", "body", o.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetBodyTypeLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetBodyTypeLevelPropEnum = append(createLogTargetBodyTypeLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetBodyLevelEmerg captures enum value "emerg"
+	CreateLogTargetBodyLevelEmerg string = "emerg"
+
+	// CreateLogTargetBodyLevelAlert captures enum value "alert"
+	CreateLogTargetBodyLevelAlert string = "alert"
+
+	// CreateLogTargetBodyLevelCrit captures enum value "crit"
+	CreateLogTargetBodyLevelCrit string = "crit"
+
+	// CreateLogTargetBodyLevelErr captures enum value "err"
+	CreateLogTargetBodyLevelErr string = "err"
+
+	// CreateLogTargetBodyLevelWarning captures enum value "warning"
+	CreateLogTargetBodyLevelWarning string = "warning"
+
+	// CreateLogTargetBodyLevelNotice captures enum value "notice"
+	CreateLogTargetBodyLevelNotice string = "notice"
+
+	// CreateLogTargetBodyLevelInfo captures enum value "info"
+	CreateLogTargetBodyLevelInfo string = "info"
+
+	// CreateLogTargetBodyLevelDebug captures enum value "debug"
+	CreateLogTargetBodyLevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *CreateLogTargetBody) validateLevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetBodyTypeLevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetBody) validateLevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Level) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLevelEnum("data"+"."+"level", "body", o.Level); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetBodyTypeMinlevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetBodyTypeMinlevelPropEnum = append(createLogTargetBodyTypeMinlevelPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetBodyMinlevelEmerg captures enum value "emerg"
+	CreateLogTargetBodyMinlevelEmerg string = "emerg"
+
+	// CreateLogTargetBodyMinlevelAlert captures enum value "alert"
+	CreateLogTargetBodyMinlevelAlert string = "alert"
+
+	// CreateLogTargetBodyMinlevelCrit captures enum value "crit"
+	CreateLogTargetBodyMinlevelCrit string = "crit"
+
+	// CreateLogTargetBodyMinlevelErr captures enum value "err"
+	CreateLogTargetBodyMinlevelErr string = "err"
+
+	// CreateLogTargetBodyMinlevelWarning captures enum value "warning"
+	CreateLogTargetBodyMinlevelWarning string = "warning"
+
+	// CreateLogTargetBodyMinlevelNotice captures enum value "notice"
+	CreateLogTargetBodyMinlevelNotice string = "notice"
+
+	// CreateLogTargetBodyMinlevelInfo captures enum value "info"
+	CreateLogTargetBodyMinlevelInfo string = "info"
+
+	// CreateLogTargetBodyMinlevelDebug captures enum value "debug"
+	CreateLogTargetBodyMinlevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *CreateLogTargetBody) validateMinlevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetBodyTypeMinlevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetBody) validateMinlevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Minlevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateMinlevelEnum("data"+"."+"minlevel", "body", o.Minlevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateLogTargetBody) validateSample(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Sample) { // not required
+		return nil
+	}
+
+	if o.Sample != nil {
+		if err := o.Sample.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("data" + "." + "sample")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateLogTargetBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateLogTargetBody) UnmarshalBinary(b []byte) error {
+	var res CreateLogTargetBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// CreateLogTargetBodySample Send only the logs whose position in each series of size logs is in one of the ranges
+//
+// swagger:model CreateLogTargetBodySample
+type CreateLogTargetBodySample struct {
+
+	// Positions, from 1, of the logs sent in each series, a single position or a range
+	// Required: true
+	Ranges []string `json:"ranges"`
+
+	// Number of logs in a series
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this create log target body sample
+func (o *CreateLogTargetBodySample) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateLogTargetBodySample) validateRanges(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"ranges", "body", o.Ranges); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateLogTargetBodySample) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"size", "body", o.Size); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("sample"+"."+"size", "body", int64(*o.Size), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateLogTargetBodySample) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateLogTargetBodySample) UnmarshalBinary(b []byte) error {
+	var res CreateLogTargetBodySample
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// CreateLogTargetCreatedBody Log target of the global section, the defaults section, a frontend or a backend (corresponds to a log directive).
+//
+// swagger:model CreateLogTargetCreatedBody
+type CreateLogTargetCreatedBody struct {
+
+	// address
+	Address string `json:"address,omitempty"`
+
+	// facility
+	// Enum: [kern user mail daemon auth syslog lpr news uucp cron auth2 ftp ntp audit alert cron2 local0 local1 local2 local3 local4 local5 local6 local7]
+	Facility string `json:"facility,omitempty"`
+
+	// format
+	// Enum: [rfc3164 rfc5424 short raw]
+	Format string `json:"format,omitempty"`
+
+	// global
+	Global bool `json:"global,omitempty"`
+
+	// index
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// length
+	Length int64 `json:"length,omitempty"`
+
+	// level
+	// Enum: [emerg alert crit err warning notice info debug]
+	Level string `json:"level,omitempty"`
+
+	// minlevel
+	// Enum: [emerg alert crit err warning notice info debug]
+	Minlevel string `json:"minlevel,omitempty"`
+
+	// nolog
+	Nolog bool `json:"nolog,omitempty"`
+
+	// Send only the logs whose position in each series of size logs is in one of the ranges
+	Sample *CreateLogTargetCreatedBodySample `json:"sample,omitempty"`
+}
+
+// Validate validates this create log target created body
+func (o *CreateLogTargetCreatedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFacility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMinlevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSample(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateLogTargetCreatedBody) validateAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Address) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("createLogTargetCreated"+"."+"address", "body", string(o.Address), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetCreatedBodyTypeFacilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["kern","user","mail","daemon","auth","syslog","lpr","news","uucp","cron","auth2","ftp","ntp","audit","alert","cron2","local0","local1","local2","local3","local4","local5","local6","local7"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetCreatedBodyTypeFacilityPropEnum = append(createLogTargetCreatedBodyTypeFacilityPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetCreatedBodyFacilityKern captures enum value "kern"
+	CreateLogTargetCreatedBodyFacilityKern string = "kern"
+
+	// CreateLogTargetCreatedBodyFacilityUser captures enum value "user"
+	CreateLogTargetCreatedBodyFacilityUser string = "user"
+
+	// CreateLogTargetCreatedBodyFacilityMail captures enum value "mail"
+	CreateLogTargetCreatedBodyFacilityMail string = "mail"
+
+	// CreateLogTargetCreatedBodyFacilityDaemon captures enum value "daemon"
+	CreateLogTargetCreatedBodyFacilityDaemon string = "daemon"
+
+	// CreateLogTargetCreatedBodyFacilityAuth captures enum value "auth"
+	CreateLogTargetCreatedBodyFacilityAuth string = "auth"
+
+	// CreateLogTargetCreatedBodyFacilitySyslog captures enum value "syslog"
+	CreateLogTargetCreatedBodyFacilitySyslog string = "syslog"
+
+	// CreateLogTargetCreatedBodyFacilityLpr captures enum value "lpr"
+	CreateLogTargetCreatedBodyFacilityLpr string = "lpr"
+
+	// CreateLogTargetCreatedBodyFacilityNews captures enum value "news"
+	CreateLogTargetCreatedBodyFacilityNews string = "news"
+
+	// CreateLogTargetCreatedBodyFacilityUucp captures enum value "uucp"
+	CreateLogTargetCreatedBodyFacilityUucp string = "uucp"
+
+	// CreateLogTargetCreatedBodyFacilityCron captures enum value "cron"
+	CreateLogTargetCreatedBodyFacilityCron string = "cron"
+
+	// CreateLogTargetCreatedBodyFacilityAuth2 captures enum value "auth2"
+	CreateLogTargetCreatedBodyFacilityAuth2 string = "auth2"
+
+	// CreateLogTargetCreatedBodyFacilityFtp captures enum value "ftp"
+	CreateLogTargetCreatedBodyFacilityFtp string = "ftp"
+
+	// CreateLogTargetCreatedBodyFacilityNtp captures enum value "ntp"
+	CreateLogTargetCreatedBodyFacilityNtp string = "ntp"
+
+	// CreateLogTargetCreatedBodyFacilityAudit captures enum value "audit"
+	CreateLogTargetCreatedBodyFacilityAudit string = "audit"
+
+	// CreateLogTargetCreatedBodyFacilityAlert captures enum value "alert"
+	CreateLogTargetCreatedBodyFacilityAlert string = "alert"
+
+	// CreateLogTargetCreatedBodyFacilityCron2 captures enum value "cron2"
+	CreateLogTargetCreatedBodyFacilityCron2 string = "cron2"
+
+	// CreateLogTargetCreatedBodyFacilityLocal0 captures enum value "local0"
+	CreateLogTargetCreatedBodyFacilityLocal0 string = "local0"
+
+	// CreateLogTargetCreatedBodyFacilityLocal1 captures enum value "local1"
+	CreateLogTargetCreatedBodyFacilityLocal1 string = "local1"
+
+	// CreateLogTargetCreatedBodyFacilityLocal2 captures enum value "local2"
+	CreateLogTargetCreatedBodyFacilityLocal2 string = "local2"
+
+	// CreateLogTargetCreatedBodyFacilityLocal3 captures enum value "local3"
+	CreateLogTargetCreatedBodyFacilityLocal3 string = "local3"
+
+	// CreateLogTargetCreatedBodyFacilityLocal4 captures enum value "local4"
+	CreateLogTargetCreatedBodyFacilityLocal4 string = "local4"
+
+	// CreateLogTargetCreatedBodyFacilityLocal5 captures enum value "local5"
+	CreateLogTargetCreatedBodyFacilityLocal5 string = "local5"
+
+	// CreateLogTargetCreatedBodyFacilityLocal6 captures enum value "local6"
+	CreateLogTargetCreatedBodyFacilityLocal6 string = "local6"
+
+	// CreateLogTargetCreatedBodyFacilityLocal7 captures enum value "local7"
+	CreateLogTargetCreatedBodyFacilityLocal7 string = "local7"
+)
+
+// prop value enum
+func (o *CreateLogTargetCreatedBody) validateFacilityEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetCreatedBodyTypeFacilityPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetCreatedBody) validateFacility(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Facility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFacilityEnum("createLogTargetCreated"+"."+"facility", "body", o.Facility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetCreatedBodyTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rfc3164","rfc5424","short","raw"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetCreatedBodyTypeFormatPropEnum = append(createLogTargetCreatedBodyTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetCreatedBodyFormatRfc3164 captures enum value "rfc3164"
+	CreateLogTargetCreatedBodyFormatRfc3164 string = "rfc3164"
+
+	// CreateLogTargetCreatedBodyFormatRfc5424 captures enum value "rfc5424"
+	CreateLogTargetCreatedBodyFormatRfc5424 string = "rfc5424"
+
+	// CreateLogTargetCreatedBodyFormatShort captures enum value "short"
+	CreateLogTargetCreatedBodyFormatShort string = "short"
+
+	// CreateLogTargetCreatedBodyFormatRaw captures enum value "raw"
+	CreateLogTargetCreatedBodyFormatRaw string = "raw"
+)
+
+// prop value enum
+func (o *CreateLogTargetCreatedBody) validateFormatEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetCreatedBodyTypeFormatPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetCreatedBody) validateFormat(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFormatEnum("createLogTargetCreated"+"."+"format", "body", o.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateLogTargetCreatedBody) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("createLogTargetCreated"+"."+"index", "body", o.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetCreatedBodyTypeLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetCreatedBodyTypeLevelPropEnum = append(createLogTargetCreatedBodyTypeLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetCreatedBodyLevelEmerg captures enum value "emerg"
+	CreateLogTargetCreatedBodyLevelEmerg string = "emerg"
+
+	// CreateLogTargetCreatedBodyLevelAlert captures enum value "alert"
+	CreateLogTargetCreatedBodyLevelAlert string = "alert"
+
+	// CreateLogTargetCreatedBodyLevelCrit captures enum value "crit"
+	CreateLogTargetCreatedBodyLevelCrit string = "crit"
+
+	// CreateLogTargetCreatedBodyLevelErr captures enum value "err"
+	CreateLogTargetCreatedBodyLevelErr string = "err"
+
+	// CreateLogTargetCreatedBodyLevelWarning captures enum value "warning"
+	CreateLogTargetCreatedBodyLevelWarning string = "warning"
+
+	// CreateLogTargetCreatedBodyLevelNotice captures enum value "notice"
+	CreateLogTargetCreatedBodyLevelNotice string = "notice"
+
+	// CreateLogTargetCreatedBodyLevelInfo captures enum value "info"
+	CreateLogTargetCreatedBodyLevelInfo string = "info"
+
+	// CreateLogTargetCreatedBodyLevelDebug captures enum value "debug"
+	CreateLogTargetCreatedBodyLevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *CreateLogTargetCreatedBody) validateLevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetCreatedBodyTypeLevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetCreatedBody) validateLevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Level) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLevelEnum("createLogTargetCreated"+"."+"level", "body", o.Level); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createLogTargetCreatedBodyTypeMinlevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createLogTargetCreatedBodyTypeMinlevelPropEnum = append(createLogTargetCreatedBodyTypeMinlevelPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateLogTargetCreatedBodyMinlevelEmerg captures enum value "emerg"
+	CreateLogTargetCreatedBodyMinlevelEmerg string = "emerg"
+
+	// CreateLogTargetCreatedBodyMinlevelAlert captures enum value "alert"
+	CreateLogTargetCreatedBodyMinlevelAlert string = "alert"
+
+	// CreateLogTargetCreatedBodyMinlevelCrit captures enum value "crit"
+	CreateLogTargetCreatedBodyMinlevelCrit string = "crit"
+
+	// CreateLogTargetCreatedBodyMinlevelErr captures enum value "err"
+	CreateLogTargetCreatedBodyMinlevelErr string = "err"
+
+	// CreateLogTargetCreatedBodyMinlevelWarning captures enum value "warning"
+	CreateLogTargetCreatedBodyMinlevelWarning string = "warning"
+
+	// CreateLogTargetCreatedBodyMinlevelNotice captures enum value "notice"
+	CreateLogTargetCreatedBodyMinlevelNotice string = "notice"
+
+	// CreateLogTargetCreatedBodyMinlevelInfo captures enum value "info"
+	CreateLogTargetCreatedBodyMinlevelInfo string = "info"
+
+	// CreateLogTargetCreatedBodyMinlevelDebug captures enum value "debug"
+	CreateLogTargetCreatedBodyMinlevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *CreateLogTargetCreatedBody) validateMinlevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createLogTargetCreatedBodyTypeMinlevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateLogTargetCreatedBody) validateMinlevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Minlevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateMinlevelEnum("createLogTargetCreated"+"."+"minlevel", "body", o.Minlevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateLogTargetCreatedBody) validateSample(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Sample) { // not required
+		return nil
+	}
+
+	if o.Sample != nil {
+		if err := o.Sample.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("createLogTargetCreated" + "." + "sample")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateLogTargetCreatedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateLogTargetCreatedBody) UnmarshalBinary(b []byte) error {
+	var res CreateLogTargetCreatedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// CreateLogTargetCreatedBodySample Send only the logs whose position in each series of size logs is in one of the ranges
+//
+// swagger:model CreateLogTargetCreatedBodySample
+type CreateLogTargetCreatedBodySample struct {
+
+	// Positions, from 1, of the logs sent in each series, a single position or a range
+	// Required: true
+	Ranges []string `json:"ranges"`
+
+	// Number of logs in a series
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this create log target created body sample
+func (o *CreateLogTargetCreatedBodySample) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateLogTargetCreatedBodySample) validateRanges(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"ranges", "body", o.Ranges); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateLogTargetCreatedBodySample) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"size", "body", o.Size); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("sample"+"."+"size", "body", int64(*o.Size), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateLogTargetCreatedBodySample) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateLogTargetCreatedBodySample) UnmarshalBinary(b []byte) error {
+	var res CreateLogTargetCreatedBodySample
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
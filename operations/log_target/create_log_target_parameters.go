@@ -30,8 +30,6 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
-
-	"github.com/haproxytech/models/v2"
 )
 
 // NewCreateLogTargetParams creates a new CreateLogTargetParams object
@@ -62,17 +60,16 @@ type CreateLogTargetParams struct {
 	  Required: true
 	  In: body
 	*/
-	Data *models.LogTarget
+	Data CreateLogTargetBody
 	/*If set, do a force reload, do not wait for the configured reload-delay. Cannot be used when transaction is specified, as changes in transaction are not applied directly to configuration.
 	  In: query
 	  Default: false
 	*/
 	ForceReload *bool
-	/*Parent name
-	  Required: true
+	/*Parent name, not used for global and defaults
 	  In: query
 	*/
-	ParentName string
+	ParentName *string
 	/*Parent type
 	  Required: true
 	  In: query
@@ -101,7 +98,7 @@ func (o *CreateLogTargetParams) BindRequest(r *http.Request, route *middleware.M
 
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
-		var body models.LogTarget
+		var body CreateLogTargetBody
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
 			if err == io.EOF {
 				res = append(res, errors.Required("data", "body"))
@@ -115,7 +112,7 @@ func (o *CreateLogTargetParams) BindRequest(r *http.Request, route *middleware.M
 			}
 
 			if len(res) == 0 {
-				o.Data = &body
+				o.Data = body
 			}
 		}
 	} else {
@@ -177,21 +174,18 @@ func (o *CreateLogTargetParams) bindForceReload(rawData []string, hasKey bool, f
 
 // bindParentName binds and validates parameter ParentName from query.
 func (o *CreateLogTargetParams) bindParentName(rawData []string, hasKey bool, formats strfmt.Registry) error {
-	if !hasKey {
-		return errors.Required("parent_name", "query")
-	}
 	var raw string
 	if len(rawData) > 0 {
 		raw = rawData[len(rawData)-1]
 	}
 
-	// Required: true
+	// Required: false
 	// AllowEmptyValue: false
-	if err := validate.RequiredString("parent_name", "query", raw); err != nil {
-		return err
+	if raw == "" { // empty values pass all other validations
+		return nil
 	}
 
-	o.ParentName = raw
+	o.ParentName = &raw
 
 	return nil
 }
@@ -224,7 +218,7 @@ func (o *CreateLogTargetParams) bindParentType(rawData []string, hasKey bool, fo
 // validateParentType carries on validations for parameter ParentType
 func (o *CreateLogTargetParams) validateParentType(formats strfmt.Registry) error {
 
-	if err := validate.Enum("parent_type", "query", o.ParentType, []interface{}{"frontend", "backend"}); err != nil {
+	if err := validate.Enum("parent_type", "query", o.ParentType, []interface{}{"global", "defaults", "frontend", "backend"}); err != nil {
 		return err
 	}
 
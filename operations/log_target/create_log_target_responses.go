@@ -41,7 +41,7 @@ type CreateLogTargetCreated struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.LogTarget `json:"body,omitempty"`
+	Payload *CreateLogTargetCreatedBody `json:"body,omitempty"`
 }
 
 // NewCreateLogTargetCreated creates CreateLogTargetCreated with default headers values
@@ -51,13 +51,13 @@ func NewCreateLogTargetCreated() *CreateLogTargetCreated {
 }
 
 // WithPayload adds the payload to the create log target created response
-func (o *CreateLogTargetCreated) WithPayload(payload *models.LogTarget) *CreateLogTargetCreated {
+func (o *CreateLogTargetCreated) WithPayload(payload *CreateLogTargetCreatedBody) *CreateLogTargetCreated {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the create log target created response
-func (o *CreateLogTargetCreated) SetPayload(payload *models.LogTarget) {
+func (o *CreateLogTargetCreated) SetPayload(payload *CreateLogTargetCreatedBody) {
 	o.Payload = payload
 }
 
@@ -89,7 +89,7 @@ type CreateLogTargetAccepted struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.LogTarget `json:"body,omitempty"`
+	Payload *CreateLogTargetAcceptedBody `json:"body,omitempty"`
 }
 
 // NewCreateLogTargetAccepted creates CreateLogTargetAccepted with default headers values
@@ -110,13 +110,13 @@ func (o *CreateLogTargetAccepted) SetReloadID(reloadID string) {
 }
 
 // WithPayload adds the payload to the create log target accepted response
-func (o *CreateLogTargetAccepted) WithPayload(payload *models.LogTarget) *CreateLogTargetAccepted {
+func (o *CreateLogTargetAccepted) WithPayload(payload *CreateLogTargetAcceptedBody) *CreateLogTargetAccepted {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the create log target accepted response
-func (o *CreateLogTargetAccepted) SetPayload(payload *models.LogTarget) {
+func (o *CreateLogTargetAccepted) SetPayload(payload *CreateLogTargetAcceptedBody) {
 	o.Payload = payload
 }
 
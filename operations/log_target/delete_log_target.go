@@ -48,7 +48,7 @@ func NewDeleteLogTarget(ctx *middleware.Context, handler DeleteLogTargetHandler)
 
 Delete a Log Target
 
-Deletes a Log Target configuration by it's index from the specified parent.
+Deletes a Log Target configuration by it's index from the specified parent, the global section, the defaults section, a frontend or a backend.
 
 */
 type DeleteLogTarget struct {
@@ -21,14 +21,14 @@ package log_target
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
-
-	"github.com/haproxytech/models/v2"
+	"github.com/go-openapi/validate"
 )
 
 // GetLogTargetHandlerFunc turns a function with the right signature into a get log target handler
@@ -53,7 +53,7 @@ func NewGetLogTarget(ctx *middleware.Context, handler GetLogTargetHandler) *GetL
 
 Return one Log Target
 
-Returns one Log Target configuration by it's index in the specified parent.
+Returns one Log Target configuration by it's index in the specified parent, the global section, the defaults section, a frontend or a backend.
 
 */
 type GetLogTarget struct {
@@ -100,8 +100,8 @@ type GetLogTargetOKBody struct {
 	// version
 	Version int64 `json:"_version,omitempty"`
 
-	// data
-	Data *models.LogTarget `json:"data,omitempty"`
+	// Log target of the global section, the defaults section, a frontend or a backend (corresponds to a log directive).
+	Data *GetLogTargetOKBodyData `json:"data,omitempty"`
 }
 
 // Validate validates this get log target o k body
@@ -153,3 +153,492 @@ func (o *GetLogTargetOKBody) UnmarshalBinary(b []byte) error {
 	*o = res
 	return nil
 }
+
+// GetLogTargetOKBodyData Log target of the global section, the defaults section, a frontend or a backend (corresponds to a log directive).
+//
+// swagger:model GetLogTargetOKBodyData
+type GetLogTargetOKBodyData struct {
+
+	// address
+	Address string `json:"address,omitempty"`
+
+	// facility
+	// Enum: [kern user mail daemon auth syslog lpr news uucp cron auth2 ftp ntp audit alert cron2 local0 local1 local2 local3 local4 local5 local6 local7]
+	Facility string `json:"facility,omitempty"`
+
+	// format
+	// Enum: [rfc3164 rfc5424 short raw]
+	Format string `json:"format,omitempty"`
+
+	// global
+	Global bool `json:"global,omitempty"`
+
+	// index
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// length
+	Length int64 `json:"length,omitempty"`
+
+	// level
+	// Enum: [emerg alert crit err warning notice info debug]
+	Level string `json:"level,omitempty"`
+
+	// minlevel
+	// Enum: [emerg alert crit err warning notice info debug]
+	Minlevel string `json:"minlevel,omitempty"`
+
+	// nolog
+	Nolog bool `json:"nolog,omitempty"`
+
+	// Send only the logs whose position in each series of size logs is in one of the ranges
+	Sample *GetLogTargetOKBodyDataSample `json:"sample,omitempty"`
+}
+
+// Validate validates this get log target o k body data
+func (o *GetLogTargetOKBodyData) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFacility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMinlevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSample(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetLogTargetOKBodyData) validateAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Address) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("data"+"."+"address", "body", string(o.Address), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getLogTargetOKBodyDataTypeFacilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["kern","user","mail","daemon","auth","syslog","lpr","news","uucp","cron","auth2","ftp","ntp","audit","alert","cron2","local0","local1","local2","local3","local4","local5","local6","local7"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getLogTargetOKBodyDataTypeFacilityPropEnum = append(getLogTargetOKBodyDataTypeFacilityPropEnum, v)
+	}
+}
+
+const (
+
+	// GetLogTargetOKBodyDataFacilityKern captures enum value "kern"
+	GetLogTargetOKBodyDataFacilityKern string = "kern"
+
+	// GetLogTargetOKBodyDataFacilityUser captures enum value "user"
+	GetLogTargetOKBodyDataFacilityUser string = "user"
+
+	// GetLogTargetOKBodyDataFacilityMail captures enum value "mail"
+	GetLogTargetOKBodyDataFacilityMail string = "mail"
+
+	// GetLogTargetOKBodyDataFacilityDaemon captures enum value "daemon"
+	GetLogTargetOKBodyDataFacilityDaemon string = "daemon"
+
+	// GetLogTargetOKBodyDataFacilityAuth captures enum value "auth"
+	GetLogTargetOKBodyDataFacilityAuth string = "auth"
+
+	// GetLogTargetOKBodyDataFacilitySyslog captures enum value "syslog"
+	GetLogTargetOKBodyDataFacilitySyslog string = "syslog"
+
+	// GetLogTargetOKBodyDataFacilityLpr captures enum value "lpr"
+	GetLogTargetOKBodyDataFacilityLpr string = "lpr"
+
+	// GetLogTargetOKBodyDataFacilityNews captures enum value "news"
+	GetLogTargetOKBodyDataFacilityNews string = "news"
+
+	// GetLogTargetOKBodyDataFacilityUucp captures enum value "uucp"
+	GetLogTargetOKBodyDataFacilityUucp string = "uucp"
+
+	// GetLogTargetOKBodyDataFacilityCron captures enum value "cron"
+	GetLogTargetOKBodyDataFacilityCron string = "cron"
+
+	// GetLogTargetOKBodyDataFacilityAuth2 captures enum value "auth2"
+	GetLogTargetOKBodyDataFacilityAuth2 string = "auth2"
+
+	// GetLogTargetOKBodyDataFacilityFtp captures enum value "ftp"
+	GetLogTargetOKBodyDataFacilityFtp string = "ftp"
+
+	// GetLogTargetOKBodyDataFacilityNtp captures enum value "ntp"
+	GetLogTargetOKBodyDataFacilityNtp string = "ntp"
+
+	// GetLogTargetOKBodyDataFacilityAudit captures enum value "audit"
+	GetLogTargetOKBodyDataFacilityAudit string = "audit"
+
+	// GetLogTargetOKBodyDataFacilityAlert captures enum value "alert"
+	GetLogTargetOKBodyDataFacilityAlert string = "alert"
+
+	// GetLogTargetOKBodyDataFacilityCron2 captures enum value "cron2"
+	GetLogTargetOKBodyDataFacilityCron2 string = "cron2"
+
+	// GetLogTargetOKBodyDataFacilityLocal0 captures enum value "local0"
+	GetLogTargetOKBodyDataFacilityLocal0 string = "local0"
+
+	// GetLogTargetOKBodyDataFacilityLocal1 captures enum value "local1"
+	GetLogTargetOKBodyDataFacilityLocal1 string = "local1"
+
+	// GetLogTargetOKBodyDataFacilityLocal2 captures enum value "local2"
+	GetLogTargetOKBodyDataFacilityLocal2 string = "local2"
+
+	// GetLogTargetOKBodyDataFacilityLocal3 captures enum value "local3"
+	GetLogTargetOKBodyDataFacilityLocal3 string = "local3"
+
+	// GetLogTargetOKBodyDataFacilityLocal4 captures enum value "local4"
+	GetLogTargetOKBodyDataFacilityLocal4 string = "local4"
+
+	// GetLogTargetOKBodyDataFacilityLocal5 captures enum value "local5"
+	GetLogTargetOKBodyDataFacilityLocal5 string = "local5"
+
+	// GetLogTargetOKBodyDataFacilityLocal6 captures enum value "local6"
+	GetLogTargetOKBodyDataFacilityLocal6 string = "local6"
+
+	// GetLogTargetOKBodyDataFacilityLocal7 captures enum value "local7"
+	GetLogTargetOKBodyDataFacilityLocal7 string = "local7"
+)
+
+// prop value enum
+func (o *GetLogTargetOKBodyData) validateFacilityEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getLogTargetOKBodyDataTypeFacilityPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetLogTargetOKBodyData) validateFacility(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Facility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFacilityEnum("data"+"."+"facility", "body", o.Facility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getLogTargetOKBodyDataTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rfc3164","rfc5424","short","raw"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getLogTargetOKBodyDataTypeFormatPropEnum = append(getLogTargetOKBodyDataTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// GetLogTargetOKBodyDataFormatRfc3164 captures enum value "rfc3164"
+	GetLogTargetOKBodyDataFormatRfc3164 string = "rfc3164"
+
+	// GetLogTargetOKBodyDataFormatRfc5424 captures enum value "rfc5424"
+	GetLogTargetOKBodyDataFormatRfc5424 string = "rfc5424"
+
+	// GetLogTargetOKBodyDataFormatShort captures enum value "short"
+	GetLogTargetOKBodyDataFormatShort string = "short"
+
+	// GetLogTargetOKBodyDataFormatRaw captures enum value "raw"
+	GetLogTargetOKBodyDataFormatRaw string = "raw"
+)
+
+// prop value enum
+func (o *GetLogTargetOKBodyData) validateFormatEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getLogTargetOKBodyDataTypeFormatPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetLogTargetOKBodyData) validateFormat(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFormatEnum("data"+"."+"format", "body", o.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetLogTargetOKBodyData) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("data"+"."+"index", "body", o.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getLogTargetOKBodyDataTypeLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getLogTargetOKBodyDataTypeLevelPropEnum = append(getLogTargetOKBodyDataTypeLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// GetLogTargetOKBodyDataLevelEmerg captures enum value "emerg"
+	GetLogTargetOKBodyDataLevelEmerg string = "emerg"
+
+	// GetLogTargetOKBodyDataLevelAlert captures enum value "alert"
+	GetLogTargetOKBodyDataLevelAlert string = "alert"
+
+	// GetLogTargetOKBodyDataLevelCrit captures enum value "crit"
+	GetLogTargetOKBodyDataLevelCrit string = "crit"
+
+	// GetLogTargetOKBodyDataLevelErr captures enum value "err"
+	GetLogTargetOKBodyDataLevelErr string = "err"
+
+	// GetLogTargetOKBodyDataLevelWarning captures enum value "warning"
+	GetLogTargetOKBodyDataLevelWarning string = "warning"
+
+	// GetLogTargetOKBodyDataLevelNotice captures enum value "notice"
+	GetLogTargetOKBodyDataLevelNotice string = "notice"
+
+	// GetLogTargetOKBodyDataLevelInfo captures enum value "info"
+	GetLogTargetOKBodyDataLevelInfo string = "info"
+
+	// GetLogTargetOKBodyDataLevelDebug captures enum value "debug"
+	GetLogTargetOKBodyDataLevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *GetLogTargetOKBodyData) validateLevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getLogTargetOKBodyDataTypeLevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetLogTargetOKBodyData) validateLevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Level) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLevelEnum("data"+"."+"level", "body", o.Level); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getLogTargetOKBodyDataTypeMinlevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getLogTargetOKBodyDataTypeMinlevelPropEnum = append(getLogTargetOKBodyDataTypeMinlevelPropEnum, v)
+	}
+}
+
+const (
+
+	// GetLogTargetOKBodyDataMinlevelEmerg captures enum value "emerg"
+	GetLogTargetOKBodyDataMinlevelEmerg string = "emerg"
+
+	// GetLogTargetOKBodyDataMinlevelAlert captures enum value "alert"
+	GetLogTargetOKBodyDataMinlevelAlert string = "alert"
+
+	// GetLogTargetOKBodyDataMinlevelCrit captures enum value "crit"
+	GetLogTargetOKBodyDataMinlevelCrit string = "crit"
+
+	// GetLogTargetOKBodyDataMinlevelErr captures enum value "err"
+	GetLogTargetOKBodyDataMinlevelErr string = "err"
+
+	// GetLogTargetOKBodyDataMinlevelWarning captures enum value "warning"
+	GetLogTargetOKBodyDataMinlevelWarning string = "warning"
+
+	// GetLogTargetOKBodyDataMinlevelNotice captures enum value "notice"
+	GetLogTargetOKBodyDataMinlevelNotice string = "notice"
+
+	// GetLogTargetOKBodyDataMinlevelInfo captures enum value "info"
+	GetLogTargetOKBodyDataMinlevelInfo string = "info"
+
+	// GetLogTargetOKBodyDataMinlevelDebug captures enum value "debug"
+	GetLogTargetOKBodyDataMinlevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *GetLogTargetOKBodyData) validateMinlevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getLogTargetOKBodyDataTypeMinlevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetLogTargetOKBodyData) validateMinlevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Minlevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateMinlevelEnum("data"+"."+"minlevel", "body", o.Minlevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetLogTargetOKBodyData) validateSample(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Sample) { // not required
+		return nil
+	}
+
+	if o.Sample != nil {
+		if err := o.Sample.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("data" + "." + "sample")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetLogTargetOKBodyData) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetLogTargetOKBodyData) UnmarshalBinary(b []byte) error {
+	var res GetLogTargetOKBodyData
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetLogTargetOKBodyDataSample Send only the logs whose position in each series of size logs is in one of the ranges
+//
+// swagger:model GetLogTargetOKBodyDataSample
+type GetLogTargetOKBodyDataSample struct {
+
+	// Positions, from 1, of the logs sent in each series, a single position or a range
+	// Required: true
+	Ranges []string `json:"ranges"`
+
+	// Number of logs in a series
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this get log target o k body data sample
+func (o *GetLogTargetOKBodyDataSample) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetLogTargetOKBodyDataSample) validateRanges(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"ranges", "body", o.Ranges); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetLogTargetOKBodyDataSample) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"size", "body", o.Size); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("sample"+"."+"size", "body", int64(*o.Size), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetLogTargetOKBodyDataSample) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetLogTargetOKBodyDataSample) UnmarshalBinary(b []byte) error {
+	var res GetLogTargetOKBodyDataSample
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
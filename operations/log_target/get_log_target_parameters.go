@@ -52,11 +52,10 @@ type GetLogTargetParams struct {
 	  In: path
 	*/
 	Index int64
-	/*Parent name
-	  Required: true
+	/*Parent name, not used for global and defaults
 	  In: query
 	*/
-	ParentName string
+	ParentName *string
 	/*Parent type
 	  Required: true
 	  In: query
@@ -126,21 +125,18 @@ func (o *GetLogTargetParams) bindIndex(rawData []string, hasKey bool, formats st
 
 // bindParentName binds and validates parameter ParentName from query.
 func (o *GetLogTargetParams) bindParentName(rawData []string, hasKey bool, formats strfmt.Registry) error {
-	if !hasKey {
-		return errors.Required("parent_name", "query")
-	}
 	var raw string
 	if len(rawData) > 0 {
 		raw = rawData[len(rawData)-1]
 	}
 
-	// Required: true
+	// Required: false
 	// AllowEmptyValue: false
-	if err := validate.RequiredString("parent_name", "query", raw); err != nil {
-		return err
+	if raw == "" { // empty values pass all other validations
+		return nil
 	}
 
-	o.ParentName = raw
+	o.ParentName = &raw
 
 	return nil
 }
@@ -173,7 +169,7 @@ func (o *GetLogTargetParams) bindParentType(rawData []string, hasKey bool, forma
 // validateParentType carries on validations for parameter ParentType
 func (o *GetLogTargetParams) validateParentType(formats strfmt.Registry) error {
 
-	if err := validate.Enum("parent_type", "query", o.ParentType, []interface{}{"frontend", "backend"}); err != nil {
+	if err := validate.Enum("parent_type", "query", o.ParentType, []interface{}{"global", "defaults", "frontend", "backend"}); err != nil {
 		return err
 	}
 
@@ -21,15 +21,15 @@ package log_target
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
-
-	"github.com/haproxytech/models/v2"
 )
 
 // GetLogTargetsHandlerFunc turns a function with the right signature into a get log targets handler
@@ -54,7 +54,7 @@ func NewGetLogTargets(ctx *middleware.Context, handler GetLogTargetsHandler) *Ge
 
 Return an array of all Log Targets
 
-Returns all Log Targets that are configured in specified parent.
+Returns all Log Targets that are configured in specified parent, the global section, the defaults section, a frontend or a backend.
 
 */
 type GetLogTargets struct {
@@ -103,7 +103,7 @@ type GetLogTargetsOKBody struct {
 
 	// data
 	// Required: true
-	Data models.LogTargets `json:"data"`
+	Data []*GetLogTargetsOKBodyDataItems0 `json:"data"`
 }
 
 // Validate validates this get log targets o k body
@@ -126,11 +126,20 @@ func (o *GetLogTargetsOKBody) validateData(formats strfmt.Registry) error {
 		return err
 	}
 
-	if err := o.Data.Validate(formats); err != nil {
-		if ve, ok := err.(*errors.Validation); ok {
-			return ve.ValidateName("getLogTargetsOK" + "." + "data")
+	for i := 0; i < len(o.Data); i++ {
+		if swag.IsZero(o.Data[i]) { // not required
+			continue
 		}
-		return err
+
+		if o.Data[i] != nil {
+			if err := o.Data[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getLogTargetsOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
 	}
 
 	return nil
@@ -153,3 +162,492 @@ func (o *GetLogTargetsOKBody) UnmarshalBinary(b []byte) error {
 	*o = res
 	return nil
 }
+
+// GetLogTargetsOKBodyDataItems0 Log target of the global section, the defaults section, a frontend or a backend (corresponds to a log directive).
+//
+// swagger:model GetLogTargetsOKBodyDataItems0
+type GetLogTargetsOKBodyDataItems0 struct {
+
+	// address
+	Address string `json:"address,omitempty"`
+
+	// facility
+	// Enum: [kern user mail daemon auth syslog lpr news uucp cron auth2 ftp ntp audit alert cron2 local0 local1 local2 local3 local4 local5 local6 local7]
+	Facility string `json:"facility,omitempty"`
+
+	// format
+	// Enum: [rfc3164 rfc5424 short raw]
+	Format string `json:"format,omitempty"`
+
+	// global
+	Global bool `json:"global,omitempty"`
+
+	// index
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// length
+	Length int64 `json:"length,omitempty"`
+
+	// level
+	// Enum: [emerg alert crit err warning notice info debug]
+	Level string `json:"level,omitempty"`
+
+	// minlevel
+	// Enum: [emerg alert crit err warning notice info debug]
+	Minlevel string `json:"minlevel,omitempty"`
+
+	// nolog
+	Nolog bool `json:"nolog,omitempty"`
+
+	// Send only the logs whose position in each series of size logs is in one of the ranges
+	Sample *GetLogTargetsOKBodyDataItems0Sample `json:"sample,omitempty"`
+}
+
+// Validate validates this get log targets o k body data items0
+func (o *GetLogTargetsOKBodyDataItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFacility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMinlevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSample(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetLogTargetsOKBodyDataItems0) validateAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Address) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("address", "body", string(o.Address), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getLogTargetsOKBodyDataItems0TypeFacilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["kern","user","mail","daemon","auth","syslog","lpr","news","uucp","cron","auth2","ftp","ntp","audit","alert","cron2","local0","local1","local2","local3","local4","local5","local6","local7"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getLogTargetsOKBodyDataItems0TypeFacilityPropEnum = append(getLogTargetsOKBodyDataItems0TypeFacilityPropEnum, v)
+	}
+}
+
+const (
+
+	// GetLogTargetsOKBodyDataItems0FacilityKern captures enum value "kern"
+	GetLogTargetsOKBodyDataItems0FacilityKern string = "kern"
+
+	// GetLogTargetsOKBodyDataItems0FacilityUser captures enum value "user"
+	GetLogTargetsOKBodyDataItems0FacilityUser string = "user"
+
+	// GetLogTargetsOKBodyDataItems0FacilityMail captures enum value "mail"
+	GetLogTargetsOKBodyDataItems0FacilityMail string = "mail"
+
+	// GetLogTargetsOKBodyDataItems0FacilityDaemon captures enum value "daemon"
+	GetLogTargetsOKBodyDataItems0FacilityDaemon string = "daemon"
+
+	// GetLogTargetsOKBodyDataItems0FacilityAuth captures enum value "auth"
+	GetLogTargetsOKBodyDataItems0FacilityAuth string = "auth"
+
+	// GetLogTargetsOKBodyDataItems0FacilitySyslog captures enum value "syslog"
+	GetLogTargetsOKBodyDataItems0FacilitySyslog string = "syslog"
+
+	// GetLogTargetsOKBodyDataItems0FacilityLpr captures enum value "lpr"
+	GetLogTargetsOKBodyDataItems0FacilityLpr string = "lpr"
+
+	// GetLogTargetsOKBodyDataItems0FacilityNews captures enum value "news"
+	GetLogTargetsOKBodyDataItems0FacilityNews string = "news"
+
+	// GetLogTargetsOKBodyDataItems0FacilityUucp captures enum value "uucp"
+	GetLogTargetsOKBodyDataItems0FacilityUucp string = "uucp"
+
+	// GetLogTargetsOKBodyDataItems0FacilityCron captures enum value "cron"
+	GetLogTargetsOKBodyDataItems0FacilityCron string = "cron"
+
+	// GetLogTargetsOKBodyDataItems0FacilityAuth2 captures enum value "auth2"
+	GetLogTargetsOKBodyDataItems0FacilityAuth2 string = "auth2"
+
+	// GetLogTargetsOKBodyDataItems0FacilityFtp captures enum value "ftp"
+	GetLogTargetsOKBodyDataItems0FacilityFtp string = "ftp"
+
+	// GetLogTargetsOKBodyDataItems0FacilityNtp captures enum value "ntp"
+	GetLogTargetsOKBodyDataItems0FacilityNtp string = "ntp"
+
+	// GetLogTargetsOKBodyDataItems0FacilityAudit captures enum value "audit"
+	GetLogTargetsOKBodyDataItems0FacilityAudit string = "audit"
+
+	// GetLogTargetsOKBodyDataItems0FacilityAlert captures enum value "alert"
+	GetLogTargetsOKBodyDataItems0FacilityAlert string = "alert"
+
+	// GetLogTargetsOKBodyDataItems0FacilityCron2 captures enum value "cron2"
+	GetLogTargetsOKBodyDataItems0FacilityCron2 string = "cron2"
+
+	// GetLogTargetsOKBodyDataItems0FacilityLocal0 captures enum value "local0"
+	GetLogTargetsOKBodyDataItems0FacilityLocal0 string = "local0"
+
+	// GetLogTargetsOKBodyDataItems0FacilityLocal1 captures enum value "local1"
+	GetLogTargetsOKBodyDataItems0FacilityLocal1 string = "local1"
+
+	// GetLogTargetsOKBodyDataItems0FacilityLocal2 captures enum value "local2"
+	GetLogTargetsOKBodyDataItems0FacilityLocal2 string = "local2"
+
+	// GetLogTargetsOKBodyDataItems0FacilityLocal3 captures enum value "local3"
+	GetLogTargetsOKBodyDataItems0FacilityLocal3 string = "local3"
+
+	// GetLogTargetsOKBodyDataItems0FacilityLocal4 captures enum value "local4"
+	GetLogTargetsOKBodyDataItems0FacilityLocal4 string = "local4"
+
+	// GetLogTargetsOKBodyDataItems0FacilityLocal5 captures enum value "local5"
+	GetLogTargetsOKBodyDataItems0FacilityLocal5 string = "local5"
+
+	// GetLogTargetsOKBodyDataItems0FacilityLocal6 captures enum value "local6"
+	GetLogTargetsOKBodyDataItems0FacilityLocal6 string = "local6"
+
+	// GetLogTargetsOKBodyDataItems0FacilityLocal7 captures enum value "local7"
+	GetLogTargetsOKBodyDataItems0FacilityLocal7 string = "local7"
+)
+
+// prop value enum
+func (o *GetLogTargetsOKBodyDataItems0) validateFacilityEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getLogTargetsOKBodyDataItems0TypeFacilityPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetLogTargetsOKBodyDataItems0) validateFacility(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Facility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFacilityEnum("facility", "body", o.Facility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getLogTargetsOKBodyDataItems0TypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rfc3164","rfc5424","short","raw"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getLogTargetsOKBodyDataItems0TypeFormatPropEnum = append(getLogTargetsOKBodyDataItems0TypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// GetLogTargetsOKBodyDataItems0FormatRfc3164 captures enum value "rfc3164"
+	GetLogTargetsOKBodyDataItems0FormatRfc3164 string = "rfc3164"
+
+	// GetLogTargetsOKBodyDataItems0FormatRfc5424 captures enum value "rfc5424"
+	GetLogTargetsOKBodyDataItems0FormatRfc5424 string = "rfc5424"
+
+	// GetLogTargetsOKBodyDataItems0FormatShort captures enum value "short"
+	GetLogTargetsOKBodyDataItems0FormatShort string = "short"
+
+	// GetLogTargetsOKBodyDataItems0FormatRaw captures enum value "raw"
+	GetLogTargetsOKBodyDataItems0FormatRaw string = "raw"
+)
+
+// prop value enum
+func (o *GetLogTargetsOKBodyDataItems0) validateFormatEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getLogTargetsOKBodyDataItems0TypeFormatPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetLogTargetsOKBodyDataItems0) validateFormat(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFormatEnum("format", "body", o.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetLogTargetsOKBodyDataItems0) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("index", "body", o.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getLogTargetsOKBodyDataItems0TypeLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getLogTargetsOKBodyDataItems0TypeLevelPropEnum = append(getLogTargetsOKBodyDataItems0TypeLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// GetLogTargetsOKBodyDataItems0LevelEmerg captures enum value "emerg"
+	GetLogTargetsOKBodyDataItems0LevelEmerg string = "emerg"
+
+	// GetLogTargetsOKBodyDataItems0LevelAlert captures enum value "alert"
+	GetLogTargetsOKBodyDataItems0LevelAlert string = "alert"
+
+	// GetLogTargetsOKBodyDataItems0LevelCrit captures enum value "crit"
+	GetLogTargetsOKBodyDataItems0LevelCrit string = "crit"
+
+	// GetLogTargetsOKBodyDataItems0LevelErr captures enum value "err"
+	GetLogTargetsOKBodyDataItems0LevelErr string = "err"
+
+	// GetLogTargetsOKBodyDataItems0LevelWarning captures enum value "warning"
+	GetLogTargetsOKBodyDataItems0LevelWarning string = "warning"
+
+	// GetLogTargetsOKBodyDataItems0LevelNotice captures enum value "notice"
+	GetLogTargetsOKBodyDataItems0LevelNotice string = "notice"
+
+	// GetLogTargetsOKBodyDataItems0LevelInfo captures enum value "info"
+	GetLogTargetsOKBodyDataItems0LevelInfo string = "info"
+
+	// GetLogTargetsOKBodyDataItems0LevelDebug captures enum value "debug"
+	GetLogTargetsOKBodyDataItems0LevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *GetLogTargetsOKBodyDataItems0) validateLevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getLogTargetsOKBodyDataItems0TypeLevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetLogTargetsOKBodyDataItems0) validateLevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Level) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLevelEnum("level", "body", o.Level); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getLogTargetsOKBodyDataItems0TypeMinlevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getLogTargetsOKBodyDataItems0TypeMinlevelPropEnum = append(getLogTargetsOKBodyDataItems0TypeMinlevelPropEnum, v)
+	}
+}
+
+const (
+
+	// GetLogTargetsOKBodyDataItems0MinlevelEmerg captures enum value "emerg"
+	GetLogTargetsOKBodyDataItems0MinlevelEmerg string = "emerg"
+
+	// GetLogTargetsOKBodyDataItems0MinlevelAlert captures enum value "alert"
+	GetLogTargetsOKBodyDataItems0MinlevelAlert string = "alert"
+
+	// GetLogTargetsOKBodyDataItems0MinlevelCrit captures enum value "crit"
+	GetLogTargetsOKBodyDataItems0MinlevelCrit string = "crit"
+
+	// GetLogTargetsOKBodyDataItems0MinlevelErr captures enum value "err"
+	GetLogTargetsOKBodyDataItems0MinlevelErr string = "err"
+
+	// GetLogTargetsOKBodyDataItems0MinlevelWarning captures enum value "warning"
+	GetLogTargetsOKBodyDataItems0MinlevelWarning string = "warning"
+
+	// GetLogTargetsOKBodyDataItems0MinlevelNotice captures enum value "notice"
+	GetLogTargetsOKBodyDataItems0MinlevelNotice string = "notice"
+
+	// GetLogTargetsOKBodyDataItems0MinlevelInfo captures enum value "info"
+	GetLogTargetsOKBodyDataItems0MinlevelInfo string = "info"
+
+	// GetLogTargetsOKBodyDataItems0MinlevelDebug captures enum value "debug"
+	GetLogTargetsOKBodyDataItems0MinlevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *GetLogTargetsOKBodyDataItems0) validateMinlevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getLogTargetsOKBodyDataItems0TypeMinlevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetLogTargetsOKBodyDataItems0) validateMinlevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Minlevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateMinlevelEnum("minlevel", "body", o.Minlevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetLogTargetsOKBodyDataItems0) validateSample(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Sample) { // not required
+		return nil
+	}
+
+	if o.Sample != nil {
+		if err := o.Sample.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sample")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetLogTargetsOKBodyDataItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetLogTargetsOKBodyDataItems0) UnmarshalBinary(b []byte) error {
+	var res GetLogTargetsOKBodyDataItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetLogTargetsOKBodyDataItems0Sample Send only the logs whose position in each series of size logs is in one of the ranges
+//
+// swagger:model GetLogTargetsOKBodyDataItems0Sample
+type GetLogTargetsOKBodyDataItems0Sample struct {
+
+	// Positions, from 1, of the logs sent in each series, a single position or a range
+	// Required: true
+	Ranges []string `json:"ranges"`
+
+	// Number of logs in a series
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this get log targets o k body data items0 sample
+func (o *GetLogTargetsOKBodyDataItems0Sample) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetLogTargetsOKBodyDataItems0Sample) validateRanges(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"ranges", "body", o.Ranges); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetLogTargetsOKBodyDataItems0Sample) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"size", "body", o.Size); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("sample"+"."+"size", "body", int64(*o.Size), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetLogTargetsOKBodyDataItems0Sample) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetLogTargetsOKBodyDataItems0Sample) UnmarshalBinary(b []byte) error {
+	var res GetLogTargetsOKBodyDataItems0Sample
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
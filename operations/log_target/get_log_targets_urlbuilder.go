@@ -28,7 +28,7 @@ import (
 
 // GetLogTargetsURL generates an URL for the get log targets operation
 type GetLogTargetsURL struct {
-	ParentName    string
+	ParentName    *string
 	ParentType    string
 	TransactionID *string
 
@@ -66,7 +66,10 @@ func (o *GetLogTargetsURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
-	parentNameQ := o.ParentName
+	var parentNameQ string
+	if o.ParentName != nil {
+		parentNameQ = *o.ParentName
+	}
 	if parentNameQ != "" {
 		qs.Set("parent_name", parentNameQ)
 	}
@@ -21,9 +21,14 @@ package log_target
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // ReplaceLogTargetHandlerFunc turns a function with the right signature into a replace log target handler
@@ -48,7 +53,7 @@ func NewReplaceLogTarget(ctx *middleware.Context, handler ReplaceLogTargetHandle
 
 Replace a Log Target
 
-Replaces a Log Target configuration by it's index in the specified parent.
+Replaces a Log Target configuration by it's index in the specified parent, the global section, the defaults section, a frontend or a backend.
 
 */
 type ReplaceLogTarget struct {
@@ -86,3 +91,1470 @@ func (o *ReplaceLogTarget) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// ReplaceLogTargetAcceptedBody Log target of the global section, the defaults section, a frontend or a backend (corresponds to a log directive).
+//
+// swagger:model ReplaceLogTargetAcceptedBody
+type ReplaceLogTargetAcceptedBody struct {
+
+	// address
+	Address string `json:"address,omitempty"`
+
+	// facility
+	// Enum: [kern user mail daemon auth syslog lpr news uucp cron auth2 ftp ntp audit alert cron2 local0 local1 local2 local3 local4 local5 local6 local7]
+	Facility string `json:"facility,omitempty"`
+
+	// format
+	// Enum: [rfc3164 rfc5424 short raw]
+	Format string `json:"format,omitempty"`
+
+	// global
+	Global bool `json:"global,omitempty"`
+
+	// index
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// length
+	Length int64 `json:"length,omitempty"`
+
+	// level
+	// Enum: [emerg alert crit err warning notice info debug]
+	Level string `json:"level,omitempty"`
+
+	// minlevel
+	// Enum: [emerg alert crit err warning notice info debug]
+	Minlevel string `json:"minlevel,omitempty"`
+
+	// nolog
+	Nolog bool `json:"nolog,omitempty"`
+
+	// Send only the logs whose position in each series of size logs is in one of the ranges
+	Sample *ReplaceLogTargetAcceptedBodySample `json:"sample,omitempty"`
+}
+
+// Validate validates this replace log target accepted body
+func (o *ReplaceLogTargetAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFacility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMinlevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSample(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetAcceptedBody) validateAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Address) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("replaceLogTargetAccepted"+"."+"address", "body", string(o.Address), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetAcceptedBodyTypeFacilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["kern","user","mail","daemon","auth","syslog","lpr","news","uucp","cron","auth2","ftp","ntp","audit","alert","cron2","local0","local1","local2","local3","local4","local5","local6","local7"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetAcceptedBodyTypeFacilityPropEnum = append(replaceLogTargetAcceptedBodyTypeFacilityPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetAcceptedBodyFacilityKern captures enum value "kern"
+	ReplaceLogTargetAcceptedBodyFacilityKern string = "kern"
+
+	// ReplaceLogTargetAcceptedBodyFacilityUser captures enum value "user"
+	ReplaceLogTargetAcceptedBodyFacilityUser string = "user"
+
+	// ReplaceLogTargetAcceptedBodyFacilityMail captures enum value "mail"
+	ReplaceLogTargetAcceptedBodyFacilityMail string = "mail"
+
+	// ReplaceLogTargetAcceptedBodyFacilityDaemon captures enum value "daemon"
+	ReplaceLogTargetAcceptedBodyFacilityDaemon string = "daemon"
+
+	// ReplaceLogTargetAcceptedBodyFacilityAuth captures enum value "auth"
+	ReplaceLogTargetAcceptedBodyFacilityAuth string = "auth"
+
+	// ReplaceLogTargetAcceptedBodyFacilitySyslog captures enum value "syslog"
+	ReplaceLogTargetAcceptedBodyFacilitySyslog string = "syslog"
+
+	// ReplaceLogTargetAcceptedBodyFacilityLpr captures enum value "lpr"
+	ReplaceLogTargetAcceptedBodyFacilityLpr string = "lpr"
+
+	// ReplaceLogTargetAcceptedBodyFacilityNews captures enum value "news"
+	ReplaceLogTargetAcceptedBodyFacilityNews string = "news"
+
+	// ReplaceLogTargetAcceptedBodyFacilityUucp captures enum value "uucp"
+	ReplaceLogTargetAcceptedBodyFacilityUucp string = "uucp"
+
+	// ReplaceLogTargetAcceptedBodyFacilityCron captures enum value "cron"
+	ReplaceLogTargetAcceptedBodyFacilityCron string = "cron"
+
+	// ReplaceLogTargetAcceptedBodyFacilityAuth2 captures enum value "auth2"
+	ReplaceLogTargetAcceptedBodyFacilityAuth2 string = "auth2"
+
+	// ReplaceLogTargetAcceptedBodyFacilityFtp captures enum value "ftp"
+	ReplaceLogTargetAcceptedBodyFacilityFtp string = "ftp"
+
+	// ReplaceLogTargetAcceptedBodyFacilityNtp captures enum value "ntp"
+	ReplaceLogTargetAcceptedBodyFacilityNtp string = "ntp"
+
+	// ReplaceLogTargetAcceptedBodyFacilityAudit captures enum value "audit"
+	ReplaceLogTargetAcceptedBodyFacilityAudit string = "audit"
+
+	// ReplaceLogTargetAcceptedBodyFacilityAlert captures enum value "alert"
+	ReplaceLogTargetAcceptedBodyFacilityAlert string = "alert"
+
+	// ReplaceLogTargetAcceptedBodyFacilityCron2 captures enum value "cron2"
+	ReplaceLogTargetAcceptedBodyFacilityCron2 string = "cron2"
+
+	// ReplaceLogTargetAcceptedBodyFacilityLocal0 captures enum value "local0"
+	ReplaceLogTargetAcceptedBodyFacilityLocal0 string = "local0"
+
+	// ReplaceLogTargetAcceptedBodyFacilityLocal1 captures enum value "local1"
+	ReplaceLogTargetAcceptedBodyFacilityLocal1 string = "local1"
+
+	// ReplaceLogTargetAcceptedBodyFacilityLocal2 captures enum value "local2"
+	ReplaceLogTargetAcceptedBodyFacilityLocal2 string = "local2"
+
+	// ReplaceLogTargetAcceptedBodyFacilityLocal3 captures enum value "local3"
+	ReplaceLogTargetAcceptedBodyFacilityLocal3 string = "local3"
+
+	// ReplaceLogTargetAcceptedBodyFacilityLocal4 captures enum value "local4"
+	ReplaceLogTargetAcceptedBodyFacilityLocal4 string = "local4"
+
+	// ReplaceLogTargetAcceptedBodyFacilityLocal5 captures enum value "local5"
+	ReplaceLogTargetAcceptedBodyFacilityLocal5 string = "local5"
+
+	// ReplaceLogTargetAcceptedBodyFacilityLocal6 captures enum value "local6"
+	ReplaceLogTargetAcceptedBodyFacilityLocal6 string = "local6"
+
+	// ReplaceLogTargetAcceptedBodyFacilityLocal7 captures enum value "local7"
+	ReplaceLogTargetAcceptedBodyFacilityLocal7 string = "local7"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetAcceptedBody) validateFacilityEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetAcceptedBodyTypeFacilityPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetAcceptedBody) validateFacility(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Facility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFacilityEnum("replaceLogTargetAccepted"+"."+"facility", "body", o.Facility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetAcceptedBodyTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rfc3164","rfc5424","short","raw"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetAcceptedBodyTypeFormatPropEnum = append(replaceLogTargetAcceptedBodyTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetAcceptedBodyFormatRfc3164 captures enum value "rfc3164"
+	ReplaceLogTargetAcceptedBodyFormatRfc3164 string = "rfc3164"
+
+	// ReplaceLogTargetAcceptedBodyFormatRfc5424 captures enum value "rfc5424"
+	ReplaceLogTargetAcceptedBodyFormatRfc5424 string = "rfc5424"
+
+	// ReplaceLogTargetAcceptedBodyFormatShort captures enum value "short"
+	ReplaceLogTargetAcceptedBodyFormatShort string = "short"
+
+	// ReplaceLogTargetAcceptedBodyFormatRaw captures enum value "raw"
+	ReplaceLogTargetAcceptedBodyFormatRaw string = "raw"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetAcceptedBody) validateFormatEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetAcceptedBodyTypeFormatPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetAcceptedBody) validateFormat(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFormatEnum("replaceLogTargetAccepted"+"."+"format", "body", o.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ReplaceLogTargetAcceptedBody) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("replaceLogTargetAccepted"+"."+"index", "body", o.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetAcceptedBodyTypeLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetAcceptedBodyTypeLevelPropEnum = append(replaceLogTargetAcceptedBodyTypeLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetAcceptedBodyLevelEmerg captures enum value "emerg"
+	ReplaceLogTargetAcceptedBodyLevelEmerg string = "emerg"
+
+	// ReplaceLogTargetAcceptedBodyLevelAlert captures enum value "alert"
+	ReplaceLogTargetAcceptedBodyLevelAlert string = "alert"
+
+	// ReplaceLogTargetAcceptedBodyLevelCrit captures enum value "crit"
+	ReplaceLogTargetAcceptedBodyLevelCrit string = "crit"
+
+	// ReplaceLogTargetAcceptedBodyLevelErr captures enum value "err"
+	ReplaceLogTargetAcceptedBodyLevelErr string = "err"
+
+	// ReplaceLogTargetAcceptedBodyLevelWarning captures enum value "warning"
+	ReplaceLogTargetAcceptedBodyLevelWarning string = "warning"
+
+	// ReplaceLogTargetAcceptedBodyLevelNotice captures enum value "notice"
+	ReplaceLogTargetAcceptedBodyLevelNotice string = "notice"
+
+	// ReplaceLogTargetAcceptedBodyLevelInfo captures enum value "info"
+	ReplaceLogTargetAcceptedBodyLevelInfo string = "info"
+
+	// ReplaceLogTargetAcceptedBodyLevelDebug captures enum value "debug"
+	ReplaceLogTargetAcceptedBodyLevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetAcceptedBody) validateLevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetAcceptedBodyTypeLevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetAcceptedBody) validateLevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Level) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLevelEnum("replaceLogTargetAccepted"+"."+"level", "body", o.Level); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetAcceptedBodyTypeMinlevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetAcceptedBodyTypeMinlevelPropEnum = append(replaceLogTargetAcceptedBodyTypeMinlevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetAcceptedBodyMinlevelEmerg captures enum value "emerg"
+	ReplaceLogTargetAcceptedBodyMinlevelEmerg string = "emerg"
+
+	// ReplaceLogTargetAcceptedBodyMinlevelAlert captures enum value "alert"
+	ReplaceLogTargetAcceptedBodyMinlevelAlert string = "alert"
+
+	// ReplaceLogTargetAcceptedBodyMinlevelCrit captures enum value "crit"
+	ReplaceLogTargetAcceptedBodyMinlevelCrit string = "crit"
+
+	// ReplaceLogTargetAcceptedBodyMinlevelErr captures enum value "err"
+	ReplaceLogTargetAcceptedBodyMinlevelErr string = "err"
+
+	// ReplaceLogTargetAcceptedBodyMinlevelWarning captures enum value "warning"
+	ReplaceLogTargetAcceptedBodyMinlevelWarning string = "warning"
+
+	// ReplaceLogTargetAcceptedBodyMinlevelNotice captures enum value "notice"
+	ReplaceLogTargetAcceptedBodyMinlevelNotice string = "notice"
+
+	// ReplaceLogTargetAcceptedBodyMinlevelInfo captures enum value "info"
+	ReplaceLogTargetAcceptedBodyMinlevelInfo string = "info"
+
+	// ReplaceLogTargetAcceptedBodyMinlevelDebug captures enum value "debug"
+	ReplaceLogTargetAcceptedBodyMinlevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetAcceptedBody) validateMinlevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetAcceptedBodyTypeMinlevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetAcceptedBody) validateMinlevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Minlevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateMinlevelEnum("replaceLogTargetAccepted"+"."+"minlevel", "body", o.Minlevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ReplaceLogTargetAcceptedBody) validateSample(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Sample) { // not required
+		return nil
+	}
+
+	if o.Sample != nil {
+		if err := o.Sample.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("replaceLogTargetAccepted" + "." + "sample")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReplaceLogTargetAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReplaceLogTargetAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res ReplaceLogTargetAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// ReplaceLogTargetAcceptedBodySample Send only the logs whose position in each series of size logs is in one of the ranges
+//
+// swagger:model ReplaceLogTargetAcceptedBodySample
+type ReplaceLogTargetAcceptedBodySample struct {
+
+	// Positions, from 1, of the logs sent in each series, a single position or a range
+	// Required: true
+	Ranges []string `json:"ranges"`
+
+	// Number of logs in a series
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this replace log target accepted body sample
+func (o *ReplaceLogTargetAcceptedBodySample) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetAcceptedBodySample) validateRanges(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"ranges", "body", o.Ranges); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ReplaceLogTargetAcceptedBodySample) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"size", "body", o.Size); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("sample"+"."+"size", "body", int64(*o.Size), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReplaceLogTargetAcceptedBodySample) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReplaceLogTargetAcceptedBodySample) UnmarshalBinary(b []byte) error {
+	var res ReplaceLogTargetAcceptedBodySample
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// ReplaceLogTargetBody Log target of the global section, the defaults section, a frontend or a backend (corresponds to a log directive).
+//
+// swagger:model ReplaceLogTargetBody
+type ReplaceLogTargetBody struct {
+
+	// address
+	Address string `json:"address,omitempty"`
+
+	// facility
+	// Enum: [kern user mail daemon auth syslog lpr news uucp cron auth2 ftp ntp audit alert cron2 local0 local1 local2 local3 local4 local5 local6 local7]
+	Facility string `json:"facility,omitempty"`
+
+	// format
+	// Enum: [rfc3164 rfc5424 short raw]
+	Format string `json:"format,omitempty"`
+
+	// global
+	Global bool `json:"global,omitempty"`
+
+	// index
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// length
+	Length int64 `json:"length,omitempty"`
+
+	// level
+	// Enum: [emerg alert crit err warning notice info debug]
+	Level string `json:"level,omitempty"`
+
+	// minlevel
+	// Enum: [emerg alert crit err warning notice info debug]
+	Minlevel string `json:"minlevel,omitempty"`
+
+	// nolog
+	Nolog bool `json:"nolog,omitempty"`
+
+	// Send only the logs whose position in each series of size logs is in one of the ranges
+	Sample *ReplaceLogTargetBodySample `json:"sample,omitempty"`
+}
+
+// Validate validates this replace log target body
+func (o *ReplaceLogTargetBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFacility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMinlevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSample(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetBody) validateAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Address) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("data"+"."+"address", "body", string(o.Address), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetBodyTypeFacilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["kern","user","mail","daemon","auth","syslog","lpr","news","uucp","cron","auth2","ftp","ntp","audit","alert","cron2","local0","local1","local2","local3","local4","local5","local6","local7"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetBodyTypeFacilityPropEnum = append(replaceLogTargetBodyTypeFacilityPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetBodyFacilityKern captures enum value "kern"
+	ReplaceLogTargetBodyFacilityKern string = "kern"
+
+	// ReplaceLogTargetBodyFacilityUser captures enum value "user"
+	ReplaceLogTargetBodyFacilityUser string = "user"
+
+	// ReplaceLogTargetBodyFacilityMail captures enum value "mail"
+	ReplaceLogTargetBodyFacilityMail string = "mail"
+
+	// ReplaceLogTargetBodyFacilityDaemon captures enum value "daemon"
+	ReplaceLogTargetBodyFacilityDaemon string = "daemon"
+
+	// ReplaceLogTargetBodyFacilityAuth captures enum value "auth"
+	ReplaceLogTargetBodyFacilityAuth string = "auth"
+
+	// ReplaceLogTargetBodyFacilitySyslog captures enum value "syslog"
+	ReplaceLogTargetBodyFacilitySyslog string = "syslog"
+
+	// ReplaceLogTargetBodyFacilityLpr captures enum value "lpr"
+	ReplaceLogTargetBodyFacilityLpr string = "lpr"
+
+	// ReplaceLogTargetBodyFacilityNews captures enum value "news"
+	ReplaceLogTargetBodyFacilityNews string = "news"
+
+	// ReplaceLogTargetBodyFacilityUucp captures enum value "uucp"
+	ReplaceLogTargetBodyFacilityUucp string = "uucp"
+
+	// ReplaceLogTargetBodyFacilityCron captures enum value "cron"
+	ReplaceLogTargetBodyFacilityCron string = "cron"
+
+	// ReplaceLogTargetBodyFacilityAuth2 captures enum value "auth2"
+	ReplaceLogTargetBodyFacilityAuth2 string = "auth2"
+
+	// ReplaceLogTargetBodyFacilityFtp captures enum value "ftp"
+	ReplaceLogTargetBodyFacilityFtp string = "ftp"
+
+	// ReplaceLogTargetBodyFacilityNtp captures enum value "ntp"
+	ReplaceLogTargetBodyFacilityNtp string = "ntp"
+
+	// ReplaceLogTargetBodyFacilityAudit captures enum value "audit"
+	ReplaceLogTargetBodyFacilityAudit string = "audit"
+
+	// ReplaceLogTargetBodyFacilityAlert captures enum value "alert"
+	ReplaceLogTargetBodyFacilityAlert string = "alert"
+
+	// ReplaceLogTargetBodyFacilityCron2 captures enum value "cron2"
+	ReplaceLogTargetBodyFacilityCron2 string = "cron2"
+
+	// ReplaceLogTargetBodyFacilityLocal0 captures enum value "local0"
+	ReplaceLogTargetBodyFacilityLocal0 string = "local0"
+
+	// ReplaceLogTargetBodyFacilityLocal1 captures enum value "local1"
+	ReplaceLogTargetBodyFacilityLocal1 string = "local1"
+
+	// ReplaceLogTargetBodyFacilityLocal2 captures enum value "local2"
+	ReplaceLogTargetBodyFacilityLocal2 string = "local2"
+
+	// ReplaceLogTargetBodyFacilityLocal3 captures enum value "local3"
+	ReplaceLogTargetBodyFacilityLocal3 string = "local3"
+
+	// ReplaceLogTargetBodyFacilityLocal4 captures enum value "local4"
+	ReplaceLogTargetBodyFacilityLocal4 string = "local4"
+
+	// ReplaceLogTargetBodyFacilityLocal5 captures enum value "local5"
+	ReplaceLogTargetBodyFacilityLocal5 string = "local5"
+
+	// ReplaceLogTargetBodyFacilityLocal6 captures enum value "local6"
+	ReplaceLogTargetBodyFacilityLocal6 string = "local6"
+
+	// ReplaceLogTargetBodyFacilityLocal7 captures enum value "local7"
+	ReplaceLogTargetBodyFacilityLocal7 string = "local7"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetBody) validateFacilityEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetBodyTypeFacilityPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetBody) validateFacility(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Facility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFacilityEnum("data"+"."+"facility", "body", o.Facility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetBodyTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rfc3164","rfc5424","short","raw"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetBodyTypeFormatPropEnum = append(replaceLogTargetBodyTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetBodyFormatRfc3164 captures enum value "rfc3164"
+	ReplaceLogTargetBodyFormatRfc3164 string = "rfc3164"
+
+	// ReplaceLogTargetBodyFormatRfc5424 captures enum value "rfc5424"
+	ReplaceLogTargetBodyFormatRfc5424 string = "rfc5424"
+
+	// ReplaceLogTargetBodyFormatShort captures enum value "short"
+	ReplaceLogTargetBodyFormatShort string = "short"
+
+	// ReplaceLogTargetBodyFormatRaw captures enum value "raw"
+	ReplaceLogTargetBodyFormatRaw string = "raw"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetBody) validateFormatEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetBodyTypeFormatPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetBody) validateFormat(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFormatEnum("data"+"."+"format", "body", o.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ReplaceLogTargetBody) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("data"+"."+"index", "body", o.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetBodyTypeLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetBodyTypeLevelPropEnum = append(replaceLogTargetBodyTypeLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetBodyLevelEmerg captures enum value "emerg"
+	ReplaceLogTargetBodyLevelEmerg string = "emerg"
+
+	// ReplaceLogTargetBodyLevelAlert captures enum value "alert"
+	ReplaceLogTargetBodyLevelAlert string = "alert"
+
+	// ReplaceLogTargetBodyLevelCrit captures enum value "crit"
+	ReplaceLogTargetBodyLevelCrit string = "crit"
+
+	// ReplaceLogTargetBodyLevelErr captures enum value "err"
+	ReplaceLogTargetBodyLevelErr string = "err"
+
+	// ReplaceLogTargetBodyLevelWarning captures enum value "warning"
+	ReplaceLogTargetBodyLevelWarning string = "warning"
+
+	// ReplaceLogTargetBodyLevelNotice captures enum value "notice"
+	ReplaceLogTargetBodyLevelNotice string = "notice"
+
+	// ReplaceLogTargetBodyLevelInfo captures enum value "info"
+	ReplaceLogTargetBodyLevelInfo string = "info"
+
+	// ReplaceLogTargetBodyLevelDebug captures enum value "debug"
+	ReplaceLogTargetBodyLevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetBody) validateLevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetBodyTypeLevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetBody) validateLevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Level) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLevelEnum("data"+"."+"level", "body", o.Level); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetBodyTypeMinlevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetBodyTypeMinlevelPropEnum = append(replaceLogTargetBodyTypeMinlevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetBodyMinlevelEmerg captures enum value "emerg"
+	ReplaceLogTargetBodyMinlevelEmerg string = "emerg"
+
+	// ReplaceLogTargetBodyMinlevelAlert captures enum value "alert"
+	ReplaceLogTargetBodyMinlevelAlert string = "alert"
+
+	// ReplaceLogTargetBodyMinlevelCrit captures enum value "crit"
+	ReplaceLogTargetBodyMinlevelCrit string = "crit"
+
+	// ReplaceLogTargetBodyMinlevelErr captures enum value "err"
+	ReplaceLogTargetBodyMinlevelErr string = "err"
+
+	// ReplaceLogTargetBodyMinlevelWarning captures enum value "warning"
+	ReplaceLogTargetBodyMinlevelWarning string = "warning"
+
+	// ReplaceLogTargetBodyMinlevelNotice captures enum value "notice"
+	ReplaceLogTargetBodyMinlevelNotice string = "notice"
+
+	// ReplaceLogTargetBodyMinlevelInfo captures enum value "info"
+	ReplaceLogTargetBodyMinlevelInfo string = "info"
+
+	// ReplaceLogTargetBodyMinlevelDebug captures enum value "debug"
+	ReplaceLogTargetBodyMinlevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetBody) validateMinlevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetBodyTypeMinlevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetBody) validateMinlevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Minlevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateMinlevelEnum("data"+"."+"minlevel", "body", o.Minlevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ReplaceLogTargetBody) validateSample(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Sample) { // not required
+		return nil
+	}
+
+	if o.Sample != nil {
+		if err := o.Sample.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("data" + "." + "sample")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReplaceLogTargetBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReplaceLogTargetBody) UnmarshalBinary(b []byte) error {
+	var res ReplaceLogTargetBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// ReplaceLogTargetBodySample Send only the logs whose position in each series of size logs is in one of the ranges
+//
+// swagger:model ReplaceLogTargetBodySample
+type ReplaceLogTargetBodySample struct {
+
+	// Positions, from 1, of the logs sent in each series, a single position or a range
+	// Required: true
+	Ranges []string `json:"ranges"`
+
+	// Number of logs in a series
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this replace log target body sample
+func (o *ReplaceLogTargetBodySample) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetBodySample) validateRanges(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"ranges", "body", o.Ranges); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ReplaceLogTargetBodySample) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"size", "body", o.Size); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("sample"+"."+"size", "body", int64(*o.Size), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReplaceLogTargetBodySample) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReplaceLogTargetBodySample) UnmarshalBinary(b []byte) error {
+	var res ReplaceLogTargetBodySample
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// ReplaceLogTargetOKBody Log target of the global section, the defaults section, a frontend or a backend (corresponds to a log directive).
+//
+// swagger:model ReplaceLogTargetOKBody
+type ReplaceLogTargetOKBody struct {
+
+	// address
+	Address string `json:"address,omitempty"`
+
+	// facility
+	// Enum: [kern user mail daemon auth syslog lpr news uucp cron auth2 ftp ntp audit alert cron2 local0 local1 local2 local3 local4 local5 local6 local7]
+	Facility string `json:"facility,omitempty"`
+
+	// format
+	// Enum: [rfc3164 rfc5424 short raw]
+	Format string `json:"format,omitempty"`
+
+	// global
+	Global bool `json:"global,omitempty"`
+
+	// index
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// length
+	Length int64 `json:"length,omitempty"`
+
+	// level
+	// Enum: [emerg alert crit err warning notice info debug]
+	Level string `json:"level,omitempty"`
+
+	// minlevel
+	// Enum: [emerg alert crit err warning notice info debug]
+	Minlevel string `json:"minlevel,omitempty"`
+
+	// nolog
+	Nolog bool `json:"nolog,omitempty"`
+
+	// Send only the logs whose position in each series of size logs is in one of the ranges
+	Sample *ReplaceLogTargetOKBodySample `json:"sample,omitempty"`
+}
+
+// Validate validates this replace log target o k body
+func (o *ReplaceLogTargetOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFacility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMinlevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSample(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetOKBody) validateAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Address) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("replaceLogTargetOK"+"."+"address", "body", string(o.Address), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetOKBodyTypeFacilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["kern","user","mail","daemon","auth","syslog","lpr","news","uucp","cron","auth2","ftp","ntp","audit","alert","cron2","local0","local1","local2","local3","local4","local5","local6","local7"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetOKBodyTypeFacilityPropEnum = append(replaceLogTargetOKBodyTypeFacilityPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetOKBodyFacilityKern captures enum value "kern"
+	ReplaceLogTargetOKBodyFacilityKern string = "kern"
+
+	// ReplaceLogTargetOKBodyFacilityUser captures enum value "user"
+	ReplaceLogTargetOKBodyFacilityUser string = "user"
+
+	// ReplaceLogTargetOKBodyFacilityMail captures enum value "mail"
+	ReplaceLogTargetOKBodyFacilityMail string = "mail"
+
+	// ReplaceLogTargetOKBodyFacilityDaemon captures enum value "daemon"
+	ReplaceLogTargetOKBodyFacilityDaemon string = "daemon"
+
+	// ReplaceLogTargetOKBodyFacilityAuth captures enum value "auth"
+	ReplaceLogTargetOKBodyFacilityAuth string = "auth"
+
+	// ReplaceLogTargetOKBodyFacilitySyslog captures enum value "syslog"
+	ReplaceLogTargetOKBodyFacilitySyslog string = "syslog"
+
+	// ReplaceLogTargetOKBodyFacilityLpr captures enum value "lpr"
+	ReplaceLogTargetOKBodyFacilityLpr string = "lpr"
+
+	// ReplaceLogTargetOKBodyFacilityNews captures enum value "news"
+	ReplaceLogTargetOKBodyFacilityNews string = "news"
+
+	// ReplaceLogTargetOKBodyFacilityUucp captures enum value "uucp"
+	ReplaceLogTargetOKBodyFacilityUucp string = "uucp"
+
+	// ReplaceLogTargetOKBodyFacilityCron captures enum value "cron"
+	ReplaceLogTargetOKBodyFacilityCron string = "cron"
+
+	// ReplaceLogTargetOKBodyFacilityAuth2 captures enum value "auth2"
+	ReplaceLogTargetOKBodyFacilityAuth2 string = "auth2"
+
+	// ReplaceLogTargetOKBodyFacilityFtp captures enum value "ftp"
+	ReplaceLogTargetOKBodyFacilityFtp string = "ftp"
+
+	// ReplaceLogTargetOKBodyFacilityNtp captures enum value "ntp"
+	ReplaceLogTargetOKBodyFacilityNtp string = "ntp"
+
+	// ReplaceLogTargetOKBodyFacilityAudit captures enum value "audit"
+	ReplaceLogTargetOKBodyFacilityAudit string = "audit"
+
+	// ReplaceLogTargetOKBodyFacilityAlert captures enum value "alert"
+	ReplaceLogTargetOKBodyFacilityAlert string = "alert"
+
+	// ReplaceLogTargetOKBodyFacilityCron2 captures enum value "cron2"
+	ReplaceLogTargetOKBodyFacilityCron2 string = "cron2"
+
+	// ReplaceLogTargetOKBodyFacilityLocal0 captures enum value "local0"
+	ReplaceLogTargetOKBodyFacilityLocal0 string = "local0"
+
+	// ReplaceLogTargetOKBodyFacilityLocal1 captures enum value "local1"
+	ReplaceLogTargetOKBodyFacilityLocal1 string = "local1"
+
+	// ReplaceLogTargetOKBodyFacilityLocal2 captures enum value "local2"
+	ReplaceLogTargetOKBodyFacilityLocal2 string = "local2"
+
+	// ReplaceLogTargetOKBodyFacilityLocal3 captures enum value "local3"
+	ReplaceLogTargetOKBodyFacilityLocal3 string = "local3"
+
+	// ReplaceLogTargetOKBodyFacilityLocal4 captures enum value "local4"
+	ReplaceLogTargetOKBodyFacilityLocal4 string = "local4"
+
+	// ReplaceLogTargetOKBodyFacilityLocal5 captures enum value "local5"
+	ReplaceLogTargetOKBodyFacilityLocal5 string = "local5"
+
+	// ReplaceLogTargetOKBodyFacilityLocal6 captures enum value "local6"
+	ReplaceLogTargetOKBodyFacilityLocal6 string = "local6"
+
+	// ReplaceLogTargetOKBodyFacilityLocal7 captures enum value "local7"
+	ReplaceLogTargetOKBodyFacilityLocal7 string = "local7"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetOKBody) validateFacilityEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetOKBodyTypeFacilityPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetOKBody) validateFacility(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Facility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFacilityEnum("replaceLogTargetOK"+"."+"facility", "body", o.Facility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetOKBodyTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rfc3164","rfc5424","short","raw"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetOKBodyTypeFormatPropEnum = append(replaceLogTargetOKBodyTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetOKBodyFormatRfc3164 captures enum value "rfc3164"
+	ReplaceLogTargetOKBodyFormatRfc3164 string = "rfc3164"
+
+	// ReplaceLogTargetOKBodyFormatRfc5424 captures enum value "rfc5424"
+	ReplaceLogTargetOKBodyFormatRfc5424 string = "rfc5424"
+
+	// ReplaceLogTargetOKBodyFormatShort captures enum value "short"
+	ReplaceLogTargetOKBodyFormatShort string = "short"
+
+	// ReplaceLogTargetOKBodyFormatRaw captures enum value "raw"
+	ReplaceLogTargetOKBodyFormatRaw string = "raw"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetOKBody) validateFormatEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetOKBodyTypeFormatPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetOKBody) validateFormat(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateFormatEnum("replaceLogTargetOK"+"."+"format", "body", o.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ReplaceLogTargetOKBody) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("replaceLogTargetOK"+"."+"index", "body", o.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetOKBodyTypeLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetOKBodyTypeLevelPropEnum = append(replaceLogTargetOKBodyTypeLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetOKBodyLevelEmerg captures enum value "emerg"
+	ReplaceLogTargetOKBodyLevelEmerg string = "emerg"
+
+	// ReplaceLogTargetOKBodyLevelAlert captures enum value "alert"
+	ReplaceLogTargetOKBodyLevelAlert string = "alert"
+
+	// ReplaceLogTargetOKBodyLevelCrit captures enum value "crit"
+	ReplaceLogTargetOKBodyLevelCrit string = "crit"
+
+	// ReplaceLogTargetOKBodyLevelErr captures enum value "err"
+	ReplaceLogTargetOKBodyLevelErr string = "err"
+
+	// ReplaceLogTargetOKBodyLevelWarning captures enum value "warning"
+	ReplaceLogTargetOKBodyLevelWarning string = "warning"
+
+	// ReplaceLogTargetOKBodyLevelNotice captures enum value "notice"
+	ReplaceLogTargetOKBodyLevelNotice string = "notice"
+
+	// ReplaceLogTargetOKBodyLevelInfo captures enum value "info"
+	ReplaceLogTargetOKBodyLevelInfo string = "info"
+
+	// ReplaceLogTargetOKBodyLevelDebug captures enum value "debug"
+	ReplaceLogTargetOKBodyLevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetOKBody) validateLevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetOKBodyTypeLevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetOKBody) validateLevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Level) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLevelEnum("replaceLogTargetOK"+"."+"level", "body", o.Level); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replaceLogTargetOKBodyTypeMinlevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["emerg","alert","crit","err","warning","notice","info","debug"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replaceLogTargetOKBodyTypeMinlevelPropEnum = append(replaceLogTargetOKBodyTypeMinlevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplaceLogTargetOKBodyMinlevelEmerg captures enum value "emerg"
+	ReplaceLogTargetOKBodyMinlevelEmerg string = "emerg"
+
+	// ReplaceLogTargetOKBodyMinlevelAlert captures enum value "alert"
+	ReplaceLogTargetOKBodyMinlevelAlert string = "alert"
+
+	// ReplaceLogTargetOKBodyMinlevelCrit captures enum value "crit"
+	ReplaceLogTargetOKBodyMinlevelCrit string = "crit"
+
+	// ReplaceLogTargetOKBodyMinlevelErr captures enum value "err"
+	ReplaceLogTargetOKBodyMinlevelErr string = "err"
+
+	// ReplaceLogTargetOKBodyMinlevelWarning captures enum value "warning"
+	ReplaceLogTargetOKBodyMinlevelWarning string = "warning"
+
+	// ReplaceLogTargetOKBodyMinlevelNotice captures enum value "notice"
+	ReplaceLogTargetOKBodyMinlevelNotice string = "notice"
+
+	// ReplaceLogTargetOKBodyMinlevelInfo captures enum value "info"
+	ReplaceLogTargetOKBodyMinlevelInfo string = "info"
+
+	// ReplaceLogTargetOKBodyMinlevelDebug captures enum value "debug"
+	ReplaceLogTargetOKBodyMinlevelDebug string = "debug"
+)
+
+// prop value enum
+func (o *ReplaceLogTargetOKBody) validateMinlevelEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, replaceLogTargetOKBodyTypeMinlevelPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetOKBody) validateMinlevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Minlevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateMinlevelEnum("replaceLogTargetOK"+"."+"minlevel", "body", o.Minlevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ReplaceLogTargetOKBody) validateSample(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Sample) { // not required
+		return nil
+	}
+
+	if o.Sample != nil {
+		if err := o.Sample.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("replaceLogTargetOK" + "." + "sample")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReplaceLogTargetOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReplaceLogTargetOKBody) UnmarshalBinary(b []byte) error {
+	var res ReplaceLogTargetOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// ReplaceLogTargetOKBodySample Send only the logs whose position in each series of size logs is in one of the ranges
+//
+// swagger:model ReplaceLogTargetOKBodySample
+type ReplaceLogTargetOKBodySample struct {
+
+	// Positions, from 1, of the logs sent in each series, a single position or a range
+	// Required: true
+	Ranges []string `json:"ranges"`
+
+	// Number of logs in a series
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this replace log target o k body sample
+func (o *ReplaceLogTargetOKBodySample) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReplaceLogTargetOKBodySample) validateRanges(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"ranges", "body", o.Ranges); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ReplaceLogTargetOKBodySample) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample"+"."+"size", "body", o.Size); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("sample"+"."+"size", "body", int64(*o.Size), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReplaceLogTargetOKBodySample) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReplaceLogTargetOKBodySample) UnmarshalBinary(b []byte) error {
+	var res ReplaceLogTargetOKBodySample
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -30,8 +30,6 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
-
-	"github.com/haproxytech/models/v2"
 )
 
 // NewReplaceLogTargetParams creates a new ReplaceLogTargetParams object
@@ -62,7 +60,7 @@ type ReplaceLogTargetParams struct {
 	  Required: true
 	  In: body
 	*/
-	Data *models.LogTarget
+	Data ReplaceLogTargetBody
 	/*If set, do a force reload, do not wait for the configured reload-delay. Cannot be used when transaction is specified, as changes in transaction are not applied directly to configuration.
 	  In: query
 	  Default: false
@@ -73,11 +71,10 @@ type ReplaceLogTargetParams struct {
 	  In: path
 	*/
 	Index int64
-	/*Parent name
-	  Required: true
+	/*Parent name, not used for global and defaults
 	  In: query
 	*/
-	ParentName string
+	ParentName *string
 	/*Parent type
 	  Required: true
 	  In: query
@@ -106,7 +103,7 @@ func (o *ReplaceLogTargetParams) BindRequest(r *http.Request, route *middleware.
 
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
-		var body models.LogTarget
+		var body ReplaceLogTargetBody
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
 			if err == io.EOF {
 				res = append(res, errors.Required("data", "body"))
@@ -120,7 +117,7 @@ func (o *ReplaceLogTargetParams) BindRequest(r *http.Request, route *middleware.
 			}
 
 			if len(res) == 0 {
-				o.Data = &body
+				o.Data = body
 			}
 		}
 	} else {
@@ -206,21 +203,18 @@ func (o *ReplaceLogTargetParams) bindIndex(rawData []string, hasKey bool, format
 
 // bindParentName binds and validates parameter ParentName from query.
 func (o *ReplaceLogTargetParams) bindParentName(rawData []string, hasKey bool, formats strfmt.Registry) error {
-	if !hasKey {
-		return errors.Required("parent_name", "query")
-	}
 	var raw string
 	if len(rawData) > 0 {
 		raw = rawData[len(rawData)-1]
 	}
 
-	// Required: true
+	// Required: false
 	// AllowEmptyValue: false
-	if err := validate.RequiredString("parent_name", "query", raw); err != nil {
-		return err
+	if raw == "" { // empty values pass all other validations
+		return nil
 	}
 
-	o.ParentName = raw
+	o.ParentName = &raw
 
 	return nil
 }
@@ -253,7 +247,7 @@ func (o *ReplaceLogTargetParams) bindParentType(rawData []string, hasKey bool, f
 // validateParentType carries on validations for parameter ParentType
 func (o *ReplaceLogTargetParams) validateParentType(formats strfmt.Registry) error {
 
-	if err := validate.Enum("parent_type", "query", o.ParentType, []interface{}{"frontend", "backend"}); err != nil {
+	if err := validate.Enum("parent_type", "query", o.ParentType, []interface{}{"global", "defaults", "frontend", "backend"}); err != nil {
 		return err
 	}
 
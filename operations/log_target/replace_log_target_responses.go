@@ -41,7 +41,7 @@ type ReplaceLogTargetOK struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.LogTarget `json:"body,omitempty"`
+	Payload *ReplaceLogTargetOKBody `json:"body,omitempty"`
 }
 
 // NewReplaceLogTargetOK creates ReplaceLogTargetOK with default headers values
@@ -51,13 +51,13 @@ func NewReplaceLogTargetOK() *ReplaceLogTargetOK {
 }
 
 // WithPayload adds the payload to the replace log target o k response
-func (o *ReplaceLogTargetOK) WithPayload(payload *models.LogTarget) *ReplaceLogTargetOK {
+func (o *ReplaceLogTargetOK) WithPayload(payload *ReplaceLogTargetOKBody) *ReplaceLogTargetOK {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the replace log target o k response
-func (o *ReplaceLogTargetOK) SetPayload(payload *models.LogTarget) {
+func (o *ReplaceLogTargetOK) SetPayload(payload *ReplaceLogTargetOKBody) {
 	o.Payload = payload
 }
 
@@ -89,7 +89,7 @@ type ReplaceLogTargetAccepted struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.LogTarget `json:"body,omitempty"`
+	Payload *ReplaceLogTargetAcceptedBody `json:"body,omitempty"`
 }
 
 // NewReplaceLogTargetAccepted creates ReplaceLogTargetAccepted with default headers values
@@ -110,13 +110,13 @@ func (o *ReplaceLogTargetAccepted) SetReloadID(reloadID string) {
 }
 
 // WithPayload adds the payload to the replace log target accepted response
-func (o *ReplaceLogTargetAccepted) WithPayload(payload *models.LogTarget) *ReplaceLogTargetAccepted {
+func (o *ReplaceLogTargetAccepted) WithPayload(payload *ReplaceLogTargetAcceptedBody) *ReplaceLogTargetAccepted {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the replace log target accepted response
-func (o *ReplaceLogTargetAccepted) SetPayload(payload *models.LogTarget) {
+func (o *ReplaceLogTargetAccepted) SetPayload(payload *ReplaceLogTargetAcceptedBody) {
 	o.Payload = payload
 }
 
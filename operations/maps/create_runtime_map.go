@@ -48,7 +48,7 @@ func NewCreateRuntimeMap(ctx *middleware.Context, handler CreateRuntimeMapHandle
 
 Creates runtime map file with its entries
 
-Creates runtime map file with its entries.
+Creates runtime map file with its entries. With a transaction_id the map file is staged in the transaction, it is stored in the maps directory when the transaction is committed and loaded by the reload applying it.
 
 */
 type CreateRuntimeMap struct {
@@ -28,6 +28,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 )
 
 // NewCreateRuntimeMapParams creates a new CreateRuntimeMapParams object
@@ -50,6 +51,10 @@ type CreateRuntimeMapParams struct {
 	  In: formData
 	*/
 	FileUpload io.ReadCloser
+	/*ID of the transaction where we want to add the operation. Cannot be used when version is specified.
+	  In: query
+	*/
+	TransactionID *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -61,6 +66,8 @@ func (o *CreateRuntimeMapParams) BindRequest(r *http.Request, route *middleware.
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if err := r.ParseMultipartForm(32 << 20); err != nil {
 		if err != http.ErrNotMultipart {
 			return errors.New(400, "%v", err)
@@ -80,6 +87,11 @@ func (o *CreateRuntimeMapParams) BindRequest(r *http.Request, route *middleware.
 		o.FileUpload = &runtime.File{Data: fileUpload, Header: fileUploadHeader}
 	}
 
+	qTransactionID, qhkTransactionID, _ := qs.GetOK("transaction_id")
+	if err := o.bindTransactionID(qTransactionID, qhkTransactionID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -92,3 +104,21 @@ func (o *CreateRuntimeMapParams) BindRequest(r *http.Request, route *middleware.
 func (o *CreateRuntimeMapParams) bindFileUpload(file multipart.File, header *multipart.FileHeader) error {
 	return nil
 }
+
+// bindTransactionID binds and validates parameter TransactionID from query.
+func (o *CreateRuntimeMapParams) bindTransactionID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.TransactionID = &raw
+
+	return nil
+}
@@ -76,6 +76,30 @@ func (o *CreateRuntimeMapCreated) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// CreateRuntimeMapAcceptedCode is the HTTP code returned for type CreateRuntimeMapAccepted
+const CreateRuntimeMapAcceptedCode int = 202
+
+/*CreateRuntimeMapAccepted Map file staged in the transaction
+
+swagger:response createRuntimeMapAccepted
+*/
+type CreateRuntimeMapAccepted struct {
+}
+
+// NewCreateRuntimeMapAccepted creates CreateRuntimeMapAccepted with default headers values
+func NewCreateRuntimeMapAccepted() *CreateRuntimeMapAccepted {
+
+	return &CreateRuntimeMapAccepted{}
+}
+
+// WriteResponse to the client
+func (o *CreateRuntimeMapAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(202)
+}
+
 // CreateRuntimeMapBadRequestCode is the HTTP code returned for type CreateRuntimeMapBadRequest
 const CreateRuntimeMapBadRequestCode int = 400
 
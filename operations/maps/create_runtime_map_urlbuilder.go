@@ -28,7 +28,11 @@ import (
 
 // CreateRuntimeMapURL generates an URL for the create runtime map operation
 type CreateRuntimeMapURL struct {
+	TransactionID *string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -58,6 +62,18 @@ func (o *CreateRuntimeMapURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var transactionIDQ string
+	if o.TransactionID != nil {
+		transactionIDQ = *o.TransactionID
+	}
+	if transactionIDQ != "" {
+		qs.Set("transaction_id", transactionIDQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -21,9 +21,15 @@ package reloads
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // GetReloadHandlerFunc turns a function with the right signature into a get reload handler
@@ -86,3 +92,187 @@ func (o *GetReload) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// GetReloadOKBody HAProxy reload with the transactions it applies
+//
+// swagger:model GetReloadOKBody
+type GetReloadOKBody struct {
+
+	// ID
+	ID string `json:"id,omitempty"`
+
+	// reload timestamp
+	ReloadTimestamp int64 `json:"reload_timestamp,omitempty"`
+
+	// response
+	Response string `json:"response,omitempty"`
+
+	// status
+	// Enum: [failed in_progress succeeded]
+	Status string `json:"status,omitempty"`
+
+	// Transactions applied by the reload, with their notes and labels
+	Transactions []*GetReloadOKBodyTransactionsItems0 `json:"transactions"`
+}
+
+// Validate validates this get reload o k body
+func (o *GetReloadOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTransactions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetReloadOKBody) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.ID) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("getReloadOK"+"."+"id", "body", string(o.ID), `^\d{4}-\d{2}-\d{2}-\d+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getReloadOKBodyTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["failed","in_progress","succeeded"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getReloadOKBodyTypeStatusPropEnum = append(getReloadOKBodyTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// GetReloadOKBodyStatusFailed captures enum value "failed"
+	GetReloadOKBodyStatusFailed string = "failed"
+
+	// GetReloadOKBodyStatusInProgress captures enum value "in_progress"
+	GetReloadOKBodyStatusInProgress string = "in_progress"
+
+	// GetReloadOKBodyStatusSucceeded captures enum value "succeeded"
+	GetReloadOKBodyStatusSucceeded string = "succeeded"
+)
+
+// prop value enum
+func (o *GetReloadOKBody) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getReloadOKBodyTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetReloadOKBody) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("getReloadOK"+"."+"status", "body", o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetReloadOKBody) validateTransactions(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Transactions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Transactions); i++ {
+		if swag.IsZero(o.Transactions[i]) { // not required
+			continue
+		}
+
+		if o.Transactions[i] != nil {
+			if err := o.Transactions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getReloadOK" + "." + "transactions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetReloadOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetReloadOKBody) UnmarshalBinary(b []byte) error {
+	var res GetReloadOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetReloadOKBodyTransactionsItems0 get reload o k body transactions items0
+//
+// swagger:model GetReloadOKBodyTransactionsItems0
+type GetReloadOKBodyTransactionsItems0 struct {
+
+	// Transaction id
+	ID string `json:"id,omitempty"`
+
+	// labels
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// note
+	Note string `json:"note,omitempty"`
+}
+
+// Validate validates this get reload o k body transactions items0
+func (o *GetReloadOKBodyTransactionsItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetReloadOKBodyTransactionsItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetReloadOKBodyTransactionsItems0) UnmarshalBinary(b []byte) error {
+	var res GetReloadOKBodyTransactionsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -41,7 +41,7 @@ type GetReloadOK struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.Reload `json:"body,omitempty"`
+	Payload *GetReloadOKBody `json:"body,omitempty"`
 }
 
 // NewGetReloadOK creates GetReloadOK with default headers values
@@ -51,13 +51,13 @@ func NewGetReloadOK() *GetReloadOK {
 }
 
 // WithPayload adds the payload to the get reload o k response
-func (o *GetReloadOK) WithPayload(payload *models.Reload) *GetReloadOK {
+func (o *GetReloadOK) WithPayload(payload *GetReloadOKBody) *GetReloadOK {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the get reload o k response
-func (o *GetReloadOK) SetPayload(payload *models.Reload) {
+func (o *GetReloadOK) SetPayload(payload *GetReloadOKBody) {
 	o.Payload = payload
 }
 
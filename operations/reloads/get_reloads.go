@@ -21,9 +21,15 @@ package reloads
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // GetReloadsHandlerFunc turns a function with the right signature into a get reloads handler
@@ -86,3 +92,187 @@ func (o *GetReloads) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// GetReloadsOKBodyItems0 HAProxy reload with the transactions it applies
+//
+// swagger:model GetReloadsOKBodyItems0
+type GetReloadsOKBodyItems0 struct {
+
+	// ID
+	ID string `json:"id,omitempty"`
+
+	// reload timestamp
+	ReloadTimestamp int64 `json:"reload_timestamp,omitempty"`
+
+	// response
+	Response string `json:"response,omitempty"`
+
+	// status
+	// Enum: [failed in_progress succeeded]
+	Status string `json:"status,omitempty"`
+
+	// Transactions applied by the reload, with their notes and labels
+	Transactions []*GetReloadsOKBodyItems0TransactionsItems0 `json:"transactions"`
+}
+
+// Validate validates this get reloads o k body items0
+func (o *GetReloadsOKBodyItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTransactions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetReloadsOKBodyItems0) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.ID) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("id", "body", string(o.ID), `^\d{4}-\d{2}-\d{2}-\d+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getReloadsOKBodyItems0TypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["failed","in_progress","succeeded"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getReloadsOKBodyItems0TypeStatusPropEnum = append(getReloadsOKBodyItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// GetReloadsOKBodyItems0StatusFailed captures enum value "failed"
+	GetReloadsOKBodyItems0StatusFailed string = "failed"
+
+	// GetReloadsOKBodyItems0StatusInProgress captures enum value "in_progress"
+	GetReloadsOKBodyItems0StatusInProgress string = "in_progress"
+
+	// GetReloadsOKBodyItems0StatusSucceeded captures enum value "succeeded"
+	GetReloadsOKBodyItems0StatusSucceeded string = "succeeded"
+)
+
+// prop value enum
+func (o *GetReloadsOKBodyItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getReloadsOKBodyItems0TypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetReloadsOKBodyItems0) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("status", "body", o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetReloadsOKBodyItems0) validateTransactions(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Transactions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Transactions); i++ {
+		if swag.IsZero(o.Transactions[i]) { // not required
+			continue
+		}
+
+		if o.Transactions[i] != nil {
+			if err := o.Transactions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("transactions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetReloadsOKBodyItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetReloadsOKBodyItems0) UnmarshalBinary(b []byte) error {
+	var res GetReloadsOKBodyItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// GetReloadsOKBodyItems0TransactionsItems0 get reloads o k body items0 transactions items0
+//
+// swagger:model GetReloadsOKBodyItems0TransactionsItems0
+type GetReloadsOKBodyItems0TransactionsItems0 struct {
+
+	// Transaction id
+	ID string `json:"id,omitempty"`
+
+	// labels
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// note
+	Note string `json:"note,omitempty"`
+}
+
+// Validate validates this get reloads o k body items0 transactions items0
+func (o *GetReloadsOKBodyItems0TransactionsItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetReloadsOKBodyItems0TransactionsItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetReloadsOKBodyItems0TransactionsItems0) UnmarshalBinary(b []byte) error {
+	var res GetReloadsOKBodyItems0TransactionsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -41,7 +41,7 @@ type GetReloadsOK struct {
 	/*
 	  In: Body
 	*/
-	Payload models.Reloads `json:"body,omitempty"`
+	Payload []*GetReloadsOKBodyItems0 `json:"body,omitempty"`
 }
 
 // NewGetReloadsOK creates GetReloadsOK with default headers values
@@ -51,13 +51,13 @@ func NewGetReloadsOK() *GetReloadsOK {
 }
 
 // WithPayload adds the payload to the get reloads o k response
-func (o *GetReloadsOK) WithPayload(payload models.Reloads) *GetReloadsOK {
+func (o *GetReloadsOK) WithPayload(payload []*GetReloadsOKBodyItems0) *GetReloadsOK {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the get reloads o k response
-func (o *GetReloadsOK) SetPayload(payload models.Reloads) {
+func (o *GetReloadsOK) SetPayload(payload []*GetReloadsOKBodyItems0) {
 	o.Payload = payload
 }
 
@@ -68,7 +68,7 @@ func (o *GetReloadsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pr
 	payload := o.Payload
 	if payload == nil {
 		// return empty array
-		payload = models.Reloads{}
+		payload = []*GetReloadsOKBodyItems0{}
 	}
 
 	if err := producer.Produce(rw, payload); err != nil {
@@ -21,9 +21,15 @@ package transactions
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // CommitTransactionHandlerFunc turns a function with the right signature into a commit transaction handler
@@ -48,7 +54,7 @@ func NewCommitTransaction(ctx *middleware.Context, handler CommitTransactionHand
 
 Commit transaction
 
-Commit transaction, execute all operations in transaction and return msg
+Commit transaction, execute all operations in transaction and return msg. Validator and mutator hooks configured in the dataplane configuration file run before the commit, a rejected transaction is not committed and returns 400. With dry_run, the transaction goes through the hooks and the HAProxy configuration check without being committed, the report of the checks being returned in dry_run. A transaction changing a section also changed by another open transaction is not committed and status 409 lists the conflicting transactions with their owners and sections, unless ignore_conflicts is set. A transaction started on a configuration version changed since is not committed either, status 409 listing the transactions committed since.
 
 */
 type CommitTransaction struct {
@@ -86,3 +92,458 @@ func (o *CommitTransaction) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// CommitTransactionConflictBody commit transaction conflict body
+//
+// swagger:model CommitTransactionConflictBody
+type CommitTransactionConflictBody struct {
+
+	// code
+	// Required: true
+	Code *int64 `json:"code"`
+
+	// conflicts
+	Conflicts []*CommitTransactionConflictBodyConflictsItems0 `json:"conflicts"`
+
+	// message
+	// Required: true
+	Message *string `json:"message"`
+}
+
+// Validate validates this commit transaction conflict body
+func (o *CommitTransactionConflictBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateConflicts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMessage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CommitTransactionConflictBody) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("commitTransactionConflict"+"."+"code", "body", o.Code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CommitTransactionConflictBody) validateConflicts(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Conflicts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Conflicts); i++ {
+		if swag.IsZero(o.Conflicts[i]) { // not required
+			continue
+		}
+
+		if o.Conflicts[i] != nil {
+			if err := o.Conflicts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("commitTransactionConflict" + "." + "conflicts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *CommitTransactionConflictBody) validateMessage(formats strfmt.Registry) error {
+
+	if err := validate.Required("commitTransactionConflict"+"."+"message", "body", o.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CommitTransactionConflictBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CommitTransactionConflictBody) UnmarshalBinary(b []byte) error {
+	var res CommitTransactionConflictBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// CommitTransactionConflictBodyConflictsItems0 commit transaction conflict body conflicts items0
+//
+// swagger:model CommitTransactionConflictBodyConflictsItems0
+type CommitTransactionConflictBodyConflictsItems0 struct {
+
+	// Transaction id
+	ID string `json:"id,omitempty"`
+
+	// User who started the transaction
+	Owner string `json:"owner,omitempty"`
+
+	// Sections changed by the transaction, as type and name
+	Sections []string `json:"sections"`
+
+	// status
+	// Enum: [in_progress success]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this commit transaction conflict body conflicts items0
+func (o *CommitTransactionConflictBodyConflictsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var commitTransactionConflictBodyConflictsItems0TypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["in_progress","success"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		commitTransactionConflictBodyConflictsItems0TypeStatusPropEnum = append(commitTransactionConflictBodyConflictsItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// CommitTransactionConflictBodyConflictsItems0StatusInProgress captures enum value "in_progress"
+	CommitTransactionConflictBodyConflictsItems0StatusInProgress string = "in_progress"
+
+	// CommitTransactionConflictBodyConflictsItems0StatusSuccess captures enum value "success"
+	CommitTransactionConflictBodyConflictsItems0StatusSuccess string = "success"
+)
+
+// prop value enum
+func (o *CommitTransactionConflictBodyConflictsItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, commitTransactionConflictBodyConflictsItems0TypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CommitTransactionConflictBodyConflictsItems0) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("status", "body", o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CommitTransactionConflictBodyConflictsItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CommitTransactionConflictBodyConflictsItems0) UnmarshalBinary(b []byte) error {
+	var res CommitTransactionConflictBodyConflictsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// CommitTransactionOKBody HAProxy configuration transaction, with the report of the checks of a dry run
+//
+// swagger:model CommitTransactionOKBody
+type CommitTransactionOKBody struct {
+
+	// version
+	Version int64 `json:"_version,omitempty"`
+
+	// Result of the checks of a dry run
+	DryRun *CommitTransactionOKBodyDryRun `json:"dry_run,omitempty"`
+
+	// ID
+	ID string `json:"id,omitempty"`
+
+	// status
+	// Enum: [failed in_progress success]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this commit transaction o k body
+func (o *CommitTransactionOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDryRun(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CommitTransactionOKBody) validateDryRun(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.DryRun) { // not required
+		return nil
+	}
+
+	if o.DryRun != nil {
+		if err := o.DryRun.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("commitTransactionOK" + "." + "dry_run")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *CommitTransactionOKBody) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.ID) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("commitTransactionOK"+"."+"id", "body", string(o.ID), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var commitTransactionOKBodyTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["failed","in_progress","success"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		commitTransactionOKBodyTypeStatusPropEnum = append(commitTransactionOKBodyTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// CommitTransactionOKBodyStatusFailed captures enum value "failed"
+	CommitTransactionOKBodyStatusFailed string = "failed"
+
+	// CommitTransactionOKBodyStatusInProgress captures enum value "in_progress"
+	CommitTransactionOKBodyStatusInProgress string = "in_progress"
+
+	// CommitTransactionOKBodyStatusSuccess captures enum value "success"
+	CommitTransactionOKBodyStatusSuccess string = "success"
+)
+
+// prop value enum
+func (o *CommitTransactionOKBody) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, commitTransactionOKBodyTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CommitTransactionOKBody) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("commitTransactionOK"+"."+"status", "body", o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CommitTransactionOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CommitTransactionOKBody) UnmarshalBinary(b []byte) error {
+	var res CommitTransactionOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// CommitTransactionOKBodyDryRun Result of the checks of a dry run
+//
+// swagger:model CommitTransactionOKBodyDryRun
+type CommitTransactionOKBodyDryRun struct {
+
+	// changes
+	Changes []*CommitTransactionOKBodyDryRunChangesItems0 `json:"changes"`
+
+	// Rejections of the hooks and alerts of the configuration check
+	Errors []string `json:"errors"`
+
+	// The configuration was changed by mutator hooks, the mutated configuration was checked
+	Mutated bool `json:"mutated,omitempty"`
+
+	// Output of the HAProxy configuration check
+	Output string `json:"output,omitempty"`
+
+	// reload required
+	ReloadRequired bool `json:"reload_required"`
+
+	// valid
+	Valid bool `json:"valid"`
+
+	// Warnings of the configuration check
+	Warnings []string `json:"warnings"`
+}
+
+// Validate validates this commit transaction o k body dry run
+func (o *CommitTransactionOKBodyDryRun) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateChanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CommitTransactionOKBodyDryRun) validateChanges(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Changes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Changes); i++ {
+		if swag.IsZero(o.Changes[i]) { // not required
+			continue
+		}
+
+		if o.Changes[i] != nil {
+			if err := o.Changes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("dry_run" + "." + "changes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CommitTransactionOKBodyDryRun) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CommitTransactionOKBodyDryRun) UnmarshalBinary(b []byte) error {
+	var res CommitTransactionOKBodyDryRun
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// CommitTransactionOKBodyDryRunChangesItems0 commit transaction o k body dry run changes items0
+//
+// swagger:model CommitTransactionOKBodyDryRunChangesItems0
+type CommitTransactionOKBodyDryRunChangesItems0 struct {
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// The change can be applied through the runtime API without a reload
+	Runtime bool `json:"runtime,omitempty"`
+
+	// section
+	Section string `json:"section,omitempty"`
+}
+
+// Validate validates this commit transaction o k body dry run changes items0
+func (o *CommitTransactionOKBodyDryRunChangesItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CommitTransactionOKBodyDryRunChangesItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CommitTransactionOKBodyDryRunChangesItems0) UnmarshalBinary(b []byte) error {
+	var res CommitTransactionOKBodyDryRunChangesItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
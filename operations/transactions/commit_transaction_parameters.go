@@ -37,11 +37,17 @@ func NewCommitTransactionParams() CommitTransactionParams {
 	var (
 		// initialize parameters with default values
 
-		forceReloadDefault = bool(false)
+		dryRunDefault          = bool(false)
+		forceReloadDefault     = bool(false)
+		ignoreConflictsDefault = bool(false)
 	)
 
 	return CommitTransactionParams{
+		DryRun: &dryRunDefault,
+
 		ForceReload: &forceReloadDefault,
+
+		IgnoreConflicts: &ignoreConflictsDefault,
 	}
 }
 
@@ -54,6 +60,11 @@ type CommitTransactionParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Validates the transaction as it would be committed, without writing the configuration or reloading HAProxy
+	  In: query
+	  Default: false
+	*/
+	DryRun *bool
 	/*If set, do a force reload, do not wait for the configured reload-delay. Cannot be used when transaction is specified, as changes in transaction are not applied directly to configuration.
 	  In: query
 	  Default: false
@@ -64,6 +75,11 @@ type CommitTransactionParams struct {
 	  In: path
 	*/
 	ID string
+	/*Commits the transaction even if open transactions change the same sections
+	  In: query
+	  Default: false
+	*/
+	IgnoreConflicts *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -77,6 +93,11 @@ func (o *CommitTransactionParams) BindRequest(r *http.Request, route *middleware
 
 	qs := runtime.Values(r.URL.Query())
 
+	qDryRun, qhkDryRun, _ := qs.GetOK("dry_run")
+	if err := o.bindDryRun(qDryRun, qhkDryRun, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qForceReload, qhkForceReload, _ := qs.GetOK("force_reload")
 	if err := o.bindForceReload(qForceReload, qhkForceReload, route.Formats); err != nil {
 		res = append(res, err)
@@ -87,12 +108,40 @@ func (o *CommitTransactionParams) BindRequest(r *http.Request, route *middleware
 		res = append(res, err)
 	}
 
+	qIgnoreConflicts, qhkIgnoreConflicts, _ := qs.GetOK("ignore_conflicts")
+	if err := o.bindIgnoreConflicts(qIgnoreConflicts, qhkIgnoreConflicts, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindDryRun binds and validates parameter DryRun from query.
+func (o *CommitTransactionParams) bindDryRun(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewCommitTransactionParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("dry_run", "query", "bool", raw)
+	}
+	o.DryRun = &value
+
+	return nil
+}
+
 // bindForceReload binds and validates parameter ForceReload from query.
 func (o *CommitTransactionParams) bindForceReload(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -130,3 +179,26 @@ func (o *CommitTransactionParams) bindID(rawData []string, hasKey bool, formats
 
 	return nil
 }
+
+// bindIgnoreConflicts binds and validates parameter IgnoreConflicts from query.
+func (o *CommitTransactionParams) bindIgnoreConflicts(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewCommitTransactionParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("ignore_conflicts", "query", "bool", raw)
+	}
+	o.IgnoreConflicts = &value
+
+	return nil
+}
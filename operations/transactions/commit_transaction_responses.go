@@ -32,7 +32,7 @@ import (
 // CommitTransactionOKCode is the HTTP code returned for type CommitTransactionOK
 const CommitTransactionOKCode int = 200
 
-/*CommitTransactionOK Transaction succesfully commited
+/*CommitTransactionOK Transaction succesfully commited, or validated with dry_run
 
 swagger:response commitTransactionOK
 */
@@ -41,7 +41,7 @@ type CommitTransactionOK struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.Transaction `json:"body,omitempty"`
+	Payload *CommitTransactionOKBody `json:"body,omitempty"`
 }
 
 // NewCommitTransactionOK creates CommitTransactionOK with default headers values
@@ -51,13 +51,13 @@ func NewCommitTransactionOK() *CommitTransactionOK {
 }
 
 // WithPayload adds the payload to the commit transaction o k response
-func (o *CommitTransactionOK) WithPayload(payload *models.Transaction) *CommitTransactionOK {
+func (o *CommitTransactionOK) WithPayload(payload *CommitTransactionOKBody) *CommitTransactionOK {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the commit transaction o k response
-func (o *CommitTransactionOK) SetPayload(payload *models.Transaction) {
+func (o *CommitTransactionOK) SetPayload(payload *CommitTransactionOKBody) {
 	o.Payload = payload
 }
 
@@ -291,6 +291,50 @@ func (o *CommitTransactionNotFound) WriteResponse(rw http.ResponseWriter, produc
 	}
 }
 
+// CommitTransactionConflictCode is the HTTP code returned for type CommitTransactionConflict
+const CommitTransactionConflictCode int = 409
+
+/*CommitTransactionConflict Conflicting transactions
+
+swagger:response commitTransactionConflict
+*/
+type CommitTransactionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *CommitTransactionConflictBody `json:"body,omitempty"`
+}
+
+// NewCommitTransactionConflict creates CommitTransactionConflict with default headers values
+func NewCommitTransactionConflict() *CommitTransactionConflict {
+
+	return &CommitTransactionConflict{}
+}
+
+// WithPayload adds the payload to the commit transaction conflict response
+func (o *CommitTransactionConflict) WithPayload(payload *CommitTransactionConflictBody) *CommitTransactionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the commit transaction conflict response
+func (o *CommitTransactionConflict) SetPayload(payload *CommitTransactionConflictBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CommitTransactionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*CommitTransactionDefault General Error
 
 swagger:response commitTransactionDefault
@@ -33,7 +33,9 @@ import (
 type CommitTransactionURL struct {
 	ID string
 
-	ForceReload *bool
+	DryRun          *bool
+	ForceReload     *bool
+	IgnoreConflicts *bool
 
 	_basePath string
 	// avoid unkeyed usage
@@ -76,6 +78,14 @@ func (o *CommitTransactionURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var dryRunQ string
+	if o.DryRun != nil {
+		dryRunQ = swag.FormatBool(*o.DryRun)
+	}
+	if dryRunQ != "" {
+		qs.Set("dry_run", dryRunQ)
+	}
+
 	var forceReloadQ string
 	if o.ForceReload != nil {
 		forceReloadQ = swag.FormatBool(*o.ForceReload)
@@ -84,6 +94,14 @@ func (o *CommitTransactionURL) Build() (*url.URL, error) {
 		qs.Set("force_reload", forceReloadQ)
 	}
 
+	var ignoreConflictsQ string
+	if o.IgnoreConflicts != nil {
+		ignoreConflictsQ = swag.FormatBool(*o.IgnoreConflicts)
+	}
+	if ignoreConflictsQ != "" {
+		qs.Set("ignore_conflicts", ignoreConflictsQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -21,9 +21,14 @@ package transactions
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // GetTransactionHandlerFunc turns a function with the right signature into a get transaction handler
@@ -48,7 +53,7 @@ func NewGetTransaction(ctx *middleware.Context, handler GetTransactionHandler) *
 
 Return one HAProxy configuration transactions
 
-Returns one HAProxy configuration transactions.
+Returns one HAProxy configuration transactions, with its note and labels.
 
 */
 type GetTransaction struct {
@@ -86,3 +91,147 @@ func (o *GetTransaction) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// GetTransactionOKBody HAProxy configuration transaction with its note and labels
+//
+// swagger:model GetTransactionOKBody
+type GetTransactionOKBody struct {
+
+	// version
+	Version int64 `json:"_version,omitempty"`
+
+	// Time the transaction expired, deleted after the transaction TTL without changes
+	// Read Only: true
+	ExpiredAt *strfmt.DateTime `json:"expired_at,omitempty"`
+
+	// ID
+	ID string `json:"id,omitempty"`
+
+	// Key/value labels attached to the transaction
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Free-form note attached to the transaction
+	Note string `json:"note,omitempty"`
+
+	// User who started the transaction
+	Owner string `json:"owner,omitempty"`
+
+	// status
+	// Enum: [failed in_progress success expired]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this get transaction o k body
+func (o *GetTransactionOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateExpiredAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetTransactionOKBody) validateExpiredAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.ExpiredAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("getTransactionOK"+"."+"expired_at", "body", "date-time", o.ExpiredAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetTransactionOKBody) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.ID) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("getTransactionOK"+"."+"id", "body", string(o.ID), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getTransactionOKBodyTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["failed","in_progress","success","expired"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getTransactionOKBodyTypeStatusPropEnum = append(getTransactionOKBodyTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// GetTransactionOKBodyStatusFailed captures enum value "failed"
+	GetTransactionOKBodyStatusFailed string = "failed"
+
+	// GetTransactionOKBodyStatusInProgress captures enum value "in_progress"
+	GetTransactionOKBodyStatusInProgress string = "in_progress"
+
+	// GetTransactionOKBodyStatusSuccess captures enum value "success"
+	GetTransactionOKBodyStatusSuccess string = "success"
+
+	// GetTransactionOKBodyStatusExpired captures enum value "expired"
+	GetTransactionOKBodyStatusExpired string = "expired"
+)
+
+// prop value enum
+func (o *GetTransactionOKBody) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getTransactionOKBodyTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetTransactionOKBody) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("getTransactionOK"+"."+"status", "body", o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetTransactionOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetTransactionOKBody) UnmarshalBinary(b []byte) error {
+	var res GetTransactionOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -41,7 +41,7 @@ type GetTransactionOK struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.Transaction `json:"body,omitempty"`
+	Payload *GetTransactionOKBody `json:"body,omitempty"`
 }
 
 // NewGetTransactionOK creates GetTransactionOK with default headers values
@@ -51,13 +51,13 @@ func NewGetTransactionOK() *GetTransactionOK {
 }
 
 // WithPayload adds the payload to the get transaction o k response
-func (o *GetTransactionOK) WithPayload(payload *models.Transaction) *GetTransactionOK {
+func (o *GetTransactionOK) WithPayload(payload *GetTransactionOKBody) *GetTransactionOK {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the get transaction o k response
-func (o *GetTransactionOK) SetPayload(payload *models.Transaction) {
+func (o *GetTransactionOK) SetPayload(payload *GetTransactionOKBody) {
 	o.Payload = payload
 }
 
@@ -21,9 +21,14 @@ package transactions
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // GetTransactionsHandlerFunc turns a function with the right signature into a get transactions handler
@@ -48,7 +53,7 @@ func NewGetTransactions(ctx *middleware.Context, handler GetTransactionsHandler)
 
 Return list of HAProxy configuration transactions.
 
-Returns a list of HAProxy configuration transactions. Transactions can be filtered by their status.
+Returns a list of HAProxy configuration transactions, with their notes and labels. Transactions can be filtered by their status and labels. The in progress transactions expired by the transaction TTL are listed with the expired status.
 
 */
 type GetTransactions struct {
@@ -86,3 +91,147 @@ func (o *GetTransactions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// GetTransactionsOKBodyItems0 HAProxy configuration transaction with its note and labels
+//
+// swagger:model GetTransactionsOKBodyItems0
+type GetTransactionsOKBodyItems0 struct {
+
+	// version
+	Version int64 `json:"_version,omitempty"`
+
+	// Time the transaction expired, deleted after the transaction TTL without changes
+	// Read Only: true
+	ExpiredAt *strfmt.DateTime `json:"expired_at,omitempty"`
+
+	// ID
+	ID string `json:"id,omitempty"`
+
+	// Key/value labels attached to the transaction
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Free-form note attached to the transaction
+	Note string `json:"note,omitempty"`
+
+	// User who started the transaction
+	Owner string `json:"owner,omitempty"`
+
+	// status
+	// Enum: [failed in_progress success expired]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this get transactions o k body items0
+func (o *GetTransactionsOKBodyItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateExpiredAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetTransactionsOKBodyItems0) validateExpiredAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.ExpiredAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expired_at", "body", "date-time", o.ExpiredAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetTransactionsOKBodyItems0) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.ID) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("id", "body", string(o.ID), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getTransactionsOKBodyItems0TypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["failed","in_progress","success","expired"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getTransactionsOKBodyItems0TypeStatusPropEnum = append(getTransactionsOKBodyItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// GetTransactionsOKBodyItems0StatusFailed captures enum value "failed"
+	GetTransactionsOKBodyItems0StatusFailed string = "failed"
+
+	// GetTransactionsOKBodyItems0StatusInProgress captures enum value "in_progress"
+	GetTransactionsOKBodyItems0StatusInProgress string = "in_progress"
+
+	// GetTransactionsOKBodyItems0StatusSuccess captures enum value "success"
+	GetTransactionsOKBodyItems0StatusSuccess string = "success"
+
+	// GetTransactionsOKBodyItems0StatusExpired captures enum value "expired"
+	GetTransactionsOKBodyItems0StatusExpired string = "expired"
+)
+
+// prop value enum
+func (o *GetTransactionsOKBodyItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, getTransactionsOKBodyItems0TypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetTransactionsOKBodyItems0) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("status", "body", o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetTransactionsOKBodyItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetTransactionsOKBodyItems0) UnmarshalBinary(b []byte) error {
+	var res GetTransactionsOKBodyItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
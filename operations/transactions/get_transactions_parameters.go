@@ -46,6 +46,10 @@ type GetTransactionsParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Filter by label, as key=value. Transactions matching all the given labels are returned.
+	  In: query
+	*/
+	Label []string
 	/*Filter by transaction status
 	  In: query
 	*/
@@ -63,6 +67,11 @@ func (o *GetTransactionsParams) BindRequest(r *http.Request, route *middleware.M
 
 	qs := runtime.Values(r.URL.Query())
 
+	qLabel, qhkLabel, _ := qs.GetOK("label")
+	if err := o.bindLabel(qLabel, qhkLabel, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qStatus, qhkStatus, _ := qs.GetOK("status")
 	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
 		res = append(res, err)
@@ -74,6 +83,28 @@ func (o *GetTransactionsParams) BindRequest(r *http.Request, route *middleware.M
 	return nil
 }
 
+// bindLabel binds and validates array parameter Label from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetTransactionsParams) bindLabel(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	labelIC := rawData
+	if len(labelIC) == 0 {
+		return nil
+	}
+
+	var labelIR []string
+	for _, labelIV := range labelIC {
+		labelI := labelIV
+
+		labelIR = append(labelIR, labelI)
+	}
+
+	o.Label = labelIR
+
+	return nil
+}
+
 // bindStatus binds and validates parameter Status from query.
 func (o *GetTransactionsParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -99,7 +130,7 @@ func (o *GetTransactionsParams) bindStatus(rawData []string, hasKey bool, format
 // validateStatus carries on validations for parameter Status
 func (o *GetTransactionsParams) validateStatus(formats strfmt.Registry) error {
 
-	if err := validate.Enum("status", "query", *o.Status, []interface{}{"failed", "in_progress"}); err != nil {
+	if err := validate.Enum("status", "query", *o.Status, []interface{}{"failed", "in_progress", "expired"}); err != nil {
 		return err
 	}
 
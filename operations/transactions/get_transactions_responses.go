@@ -41,7 +41,7 @@ type GetTransactionsOK struct {
 	/*
 	  In: Body
 	*/
-	Payload models.Transactions `json:"body,omitempty"`
+	Payload []*GetTransactionsOKBodyItems0 `json:"body,omitempty"`
 }
 
 // NewGetTransactionsOK creates GetTransactionsOK with default headers values
@@ -51,13 +51,13 @@ func NewGetTransactionsOK() *GetTransactionsOK {
 }
 
 // WithPayload adds the payload to the get transactions o k response
-func (o *GetTransactionsOK) WithPayload(payload models.Transactions) *GetTransactionsOK {
+func (o *GetTransactionsOK) WithPayload(payload []*GetTransactionsOKBodyItems0) *GetTransactionsOK {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the get transactions o k response
-func (o *GetTransactionsOK) SetPayload(payload models.Transactions) {
+func (o *GetTransactionsOK) SetPayload(payload []*GetTransactionsOKBodyItems0) {
 	o.Payload = payload
 }
 
@@ -68,7 +68,7 @@ func (o *GetTransactionsOK) WriteResponse(rw http.ResponseWriter, producer runti
 	payload := o.Payload
 	if payload == nil {
 		// return empty array
-		payload = models.Transactions{}
+		payload = []*GetTransactionsOKBodyItems0{}
 	}
 
 	if err := producer.Produce(rw, payload); err != nil {
@@ -24,10 +24,13 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // GetTransactionsURL generates an URL for the get transactions operation
 type GetTransactionsURL struct {
+	Label  []string
 	Status *string
 
 	_basePath string
@@ -64,6 +67,20 @@ func (o *GetTransactionsURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var labelIR []string
+	for _, labelI := range o.Label {
+		labelIS := labelI
+		if labelIS != "" {
+			labelIR = append(labelIR, labelIS)
+		}
+	}
+
+	label := swag.JoinByFormat(labelIR, "multi")
+
+	for _, qsv := range label {
+		qs.Add("label", qsv)
+	}
+
 	var statusQ string
 	if o.Status != nil {
 		statusQ = *o.Status
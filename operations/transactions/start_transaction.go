@@ -21,9 +21,15 @@ package transactions
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"encoding/json"
 	"net/http"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // StartTransactionHandlerFunc turns a function with the right signature into a start transaction handler
@@ -48,7 +54,7 @@ func NewStartTransaction(ctx *middleware.Context, handler StartTransactionHandle
 
 Start a new transaction
 
-Starts a new transaction and returns it's id
+Starts a new transaction and returns it's id. A note and labels can be attached to the transaction, they are shown in listings, reload records and git mode commit messages. When --max-open-transactions transactions are already in progress, the transaction is not started and status 409 lists the open ones with their owners.
 
 */
 type StartTransaction struct {
@@ -86,3 +92,317 @@ func (o *StartTransaction) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// StartTransactionConflictBody start transaction conflict body
+//
+// swagger:model StartTransactionConflictBody
+type StartTransactionConflictBody struct {
+
+	// code
+	// Required: true
+	Code *int64 `json:"code"`
+
+	// conflicts
+	Conflicts []*StartTransactionConflictBodyConflictsItems0 `json:"conflicts"`
+
+	// message
+	// Required: true
+	Message *string `json:"message"`
+}
+
+// Validate validates this start transaction conflict body
+func (o *StartTransactionConflictBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateConflicts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMessage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *StartTransactionConflictBody) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("startTransactionConflict"+"."+"code", "body", o.Code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *StartTransactionConflictBody) validateConflicts(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Conflicts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Conflicts); i++ {
+		if swag.IsZero(o.Conflicts[i]) { // not required
+			continue
+		}
+
+		if o.Conflicts[i] != nil {
+			if err := o.Conflicts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("startTransactionConflict" + "." + "conflicts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *StartTransactionConflictBody) validateMessage(formats strfmt.Registry) error {
+
+	if err := validate.Required("startTransactionConflict"+"."+"message", "body", o.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *StartTransactionConflictBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *StartTransactionConflictBody) UnmarshalBinary(b []byte) error {
+	var res StartTransactionConflictBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// StartTransactionConflictBodyConflictsItems0 start transaction conflict body conflicts items0
+//
+// swagger:model StartTransactionConflictBodyConflictsItems0
+type StartTransactionConflictBodyConflictsItems0 struct {
+
+	// Transaction id
+	ID string `json:"id,omitempty"`
+
+	// User who started the transaction
+	Owner string `json:"owner,omitempty"`
+
+	// Sections changed by the transaction, as type and name
+	Sections []string `json:"sections"`
+
+	// status
+	// Enum: [in_progress success]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this start transaction conflict body conflicts items0
+func (o *StartTransactionConflictBodyConflictsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var startTransactionConflictBodyConflictsItems0TypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["in_progress","success"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		startTransactionConflictBodyConflictsItems0TypeStatusPropEnum = append(startTransactionConflictBodyConflictsItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// StartTransactionConflictBodyConflictsItems0StatusInProgress captures enum value "in_progress"
+	StartTransactionConflictBodyConflictsItems0StatusInProgress string = "in_progress"
+
+	// StartTransactionConflictBodyConflictsItems0StatusSuccess captures enum value "success"
+	StartTransactionConflictBodyConflictsItems0StatusSuccess string = "success"
+)
+
+// prop value enum
+func (o *StartTransactionConflictBodyConflictsItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, startTransactionConflictBodyConflictsItems0TypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *StartTransactionConflictBodyConflictsItems0) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("status", "body", o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *StartTransactionConflictBodyConflictsItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *StartTransactionConflictBodyConflictsItems0) UnmarshalBinary(b []byte) error {
+	var res StartTransactionConflictBodyConflictsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// StartTransactionCreatedBody HAProxy configuration transaction with its note and labels
+//
+// swagger:model StartTransactionCreatedBody
+type StartTransactionCreatedBody struct {
+
+	// version
+	Version int64 `json:"_version,omitempty"`
+
+	// ID
+	ID string `json:"id,omitempty"`
+
+	// Key/value labels attached to the transaction
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Free-form note attached to the transaction
+	Note string `json:"note,omitempty"`
+
+	// User who started the transaction
+	Owner string `json:"owner,omitempty"`
+
+	// status
+	// Enum: [failed in_progress success]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this start transaction created body
+func (o *StartTransactionCreatedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *StartTransactionCreatedBody) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.ID) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("startTransactionCreated"+"."+"id", "body", string(o.ID), `^[^\s]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var startTransactionCreatedBodyTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["failed","in_progress","success"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		startTransactionCreatedBodyTypeStatusPropEnum = append(startTransactionCreatedBodyTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// StartTransactionCreatedBodyStatusFailed captures enum value "failed"
+	StartTransactionCreatedBodyStatusFailed string = "failed"
+
+	// StartTransactionCreatedBodyStatusInProgress captures enum value "in_progress"
+	StartTransactionCreatedBodyStatusInProgress string = "in_progress"
+
+	// StartTransactionCreatedBodyStatusSuccess captures enum value "success"
+	StartTransactionCreatedBodyStatusSuccess string = "success"
+)
+
+// prop value enum
+func (o *StartTransactionCreatedBody) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, startTransactionCreatedBodyTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *StartTransactionCreatedBody) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("startTransactionCreated"+"."+"status", "body", o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *StartTransactionCreatedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *StartTransactionCreatedBody) UnmarshalBinary(b []byte) error {
+	var res StartTransactionCreatedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -47,6 +47,14 @@ type StartTransactionParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Label attached to the transaction, as key=value
+	  In: query
+	*/
+	Label []string
+	/*Free-form note attached to the transaction
+	  In: query
+	*/
+	Note *string
 	/*Configuration version on which to work on
 	  Required: true
 	  In: query
@@ -65,6 +73,16 @@ func (o *StartTransactionParams) BindRequest(r *http.Request, route *middleware.
 
 	qs := runtime.Values(r.URL.Query())
 
+	qLabel, qhkLabel, _ := qs.GetOK("label")
+	if err := o.bindLabel(qLabel, qhkLabel, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNote, qhkNote, _ := qs.GetOK("note")
+	if err := o.bindNote(qNote, qhkNote, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qVersion, qhkVersion, _ := qs.GetOK("version")
 	if err := o.bindVersion(qVersion, qhkVersion, route.Formats); err != nil {
 		res = append(res, err)
@@ -76,6 +94,46 @@ func (o *StartTransactionParams) BindRequest(r *http.Request, route *middleware.
 	return nil
 }
 
+// bindLabel binds and validates array parameter Label from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *StartTransactionParams) bindLabel(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	labelIC := rawData
+	if len(labelIC) == 0 {
+		return nil
+	}
+
+	var labelIR []string
+	for _, labelIV := range labelIC {
+		labelI := labelIV
+
+		labelIR = append(labelIR, labelI)
+	}
+
+	o.Label = labelIR
+
+	return nil
+}
+
+// bindNote binds and validates parameter Note from query.
+func (o *StartTransactionParams) bindNote(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Note = &raw
+
+	return nil
+}
+
 // bindVersion binds and validates parameter Version from query.
 func (o *StartTransactionParams) bindVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
@@ -41,7 +41,7 @@ type StartTransactionCreated struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.Transaction `json:"body,omitempty"`
+	Payload *StartTransactionCreatedBody `json:"body,omitempty"`
 }
 
 // NewStartTransactionCreated creates StartTransactionCreated with default headers values
@@ -51,13 +51,13 @@ func NewStartTransactionCreated() *StartTransactionCreated {
 }
 
 // WithPayload adds the payload to the start transaction created response
-func (o *StartTransactionCreated) WithPayload(payload *models.Transaction) *StartTransactionCreated {
+func (o *StartTransactionCreated) WithPayload(payload *StartTransactionCreatedBody) *StartTransactionCreated {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the start transaction created response
-func (o *StartTransactionCreated) SetPayload(payload *models.Transaction) {
+func (o *StartTransactionCreated) SetPayload(payload *StartTransactionCreatedBody) {
 	o.Payload = payload
 }
 
@@ -73,6 +73,50 @@ func (o *StartTransactionCreated) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// StartTransactionConflictCode is the HTTP code returned for type StartTransactionConflict
+const StartTransactionConflictCode int = 409
+
+/*StartTransactionConflict Conflicting transactions
+
+swagger:response startTransactionConflict
+*/
+type StartTransactionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *StartTransactionConflictBody `json:"body,omitempty"`
+}
+
+// NewStartTransactionConflict creates StartTransactionConflict with default headers values
+func NewStartTransactionConflict() *StartTransactionConflict {
+
+	return &StartTransactionConflict{}
+}
+
+// WithPayload adds the payload to the start transaction conflict response
+func (o *StartTransactionConflict) WithPayload(payload *StartTransactionConflictBody) *StartTransactionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the start transaction conflict response
+func (o *StartTransactionConflict) SetPayload(payload *StartTransactionConflictBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StartTransactionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*StartTransactionDefault General Error
 
 swagger:response startTransactionDefault
@@ -30,6 +30,8 @@ import (
 
 // StartTransactionURL generates an URL for the start transaction operation
 type StartTransactionURL struct {
+	Label   []string
+	Note    *string
 	Version int64
 
 	_basePath string
@@ -66,6 +68,28 @@ func (o *StartTransactionURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var labelIR []string
+	for _, labelI := range o.Label {
+		labelIS := labelI
+		if labelIS != "" {
+			labelIR = append(labelIR, labelIS)
+		}
+	}
+
+	label := swag.JoinByFormat(labelIR, "multi")
+
+	for _, qsv := range label {
+		qs.Add("label", qsv)
+	}
+
+	var noteQ string
+	if o.Note != nil {
+		noteQ = *o.Note
+	}
+	if noteQ != "" {
+		qs.Set("note", noteQ)
+	}
+
 	versionQ := swag.FormatInt64(o.Version)
 	if versionQ != "" {
 		qs.Set("version", versionQ)
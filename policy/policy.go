@@ -0,0 +1,228 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+const (
+	// admitFunction is the global function a policy script defines
+	admitFunction = "admit"
+	evalTimeout   = time.Second
+)
+
+// Request is a mutating API request submitted to the policies
+type Request struct {
+	Method string
+	// Path is the path pattern of the endpoint in the specification
+	Path string
+	// URL is the requested path with its query string
+	URL       string
+	Operation string
+	// Params are the path parameters of the endpoint
+	Params map[string]string
+	Query  map[string]string
+	User   string
+	// Roles are the roles of the user of a token
+	Roles []string
+	// Body is the decoded JSON body, nil when there is none
+	Body interface{}
+}
+
+// Policy is an admission policy written in Lua. The script defines a global
+// admit(request) function returning false and a reason to deny the request.
+type Policy struct {
+	File  string
+	mu    sync.Mutex
+	state *lua.LState
+}
+
+// Engine evaluates admission policies on mutating requests
+type Engine struct {
+	policies []*Policy
+}
+
+// NewEngine loads the policy script files
+func NewEngine(files []string) (*Engine, error) {
+	e := &Engine{policies: make([]*Policy, 0, len(files))}
+	for _, f := range files {
+		p, err := Load(f)
+		if err != nil {
+			return nil, err
+		}
+		e.policies = append(e.policies, p)
+	}
+	return e, nil
+}
+
+// Empty reports whether there are no policies to evaluate
+func (e *Engine) Empty() bool {
+	return e == nil || len(e.policies) == 0
+}
+
+// Admit evaluates the policies in order, a request is admitted when none of
+// them denies it
+func (e *Engine) Admit(r Request) (bool, string, error) {
+	if e == nil {
+		return true, "", nil
+	}
+	for _, p := range e.policies {
+		allowed, reason, err := p.Admit(r)
+		if err != nil || !allowed {
+			return allowed, reason, err
+		}
+	}
+	return true, "", nil
+}
+
+// Load compiles the policy script file
+func Load(file string) (*Policy, error) {
+	L := newState()
+	if err := L.DoFile(file); err != nil {
+		L.Close()
+		return nil, fmt.Errorf("policy %s: %s", file, err.Error())
+	}
+	if L.GetGlobal(admitFunction).Type() != lua.LTFunction {
+		L.Close()
+		return nil, fmt.Errorf("policy %s: no %s function defined", file, admitFunction)
+	}
+	return &Policy{File: file, state: L}, nil
+}
+
+// Admit evaluates the policy on a request. It returns false and the reason
+// given by the script when the request is denied.
+func (p *Policy) Admit(r Request) (bool, string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), evalTimeout)
+	defer cancel()
+	L := p.state
+	L.SetContext(ctx)
+	defer L.RemoveContext()
+
+	err := L.CallByParam(lua.P{
+		Fn:      L.GetGlobal(admitFunction),
+		NRet:    2,
+		Protect: true,
+	}, requestTable(L, r))
+	if err != nil {
+		return false, "", fmt.Errorf("policy %s: %s", p.File, err.Error())
+	}
+	allowed, reason := L.Get(-2), L.Get(-1)
+	L.Pop(2)
+	if allowed == lua.LNil || lua.LVAsBool(allowed) {
+		return true, "", nil
+	}
+	if reason == lua.LNil {
+		return false, fmt.Sprintf("denied by policy %s", p.File), nil
+	}
+	return false, reason.String(), nil
+}
+
+// newState returns a Lua state with the libraries a policy needs, without
+// access to files or processes
+func newState() *lua.LState {
+	L := lua.NewState(lua.Options{SkipOpenLibs: true})
+	// the base library is opened first like lua.OpenLibs does
+	libs := []struct {
+		name string
+		open lua.LGFunction
+	}{
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+		{lua.OsLibName, lua.OpenOs},
+	}
+	for _, lib := range libs {
+		L.Push(L.NewFunction(lib.open))
+		L.Push(lua.LString(lib.name))
+		L.Call(1, 0)
+	}
+	for _, f := range []string{"dofile", "loadfile"} {
+		L.SetGlobal(f, lua.LNil)
+	}
+	// keep os.time, os.date, os.clock and os.difftime
+	osLib := L.GetGlobal(lua.OsLibName)
+	for _, f := range []string{"execute", "exit", "getenv", "remove", "rename", "setenv", "setlocale", "tmpname"} {
+		L.SetField(osLib, f, lua.LNil)
+	}
+	return L
+}
+
+func requestTable(L *lua.LState, r Request) *lua.LTable {
+	t := L.NewTable()
+	t.RawSetString("method", lua.LString(r.Method))
+	t.RawSetString("path", lua.LString(r.Path))
+	t.RawSetString("url", lua.LString(r.URL))
+	t.RawSetString("operation", lua.LString(r.Operation))
+	t.RawSetString("user", lua.LString(r.User))
+	roles := L.NewTable()
+	for _, role := range r.Roles {
+		roles.Append(lua.LString(role))
+	}
+	t.RawSetString("roles", roles)
+	t.RawSetString("params", stringsTable(L, r.Params))
+	t.RawSetString("query", stringsTable(L, r.Query))
+	t.RawSetString("body", toLua(L, r.Body))
+	return t
+}
+
+func stringsTable(L *lua.LState, m map[string]string) *lua.LTable {
+	t := L.NewTable()
+	for k, v := range m {
+		t.RawSetString(k, lua.LString(v))
+	}
+	return t
+}
+
+// toLua converts a value decoded from JSON to a Lua value
+func toLua(L *lua.LState, v interface{}) lua.LValue {
+	switch v := v.(type) {
+	case nil:
+		return lua.LNil
+	case bool:
+		return lua.LBool(v)
+	case float64:
+		return lua.LNumber(v)
+	case json.Number:
+		f, _ := v.Float64()
+		return lua.LNumber(f)
+	case string:
+		return lua.LString(v)
+	case []interface{}:
+		t := L.NewTable()
+		for _, e := range v {
+			t.Append(toLua(L, e))
+		}
+		return t
+	case map[string]interface{}:
+		t := L.NewTable()
+		for k, e := range v {
+			t.RawSetString(k, toLua(L, e))
+		}
+		return t
+	}
+	return lua.LString(fmt.Sprintf("%v", v))
+}
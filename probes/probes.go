@@ -0,0 +1,193 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package probes
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/haproxytech/dataplaneapi/configuration"
+)
+
+const (
+	// TypeHTTP probes send an HTTP request and check the response status
+	TypeHTTP = "http"
+	// TypeTCP probes open a connection
+	TypeTCP = "tcp"
+
+	defaultTimeout = 5 * time.Second
+)
+
+// Endpoint is an address HAProxy listens on
+type Endpoint struct {
+	Address string
+	TLS     bool
+}
+
+// Resolver returns the endpoints of the binds of a frontend
+type Resolver func(frontend string) ([]Endpoint, error)
+
+// Runner runs the probes configured in the dataplane configuration file
+type Runner struct {
+	probes   []configuration.Probe
+	resolver Resolver
+}
+
+// NewRunner returns a runner for probes, ignoring the ones with an unknown type
+// or without a target
+func NewRunner(probes []configuration.Probe, resolver Resolver) *Runner {
+	r := &Runner{probes: make([]configuration.Probe, 0, len(probes)), resolver: resolver}
+	for _, p := range probes {
+		switch {
+		case p.Address == "" && p.Frontend == "":
+			log.Warningf("Probe %s has no address nor frontend, ignoring it", p.Name)
+		case p.Type != "" && p.Type != TypeHTTP && p.Type != TypeTCP:
+			log.Warningf("Probe %s has unknown type %s, ignoring it", p.Name, p.Type)
+		default:
+			r.probes = append(r.probes, p)
+		}
+	}
+	return r
+}
+
+// Empty reports whether there are no probes to run
+func (r *Runner) Empty() bool {
+	return r == nil || len(r.probes) == 0
+}
+
+// Run runs every probe and returns a report of the failed ones. The error is
+// set when a probe that is not optional failed.
+func (r *Runner) Run() (string, error) {
+	if r.Empty() {
+		return "", nil
+	}
+	var report strings.Builder
+	failed := make([]string, 0)
+	for _, p := range r.probes {
+		endpoints, err := r.endpoints(p)
+		if err == nil {
+			for _, e := range endpoints {
+				if err = probe(p, e); err != nil {
+					break
+				}
+			}
+		}
+		if err == nil {
+			continue
+		}
+		if p.Optional {
+			log.Warningf("Optional probe %s failed, reload is degraded: %s", p.Name, err.Error())
+			fmt.Fprintf(&report, "degraded: probe %s failed: %s\n", p.Name, err.Error())
+			continue
+		}
+		fmt.Fprintf(&report, "probe %s failed: %s\n", p.Name, err.Error())
+		failed = append(failed, p.Name)
+	}
+	if len(failed) > 0 {
+		return report.String(), fmt.Errorf("probes %s failed", strings.Join(failed, ", "))
+	}
+	return report.String(), nil
+}
+
+func (r *Runner) endpoints(p configuration.Probe) ([]Endpoint, error) {
+	if p.Address != "" {
+		return []Endpoint{{Address: p.Address}}, nil
+	}
+	if r.resolver == nil {
+		return nil, fmt.Errorf("frontend %s can not be resolved", p.Frontend)
+	}
+	endpoints, err := r.resolver(p.Frontend)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("frontend %s has no bind to probe", p.Frontend)
+	}
+	return endpoints, nil
+}
+
+// probe sends the probe to an endpoint
+func probe(p configuration.Probe, e Endpoint) error {
+	timeout := defaultTimeout
+	if p.Timeout > 0 {
+		timeout = time.Duration(p.Timeout) * time.Second
+	}
+	if p.Type == TypeTCP {
+		conn, err := net.DialTimeout("tcp", e.Address, timeout)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+
+	scheme := "http"
+	if e.TLS {
+		scheme = "https"
+	}
+	path := p.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	method := p.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequest(method, fmt.Sprintf("%s://%s%s", scheme, e.Address, path), nil)
+	if err != nil {
+		return err
+	}
+	if p.Host != "" {
+		req.Host = p.Host
+	}
+	c := &http.Client{
+		Timeout: timeout,
+		// the response of HAProxy is checked, not the one of the redirect target
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Transport: &http.Transport{
+			// nolint:gosec
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if !expectedStatus(p.ExpectStatus, resp.StatusCode) {
+		return fmt.Errorf("%s %s returned %s", method, e.Address, resp.Status)
+	}
+	return nil
+}
+
+func expectedStatus(expected []int, status int) bool {
+	if len(expected) == 0 {
+		return status < http.StatusInternalServerError
+	}
+	for _, s := range expected {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -20,10 +20,7 @@ package dataplaneapi
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"errors"
-	"fmt"
-	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
@@ -104,6 +101,8 @@ type Server struct {
 	TLSCertificate    flags.Filename `long:"tls-certificate" description:"the certificate to use for secure connections" env:"TLS_CERTIFICATE"`
 	TLSCertificateKey flags.Filename `long:"tls-key" description:"the private key to use for secure connections" env:"TLS_PRIVATE_KEY"`
 	TLSCACertificate  flags.Filename `long:"tls-ca" description:"the certificate authority file to be used with mutual tls auth" env:"TLS_CA_CERTIFICATE"`
+	TLSClientAuth     string         `long:"tls-client-auth" description:"require the client certificates verified with --tls-ca, or only verify them when given with request" choice:"require" choice:"request" env:"TLS_CLIENT_AUTH"`
+	TLSClientIdentity []string       `long:"tls-client-identity" description:"map the client certificates to a user of the API as type:value=user, type being cn, dns, email, uri or ip, such as cn:ops-bot=admin" env:"TLS_CLIENT_IDENTITY" env-delim:","`
 	TLSListenLimit    int            `long:"tls-listen-limit" description:"limit the number of outstanding requests"`
 	TLSKeepAlive      time.Duration  `long:"tls-keep-alive" description:"sets the TCP keep-alive timeouts on accepted connections. It prunes dead TCP connections ( e.g. closing laptop mid-download)"`
 	TLSReadTimeout    time.Duration  `long:"tls-read-timeout" description:"maximum duration before timing out read of the request"`
@@ -115,6 +114,8 @@ type Server struct {
 	hasListeners bool
 	shutdown     chan struct{}
 	shuttingDown int32
+	restarting   int32
+	listeners    []*sharedListener
 	interrupted  bool
 	interrupt    chan os.Signal
 }
@@ -287,20 +288,12 @@ func (s *Server) Serve() (err error) {
 			}
 		}
 
-		if s.TLSCACertificate != "" {
-			// include specified CA certificate
-			caCert, caCertErr := ioutil.ReadFile(string(s.TLSCACertificate))
-			if caCertErr != nil {
-				return caCertErr
-			}
-			caCertPool := x509.NewCertPool()
-			ok := caCertPool.AppendCertsFromPEM(caCert)
-			if !ok {
-				return fmt.Errorf("cannot parse CA certificate")
-			}
-			httpsServer.TLSConfig.ClientCAs = caCertPool
-			httpsServer.TLSConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		// the client certificates are verified with the specified CA
+		// certificate, read again by ReloadClientCertificates
+		if err := clientCertificates.Load(string(s.TLSCACertificate), s.TLSClientAuth, s.TLSClientIdentity); err != nil {
+			return err
 		}
+		httpsServer.TLSConfig.GetConfigForClient = clientCertificates.ConfigForClient(httpsServer.TLSConfig)
 
 		// call custom TLS configurator
 		configureTLS(httpsServer.TLSConfig)
@@ -341,6 +334,8 @@ func (s *Server) Serve() (err error) {
 	go s.handleShutdown(wg, &servers)
 
 	wg.Wait()
+	signal.Stop(s.interrupt)
+	s.releaseListeners()
 	return nil
 }
 
@@ -374,7 +369,7 @@ func (s *Server) Listen() error {
 	}
 
 	if s.hasScheme(schemeUnix) {
-		domSockListener, err := net.Listen("unix", string(s.SocketPath))
+		domSockListener, err := s.listen("unix", string(s.SocketPath))
 		if err != nil {
 			return err
 		}
@@ -382,7 +377,7 @@ func (s *Server) Listen() error {
 	}
 
 	if s.hasScheme(schemeHTTP) {
-		listener, err := net.Listen("tcp", net.JoinHostPort(s.Host, strconv.Itoa(s.Port)))
+		listener, err := s.listen("tcp", net.JoinHostPort(s.Host, strconv.Itoa(s.Port)))
 		if err != nil {
 			return err
 		}
@@ -397,7 +392,7 @@ func (s *Server) Listen() error {
 	}
 
 	if s.hasScheme(schemeHTTPS) {
-		tlsListener, err := net.Listen("tcp", net.JoinHostPort(s.TLSHost, strconv.Itoa(s.TLSPort)))
+		tlsListener, err := s.listen("tcp", net.JoinHostPort(s.TLSHost, strconv.Itoa(s.TLSPort)))
 		if err != nil {
 			return err
 		}
@@ -411,6 +406,7 @@ func (s *Server) Listen() error {
 		s.httpsServerL = tlsListener
 	}
 
+	closeInheritedListeners()
 	s.hasListeners = true
 	return nil
 }
@@ -505,6 +501,15 @@ func (s *Server) TLSListener() (net.Listener, error) {
 	return s.httpsServerL, nil
 }
 
+// ReloadClientCertificates reads the CA certificate and the identities of the
+// client certificates again, applying them to the next TLS handshakes
+func (s *Server) ReloadClientCertificates() error {
+	if !s.hasScheme(schemeHTTPS) {
+		return nil
+	}
+	return clientCertificates.Load(string(s.TLSCACertificate), s.TLSClientAuth, s.TLSClientIdentity)
+}
+
 func handleInterrupt(once *sync.Once, s *Server) {
 	once.Do(func() {
 		for range s.interrupt {
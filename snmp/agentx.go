@@ -0,0 +1,516 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package snmp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// AgentX PDU types, RFC 2741 section 6.1
+const (
+	pduOpen       = 1
+	pduClose      = 2
+	pduRegister   = 3
+	pduGet        = 5
+	pduGetNext    = 6
+	pduGetBulk    = 7
+	pduTestSet    = 8
+	pduCommitSet  = 9
+	pduUndoSet    = 10
+	pduCleanupSet = 11
+	pduResponse   = 18
+)
+
+// AgentX varbind types, RFC 2741 section 5.4
+const (
+	typeOctetString    = 4
+	typeNull           = 5
+	typeGauge32        = 66
+	typeCounter64      = 70
+	typeNoSuchObject   = 128
+	typeNoSuchInstance = 129
+	typeEndOfMibView   = 130
+)
+
+const (
+	flagNonDefaultContext = 0x08
+	flagNetworkByteOrder  = 0x10
+
+	errNotWritable = 17
+
+	headerLength   = 20
+	reconnectDelay = 10 * time.Second
+	// DefaultAddress is the socket of the master agent of net-snmp
+	DefaultAddress = "/var/agentx/master"
+)
+
+// OID is an SNMP object identifier
+type OID []uint32
+
+// ParseOID parses a dotted object identifier
+func ParseOID(s string) (OID, error) {
+	s = strings.TrimPrefix(s, ".")
+	oid := make(OID, 0)
+	for _, p := range strings.Split(s, ".") {
+		n, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OID %s", s)
+		}
+		oid = append(oid, uint32(n))
+	}
+	return oid, nil
+}
+
+func (o OID) String() string {
+	parts := make([]string, len(o))
+	for i, n := range o {
+		parts[i] = strconv.FormatUint(uint64(n), 10)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Compare returns -1, 0 or 1 when o sorts before, like or after b
+func (o OID) Compare(b OID) int {
+	for i := 0; i < len(o) && i < len(b); i++ {
+		switch {
+		case o[i] < b[i]:
+			return -1
+		case o[i] > b[i]:
+			return 1
+		}
+	}
+	switch {
+	case len(o) < len(b):
+		return -1
+	case len(o) > len(b):
+		return 1
+	}
+	return 0
+}
+
+// HasPrefix reports whether o is in the subtree of p
+func (o OID) HasPrefix(p OID) bool {
+	return len(o) >= len(p) && o[:len(p)].Compare(p) == 0
+}
+
+// Value is the value of an object, Data is an uint32, an uint64 or a string
+// depending on its type
+type Value struct {
+	Type uint16
+	Data interface{}
+}
+
+// VarBind is an object and its value
+type VarBind struct {
+	Name  OID
+	Value Value
+}
+
+type header struct {
+	typ           byte
+	flags         byte
+	sessionID     uint32
+	transactionID uint32
+	packetID      uint32
+}
+
+type searchRange struct {
+	start   OID
+	include bool
+	end     OID
+}
+
+// Agent is a read-only AgentX subagent serving the objects of a subtree
+type Agent struct {
+	address  string
+	base     OID
+	varBinds func() []VarBind
+	start    time.Time
+	packetID uint32
+}
+
+// NewAgent returns a subagent registering base with the master agent at address,
+// a unix socket path or tcp:host:port. varBinds returns the objects of the
+// subtree sorted by name.
+func NewAgent(address string, base OID, varBinds func() []VarBind) *Agent {
+	if address == "" {
+		address = DefaultAddress
+	}
+	return &Agent{address: address, base: base, varBinds: varBinds, start: time.Now()}
+}
+
+// Run connects to the master agent and serves its requests, reconnecting
+// when the connection is lost
+func (a *Agent) Run() {
+	for {
+		if err := a.session(); err != nil {
+			log.Warningf("SNMP AgentX session with %s ended: %s", a.address, err.Error())
+		}
+		time.Sleep(reconnectDelay)
+	}
+}
+
+func (a *Agent) session() error {
+	network, address := "unix", a.address
+	if strings.HasPrefix(address, "tcp:") {
+		network, address = "tcp", strings.TrimPrefix(address, "tcp:")
+	}
+	conn, err := net.Dial(network, strings.TrimPrefix(address, "unix:"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// open the session, the master agent assigns its ID in the response
+	e := &encoder{}
+	e.putUint32(0) // timeout and reserved
+	e.putOID(nil, false)
+	e.putString("HAProxy Data Plane API")
+	h, err := a.request(conn, header{typ: pduOpen}, e.Bytes())
+	if err != nil {
+		return fmt.Errorf("open: %s", err.Error())
+	}
+	sessionID := h.sessionID
+
+	e = &encoder{}
+	e.putUint8(0)   // timeout
+	e.putUint8(127) // priority
+	e.putUint8(0)   // range_subid
+	e.putUint8(0)
+	e.putOID(a.base, false)
+	if _, err := a.request(conn, header{typ: pduRegister, sessionID: sessionID}, e.Bytes()); err != nil {
+		return fmt.Errorf("register %s: %s", a.base, err.Error())
+	}
+	log.Infof("SNMP AgentX subagent registered %s with %s", a.base, a.address)
+
+	for {
+		h, payload, err := readPDU(conn)
+		if err != nil {
+			return err
+		}
+		switch h.typ {
+		case pduGet, pduGetNext, pduGetBulk:
+			varBinds, err := a.handleRead(h, payload)
+			if err != nil {
+				return err
+			}
+			if err := a.respond(conn, h, 0, 0, varBinds); err != nil {
+				return err
+			}
+		case pduTestSet:
+			if err := a.respond(conn, h, errNotWritable, 1, nil); err != nil {
+				return err
+			}
+		case pduCommitSet, pduUndoSet:
+			if err := a.respond(conn, h, 0, 0, nil); err != nil {
+				return err
+			}
+		case pduCleanupSet, pduResponse:
+		case pduClose:
+			return fmt.Errorf("closed by the master agent")
+		default:
+			log.Debugf("SNMP AgentX: ignoring PDU of type %d", h.typ)
+		}
+	}
+}
+
+// request sends a PDU and waits for its response
+func (a *Agent) request(conn io.ReadWriter, h header, payload []byte) (header, error) {
+	a.packetID++
+	h.packetID = a.packetID
+	if err := writePDU(conn, h, payload); err != nil {
+		return header{}, err
+	}
+	for {
+		rh, rp, err := readPDU(conn)
+		if err != nil {
+			return header{}, err
+		}
+		if rh.typ != pduResponse || rh.packetID != h.packetID {
+			continue
+		}
+		d := newDecoder(rh, rp)
+		d.uint32() // sysUpTime
+		if code := d.uint16(); code != 0 {
+			return rh, fmt.Errorf("error %d", code)
+		}
+		return rh, d.err
+	}
+}
+
+func (a *Agent) respond(conn io.Writer, h header, code, index uint16, varBinds []VarBind) error {
+	e := &encoder{}
+	e.putUint32(uint32(time.Since(a.start) / (10 * time.Millisecond)))
+	e.putUint16(code)
+	e.putUint16(index)
+	for _, vb := range varBinds {
+		e.putVarBind(vb)
+	}
+	return writePDU(conn, header{typ: pduResponse, sessionID: h.sessionID, transactionID: h.transactionID, packetID: h.packetID}, e.Bytes())
+}
+
+func (a *Agent) handleRead(h header, payload []byte) ([]VarBind, error) {
+	d := newDecoder(h, payload)
+	if h.flags&flagNonDefaultContext != 0 {
+		d.string()
+	}
+	var nonRepeaters, maxRepetitions int
+	if h.typ == pduGetBulk {
+		nonRepeaters = int(d.uint16())
+		maxRepetitions = int(d.uint16())
+	}
+	ranges := make([]searchRange, 0)
+	for d.err == nil && len(d.b) > 0 {
+		start, include := d.oid()
+		end, _ := d.oid()
+		ranges = append(ranges, searchRange{start: start, include: include, end: end})
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	objects := a.varBinds()
+	results := make([]VarBind, 0, len(ranges))
+	switch h.typ {
+	case pduGet:
+		for _, r := range ranges {
+			results = append(results, a.get(objects, r.start))
+		}
+	case pduGetNext:
+		for _, r := range ranges {
+			results = append(results, getNext(objects, r))
+		}
+	case pduGetBulk:
+		if nonRepeaters > len(ranges) {
+			nonRepeaters = len(ranges)
+		}
+		for _, r := range ranges[:nonRepeaters] {
+			results = append(results, getNext(objects, r))
+		}
+		repeaters := append([]searchRange{}, ranges[nonRepeaters:]...)
+		for i := 0; i < maxRepetitions && len(repeaters) > 0; i++ {
+			done := true
+			for j, r := range repeaters {
+				vb := getNext(objects, r)
+				results = append(results, vb)
+				if vb.Value.Type != typeEndOfMibView {
+					done = false
+					repeaters[j].start = vb.Name
+					repeaters[j].include = false
+				}
+			}
+			if done {
+				break
+			}
+		}
+	}
+	return results, nil
+}
+
+func (a *Agent) get(objects []VarBind, name OID) VarBind {
+	i := sort.Search(len(objects), func(i int) bool { return objects[i].Name.Compare(name) >= 0 })
+	if i < len(objects) && objects[i].Name.Compare(name) == 0 {
+		return objects[i]
+	}
+	if name.HasPrefix(a.base) {
+		return VarBind{Name: name, Value: Value{Type: typeNoSuchInstance}}
+	}
+	return VarBind{Name: name, Value: Value{Type: typeNoSuchObject}}
+}
+
+func getNext(objects []VarBind, r searchRange) VarBind {
+	i := sort.Search(len(objects), func(i int) bool { return objects[i].Name.Compare(r.start) >= 0 })
+	if i < len(objects) && !r.include && objects[i].Name.Compare(r.start) == 0 {
+		i++
+	}
+	if i < len(objects) && (len(r.end) == 0 || objects[i].Name.Compare(r.end) < 0) {
+		return objects[i]
+	}
+	return VarBind{Name: r.start, Value: Value{Type: typeEndOfMibView}}
+}
+
+func readPDU(r io.Reader) (header, []byte, error) {
+	buf := make([]byte, headerLength)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return header{}, nil, err
+	}
+	h := header{typ: buf[1], flags: buf[2]}
+	var order binary.ByteOrder = binary.LittleEndian
+	if h.flags&flagNetworkByteOrder != 0 {
+		order = binary.BigEndian
+	}
+	h.sessionID = order.Uint32(buf[4:])
+	h.transactionID = order.Uint32(buf[8:])
+	h.packetID = order.Uint32(buf[12:])
+	payload := make([]byte, order.Uint32(buf[16:]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return header{}, nil, err
+	}
+	return h, payload, nil
+}
+
+func writePDU(w io.Writer, h header, payload []byte) error {
+	e := &encoder{}
+	e.putUint8(1) // version
+	e.putUint8(h.typ)
+	e.putUint8(flagNetworkByteOrder)
+	e.putUint8(0)
+	e.putUint32(h.sessionID)
+	e.putUint32(h.transactionID)
+	e.putUint32(h.packetID)
+	e.putUint32(uint32(len(payload)))
+	e.Write(payload)
+	_, err := w.Write(e.Bytes())
+	return err
+}
+
+// encoder writes AgentX data in network byte order
+type encoder struct {
+	bytes.Buffer
+}
+
+func (e *encoder) putUint8(n uint8) {
+	e.WriteByte(n)
+}
+
+func (e *encoder) putUint16(n uint16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], n)
+	e.Write(b[:])
+}
+
+func (e *encoder) putUint32(n uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], n)
+	e.Write(b[:])
+}
+
+func (e *encoder) putUint64(n uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], n)
+	e.Write(b[:])
+}
+
+func (e *encoder) putOID(oid OID, include bool) {
+	e.putUint8(uint8(len(oid)))
+	e.putUint8(0) // prefix
+	if include {
+		e.putUint8(1)
+	} else {
+		e.putUint8(0)
+	}
+	e.putUint8(0)
+	for _, n := range oid {
+		e.putUint32(n)
+	}
+}
+
+func (e *encoder) putString(s string) {
+	e.putUint32(uint32(len(s)))
+	e.WriteString(s)
+	for i := len(s); i%4 != 0; i++ {
+		e.WriteByte(0)
+	}
+}
+
+func (e *encoder) putVarBind(vb VarBind) {
+	e.putUint16(vb.Value.Type)
+	e.putUint16(0)
+	e.putOID(vb.Name, false)
+	switch v := vb.Value.Data.(type) {
+	case uint32:
+		e.putUint32(v)
+	case uint64:
+		e.putUint64(v)
+	case string:
+		e.putString(v)
+	}
+}
+
+// decoder reads AgentX data in the byte order of the PDU, err is set when
+// the data is too short
+type decoder struct {
+	order binary.ByteOrder
+	b     []byte
+	err   error
+}
+
+func newDecoder(h header, payload []byte) *decoder {
+	d := &decoder{order: binary.LittleEndian, b: payload}
+	if h.flags&flagNetworkByteOrder != 0 {
+		d.order = binary.BigEndian
+	}
+	return d
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return make([]byte, n)
+	}
+	if len(d.b) < n {
+		d.err = fmt.Errorf("truncated PDU")
+		return make([]byte, n)
+	}
+	b := d.b[:n]
+	d.b = d.b[n:]
+	return b
+}
+
+func (d *decoder) uint8() uint8 {
+	return d.next(1)[0]
+}
+
+func (d *decoder) uint16() uint16 {
+	return d.order.Uint16(d.next(2))
+}
+
+func (d *decoder) uint32() uint32 {
+	return d.order.Uint32(d.next(4))
+}
+
+func (d *decoder) oid() (OID, bool) {
+	n := int(d.uint8())
+	prefix := d.uint8()
+	include := d.uint8() != 0
+	d.uint8()
+	oid := make(OID, 0, n+5)
+	if prefix != 0 {
+		oid = append(oid, 1, 3, 6, 1, uint32(prefix))
+	}
+	for i := 0; i < n && d.err == nil; i++ {
+		oid = append(oid, d.uint32())
+	}
+	return oid, include
+}
+
+func (d *decoder) string() string {
+	n := int(d.uint32())
+	padded := n
+	if padded%4 != 0 {
+		padded += 4 - padded%4
+	}
+	return string(d.next(padded)[:n])
+}
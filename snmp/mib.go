@@ -0,0 +1,219 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package snmp
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/haproxytech/models/v2"
+)
+
+const (
+	// DefaultBaseOID is the subtree registered when none is configured, under
+	// the enterprise number of HAProxy Technologies
+	DefaultBaseOID = "1.3.6.1.4.1.23263.4.3"
+	// cacheDuration is the time stats are reused for, a walk of the subtree
+	// is made of many requests
+	cacheDuration = 5 * time.Second
+)
+
+// Tables of the subtree. Objects are named base.table.1.column.process.index,
+// the index being the proxy ID for frontends and backends, and the proxy ID
+// followed by the server ID for servers.
+const (
+	tableFrontends = 1
+	tableBackends  = 2
+	tableServers   = 3
+)
+
+type column struct {
+	id    uint32
+	value func(s *models.NativeStat) (Value, bool)
+}
+
+var (
+	frontendColumns = []column{
+		{1, name}, {2, status},
+		{3, gauge(func(s *models.NativeStatStats) *int64 { return s.Scur })},
+		{4, gauge(func(s *models.NativeStatStats) *int64 { return s.Smax })},
+		{5, gauge(func(s *models.NativeStatStats) *int64 { return s.Slim })},
+		{6, counter(func(s *models.NativeStatStats) *int64 { return s.Stot })},
+		{7, counter(func(s *models.NativeStatStats) *int64 { return s.Bin })},
+		{8, counter(func(s *models.NativeStatStats) *int64 { return s.Bout })},
+		{9, counter(func(s *models.NativeStatStats) *int64 { return s.Dreq })},
+		{10, counter(func(s *models.NativeStatStats) *int64 { return s.Dresp })},
+		{11, counter(func(s *models.NativeStatStats) *int64 { return s.Ereq })},
+		{12, counter(func(s *models.NativeStatStats) *int64 { return s.ReqTot })},
+		{13, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp1xx })},
+		{14, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp2xx })},
+		{15, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp3xx })},
+		{16, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp4xx })},
+		{17, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp5xx })},
+	}
+	backendColumns = []column{
+		{1, name}, {2, status},
+		{3, gauge(func(s *models.NativeStatStats) *int64 { return s.Scur })},
+		{4, gauge(func(s *models.NativeStatStats) *int64 { return s.Smax })},
+		{5, gauge(func(s *models.NativeStatStats) *int64 { return s.Slim })},
+		{6, counter(func(s *models.NativeStatStats) *int64 { return s.Stot })},
+		{7, counter(func(s *models.NativeStatStats) *int64 { return s.Bin })},
+		{8, counter(func(s *models.NativeStatStats) *int64 { return s.Bout })},
+		{9, counter(func(s *models.NativeStatStats) *int64 { return s.Dreq })},
+		{10, counter(func(s *models.NativeStatStats) *int64 { return s.Dresp })},
+		{11, counter(func(s *models.NativeStatStats) *int64 { return s.Econ })},
+		{12, counter(func(s *models.NativeStatStats) *int64 { return s.Eresp })},
+		{13, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp1xx })},
+		{14, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp2xx })},
+		{15, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp3xx })},
+		{16, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp4xx })},
+		{17, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp5xx })},
+		{18, gauge(func(s *models.NativeStatStats) *int64 { return s.Qcur })},
+		{19, gauge(func(s *models.NativeStatStats) *int64 { return s.Act })},
+		{20, gauge(func(s *models.NativeStatStats) *int64 { return s.Bck })},
+	}
+	serverColumns = []column{
+		{1, name},
+		{2, func(s *models.NativeStat) (Value, bool) {
+			return Value{Type: typeOctetString, Data: s.BackendName}, true
+		}},
+		{3, status},
+		{4, gauge(func(s *models.NativeStatStats) *int64 { return s.Scur })},
+		{5, gauge(func(s *models.NativeStatStats) *int64 { return s.Smax })},
+		{6, counter(func(s *models.NativeStatStats) *int64 { return s.Stot })},
+		{7, counter(func(s *models.NativeStatStats) *int64 { return s.Bin })},
+		{8, counter(func(s *models.NativeStatStats) *int64 { return s.Bout })},
+		{9, counter(func(s *models.NativeStatStats) *int64 { return s.Econ })},
+		{10, counter(func(s *models.NativeStatStats) *int64 { return s.Eresp })},
+		{11, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp1xx })},
+		{12, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp2xx })},
+		{13, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp3xx })},
+		{14, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp4xx })},
+		{15, counter(func(s *models.NativeStatStats) *int64 { return s.Hrsp5xx })},
+		{16, gauge(func(s *models.NativeStatStats) *int64 { return s.Qcur })},
+		{17, gauge(func(s *models.NativeStatStats) *int64 { return s.Weight })},
+		{18, func(s *models.NativeStat) (Value, bool) {
+			return Value{Type: typeOctetString, Data: s.Stats.CheckStatus}, true
+		}},
+		{19, counter(func(s *models.NativeStatStats) *int64 { return s.Chkfail })},
+		{20, gauge(func(s *models.NativeStatStats) *int64 { return s.Downtime })},
+	}
+)
+
+func name(s *models.NativeStat) (Value, bool) {
+	return Value{Type: typeOctetString, Data: s.Name}, true
+}
+
+func status(s *models.NativeStat) (Value, bool) {
+	return Value{Type: typeOctetString, Data: s.Stats.Status}, true
+}
+
+func gauge(field func(*models.NativeStatStats) *int64) func(*models.NativeStat) (Value, bool) {
+	return func(s *models.NativeStat) (Value, bool) {
+		v := field(s.Stats)
+		if v == nil {
+			return Value{}, false
+		}
+		n := *v
+		if n < 0 {
+			n = 0
+		}
+		if n > math.MaxUint32 {
+			n = math.MaxUint32
+		}
+		return Value{Type: typeGauge32, Data: uint32(n)}, true
+	}
+}
+
+func counter(field func(*models.NativeStatStats) *int64) func(*models.NativeStat) (Value, bool) {
+	return func(s *models.NativeStat) (Value, bool) {
+		v := field(s.Stats)
+		if v == nil || *v < 0 {
+			return Value{}, false
+		}
+		return Value{Type: typeCounter64, Data: uint64(*v)}, true
+	}
+}
+
+// MIB maps HAProxy stats read from the runtime API to objects
+type MIB struct {
+	base  OID
+	stats func() models.NativeStats
+
+	mu       sync.Mutex
+	cached   []VarBind
+	cachedAt time.Time
+}
+
+// NewMIB returns the objects of the stats returned by stats under base
+func NewMIB(base OID, stats func() models.NativeStats) *MIB {
+	return &MIB{base: base, stats: stats}
+}
+
+// VarBinds returns the objects sorted by name
+func (m *MIB) VarBinds() []VarBind {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.cached != nil && time.Since(m.cachedAt) < cacheDuration {
+		return m.cached
+	}
+
+	varBinds := make([]VarBind, 0)
+	for p, collection := range m.stats() {
+		if collection == nil || collection.Error != "" {
+			continue
+		}
+		process := uint32(p + 1)
+		for _, s := range collection.Stats {
+			if s.Stats == nil || s.Stats.Iid == nil {
+				continue
+			}
+			index := []uint32{process, uint32(*s.Stats.Iid)}
+			var table uint32
+			var columns []column
+			switch s.Type {
+			case models.NativeStatTypeFrontend:
+				table, columns = tableFrontends, frontendColumns
+			case models.NativeStatTypeBackend:
+				table, columns = tableBackends, backendColumns
+			case models.NativeStatTypeServer:
+				if s.Stats.Sid == nil {
+					continue
+				}
+				table, columns = tableServers, serverColumns
+				index = append(index, uint32(*s.Stats.Sid))
+			default:
+				continue
+			}
+			for _, c := range columns {
+				v, ok := c.value(s)
+				if !ok {
+					continue
+				}
+				oid := append(append(append(OID{}, m.base...), table, 1, c.id), index...)
+				varBinds = append(varBinds, VarBind{Name: oid, Value: v})
+			}
+		}
+	}
+	sort.Slice(varBinds, func(i, j int) bool {
+		return varBinds[i].Name.Compare(varBinds[j].Name) < 0
+	})
+	m.cached = varBinds
+	m.cachedAt = time.Now()
+	return varBinds
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package system
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ConfigWindow keeps the configuration directory read-only outside of the
+// changes of the API, running the commands making it writable before the
+// first concurrent change and read-only again after the last one
+var ConfigWindow = &WritableConfig{}
+
+// WritableConfig runs the commands making the configuration directory
+// writable and read-only again, such as remounting it. They run with the
+// privileges the API is left with once they are dropped.
+type WritableConfig struct {
+	mu        sync.Mutex
+	unlockCmd string
+	lockCmd   string
+	writers   int
+}
+
+// SetCommands sets the commands making the configuration directory writable
+// and read-only again, the directory is not locked when they are not set
+func (w *WritableConfig) SetCommands(unlockCmd, lockCmd string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.unlockCmd = unlockCmd
+	w.lockCmd = lockCmd
+}
+
+// Enabled reports whether the configuration directory is kept read-only
+func (w *WritableConfig) Enabled() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.unlockCmd != "" && w.lockCmd != ""
+}
+
+// Locked reports whether the configuration directory is read-only
+func (w *WritableConfig) Locked() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.unlockCmd != "" && w.lockCmd != "" && w.writers == 0
+}
+
+// Open makes the configuration directory writable until Close is called
+func (w *WritableConfig) Open() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.unlockCmd == "" || w.lockCmd == "" {
+		return nil
+	}
+	if w.writers == 0 {
+		if err := runCmd(w.unlockCmd); err != nil {
+			return fmt.Errorf("cannot make the configuration directory writable: %w", err)
+		}
+	}
+	w.writers++
+	return nil
+}
+
+// Close makes the configuration directory read-only again once the last
+// change is done
+func (w *WritableConfig) Close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.writers == 0 {
+		return
+	}
+	w.writers--
+	if w.writers == 0 {
+		if err := runCmd(w.lockCmd); err != nil {
+			log.Warningf("Cannot make the configuration directory read-only: %s", err.Error())
+		}
+	}
+}
+
+func runCmd(cmd string) error {
+	args := strings.Fields(cmd)
+	//nolint:gosec
+	out, err := exec.Command(args[0], args[1:]...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s: %s: %s", cmd, err.Error(), strings.TrimSpace(string(out)))
+	}
+	return nil
+}
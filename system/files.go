@@ -0,0 +1,145 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/google/renameio"
+)
+
+// Files are the settings of the files and directories the API creates
+var Files = &FileSettings{}
+
+// FileSettings sets how the API creates files and directories. Confined, the
+// temporary files are created next to the files they replace instead of the
+// temporary directory of the system, and the files and directories get Label,
+// so that SELinux or AppArmor policies allowing the configured directories
+// allow every write of the API.
+type FileSettings struct {
+	mu       sync.Mutex
+	confined bool
+	fileMode os.FileMode
+	dirMode  os.FileMode
+	label    string
+}
+
+// Set sets the settings, a zero mode keeping the mode chosen by the API and an
+// empty label the label inherited from the parent directory
+func (f *FileSettings) Set(confined bool, fileMode, dirMode os.FileMode, label string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.confined = confined
+	f.fileMode = fileMode
+	f.dirMode = dirMode
+	f.label = label
+}
+
+// Confined reports whether files are only created in the configured directories
+func (f *FileSettings) Confined() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.confined
+}
+
+// Label returns the label set to the files and directories created
+func (f *FileSettings) Label() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.label
+}
+
+// FileMode returns the mode of a file created with perm, the files readable by
+// their owner only, holding credentials, keeping it
+func (f *FileSettings) FileMode(perm os.FileMode) os.FileMode {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fileMode == 0 || perm&0077 == 0 {
+		return perm
+	}
+	return f.fileMode
+}
+
+// DirMode returns the mode of a directory created with perm
+func (f *FileSettings) DirMode(perm os.FileMode) os.FileMode {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.dirMode == 0 || perm&0077 == 0 {
+		return perm
+	}
+	return f.dirMode
+}
+
+// InTempDir reports whether path is in the temporary directory of the system
+func InTempDir(path string) bool {
+	tmp, err := filepath.Abs(os.TempDir())
+	if err != nil {
+		return false
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	return path == tmp || strings.HasPrefix(path, tmp+string(filepath.Separator))
+}
+
+// WriteFile atomically replaces filename with data, its temporary file being
+// created next to it when the files are confined
+func WriteFile(filename string, data []byte, perm os.FileMode) error {
+	dir := ""
+	if Files.Confined() {
+		dir = filepath.Dir(filename)
+	}
+	t, err := renameio.TempFile(dir, filename)
+	if err != nil {
+		return err
+	}
+	// nolint:errcheck
+	defer t.Cleanup()
+	if err := t.Chmod(Files.FileMode(perm)); err != nil {
+		return err
+	}
+	if _, err := t.Write(data); err != nil {
+		return err
+	}
+	if err := t.CloseAtomicallyReplace(); err != nil {
+		return err
+	}
+	return SetLabel(filename)
+}
+
+// MkdirAll creates the directory path and its missing parents
+func MkdirAll(path string, perm os.FileMode) error {
+	if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+		return nil
+	}
+	if err := os.MkdirAll(path, Files.DirMode(perm)); err != nil {
+		return err
+	}
+	return SetLabel(path)
+}
+
+// SetLabel sets the label of the files created to path, when one is set
+func SetLabel(path string) error {
+	label := Files.Label()
+	if label == "" {
+		return nil
+	}
+	return setFileLabel(path, label)
+}
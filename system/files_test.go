@@ -0,0 +1,71 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package system
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestFileMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileMode os.FileMode
+		perm     os.FileMode
+		mode     os.FileMode
+	}{
+		{"default", 0, 0644, 0644},
+		{"default credentials", 0, 0600, 0600},
+		{"configured", 0640, 0644, 0640},
+		{"configured credentials", 0664, 0600, 0600},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &FileSettings{}
+			f.Set(false, tt.fileMode, 0, "")
+			if mode := f.FileMode(tt.perm); mode != tt.mode {
+				t.Fatalf("expected %o, got %o", tt.mode, mode)
+			}
+		})
+	}
+}
+
+func TestWriteFileCredentials(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not supported")
+	}
+	Files.Set(false, 0664, 0, "")
+	defer Files.Set(false, 0, 0, "")
+	dir, err := ioutil.TempDir("", "dataplaneapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "dataplaneapi.yaml")
+	if err := WriteFile(name, []byte("users: []\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Fatalf("expected 600, got %o", fi.Mode().Perm())
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package system
+
+import (
+	"sync"
+)
+
+// Identity is the user and groups the API runs as
+type Identity struct {
+	UID    int
+	GID    int
+	User   string
+	Group  string
+	Groups []int
+}
+
+var privileges = struct {
+	mu      sync.Mutex
+	applied bool
+	chroot  string
+	dropped bool
+}{}
+
+// ChrootDir returns the directory the API was chrooted to, empty when it was not
+func ChrootDir() string {
+	privileges.mu.Lock()
+	defer privileges.mu.Unlock()
+	return privileges.chroot
+}
+
+// PrivilegesDropped reports whether the API changed its user on startup
+func PrivilegesDropped() bool {
+	privileges.mu.Lock()
+	defer privileges.mu.Unlock()
+	return privileges.dropped
+}
@@ -0,0 +1,123 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//go:build !windows
+// +build !windows
+
+package system
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// DropPrivileges changes the root directory of the API to chroot and its user
+// and group to userName and groupName, the ones set, the group defaulting to
+// the primary group of the user. It is called once the listeners are bound,
+// the restarts of the server keeping the privileges of the first start.
+func DropPrivileges(chroot, userName, groupName string) error {
+	privileges.mu.Lock()
+	applied := privileges.applied
+	privileges.applied = true
+	privileges.mu.Unlock()
+	if applied {
+		return nil
+	}
+	uid, gid := -1, -1
+	// the users and groups are looked up before chrooting
+	if userName != "" {
+		u, err := lookupUser(userName)
+		if err != nil {
+			return err
+		}
+		uid, _ = strconv.Atoi(u.Uid)
+		gid, _ = strconv.Atoi(u.Gid)
+	}
+	if groupName != "" {
+		g, err := lookupGroup(groupName)
+		if err != nil {
+			return err
+		}
+		gid, _ = strconv.Atoi(g.Gid)
+	}
+	if chroot != "" {
+		if err := syscall.Chroot(chroot); err != nil {
+			return fmt.Errorf("chroot to %s: %w", chroot, err)
+		}
+		if err := os.Chdir("/"); err != nil {
+			return err
+		}
+		privileges.mu.Lock()
+		privileges.chroot = chroot
+		privileges.mu.Unlock()
+	}
+	if gid != -1 {
+		if err := syscall.Setgroups([]int{gid}); err != nil {
+			return fmt.Errorf("setting groups: %w", err)
+		}
+		if err := syscall.Setgid(gid); err != nil {
+			return fmt.Errorf("setting group %d: %w", gid, err)
+		}
+	}
+	if uid != -1 {
+		if err := syscall.Setuid(uid); err != nil {
+			return fmt.Errorf("setting user %d: %w", uid, err)
+		}
+		privileges.mu.Lock()
+		privileges.dropped = true
+		privileges.mu.Unlock()
+	}
+	return nil
+}
+
+func lookupUser(name string) (*user.User, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		return user.LookupId(name)
+	}
+	return user.Lookup(name)
+}
+
+func lookupGroup(name string) (*user.Group, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		return user.LookupGroupId(name)
+	}
+	return user.LookupGroup(name)
+}
+
+// CurrentIdentity returns the user and groups the API runs as, the names
+// being empty when they cannot be looked up, in a chroot for instance
+func CurrentIdentity() Identity {
+	id := Identity{UID: os.Getuid(), GID: os.Getgid()}
+	if u, err := user.LookupId(strconv.Itoa(id.UID)); err == nil {
+		id.User = u.Username
+	}
+	if g, err := user.LookupGroupId(strconv.Itoa(id.GID)); err == nil {
+		id.Group = g.Name
+	}
+	id.Groups, _ = os.Getgroups()
+	return id
+}
+
+// FileOwner returns the user and group owning the file of fi
+func FileOwner(fi os.FileInfo) (int, int, bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, 0, false
+	}
+	return int(st.Uid), int(st.Gid), true
+}
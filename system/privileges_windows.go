@@ -0,0 +1,46 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package system
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+)
+
+// DropPrivileges is not supported on Windows, the API has to be started as
+// the user it runs as
+func DropPrivileges(chroot, userName, groupName string) error {
+	if chroot != "" || userName != "" || groupName != "" {
+		return fmt.Errorf("chroot and changing the user are not supported on Windows")
+	}
+	return nil
+}
+
+// CurrentIdentity returns the name of the user the API runs as, Windows
+// having no numeric user and group IDs
+func CurrentIdentity() Identity {
+	id := Identity{UID: os.Getuid(), GID: os.Getgid()}
+	if u, err := user.Current(); err == nil {
+		id.User = u.Username
+	}
+	return id
+}
+
+// FileOwner is not available on Windows
+func FileOwner(fi os.FileInfo) (int, int, bool) {
+	return 0, 0, false
+}
@@ -0,0 +1,41 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package system abstracts the operating system the API runs on: the signals
+// it handles, how it stops processes, the default commands reloading and
+// restarting HAProxy and the system information of the info endpoint. Linux
+// and FreeBSD are supported, Windows on a best-effort basis for development.
+package system
+
+import (
+	"os"
+	"os/signal"
+)
+
+// Notify relays the signals sigs to c, the signals the system does not have
+// being nil are skipped, and nothing is relayed when none is left
+func Notify(c chan<- os.Signal, sigs ...os.Signal) {
+	available := make([]os.Signal, 0, len(sigs))
+	for _, s := range sigs {
+		if s != nil {
+			available = append(available, s)
+		}
+	}
+	// relaying no signal would relay all of them
+	if len(available) == 0 {
+		return
+	}
+	signal.Notify(c, available...)
+}
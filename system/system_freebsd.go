@@ -0,0 +1,76 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package system
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// freebsdSysctls are the FreeBSD kernel parameters of the Linux ones, the
+// parameters without an equivalent, such as the conntrack ones, are not available
+var freebsdSysctls = map[string]string{
+	"fs.file-max":        "kern.maxfiles",
+	"fs.nr_open":         "kern.maxfilesperproc",
+	"net.core.somaxconn": "kern.ipc.soacceptqueue",
+}
+
+// Sysctl returns the value of an integer kernel parameter named after its
+// Linux name, nil if it is not available
+func Sysctl(name string) *int64 {
+	bsdName, ok := freebsdSysctls[name]
+	if !ok {
+		return nil
+	}
+	v, err := unix.SysctlUint32(bsdName)
+	if err != nil {
+		return nil
+	}
+	value := int64(v)
+	return &value
+}
+
+// CPUModel returns the model of the CPUs
+func CPUModel() string {
+	model, err := unix.Sysctl("hw.model")
+	if err != nil {
+		return ""
+	}
+	return model
+}
+
+// DefaultReloadCmd returns the command reloading the haproxy service with its
+// rc.d script
+func DefaultReloadCmd() string {
+	return "service haproxy reload"
+}
+
+// DefaultRestartCmd returns the command restarting the haproxy service with
+// its rc.d script
+func DefaultRestartCmd() string {
+	return "service haproxy restart"
+}
+
+// Capabilities are Linux capabilities, not available on FreeBSD
+func Capabilities() string {
+	return ""
+}
+
+// setFileLabel is not supported, SELinux labels only exist on Linux
+func setFileLabel(path, label string) error {
+	return fmt.Errorf("cannot label %s, file labels are only supported on Linux", path)
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package system
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// Sysctl returns the value of an integer kernel parameter, nil if it is not available
+func Sysctl(name string) *int64 {
+	b, err := ioutil.ReadFile(filepath.Join("/proc/sys", strings.Replace(name, ".", "/", -1)))
+	if err != nil {
+		return nil
+	}
+	v, err := strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+// CPUModel returns the model of the first CPU
+func CPUModel() string {
+	file, err := os.Open("/proc/cpuinfo")
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		l := scanner.Text()
+		if strings.HasPrefix(l, "model name") {
+			s := strings.Split(l, ":")
+			return strings.TrimSpace(strings.Join(s[1:], ":"))
+		}
+	}
+	return ""
+}
+
+// DefaultReloadCmd returns the command reloading the haproxy service, with
+// systemd or the init script, empty when there is neither
+func DefaultReloadCmd() string {
+	return serviceCmd("reload")
+}
+
+// DefaultRestartCmd returns the command restarting the haproxy service, with
+// systemd or the init script, empty when there is neither
+func DefaultRestartCmd() string {
+	return serviceCmd("restart")
+}
+
+func serviceCmd(action string) string {
+	if _, err := exec.LookPath("systemctl"); err == nil {
+		return "systemctl " + action + " haproxy"
+	}
+	if _, err := exec.LookPath("service"); err == nil {
+		return "service haproxy " + action
+	}
+	return ""
+}
+
+// Capabilities returns the effective capabilities of the API in hexadecimal,
+// empty when they cannot be read
+func Capabilities() string {
+	file, err := os.Open("/proc/self/status")
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if l := scanner.Text(); strings.HasPrefix(l, "CapEff:") {
+			return strings.TrimSpace(strings.TrimPrefix(l, "CapEff:"))
+		}
+	}
+	return ""
+}
+
+// setFileLabel sets the SELinux context of path
+func setFileLabel(path, label string) error {
+	if err := unix.Lsetxattr(path, "security.selinux", []byte(label), 0); err != nil {
+		return fmt.Errorf("setting the SELinux label of %s: %w", path, err)
+	}
+	return nil
+}
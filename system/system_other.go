@@ -0,0 +1,51 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//go:build !linux && !freebsd
+// +build !linux,!freebsd
+
+package system
+
+import "fmt"
+
+// Sysctl is only available on Linux and FreeBSD
+func Sysctl(name string) *int64 {
+	return nil
+}
+
+// CPUModel is only available on Linux and FreeBSD
+func CPUModel() string {
+	return ""
+}
+
+// DefaultReloadCmd is empty, the reload command has to be set
+func DefaultReloadCmd() string {
+	return ""
+}
+
+// DefaultRestartCmd is empty, the restart command has to be set
+func DefaultRestartCmd() string {
+	return ""
+}
+
+// Capabilities are Linux capabilities, only available on Linux
+func Capabilities() string {
+	return ""
+}
+
+// setFileLabel is not supported, SELinux labels only exist on Linux
+func setFileLabel(path, label string) error {
+	return fmt.Errorf("cannot label %s, file labels are only supported on Linux", path)
+}
@@ -0,0 +1,62 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//go:build !windows
+// +build !windows
+
+package system
+
+import (
+	"bytes"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+var (
+	// ShutdownSignals stop the API
+	ShutdownSignals = []os.Signal{syscall.SIGINT, syscall.SIGTERM}
+	// ReloadSignal restarts the API and sets its runtime client up again
+	ReloadSignal os.Signal = syscall.SIGUSR1
+	// RereadSignal reads the configuration files again
+	RereadSignal os.Signal = syscall.SIGUSR2
+	// HangupSignal reads the dataplane configuration file again and applies
+	// the options which do not need a restart
+	HangupSignal os.Signal = syscall.SIGHUP
+)
+
+// StopProcess asks the process pid to stop
+func StopProcess(pid int) error {
+	return syscall.Kill(pid, syscall.SIGTERM)
+}
+
+// OSString returns the name, release and version of the kernel
+func OSString() string {
+	uName := &unix.Utsname{}
+	if err := unix.Uname(uName); err != nil {
+		return ""
+	}
+	return string(bytes.Trim(uName.Sysname[:], "\x00")) + " " + string(bytes.Trim(uName.Release[:], "\x00")) + " " + string(bytes.Trim(uName.Version[:], "\x00"))
+}
+
+// FileLimits returns the soft and hard limits of open files of the API
+func FileLimits() (int64, int64, bool) {
+	rLimit := &unix.Rlimit{}
+	if err := unix.Getrlimit(unix.RLIMIT_NOFILE, rLimit); err != nil {
+		return 0, 0, false
+	}
+	return int64(rLimit.Cur), int64(rLimit.Max), true
+}
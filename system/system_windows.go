@@ -0,0 +1,55 @@
+// Copyright 2019 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package system
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	// ShutdownSignals stop the API
+	ShutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	// ReloadSignal, RereadSignal and HangupSignal do not exist on Windows,
+	// the API is restarted to apply the changes they would
+	ReloadSignal os.Signal
+	RereadSignal os.Signal
+	HangupSignal os.Signal
+)
+
+// StopProcess terminates the process pid, Windows having no signal asking it
+// to stop
+func StopProcess(pid int) error {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	return p.Kill()
+}
+
+// OSString returns the name and version of Windows
+func OSString() string {
+	v := windows.RtlGetVersion()
+	return fmt.Sprintf("Windows %d.%d build %d", v.MajorVersion, v.MinorVersion, v.BuildNumber)
+}
+
+// FileLimits is not available on Windows
+func FileLimits() (int64, int64, bool) {
+	return 0, 0, false
+}